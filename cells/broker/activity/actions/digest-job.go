@@ -14,36 +14,56 @@ import (
 )
 
 func DigestJob() *jobs.Job {
+	return digestJob("users-activity-digest", "Users activities digest", "R/2012-06-04T19:25:16.828696-07:00/PT15M", "") // every 15 mn
+}
+
+// DailyDigestJob sends activities routed to the "daily" channel of users notification preferences.
+func DailyDigestJob() *jobs.Job {
+	return digestJob("users-activity-digest-daily", "Users daily activities digest", "R/2012-01-01T07:00:00.828Z/PT24H", digestFrequencyDaily)
+}
+
+// WeeklyDigestJob sends activities routed to the "weekly" channel of users notification preferences.
+func WeeklyDigestJob() *jobs.Job {
+	return digestJob("users-activity-digest-weekly", "Users weekly activities digest", "R/2012-01-02T07:00:00.828Z/PT168H", digestFrequencyWeekly)
+}
+
+func digestJob(id, label, schedule, frequency string) *jobs.Job {
 	// Build queries for standard users
 	q1, _ := anypb.New(&idm.UserSingleQuery{NodeType: idm.NodeType_USER})
 	q2, _ := anypb.New(&idm.UserSingleQuery{AttributeName: idm.UserAttrHidden, AttributeAnyValue: true, Not: true})
+	action := &jobs.Action{
+		ID: digestActionName,
+		UsersSelector: &jobs.UsersSelector{
+			Label: "All users except hidden",
+			Query: &service.Query{
+				SubQueries: []*anypb.Any{q1, q2},
+				Operation:  service.OperationType_AND,
+			},
+		},
+	}
+	if frequency != "" {
+		action.Parameters = map[string]string{"frequency": frequency}
+	}
 	return &jobs.Job{
-		ID:             "users-activity-digest",
-		Label:          "Users activities digest",
+		ID:             id,
+		Label:          label,
 		Owner:          common.PydioSystemUsername,
 		MaxConcurrency: 1,
 		AutoStart:      false,
 		Schedule: &jobs.Schedule{
-			Iso8601Schedule: "R/2012-06-04T19:25:16.828696-07:00/PT15M", // every 5 mn
-		},
-		Actions: []*jobs.Action{
-			{
-				ID: "broker.activity.actions.mail-digest",
-				UsersSelector: &jobs.UsersSelector{
-					Label: "All users except hidden",
-					Query: &service.Query{
-						SubQueries: []*anypb.Any{q1, q2},
-						Operation:  service.OperationType_AND,
-					},
-				},
-			},
+			Iso8601Schedule: schedule,
 		},
+		Actions: []*jobs.Action{action},
 	}
 
 }
 
 func RegisterDigestJob(ctx context.Context) error {
-	log.Logger(ctx).Info("Registering default job for creating activities digests")
-	_, err := jobsc.JobServiceClient(ctx).PutJob(ctx, &jobs.PutJobRequest{Job: DigestJob()})
-	return err
+	log.Logger(ctx).Info("Registering default jobs for creating activities digests")
+	for _, j := range []*jobs.Job{DigestJob(), DailyDigestJob(), WeeklyDigestJob()} {
+		if _, err := jobsc.JobServiceClient(ctx).PutJob(ctx, &jobs.PutJobRequest{Job: j}); err != nil {
+			return err
+		}
+	}
+	return nil
 }
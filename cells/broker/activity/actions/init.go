@@ -34,5 +34,7 @@ func init() {
 		return &MailDigestAction{}
 	})
 	jobs.RegisterDefault(DigestJob(), common.ServiceGrpcNamespace_+common.ServiceActivity)
+	jobs.RegisterDefault(DailyDigestJob(), common.ServiceGrpcNamespace_+common.ServiceActivity)
+	jobs.RegisterDefault(WeeklyDigestJob(), common.ServiceGrpcNamespace_+common.ServiceActivity)
 
 }
@@ -25,6 +25,8 @@ import (
 	"strings"
 	"time"
 
+	"go.uber.org/zap"
+
 	activity2 "github.com/pydio/cells/v5/broker/activity"
 	l "github.com/pydio/cells/v5/broker/activity/lang"
 	"github.com/pydio/cells/v5/broker/activity/render"
@@ -47,11 +49,18 @@ import (
 
 const (
 	digestActionName = "broker.activity.actions.mail-digest"
+
+	digestFrequencyDaily  = "daily"
+	digestFrequencyWeekly = "weekly"
 )
 
+// MailDigestAction sends users the activities of their inbox that were not sent yet, following their notification
+// preferences. Without frequency, it delivers the "email" and "webhook" channels, otherwise it sends a
+// daily or weekly digest of the activities routed to the corresponding channel.
 type MailDigestAction struct {
-	dryRun  bool
-	dryMail string
+	dryRun    bool
+	dryMail   string
+	frequency string
 }
 
 // GetDescription returns the action description
@@ -87,6 +96,14 @@ func (m *MailDigestAction) Init(ctx context.Context, job *jobs.Job, action *jobs
 	if email, ok := action.Parameters["dryMail"]; ok && email != "" {
 		m.dryMail = email
 	}
+	if f, ok := action.Parameters["frequency"]; ok {
+		switch f {
+		case "", digestFrequencyDaily, digestFrequencyWeekly:
+			m.frequency = f
+		default:
+			return errors.WithMessagef(errors.InvalidParameters, "unsupported digest frequency %s", f)
+		}
+	}
 	return nil
 }
 
@@ -115,7 +132,27 @@ func (m *MailDigestAction) Run(ctx context.Context, channels *actions.RunnableCh
 	var email, displayName string
 	var has bool
 
-	if email, has = userObject.Attributes["email"]; !has {
+	prefs, er := activity.ParseNotificationPreferences(userObject.Attributes[idm.UserAttrNotifications])
+	if er != nil {
+		log.TasksLogger(ctx).Warn("Ignoring invalid notification preferences for user "+userObject.Login, zap.Error(er))
+		prefs = &activity.NotificationPreferences{}
+	}
+	box := activity2.BoxLastSent
+	var since int64
+	switch m.frequency {
+	case digestFrequencyDaily:
+		box, since = activity2.BoxLastDaily, time.Now().Add(-24*time.Hour).Unix()
+	case digestFrequencyWeekly:
+		box, since = activity2.BoxLastWeekly, time.Now().Add(-7*24*time.Hour).Unix()
+	default:
+		if prefs.InQuietHours(time.Now()) {
+			// Activities will be sent at the end of the quiet hours
+			return input.WithIgnore(), nil
+		}
+	}
+
+	email, has = userObject.Attributes["email"]
+	if !has && (m.frequency != "" || prefs.WebhookURL == "") {
 		// Ignoring as the user has no email address set up
 		return input.WithIgnore(), nil
 	}
@@ -129,6 +166,7 @@ func (m *MailDigestAction) Run(ctx context.Context, channels *actions.RunnableCh
 		ContextData: userObject.Login,
 		BoxName:     "inbox",
 		AsDigest:    true,
+		DigestBox:   string(box),
 	}
 
 	streamer, e := activityClient(ctx).StreamActivities(ctx, query)
@@ -152,7 +190,22 @@ func (m *MailDigestAction) Run(ctx context.Context, channels *actions.RunnableCh
 		return input, nil
 	}
 
-	digest, err := activity2.Digest(ctx, collection)
+	mailed, hooked := m.routeActivities(ctx, prefs, collection, since)
+	if len(hooked) > 0 && !m.dryRun {
+		if er := postToWebhook(ctx, prefs.WebhookURL, userObject.Login, hooked); er != nil {
+			// Do not move the marker, activities will be posted again on next run
+			log.TasksLogger(ctx).Warn("Cannot post activities to webhook for user "+userObject.Login, zap.Error(er))
+			return input.WithError(er), er
+		}
+	}
+	if len(mailed) == 0 || email == "" {
+		if err := m.storeLastSent(ctx, userObject.Login, box, collection); err != nil {
+			return input.WithError(err), err
+		}
+		return input, nil
+	}
+
+	digest, err := activity2.Digest(ctx, mailed)
 	if err != nil {
 		return input.WithError(err), err
 	}
@@ -213,16 +266,58 @@ func (m *MailDigestAction) Run(ctx context.Context, channels *actions.RunnableCh
 	}
 
 	log.TasksLogger(ctx).Info("Digest sent to user "+userObject.Login, userObject.ZapLogin())
-	if len(collection) > 0 && !m.dryRun {
-		lastActivity := collection[0] // Activities are in reverse order, the first one is the last id
-		_, err := activityClient(ctx).SetUserLastActivity(ctx, &activity.UserLastActivityRequest{
-			ActivityId: lastActivity.Id,
-			UserId:     userObject.Login,
-			BoxName:    "lastsent",
-		})
-		if err != nil {
-			return input.WithError(err), err
-		}
+	if err := m.storeLastSent(ctx, userObject.Login, box, collection); err != nil {
+		return input.WithError(err), err
 	}
 	return input, nil
 }
+
+// storeLastSent moves the digest marker to the last activity of the collection.
+func (m *MailDigestAction) storeLastSent(ctx context.Context, login string, box activity2.BoxName, collection []*activity.Object) error {
+	if len(collection) == 0 || m.dryRun {
+		return nil
+	}
+	lastActivity := collection[0] // Activities are in reverse order, the first one is the last id
+	_, err := activityClient(ctx).SetUserLastActivity(ctx, &activity.UserLastActivityRequest{
+		ActivityId: lastActivity.Id,
+		UserId:     login,
+		BoxName:    string(box),
+	})
+	return err
+}
+
+// routeActivities applies user preferences to the collection and returns the activities to send by email and
+// the ones to post to the webhook. Activities older than since are ignored, so that a newly enabled digest
+// does not contain the whole inbox history.
+func (m *MailDigestAction) routeActivities(ctx context.Context, prefs *activity.NotificationPreferences, collection []*activity.Object, since int64) (mailed, hooked []*activity.Object) {
+	ancestors := make(map[string][]string)
+	for _, ac := range collection {
+		if since > 0 && ac.GetUpdated().GetSeconds() < since {
+			continue
+		}
+		ev := activity.NotificationEventFor(ac)
+		var folders []string
+		if prefs.HasFolderOverrides() {
+			folders = activityFolders(ctx, ac, ancestors)
+		}
+		switch m.frequency {
+		case digestFrequencyDaily:
+			if prefs.Routes(ev, activity.NotificationChannelDaily, folders...) {
+				mailed = append(mailed, ac)
+			}
+		case digestFrequencyWeekly:
+			if prefs.Routes(ev, activity.NotificationChannelWeekly, folders...) {
+				mailed = append(mailed, ac)
+			}
+		default:
+			// Mentions are emailed by the chat service as they happen, unless they happened during quiet hours
+			if prefs.Routes(ev, activity.NotificationChannelEmail, folders...) && (ev != activity.NotificationEventMention || prefs.InQuietHours(ac.GetUpdated().AsTime())) {
+				mailed = append(mailed, ac)
+			}
+			if prefs.Routes(ev, activity.NotificationChannelWebhook, folders...) {
+				hooked = append(hooked, ac)
+			}
+		}
+	}
+	return
+}
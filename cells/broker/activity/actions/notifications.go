@@ -0,0 +1,118 @@
+/*
+ * Copyright (c) 2025 Abstrium SAS <team (at) pydio.com>
+ * This file is part of Pydio Cells.
+ *
+ * Pydio Cells is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * Pydio Cells is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with Pydio Cells.  If not, see <http://www.gnu.org/licenses/>.
+ *
+ * The latest code can be found at <https://pydio.com>.
+ */
+
+package actions
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net"
+	"net/http"
+	"syscall"
+	"time"
+
+	"google.golang.org/protobuf/encoding/protojson"
+
+	"github.com/pydio/cells/v5/common/client/commons/treec"
+	"github.com/pydio/cells/v5/common/nodes"
+	"github.com/pydio/cells/v5/common/proto/activity"
+	"github.com/pydio/cells/v5/common/proto/tree"
+)
+
+var (
+	webhookClient = &http.Client{
+		Timeout: 10 * time.Second,
+		Transport: &http.Transport{
+			DialContext: (&net.Dialer{Timeout: 5 * time.Second, Control: refuseInternalAddress}).DialContext,
+		},
+	}
+	sharedAddressSpace = &net.IPNet{IP: net.IPv4(100, 64, 0, 0), Mask: net.CIDRMask(10, 32)}
+)
+
+// refuseInternalAddress is called on the resolved address before connecting, so that user-defined webhooks
+// cannot target the server itself or its internal network, even through a hostname or a redirection.
+func refuseInternalAddress(_, address string, _ syscall.RawConn) error {
+	host, _, er := net.SplitHostPort(address)
+	if er != nil {
+		return er
+	}
+	ip := net.ParseIP(host)
+	if ip == nil || ip.IsLoopback() || ip.IsPrivate() || ip.IsUnspecified() || ip.IsLinkLocalUnicast() ||
+		ip.IsMulticast() || sharedAddressSpace.Contains(ip) {
+		return fmt.Errorf("webhook address %s is not allowed", host)
+	}
+	return nil
+}
+
+// activityFolders lists the uuids of the activity node and its ancestors, closest first, to resolve
+// per-folder overrides. Results are cached by node uuid for the duration of a run.
+func activityFolders(ctx context.Context, ac *activity.Object, cache map[string][]string) []string {
+	object := ac.GetObject()
+	if object.GetId() == "" || (object.GetType() != activity.ObjectType_Document && object.GetType() != activity.ObjectType_Folder) {
+		return nil
+	}
+	if uuids, ok := cache[object.GetId()]; ok {
+		return uuids
+	}
+	uuids := []string{object.GetId()}
+	if ancestors, er := nodes.BuildAncestorsList(ctx, treec.NodeProviderClient(ctx), &tree.Node{Uuid: object.GetId()}); er == nil {
+		uuids = uuids[:0]
+		for _, a := range ancestors {
+			uuids = append(uuids, a.GetUuid())
+		}
+	}
+	cache[object.GetId()] = uuids
+	return uuids
+}
+
+// postToWebhook sends activities as a JSON document {"user": login, "activities": [...]} to the user webhook.
+func postToWebhook(ctx context.Context, url, login string, activities []*activity.Object) error {
+	payload := struct {
+		User       string            `json:"user"`
+		Activities []json.RawMessage `json:"activities"`
+	}{User: login}
+	for _, ac := range activities {
+		data, er := protojson.Marshal(ac)
+		if er != nil {
+			return er
+		}
+		payload.Activities = append(payload.Activities, data)
+	}
+	body, er := json.Marshal(payload)
+	if er != nil {
+		return er
+	}
+	req, er := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewReader(body))
+	if er != nil {
+		return er
+	}
+	req.Header.Set("Content-Type", "application/json")
+	resp, er := webhookClient.Do(req)
+	if er != nil {
+		return er
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode >= 300 {
+		return fmt.Errorf("webhook responded with status %d", resp.StatusCode)
+	}
+	return nil
+}
@@ -0,0 +1,55 @@
+/*
+ * Copyright (c) 2025 Abstrium SAS <team (at) pydio.com>
+ * This file is part of Pydio Cells.
+ *
+ * Pydio Cells is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * Pydio Cells is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with Pydio Cells.  If not, see <http://www.gnu.org/licenses/>.
+ *
+ * The latest code can be found at <https://pydio.com>.
+ */
+
+package actions
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/pydio/cells/v5/common/proto/activity"
+
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestWebhookAddresses(t *testing.T) {
+	Convey("Test webhook address filtering", t, func() {
+		So(refuseInternalAddress("tcp", "127.0.0.1:80", nil), ShouldNotBeNil)
+		So(refuseInternalAddress("tcp", "[::1]:443", nil), ShouldNotBeNil)
+		So(refuseInternalAddress("tcp", "10.1.2.3:443", nil), ShouldNotBeNil)
+		So(refuseInternalAddress("tcp", "192.168.1.10:443", nil), ShouldNotBeNil)
+		So(refuseInternalAddress("tcp", "169.254.169.254:80", nil), ShouldNotBeNil)
+		So(refuseInternalAddress("tcp", "100.100.1.1:80", nil), ShouldNotBeNil)
+		So(refuseInternalAddress("tcp", "0.0.0.0:80", nil), ShouldNotBeNil)
+		So(refuseInternalAddress("tcp", "[::ffff:127.0.0.1]:80", nil), ShouldNotBeNil)
+		So(refuseInternalAddress("tcp", "93.184.216.34:443", nil), ShouldBeNil)
+
+		var called bool
+		srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			called = true
+		}))
+		defer srv.Close()
+		er := postToWebhook(context.Background(), srv.URL, "user", []*activity.Object{{Id: "1"}})
+		So(er, ShouldNotBeNil)
+		So(called, ShouldBeFalse)
+	})
+}
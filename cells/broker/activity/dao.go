@@ -42,8 +42,15 @@ const (
 	BoxSubscriptions BoxName = "subscriptions"
 	BoxLastRead      BoxName = "lastread"
 	BoxLastSent      BoxName = "lastsent"
+	BoxLastDaily     BoxName = "lastsent-daily"
+	BoxLastWeekly    BoxName = "lastsent-weekly"
 )
 
+// IsDigestMarker tells whether this box stores the last activity sent by one of the digests.
+func (b BoxName) IsDigestMarker() bool {
+	return b == BoxLastSent || b == BoxLastDaily || b == BoxLastWeekly
+}
+
 var (
 	Drivers = service.StorageDrivers{}
 )
@@ -360,7 +360,7 @@ func (dao *boltdbimpl) ActivitiesFor(ctx context.Context, ownerType acproto.Owne
 		return nil
 	})
 
-	if refBoxOffset != activity.BoxLastSent && ownerType == acproto.OwnerType_USER && boxName == activity.BoxInbox && len(lastRead) > 0 {
+	if !refBoxOffset.IsDigestMarker() && ownerType == acproto.OwnerType_USER && boxName == activity.BoxInbox && len(lastRead) > 0 {
 		// Store last read in dedicated box
 		go func() {
 			if err := dao.storeLastUserInbox(ownerId, activity.BoxLastRead, lastRead); err != nil {
@@ -257,7 +257,7 @@ func (m *mongoimpl) ActivitiesFor(ctx context.Context, ownerType proto.OwnerType
 		result <- doc.Object
 	}
 
-	if refBoxOffset != activity.BoxLastSent && ownerType == proto.OwnerType_USER && boxName == activity.BoxInbox && userLastRead > 0 {
+	if !refBoxOffset.IsDigestMarker() && ownerType == proto.OwnerType_USER && boxName == activity.BoxInbox && userLastRead > 0 {
 		// Store last read in dedicated box
 		go func() {
 			if er := m.storeLastUserInbox(ctx, ownerId, activity.BoxLastRead, userLastRead); er != nil {
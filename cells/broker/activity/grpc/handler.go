@@ -32,6 +32,7 @@ import (
 	"github.com/pydio/cells/v5/broker/activity"
 	"github.com/pydio/cells/v5/common/client/commons/treec"
 	"github.com/pydio/cells/v5/common/errors"
+	"github.com/pydio/cells/v5/common/permissions"
 	proto "github.com/pydio/cells/v5/common/proto/activity"
 	"github.com/pydio/cells/v5/common/proto/tree"
 	"github.com/pydio/cells/v5/common/runtime/manager"
@@ -66,7 +67,16 @@ func (h *Handler) PostActivity(stream proto.ActivityService_PostActivityServer)
 		default:
 			return errors.New("unrecognized box name")
 		}
-		if e := dao.PostActivity(ctx, request.OwnerType, request.OwnerId, boxName, request.Activity, true); e != nil {
+		publish := true
+		if request.OwnerType == proto.OwnerType_USER && boxName == activity.BoxInbox {
+			if user, er := permissions.SearchUniqueUser(ctx, request.OwnerId, ""); er == nil {
+				var post bool
+				if post, publish = inboxRouting(ctx, user, request.Activity); !post {
+					continue
+				}
+			}
+		}
+		if e := dao.PostActivity(ctx, request.OwnerType, request.OwnerId, boxName, request.Activity, publish); e != nil {
 			return e
 		}
 	}
@@ -79,6 +89,13 @@ func (h *Handler) StreamActivities(request *proto.StreamActivitiesRequest, strea
 	if err != nil {
 		return err
 	}
+	digestBox := activity.BoxLastSent
+	if request.DigestBox != "" {
+		digestBox = activity.BoxName(request.DigestBox)
+		if !digestBox.IsDigestMarker() {
+			return errors.New("invalid digest box name")
+		}
+	}
 	log.Logger(ctx).Debug("Should get activities", zap.Any("r", request))
 	treeStreamer := treec.NodeProviderStreamerClient(ctx) // tree.NewNodeProviderStreamerClient(grpc.ResolveConn(ctx, common.ServiceTree))
 	sClient, e := treeStreamer.ReadNodeStream(ctx)
@@ -142,7 +159,7 @@ func (h *Handler) StreamActivities(request *proto.StreamActivitiesRequest, strea
 	} else if request.Context == proto.StreamContext_USER_ID {
 		var refBoxOffset activity.BoxName
 		if request.AsDigest {
-			refBoxOffset = activity.BoxLastSent
+			refBoxOffset = digestBox
 		}
 		er = dao.ActivitiesFor(ctx, proto.OwnerType_USER, request.ContextData, boxName, refBoxOffset, request.Offset, request.Limit, "", result, done)
 		wg.Wait()
@@ -218,12 +235,8 @@ func (h *Handler) SetUserLastActivity(ctx context.Context, request *proto.UserLa
 	if err != nil {
 		return nil, err
 	}
-	var boxName activity.BoxName
-	if request.BoxName == "lastread" {
-		boxName = activity.BoxLastRead
-	} else if request.BoxName == "lastsent" {
-		boxName = activity.BoxLastSent
-	} else {
+	boxName := activity.BoxName(request.BoxName)
+	if boxName != activity.BoxLastRead && !boxName.IsDigestMarker() {
 		return nil, errors.New("invalid box name")
 	}
 
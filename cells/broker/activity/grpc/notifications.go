@@ -0,0 +1,58 @@
+/*
+ * Copyright (c) 2025 Abstrium SAS <team (at) pydio.com>
+ * This file is part of Pydio Cells.
+ *
+ * Pydio Cells is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * Pydio Cells is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with Pydio Cells.  If not, see <http://www.gnu.org/licenses/>.
+ *
+ * The latest code can be found at <https://pydio.com>.
+ */
+
+package grpc
+
+import (
+	"context"
+	"time"
+
+	"go.uber.org/zap"
+
+	proto "github.com/pydio/cells/v5/common/proto/activity"
+	"github.com/pydio/cells/v5/common/proto/idm"
+	"github.com/pydio/cells/v5/common/proto/tree"
+	"github.com/pydio/cells/v5/common/telemetry/log"
+)
+
+// inboxRouting applies the user notification preferences to an activity. The activity is posted to the
+// user inbox as soon as one channel is routed, as digests and webhooks are fed from the inbox, but it is
+// published as a live alert only if the in-app channel is routed and the user is not in quiet hours.
+func inboxRouting(ctx context.Context, user *idm.User, ac *proto.Object, folders ...string) (post, publish bool) {
+	prefs, er := proto.ParseNotificationPreferences(user.GetAttributes()[idm.UserAttrNotifications])
+	if er != nil {
+		log.Logger(ctx).Warn("Ignoring invalid notification preferences", user.ZapLogin(), zap.Error(er))
+		return true, true
+	}
+	ev := proto.NotificationEventFor(ac)
+	if len(prefs.Route(ev, folders...)) == 0 {
+		return false, false
+	}
+	publish = prefs.Routes(ev, proto.NotificationChannelInApp, folders...) && !prefs.InQuietHours(time.Now())
+	return true, publish
+}
+
+// folderUuids lists the uuids of an ancestors list, as expected by inboxRouting.
+func folderUuids(ancestors []*tree.Node) (uuids []string) {
+	for _, a := range ancestors {
+		uuids = append(uuids, a.GetUuid())
+	}
+	return
+}
@@ -211,7 +211,11 @@ func (e *MicroEventsSubscriber) HandleNodeChange(ctx context.Context, msg *tree.
 			continue
 		}
 		if accessList.CanReadWithResolver(userCtx, e.vNodeResolver, ancestors...) {
-			if er := dao.PostActivity(ctx, activity2.OwnerType_USER, subscription.UserId, activity.BoxInbox, ac, true); er != nil {
+			post, publish := inboxRouting(ctx, user, ac, folderUuids(ancestors)...)
+			if !post {
+				continue
+			}
+			if er := dao.PostActivity(ctx, activity2.OwnerType_USER, subscription.UserId, activity.BoxInbox, ac, publish); er != nil {
 				log.Logger(ctx).Error("Could not post activity", zap.Error(er))
 			}
 		}
@@ -404,8 +408,10 @@ func (e *MicroEventsSubscriber) ProcessIdmBatch(ctx context.Context, cE ...broke
 			ac := activity.AclActivity(sourceUser.Login, workspaces[r.Acl.WorkspaceID], r.Acl.Action.Name)
 			log.Logger(e.RuntimeCtx).Debug("Publishing Activity", zap.String("targetUser", targetUser.Login), zap.Any("a", ac))
 			// Post to target User Inbox
-			if er := dao.PostActivity(ctx, activity2.OwnerType_USER, targetUser.Login, activity.BoxInbox, ac, true); er != nil {
-				log.Logger(e.RuntimeCtx).Error("Failed publishing activity to user "+targetUser.Login+"'s inbox", zap.Error(er))
+			if post, publish := inboxRouting(ctx, targetUser, ac, r.Acl.NodeID); post {
+				if er := dao.PostActivity(ctx, activity2.OwnerType_USER, targetUser.Login, activity.BoxInbox, ac, publish); er != nil {
+					log.Logger(e.RuntimeCtx).Error("Failed publishing activity to user "+targetUser.Login+"'s inbox", zap.Error(er))
+				}
 			}
 			if workspaces[r.Acl.WorkspaceID].Scope == idm.WorkspaceScope_ROOM {
 				// Post to node Outbox
@@ -0,0 +1,277 @@
+/*
+ * Copyright (c) 2025 Abstrium SAS <team (at) pydio.com>
+ * This file is part of Pydio Cells.
+ *
+ * Pydio Cells is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * Pydio Cells is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with Pydio Cells.  If not, see <http://www.gnu.org/licenses/>.
+ *
+ * The latest code can be found at <https://pydio.com>.
+ */
+
+package render
+
+import (
+	"encoding/xml"
+	"html"
+	"io"
+	"regexp"
+	"time"
+
+	"github.com/pydio/cells/v5/common/errors"
+	"github.com/pydio/cells/v5/common/proto/activity"
+	json "github.com/pydio/cells/v5/common/utils/jsonx"
+)
+
+const (
+	FeedFormatAtom = "atom"
+	FeedFormatRSS  = "rss"
+	FeedFormatJSON = "json"
+)
+
+var mdLink = regexp.MustCompile(`\[([^\]]*)\]\(([^)\s]*)\)`)
+
+// Feed is a format-agnostic syndication feed
+type Feed struct {
+	ID      string
+	Title   string
+	Link    string
+	FeedURL string
+	Updated time.Time
+	Items   []*FeedItem
+}
+
+// FeedItem is a single entry of a Feed
+type FeedItem struct {
+	ID          string
+	Title       string
+	Link        string
+	Author      string
+	ContentHTML string
+	Updated     time.Time
+}
+
+// NewFeedItem renders an activity as a feed entry. Link is left empty and must be set by caller.
+func NewFeedItem(ac *activity.Object, language string, links ...*ServerLinks) *FeedItem {
+	md := Markdown(ac, activity.SummaryPointOfView_GENERIC, language, links...)
+	item := &FeedItem{
+		ID:          ac.Id,
+		Title:       MarkdownToText(md),
+		ContentHTML: MarkdownToHTML(md),
+	}
+	if ac.Actor != nil {
+		item.Author = ac.Actor.Name
+	}
+	if ac.Updated != nil {
+		item.Updated = ac.Updated.AsTime()
+	}
+	return item
+}
+
+// MarkdownToText replaces markdown links by their label and unescapes HTML entities
+func MarkdownToText(md string) string {
+	return html.UnescapeString(mdLink.ReplaceAllString(md, "$1"))
+}
+
+// MarkdownToHTML transforms markdown links into HTML anchors. Labels are already escaped by Markdown.
+func MarkdownToHTML(md string) string {
+	return mdLink.ReplaceAllStringFunc(md, func(s string) string {
+		parts := mdLink.FindStringSubmatch(s)
+		return `<a href="` + html.EscapeString(parts[2]) + `">` + parts[1] + `</a>`
+	})
+}
+
+// FeedContentType returns the MIME type for a given feed format
+func FeedContentType(format string) (string, bool) {
+	switch format {
+	case FeedFormatAtom:
+		return "application/atom+xml; charset=utf-8", true
+	case FeedFormatRSS:
+		return "application/rss+xml; charset=utf-8", true
+	case FeedFormatJSON:
+		return "application/feed+json; charset=utf-8", true
+	}
+	return "", false
+}
+
+// WriteFeed serializes a Feed in Atom, RSS 2.0 or JSON Feed 1.1 format
+func WriteFeed(w io.Writer, feed *Feed, format string) error {
+	switch format {
+	case FeedFormatAtom:
+		return writeXML(w, feed.atom())
+	case FeedFormatRSS:
+		return writeXML(w, feed.rss())
+	case FeedFormatJSON:
+		return json.NewEncoder(w).Encode(feed.jsonFeed())
+	}
+	return errors.WithMessagef(errors.InvalidParameters, "unsupported feed format %s", format)
+}
+
+func writeXML(w io.Writer, v interface{}) error {
+	if _, e := io.WriteString(w, xml.Header); e != nil {
+		return e
+	}
+	enc := xml.NewEncoder(w)
+	enc.Indent("", "  ")
+	return enc.Encode(v)
+}
+
+type atomLink struct {
+	Href string `xml:"href,attr"`
+	Rel  string `xml:"rel,attr,omitempty"`
+}
+
+type atomPerson struct {
+	Name string `xml:"name"`
+}
+
+type atomText struct {
+	Type string `xml:"type,attr,omitempty"`
+	Body string `xml:",chardata"`
+}
+
+type atomEntry struct {
+	ID      string      `xml:"id"`
+	Title   string      `xml:"title"`
+	Updated string      `xml:"updated"`
+	Link    *atomLink   `xml:"link,omitempty"`
+	Author  *atomPerson `xml:"author,omitempty"`
+	Content *atomText   `xml:"content,omitempty"`
+}
+
+type atomFeed struct {
+	XMLName xml.Name     `xml:"http://www.w3.org/2005/Atom feed"`
+	ID      string       `xml:"id"`
+	Title   string       `xml:"title"`
+	Updated string       `xml:"updated"`
+	Links   []*atomLink  `xml:"link"`
+	Entries []*atomEntry `xml:"entry"`
+}
+
+func (f *Feed) atom() *atomFeed {
+	af := &atomFeed{
+		ID:      f.ID,
+		Title:   f.Title,
+		Updated: f.Updated.UTC().Format(time.RFC3339),
+		Links:   []*atomLink{{Href: f.Link, Rel: "alternate"}},
+	}
+	if f.FeedURL != "" {
+		af.Links = append(af.Links, &atomLink{Href: f.FeedURL, Rel: "self"})
+	}
+	for _, it := range f.Items {
+		e := &atomEntry{
+			ID:      f.ID + "/" + it.ID,
+			Title:   it.Title,
+			Updated: it.Updated.UTC().Format(time.RFC3339),
+			Content: &atomText{Type: "html", Body: it.ContentHTML},
+		}
+		if it.Link != "" {
+			e.Link = &atomLink{Href: it.Link, Rel: "alternate"}
+		}
+		if it.Author != "" {
+			e.Author = &atomPerson{Name: it.Author}
+		}
+		af.Entries = append(af.Entries, e)
+	}
+	return af
+}
+
+type rssGUID struct {
+	IsPermaLink string `xml:"isPermaLink,attr"`
+	Value       string `xml:",chardata"`
+}
+
+type rssItem struct {
+	Title       string   `xml:"title"`
+	Link        string   `xml:"link,omitempty"`
+	Description string   `xml:"description"`
+	GUID        *rssGUID `xml:"guid"`
+	PubDate     string   `xml:"pubDate"`
+}
+
+type rssChannel struct {
+	Title         string     `xml:"title"`
+	Link          string     `xml:"link"`
+	Description   string     `xml:"description"`
+	LastBuildDate string     `xml:"lastBuildDate"`
+	Items         []*rssItem `xml:"item"`
+}
+
+type rssFeed struct {
+	XMLName xml.Name    `xml:"rss"`
+	Version string      `xml:"version,attr"`
+	Channel *rssChannel `xml:"channel"`
+}
+
+func (f *Feed) rss() *rssFeed {
+	ch := &rssChannel{
+		Title:         f.Title,
+		Link:          f.Link,
+		Description:   f.Title,
+		LastBuildDate: f.Updated.UTC().Format(time.RFC1123Z),
+	}
+	for _, it := range f.Items {
+		ch.Items = append(ch.Items, &rssItem{
+			Title:       it.Title,
+			Link:        it.Link,
+			Description: it.ContentHTML,
+			GUID:        &rssGUID{IsPermaLink: "false", Value: f.ID + "/" + it.ID},
+			PubDate:     it.Updated.UTC().Format(time.RFC1123Z),
+		})
+	}
+	return &rssFeed{Version: "2.0", Channel: ch}
+}
+
+type jsonFeedAuthor struct {
+	Name string `json:"name"`
+}
+
+type jsonFeedItem struct {
+	ID            string            `json:"id"`
+	URL           string            `json:"url,omitempty"`
+	Title         string            `json:"title"`
+	ContentHTML   string            `json:"content_html"`
+	DatePublished string            `json:"date_published"`
+	Authors       []*jsonFeedAuthor `json:"authors,omitempty"`
+}
+
+type jsonFeed struct {
+	Version     string          `json:"version"`
+	Title       string          `json:"title"`
+	HomePageURL string          `json:"home_page_url"`
+	FeedURL     string          `json:"feed_url,omitempty"`
+	Items       []*jsonFeedItem `json:"items"`
+}
+
+func (f *Feed) jsonFeed() *jsonFeed {
+	jf := &jsonFeed{
+		Version:     "https://jsonfeed.org/version/1.1",
+		Title:       f.Title,
+		HomePageURL: f.Link,
+		FeedURL:     f.FeedURL,
+		Items:       []*jsonFeedItem{},
+	}
+	for _, it := range f.Items {
+		ji := &jsonFeedItem{
+			ID:            f.ID + "/" + it.ID,
+			URL:           it.Link,
+			Title:         it.Title,
+			ContentHTML:   it.ContentHTML,
+			DatePublished: it.Updated.UTC().Format(time.RFC3339),
+		}
+		if it.Author != "" {
+			ji.Authors = []*jsonFeedAuthor{{Name: it.Author}}
+		}
+		jf.Items = append(jf.Items, ji)
+	}
+	return jf
+}
@@ -0,0 +1,107 @@
+/*
+ * Copyright (c) 2025 Abstrium SAS <team (at) pydio.com>
+ * This file is part of Pydio Cells.
+ *
+ * Pydio Cells is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * Pydio Cells is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with Pydio Cells.  If not, see <http://www.gnu.org/licenses/>.
+ *
+ * The latest code can be found at <https://pydio.com>.
+ */
+
+package render
+
+import (
+	"bytes"
+	"encoding/xml"
+	"testing"
+	"time"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/pydio/cells/v5/common/proto/activity"
+	json "github.com/pydio/cells/v5/common/utils/jsonx"
+
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestFeed(t *testing.T) {
+
+	Convey("Test markdown conversions", t, func() {
+		md := "[a &amp; b](https://example.com/ws-a?x=1&y=2) was modified"
+		So(MarkdownToText(md), ShouldEqual, "a & b was modified")
+		So(MarkdownToHTML(md), ShouldEqual, `<a href="https://example.com/ws-a?x=1&amp;y=2">a &amp; b</a> was modified`)
+	})
+
+	Convey("Test feed item from activity", t, func() {
+		ac := &activity.Object{
+			Id:      "0001",
+			Type:    activity.ObjectType_Update,
+			Actor:   &activity.Object{Type: activity.ObjectType_Person, Id: "john", Name: "john"},
+			Object:  &activity.Object{Type: activity.ObjectType_Document, Id: "node-1", Name: "ws/report.pdf"},
+			Updated: timestamppb.New(time.Unix(1700000000, 0)),
+		}
+		item := NewFeedItem(ac, "en-us")
+		So(item.ID, ShouldEqual, "0001")
+		So(item.Author, ShouldEqual, "john")
+		So(item.Title, ShouldContainSubstring, "report.pdf")
+		So(item.Updated.Unix(), ShouldEqual, 1700000000)
+	})
+
+	Convey("Test feed serialization", t, func() {
+		feed := &Feed{
+			ID:      "https://cells.example.com/activity/inbox/john",
+			Title:   "Activity <john>",
+			Link:    "https://cells.example.com",
+			FeedURL: "https://cells.example.com/a/activity/feed/atom",
+			Updated: time.Unix(1700000000, 0),
+			Items: []*FeedItem{{
+				ID:          "0001",
+				Title:       "report.pdf was modified",
+				Link:        "https://cells.example.com/ws-ws/report.pdf",
+				Author:      "john",
+				ContentHTML: `<a href="https://cells.example.com/ws-ws/report.pdf">report.pdf</a> was modified`,
+				Updated:     time.Unix(1700000000, 0),
+			}},
+		}
+
+		buf := &bytes.Buffer{}
+		So(WriteFeed(buf, feed, FeedFormatAtom), ShouldBeNil)
+		var af atomFeed
+		So(xml.Unmarshal(buf.Bytes(), &af), ShouldBeNil)
+		So(af.Title, ShouldEqual, "Activity <john>")
+		So(af.Entries, ShouldHaveLength, 1)
+		So(af.Entries[0].Content.Body, ShouldEqual, feed.Items[0].ContentHTML)
+		So(af.Entries[0].Updated, ShouldEqual, "2023-11-14T22:13:20Z")
+
+		buf.Reset()
+		So(WriteFeed(buf, feed, FeedFormatRSS), ShouldBeNil)
+		var rf rssFeed
+		So(xml.Unmarshal(buf.Bytes(), &rf), ShouldBeNil)
+		So(rf.Version, ShouldEqual, "2.0")
+		So(rf.Channel.Items, ShouldHaveLength, 1)
+		So(rf.Channel.Items[0].Link, ShouldEqual, feed.Items[0].Link)
+		So(rf.Channel.Items[0].GUID.Value, ShouldEqual, feed.ID+"/0001")
+
+		buf.Reset()
+		So(WriteFeed(buf, feed, FeedFormatJSON), ShouldBeNil)
+		var jf map[string]interface{}
+		So(json.Unmarshal(buf.Bytes(), &jf), ShouldBeNil)
+		So(jf["version"], ShouldEqual, "https://jsonfeed.org/version/1.1")
+		So(jf["items"], ShouldHaveLength, 1)
+
+		So(WriteFeed(buf, feed, "csv"), ShouldNotBeNil)
+		_, ok := FeedContentType("csv")
+		So(ok, ShouldBeFalse)
+	})
+
+}
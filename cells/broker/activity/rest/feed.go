@@ -0,0 +1,217 @@
+/*
+ * Copyright (c) 2025 Abstrium SAS <team (at) pydio.com>
+ * This file is part of Pydio Cells.
+ *
+ * Pydio Cells is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * Pydio Cells is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with Pydio Cells.  If not, see <http://www.gnu.org/licenses/>.
+ *
+ * The latest code can be found at <https://pydio.com>.
+ */
+
+package rest
+
+import (
+	"net/http"
+	"net/url"
+	"path"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	restful "github.com/emicklei/go-restful/v3"
+
+	"github.com/pydio/cells/v5/broker/activity/render"
+	"github.com/pydio/cells/v5/common"
+	"github.com/pydio/cells/v5/common/auth/claim"
+	"github.com/pydio/cells/v5/common/client/commons"
+	"github.com/pydio/cells/v5/common/client/grpc"
+	"github.com/pydio/cells/v5/common/config"
+	"github.com/pydio/cells/v5/common/errors"
+	"github.com/pydio/cells/v5/common/middleware"
+	"github.com/pydio/cells/v5/common/nodes/compose"
+	"github.com/pydio/cells/v5/common/permissions"
+	"github.com/pydio/cells/v5/common/proto/activity"
+	"github.com/pydio/cells/v5/common/proto/auth"
+	"github.com/pydio/cells/v5/common/proto/idm"
+	"github.com/pydio/cells/v5/common/proto/rest"
+	"github.com/pydio/cells/v5/common/proto/tree"
+	"github.com/pydio/cells/v5/common/utils/net"
+)
+
+const (
+	defaultFeedLimit = 50
+	maxFeedLimit     = 200
+
+	defaultFeedTokenExpiration = "8760h"
+)
+
+// Feed exports the user inbox, or the activities of a folder or workspace, as an Atom, RSS or JSON feed.
+func (a *ActivityHandler) Feed(req *restful.Request, rsp *restful.Response) error {
+
+	ctx := req.Request.Context()
+	format := req.PathParameter("Format")
+	contentType, ok := render.FeedContentType(format)
+	if !ok {
+		return errors.WithMessagef(errors.InvalidParameters, "unsupported feed format %s, use one of atom, rss or json", format)
+	}
+	claims, ok := claim.FromContext(ctx)
+	if !ok || claims.Name == "" {
+		return errors.WithStack(errors.StatusUnauthorized)
+	}
+	limit := defaultFeedLimit
+	if l, e := strconv.Atoi(req.QueryParameter("Limit")); e == nil && l > 0 {
+		limit = min(l, maxFeedLimit)
+	}
+	language := req.QueryParameter("Language")
+	if language == "" {
+		language = middleware.DetectedLanguages(ctx)[0]
+	}
+	accessList, err := permissions.AccessListFromContextClaims(ctx)
+	if err != nil {
+		return err
+	}
+
+	base := strings.TrimRight(net.ExternalDomainFromRequest(req.Request).String(), "/")
+	feed := &render.Feed{
+		Link:    base,
+		FeedURL: base + req.Request.URL.Path,
+	}
+	var streams []*activity.StreamActivitiesRequest
+	if nodeId := req.QueryParameter("NodeId"); nodeId != "" {
+		resp, er := compose.UuidClient().ReadNode(ctx, &tree.ReadNodeRequest{Node: &tree.Node{Uuid: nodeId}})
+		if er != nil {
+			return er
+		}
+		feed.ID = base + "/activity/node/" + nodeId
+		feed.Title = "Activity - " + path.Base(resp.GetNode().GetPath())
+		feed.FeedURL += "?NodeId=" + url.QueryEscape(nodeId)
+		streams = append(streams, &activity.StreamActivitiesRequest{Context: activity.StreamContext_NODE_ID, ContextData: nodeId, BoxName: "outbox"})
+	} else if slug := req.QueryParameter("WorkspaceSlug"); slug != "" {
+		var ws *idm.Workspace
+		for _, w := range accessList.GetWorkspaces() {
+			if w.GetSlug() == slug {
+				ws = w
+				break
+			}
+		}
+		if ws == nil {
+			return errors.WithMessagef(errors.WorkspaceNotFound, "cannot find workspace %s", slug)
+		}
+		feed.ID = base + "/activity/workspace/" + ws.GetUUID()
+		feed.Title = "Activity - " + ws.GetLabel()
+		feed.FeedURL += "?WorkspaceSlug=" + url.QueryEscape(slug)
+		for rootId := range accessList.GetWorkspacesRoots()[ws.GetUUID()] {
+			streams = append(streams, &activity.StreamActivitiesRequest{Context: activity.StreamContext_NODE_ID, ContextData: rootId, BoxName: "outbox"})
+		}
+	} else {
+		feed.ID = base + "/activity/user/" + claims.Name
+		feed.Title = "Activity - " + claims.Name
+		if claims.DisplayName != "" {
+			feed.Title = "Activity - " + claims.DisplayName
+		}
+		streams = append(streams, &activity.StreamActivitiesRequest{Context: activity.StreamContext_USER_ID, ContextData: claims.Name, BoxName: "inbox"})
+	}
+
+	var collection []*activity.Object
+	if len(accessList.GetWorkspaces()) > 0 {
+		client := a.getClient()
+		seen := make(map[string]bool)
+		for _, sr := range streams {
+			sr.Limit = int64(limit)
+			sr.Language = language
+			streamer, er := client.StreamActivities(ctx, sr)
+			if e := commons.ForEach(streamer, er, func(resp *activity.StreamActivitiesResponse) error {
+				if ac := resp.GetActivity(); !seen[ac.GetId()] && a.filterActivity(ctx, accessList, ac) {
+					seen[ac.GetId()] = true
+					collection = append(collection, ac)
+				}
+				return nil
+			}); e != nil {
+				return e
+			}
+		}
+	}
+	// Merge streams from multiple roots, most recent first
+	sort.SliceStable(collection, func(i, j int) bool {
+		return collection[i].GetUpdated().AsTime().After(collection[j].GetUpdated().AsTime())
+	})
+	if len(collection) > limit {
+		collection = collection[:limit]
+	}
+
+	links := render.NewServerLinks()
+	links.UrlFuncs[render.ServerUrlTypeDocs] = func(object *activity.Object, label string) string {
+		return render.MakeMarkdownLink(base+"/ws-"+strings.TrimLeft(object.Name, "/"), label)
+	}
+	links.UrlFuncs[render.ServerUrlTypeWorkspaces] = func(object *activity.Object, label string) string {
+		if object.Href != "" {
+			return render.MakeMarkdownLink(base+"/ws-"+strings.TrimLeft(object.Href, "/"), label)
+		}
+		return label
+	}
+	feed.Updated = time.Now()
+	for i, ac := range collection {
+		item := render.NewFeedItem(ac, language, links)
+		item.Link = base
+		if obj := ac.GetObject(); obj != nil && (obj.Type == activity.ObjectType_Document || obj.Type == activity.ObjectType_Folder) && ac.Type != activity.ObjectType_Delete {
+			item.Link = base + "/ws-" + strings.TrimLeft(obj.Name, "/")
+		}
+		if i == 0 {
+			feed.Updated = item.Updated
+		}
+		feed.Items = append(feed.Items, item)
+	}
+
+	rsp.Header().Set("Content-Type", contentType)
+	rsp.WriteHeader(http.StatusOK)
+	return render.WriteFeed(rsp, feed, format)
+}
+
+// FeedToken generates a personal access token restricted to the feed:read scope. It is only accepted
+// by the feed endpoints, so that the URL pasted in feed readers never carries a full-scope token.
+func (a *ActivityHandler) FeedToken(req *restful.Request, rsp *restful.Response) error {
+
+	var input rest.ActivityFeedTokenRequest
+	if e := req.ReadEntity(&input); e != nil {
+		return e
+	}
+	ctx := req.Request.Context()
+	claims, ok := claim.FromContext(ctx)
+	if !ok || claims.Name == "" {
+		return errors.WithStack(errors.StatusUnauthorized)
+	}
+	validity, er := time.ParseDuration(config.Get(ctx, "defaults", "personalTokens", "feedTokensExpiration").Default(defaultFeedTokenExpiration).String())
+	if er != nil || validity <= 0 {
+		validity, _ = time.ParseDuration(defaultFeedTokenExpiration)
+	}
+	label := input.GetLabel()
+	if label == "" {
+		label = "Activity feed"
+	}
+	expiresAt := time.Now().Add(validity).Unix()
+	cli := auth.NewPersonalAccessTokenServiceClient(grpc.ResolveConn(ctx, common.ServiceTokenGRPC))
+	genResp, er := cli.Generate(ctx, &auth.PatGenerateRequest{
+		Type:      auth.PatType_PERSONAL,
+		UserUuid:  claims.Subject,
+		UserLogin: claims.Name,
+		Label:     label,
+		ExpiresAt: expiresAt,
+		Issuer:    req.Request.URL.String(),
+		Scopes:    []string{claim.ScopeFeedRead},
+	})
+	if er != nil {
+		return er
+	}
+	return rsp.WriteEntity(&rest.ActivityFeedTokenResponse{AccessToken: genResp.GetAccessToken(), ExpiresAt: expiresAt})
+}
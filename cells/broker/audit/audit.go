@@ -0,0 +1,51 @@
+/*
+ * Copyright (c) 2025 Abstrium SAS <team (at) pydio.com>
+ * This file is part of Pydio Cells.
+ *
+ * Pydio Cells is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * Pydio Cells is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with Pydio Cells.  If not, see <http://www.gnu.org/licenses/>.
+ *
+ * The latest code can be found at <https://pydio.com>.
+ */
+
+// Package audit provides a tamper-evident, append-only store for audit logs.
+//
+// Each record received from the audit logger is chained to the previous one by a SHA-256 hash, so that any
+// modification or deletion of a stored record can be detected by re-computing the chain. Records can also
+// be forwarded to external sinks (syslog, CEF, JSON lines files), see the export package.
+package audit
+
+import (
+	"context"
+
+	"github.com/pydio/cells/v5/common/errors"
+	"github.com/pydio/cells/v5/common/proto/log"
+	"github.com/pydio/cells/v5/common/service"
+)
+
+var (
+	Drivers = service.StorageDrivers{}
+
+	// ErrSequenceConflict is returned by DAO.Insert when a record with the same sequence number already exists.
+	ErrSequenceConflict = errors.RegisterBaseSentinel(errors.StatusConflict, "audit record sequence already exists")
+)
+
+// DAO stores audit records. There is intentionally no method to update or delete records.
+type DAO interface {
+	// Insert appends a record to the store. It must fail with ErrSequenceConflict if the sequence is already used.
+	Insert(ctx context.Context, record *log.AuditRecord) error
+	// Last returns the record with the highest sequence number, or nil if the store is empty.
+	Last(ctx context.Context) (*log.AuditRecord, error)
+	// List returns records ordered by sequence, starting at fromSeq (inclusive). A limit of 0 means no limit.
+	List(ctx context.Context, fromSeq int64, limit int) ([]*log.AuditRecord, error)
+}
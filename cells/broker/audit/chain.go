@@ -0,0 +1,117 @@
+/*
+ * Copyright (c) 2025 Abstrium SAS <team (at) pydio.com>
+ * This file is part of Pydio Cells.
+ *
+ * Pydio Cells is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * Pydio Cells is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with Pydio Cells.  If not, see <http://www.gnu.org/licenses/>.
+ *
+ * The latest code can be found at <https://pydio.com>.
+ */
+
+package audit
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"strconv"
+
+	"github.com/pydio/cells/v5/common/proto/log"
+)
+
+// Hash computes the hex-encoded SHA-256 of a record content, linked to the previous record hash.
+func Hash(seq, ts int64, prevHash string, message []byte) string {
+	h := sha256.New()
+	h.Write([]byte(strconv.FormatInt(seq, 10) + "\n" + strconv.FormatInt(ts, 10) + "\n" + prevHash + "\n"))
+	h.Write(message)
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+// NormalizeMessage returns the compact form of a JSON message, or the message encoded as a JSON string if it is
+// not valid JSON. Chaining normalized messages guarantees that records exported as JSON lines can be verified.
+func NormalizeMessage(raw []byte) []byte {
+	buf := &bytes.Buffer{}
+	if er := json.Compact(buf, raw); er == nil {
+		return buf.Bytes()
+	}
+	str, _ := json.Marshal(string(raw))
+	return str
+}
+
+// Next builds the record following prev in the chain. A nil prev starts a new chain.
+func Next(prev *log.AuditRecord, ts int64, message []byte) *log.AuditRecord {
+	rec := &log.AuditRecord{
+		Seq:     prev.GetSeq() + 1,
+		Ts:      ts,
+		Message: message,
+	}
+	if prev != nil {
+		rec.PrevHash = prev.GetHash()
+	}
+	rec.Hash = Hash(rec.Seq, rec.Ts, rec.PrevHash, rec.Message)
+	return rec
+}
+
+// Verifier checks records one after the other. Records must be passed in sequence order.
+type Verifier struct {
+	// Checked is the number of records successfully verified.
+	Checked int64
+	// Last is the last record successfully verified.
+	Last *log.AuditRecord
+
+	anchorSeq     int64
+	anchorHash    string
+	anchorChecked bool
+}
+
+// NewVerifier creates a Verifier. If anchorHash is set, the record at anchorSeq must have this hash: this is used
+// to verify a chain against a hash previously stored outside of Cells.
+func NewVerifier(anchorSeq int64, anchorHash string) *Verifier {
+	return &Verifier{anchorSeq: anchorSeq, anchorHash: anchorHash}
+}
+
+// Check verifies that the record hash is valid and that it is correctly linked to the previously checked record.
+// When the first checked record is not the first of the chain, its link to the previous record is trusted.
+func (v *Verifier) Check(rec *log.AuditRecord) error {
+	if v.Last == nil {
+		if rec.GetSeq() == 1 && rec.GetPrevHash() != "" {
+			return fmt.Errorf("record #1 must not reference a previous hash")
+		}
+	} else {
+		if rec.GetSeq() != v.Last.GetSeq()+1 {
+			return fmt.Errorf("record #%d is missing (found #%d after #%d)", v.Last.GetSeq()+1, rec.GetSeq(), v.Last.GetSeq())
+		}
+		if rec.GetPrevHash() != v.Last.GetHash() {
+			return fmt.Errorf("record #%d is not linked to record #%d", rec.GetSeq(), v.Last.GetSeq())
+		}
+	}
+	if Hash(rec.GetSeq(), rec.GetTs(), rec.GetPrevHash(), rec.GetMessage()) != rec.GetHash() {
+		return fmt.Errorf("record #%d content does not match its hash", rec.GetSeq())
+	}
+	if v.anchorHash != "" && rec.GetSeq() == v.anchorSeq {
+		if rec.GetHash() != v.anchorHash {
+			return fmt.Errorf("record #%d does not match the anchor hash", rec.GetSeq())
+		}
+		v.anchorChecked = true
+	}
+	v.Last = rec
+	v.Checked++
+	return nil
+}
+
+// AnchorReached tells if the anchor passed to NewVerifier, if any, was found and checked.
+func (v *Verifier) AnchorReached() bool {
+	return v.anchorHash == "" || v.anchorChecked
+}
@@ -0,0 +1,108 @@
+/*
+ * Copyright (c) 2025 Abstrium SAS <team (at) pydio.com>
+ * This file is part of Pydio Cells.
+ *
+ * Pydio Cells is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * Pydio Cells is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with Pydio Cells.  If not, see <http://www.gnu.org/licenses/>.
+ *
+ * The latest code can be found at <https://pydio.com>.
+ */
+
+package audit
+
+import (
+	"fmt"
+	"testing"
+
+	"google.golang.org/protobuf/proto"
+
+	"github.com/pydio/cells/v5/common/proto/log"
+
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func testChain(n int) []*log.AuditRecord {
+	var rr []*log.AuditRecord
+	var prev *log.AuditRecord
+	for i := 0; i < n; i++ {
+		prev = Next(prev, int64(1700000000+i), []byte(fmt.Sprintf(`{"msg":"event %d"}`, i)))
+		rr = append(rr, prev)
+	}
+	return rr
+}
+
+func verifyAll(v *Verifier, rr []*log.AuditRecord) error {
+	for _, r := range rr {
+		if er := v.Check(r); er != nil {
+			return er
+		}
+	}
+	return nil
+}
+
+func TestChain(t *testing.T) {
+
+	Convey("Build and verify a chain", t, func() {
+		rr := testChain(5)
+		So(rr[0].Seq, ShouldEqual, 1)
+		So(rr[0].PrevHash, ShouldBeEmpty)
+		So(rr[4].Seq, ShouldEqual, 5)
+		So(rr[4].PrevHash, ShouldEqual, rr[3].Hash)
+
+		v := NewVerifier(0, "")
+		So(verifyAll(v, rr), ShouldBeNil)
+		So(v.Checked, ShouldEqual, 5)
+		So(v.Last.Hash, ShouldEqual, rr[4].Hash)
+		So(v.AnchorReached(), ShouldBeTrue)
+	})
+
+	Convey("Detect tampering", t, func() {
+		rr := testChain(5)
+
+		// Modified content
+		modified := proto.Clone(rr[2]).(*log.AuditRecord)
+		modified.Message = []byte(`{"msg":"something else"}`)
+		So(verifyAll(NewVerifier(0, ""), []*log.AuditRecord{rr[0], rr[1], modified, rr[3]}), ShouldNotBeNil)
+
+		// Modified content and re-computed hash breaks the link
+		modified.Hash = Hash(modified.Seq, modified.Ts, modified.PrevHash, modified.Message)
+		So(verifyAll(NewVerifier(0, ""), []*log.AuditRecord{rr[0], rr[1], modified, rr[3]}), ShouldNotBeNil)
+
+		// Deleted record
+		So(verifyAll(NewVerifier(0, ""), []*log.AuditRecord{rr[0], rr[1], rr[3]}), ShouldNotBeNil)
+
+		// Truncated head is only detected with an anchor
+		So(verifyAll(NewVerifier(0, ""), rr[2:]), ShouldBeNil)
+		v := NewVerifier(2, rr[1].Hash)
+		So(verifyAll(v, rr[2:]), ShouldBeNil)
+		So(v.AnchorReached(), ShouldBeFalse)
+	})
+
+	Convey("Verify against an anchor", t, func() {
+		rr := testChain(5)
+		v := NewVerifier(3, rr[2].Hash)
+		So(verifyAll(v, rr), ShouldBeNil)
+		So(v.AnchorReached(), ShouldBeTrue)
+
+		So(verifyAll(NewVerifier(3, rr[1].Hash), rr), ShouldNotBeNil)
+
+		// Whole chain re-computed from scratch does not match the anchor
+		var forged []*log.AuditRecord
+		var prev *log.AuditRecord
+		for i := 1; i <= 3; i++ {
+			prev = Next(prev, int64(i), []byte("{}"))
+			forged = append(forged, prev)
+		}
+		So(verifyAll(NewVerifier(3, rr[2].Hash), forged), ShouldNotBeNil)
+	})
+}
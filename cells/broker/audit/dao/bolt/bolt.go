@@ -0,0 +1,118 @@
+/*
+ * Copyright (c) 2025 Abstrium SAS <team (at) pydio.com>
+ * This file is part of Pydio Cells.
+ *
+ * Pydio Cells is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * Pydio Cells is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with Pydio Cells.  If not, see <http://www.gnu.org/licenses/>.
+ *
+ * The latest code can be found at <https://pydio.com>.
+ */
+
+// Package bolt provides a BoltDB implementation of the audit DAO.
+package bolt
+
+import (
+	"context"
+	"encoding/binary"
+
+	"go.etcd.io/bbolt"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/pydio/cells/v5/broker/audit"
+	"github.com/pydio/cells/v5/common/errors"
+	"github.com/pydio/cells/v5/common/proto/log"
+	"github.com/pydio/cells/v5/common/storage/boltdb"
+)
+
+var (
+	bucketName = []byte("AuditTrail")
+)
+
+func init() {
+	audit.Drivers.Register(NewBoltDAO)
+}
+
+func NewBoltDAO(db boltdb.DB) audit.DAO {
+	b := &boltTrail{DB: db}
+	_ = b.Init(nil)
+	return b
+}
+
+// boltTrail stores records in a single bucket, keyed by their big-endian sequence number.
+type boltTrail struct {
+	boltdb.DB
+}
+
+func (b *boltTrail) Init(ctx context.Context) error {
+	return b.Update(func(tx *bbolt.Tx) error {
+		_, e := tx.CreateBucketIfNotExists(bucketName)
+		return e
+	})
+}
+
+// Insert appends a record, failing if its sequence number is already stored.
+func (b *boltTrail) Insert(ctx context.Context, record *log.AuditRecord) error {
+	data, er := proto.Marshal(record)
+	if er != nil {
+		return er
+	}
+	return b.Update(func(tx *bbolt.Tx) error {
+		bucket := tx.Bucket(bucketName)
+		k := seqKey(record.GetSeq())
+		if bucket.Get(k) != nil {
+			return errors.WithStack(audit.ErrSequenceConflict)
+		}
+		return bucket.Put(k, data)
+	})
+}
+
+// Last returns the record with the highest sequence.
+func (b *boltTrail) Last(ctx context.Context) (last *log.AuditRecord, err error) {
+	err = b.View(func(tx *bbolt.Tx) error {
+		_, v := tx.Bucket(bucketName).Cursor().Last()
+		if v == nil {
+			return nil
+		}
+		last = &log.AuditRecord{}
+		return proto.Unmarshal(v, last)
+	})
+	return
+}
+
+// List returns records starting at fromSeq.
+func (b *boltTrail) List(ctx context.Context, fromSeq int64, limit int) (records []*log.AuditRecord, err error) {
+	err = b.View(func(tx *bbolt.Tx) error {
+		c := tx.Bucket(bucketName).Cursor()
+		for k, v := c.Seek(seqKey(fromSeq)); k != nil; k, v = c.Next() {
+			rec := &log.AuditRecord{}
+			if er := proto.Unmarshal(v, rec); er != nil {
+				return er
+			}
+			records = append(records, rec)
+			if limit > 0 && len(records) >= limit {
+				break
+			}
+		}
+		return nil
+	})
+	return
+}
+
+func seqKey(seq int64) []byte {
+	if seq < 0 {
+		seq = 0
+	}
+	k := make([]byte, 8)
+	binary.BigEndian.PutUint64(k, uint64(seq))
+	return k
+}
@@ -0,0 +1,93 @@
+//go:build storage || kv
+
+/*
+ * Copyright (c) 2025 Abstrium SAS <team (at) pydio.com>
+ * This file is part of Pydio Cells.
+ *
+ * Pydio Cells is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * Pydio Cells is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with Pydio Cells.  If not, see <http://www.gnu.org/licenses/>.
+ *
+ * The latest code can be found at <https://pydio.com>.
+ */
+
+package dao
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/pydio/cells/v5/broker/audit"
+	"github.com/pydio/cells/v5/broker/audit/dao/bolt"
+	"github.com/pydio/cells/v5/broker/audit/dao/mongo"
+	"github.com/pydio/cells/v5/common/errors"
+	"github.com/pydio/cells/v5/common/proto/log"
+	"github.com/pydio/cells/v5/common/runtime/manager"
+	"github.com/pydio/cells/v5/common/storage/test"
+	"github.com/pydio/cells/v5/common/utils/uuid"
+
+	_ "github.com/pydio/cells/v5/common/storage/boltdb"
+	_ "github.com/pydio/cells/v5/common/storage/mongodb"
+
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+var (
+	testcases = []test.StorageTestCase{
+		test.TemplateBoltWithPrefix(bolt.NewBoltDAO, "audit_bolt_"),
+		test.TemplateMongoEnvWithPrefix(mongo.NewMongoDAO, "broker_"+uuid.New()[:6]+"_"),
+	}
+)
+
+func TestDAO(t *testing.T) {
+
+	test.RunStorageTests(testcases, t, func(ctx context.Context) {
+		Convey("Append and list records", t, func() {
+			dao, err := manager.Resolve[audit.DAO](ctx)
+			So(err, ShouldBeNil)
+
+			last, er := dao.Last(ctx)
+			So(er, ShouldBeNil)
+			So(last, ShouldBeNil)
+
+			for i := 0; i < 10; i++ {
+				last = audit.Next(last, int64(1700000000+i), []byte(fmt.Sprintf(`{"msg":"event %d"}`, i)))
+				So(dao.Insert(ctx, last), ShouldBeNil)
+			}
+
+			// Same sequence cannot be inserted twice
+			er = dao.Insert(ctx, audit.Next(&log.AuditRecord{Seq: 4}, 1700000100, []byte("{}")))
+			So(errors.Is(er, audit.ErrSequenceConflict), ShouldBeTrue)
+
+			stored, er := dao.Last(ctx)
+			So(er, ShouldBeNil)
+			So(stored.GetSeq(), ShouldEqual, 10)
+			So(stored.GetHash(), ShouldEqual, last.GetHash())
+
+			all, er := dao.List(ctx, 0, 0)
+			So(er, ShouldBeNil)
+			So(all, ShouldHaveLength, 10)
+			v := audit.NewVerifier(0, "")
+			for _, r := range all {
+				So(v.Check(r), ShouldBeNil)
+			}
+
+			page, er := dao.List(ctx, 4, 3)
+			So(er, ShouldBeNil)
+			So(page, ShouldHaveLength, 3)
+			So(page[0].GetSeq(), ShouldEqual, 4)
+			So(page[2].GetSeq(), ShouldEqual, 6)
+			So(string(page[0].GetMessage()), ShouldEqual, `{"msg":"event 3"}`)
+		})
+	})
+}
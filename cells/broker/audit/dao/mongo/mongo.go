@@ -0,0 +1,142 @@
+/*
+ * Copyright (c) 2025 Abstrium SAS <team (at) pydio.com>
+ * This file is part of Pydio Cells.
+ *
+ * Pydio Cells is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * Pydio Cells is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with Pydio Cells.  If not, see <http://www.gnu.org/licenses/>.
+ *
+ * The latest code can be found at <https://pydio.com>.
+ */
+
+// Package mongo provides a MongoDB implementation of the audit DAO.
+package mongo
+
+import (
+	"context"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+
+	"github.com/pydio/cells/v5/broker/audit"
+	"github.com/pydio/cells/v5/common/errors"
+	"github.com/pydio/cells/v5/common/proto/log"
+	"github.com/pydio/cells/v5/common/storage/mongodb"
+	"github.com/pydio/cells/v5/common/utils/configx"
+)
+
+const (
+	collAuditRecords = "records"
+)
+
+var (
+	model = &mongodb.Model{Collections: []mongodb.Collection{
+		{
+			Name: collAuditRecords,
+		},
+	}}
+)
+
+type storedRecord struct {
+	Seq      int64  `bson:"seq"`
+	Ts       int64  `bson:"ts"`
+	Message  string `bson:"message"`
+	PrevHash string `bson:"prev_hash"`
+	Hash     string `bson:"hash"`
+}
+
+func (s *storedRecord) toProto() *log.AuditRecord {
+	return &log.AuditRecord{
+		Seq:      s.Seq,
+		Ts:       s.Ts,
+		Message:  []byte(s.Message),
+		PrevHash: s.PrevHash,
+		Hash:     s.Hash,
+	}
+}
+
+func init() {
+	audit.Drivers.Register(NewMongoDAO)
+}
+
+func NewMongoDAO(db *mongodb.Indexer) audit.DAO {
+	return &mongoTrail{db: db.Database}
+}
+
+type mongoTrail struct {
+	db *mongodb.Database
+}
+
+// Init creates the collection and a unique index on the sequence number.
+func (m *mongoTrail) Init(ctx context.Context, conf configx.Values) error {
+	if er := model.Init(ctx, m.db); er != nil {
+		return er
+	}
+	_, er := m.db.Collection(collAuditRecords).Indexes().CreateOne(ctx, mongo.IndexModel{
+		Keys:    bson.D{{Key: "seq", Value: 1}},
+		Options: options.Index().SetUnique(true),
+	})
+	return er
+}
+
+// Insert appends a record, relying on the unique index to detect sequence conflicts.
+func (m *mongoTrail) Insert(ctx context.Context, record *log.AuditRecord) error {
+	_, er := m.db.Collection(collAuditRecords).InsertOne(ctx, &storedRecord{
+		Seq:      record.GetSeq(),
+		Ts:       record.GetTs(),
+		Message:  string(record.GetMessage()),
+		PrevHash: record.GetPrevHash(),
+		Hash:     record.GetHash(),
+	})
+	if mongo.IsDuplicateKeyError(er) {
+		return errors.WithStack(audit.ErrSequenceConflict)
+	}
+	return er
+}
+
+// Last returns the record with the highest sequence.
+func (m *mongoTrail) Last(ctx context.Context) (*log.AuditRecord, error) {
+	res := m.db.Collection(collAuditRecords).FindOne(ctx, bson.D{}, options.FindOne().SetSort(bson.D{{Key: "seq", Value: -1}}))
+	if er := res.Err(); er != nil {
+		if errors.Is(er, mongo.ErrNoDocuments) {
+			return nil, nil
+		}
+		return nil, er
+	}
+	stored := &storedRecord{}
+	if er := res.Decode(stored); er != nil {
+		return nil, er
+	}
+	return stored.toProto(), nil
+}
+
+// List returns records starting at fromSeq.
+func (m *mongoTrail) List(ctx context.Context, fromSeq int64, limit int) (records []*log.AuditRecord, err error) {
+	opts := options.Find().SetSort(bson.D{{Key: "seq", Value: 1}})
+	if limit > 0 {
+		opts.SetLimit(int64(limit))
+	}
+	cursor, er := m.db.Collection(collAuditRecords).Find(ctx, bson.D{{Key: "seq", Value: bson.M{"$gte": fromSeq}}}, opts)
+	if er != nil {
+		return nil, er
+	}
+	defer cursor.Close(ctx)
+	for cursor.Next(ctx) {
+		stored := &storedRecord{}
+		if de := cursor.Decode(stored); de != nil {
+			return nil, de
+		}
+		records = append(records, stored.toProto())
+	}
+	return records, cursor.Err()
+}
@@ -0,0 +1,187 @@
+/*
+ * Copyright (c) 2025 Abstrium SAS <team (at) pydio.com>
+ * This file is part of Pydio Cells.
+ *
+ * Pydio Cells is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * Pydio Cells is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with Pydio Cells.  If not, see <http://www.gnu.org/licenses/>.
+ *
+ * The latest code can be found at <https://pydio.com>.
+ */
+
+package export
+
+import (
+	"bufio"
+	"net"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/pydio/cells/v5/broker/audit"
+	"github.com/pydio/cells/v5/common/proto/log"
+
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func testRecords() []*log.AuditRecord {
+	var rr []*log.AuditRecord
+	var prev *log.AuditRecord
+	for _, m := range []string{
+		`{"level":"info","ts":"2024-05-02T10:00:00Z","msg":"Retrieved object at a|b","MsgId":"21","UserName":"alice","RemoteAddress":"10.0.0.1","NodePath":"pydiods1/a=b.txt"}` + "\n",
+		`{ "level" : "warn", "msg" : "Login failed", "MsgId":"2" }`,
+		"not a json message",
+	} {
+		prev = audit.Next(prev, 1714644000, audit.NormalizeMessage([]byte(m)))
+		rr = append(rr, prev)
+	}
+	return rr
+}
+
+func TestFileExporter(t *testing.T) {
+
+	Convey("Export to a JSON lines file and verify it", t, func() {
+		target := filepath.Join(t.TempDir(), "audit.jsonl")
+		ex, er := Open("file://" + target + "?maxSize=1&maxBackups=2")
+		So(er, ShouldBeNil)
+		rr := testRecords()
+		for _, r := range rr {
+			So(ex.Export(r), ShouldBeNil)
+		}
+		So(ex.Close(), ShouldBeNil)
+
+		f, er := os.Open(target)
+		So(er, ShouldBeNil)
+		defer f.Close()
+		v := audit.NewVerifier(0, "")
+		So(ReadJSONLines(f, v.Check), ShouldBeNil)
+		So(v.Checked, ShouldEqual, 3)
+		So(v.Last.GetHash(), ShouldEqual, rr[2].GetHash())
+	})
+
+	Convey("Reject unsupported urls", t, func() {
+		_, er := Open("file:///tmp/audit.log?format=xml")
+		So(er, ShouldNotBeNil)
+		_, er = Open("kafka://localhost:9092")
+		So(er, ShouldNotBeNil)
+		_, er = Open("syslog+udp://localhost:514?facility=42")
+		So(er, ShouldNotBeNil)
+	})
+}
+
+func TestEncoders(t *testing.T) {
+
+	Convey("Encode CEF", t, func() {
+		rr := testRecords()
+		cef := EncodeCEF(rr[0])
+		So(cef, ShouldStartWith, "CEF:0|Pydio|Cells|")
+		So(cef, ShouldContainSubstring, "|21|Retrieved object at a\\|b|3|")
+		So(cef, ShouldContainSubstring, "suser=alice")
+		So(cef, ShouldContainSubstring, "src=10.0.0.1")
+		So(cef, ShouldContainSubstring, "fname=pydiods1/a\\=b.txt")
+		So(cef, ShouldContainSubstring, "cs1="+rr[0].GetHash())
+		So(cef, ShouldContainSubstring, "cn1=1")
+
+		cef = EncodeCEF(rr[1])
+		So(cef, ShouldContainSubstring, "|2|Login failed|6|")
+		So(cef, ShouldContainSubstring, "cs2="+rr[0].GetHash())
+
+		cef = EncodeCEF(rr[2])
+		So(cef, ShouldContainSubstring, "|audit||3|")
+	})
+
+	Convey("Encode RFC 5424", t, func() {
+		rr := testRecords()
+		msg := EncodeRFC5424(rr[0], 13, "cells host", "cells", 42, "payload")
+		So(msg, ShouldEqual, "<110>1 2024-05-02T10:00:00Z cellshost cells 42 21 - payload")
+		msg = EncodeRFC5424(rr[2], 13, "", "cells", 42, "payload")
+		So(msg, ShouldEqual, "<110>1 2024-05-02T10:00:00Z - cells 42 - - payload")
+	})
+}
+
+func TestSyslogExporter(t *testing.T) {
+
+	Convey("Send over UDP", t, func() {
+		pc, er := net.ListenPacket("udp", "127.0.0.1:0")
+		if er != nil {
+			SkipSo(er, ShouldBeNil)
+			return
+		}
+		defer pc.Close()
+		ex, er := Open("syslog+udp://" + pc.LocalAddr().String() + "?format=cef&appName=test")
+		So(er, ShouldBeNil)
+		defer ex.Close()
+		So(ex.Export(testRecords()[0]), ShouldBeNil)
+
+		buf := make([]byte, 4096)
+		_ = pc.SetReadDeadline(time.Now().Add(5 * time.Second))
+		n, _, er := pc.ReadFrom(buf)
+		So(er, ShouldBeNil)
+		So(string(buf[:n]), ShouldStartWith, "<110>1 2024-05-02T10:00:00Z ")
+		So(string(buf[:n]), ShouldContainSubstring, " test ")
+		So(string(buf[:n]), ShouldContainSubstring, " 21 - CEF:0|Pydio|Cells|")
+	})
+
+	Convey("Send over TCP with octet counting", t, func() {
+		l, er := net.Listen("tcp", "127.0.0.1:0")
+		if er != nil {
+			SkipSo(er, ShouldBeNil)
+			return
+		}
+		defer l.Close()
+		received := make(chan string, 2)
+		go func() {
+			c, e := l.Accept()
+			if e != nil {
+				return
+			}
+			defer c.Close()
+			r := bufio.NewReader(c)
+			for i := 0; i < 2; i++ {
+				var size int
+				var msg strings.Builder
+				for {
+					b, e := r.ReadByte()
+					if e != nil {
+						return
+					}
+					if b == ' ' {
+						break
+					}
+					size = size*10 + int(b-'0')
+				}
+				for j := 0; j < size; j++ {
+					b, _ := r.ReadByte()
+					msg.WriteByte(b)
+				}
+				received <- msg.String()
+			}
+		}()
+		ex, er := Open("syslog+tcp://" + l.Addr().String())
+		So(er, ShouldBeNil)
+		defer ex.Close()
+		rr := testRecords()
+		So(ex.Export(rr[0]), ShouldBeNil)
+		So(ex.Export(rr[1]), ShouldBeNil)
+
+		for _, r := range rr[:2] {
+			select {
+			case msg := <-received:
+				So(msg, ShouldContainSubstring, `"hash":"`+r.GetHash()+`"`)
+			case <-time.After(5 * time.Second):
+				So("timeout", ShouldBeEmpty)
+			}
+		}
+	})
+}
@@ -0,0 +1,109 @@
+/*
+ * Copyright (c) 2025 Abstrium SAS <team (at) pydio.com>
+ * This file is part of Pydio Cells.
+ *
+ * Pydio Cells is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * Pydio Cells is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with Pydio Cells.  If not, see <http://www.gnu.org/licenses/>.
+ *
+ * The latest code can be found at <https://pydio.com>.
+ */
+
+// Package export forwards audit records to external sinks. Sinks are described by URLs:
+//
+//   - file:///var/log/cells/audit.jsonl?format=json&maxSize=100&maxBackups=30&maxAge=365&compress=true
+//   - syslog+udp://siem.example.com:514?format=cef
+//   - syslog+tcp://siem.example.com:601?appName=cells&facility=13
+//   - syslog+tls://siem.example.com:6514
+//
+// Format is "json" (default) or "cef". Syslog messages are RFC 5424 formatted, TCP and TLS transports use
+// octet-counting framing.
+package export
+
+import (
+	"bufio"
+	"io"
+	"net/url"
+	"strconv"
+
+	"github.com/pydio/cells/v5/common/errors"
+	"github.com/pydio/cells/v5/common/proto/log"
+	json "github.com/pydio/cells/v5/common/utils/jsonx"
+)
+
+// Exporter forwards audit records to an external sink.
+type Exporter interface {
+	Export(rec *log.AuditRecord) error
+	Close() error
+}
+
+// Open creates an Exporter from its URL.
+func Open(rawURL string) (Exporter, error) {
+	u, er := url.Parse(rawURL)
+	if er != nil {
+		return nil, er
+	}
+	format := u.Query().Get("format")
+	switch format {
+	case "":
+		format = FormatJSON
+	case FormatJSON, FormatCEF:
+	default:
+		return nil, errors.WithMessagef(errors.InvalidParameters, "unsupported audit export format %s", format)
+	}
+	switch u.Scheme {
+	case "file":
+		return newFileExporter(u, format)
+	case "syslog+udp", "syslog+tcp", "syslog+tls":
+		return newSyslogExporter(u, format)
+	default:
+		return nil, errors.WithMessagef(errors.InvalidParameters, "unsupported audit export scheme %s", u.Scheme)
+	}
+}
+
+// encode formats a record as a single line in the given format.
+func encode(rec *log.AuditRecord, format string) ([]byte, error) {
+	if format == FormatCEF {
+		return []byte(EncodeCEF(rec)), nil
+	}
+	return EncodeJSONLine(rec)
+}
+
+func intParam(q url.Values, name string, def int) int {
+	if s := q.Get(name); s != "" {
+		if i, e := strconv.Atoi(s); e == nil {
+			return i
+		}
+	}
+	return def
+}
+
+// ReadJSONLines parses records from a JSON lines export, calling f for each record in file order.
+func ReadJSONLines(r io.Reader, f func(rec *log.AuditRecord) error) error {
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 0, 64*1024), 16*1024*1024)
+	var line int
+	for scanner.Scan() {
+		line++
+		if len(scanner.Bytes()) == 0 {
+			continue
+		}
+		jr := &JSONRecord{}
+		if er := json.Unmarshal(scanner.Bytes(), jr); er != nil {
+			return errors.WithMessagef(errors.InvalidParameters, "cannot parse line %d: %s", line, er.Error())
+		}
+		if er := f(jr.ToProto()); er != nil {
+			return er
+		}
+	}
+	return scanner.Err()
+}
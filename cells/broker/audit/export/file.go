@@ -0,0 +1,70 @@
+/*
+ * Copyright (c) 2025 Abstrium SAS <team (at) pydio.com>
+ * This file is part of Pydio Cells.
+ *
+ * Pydio Cells is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * Pydio Cells is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with Pydio Cells.  If not, see <http://www.gnu.org/licenses/>.
+ *
+ * The latest code can be found at <https://pydio.com>.
+ */
+
+package export
+
+import (
+	"net/url"
+	"sync"
+
+	lumberjack "gopkg.in/natefinch/lumberjack.v2"
+
+	"github.com/pydio/cells/v5/common/errors"
+	"github.com/pydio/cells/v5/common/proto/log"
+)
+
+// fileExporter appends one line per record to a file, rotated by size.
+type fileExporter struct {
+	sync.Mutex
+	format string
+	lj     *lumberjack.Logger
+}
+
+func newFileExporter(u *url.URL, format string) (Exporter, error) {
+	if u.Path == "" {
+		return nil, errors.WithMessage(errors.InvalidParameters, "audit file exporter requires a path")
+	}
+	q := u.Query()
+	return &fileExporter{
+		format: format,
+		lj: &lumberjack.Logger{
+			Filename:   u.Path,
+			MaxSize:    intParam(q, "maxSize", 100), // megabytes
+			MaxBackups: intParam(q, "maxBackups", 0),
+			MaxAge:     intParam(q, "maxAge", 0), // days
+			Compress:   q.Get("compress") == "true",
+		},
+	}, nil
+}
+
+func (f *fileExporter) Export(rec *log.AuditRecord) error {
+	line, er := encode(rec, f.format)
+	if er != nil {
+		return er
+	}
+	f.Lock()
+	defer f.Unlock()
+	_, er = f.lj.Write(append(line, '\n'))
+	return er
+}
+
+func (f *fileExporter) Close() error {
+	return f.lj.Close()
+}
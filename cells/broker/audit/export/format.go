@@ -0,0 +1,205 @@
+/*
+ * Copyright (c) 2025 Abstrium SAS <team (at) pydio.com>
+ * This file is part of Pydio Cells.
+ *
+ * Pydio Cells is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * Pydio Cells is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with Pydio Cells.  If not, see <http://www.gnu.org/licenses/>.
+ *
+ * The latest code can be found at <https://pydio.com>.
+ */
+
+package export
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/pydio/cells/v5/common"
+	"github.com/pydio/cells/v5/common/middleware/keys"
+	"github.com/pydio/cells/v5/common/proto/log"
+	json "github.com/pydio/cells/v5/common/utils/jsonx"
+)
+
+const (
+	FormatJSON = "json"
+	FormatCEF  = "cef"
+)
+
+// JSONRecord is the JSON lines representation of a record. Message is embedded as is, so that
+// an exported file can be verified with the same hash algorithm as the store.
+type JSONRecord struct {
+	Seq      int64           `json:"seq"`
+	Ts       int64           `json:"ts"`
+	PrevHash string          `json:"prevHash"`
+	Hash     string          `json:"hash"`
+	Message  json.RawMessage `json:"message"`
+}
+
+// ToProto converts back a JSONRecord to an AuditRecord.
+func (j *JSONRecord) ToProto() *log.AuditRecord {
+	return &log.AuditRecord{
+		Seq:      j.Seq,
+		Ts:       j.Ts,
+		PrevHash: j.PrevHash,
+		Hash:     j.Hash,
+		Message:  j.Message,
+	}
+}
+
+// EncodeJSONLine encodes a record as a single JSON line (without trailing newline).
+func EncodeJSONLine(rec *log.AuditRecord) ([]byte, error) {
+	msg := rec.GetMessage()
+	if !json.Valid(msg) {
+		// Keep the record verifiable: the message is stored as a JSON string
+		msg, _ = json.Marshal(string(msg))
+	}
+	return json.Marshal(&JSONRecord{
+		Seq:      rec.GetSeq(),
+		Ts:       rec.GetTs(),
+		PrevHash: rec.GetPrevHash(),
+		Hash:     rec.GetHash(),
+		Message:  msg,
+	})
+}
+
+// auditFields extracts well-known fields from the JSON log message.
+func auditFields(rec *log.AuditRecord) map[string]string {
+	raw := map[string]interface{}{}
+	_ = json.Unmarshal(rec.GetMessage(), &raw)
+	out := make(map[string]string, len(raw))
+	for k, v := range raw {
+		switch tv := v.(type) {
+		case string:
+			out[k] = tv
+		case float64:
+			out[k] = strconv.FormatFloat(tv, 'f', -1, 64)
+		case bool:
+			out[k] = strconv.FormatBool(tv)
+		}
+	}
+	return out
+}
+
+// EncodeCEF encodes a record using the ArcSight Common Event Format.
+func EncodeCEF(rec *log.AuditRecord) string {
+	ff := auditFields(rec)
+	signature := ff[common.KeyMsgId]
+	if signature == "" {
+		signature = "audit"
+	}
+	name := ff["msg"]
+	if label, ok := common.LogEventLabels[ff[common.KeyMsgId]]; ok && name == "" {
+		name = label
+	}
+	header := []string{
+		"CEF:0",
+		"Pydio",
+		"Cells",
+		cefHeader(common.Version().String()),
+		cefHeader(signature),
+		cefHeader(name),
+		strconv.Itoa(cefSeverity(ff["level"])),
+	}
+	ext := []string{
+		"rt=" + strconv.FormatInt(rec.GetTs()*1000, 10),
+		"cn1Label=seq",
+		"cn1=" + strconv.FormatInt(rec.GetSeq(), 10),
+		"cs1Label=hash",
+		"cs1=" + rec.GetHash(),
+		"cs2Label=prevHash",
+		"cs2=" + rec.GetPrevHash(),
+	}
+	for _, m := range [][2]string{
+		{"suser", common.KeyUsername},
+		{"suid", common.KeyUserUuid},
+		{"src", keys.HttpMetaRemoteAddress},
+		{"requestClientApplication", keys.HttpMetaUserAgent},
+		{"fname", common.KeyNodePath},
+		{"fileId", common.KeyNodeUuid},
+	} {
+		if v := ff[m[1]]; v != "" {
+			ext = append(ext, m[0]+"="+cefExtension(v))
+		}
+	}
+	if ws := ff[common.KeyWorkspaceUuid]; ws != "" {
+		ext = append(ext, "cs3Label=workspace", "cs3="+cefExtension(ws))
+	}
+	return strings.Join(header, "|") + "|" + strings.Join(ext, " ")
+}
+
+func cefHeader(s string) string {
+	return strings.NewReplacer(`\`, `\\`, "|", `\|`, "\n", " ", "\r", " ").Replace(s)
+}
+
+func cefExtension(s string) string {
+	return strings.NewReplacer(`\`, `\\`, "=", `\=`, "\n", `\n`, "\r", `\r`).Replace(s)
+}
+
+func cefSeverity(level string) int {
+	switch level {
+	case "debug":
+		return 1
+	case "warn":
+		return 6
+	case "error":
+		return 8
+	case "dpanic", "panic", "fatal":
+		return 10
+	default:
+		return 3
+	}
+}
+
+// syslogSeverity maps zap levels to RFC 5424 severities.
+func syslogSeverity(level string) int {
+	switch level {
+	case "debug":
+		return 7
+	case "warn":
+		return 4
+	case "error":
+		return 3
+	case "dpanic", "panic", "fatal":
+		return 2
+	default:
+		return 6
+	}
+}
+
+// EncodeRFC5424 wraps a payload in an RFC 5424 syslog message.
+func EncodeRFC5424(rec *log.AuditRecord, facility int, hostname, appName string, procId int, payload string) string {
+	ff := auditFields(rec)
+	msgId := syslogHeader(ff[common.KeyMsgId], 32)
+	pri := facility*8 + syslogSeverity(ff["level"])
+	ts := time.Unix(rec.GetTs(), 0).UTC().Format(time.RFC3339)
+	return fmt.Sprintf("<%d>1 %s %s %s %d %s - %s", pri, ts, syslogHeader(hostname, 255), syslogHeader(appName, 48), procId, msgId, payload)
+}
+
+// syslogHeader ensures a header field is made of printable US-ASCII without spaces, or NILVALUE.
+func syslogHeader(s string, max int) string {
+	var b strings.Builder
+	for _, r := range s {
+		if r > 32 && r < 127 {
+			b.WriteRune(r)
+		}
+		if b.Len() == max {
+			break
+		}
+	}
+	if b.Len() == 0 {
+		return "-"
+	}
+	return b.String()
+}
@@ -0,0 +1,127 @@
+/*
+ * Copyright (c) 2025 Abstrium SAS <team (at) pydio.com>
+ * This file is part of Pydio Cells.
+ *
+ * Pydio Cells is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * Pydio Cells is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with Pydio Cells.  If not, see <http://www.gnu.org/licenses/>.
+ *
+ * The latest code can be found at <https://pydio.com>.
+ */
+
+package export
+
+import (
+	"crypto/tls"
+	"fmt"
+	"net"
+	"net/url"
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/pydio/cells/v5/common/proto/log"
+)
+
+const (
+	// facilityLogAudit is the RFC 5424 "log audit" facility
+	facilityLogAudit = 13
+	dialTimeout      = 10 * time.Second
+)
+
+// syslogExporter sends RFC 5424 messages over UDP, TCP or TLS.
+type syslogExporter struct {
+	sync.Mutex
+	transport string
+	address   string
+	format    string
+	facility  int
+	appName   string
+	hostname  string
+	procId    int
+
+	conn net.Conn
+}
+
+func newSyslogExporter(u *url.URL, format string) (Exporter, error) {
+	q := u.Query()
+	s := &syslogExporter{
+		transport: strings.TrimPrefix(u.Scheme, "syslog+"),
+		address:   u.Host,
+		format:    format,
+		facility:  intParam(q, "facility", facilityLogAudit),
+		appName:   q.Get("appName"),
+		procId:    os.Getpid(),
+	}
+	if s.appName == "" {
+		s.appName = "cells"
+	}
+	if s.facility < 0 || s.facility > 23 {
+		return nil, fmt.Errorf("invalid syslog facility %d", s.facility)
+	}
+	s.hostname, _ = os.Hostname()
+	return s, nil
+}
+
+func (s *syslogExporter) dial() (net.Conn, error) {
+	switch s.transport {
+	case "tls":
+		return tls.DialWithDialer(&net.Dialer{Timeout: dialTimeout}, "tcp", s.address, &tls.Config{})
+	default:
+		return net.DialTimeout(s.transport, s.address, dialTimeout)
+	}
+}
+
+// frame applies octet-counting framing for stream transports (RFC 6587 / RFC 5425).
+func (s *syslogExporter) frame(msg string) []byte {
+	if s.transport == "udp" {
+		return []byte(msg)
+	}
+	return []byte(fmt.Sprintf("%d %s", len(msg), msg))
+}
+
+func (s *syslogExporter) Export(rec *log.AuditRecord) error {
+	payload, er := encode(rec, s.format)
+	if er != nil {
+		return er
+	}
+	data := s.frame(EncodeRFC5424(rec, s.facility, s.hostname, s.appName, s.procId, string(payload)))
+
+	s.Lock()
+	defer s.Unlock()
+	// Retry once on a fresh connection if the previous one was closed
+	for attempt := 0; attempt < 2; attempt++ {
+		if s.conn == nil {
+			if s.conn, er = s.dial(); er != nil {
+				return er
+			}
+		}
+		if _, er = s.conn.Write(data); er == nil {
+			return nil
+		}
+		_ = s.conn.Close()
+		s.conn = nil
+	}
+	return er
+}
+
+func (s *syslogExporter) Close() error {
+	s.Lock()
+	defer s.Unlock()
+	if s.conn != nil {
+		er := s.conn.Close()
+		s.conn = nil
+		return er
+	}
+	return nil
+}
@@ -0,0 +1,156 @@
+/*
+ * Copyright (c) 2025 Abstrium SAS <team (at) pydio.com>
+ * This file is part of Pydio Cells.
+ *
+ * Pydio Cells is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * Pydio Cells is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with Pydio Cells.  If not, see <http://www.gnu.org/licenses/>.
+ *
+ * The latest code can be found at <https://pydio.com>.
+ */
+
+package grpc
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/pydio/cells/v5/broker/audit"
+	"github.com/pydio/cells/v5/broker/audit/export"
+	"github.com/pydio/cells/v5/common"
+	"github.com/pydio/cells/v5/common/config"
+	"github.com/pydio/cells/v5/common/errors"
+	proto "github.com/pydio/cells/v5/common/proto/log"
+	"github.com/pydio/cells/v5/common/runtime/manager"
+	log2 "github.com/pydio/cells/v5/common/telemetry/log"
+)
+
+const listBatchSize = 1000
+
+// Handler receives audit logs from the audit logger, appends them to the hash chain and forwards them to exporters.
+type Handler struct {
+	proto.UnimplementedLogRecorderServer
+	proto.UnimplementedAuditTrailServer
+
+	// appendLock serializes appends to preserve the chain order
+	appendLock sync.Mutex
+
+	exportersOnce sync.Once
+	exporters     []export.Exporter
+}
+
+// PutLog appends each received message to the audit chain.
+func (h *Handler) PutLog(stream proto.LogRecorder_PutLogServer) error {
+	ctx := stream.Context()
+	dao, er := manager.Resolve[audit.DAO](ctx)
+	if er != nil {
+		return er
+	}
+	for {
+		line, er := stream.Recv()
+		if er != nil {
+			if errors.IsStreamFinished(er) {
+				return stream.SendAndClose(&proto.RecorderPutResponse{})
+			}
+			return er
+		}
+		if er := h.Append(ctx, dao, line.GetMessage()); er != nil {
+			log2.Logger(ctx).Error("cannot append audit record", zap.Error(er))
+		}
+	}
+}
+
+// Append chains a message to the last stored record, stores it and forwards it to exporters.
+func (h *Handler) Append(ctx context.Context, dao audit.DAO, message []byte) error {
+	message = audit.NormalizeMessage(message)
+	h.appendLock.Lock()
+	defer h.appendLock.Unlock()
+	var rec *proto.AuditRecord
+	// Retry once if another instance appended a record in the meantime
+	for attempt := 0; attempt < 2; attempt++ {
+		last, er := dao.Last(ctx)
+		if er != nil {
+			return er
+		}
+		rec = audit.Next(last, time.Now().Unix(), message)
+		if er = dao.Insert(ctx, rec); er == nil {
+			break
+		} else if !errors.Is(er, audit.ErrSequenceConflict) || attempt > 0 {
+			return er
+		}
+	}
+	for _, ex := range h.loadExporters(ctx) {
+		if er := ex.Export(rec); er != nil {
+			log2.Logger(ctx).Warn("cannot export audit record", zap.Int64("seq", rec.GetSeq()), zap.Error(er))
+		}
+	}
+	return nil
+}
+
+// DeleteLogs is forbidden, the audit trail is append-only.
+func (h *Handler) DeleteLogs(ctx context.Context, req *proto.ListLogRequest) (*proto.DeleteLogsResponse, error) {
+	return nil, errors.WithMessage(errors.StatusForbidden, "audit trail is append-only, records cannot be deleted")
+}
+
+// ListRecords streams records in sequence order.
+func (h *Handler) ListRecords(req *proto.ListAuditRecordsRequest, stream proto.AuditTrail_ListRecordsServer) error {
+	ctx := stream.Context()
+	dao, er := manager.Resolve[audit.DAO](ctx)
+	if er != nil {
+		return er
+	}
+	from := req.GetFromSeq()
+	var sent int64
+	for {
+		batch := listBatchSize
+		if req.GetLimit() > 0 && req.GetLimit()-sent < int64(batch) {
+			batch = int(req.GetLimit() - sent)
+		}
+		if batch == 0 {
+			return nil
+		}
+		records, er := dao.List(ctx, from, batch)
+		if er != nil {
+			return er
+		}
+		for _, r := range records {
+			if er := stream.Send(r); er != nil {
+				return er
+			}
+			sent++
+			from = r.GetSeq() + 1
+		}
+		if len(records) < batch {
+			return nil
+		}
+	}
+}
+
+// loadExporters opens the exporters declared in the service configuration. They are loaded once:
+// changing the configuration requires a restart of the service.
+func (h *Handler) loadExporters(ctx context.Context) []export.Exporter {
+	h.exportersOnce.Do(func() {
+		urls := config.Get(ctx, "services", common.ServiceAuditGRPC, "exporters").StringArray()
+		for _, u := range urls {
+			ex, er := export.Open(u)
+			if er != nil {
+				log2.Logger(ctx).Error("cannot open audit exporter", zap.String("url", u), zap.Error(er))
+				continue
+			}
+			h.exporters = append(h.exporters, ex)
+		}
+	})
+	return h.exporters
+}
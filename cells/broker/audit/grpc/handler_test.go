@@ -0,0 +1,93 @@
+/*
+ * Copyright (c) 2025 Abstrium SAS <team (at) pydio.com>
+ * This file is part of Pydio Cells.
+ *
+ * Pydio Cells is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * Pydio Cells is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with Pydio Cells.  If not, see <http://www.gnu.org/licenses/>.
+ *
+ * The latest code can be found at <https://pydio.com>.
+ */
+
+package grpc
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/pydio/cells/v5/broker/audit"
+	"github.com/pydio/cells/v5/broker/audit/dao/bolt"
+	"github.com/pydio/cells/v5/broker/audit/dao/mongo"
+	proto "github.com/pydio/cells/v5/common/proto/log"
+	"github.com/pydio/cells/v5/common/runtime/manager"
+	"github.com/pydio/cells/v5/common/server/stubs"
+	"github.com/pydio/cells/v5/common/storage/test"
+	"github.com/pydio/cells/v5/common/utils/uuid"
+
+	_ "github.com/pydio/cells/v5/common/storage/boltdb"
+	_ "github.com/pydio/cells/v5/common/storage/mongodb"
+
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+var (
+	testcases = []test.StorageTestCase{
+		test.TemplateBoltWithPrefix(bolt.NewBoltDAO, "audit_bolt_"),
+		test.TemplateMongoEnvWithPrefix(mongo.NewMongoDAO, "broker_"+uuid.New()[:6]+"_"),
+	}
+)
+
+type recordsSrvStub struct {
+	stubs.StreamerStubCore
+	rr []*proto.AuditRecord
+}
+
+func (s *recordsSrvStub) Send(rec *proto.AuditRecord) error {
+	s.rr = append(s.rr, rec)
+	return nil
+}
+
+func TestHandler(t *testing.T) {
+
+	test.RunStorageTests(testcases, t, func(ctx context.Context) {
+		Convey("Append, list and verify records", t, func() {
+			dao, er := manager.Resolve[audit.DAO](ctx)
+			So(er, ShouldBeNil)
+			// No exporters in tests
+			h := &Handler{}
+			h.exportersOnce.Do(func() {})
+
+			for i := 0; i < 7; i++ {
+				So(h.Append(ctx, dao, []byte(fmt.Sprintf("{\"msg\": \"event %d\"}\n", i))), ShouldBeNil)
+			}
+
+			all := &recordsSrvStub{StreamerStubCore: stubs.StreamerStubCore{Ctx: ctx}}
+			So(h.ListRecords(&proto.ListAuditRecordsRequest{}, all), ShouldBeNil)
+			So(all.rr, ShouldHaveLength, 7)
+			So(string(all.rr[0].GetMessage()), ShouldEqual, `{"msg":"event 0"}`)
+			v := audit.NewVerifier(0, "")
+			for _, r := range all.rr {
+				So(v.Check(r), ShouldBeNil)
+			}
+
+			page := &recordsSrvStub{StreamerStubCore: stubs.StreamerStubCore{Ctx: ctx}}
+			So(h.ListRecords(&proto.ListAuditRecordsRequest{FromSeq: 3, Limit: 2}, page), ShouldBeNil)
+			So(page.rr, ShouldHaveLength, 2)
+			So(page.rr[0].GetSeq(), ShouldEqual, 3)
+			So(page.rr[1].GetSeq(), ShouldEqual, 4)
+
+			_, er = h.DeleteLogs(ctx, &proto.ListLogRequest{Query: "*"})
+			So(er, ShouldNotBeNil)
+		})
+	})
+}
@@ -0,0 +1,53 @@
+/*
+ * Copyright (c) 2025 Abstrium SAS <team (at) pydio.com>
+ * This file is part of Pydio Cells.
+ *
+ * Pydio Cells is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * Pydio Cells is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with Pydio Cells.  If not, see <http://www.gnu.org/licenses/>.
+ *
+ * The latest code can be found at <https://pydio.com>.
+ */
+
+// Package service provides a Pydio GRPC service for storing hash-chained audit records.
+package service
+
+import (
+	"context"
+
+	"google.golang.org/grpc"
+
+	"github.com/pydio/cells/v5/broker/audit"
+	grpc2 "github.com/pydio/cells/v5/broker/audit/grpc"
+	"github.com/pydio/cells/v5/common"
+	proto "github.com/pydio/cells/v5/common/proto/log"
+	"github.com/pydio/cells/v5/common/runtime"
+	"github.com/pydio/cells/v5/common/service"
+)
+
+func init() {
+	runtime.Register("main", func(ctx context.Context) {
+		service.NewService(
+			service.Name(common.ServiceAuditGRPC),
+			service.Context(ctx),
+			service.Tag(common.ServiceTagBroker),
+			service.Description("Append-only, hash-chained audit trail"),
+			service.WithStorageDrivers(audit.Drivers),
+			service.WithGRPC(func(c context.Context, server grpc.ServiceRegistrar) error {
+				handler := &grpc2.Handler{}
+				proto.RegisterLogRecorderServer(server, handler)
+				proto.RegisterAuditTrailServer(server, handler)
+				return nil
+			}),
+		)
+	})
+}
@@ -23,6 +23,7 @@ package chat
 
 import (
 	"context"
+	"slices"
 
 	"github.com/pydio/cells/v5/common/proto/chat"
 	"github.com/pydio/cells/v5/common/runtime/manager"
@@ -44,18 +45,47 @@ type DAO interface {
 	ListRooms(ctx context.Context, request *chat.ListRoomsRequest) ([]*chat.ChatRoom, error)
 	// RoomByUuid loads a room by UUID
 	RoomByUuid(ctx context.Context, byType chat.RoomType, roomUUID string) (*chat.ChatRoom, error)
-	// ListMessages loads all message for a given room, with cursor information
+	// ListMessages loads all message for a given room, with cursor information, optionally restricted to a thread
 	ListMessages(ctx context.Context, request *chat.ListMessagesRequest) ([]*chat.ChatMessage, error)
+	// MessageByUuid loads a message of a given room, returning errors.StatusNotFound if it does not exist in this room
+	MessageByUuid(ctx context.Context, roomUuid, messageUuid string) (*chat.ChatMessage, error)
 	// PostMessage appends a message to the list, generating a UUID if required.
 	PostMessage(ctx context.Context, request *chat.ChatMessage) (*chat.ChatMessage, error)
-	// UpdateMessage updates the content of a message. It must check that new message's author matches existing author.
+	// UpdateMessage updates the content of a message. The callback is responsible for checking that the update is allowed.
 	UpdateMessage(ctx context.Context, request *chat.ChatMessage, callback MessageMatcher) (*chat.ChatMessage, error)
-	// DeleteMessage deletes a message by UUID
+	// ReactMessage atomically adds or removes a user from an emoji reaction. It returns a nil message if it cannot be found.
+	ReactMessage(ctx context.Context, message *chat.ChatMessage, emoji, user string, remove bool) (*chat.ChatMessage, error)
+	// DeleteMessage deletes a message by UUID. If the message Author is set, it must match the stored author.
 	DeleteMessage(ctx context.Context, message *chat.ChatMessage) error
 	// CountMessages counts all messages in a ChatRoom
 	CountMessages(ctx context.Context, room *chat.ChatRoom) (count int, e error)
 }
 
+// ToggleReaction adds or removes user from the given emoji reaction, dropping reactions that have no more users.
+func ToggleReaction(reactions []*chat.ChatReaction, emoji, user string, remove bool) (out []*chat.ChatReaction) {
+	var found bool
+	for _, r := range reactions {
+		if r.GetEmoji() == emoji {
+			found = true
+			users := slices.Clone(r.GetUsers())
+			if remove {
+				users = slices.DeleteFunc(users, func(u string) bool { return u == user })
+			} else if !slices.Contains(users, user) {
+				users = append(users, user)
+			}
+			if len(users) == 0 {
+				continue
+			}
+			r = &chat.ChatReaction{Emoji: emoji, Users: users}
+		}
+		out = append(out, r)
+	}
+	if !found && !remove {
+		out = append(out, &chat.ChatReaction{Emoji: emoji, Users: []string{user}})
+	}
+	return
+}
+
 func Migrate(ctx, fromCtx, toCtx context.Context, dryRun bool, status chan service.MigratorStatus) (map[string]int, error) {
 	res := map[string]int{
 		"Rooms":    0,
@@ -310,14 +310,17 @@ func (h *boltdbimpl) ListMessages(ctx context.Context, request *proto.ListMessag
 			c := bucket.Cursor()
 			c.Last()
 			for k, v := c.Last(); k != nil; k, v = c.Prev() {
-				if request.Offset > 0 && cursor < request.Offset {
-					cursor++
-					continue
-				}
 				var msg proto.ChatMessage
 				if err := json.Unmarshal(v, &msg); err != nil {
 					continue
 				}
+				if !messageMatches(request, &msg) {
+					continue
+				}
+				if request.Offset > 0 && cursor < request.Offset {
+					cursor++
+					continue
+				}
 				if request.Limit > 0 && int64(len(messages)) >= request.Limit {
 					break
 				}
@@ -332,6 +335,9 @@ func (h *boltdbimpl) ListMessages(ctx context.Context, request *proto.ListMessag
 				if err != nil {
 					return err
 				}
+				if !messageMatches(request, &msg) {
+					return nil
+				}
 				messages = append(messages, &msg)
 				return nil
 			})
@@ -349,6 +355,41 @@ func (h *boltdbimpl) ListMessages(ctx context.Context, request *proto.ListMessag
 	return messages, e
 }
 
+// messageMatches applies ListMessagesRequest thread and time filters to a message
+func messageMatches(request *proto.ListMessagesRequest, msg *proto.ChatMessage) bool {
+	if request.ParentUuid != "" && msg.ParentUuid != request.ParentUuid {
+		return false
+	}
+	if request.Since > 0 && msg.Timestamp < request.Since {
+		return false
+	}
+	if request.Until > 0 && msg.Timestamp > request.Until {
+		return false
+	}
+	return true
+}
+
+func (h *boltdbimpl) MessageByUuid(ctx context.Context, roomUuid, messageUuid string) (out *proto.ChatMessage, err error) {
+
+	err = h.View(func(tx *bbolt.Tx) error {
+		bucket, _ := h.getMessagesBucket(tx, false, roomUuid)
+		if bucket == nil {
+			return nil
+		}
+		return bucket.ForEach(func(k, v []byte) error {
+			var msg proto.ChatMessage
+			if er := json.Unmarshal(v, &msg); er == nil && msg.Uuid == messageUuid {
+				out = &msg
+			}
+			return nil
+		})
+	})
+	if err == nil && out == nil {
+		err = errors.WithMessagef(errors.StatusNotFound, "cannot find message %s in room %s", messageUuid, roomUuid)
+	}
+	return
+}
+
 func (h *boltdbimpl) PostMessage(ctx context.Context, request *proto.ChatMessage) (*proto.ChatMessage, error) {
 
 	if request.Uuid == "" {
@@ -399,6 +440,17 @@ func (h *boltdbimpl) UpdateMessage(ctx context.Context, request *proto.ChatMessa
 	return
 }
 
+// ReactMessage toggles the reaction inside the UpdateMessage transaction, which is serialized by bolt.
+func (h *boltdbimpl) ReactMessage(ctx context.Context, message *proto.ChatMessage, emoji, user string, remove bool) (*proto.ChatMessage, error) {
+	return h.UpdateMessage(ctx, message, func(msg *proto.ChatMessage) (bool, *proto.ChatMessage, error) {
+		if msg.Uuid != message.Uuid {
+			return false, nil, nil
+		}
+		msg.Reactions = chat.ToggleReaction(msg.GetReactions(), emoji, user, remove)
+		return true, msg, nil
+	})
+}
+
 func (h *boltdbimpl) DeleteMessage(ctx context.Context, message *proto.ChatMessage) error {
 
 	if message.Uuid == "" {
@@ -413,6 +465,9 @@ func (h *boltdbimpl) DeleteMessage(ctx context.Context, message *proto.ChatMessa
 		return bucket.ForEach(func(k, v []byte) error {
 			var msg proto.ChatMessage
 			if err := json.Unmarshal(v, &msg); err == nil && msg.Uuid == message.Uuid {
+				if message.Author != "" && msg.Author != message.Author {
+					return errors.WithMessage(errors.StatusForbidden, "cannot delete message from a different user")
+				}
 				return bucket.Delete(k)
 			}
 			return nil
@@ -26,9 +26,11 @@ import (
 
 	"go.mongodb.org/mongo-driver/bson"
 	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
 	"go.mongodb.org/mongo-driver/mongo/options"
 
 	"github.com/pydio/cells/v5/broker/chat"
+	"github.com/pydio/cells/v5/common/errors"
 	proto "github.com/pydio/cells/v5/common/proto/chat"
 	"github.com/pydio/cells/v5/common/storage/mongodb"
 	"github.com/pydio/cells/v5/common/utils/configx"
@@ -58,6 +60,7 @@ var mongoModel = mongodb.Model{
 				{"roomuuid": 1},
 				{"uuid": 1},
 				{"author": 1},
+				{"parentuuid": 1},
 				{"timestamp": -1},
 			},
 		},
@@ -151,6 +154,19 @@ func (m *mongoImpl) RoomByUuid(ctx context.Context, byType proto.RoomType, roomU
 
 func (m *mongoImpl) ListMessages(ctx context.Context, request *proto.ListMessagesRequest) (cc []*proto.ChatMessage, e error) {
 	filter := bson.D{{"roomuuid", request.RoomUuid}}
+	if request.ParentUuid != "" {
+		filter = append(filter, bson.E{Key: "parentuuid", Value: request.ParentUuid})
+	}
+	if request.Since > 0 || request.Until > 0 {
+		tsRange := bson.D{}
+		if request.Since > 0 {
+			tsRange = append(tsRange, bson.E{Key: "$gte", Value: request.Since})
+		}
+		if request.Until > 0 {
+			tsRange = append(tsRange, bson.E{Key: "$lte", Value: request.Until})
+		}
+		filter = append(filter, bson.E{Key: "timestamp", Value: tsRange})
+	}
 	opts := &options.FindOptions{}
 	if request.Limit > 0 {
 		opts.Limit = &request.Limit
@@ -178,6 +194,17 @@ func (m *mongoImpl) ListMessages(ctx context.Context, request *proto.ListMessage
 	return
 }
 
+func (m *mongoImpl) MessageByUuid(ctx context.Context, roomUuid, messageUuid string) (*proto.ChatMessage, error) {
+	res := &proto.ChatMessage{}
+	if er := m.db.Collection("messages").FindOne(ctx, bson.D{{"roomuuid", roomUuid}, {"uuid", messageUuid}}).Decode(res); er != nil {
+		if errors.Is(er, mongo.ErrNoDocuments) {
+			return nil, errors.WithMessagef(errors.StatusNotFound, "cannot find message %s in room %s", messageUuid, roomUuid)
+		}
+		return nil, er
+	}
+	return res, nil
+}
+
 func (m *mongoImpl) PostMessage(ctx context.Context, request *proto.ChatMessage) (*proto.ChatMessage, error) {
 	if request.Uuid == "" {
 		request.Uuid = uuid.New()
@@ -195,7 +222,6 @@ func (m *mongoImpl) UpdateMessage(ctx context.Context, request *proto.ChatMessag
 	search := bson.D{
 		{Key: "roomuuid", Value: request.RoomUuid},
 		{Key: "uuid", Value: request.Uuid},
-		{Key: "author", Value: request.Author},
 	}
 	single := m.db.Collection("messages").FindOne(ctx, search)
 	if se := single.Err(); se != nil {
@@ -216,7 +242,62 @@ func (m *mongoImpl) UpdateMessage(ctx context.Context, request *proto.ChatMessag
 	return newMsg, e
 }
 
+// ReactMessage uses $addToSet / $pull operators so that concurrent reactions on the same message do not overwrite each other.
+func (m *mongoImpl) ReactMessage(ctx context.Context, message *proto.ChatMessage, emoji, user string, remove bool) (*proto.ChatMessage, error) {
+	coll := m.db.Collection("messages")
+	search := bson.D{
+		{Key: "roomuuid", Value: message.RoomUuid},
+		{Key: "uuid", Value: message.Uuid},
+	}
+	withEmoji := append(bson.D{{Key: "reactions.emoji", Value: emoji}}, search...)
+	if remove {
+		if _, e := coll.UpdateOne(ctx, withEmoji, bson.D{{"$pull", bson.D{{"reactions.$.users", user}}}}); e != nil {
+			return nil, e
+		}
+		// Drop reactions that have no more users
+		if _, e := coll.UpdateOne(ctx, search, bson.D{{"$pull", bson.D{{"reactions", bson.D{{"users", bson.D{{"$size", 0}}}}}}}}); e != nil {
+			return nil, e
+		}
+	} else {
+		withoutEmoji := append(bson.D{{Key: "reactions.emoji", Value: bson.D{{"$ne", emoji}}}}, search...)
+		// Reactions may be stored as null, concatenate with a pipeline update instead of $push
+		appendEmoji := mongo.Pipeline{{{"$set", bson.D{{"reactions", bson.D{{"$concatArrays", bson.A{
+			bson.D{{"$ifNull", bson.A{"$reactions", bson.A{}}}},
+			bson.A{bson.D{{"emoji", emoji}, {"users", bson.A{user}}}},
+		}}}}}}}}
+		// Retry if the same emoji was concurrently added between the two updates
+		for i := 0; i < 3; i++ {
+			res, e := coll.UpdateOne(ctx, withEmoji, bson.D{{"$addToSet", bson.D{{"reactions.$.users", user}}}})
+			if e != nil {
+				return nil, e
+			} else if res.MatchedCount > 0 {
+				break
+			}
+			res, e = coll.UpdateOne(ctx, withoutEmoji, appendEmoji)
+			if e != nil {
+				return nil, e
+			} else if res.MatchedCount > 0 {
+				break
+			}
+		}
+	}
+	res := &proto.ChatMessage{}
+	if er := coll.FindOne(ctx, search).Decode(res); er != nil {
+		if errors.Is(er, mongo.ErrNoDocuments) {
+			return nil, nil
+		}
+		return nil, er
+	}
+	return res, nil
+}
+
 func (m *mongoImpl) DeleteMessage(ctx context.Context, message *proto.ChatMessage) error {
+	if message.Author != "" {
+		existing := &proto.ChatMessage{}
+		if er := m.db.Collection("messages").FindOne(ctx, bson.D{{Key: "uuid", Value: message.Uuid}}).Decode(existing); er == nil && existing.Author != message.Author {
+			return errors.WithMessage(errors.StatusForbidden, "cannot delete message from a different user")
+		}
+	}
 	res := m.db.Collection("messages").FindOneAndDelete(ctx, bson.D{{"uuid", message.Uuid}})
 	if res.Err() != nil {
 		return res.Err()
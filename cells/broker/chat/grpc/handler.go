@@ -22,7 +22,6 @@ package grpc
 
 import (
 	"context"
-	"errors"
 	"fmt"
 	"time"
 
@@ -34,6 +33,7 @@ import (
 	"github.com/pydio/cells/v5/common"
 	"github.com/pydio/cells/v5/common/broker"
 	"github.com/pydio/cells/v5/common/client/commons/treec"
+	"github.com/pydio/cells/v5/common/errors"
 	"github.com/pydio/cells/v5/common/proto/activity"
 	"github.com/pydio/cells/v5/common/proto/chat"
 	"github.com/pydio/cells/v5/common/proto/tree"
@@ -49,6 +49,9 @@ func getMetaClient(ctx context.Context) tree.NodeReceiverClient {
 	return treec.ServiceNodeReceiverClient(ctx, common.ServiceMeta)
 }
 
+// maxReactionLength limits the size of a reaction, which is expected to be a single (possibly composed) emoji.
+const maxReactionLength = 32
+
 type ChatHandler struct {
 	chat.UnimplementedChatServiceServer
 	RuntimeCtx context.Context
@@ -112,6 +115,14 @@ func (c *ChatHandler) ListRooms(req *chat.ListRoomsRequest, streamer chat.ChatSe
 
 	log.Logger(ctx).Debug("List Rooms", zap.Any(common.KeyChatListRoomReq, req))
 
+	if req.GetRoomUuid() != "" {
+		// Single room lookup, whatever its type. Not found is not an error.
+		if room, er := dao.RoomByUuid(ctx, chat.RoomType_ANY, req.GetRoomUuid()); er == nil && room != nil {
+			return streamer.Send(&chat.ListRoomsResponse{Room: room})
+		}
+		return nil
+	}
+
 	rooms, err := dao.ListRooms(ctx, req)
 	if err != nil {
 		return err
@@ -155,7 +166,8 @@ func (c *ChatHandler) PostMessage(ctx context.Context, req *chat.PostMessageRequ
 
 	resp := &chat.PostMessageResponse{}
 	type outMsg struct {
-		update bool
+		update   bool
+		mentions []string
 		*chat.ChatMessage
 	}
 	log.Logger(ctx).Debug("Post Messages", zap.Any(common.KeyChatPostMsgReq, req))
@@ -164,6 +176,7 @@ func (c *ChatHandler) PostMessage(ctx context.Context, req *chat.PostMessageRequ
 		var newMessage *chat.ChatMessage
 		var err error
 		out := &outMsg{update: m.Uuid != ""}
+		mentions := ParseMentions(m.GetMessage())
 		if out.update {
 			newMessage, err = dao.UpdateMessage(ctx, m, func(msg *chat.ChatMessage) (matches bool, filtered *chat.ChatMessage, err error) {
 				if msg.Uuid != m.Uuid {
@@ -177,6 +190,9 @@ func (c *ChatHandler) PostMessage(ctx context.Context, req *chat.PostMessageRequ
 				filtered = proto.Clone(msg).(*chat.ChatMessage)
 				originalMessage := filtered.GetMessage()
 				filtered.Message = m.GetMessage()
+				filtered.EditTimestamp = time.Now().Unix()
+				out.mentions = newMentions(filtered.GetMentions(), mentions)
+				filtered.Mentions = mentions
 				// Append an activity
 				if filtered.GetActivity() == nil {
 					filtered.Activity = &activity.Object{}
@@ -193,6 +209,16 @@ func (c *ChatHandler) PostMessage(ctx context.Context, req *chat.PostMessageRequ
 				err = errors.New("cannot find message")
 			}
 		} else {
+			if m.GetParentUuid() != "" {
+				// Replies must point to a message of the same room
+				if _, er := dao.MessageByUuid(ctx, m.GetRoomUuid(), m.GetParentUuid()); er != nil {
+					return nil, errors.Tag(er, errors.InvalidParameters)
+				}
+			}
+			m.Mentions = mentions
+			m.Reactions = nil
+			m.EditTimestamp = 0
+			out.mentions = mentions
 			newMessage, err = dao.PostMessage(ctx, m)
 		}
 		if err != nil {
@@ -216,6 +242,9 @@ func (c *ChatHandler) PostMessage(ctx context.Context, req *chat.PostMessageRequ
 				Message: m.ChatMessage,
 				Room:    kr,
 			})
+			if len(m.mentions) > 0 && kr != nil {
+				c.notifyMentions(bgCtx, kr, m.ChatMessage, m.mentions)
+			}
 			// For comments on nodes, publish an UPDATE_USER_META event (if not a message update)
 			if !m.update {
 				if room, err := dao.RoomByUuid(bgCtx, chat.RoomType_NODE, m.RoomUuid); err == nil {
@@ -294,6 +323,34 @@ func (c *ChatHandler) DeleteMessage(ctx context.Context, req *chat.DeleteMessage
 	return &chat.DeleteMessageResponse{Success: true}, nil
 }
 
+// ReactMessage adds or removes an emoji reaction of a user on a message. Unlike edition, any user can react.
+func (c *ChatHandler) ReactMessage(ctx context.Context, req *chat.ReactMessageRequest) (*chat.ReactMessageResponse, error) {
+
+	if req.GetRoomUuid() == "" || req.GetMessageUuid() == "" || req.GetUser() == "" || req.GetEmoji() == "" || len(req.GetEmoji()) > maxReactionLength {
+		return nil, errors.New("reaction requires a message, a user and a valid emoji")
+	}
+
+	dao, err := manager.Resolve[chat2.DAO](ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	log.Logger(ctx).Debug("React Message", zap.Any(common.KeyChatPostMsgReq, req))
+	newMessage, err := dao.ReactMessage(ctx, &chat.ChatMessage{Uuid: req.GetMessageUuid(), RoomUuid: req.GetRoomUuid()}, req.GetEmoji(), req.GetUser(), req.GetRemove())
+	if err != nil {
+		return nil, err
+	} else if newMessage == nil {
+		return nil, errors.New("cannot find message")
+	}
+
+	kr, _ := c.knownRoomFromUuid(ctx, dao, newMessage.RoomUuid, map[string]*chat.ChatRoom{})
+	broker.MustPublish(ctx, common.TopicChatEvent, &chat.ChatEvent{
+		Message: newMessage,
+		Room:    kr,
+	})
+	return &chat.ReactMessageResponse{Message: newMessage}, nil
+}
+
 // knownRoomFromUuid tries to find room in map, or look up in DAO
 func (c *ChatHandler) knownRoomFromUuid(ctx context.Context, dao chat2.DAO, roomUuid string, kr map[string]*chat.ChatRoom) (*chat.ChatRoom, error) {
 
@@ -23,6 +23,7 @@ package grpc
 import (
 	"context"
 	"fmt"
+	"sync"
 	"testing"
 	"time"
 
@@ -294,3 +295,213 @@ func TestChatHandler_ListMessages(t *testing.T) {
 		})
 	})
 }
+
+func TestChatHandler_ThreadsAndReactions(t *testing.T) {
+
+	handler := &ChatHandler{}
+
+	test.RunStorageTests(testcases, t, func(ctx context.Context) {
+
+		roomUuid := uuid.New()
+		ctx = context.WithValue(ctx, "resolved-meta-client", &mocks.NodeReceiverClient{})
+
+		Convey("Test Chat threads and reactions", t, func() {
+			_, e := handler.PutRoom(ctx, &chat.PutRoomRequest{Room: &chat.ChatRoom{
+				Type:           chat.RoomType_GLOBAL,
+				Uuid:           roomUuid,
+				RoomTypeObject: "global",
+				RoomLabel:      "Global",
+			}})
+			So(e, ShouldBeNil)
+
+			resp, e := handler.PostMessage(ctx, &chat.PostMessageRequest{Messages: []*chat.ChatMessage{{
+				RoomUuid:      roomUuid,
+				Message:       "Please review",
+				Author:        "tester",
+				NodeVersionId: "version-1",
+			}}})
+			So(e, ShouldBeNil)
+			parent := resp.Messages[0]
+			So(parent.NodeVersionId, ShouldEqual, "version-1")
+
+			for i := 0; i < 3; i++ {
+				_, e = handler.PostMessage(ctx, &chat.PostMessageRequest{Messages: []*chat.ChatMessage{{
+					RoomUuid:   roomUuid,
+					ParentUuid: parent.Uuid,
+					Message:    fmt.Sprintf("Reply %d", i),
+					Author:     "reviewer",
+				}}})
+				So(e, ShouldBeNil)
+			}
+
+			// Replies cannot point to a message of another room
+			_, e = handler.PostMessage(ctx, &chat.PostMessageRequest{Messages: []*chat.ChatMessage{{
+				RoomUuid:   uuid.New(),
+				ParentUuid: parent.Uuid,
+				Message:    "Cross-room reply",
+				Author:     "reviewer",
+			}}})
+			So(e, ShouldNotBeNil)
+
+			stub := &msgSrvStub{}
+			stub.Ctx = ctx
+			e = handler.ListMessages(&chat.ListMessagesRequest{RoomUuid: roomUuid}, stub)
+			So(e, ShouldBeNil)
+			So(stub.mm, ShouldHaveLength, 4)
+
+			stub = &msgSrvStub{}
+			stub.Ctx = ctx
+			e = handler.ListMessages(&chat.ListMessagesRequest{RoomUuid: roomUuid, ParentUuid: parent.Uuid, Limit: 2}, stub)
+			So(e, ShouldBeNil)
+			So(stub.mm, ShouldHaveLength, 2)
+			So(stub.mm[0].Message.Message, ShouldEqual, "Reply 1")
+			So(stub.mm[1].Message.Message, ShouldEqual, "Reply 2")
+
+			// Edit keeps history and tracks edition time
+			resp, e = handler.PostMessage(ctx, &chat.PostMessageRequest{Messages: []*chat.ChatMessage{{
+				Uuid:     parent.Uuid,
+				RoomUuid: roomUuid,
+				Message:  "Please review the summary",
+				Author:   "tester",
+			}}})
+			So(e, ShouldBeNil)
+			So(resp.Messages[0].EditTimestamp, ShouldBeGreaterThan, 0)
+			So(resp.Messages[0].Activity.Items, ShouldHaveLength, 1)
+			So(resp.Messages[0].Activity.Items[0].Markdown, ShouldEqual, "Please review")
+
+			// Reactions from any user
+			rr, e := handler.ReactMessage(ctx, &chat.ReactMessageRequest{RoomUuid: roomUuid, MessageUuid: parent.Uuid, Emoji: "👍", User: "reviewer"})
+			So(e, ShouldBeNil)
+			So(rr.Message.Reactions, ShouldHaveLength, 1)
+			rr, e = handler.ReactMessage(ctx, &chat.ReactMessageRequest{RoomUuid: roomUuid, MessageUuid: parent.Uuid, Emoji: "👍", User: "other"})
+			So(e, ShouldBeNil)
+			So(rr.Message.Reactions[0].Users, ShouldResemble, []string{"reviewer", "other"})
+			rr, e = handler.ReactMessage(ctx, &chat.ReactMessageRequest{RoomUuid: roomUuid, MessageUuid: parent.Uuid, Emoji: "👍", User: "reviewer", Remove: true})
+			So(e, ShouldBeNil)
+			So(rr.Message.Reactions[0].Users, ShouldResemble, []string{"other"})
+			So(rr.Message.Message, ShouldEqual, "Please review the summary")
+
+			// Concurrent reactions are all kept
+			wg := &sync.WaitGroup{}
+			for i := 0; i < 5; i++ {
+				wg.Add(1)
+				go func() {
+					defer wg.Done()
+					_, _ = handler.ReactMessage(ctx, &chat.ReactMessageRequest{RoomUuid: roomUuid, MessageUuid: parent.Uuid, Emoji: "🎉", User: fmt.Sprintf("user%d", i)})
+				}()
+			}
+			wg.Wait()
+			rr, e = handler.ReactMessage(ctx, &chat.ReactMessageRequest{RoomUuid: roomUuid, MessageUuid: parent.Uuid, Emoji: "🎉", User: "user0"})
+			So(e, ShouldBeNil)
+			So(rr.Message.Reactions, ShouldHaveLength, 2)
+			So(rr.Message.Reactions[1].Users, ShouldHaveLength, 5)
+
+			_, e = handler.ReactMessage(ctx, &chat.ReactMessageRequest{RoomUuid: roomUuid, MessageUuid: "unknown", Emoji: "👍", User: "reviewer"})
+			So(e, ShouldNotBeNil)
+			_, e = handler.ReactMessage(ctx, &chat.ReactMessageRequest{RoomUuid: roomUuid, MessageUuid: parent.Uuid, User: "reviewer"})
+			So(e, ShouldNotBeNil)
+		})
+	})
+}
+
+func TestParseMentions(t *testing.T) {
+	Convey("Test mentions parsing", t, func() {
+		So(ParseMentions("no mention here"), ShouldBeEmpty)
+		So(ParseMentions("@admin please check, cc @john.doe."), ShouldResemble, []string{"admin", "john.doe"})
+		So(ParseMentions("ping @user@example.com and @admin again @admin"), ShouldResemble, []string{"user@example.com", "admin"})
+		So(ParseMentions("mail me at someone@example.com"), ShouldBeEmpty)
+		So(newMentions([]string{"admin"}, []string{"admin", "john"}), ShouldResemble, []string{"john"})
+	})
+}
+
+func TestToggleReaction(t *testing.T) {
+	Convey("Test reactions toggling", t, func() {
+		rr := chat2.ToggleReaction(nil, "🎉", "alice", false)
+		So(rr, ShouldHaveLength, 1)
+		rr = chat2.ToggleReaction(rr, "🎉", "alice", false)
+		So(rr[0].Users, ShouldResemble, []string{"alice"})
+		rr = chat2.ToggleReaction(rr, "👀", "bob", false)
+		So(rr, ShouldHaveLength, 2)
+		rr = chat2.ToggleReaction(rr, "🎉", "alice", true)
+		So(rr, ShouldHaveLength, 1)
+		So(rr[0].Emoji, ShouldEqual, "👀")
+		rr = chat2.ToggleReaction(rr, "❤️", "bob", true)
+		So(rr, ShouldHaveLength, 1)
+	})
+}
+
+func TestChatHandler_Filters(t *testing.T) {
+
+	handler := &ChatHandler{}
+
+	test.RunStorageTests(testcases, t, func(ctx context.Context) {
+
+		roomUuid := uuid.New()
+		ctx = context.WithValue(ctx, "resolved-meta-client", &mocks.NodeReceiverClient{})
+
+		Convey("Test room lookup, time filters and authored deletes", t, func() {
+			_, e := handler.PutRoom(ctx, &chat.PutRoomRequest{Room: &chat.ChatRoom{
+				Type:           chat.RoomType_NODE,
+				Uuid:           roomUuid,
+				RoomTypeObject: uuid.New(),
+				RoomLabel:      "Comments",
+			}})
+			So(e, ShouldBeNil)
+
+			rStub := &roomsSrvStub{}
+			rStub.Ctx = ctx
+			So(handler.ListRooms(&chat.ListRoomsRequest{RoomUuid: roomUuid}, rStub), ShouldBeNil)
+			So(rStub.rr, ShouldHaveLength, 1)
+			So(rStub.rr[0].Room.Type, ShouldEqual, chat.RoomType_NODE)
+
+			rStub = &roomsSrvStub{}
+			rStub.Ctx = ctx
+			So(handler.ListRooms(&chat.ListRoomsRequest{RoomUuid: "unknown-room"}, rStub), ShouldBeNil)
+			So(rStub.rr, ShouldHaveLength, 0)
+
+			var ids []string
+			for i := 1; i <= 5; i++ {
+				resp, e := handler.PostMessage(ctx, &chat.PostMessageRequest{Messages: []*chat.ChatMessage{{
+					RoomUuid:  roomUuid,
+					Message:   fmt.Sprintf("Message %d", i),
+					Author:    "tester",
+					Timestamp: int64(i * 100),
+				}}})
+				So(e, ShouldBeNil)
+				ids = append(ids, resp.Messages[0].Uuid)
+			}
+
+			stub := &msgSrvStub{}
+			stub.Ctx = ctx
+			So(handler.ListMessages(&chat.ListMessagesRequest{RoomUuid: roomUuid, Since: 200, Until: 400}, stub), ShouldBeNil)
+			So(stub.mm, ShouldHaveLength, 3)
+			So(stub.mm[0].Message.Message, ShouldEqual, "Message 2")
+			So(stub.mm[2].Message.Message, ShouldEqual, "Message 4")
+
+			stub = &msgSrvStub{}
+			stub.Ctx = ctx
+			So(handler.ListMessages(&chat.ListMessagesRequest{RoomUuid: roomUuid, Since: 200, Limit: 2}, stub), ShouldBeNil)
+			So(stub.mm, ShouldHaveLength, 2)
+			So(stub.mm[0].Message.Message, ShouldEqual, "Message 4")
+
+			_, e = handler.DeleteMessage(ctx, &chat.DeleteMessageRequest{Messages: []*chat.ChatMessage{{
+				Uuid:     ids[0],
+				RoomUuid: roomUuid,
+				Author:   "someone-else",
+			}}})
+			So(e, ShouldNotBeNil)
+
+			_, e = handler.DeleteMessage(ctx, &chat.DeleteMessageRequest{Messages: []*chat.ChatMessage{{
+				Uuid:     ids[0],
+				RoomUuid: roomUuid,
+				Author:   "tester",
+			}}})
+			So(e, ShouldBeNil)
+
+			stub = &msgSrvStub{}
+			stub.Ctx = ctx
+			So(handler.ListMessages(&chat.ListMessagesRequest{RoomUuid: roomUuid}, stub), ShouldBeNil)
+			So(stub.mm, ShouldHaveLength, 4)
+		})
+	})
+}
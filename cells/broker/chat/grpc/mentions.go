@@ -0,0 +1,193 @@
+/*
+ * Copyright (c) 2025 Abstrium SAS <team (at) pydio.com>
+ * This file is part of Pydio Cells.
+ *
+ * Pydio Cells is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * Pydio Cells is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with Pydio Cells.  If not, see <http://www.gnu.org/licenses/>.
+ *
+ * The latest code can be found at <https://pydio.com>.
+ */
+
+package grpc
+
+import (
+	"context"
+	"fmt"
+	"path"
+	"regexp"
+	"slices"
+	"strings"
+	"time"
+
+	"go.uber.org/zap"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/pydio/cells/v5/common"
+	"github.com/pydio/cells/v5/common/auth"
+	"github.com/pydio/cells/v5/common/client/grpc"
+	"github.com/pydio/cells/v5/common/nodes/compose"
+	"github.com/pydio/cells/v5/common/permissions"
+	"github.com/pydio/cells/v5/common/proto/activity"
+	"github.com/pydio/cells/v5/common/proto/chat"
+	"github.com/pydio/cells/v5/common/proto/idm"
+	"github.com/pydio/cells/v5/common/proto/mailer"
+	"github.com/pydio/cells/v5/common/proto/tree"
+	"github.com/pydio/cells/v5/common/telemetry/log"
+	"github.com/pydio/cells/v5/common/utils/i18n/languages"
+)
+
+// mentionRegexp captures "@login" tokens. Logins may themselves be email addresses.
+var mentionRegexp = regexp.MustCompile(`(?:^|[^\w@])@(\w[\w.\-]*(?:@[\w\-]+(?:\.[\w\-]+)+)?)`)
+
+// ParseMentions extracts unique logins mentioned with an "@" prefix in a message, in order of appearance.
+func ParseMentions(message string) (logins []string) {
+	for _, match := range mentionRegexp.FindAllStringSubmatch(message, -1) {
+		login := strings.TrimRight(match[1], ".-")
+		if login != "" && !slices.Contains(logins, login) {
+			logins = append(logins, login)
+		}
+	}
+	return
+}
+
+// newMentions returns mentions that are not already part of the previous list.
+func newMentions(previous, current []string) (added []string) {
+	for _, m := range current {
+		if !slices.Contains(previous, m) {
+			added = append(added, m)
+		}
+	}
+	return
+}
+
+// notifyMentions posts an activity to the inbox of each mentioned user and sends them an email,
+// provided they can actually access the object the room is attached to.
+func (c *ChatHandler) notifyMentions(ctx context.Context, room *chat.ChatRoom, msg *chat.ChatMessage, logins []string) {
+	for _, login := range logins {
+		if login == msg.GetAuthor() {
+			continue
+		}
+		user, er := permissions.SearchUniqueUser(ctx, login, "")
+		if er != nil || user == nil {
+			log.Logger(ctx).Debug("Ignoring mention of unknown user "+login, zap.Error(er))
+			continue
+		}
+		objectName, ok := c.mentionObjectName(ctx, room, user)
+		if !ok {
+			log.Logger(ctx).Debug("Ignoring mention of user " + login + " that cannot access the room")
+			continue
+		}
+		c.postMentionActivity(ctx, user, msg, objectName)
+		if mentionMailRouted(ctx, user, room) {
+			c.sendMentionMail(ctx, user, msg, objectName)
+		}
+	}
+}
+
+// mentionMailRouted checks the user notification preferences: mentions are emailed right away if
+// the email channel is routed and the user is not in quiet hours. Mentions received during quiet
+// hours are left to the digest job, that emails them when quiet hours end.
+func mentionMailRouted(ctx context.Context, user *idm.User, room *chat.ChatRoom) bool {
+	prefs, er := activity.ParseNotificationPreferences(user.GetAttributes()[idm.UserAttrNotifications])
+	if er != nil {
+		log.Logger(ctx).Warn("Ignoring invalid notification preferences", user.ZapLogin(), zap.Error(er))
+		return true
+	}
+	var folders []string
+	if room.GetType() == chat.RoomType_NODE {
+		folders = append(folders, room.GetRoomTypeObject())
+	}
+	return prefs.Routes(activity.NotificationEventMention, activity.NotificationChannelEmail, folders...) && !prefs.InQuietHours(time.Now())
+}
+
+// mentionObjectName checks that user can access the room target and returns a human-readable name for it.
+func (c *ChatHandler) mentionObjectName(ctx context.Context, room *chat.ChatRoom, user *idm.User) (string, bool) {
+	switch room.GetType() {
+	case chat.RoomType_NODE:
+		resp, er := compose.UuidClient().ReadNode(auth.WithImpersonate(ctx, user), &tree.ReadNodeRequest{Node: &tree.Node{Uuid: room.GetRoomTypeObject()}})
+		if er != nil {
+			return "", false
+		}
+		return path.Base(resp.GetNode().GetPath()), true
+	case chat.RoomType_WORKSPACE:
+		accessList, _, er := permissions.AccessListFromUser(ctx, user.GetLogin(), false)
+		if er != nil {
+			return "", false
+		}
+		ws, ok := accessList.GetWorkspaces()[room.GetRoomTypeObject()]
+		if !ok {
+			return "", false
+		}
+		return ws.GetLabel(), true
+	case chat.RoomType_USER:
+		return room.GetRoomLabel(), slices.Contains(room.GetUsers(), user.GetLogin())
+	default:
+		return room.GetRoomLabel(), true
+	}
+}
+
+func (c *ChatHandler) postMentionActivity(ctx context.Context, user *idm.User, msg *chat.ChatMessage, objectName string) {
+	ac := &activity.Object{
+		JsonLdContext: "https://www.w3.org/ns/activitystreams",
+		Type:          activity.ObjectType_Event,
+		Name:          activity.MentionActivityName,
+		Actor: &activity.Object{
+			Type: activity.ObjectType_Person,
+			Name: msg.GetAuthor(),
+			Id:   msg.GetAuthor(),
+		},
+		Markdown: fmt.Sprintf("[Actor] mentioned you on *%s*: %s", objectName, msg.GetMessage()),
+		Updated: &timestamppb.Timestamp{
+			Seconds: time.Now().Unix(),
+		},
+	}
+	stream, er := activity.NewActivityServiceClient(grpc.ResolveConn(ctx, common.ServiceActivityGRPC)).PostActivity(ctx)
+	if er == nil {
+		er = stream.Send(&activity.PostActivityRequest{
+			OwnerType: activity.OwnerType_USER,
+			OwnerId:   user.GetLogin(),
+			BoxName:   "inbox",
+			Activity:  ac,
+		})
+		_, _ = stream.CloseAndRecv()
+	}
+	if er != nil {
+		log.Logger(ctx).Warn("Cannot post mention activity to "+user.GetLogin()+"'s inbox", zap.Error(er))
+	}
+}
+
+func (c *ChatHandler) sendMentionMail(ctx context.Context, user *idm.User, msg *chat.ChatMessage, objectName string) {
+	address := user.GetAttributes()["email"]
+	if address == "" {
+		return
+	}
+	_, er := mailer.NewMailerServiceClient(grpc.ResolveConn(ctx, common.ServiceMailerGRPC)).SendMail(ctx, &mailer.SendMailRequest{
+		Mail: &mailer.Mail{
+			To: []*mailer.User{{
+				Uuid:     user.GetUuid(),
+				Name:     user.GetAttributes()["displayName"],
+				Address:  address,
+				Language: languages.UserLanguage(ctx, user),
+			}},
+			TemplateId: "ChatMention",
+			TemplateData: map[string]string{
+				"Author": msg.GetAuthor(),
+				"Object": objectName,
+			},
+			ContentMarkdown: "> " + strings.ReplaceAll(msg.GetMessage(), "\n", "\n> "),
+		},
+	})
+	if er != nil {
+		log.Logger(ctx).Warn("Cannot send mention email to "+user.GetLogin(), zap.Error(er))
+	}
+}
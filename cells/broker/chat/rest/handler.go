@@ -0,0 +1,338 @@
+/*
+ * Copyright (c) 2025 Abstrium SAS <team (at) pydio.com>
+ * This file is part of Pydio Cells.
+ *
+ * Pydio Cells is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * Pydio Cells is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with Pydio Cells.  If not, see <http://www.gnu.org/licenses/>.
+ *
+ * The latest code can be found at <https://pydio.com>.
+ */
+
+// Package rest exposes chat rooms and messages attached to nodes over plain HTTP.
+package rest
+
+import (
+	"context"
+	"time"
+
+	restful "github.com/emicklei/go-restful/v3"
+	"github.com/microcosm-cc/bluemonday"
+
+	"github.com/pydio/cells/v5/common"
+	"github.com/pydio/cells/v5/common/auth/claim"
+	"github.com/pydio/cells/v5/common/client/commons"
+	"github.com/pydio/cells/v5/common/client/grpc"
+	"github.com/pydio/cells/v5/common/errors"
+	"github.com/pydio/cells/v5/common/nodes"
+	"github.com/pydio/cells/v5/common/nodes/compose"
+	"github.com/pydio/cells/v5/common/proto/chat"
+	"github.com/pydio/cells/v5/common/proto/rest"
+	"github.com/pydio/cells/v5/common/proto/tree"
+)
+
+const (
+	defaultMessagesLimit = 50
+	maxMessagesLimit     = 500
+)
+
+// ChatHandler responds to chat REST requests
+type ChatHandler struct {
+	RuntimeCtx context.Context
+	router     nodes.Client
+}
+
+func NewChatHandler(ctx context.Context) *ChatHandler {
+	return &ChatHandler{
+		RuntimeCtx: ctx,
+		router:     compose.UuidClient(),
+	}
+}
+
+// SwaggerTags list the names of the service tags declared in the swagger json implemented by this service
+func (h *ChatHandler) SwaggerTags() []string {
+	return []string{"ChatService"}
+}
+
+// Filter returns a function to filter the swagger path
+func (h *ChatHandler) Filter() func(string) string {
+	return nil
+}
+
+func (h *ChatHandler) getClient(ctx context.Context) chat.ChatServiceClient {
+	return chat.NewChatServiceClient(grpc.ResolveConn(ctx, common.ServiceChatGRPC))
+}
+
+// ListChatRooms lists rooms attached to a given object
+func (h *ChatHandler) ListChatRooms(req *restful.Request, rsp *restful.Response) error {
+
+	var input rest.ChatRoomsRequest
+	if e := req.ReadEntity(&input); e != nil {
+		return e
+	}
+	ctx := req.Request.Context()
+	if input.GetTypeObject() == "" && !isAdmin(ctx) {
+		return errors.WithMessage(errors.InvalidParameters, "please provide the object the rooms are attached to")
+	}
+	if input.GetTypeObject() != "" {
+		if _, er := h.roomAccess(ctx, &chat.ChatRoom{Type: input.GetType(), RoomTypeObject: input.GetTypeObject()}); er != nil {
+			return er
+		}
+	}
+	st, er := h.getClient(ctx).ListRooms(ctx, &chat.ListRoomsRequest{ByType: input.GetType(), TypeObject: input.GetTypeObject()})
+	coll := &rest.ChatRoomsCollection{}
+	if err := commons.ForEach(st, er, func(resp *chat.ListRoomsResponse) error {
+		coll.Rooms = append(coll.Rooms, resp.GetRoom())
+		return nil
+	}); err != nil {
+		return err
+	}
+	return rsp.WriteEntity(coll)
+}
+
+// PutChatRoom returns the room attached to an object, creating it if it does not exist yet
+func (h *ChatHandler) PutChatRoom(req *restful.Request, rsp *restful.Response) error {
+
+	var input chat.ChatRoom
+	if e := req.ReadEntity(&input); e != nil {
+		return e
+	}
+	ctx := req.Request.Context()
+	if input.GetRoomTypeObject() == "" {
+		return errors.WithMessage(errors.InvalidParameters, "please provide the object the room is attached to")
+	}
+	if _, er := h.roomAccess(ctx, &input); er != nil {
+		return er
+	}
+	cl := h.getClient(ctx)
+	st, er := cl.ListRooms(ctx, &chat.ListRoomsRequest{ByType: input.GetType(), TypeObject: input.GetRoomTypeObject()})
+	var existing *chat.ChatRoom
+	if err := commons.ForEach(st, er, func(resp *chat.ListRoomsResponse) error {
+		if existing == nil {
+			existing = resp.GetRoom()
+		}
+		return nil
+	}); err != nil {
+		return err
+	}
+	if existing != nil {
+		return rsp.WriteEntity(existing)
+	}
+	resp, er := cl.PutRoom(ctx, &chat.PutRoomRequest{Room: &chat.ChatRoom{
+		Type:           input.GetType(),
+		RoomTypeObject: input.GetRoomTypeObject(),
+		RoomLabel:      input.GetRoomLabel(),
+	}})
+	if er != nil {
+		return er
+	}
+	return rsp.WriteEntity(resp.GetRoom())
+}
+
+// DeleteChatRoom deletes a room and its messages. It is restricted to administrators.
+func (h *ChatHandler) DeleteChatRoom(req *restful.Request, rsp *restful.Response) error {
+
+	ctx := req.Request.Context()
+	if !isAdmin(ctx) {
+		return errors.WithMessage(errors.StatusForbidden, "deleting chat rooms is restricted to administrators")
+	}
+	room, er := h.loadRoom(ctx, req.PathParameter("Uuid"))
+	if er != nil {
+		return er
+	}
+	if _, er = h.getClient(ctx).DeleteRoom(ctx, &chat.DeleteRoomRequest{Room: room}); er != nil {
+		return er
+	}
+	return rsp.WriteEntity(&rest.DeleteResponse{Success: true, NumRows: 1})
+}
+
+// ListChatMessages lists messages of a room with offset/limit pagination, since/until and thread filters
+func (h *ChatHandler) ListChatMessages(req *restful.Request, rsp *restful.Response) error {
+
+	var input rest.ChatMessagesRequest
+	if e := req.ReadEntity(&input); e != nil {
+		return e
+	}
+	ctx := req.Request.Context()
+	room, er := h.loadRoom(ctx, input.GetRoomUuid())
+	if er != nil {
+		return er
+	}
+	if _, er = h.roomAccess(ctx, room); er != nil {
+		return er
+	}
+	limit := input.GetLimit()
+	if limit <= 0 {
+		limit = defaultMessagesLimit
+	} else if limit > maxMessagesLimit {
+		limit = maxMessagesLimit
+	}
+	// Load one more message to detect if there are older ones
+	st, er := h.getClient(ctx).ListMessages(ctx, &chat.ListMessagesRequest{
+		RoomUuid:   room.GetUuid(),
+		ParentUuid: input.GetParentUuid(),
+		Offset:     input.GetOffset(),
+		Limit:      limit + 1,
+		Since:      input.GetSince(),
+		Until:      input.GetUntil(),
+	})
+	coll := &rest.ChatMessagesCollection{}
+	if err := commons.ForEach(st, er, func(resp *chat.ListMessagesResponse) error {
+		coll.Messages = append(coll.Messages, resp.GetMessage())
+		return nil
+	}); err != nil {
+		return err
+	}
+	if int64(len(coll.Messages)) > limit {
+		// Messages are sent in chronological order, the extra one is the oldest
+		coll.Messages = coll.Messages[1:]
+		coll.HasMore = true
+	}
+	return rsp.WriteEntity(coll)
+}
+
+// PostChatMessage posts a new message as the current user, or edits one of their messages if Uuid is set
+func (h *ChatHandler) PostChatMessage(req *restful.Request, rsp *restful.Response) error {
+
+	var input chat.ChatMessage
+	if e := req.ReadEntity(&input); e != nil {
+		return e
+	}
+	ctx := req.Request.Context()
+	claims, _ := claim.FromContext(ctx)
+	message := bluemonday.UGCPolicy().Sanitize(input.GetMessage())
+	if message == "" {
+		return errors.WithMessage(errors.InvalidParameters, "message cannot be empty")
+	}
+	room, er := h.loadRoom(ctx, input.GetRoomUuid())
+	if er != nil {
+		return er
+	}
+	if canPost, er := h.roomAccess(ctx, room); er != nil {
+		return er
+	} else if !canPost {
+		return errors.WithMessage(errors.StatusForbidden, "you are not allowed to post in this room")
+	}
+	resp, er := h.getClient(ctx).PostMessage(ctx, &chat.PostMessageRequest{
+		Messages: []*chat.ChatMessage{{
+			Uuid:          input.GetUuid(),
+			RoomUuid:      room.GetUuid(),
+			Message:       message,
+			Author:        claims.Name,
+			Timestamp:     time.Now().Unix(),
+			ParentUuid:    input.GetParentUuid(),
+			NodeVersionId: input.GetNodeVersionId(),
+		}},
+		KnownRooms: map[string]*chat.ChatRoom{room.GetUuid(): room},
+	})
+	if er != nil {
+		return er
+	}
+	if len(resp.GetMessages()) == 0 {
+		return errors.WithMessage(errors.StatusInternalServerError, "message was not stored")
+	}
+	return rsp.WriteEntity(resp.GetMessages()[0])
+}
+
+// DeleteChatMessage deletes a message. Users can only delete their own messages, administrators can delete any message.
+func (h *ChatHandler) DeleteChatMessage(req *restful.Request, rsp *restful.Response) error {
+
+	ctx := req.Request.Context()
+	claims, _ := claim.FromContext(ctx)
+	room, er := h.loadRoom(ctx, req.PathParameter("RoomUuid"))
+	if er != nil {
+		return er
+	}
+	if _, er = h.roomAccess(ctx, room); er != nil {
+		return er
+	}
+	msg := &chat.ChatMessage{Uuid: req.PathParameter("Uuid"), RoomUuid: room.GetUuid()}
+	if !isAdmin(ctx) {
+		msg.Author = claims.Name
+	}
+	if _, er = h.getClient(ctx).DeleteMessage(ctx, &chat.DeleteMessageRequest{
+		Messages:   []*chat.ChatMessage{msg},
+		KnownRooms: map[string]*chat.ChatRoom{room.GetUuid(): room},
+	}); er != nil {
+		return er
+	}
+	return rsp.WriteEntity(&rest.DeleteResponse{Success: true, NumRows: 1})
+}
+
+// ReactChatMessage adds or removes a reaction of the current user on a message
+func (h *ChatHandler) ReactChatMessage(req *restful.Request, rsp *restful.Response) error {
+
+	var input chat.ReactMessageRequest
+	if e := req.ReadEntity(&input); e != nil {
+		return e
+	}
+	ctx := req.Request.Context()
+	claims, _ := claim.FromContext(ctx)
+	room, er := h.loadRoom(ctx, input.GetRoomUuid())
+	if er != nil {
+		return er
+	}
+	if canPost, er := h.roomAccess(ctx, room); er != nil {
+		return er
+	} else if !canPost {
+		return errors.WithMessage(errors.StatusForbidden, "you are not allowed to react in this room")
+	}
+	input.User = claims.Name
+	resp, er := h.getClient(ctx).ReactMessage(ctx, &input)
+	if er != nil {
+		return er
+	}
+	return rsp.WriteEntity(resp.GetMessage())
+}
+
+// loadRoom finds a room by its Uuid
+func (h *ChatHandler) loadRoom(ctx context.Context, roomUuid string) (*chat.ChatRoom, error) {
+	if roomUuid == "" {
+		return nil, errors.WithMessage(errors.InvalidParameters, "please provide a room Uuid")
+	}
+	st, er := h.getClient(ctx).ListRooms(ctx, &chat.ListRoomsRequest{RoomUuid: roomUuid})
+	var room *chat.ChatRoom
+	if err := commons.ForEach(st, er, func(resp *chat.ListRoomsResponse) error {
+		room = resp.GetRoom()
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+	if room == nil {
+		return nil, errors.WithMessagef(errors.StatusNotFound, "cannot find room %s", roomUuid)
+	}
+	return room, nil
+}
+
+// roomAccess checks that current user can read the room, and tells whether they can post in it.
+// Rooms attached to nodes follow the node permissions, other rooms are restricted to administrators.
+func (h *ChatHandler) roomAccess(ctx context.Context, room *chat.ChatRoom) (canPost bool, err error) {
+	if room.GetType() != chat.RoomType_NODE {
+		if !isAdmin(ctx) {
+			return false, errors.WithMessage(errors.StatusForbidden, "only rooms attached to nodes are accessible")
+		}
+		return true, nil
+	}
+	resp, er := h.router.ReadNode(ctx, &tree.ReadNodeRequest{Node: &tree.Node{Uuid: room.GetRoomTypeObject()}})
+	if er != nil {
+		return false, er
+	}
+	if _, er := h.router.CanApply(ctx, &tree.NodeChangeEvent{Type: tree.NodeChangeEvent_UPDATE_CONTENT, Target: resp.GetNode()}); er == nil {
+		canPost = true
+	}
+	return
+}
+
+func isAdmin(ctx context.Context) bool {
+	claims, ok := claim.FromContext(ctx)
+	return ok && claims.Profile == common.PydioProfileAdmin
+}
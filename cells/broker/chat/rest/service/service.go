@@ -0,0 +1,45 @@
+/*
+ * Copyright (c) 2025 Abstrium SAS <team (at) pydio.com>
+ * This file is part of Pydio Cells.
+ *
+ * Pydio Cells is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * Pydio Cells is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with Pydio Cells.  If not, see <http://www.gnu.org/licenses/>.
+ *
+ * The latest code can be found at <https://pydio.com>.
+ */
+
+// Package service exposes a Rest service for reading and posting chat messages
+package service
+
+import (
+	"context"
+
+	"github.com/pydio/cells/v5/broker/chat/rest"
+	"github.com/pydio/cells/v5/common"
+	"github.com/pydio/cells/v5/common/runtime"
+	"github.com/pydio/cells/v5/common/service"
+)
+
+func init() {
+	runtime.Register("main", func(ctx context.Context) {
+		service.NewService(
+			service.Name(common.ServiceRestNamespace_+common.ServiceChat),
+			service.Context(ctx),
+			service.Tag(common.ServiceTagBroker),
+			service.Description("RESTful Gateway to Chat service"),
+			service.WithWeb(func(c context.Context) service.WebHandler {
+				return rest.NewChatHandler(c)
+			}),
+		)
+	})
+}
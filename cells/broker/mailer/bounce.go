@@ -0,0 +1,131 @@
+/*
+ * Copyright (c) 2025 Abstrium SAS <team (at) pydio.com>
+ * This file is part of Pydio Cells.
+ *
+ * Pydio Cells is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * Pydio Cells is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with Pydio Cells.  If not, see <http://www.gnu.org/licenses/>.
+ *
+ * The latest code can be found at <https://pydio.com>.
+ */
+
+package mailer
+
+import (
+	"bufio"
+	"bytes"
+	"io"
+	"mime"
+	"mime/multipart"
+	"net/mail"
+	"net/textproto"
+	"strings"
+
+	"github.com/pydio/cells/v5/common/errors"
+)
+
+// Bounce is a per-recipient entry of a delivery status notification (RFC 3464).
+type Bounce struct {
+	Recipient  string
+	Action     string
+	Status     string
+	Diagnostic string
+}
+
+// Permanent tells whether the delivery definitely failed (5.x.x status), in which case the address should
+// be considered invalid. Transient failures (4.x.x) and delays are retried by the remote MTA.
+func (b *Bounce) Permanent() bool {
+	return strings.EqualFold(b.Action, "failed") && strings.HasPrefix(b.Status, "5")
+}
+
+// ParseDSN reads a raw delivery status notification and returns its per-recipient entries.
+// The report may be the top-level part or nested inside other multipart containers.
+func ParseDSN(raw []byte) ([]*Bounce, error) {
+	msg, er := mail.ReadMessage(bytes.NewReader(raw))
+	if er != nil {
+		return nil, errors.WithMessagef(errors.InvalidParameters, "cannot read message: %v", er)
+	}
+	bounces, er := parseDSNPart(msg.Header.Get("Content-Type"), msg.Body)
+	if er != nil {
+		return nil, er
+	}
+	if len(bounces) == 0 {
+		return nil, errors.WithMessage(errors.InvalidParameters, "message is not a delivery status notification")
+	}
+	return bounces, nil
+}
+
+func parseDSNPart(contentType string, body io.Reader) ([]*Bounce, error) {
+	mediaType, params, er := mime.ParseMediaType(contentType)
+	if er != nil {
+		return nil, nil
+	}
+	if mediaType == "message/delivery-status" {
+		return parseDeliveryStatus(body), nil
+	}
+	if !strings.HasPrefix(mediaType, "multipart/") || params["boundary"] == "" {
+		return nil, nil
+	}
+	var bounces []*Bounce
+	reader := multipart.NewReader(body, params["boundary"])
+	for {
+		part, er := reader.NextPart()
+		if er == io.EOF {
+			break
+		} else if er != nil {
+			return nil, errors.WithMessagef(errors.InvalidParameters, "cannot read multipart message: %v", er)
+		}
+		bb, er := parseDSNPart(part.Header.Get("Content-Type"), part)
+		if er != nil {
+			return nil, er
+		}
+		bounces = append(bounces, bb...)
+	}
+	return bounces, nil
+}
+
+// parseDeliveryStatus reads the per-message fields block, then one block of fields per recipient.
+func parseDeliveryStatus(body io.Reader) (bounces []*Bounce) {
+	reader := textproto.NewReader(bufio.NewReader(body))
+	first := true
+	for {
+		fields, er := reader.ReadMIMEHeader()
+		if len(fields) > 0 {
+			if first {
+				first = false
+			} else if recipient := dsnAddress(fields.Get("Final-Recipient"), fields.Get("Original-Recipient")); recipient != "" {
+				bounces = append(bounces, &Bounce{
+					Recipient:  recipient,
+					Action:     strings.ToLower(strings.TrimSpace(fields.Get("Action"))),
+					Status:     strings.TrimSpace(fields.Get("Status")),
+					Diagnostic: strings.TrimSpace(fields.Get("Diagnostic-Code")),
+				})
+			}
+		}
+		if er != nil {
+			return
+		}
+	}
+}
+
+// dsnAddress extracts the address from an "rfc822; user@example.com" field.
+func dsnAddress(values ...string) string {
+	for _, v := range values {
+		if _, addr, ok := strings.Cut(v, ";"); ok {
+			v = addr
+		}
+		if v = strings.Trim(strings.TrimSpace(v), "<>"); v != "" {
+			return strings.ToLower(v)
+		}
+	}
+	return ""
+}
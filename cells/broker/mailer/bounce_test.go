@@ -0,0 +1,87 @@
+/*
+ * Copyright (c) 2025 Abstrium SAS <team (at) pydio.com>
+ * This file is part of Pydio Cells.
+ *
+ * Pydio Cells is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * Pydio Cells is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with Pydio Cells.  If not, see <http://www.gnu.org/licenses/>.
+ *
+ * The latest code can be found at <https://pydio.com>.
+ */
+
+package mailer
+
+import (
+	"strings"
+	"testing"
+
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+const sampleDSN = `From: MAILER-DAEMON@mx.example.com
+To: noreply@cells.example.com
+Subject: Undelivered Mail Returned to Sender
+MIME-Version: 1.0
+Content-Type: multipart/report; report-type=delivery-status; boundary="BOUNDARY"
+
+--BOUNDARY
+Content-Type: text/plain; charset=us-ascii
+
+This is the mail system at host mx.example.com.
+
+--BOUNDARY
+Content-Type: message/delivery-status
+
+Reporting-MTA: dns; mx.example.com
+Arrival-Date: Mon, 3 Mar 2025 10:00:00 +0100
+
+Final-Recipient: rfc822; John.Doe@example.com
+Original-Recipient: rfc822;john.doe@example.com
+Action: failed
+Status: 5.1.1
+Diagnostic-Code: smtp; 550 5.1.1 <john.doe@example.com>: Recipient address
+    rejected: User unknown
+
+Final-Recipient: rfc822; busy@example.com
+Action: delayed
+Status: 4.2.2
+Diagnostic-Code: smtp; 452 4.2.2 Mailbox full
+
+--BOUNDARY
+Content-Type: message/rfc822
+
+Subject: Digest
+
+--BOUNDARY--
+`
+
+func TestParseDSN(t *testing.T) {
+
+	Convey("Parse a delivery status notification", t, func() {
+		bounces, er := ParseDSN([]byte(strings.ReplaceAll(sampleDSN, "\n", "\r\n")))
+		So(er, ShouldBeNil)
+		So(bounces, ShouldHaveLength, 2)
+		So(bounces[0].Recipient, ShouldEqual, "john.doe@example.com")
+		So(bounces[0].Status, ShouldEqual, "5.1.1")
+		So(bounces[0].Diagnostic, ShouldContainSubstring, "User unknown")
+		So(bounces[0].Permanent(), ShouldBeTrue)
+		So(bounces[1].Recipient, ShouldEqual, "busy@example.com")
+		So(bounces[1].Permanent(), ShouldBeFalse)
+	})
+
+	Convey("Reject regular messages", t, func() {
+		_, er := ParseDSN([]byte("Subject: hello\r\nContent-Type: text/plain\r\n\r\nHello"))
+		So(er, ShouldNotBeNil)
+		_, er = ParseDSN([]byte("not a mail"))
+		So(er, ShouldNotBeNil)
+	})
+}
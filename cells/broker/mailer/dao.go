@@ -26,6 +26,7 @@ package mailer
 
 import (
 	"context"
+	"time"
 
 	"github.com/pydio/cells/v5/common/errors"
 	"github.com/pydio/cells/v5/common/proto/mailer"
@@ -35,10 +36,58 @@ import (
 )
 
 const (
-	// MaxSendRetries defines number of retries in case of connection failure.
+	// MaxSendRetries defines the default number of retries in case of connection failure.
 	MaxSendRetries = 5
 )
 
+// RetryPolicy defines how mails that could not be sent are rescheduled. The delay starts at InitialDelay
+// and doubles after each failed attempt, up to MaxDelay. Once MaxRetries is reached, mails are flagged as
+// failed and kept in the queue until they are retried or discarded.
+type RetryPolicy struct {
+	MaxRetries   int
+	InitialDelay time.Duration
+	MaxDelay     time.Duration
+}
+
+// DefaultRetryPolicy is used when the service configuration does not override it.
+var DefaultRetryPolicy = RetryPolicy{
+	MaxRetries:   MaxSendRetries,
+	InitialDelay: time.Minute,
+	MaxDelay:     6 * time.Hour,
+}
+
+// Ready tells whether a queued mail can be sent at a given time.
+func (p RetryPolicy) Ready(email *mailer.Mail, now time.Time) bool {
+	return !email.GetFailed() && email.GetNextAttempt() <= now.Unix()
+}
+
+// Failed records a failed attempt on the mail, and either schedules the next attempt or flags it as failed.
+func (p RetryPolicy) Failed(email *mailer.Mail, err error, now time.Time) {
+	email.Retries++
+	email.SendErrors = append(email.SendErrors, now.Format(time.RFC3339)+" "+err.Error())
+	if int(email.Retries) > p.MaxRetries {
+		email.Failed = true
+		email.NextAttempt = 0
+		return
+	}
+	delay := p.InitialDelay
+	for i := int32(1); i < email.Retries && (p.MaxDelay == 0 || delay < p.MaxDelay); i++ {
+		delay *= 2
+	}
+	if p.MaxDelay > 0 && delay > p.MaxDelay {
+		delay = p.MaxDelay
+	}
+	email.NextAttempt = now.Add(delay).Unix()
+}
+
+// ResetRetries clears the retry state of a mail so that it is sent at next queue consumption.
+// Previous errors are kept for reference.
+func ResetRetries(email *mailer.Mail) {
+	email.Retries = 0
+	email.NextAttempt = 0
+	email.Failed = false
+}
+
 var Drivers = service.StorageDrivers{}
 
 type Sender interface {
@@ -81,11 +130,32 @@ func GetSender(ctx context.Context, t string, conf configx.Values) (Sender, erro
 }
 
 type Queue interface {
+	// Push adds a mail to the queue, assigning it a Uuid if it has none.
 	Push(ctx context.Context, email *mailer.Mail) error
-	Consume(context.Context, func(email *mailer.Mail) error) error
+	// Consume sends the mails that are ready according to the policy, removes them from the queue on success and reschedules them on failure.
+	Consume(ctx context.Context, policy RetryPolicy, send func(email *mailer.Mail) error) error
+	// List returns queued mails in queue order, with the total number of matching mails.
+	List(ctx context.Context, failedOnly bool, offset, limit int) ([]*mailer.Mail, int, error)
+	// Retry resets the retry state of the given mails (or of all failed mails) and returns the number of updated mails.
+	Retry(ctx context.Context, uuids []string, allFailed bool) (int, error)
+	// Discard removes the given mails (or all failed mails) from the queue and returns the number of deleted mails.
+	Discard(ctx context.Context, uuids []string, allFailed bool) (int, error)
 	Close(ctx context.Context) error
 }
 
+// QueueSelects tells whether a mail is targeted by a Retry or Discard call.
+func QueueSelects(email *mailer.Mail, uuids []string, allFailed bool) bool {
+	if allFailed && email.GetFailed() {
+		return true
+	}
+	for _, u := range uuids {
+		if u == email.GetUuid() {
+			return true
+		}
+	}
+	return false
+}
+
 // MigrateQueue is a MigratorFunc to move queued emails from one Queue to another.
 func MigrateQueue(mainCtx, fromCtx, toCtx context.Context, dryRun bool, status chan service.MigratorStatus) (map[string]int, error) {
 	out := map[string]int{
@@ -101,12 +171,25 @@ func MigrateQueue(mainCtx, fromCtx, toCtx context.Context, dryRun bool, status c
 		return nil, er
 	}
 
-	er = queueFrom.Consume(mainCtx, func(email *mailer.Mail) error {
+	emails, _, er := queueFrom.List(mainCtx, false, 0, 0)
+	if er != nil {
+		return nil, er
+	}
+	var moved []string
+	for _, email := range emails {
 		out["Emails"]++
 		if dryRun {
-			return nil
+			continue
+		}
+		if er = queueTo.Push(mainCtx, email); er != nil {
+			break
 		}
-		return queueTo.Push(nil, email)
-	})
+		moved = append(moved, email.GetUuid())
+	}
+	if len(moved) > 0 {
+		if _, e := queueFrom.Discard(mainCtx, moved, false); e != nil && er == nil {
+			er = e
+		}
+	}
 	return out, er
 }
@@ -26,6 +26,7 @@ import (
 	"encoding/binary"
 	"fmt"
 	"strings"
+	"time"
 
 	"go.etcd.io/bbolt"
 
@@ -33,6 +34,7 @@ import (
 	"github.com/pydio/cells/v5/common/proto/mailer"
 	"github.com/pydio/cells/v5/common/storage/boltdb"
 	json "github.com/pydio/cells/v5/common/utils/jsonx"
+	"github.com/pydio/cells/v5/common/utils/uuid"
 )
 
 // BOLT DAO MANAGEMENT
@@ -71,9 +73,12 @@ func (b *BoltQueue) Close(ctx context.Context) error {
 // Push acquires the lock and add a mail to be sent in the queue.
 func (b *BoltQueue) Push(ctx context.Context, email *mailer.Mail) error {
 
+	if email.Uuid == "" {
+		email.Uuid = uuid.New()
+	}
 	return b.Update(func(tx *bbolt.Tx) error {
 		// Retrieve the bucket.
-		b := tx.Bucket([]byte("MailerQueue"))
+		b := tx.Bucket(bucketName)
 
 		// Generate ID for this mail.
 		id, _ := b.NextSequence()
@@ -90,15 +95,16 @@ func (b *BoltQueue) Push(ctx context.Context, email *mailer.Mail) error {
 	})
 }
 
-// Consume acquires the lock and send mails that are in the queue by batches,
+// Consume acquires the lock and send mails that are ready in the queue by batches,
 // sending at most 100 mails by batch.
-func (b *BoltQueue) Consume(ctx context.Context, sendHandler func(email *mailer.Mail) error) error {
+func (b *BoltQueue) Consume(ctx context.Context, policy mailer2.RetryPolicy, sendHandler func(email *mailer.Mail) error) error {
 
 	var output error
+	now := time.Now()
 
 	_ = b.Update(func(tx *bbolt.Tx) error {
 
-		b := tx.Bucket([]byte("MailerQueue"))
+		b := tx.Bucket(bucketName)
 		c := b.Cursor()
 		var errStack []string
 		// Launch by batch
@@ -113,21 +119,22 @@ func (b *BoltQueue) Consume(ctx context.Context, sendHandler func(email *mailer.
 				c.Delete()
 				continue
 			}
+			if !policy.Ready(&em, now) {
+				continue
+			}
 
 			// Stream mail
 			if err = sendHandler(&em); err != nil {
 				tos := getTos(&em)
-				if em.Retries <= mailer2.MaxSendRetries {
-					// Update number of tries and re-put mail in the queue.
-					em.Retries++
-					em.SendErrors = append(em.SendErrors, err.Error())
-					marsh, _ := json.Marshal(&em)
-					b.Put(k, marsh)
-					errStack = append(errStack, fmt.Sprintf("cannot send email to [%s], cause: %s", tos, err.Error()))
-					continue
-				} else {
+				policy.Failed(&em, err, now)
+				marsh, _ := json.Marshal(&em)
+				b.Put(k, marsh)
+				if em.Failed {
 					errStack = append(errStack, fmt.Sprintf("max number of retries reached for recipient [%s], cause: %s", tos, err.Error()))
+				} else {
+					errStack = append(errStack, fmt.Sprintf("cannot send email to [%s], cause: %s", tos, err.Error()))
 				}
+				continue
 			}
 
 			// Remove message
@@ -149,6 +156,85 @@ func (b *BoltQueue) Consume(ctx context.Context, sendHandler func(email *mailer.
 	return output
 }
 
+// List browses the queue in order and returns a page of mails.
+func (b *BoltQueue) List(ctx context.Context, failedOnly bool, offset, limit int) (mails []*mailer.Mail, total int, err error) {
+	err = b.View(func(tx *bbolt.Tx) error {
+		return tx.Bucket(bucketName).ForEach(func(k, v []byte) error {
+			em := &mailer.Mail{}
+			if er := json.Unmarshal(v, em); er != nil {
+				return nil
+			}
+			if failedOnly && !em.Failed {
+				return nil
+			}
+			total++
+			if total > offset && (limit <= 0 || len(mails) < limit) {
+				mails = append(mails, withKeyUuid(k, em))
+			}
+			return nil
+		})
+	})
+	return
+}
+
+// Retry resets the retry state of selected mails.
+func (b *BoltQueue) Retry(ctx context.Context, uuids []string, allFailed bool) (int, error) {
+	return b.apply(uuids, allFailed, func(bucket *bbolt.Bucket, k []byte, em *mailer.Mail) error {
+		mailer2.ResetRetries(em)
+		marsh, er := json.Marshal(em)
+		if er != nil {
+			return er
+		}
+		return bucket.Put(k, marsh)
+	})
+}
+
+// Discard removes selected mails from the queue.
+func (b *BoltQueue) Discard(ctx context.Context, uuids []string, allFailed bool) (int, error) {
+	return b.apply(uuids, allFailed, func(bucket *bbolt.Bucket, k []byte, em *mailer.Mail) error {
+		return bucket.Delete(k)
+	})
+}
+
+func (b *BoltQueue) apply(uuids []string, allFailed bool, action func(bucket *bbolt.Bucket, k []byte, em *mailer.Mail) error) (int, error) {
+	var count int
+	err := b.Update(func(tx *bbolt.Tx) error {
+		bucket := tx.Bucket(bucketName)
+		// Collect keys first, as bucket cannot be modified during ForEach
+		var keys [][]byte
+		var mails []*mailer.Mail
+		if er := bucket.ForEach(func(k, v []byte) error {
+			em := &mailer.Mail{}
+			if er := json.Unmarshal(v, em); er != nil {
+				return nil
+			}
+			if mailer2.QueueSelects(withKeyUuid(k, em), uuids, allFailed) {
+				keys = append(keys, append([]byte{}, k...))
+				mails = append(mails, em)
+			}
+			return nil
+		}); er != nil {
+			return er
+		}
+		for i, k := range keys {
+			if er := action(bucket, k, mails[i]); er != nil {
+				return er
+			}
+			count++
+		}
+		return nil
+	})
+	return count, err
+}
+
+// withKeyUuid sets the bolt key as Uuid for mails that were queued without one.
+func withKeyUuid(k []byte, em *mailer.Mail) *mailer.Mail {
+	if em.Uuid == "" {
+		em.Uuid = fmt.Sprintf("%d", binary.BigEndian.Uint64(k))
+	}
+	return em
+}
+
 // itob returns an 8-byte big endian representation of v.
 func itob(v int) []byte {
 	b := make([]byte, 8)
@@ -74,43 +74,43 @@ func (m *mongoQueue) Init(ctx context.Context, conf configx.Values) error {
 }
 
 func (m *mongoQueue) Push(ctx context.Context, email *mailer.Mail) error {
+	if email.Uuid == "" {
+		email.Uuid = uuid.New()
+	}
 	store := &StoredEmail{
 		Ts:    time.Now().UnixNano(),
-		ID:    uuid.New(),
+		ID:    email.Uuid,
 		Email: email,
 	}
 	_, e := m.db.Collection(collMailerQueue).InsertOne(ctx, store)
 	return e
 }
 
-func (m *mongoQueue) Consume(ctx context.Context, f func(email *mailer.Mail) error) error {
+func (m *mongoQueue) Consume(ctx context.Context, policy mailer2.RetryPolicy, f func(email *mailer.Mail) error) error {
 	coll := m.db.Collection(collMailerQueue)
 	cursor, e := coll.Find(ctx, bson.D{}, &options.FindOptions{Sort: bson.D{{"ts", 1}}})
 	if e != nil {
 		return e
 	}
+	now := time.Now()
 	var errStack []string
 	var i int
 	for cursor.Next(ctx) {
 		mail := &StoredEmail{}
-		if de := cursor.Decode(mail); de == nil {
-			i++
-			if err := f(mail.Email); err == nil || mail.Email.Retries > mailer2.MaxSendRetries {
-				if _, delE := coll.DeleteOne(ctx, bson.D{{"id", mail.ID}}); delE != nil {
-					fmt.Println("Could not delete email after send", delE)
-					errStack = append(errStack, delE.Error())
-				} else {
-					fmt.Println("Deleted email after send (or max retries reached)")
-				}
+		if de := cursor.Decode(mail); de != nil || !policy.Ready(mail.Email, now) {
+			continue
+		}
+		i++
+		if err := f(mail.Email); err == nil {
+			if _, delE := coll.DeleteOne(ctx, bson.D{{"id", mail.ID}}); delE != nil {
+				errStack = append(errStack, delE.Error())
+			}
+		} else {
+			policy.Failed(mail.Email, err, now)
+			if _, ue := coll.ReplaceOne(ctx, bson.D{{Key: "id", Value: mail.ID}}, mail); ue != nil {
+				errStack = append(errStack, ue.Error())
 			} else {
-				mail.Email.Retries += 1
-				if _, ue := coll.ReplaceOne(ctx, bson.D{{Key: "id", Value: mail.ID}}, mail); ue != nil {
-					fmt.Println("Could not update retry after send failed", ue)
-					errStack = append(errStack, ue.Error())
-				} else if err != nil {
-					fmt.Println("Send failed, updated retry count")
-					errStack = append(errStack, err.Error())
-				}
+				errStack = append(errStack, err.Error())
 			}
 		}
 	}
@@ -120,6 +120,77 @@ func (m *mongoQueue) Consume(ctx context.Context, f func(email *mailer.Mail) err
 	return nil
 }
 
+func (m *mongoQueue) List(ctx context.Context, failedOnly bool, offset, limit int) (mails []*mailer.Mail, total int, err error) {
+	err = m.browse(ctx, func(mail *StoredEmail) error {
+		if failedOnly && !mail.Email.GetFailed() {
+			return nil
+		}
+		total++
+		if total > offset && (limit <= 0 || len(mails) < limit) {
+			mails = append(mails, mail.Email)
+		}
+		return nil
+	})
+	return
+}
+
+func (m *mongoQueue) Retry(ctx context.Context, uuids []string, allFailed bool) (int, error) {
+	var count int
+	coll := m.db.Collection(collMailerQueue)
+	er := m.browse(ctx, func(mail *StoredEmail) error {
+		if !mailer2.QueueSelects(mail.Email, uuids, allFailed) {
+			return nil
+		}
+		mailer2.ResetRetries(mail.Email)
+		if _, ue := coll.ReplaceOne(ctx, bson.D{{Key: "id", Value: mail.ID}}, mail); ue != nil {
+			return ue
+		}
+		count++
+		return nil
+	})
+	return count, er
+}
+
+func (m *mongoQueue) Discard(ctx context.Context, uuids []string, allFailed bool) (int, error) {
+	var ids []string
+	if er := m.browse(ctx, func(mail *StoredEmail) error {
+		if mailer2.QueueSelects(mail.Email, uuids, allFailed) {
+			ids = append(ids, mail.ID)
+		}
+		return nil
+	}); er != nil {
+		return 0, er
+	}
+	if len(ids) == 0 {
+		return 0, nil
+	}
+	res, er := m.db.Collection(collMailerQueue).DeleteMany(ctx, bson.D{{Key: "id", Value: bson.M{"$in": ids}}})
+	if er != nil {
+		return 0, er
+	}
+	return int(res.DeletedCount), nil
+}
+
+// browse iterates over stored emails in queue order, setting their Uuid from the stored ID.
+func (m *mongoQueue) browse(ctx context.Context, f func(mail *StoredEmail) error) error {
+	cursor, e := m.db.Collection(collMailerQueue).Find(ctx, bson.D{}, &options.FindOptions{Sort: bson.D{{Key: "ts", Value: 1}}})
+	if e != nil {
+		return e
+	}
+	defer cursor.Close(ctx)
+	for cursor.Next(ctx) {
+		mail := &StoredEmail{}
+		if de := cursor.Decode(mail); de != nil || mail.Email == nil {
+			continue
+		}
+		mail.Email.Uuid = mail.ID
+		if er := f(mail); er != nil {
+			return er
+		}
+	}
+	return cursor.Err()
+}
+
 func (m *mongoQueue) Close(ctx context.Context) error {
 	return nil
 	// return m.DAO.CloseConn(ctx)
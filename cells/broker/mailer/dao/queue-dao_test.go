@@ -25,6 +25,7 @@ package dao
 import (
 	"context"
 	"testing"
+	"time"
 
 	"google.golang.org/protobuf/proto"
 
@@ -52,6 +53,9 @@ var (
 	}
 )
 
+// noDelay retries failed mails at next consumption
+var noDelay = mailer2.RetryPolicy{MaxRetries: mailer2.MaxSendRetries}
+
 func init() {
 	// Define parameters to shorten tests launch
 	conf = configx.New()
@@ -80,7 +84,7 @@ func testQueue(ctx context.Context, t *testing.T, queue mailer2.Queue) {
 	So(err, ShouldBeNil)
 
 	var consumedMail *mailer.Mail
-	e := queue.Consume(ctx, func(email *mailer.Mail) error {
+	e := queue.Consume(ctx, noDelay, func(email *mailer.Mail) error {
 		consumedMail = email
 		return nil
 	})
@@ -93,7 +97,7 @@ func testQueue(ctx context.Context, t *testing.T, queue mailer2.Queue) {
 
 	// We should only retrieve 2nd email
 	i := 0
-	e = queue.Consume(ctx, func(email *mailer.Mail) error {
+	e = queue.Consume(ctx, noDelay, func(email *mailer.Mail) error {
 		consumedMail = email
 		i++
 		return nil
@@ -106,18 +110,18 @@ func testQueue(ctx context.Context, t *testing.T, queue mailer2.Queue) {
 	email2.Subject = "Test 3 With Fail"
 	err = queue.Push(ctx, email2)
 	So(err, ShouldBeNil)
-	e = queue.Consume(ctx, func(email *mailer.Mail) error {
+	e = queue.Consume(ctx, noDelay, func(email *mailer.Mail) error {
 		return errors.New("Failed Sending Email - Should Update Retry")
 	})
 	So(e, ShouldNotBeNil)
 
-	e = queue.Consume(ctx, func(email *mailer.Mail) error {
+	e = queue.Consume(ctx, noDelay, func(email *mailer.Mail) error {
 		return errors.New("Failed Sending Email - Should Update Retry _ 2")
 	})
 	So(e, ShouldNotBeNil)
 
 	i = 0
-	e = queue.Consume(ctx, func(email *mailer.Mail) error {
+	e = queue.Consume(ctx, noDelay, func(email *mailer.Mail) error {
 		i++
 		consumedMail = email
 		return nil
@@ -128,6 +132,70 @@ func testQueue(ctx context.Context, t *testing.T, queue mailer2.Queue) {
 
 }
 
+func testQueueRetries(ctx context.Context, queue mailer2.Queue) {
+
+	email := &mailer.Mail{
+		To:           []*mailer.User{{Address: "bounce@example.com"}},
+		Subject:      "Retried email",
+		ContentPlain: "This is a test",
+	}
+	So(queue.Push(ctx, email), ShouldBeNil)
+	So(email.Uuid, ShouldNotBeEmpty)
+
+	fail := func(*mailer.Mail) error { return errors.New("connection refused") }
+	count := 0
+	succeed := func(*mailer.Mail) error { count++; return nil }
+
+	// With a delay, a failed email is not retried right away
+	delayed := mailer2.RetryPolicy{MaxRetries: 1, InitialDelay: time.Hour}
+	So(queue.Consume(ctx, delayed, fail), ShouldNotBeNil)
+	So(queue.Consume(ctx, delayed, succeed), ShouldBeNil)
+	So(count, ShouldEqual, 0)
+	mails, total, er := queue.List(ctx, false, 0, 0)
+	So(er, ShouldBeNil)
+	So(total, ShouldEqual, 1)
+	So(mails[0].Uuid, ShouldEqual, email.Uuid)
+	So(mails[0].NextAttempt, ShouldBeGreaterThan, time.Now().Unix())
+	So(mails[0].SendErrors, ShouldHaveLength, 1)
+
+	// Once max retries is reached, email is flagged as failed and kept
+	n, er := queue.Retry(ctx, []string{email.Uuid}, false)
+	So(er, ShouldBeNil)
+	So(n, ShouldEqual, 1)
+	So(queue.Consume(ctx, mailer2.RetryPolicy{MaxRetries: 1}, fail), ShouldNotBeNil)
+	So(queue.Consume(ctx, mailer2.RetryPolicy{MaxRetries: 1}, fail), ShouldNotBeNil)
+	mails, total, er = queue.List(ctx, true, 0, 10)
+	So(er, ShouldBeNil)
+	So(total, ShouldEqual, 1)
+	So(mails[0].Failed, ShouldBeTrue)
+	So(queue.Consume(ctx, noDelay, succeed), ShouldBeNil)
+	So(count, ShouldEqual, 0)
+
+	// Retry all failed then send
+	n, er = queue.Retry(ctx, nil, true)
+	So(er, ShouldBeNil)
+	So(n, ShouldEqual, 1)
+	So(queue.Consume(ctx, noDelay, succeed), ShouldBeNil)
+	So(count, ShouldEqual, 1)
+	_, total, _ = queue.List(ctx, false, 0, 0)
+	So(total, ShouldEqual, 0)
+
+	// Discard
+	So(queue.Push(ctx, proto.Clone(email).(*mailer.Mail)), ShouldBeNil)
+	email2 := proto.Clone(email).(*mailer.Mail)
+	email2.Uuid = ""
+	So(queue.Push(ctx, email2), ShouldBeNil)
+	mails, total, _ = queue.List(ctx, false, 1, 10)
+	So(total, ShouldEqual, 2)
+	So(mails, ShouldHaveLength, 1)
+	So(mails[0].Uuid, ShouldEqual, email2.Uuid)
+	n, er = queue.Discard(ctx, []string{email.Uuid, email2.Uuid}, false)
+	So(er, ShouldBeNil)
+	So(n, ShouldEqual, 2)
+	_, total, _ = queue.List(ctx, false, 0, 0)
+	So(total, ShouldEqual, 0)
+}
+
 func TestEnqueueMail(t *testing.T) {
 
 	test.RunStorageTests(testcases, t, func(ctx context.Context) {
@@ -138,6 +206,7 @@ func TestEnqueueMail(t *testing.T) {
 			}
 
 			testQueue(ctx, t, queue)
+			testQueueRetries(ctx, queue)
 		})
 	})
 
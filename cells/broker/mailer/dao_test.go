@@ -0,0 +1,56 @@
+/*
+ * Copyright (c) 2025 Abstrium SAS <team (at) pydio.com>
+ * This file is part of Pydio Cells.
+ *
+ * Pydio Cells is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * Pydio Cells is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with Pydio Cells.  If not, see <http://www.gnu.org/licenses/>.
+ *
+ * The latest code can be found at <https://pydio.com>.
+ */
+
+package mailer
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	. "github.com/smartystreets/goconvey/convey"
+
+	mailer2 "github.com/pydio/cells/v5/common/proto/mailer"
+)
+
+func TestRetryPolicy(t *testing.T) {
+
+	Convey("Failed attempts are rescheduled with exponential delays", t, func() {
+		p := RetryPolicy{MaxRetries: 3, InitialDelay: time.Minute, MaxDelay: 3 * time.Minute}
+		now := time.Now()
+		m := &mailer2.Mail{}
+		So(p.Ready(m, now), ShouldBeTrue)
+		p.Failed(m, errors.New("timeout"), now)
+		So(m.NextAttempt, ShouldEqual, now.Add(time.Minute).Unix())
+		So(p.Ready(m, now), ShouldBeFalse)
+		p.Failed(m, errors.New("timeout"), now)
+		So(m.NextAttempt, ShouldEqual, now.Add(2*time.Minute).Unix())
+		p.Failed(m, errors.New("timeout"), now)
+		So(m.NextAttempt, ShouldEqual, now.Add(3*time.Minute).Unix())
+		So(m.Failed, ShouldBeFalse)
+		p.Failed(m, errors.New("timeout"), now)
+		So(m.Failed, ShouldBeTrue)
+		So(m.SendErrors, ShouldHaveLength, 4)
+		So(p.Ready(m, now.Add(time.Hour)), ShouldBeFalse)
+		ResetRetries(m)
+		So(p.Ready(m, now), ShouldBeTrue)
+		So(m.SendErrors, ShouldHaveLength, 4)
+	})
+}
@@ -21,6 +21,7 @@
 package grpc
 
 import (
+	"github.com/pydio/cells/v5/broker/mailer"
 	"github.com/pydio/cells/v5/broker/mailer/lang"
 	"github.com/pydio/cells/v5/common/forms"
 )
@@ -64,6 +65,22 @@ var ExposedConfigs = &forms.Form{
 				Type:      forms.ParamBool,
 				Default:   true,
 			},
+			&forms.FormField{
+				Name:        "queueMaxRetries",
+				Label:       "Mail.Config.QueueMaxRetries.Label",
+				Description: "Mail.Config.QueueMaxRetries.Description",
+				Mandatory:   false,
+				Type:        forms.ParamInteger,
+				Default:     mailer.MaxSendRetries,
+			},
+			&forms.FormField{
+				Name:        "queueRetryDelay",
+				Label:       "Mail.Config.QueueRetryDelay.Label",
+				Description: "Mail.Config.QueueRetryDelay.Description",
+				Mandatory:   false,
+				Type:        forms.ParamString,
+				Default:     mailer.DefaultRetryPolicy.InitialDelay.String(),
+			},
 			&forms.SwitchField{
 				Name:        "sender",
 				Label:       "Mail.Config.Mailer.Label",
@@ -23,17 +23,22 @@ package grpc
 import (
 	"context"
 	"fmt"
+	"time"
 
 	hermes "github.com/matcornic/hermes/v2"
 	"go.uber.org/zap"
 	protobuf "google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/anypb"
 
 	"github.com/pydio/cells/v5/broker/mailer"
 	"github.com/pydio/cells/v5/broker/mailer/templates"
 	"github.com/pydio/cells/v5/common"
+	"github.com/pydio/cells/v5/common/client/commons/idmc"
 	"github.com/pydio/cells/v5/common/config"
 	"github.com/pydio/cells/v5/common/errors"
+	"github.com/pydio/cells/v5/common/proto/idm"
 	proto "github.com/pydio/cells/v5/common/proto/mailer"
+	"github.com/pydio/cells/v5/common/proto/service"
 	"github.com/pydio/cells/v5/common/runtime/manager"
 	"github.com/pydio/cells/v5/common/telemetry/log"
 	"github.com/pydio/cells/v5/common/utils/configx"
@@ -75,7 +80,16 @@ func (h *Handler) SendMail(ctx context.Context, req *proto.SendMailRequest) (*pr
 		return nil, errors.Tag(er, errors.StatusServiceUnavailable)
 	}
 
+	var sent int
 	for _, to := range mail.To {
+		// Never send to addresses that were flagged after a permanent delivery failure
+		if bounce, er := h.bouncedAddress(ctx, to.GetAddress()); er != nil {
+			return nil, errors.Tag(er, errors.StatusServiceUnavailable)
+		} else if bounce != "" {
+			log.Logger(ctx).Warn("SendMail: ignoring recipient whose email was flagged as invalid", zap.String("to", to.GetAddress()), zap.String("bounce", bounce))
+			continue
+		}
+		sent++
 		// Find language to be used
 		var languages []string
 		if to.Language != "" {
@@ -165,9 +179,35 @@ func (h *Handler) SendMail(ctx context.Context, req *proto.SendMailRequest) (*pr
 			}
 		}
 	}
+	if sent == 0 {
+		return nil, errors.WithMessage(errors.StatusBadRequest, "cannot send mail: all recipients email addresses were flagged as invalid")
+	}
 	return &proto.SendMailResponse{Success: true}, nil
 }
 
+// bouncedAddress returns the idm.UserAttrEmailBounce flag of the users registered with this address, if any.
+func (h *Handler) bouncedAddress(ctx context.Context, address string) (string, error) {
+	if address == "" {
+		return "", nil
+	}
+	q, _ := anypb.New(&idm.UserSingleQuery{AttributeName: idm.UserAttrEmail, AttributeValue: address})
+	stream, er := idmc.UserServiceClient(ctx).SearchUser(ctx, &idm.SearchUserRequest{Query: &service.Query{SubQueries: []*anypb.Any{q}}})
+	if er != nil {
+		return "", er
+	}
+	var bounce string
+	for {
+		resp, e := stream.Recv()
+		if e != nil {
+			break
+		}
+		if b := resp.GetUser().GetAttributes()[idm.UserAttrEmailBounce]; b != "" && bounce == "" {
+			bounce = b
+		}
+	}
+	return bounce, nil
+}
+
 // ConsumeQueue browses current queue for emails to be sent
 func (h *Handler) ConsumeQueue(ctx context.Context, req *proto.ConsumeQueueRequest) (*proto.ConsumeQueueResponse, error) {
 
@@ -191,7 +231,7 @@ func (h *Handler) ConsumeQueue(ctx context.Context, req *proto.ConsumeQueueReque
 		return sender.Send(ctx, em)
 	}
 
-	e := dao.Consume(ctx, c)
+	e := dao.Consume(ctx, h.retryPolicy(ctx), c)
 	if e != nil {
 		return nil, e
 	}
@@ -203,6 +243,69 @@ func (h *Handler) ConsumeQueue(ctx context.Context, req *proto.ConsumeQueueReque
 	return rsp, nil
 }
 
+// ListQueue lists pending and failed emails
+func (h *Handler) ListQueue(ctx context.Context, req *proto.ListQueueRequest) (*proto.ListQueueResponse, error) {
+
+	dao, err := manager.Resolve[mailer.Queue](ctx)
+	if err != nil {
+		return nil, err
+	}
+	mails, total, er := dao.List(ctx, req.GetFailedOnly(), int(req.GetOffset()), int(req.GetLimit()))
+	if er != nil {
+		return nil, er
+	}
+	return &proto.ListQueueResponse{Mails: mails, Total: int32(total)}, nil
+}
+
+// RetryQueue resets retries counters of emails so that they are sent at next queue consumption
+func (h *Handler) RetryQueue(ctx context.Context, req *proto.QueueActionRequest) (*proto.QueueActionResponse, error) {
+
+	dao, err := manager.Resolve[mailer.Queue](ctx)
+	if err != nil {
+		return nil, err
+	}
+	if len(req.GetUuids()) == 0 && !req.GetAllFailed() {
+		return nil, errors.WithMessage(errors.InvalidParameters, "please provide mails uuids or use AllFailed")
+	}
+	count, er := dao.Retry(ctx, req.GetUuids(), req.GetAllFailed())
+	if er != nil {
+		return nil, er
+	}
+	log.Logger(ctx).Info(fmt.Sprintf("Rescheduled %d mail(s) in queue", count))
+	return &proto.QueueActionResponse{Count: int32(count)}, nil
+}
+
+// DiscardQueue removes emails from the queue
+func (h *Handler) DiscardQueue(ctx context.Context, req *proto.QueueActionRequest) (*proto.QueueActionResponse, error) {
+
+	dao, err := manager.Resolve[mailer.Queue](ctx)
+	if err != nil {
+		return nil, err
+	}
+	if len(req.GetUuids()) == 0 && !req.GetAllFailed() {
+		return nil, errors.WithMessage(errors.InvalidParameters, "please provide mails uuids or use AllFailed")
+	}
+	count, er := dao.Discard(ctx, req.GetUuids(), req.GetAllFailed())
+	if er != nil {
+		return nil, er
+	}
+	log.Logger(ctx).Info(fmt.Sprintf("Discarded %d mail(s) from queue", count))
+	return &proto.QueueActionResponse{Count: int32(count)}, nil
+}
+
+// retryPolicy reads queue retry parameters from the service configuration.
+func (h *Handler) retryPolicy(ctx context.Context) mailer.RetryPolicy {
+	policy := mailer.DefaultRetryPolicy
+	conf := config.Get(ctx, "services", h.svcName)
+	if m := conf.Val("queueMaxRetries").Default(policy.MaxRetries).Int(); m >= 0 {
+		policy.MaxRetries = m
+	}
+	if d, er := time.ParseDuration(conf.Val("queueRetryDelay").Default(policy.InitialDelay.String()).String()); er == nil && d >= 0 {
+		policy.InitialDelay = d
+	}
+	return policy
+}
+
 func (h *Handler) parseConf(conf configx.Values) (senderName string, senderConfig configx.Values) {
 
 	senderConfig = conf.Val("sender")
@@ -0,0 +1,172 @@
+/*
+ * Copyright (c) 2025 Abstrium SAS <team (at) pydio.com>
+ * This file is part of Pydio Cells.
+ *
+ * Pydio Cells is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * Pydio Cells is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with Pydio Cells.  If not, see <http://www.gnu.org/licenses/>.
+ *
+ * The latest code can be found at <https://pydio.com>.
+ */
+
+package rest
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	restful "github.com/emicklei/go-restful/v3"
+	"go.uber.org/zap"
+	"google.golang.org/protobuf/types/known/anypb"
+
+	"github.com/pydio/cells/v5/broker/mailer"
+	"github.com/pydio/cells/v5/common"
+	"github.com/pydio/cells/v5/common/auth/claim"
+	"github.com/pydio/cells/v5/common/client/commons/idmc"
+	"github.com/pydio/cells/v5/common/client/grpc"
+	"github.com/pydio/cells/v5/common/errors"
+	"github.com/pydio/cells/v5/common/proto/idm"
+	pbmailer "github.com/pydio/cells/v5/common/proto/mailer"
+	"github.com/pydio/cells/v5/common/proto/rest"
+	"github.com/pydio/cells/v5/common/proto/service"
+	"github.com/pydio/cells/v5/common/telemetry/log"
+)
+
+// ListMailQueue implements the corresponding Rest API operation. It is restricted to administrators.
+func (mh *MailerHandler) ListMailQueue(req *restful.Request, rsp *restful.Response) error {
+
+	var request pbmailer.ListQueueRequest
+	if e := req.ReadEntity(&request); e != nil {
+		return e
+	}
+	ctx := req.Request.Context()
+	if claims, ok := claim.FromContext(ctx); !ok || claims.Profile != common.PydioProfileAdmin {
+		return errors.WithMessage(errors.StatusForbidden, "mail queue inspection is restricted to administrators")
+	}
+	response, e := mh.queueClient().ListQueue(ctx, &request)
+	if e != nil {
+		return e
+	}
+	return rsp.WriteEntity(response)
+
+}
+
+// RetryMailQueue implements the corresponding Rest API operation. It is restricted to administrators.
+func (mh *MailerHandler) RetryMailQueue(req *restful.Request, rsp *restful.Response) error {
+
+	var request pbmailer.QueueActionRequest
+	if e := req.ReadEntity(&request); e != nil {
+		return e
+	}
+	ctx := req.Request.Context()
+	if claims, ok := claim.FromContext(ctx); !ok || claims.Profile != common.PydioProfileAdmin {
+		return errors.WithMessage(errors.StatusForbidden, "mail queue actions are restricted to administrators")
+	}
+	response, e := mh.queueClient().RetryQueue(ctx, &request)
+	if e != nil {
+		return e
+	}
+	return rsp.WriteEntity(response)
+
+}
+
+// DiscardMailQueue implements the corresponding Rest API operation. It is restricted to administrators.
+func (mh *MailerHandler) DiscardMailQueue(req *restful.Request, rsp *restful.Response) error {
+
+	var request pbmailer.QueueActionRequest
+	if e := req.ReadEntity(&request); e != nil {
+		return e
+	}
+	ctx := req.Request.Context()
+	if claims, ok := claim.FromContext(ctx); !ok || claims.Profile != common.PydioProfileAdmin {
+		return errors.WithMessage(errors.StatusForbidden, "mail queue actions are restricted to administrators")
+	}
+	response, e := mh.queueClient().DiscardQueue(ctx, &request)
+	if e != nil {
+		return e
+	}
+	return rsp.WriteEntity(response)
+
+}
+
+// ProcessMailBounce parses a DSN message and flags the email address of the users matching
+// a permanently failed recipient. It is restricted to administrators.
+func (mh *MailerHandler) ProcessMailBounce(req *restful.Request, rsp *restful.Response) error {
+
+	var request rest.MailBounceRequest
+	if e := req.ReadEntity(&request); e != nil {
+		return e
+	}
+	ctx := req.Request.Context()
+	if claims, ok := claim.FromContext(ctx); !ok || claims.Profile != common.PydioProfileAdmin {
+		return errors.WithMessage(errors.StatusForbidden, "bounce processing is restricted to administrators")
+	}
+	bounces, e := mailer.ParseDSN([]byte(request.GetMessage()))
+	if e != nil {
+		return errors.Tag(e, errors.InvalidParameters)
+	}
+	response := &rest.MailBounceResponse{}
+	for _, b := range bounces {
+		mb := &rest.MailBounce{
+			Recipient:  b.Recipient,
+			Action:     b.Action,
+			Status:     b.Status,
+			Diagnostic: b.Diagnostic,
+		}
+		if b.Permanent() {
+			logins, er := mh.flagBouncedUsers(ctx, b)
+			if er != nil {
+				return er
+			}
+			mb.Users = logins
+		}
+		response.Bounces = append(response.Bounces, mb)
+	}
+	return rsp.WriteEntity(response)
+
+}
+
+func (mh *MailerHandler) queueClient() pbmailer.MailerServiceClient {
+	return pbmailer.NewMailerServiceClient(grpc.ResolveConn(mh.RuntimeCtx, common.ServiceMailerGRPC))
+}
+
+// flagBouncedUsers sets the idm.UserAttrEmailBounce attribute on all users using the bounced address.
+func (mh *MailerHandler) flagBouncedUsers(ctx context.Context, b *mailer.Bounce) (logins []string, err error) {
+	uCli := idmc.UserServiceClient(ctx)
+	q, _ := anypb.New(&idm.UserSingleQuery{AttributeName: idm.UserAttrEmail, AttributeValue: b.Recipient})
+	stream, e := uCli.SearchUser(ctx, &idm.SearchUserRequest{Query: &service.Query{SubQueries: []*anypb.Any{q}}})
+	if e != nil {
+		return nil, e
+	}
+	var users []*idm.User
+	for {
+		resp, er := stream.Recv()
+		if er != nil {
+			break
+		}
+		users = append(users, resp.GetUser())
+	}
+	flag := fmt.Sprintf("%s %s %s", time.Now().UTC().Format(time.RFC3339), b.Status, b.Diagnostic)
+	for _, u := range users {
+		if u.Attributes == nil {
+			u.Attributes = map[string]string{}
+		}
+		u.Attributes[idm.UserAttrEmailBounce] = flag
+		if _, er := uCli.CreateUser(ctx, &idm.CreateUserRequest{User: u}); er != nil {
+			return nil, er
+		}
+		log.Logger(ctx).Info("Flagged user email address as invalid after bounce", u.ZapLogin(), zap.String("status", b.Status))
+		logins = append(logins, u.GetLogin())
+	}
+	return
+}
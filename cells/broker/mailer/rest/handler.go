@@ -34,6 +34,7 @@ import (
 	"github.com/pydio/cells/v5/common/errors"
 	"github.com/pydio/cells/v5/common/middleware"
 	"github.com/pydio/cells/v5/common/permissions"
+	"github.com/pydio/cells/v5/common/proto/idm"
 	"github.com/pydio/cells/v5/common/proto/mailer"
 	"github.com/pydio/cells/v5/common/telemetry/log"
 	"github.com/pydio/cells/v5/common/utils/i18n/languages"
@@ -116,7 +117,7 @@ func (mh *MailerHandler) Send(req *restful.Request, rsp *restful.Response) error
 			}
 			resolvedTos = append(resolvedTos, resolved)
 		} else {
-			log.Logger(ctx).Error("ignoring sendmail for user as no email was found", zap.Any("user", to))
+			log.Logger(ctx).Error("ignoring sendmail for user as no valid email was found", zap.Any("user", to), zap.Error(e))
 		}
 	}
 	if len(resolvedTos) == 0 {
@@ -145,6 +146,9 @@ func (mh *MailerHandler) ResolveUser(ctx context.Context, user *mailer.User) (*m
 	emailOrAddress := user.Uuid
 	// Check if it's a user Login
 	if u, e := permissions.SearchUniqueUser(ctx, emailOrAddress, ""); e == nil && u != nil {
+		if bounce, has := u.GetAttributes()[idm.UserAttrEmailBounce]; has {
+			return nil, fmt.Errorf("email of user %s was flagged as invalid (%s)", emailOrAddress, bounce)
+		}
 		if email, has := u.GetAttributes()["email"]; has {
 			output := &mailer.User{Uuid: u.GetUuid(), Address: email}
 			if display, has := u.GetAttributes()["displayName"]; has {
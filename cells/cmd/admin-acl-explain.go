@@ -0,0 +1,100 @@
+/*
+ * Copyright (c) 2025 Abstrium SAS <team (at) pydio.com>
+ * This file is part of Pydio Cells.
+ *
+ * Pydio Cells is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * Pydio Cells is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with Pydio Cells.  If not, see <http://www.gnu.org/licenses/>.
+ *
+ * The latest code can be found at <https://pydio.com>.
+ */
+
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/pydio/cells/v5/common/permissions"
+	"github.com/pydio/cells/v5/common/proto/tree"
+)
+
+var (
+	explainLogin    string
+	explainUserUuid string
+	explainPath     string
+	explainNodeUuid string
+	explainScopes   []string
+)
+
+var explainAclCmd = &cobra.Command{
+	Use:   "explain",
+	Short: "Explain the effective permissions of a user on a node",
+	Long: `
+DESCRIPTION
+
+  Compute the effective permissions of a user on a given node, and display how they are resolved: 
+  roles stack, ACLs found on the node and each of its parents (including overridden ones and explicit denies), 
+  workspaces through which the node is visible and evaluated security policies.
+
+  Node is found by its path as stored in the tree (datasource/path/to/node) or by its UUID.
+
+EXAMPLES
+
+  1. Explain why user john can access a folder
+  $ ` + os.Args[0] + ` admin acl explain --user john --path pydiods1/projects/2024
+
+  2. Explain permissions on a node found by UUID, as if john was using a token restricted to this node
+  $ ` + os.Args[0] + ` admin acl explain --user john --node 53a65cc3-e407-4fcc-9230-5630ff054659 --scope "node:53a65cc3-e407-4fcc-9230-5630ff054659:r"
+
+`,
+	PreRunE: func(cmd *cobra.Command, args []string) error {
+		if explainLogin == "" && explainUserUuid == "" {
+			return fmt.Errorf("please provide at least one of --user or --user_uuid")
+		}
+		if explainPath == "" && explainNodeUuid == "" {
+			return fmt.Errorf("please provide at least one of --path or --node")
+		}
+		return nil
+	},
+	RunE: func(cmd *cobra.Command, args []string) error {
+		node := &tree.Node{Uuid: explainNodeUuid, Path: strings.Trim(explainPath, "/")}
+		ex, user, er := permissions.ExplainUserAccess(cmd.Context(), explainLogin, explainUserUuid, node, explainScopes...)
+		if er != nil {
+			return er
+		}
+
+		cmd.Printf("User: %s (%s)\n", user.Login, user.Uuid)
+		if len(ex.Nodes) > 0 {
+			target := ex.Nodes[0].Node
+			cmd.Printf("Node: %s (%s)\n", target.GetPath(), target.GetUuid())
+		}
+		cmd.Println("")
+		for _, line := range ex.Trace {
+			cmd.Println(line)
+		}
+		return nil
+	},
+}
+
+func init() {
+	explainAclCmd.Flags().StringVarP(&explainLogin, "user", "u", "", "User login")
+	explainAclCmd.Flags().StringVar(&explainUserUuid, "user_uuid", "", "User UUID, can be used instead of --user")
+	explainAclCmd.Flags().StringVarP(&explainPath, "path", "p", "", "Node path, as stored in the tree (datasource/path/to/node)")
+	explainAclCmd.Flags().StringVarP(&explainNodeUuid, "node", "n", "", "Node UUID, can be used instead of --path")
+	explainAclCmd.Flags().StringArrayVarP(&explainScopes, "scope", "s", []string{}, "Explain as if user was using a token restricted to these scopes (node:UUID:rw)")
+
+	AclCmd.AddCommand(explainAclCmd)
+}
@@ -0,0 +1,46 @@
+/*
+ * Copyright (c) 2025 Abstrium SAS <team (at) pydio.com>
+ * This file is part of Pydio Cells.
+ *
+ * Pydio Cells is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * Pydio Cells is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with Pydio Cells.  If not, see <http://www.gnu.org/licenses/>.
+ *
+ * The latest code can be found at <https://pydio.com>.
+ */
+
+package cmd
+
+import (
+	"github.com/spf13/cobra"
+)
+
+// AuditCmd groups the commands managing the audit trail
+var AuditCmd = &cobra.Command{
+	Use:   "audit",
+	Short: "Manage the tamper-evident audit trail",
+	Long: `
+DESCRIPTION
+
+  Audit logs are stored by the audit service in an append-only trail, where each record is chained
+  to the previous one by a SHA-256 hash. Records can also be forwarded to syslog servers (RFC 5424,
+  JSON or CEF payloads) or to rotated JSON lines files, by listing exporters URLs in the
+  services/pydio.grpc.audit/exporters configuration.
+`,
+	Run: func(cmd *cobra.Command, args []string) {
+		cmd.Help()
+	},
+}
+
+func init() {
+	AdminCmd.AddCommand(AuditCmd)
+}
@@ -0,0 +1,115 @@
+/*
+ * Copyright (c) 2025 Abstrium SAS <team (at) pydio.com>
+ * This file is part of Pydio Cells.
+ *
+ * Pydio Cells is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * Pydio Cells is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with Pydio Cells.  If not, see <http://www.gnu.org/licenses/>.
+ *
+ * The latest code can be found at <https://pydio.com>.
+ */
+
+package cmd
+
+import (
+	"os"
+
+	"github.com/manifoldco/promptui"
+	"github.com/spf13/cobra"
+	"google.golang.org/protobuf/types/known/anypb"
+
+	"github.com/pydio/cells/v5/common/client/commons/jobsc"
+	"github.com/pydio/cells/v5/common/proto/jobs"
+	"github.com/pydio/cells/v5/common/proto/object"
+	"github.com/pydio/cells/v5/common/proto/service"
+	"github.com/pydio/cells/v5/common/utils/uuid"
+)
+
+var (
+	rotateKeyDsName   string
+	rotateKeyUserName string
+	rotateKeyID       string
+	rotateKeyLabel    string
+	rotateKeyTimeout  string
+)
+
+var dsRotateKeyCmd = &cobra.Command{
+	Use:   "rotate-key",
+	Short: "Rotate the master key of an encrypted datasource",
+	Long: `
+DESCRIPTION
+
+  Create a new master key for an encrypted datasource and re-encrypt all files keys with it. Files contents are
+  not re-encrypted. This operation is launched in scheduler: previous keys are kept readable until all files are
+  processed, and the job can be safely launched again with the same key if it was interrupted.
+
+EXAMPLES
+
+  1. Rotate "pydiods1" datasource key with a generated key ID:
+  $ ` + os.Args[0] + ` admin datasource rotate-key --datasource=pydiods1 --username=admin
+
+  2. Rotate to a specific key ID (created if it does not exist):
+  $ ` + os.Args[0] + ` admin datasource rotate-key --datasource=pydiods1 --username=admin --key=pydiods1-2025
+
+`,
+	Run: func(cmd *cobra.Command, args []string) {
+		if rotateKeyDsName == "" || rotateKeyUserName == "" {
+			cmd.Println("Please provide at least a datasource name (--datasource) and an admin user name")
+			cmd.Help()
+			return
+		}
+
+		dsq, _ := anypb.New(&object.DataSourceSingleQuery{
+			Name: rotateKeyDsName,
+		})
+
+		ctx := cmd.Context()
+		job := &jobs.Job{
+			ID:        uuid.New(),
+			Owner:     rotateKeyUserName,
+			Label:     "Rotate encryption key for datasource " + rotateKeyDsName,
+			AutoStart: true,
+			AutoClean: true,
+			Actions: []*jobs.Action{
+				{
+					ID: "actions.tree.ds-rotate-key",
+					DataSourceSelector: &jobs.DataSourceSelector{
+						Query: &service.Query{
+							SubQueries: []*anypb.Any{dsq},
+						},
+						Collect: true,
+					},
+					Parameters: map[string]string{
+						"keyId":    rotateKeyID,
+						"keyLabel": rotateKeyLabel,
+					},
+				},
+			},
+			Timeout: rotateKeyTimeout,
+		}
+
+		if _, err := jobsc.JobServiceClient(ctx).PutJob(ctx, &jobs.PutJobRequest{Job: job}); err != nil {
+			cmd.Println(promptui.IconBad + " [ERROR] " + err.Error())
+		} else {
+			cmd.Println(promptui.IconGood + " [SUCCESS] Posted job for rotating datasource key. You can monitor the job in the scheduler.")
+		}
+	},
+}
+
+func init() {
+	dsRotateKeyCmd.PersistentFlags().StringVarP(&rotateKeyDsName, "datasource", "d", "", "Name of the encrypted datasource")
+	dsRotateKeyCmd.PersistentFlags().StringVarP(&rotateKeyUserName, "username", "u", "", "Username under which the job will be executed (generally admin)")
+	dsRotateKeyCmd.PersistentFlags().StringVarP(&rotateKeyID, "key", "k", "", "ID of the new key (generated if empty)")
+	dsRotateKeyCmd.PersistentFlags().StringVarP(&rotateKeyLabel, "label", "l", "", "Label of the new key")
+	dsRotateKeyCmd.PersistentFlags().StringVarP(&rotateKeyTimeout, "timeout", "t", "2h", "Maximum job duration")
+	DataSourceCmd.AddCommand(dsRotateKeyCmd)
+}
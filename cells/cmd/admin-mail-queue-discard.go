@@ -0,0 +1,58 @@
+/*
+ * Copyright (c) 2025 Abstrium SAS <team (at) pydio.com>
+ * This file is part of Pydio Cells.
+ *
+ * Pydio Cells is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * Pydio Cells is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with Pydio Cells.  If not, see <http://www.gnu.org/licenses/>.
+ *
+ * The latest code can be found at <https://pydio.com>.
+ */
+
+package cmd
+
+import (
+	"os"
+
+	"github.com/spf13/cobra"
+
+	"github.com/pydio/cells/v5/common/proto/mailer"
+)
+
+var mailQueueDiscardCmd = &cobra.Command{
+	Use:   "discard",
+	Short: "Remove mails from the queue",
+	Long: `
+DESCRIPTION
+
+  Definitively remove the selected mails from the queue, they will not be sent.
+
+EXAMPLES
+
+  1. Discard all mails flagged as failed
+  $ ` + os.Args[0] + ` admin mail queue discard --failed
+
+  2. Discard specific mails
+  $ ` + os.Args[0] + ` admin mail queue discard --uuid 4f1e2c5a-8d3b-4b7e-9c61-2a0d5e7f8b90 --yes
+
+`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return runMailQueueAction(cmd, "discard", func(req *mailer.QueueActionRequest) (*mailer.QueueActionResponse, error) {
+			return mailerClient(cmd).DiscardQueue(cmd.Context(), req)
+		})
+	},
+}
+
+func init() {
+	addMailQueueSelectionFlags(mailQueueDiscardCmd)
+	mailQueueCmd.AddCommand(mailQueueDiscardCmd)
+}
@@ -0,0 +1,72 @@
+/*
+ * Copyright (c) 2025 Abstrium SAS <team (at) pydio.com>
+ * This file is part of Pydio Cells.
+ *
+ * Pydio Cells is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * Pydio Cells is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with Pydio Cells.  If not, see <http://www.gnu.org/licenses/>.
+ *
+ * The latest code can be found at <https://pydio.com>.
+ */
+
+package cmd
+
+import (
+	"os"
+
+	"github.com/spf13/cobra"
+
+	"github.com/pydio/cells/v5/common/proto/mailer"
+)
+
+var mailQueueListCmd = &cobra.Command{
+	Use:   "list",
+	Short: "List mails waiting in the queue",
+	Long: `
+DESCRIPTION
+
+  List mails currently waiting in the queue, with their number of retries and the last delivery error.
+
+EXAMPLES
+
+  1. List all queued mails
+  $ ` + os.Args[0] + ` admin mail queue list
+
+  2. List only mails that reached the maximum number of retries
+  $ ` + os.Args[0] + ` admin mail queue list --failed
+
+`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		resp, er := mailerClient(cmd).ListQueue(cmd.Context(), &mailer.ListQueueRequest{
+			FailedOnly: mailQueueFailedOnly,
+			Offset:     mailQueueOffset,
+			Limit:      mailQueueLimit,
+		})
+		if er != nil {
+			return er
+		}
+		if len(resp.GetMails()) == 0 {
+			cmd.Println("No mail found in queue")
+			return nil
+		}
+		renderMailQueue(cmd, resp.GetMails())
+		cmd.Printf("Showing %d mail(s) out of %d\n", len(resp.GetMails()), resp.GetTotal())
+		return nil
+	},
+}
+
+func init() {
+	mailQueueListCmd.Flags().BoolVarP(&mailQueueFailedOnly, "failed", "f", false, "Only list mails flagged as failed")
+	mailQueueListCmd.Flags().Int32VarP(&mailQueueOffset, "offset", "o", 0, "Start listing at a given position")
+	mailQueueListCmd.Flags().Int32VarP(&mailQueueLimit, "limit", "l", 50, "Maximum number of mails to list")
+	mailQueueCmd.AddCommand(mailQueueListCmd)
+}
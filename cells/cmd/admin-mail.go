@@ -0,0 +1,147 @@
+/*
+ * Copyright (c) 2025 Abstrium SAS <team (at) pydio.com>
+ * This file is part of Pydio Cells.
+ *
+ * Pydio Cells is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * Pydio Cells is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with Pydio Cells.  If not, see <http://www.gnu.org/licenses/>.
+ *
+ * The latest code can be found at <https://pydio.com>.
+ */
+
+package cmd
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	p "github.com/manifoldco/promptui"
+	"github.com/olekukonko/tablewriter"
+	"github.com/spf13/cobra"
+
+	"github.com/pydio/cells/v5/common"
+	"github.com/pydio/cells/v5/common/client/grpc"
+	"github.com/pydio/cells/v5/common/errors"
+	"github.com/pydio/cells/v5/common/proto/mailer"
+)
+
+var (
+	mailQueueFailedOnly bool
+	mailQueueOffset     int32
+	mailQueueLimit      int32
+	mailQueueUuids      []string
+	mailQueueAllFailed  bool
+	mailQueueAssumeYes  bool
+)
+
+// MailCmd groups the commands managing the outgoing mails of the mailer service
+var MailCmd = &cobra.Command{
+	Use:   "mail",
+	Short: "Manage outgoing mails",
+	Long: `
+DESCRIPTION
+
+  Manage the outgoing mails of the mailer service.
+`,
+	Run: func(cmd *cobra.Command, args []string) {
+		cmd.Help()
+	},
+}
+
+var mailQueueCmd = &cobra.Command{
+	Use:   "queue",
+	Short: "Inspect and manage the mails queue",
+	Long: `
+DESCRIPTION
+
+  Mails are queued and sent periodically by the mailer service. Failed deliveries are retried with an
+  exponential delay until the maximum number of retries is reached: mails are then flagged as failed
+  and stay in the queue until they are retried or discarded with the commands below.
+`,
+	Run: func(cmd *cobra.Command, args []string) {
+		cmd.Help()
+	},
+}
+
+func init() {
+	MailCmd.AddCommand(mailQueueCmd)
+	AdminCmd.AddCommand(MailCmd)
+}
+
+/* Package protected utility methods that are used by the various mail subcommands */
+
+func mailerClient(cmd *cobra.Command) mailer.MailerServiceClient {
+	return mailer.NewMailerServiceClient(grpc.ResolveConn(cmd.Context(), common.ServiceMailerGRPC))
+}
+
+func addMailQueueSelectionFlags(cmd *cobra.Command) {
+	cmd.Flags().StringSliceVarP(&mailQueueUuids, "uuid", "u", []string{}, "Apply to these mails uuids")
+	cmd.Flags().BoolVarP(&mailQueueAllFailed, "failed", "f", false, "Apply to all mails flagged as failed")
+	cmd.Flags().BoolVarP(&mailQueueAssumeYes, "yes", "y", false, "Do not ask for confirmation")
+}
+
+func renderMailQueue(cmd *cobra.Command, mails []*mailer.Mail) {
+	table := tablewriter.NewWriter(cmd.OutOrStdout())
+	table.SetHeader([]string{"UUID", "To", "Subject", "Retries", "Status", "Last Error"})
+	for _, m := range mails {
+		var tos []string
+		for _, to := range m.GetTo() {
+			if to.GetAddress() != "" {
+				tos = append(tos, to.GetAddress())
+			} else {
+				tos = append(tos, to.GetUuid())
+			}
+		}
+		subject := m.GetSubject()
+		if subject == "" {
+			subject = m.GetTemplateId()
+		}
+		status := "Pending"
+		if m.GetFailed() {
+			status = "Failed"
+		} else if m.GetNextAttempt() > time.Now().Unix() {
+			status = "Retry at " + time.Unix(m.GetNextAttempt(), 0).Format("2006-01-02 15:04")
+		}
+		var lastErr string
+		if errs := m.GetSendErrors(); len(errs) > 0 {
+			lastErr = errs[len(errs)-1]
+		}
+		table.Append([]string{m.GetUuid(), strings.Join(tos, "\n"), subject, fmt.Sprintf("%d", m.GetRetries()), status, lastErr})
+	}
+	table.Render()
+}
+
+// runMailQueueAction asks for confirmation and applies a retry or discard action on the selected mails.
+func runMailQueueAction(cmd *cobra.Command, verb string, action func(*mailer.QueueActionRequest) (*mailer.QueueActionResponse, error)) error {
+	if len(mailQueueUuids) == 0 && !mailQueueAllFailed {
+		return errors.New("please provide a list of uuids or use the --failed flag")
+	}
+	if !mailQueueAssumeYes {
+		target := fmt.Sprintf("%d mail(s)", len(mailQueueUuids))
+		if mailQueueAllFailed {
+			target = "all failed mails"
+		}
+		q := fmt.Sprintf("You are about to %s %s, are you sure you want to proceed", verb, target)
+		confirm := p.Prompt{Label: q, IsConfirm: true}
+		// Always returns an error if the end user does not confirm
+		if _, e := confirm.Run(); e != nil {
+			return nil
+		}
+	}
+	resp, er := action(&mailer.QueueActionRequest{Uuids: mailQueueUuids, AllFailed: mailQueueAllFailed})
+	if er != nil {
+		return er
+	}
+	cmd.Printf("Applied %s on %d mail(s)\n", verb, resp.GetCount())
+	return nil
+}
@@ -0,0 +1,53 @@
+/*
+ * Copyright (c) 2025 Abstrium SAS <team (at) pydio.com>
+ * This file is part of Pydio Cells.
+ *
+ * Pydio Cells is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * Pydio Cells is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with Pydio Cells.  If not, see <http://www.gnu.org/licenses/>.
+ *
+ * The latest code can be found at <https://pydio.com>.
+ */
+
+package cmd
+
+import (
+	"os"
+
+	"github.com/spf13/cobra"
+
+	"github.com/pydio/cells/v5/common/proto/rest"
+)
+
+var shareDeleteCmd = &cobra.Command{
+	Use:   "delete",
+	Short: "Delete Cells and public links",
+	Long: `
+DESCRIPTION
+
+  Definitively delete a set of public links or Cells, selected by uuid or by filters (see the list command).
+
+EXAMPLES
+
+  1. Delete all public links that expired before 2024
+  $ ` + os.Args[0] + ` admin share delete --type link --expires-before 2024-01-01
+
+`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return runShareBulkAction(cmd, rest.ShareBulkActionRequest_DELETE, "")
+	},
+}
+
+func init() {
+	addShareSelectionFlags(shareDeleteCmd)
+	ShareCmd.AddCommand(shareDeleteCmd)
+}
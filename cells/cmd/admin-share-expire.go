@@ -0,0 +1,57 @@
+/*
+ * Copyright (c) 2025 Abstrium SAS <team (at) pydio.com>
+ * This file is part of Pydio Cells.
+ *
+ * Pydio Cells is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * Pydio Cells is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with Pydio Cells.  If not, see <http://www.gnu.org/licenses/>.
+ *
+ * The latest code can be found at <https://pydio.com>.
+ */
+
+package cmd
+
+import (
+	"os"
+
+	"github.com/spf13/cobra"
+
+	"github.com/pydio/cells/v5/common/proto/rest"
+)
+
+var shareExpireCmd = &cobra.Command{
+	Use:   "expire",
+	Short: "Expire Cells and public links",
+	Long: `
+DESCRIPTION
+
+  Expire a set of public links or Cells, selected by uuid or by filters (see the list command).
+  Links expiration date is set to now. Cells members but the owner lose their access to the Cell.
+
+EXAMPLES
+
+  1. Expire all public links owned by a departing user
+  $ ` + os.Args[0] + ` admin share expire --type link --owner alice
+
+  2. Expire a specific share without confirmation
+  $ ` + os.Args[0] + ` admin share expire --uuid 0a1b2c3d-... --yes
+
+`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return runShareBulkAction(cmd, rest.ShareBulkActionRequest_EXPIRE, "")
+	},
+}
+
+func init() {
+	addShareSelectionFlags(shareExpireCmd)
+	ShareCmd.AddCommand(shareExpireCmd)
+}
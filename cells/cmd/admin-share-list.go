@@ -0,0 +1,77 @@
+/*
+ * Copyright (c) 2025 Abstrium SAS <team (at) pydio.com>
+ * This file is part of Pydio Cells.
+ *
+ * Pydio Cells is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * Pydio Cells is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with Pydio Cells.  If not, see <http://www.gnu.org/licenses/>.
+ *
+ * The latest code can be found at <https://pydio.com>.
+ */
+
+package cmd
+
+import (
+	"os"
+
+	"github.com/spf13/cobra"
+
+	"github.com/pydio/cells/v5/idm/share"
+)
+
+var shareListCmd = &cobra.Command{
+	Use:   "list",
+	Short: "List Cells and public links of all users",
+	Long: `
+DESCRIPTION
+
+  List all Cells and public links of the instance, optionally filtered by owner, workspace,
+  datasource path prefix, expiration date or target users.
+
+EXAMPLES
+
+  1. List all shares
+  $ ` + os.Args[0] + ` admin share list
+
+  2. List public links owned by alice
+  $ ` + os.Args[0] + ` admin share list --type link --owner alice
+
+  3. List shares of the "common-files" workspace expiring in the next week
+  $ ` + os.Args[0] + ` admin share list --workspace common-files --expires-before 168h
+
+  4. List shares that never expire and are targeted to bob
+  $ ` + os.Args[0] + ` admin share list --no-expiration --target bob
+
+`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		req, er := shareInventoryRequest()
+		if er != nil {
+			return er
+		}
+		shares, er := share.NewClient(nil).Inventory(cmd.Context(), req)
+		if er != nil {
+			return er
+		}
+		if len(shares) == 0 {
+			cmd.Println("No result found")
+			return nil
+		}
+		renderShareInventory(cmd, shares)
+		cmd.Printf("Showing %d share(s)\n", len(shares))
+		return nil
+	},
+}
+
+func init() {
+	addShareFilterFlags(shareListCmd)
+	ShareCmd.AddCommand(shareListCmd)
+}
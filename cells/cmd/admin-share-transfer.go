@@ -0,0 +1,64 @@
+/*
+ * Copyright (c) 2025 Abstrium SAS <team (at) pydio.com>
+ * This file is part of Pydio Cells.
+ *
+ * Pydio Cells is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * Pydio Cells is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with Pydio Cells.  If not, see <http://www.gnu.org/licenses/>.
+ *
+ * The latest code can be found at <https://pydio.com>.
+ */
+
+package cmd
+
+import (
+	"os"
+
+	"github.com/spf13/cobra"
+
+	"github.com/pydio/cells/v5/common/errors"
+	"github.com/pydio/cells/v5/common/proto/rest"
+)
+
+var shareTransferTo string
+
+var shareTransferCmd = &cobra.Command{
+	Use:   "transfer",
+	Short: "Transfer ownership of Cells and public links",
+	Long: `
+DESCRIPTION
+
+  Give ownership of a set of public links or Cells, selected by uuid or by filters (see the list command),
+  to another user.
+
+EXAMPLES
+
+  1. Transfer all shares of a departing user to another user
+  $ ` + os.Args[0] + ` admin share transfer --owner alice --to bob
+
+`,
+	PreRunE: func(cmd *cobra.Command, args []string) error {
+		if shareTransferTo == "" {
+			return errors.New("missing argument: please provide the new owner with --to")
+		}
+		return nil
+	},
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return runShareBulkAction(cmd, rest.ShareBulkActionRequest_TRANSFER, shareTransferTo)
+	},
+}
+
+func init() {
+	addShareSelectionFlags(shareTransferCmd)
+	shareTransferCmd.Flags().StringVar(&shareTransferTo, "to", "", "Login or uuid of the new owner")
+	ShareCmd.AddCommand(shareTransferCmd)
+}
@@ -0,0 +1,189 @@
+/*
+ * Copyright (c) 2025 Abstrium SAS <team (at) pydio.com>
+ * This file is part of Pydio Cells.
+ *
+ * Pydio Cells is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * Pydio Cells is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with Pydio Cells.  If not, see <http://www.gnu.org/licenses/>.
+ *
+ * The latest code can be found at <https://pydio.com>.
+ */
+
+package cmd
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	p "github.com/manifoldco/promptui"
+	"github.com/olekukonko/tablewriter"
+	"github.com/spf13/cobra"
+
+	"github.com/pydio/cells/v5/common/errors"
+	"github.com/pydio/cells/v5/common/proto/rest"
+	"github.com/pydio/cells/v5/idm/share"
+)
+
+var (
+	shareType          string
+	shareOwner         string
+	shareWorkspace     string
+	sharePathPrefix    string
+	shareExpiresBefore string
+	shareNoExpiration  bool
+	shareTargetUser    string
+	shareUuids         []string
+	shareAssumeYes     bool
+)
+
+// ShareCmd groups the commands managing Cells and public links of all users
+var ShareCmd = &cobra.Command{
+	Use:   "share",
+	Short: "Manage Cells and public links of all users",
+	Long: `
+DESCRIPTION
+
+  List all Cells and public links of the instance, whoever owns them, and apply bulk actions
+  to them: expire, transfer ownership to another user or delete. Each action is recorded in the audit log.
+`,
+	Run: func(cmd *cobra.Command, args []string) {
+		cmd.Help()
+	},
+}
+
+func init() {
+	AdminCmd.AddCommand(ShareCmd)
+}
+
+/* Package protected utility methods that are used by the various share subcommands */
+
+func addShareFilterFlags(cmd *cobra.Command) {
+	cmd.Flags().StringVarP(&shareType, "type", "t", "", "Restrict to a type of share (link|cell)")
+	cmd.Flags().StringVarP(&shareOwner, "owner", "o", "", "Restrict to shares owned by this user (login or uuid)")
+	cmd.Flags().StringVarP(&shareWorkspace, "workspace", "w", "", "Restrict to shares whose root is inside this workspace (uuid or slug)")
+	cmd.Flags().StringVarP(&sharePathPrefix, "path", "p", "", "Restrict to shares whose root is under this datasource path (e.g. pydiods1/projects)")
+	cmd.Flags().StringVarP(&shareExpiresBefore, "expires-before", "e", "", "Restrict to shares expiring before a date (YYYY-MM-DD) or a duration from now (e.g. 72h)")
+	cmd.Flags().BoolVarP(&shareNoExpiration, "no-expiration", "n", false, "Restrict to shares that never expire")
+	cmd.Flags().StringVarP(&shareTargetUser, "target", "r", "", "Restrict to shares targeting this user (login, uuid or email)")
+}
+
+func addShareSelectionFlags(cmd *cobra.Command) {
+	addShareFilterFlags(cmd)
+	cmd.Flags().StringSliceVarP(&shareUuids, "uuid", "u", []string{}, "Apply to these shares uuids instead of using filters")
+	cmd.Flags().BoolVarP(&shareAssumeYes, "yes", "y", false, "Do not ask for confirmation")
+}
+
+func shareInventoryRequest() (*rest.ShareInventoryRequest, error) {
+	req := &rest.ShareInventoryRequest{
+		Owner:        shareOwner,
+		Workspace:    shareWorkspace,
+		PathPrefix:   sharePathPrefix,
+		NoExpiration: shareNoExpiration,
+		TargetUser:   shareTargetUser,
+	}
+	switch strings.ToLower(shareType) {
+	case "":
+	case "link", "links":
+		req.ShareType = rest.ListSharedResourcesRequest_LINKS
+	case "cell", "cells":
+		req.ShareType = rest.ListSharedResourcesRequest_CELLS
+	default:
+		return nil, errors.New("invalid type, use one of link or cell")
+	}
+	if shareExpiresBefore != "" {
+		if d, e := time.ParseDuration(shareExpiresBefore); e == nil {
+			req.ExpiresBefore = time.Now().Add(d).Unix()
+		} else if t, e := time.ParseInLocation("2006-01-02", shareExpiresBefore, time.Local); e == nil {
+			req.ExpiresBefore = t.Unix()
+		} else {
+			return nil, errors.New("cannot parse expires-before, use a date (YYYY-MM-DD) or a duration (72h)")
+		}
+	}
+	return req, nil
+}
+
+func renderShareInventory(cmd *cobra.Command, shares []*rest.ShareInventoryEntry) {
+	table := tablewriter.NewWriter(cmd.OutOrStdout())
+	table.SetHeader([]string{"Type", "Label", "Owner", "Roots", "Expires", "Targets", "UUID"})
+	for _, s := range shares {
+		typ := "Cell"
+		if s.GetType() == rest.ListSharedResourcesRequest_LINKS {
+			typ = "Link"
+		}
+		exp := ""
+		if s.GetAccessEnd() > 0 {
+			exp = time.Unix(s.GetAccessEnd(), 0).Format("2006-01-02 15:04")
+		}
+		table.Append([]string{typ, s.GetLabel(), s.GetOwnerLogin(), strings.Join(s.GetRootPaths(), "\n"), exp, strings.Join(s.GetTargetUsers(), ", "), s.GetUuid()})
+	}
+	table.Render()
+}
+
+// runShareBulkAction resolves the shares selected by flags, asks for confirmation and applies the action.
+func runShareBulkAction(cmd *cobra.Command, action rest.ShareBulkActionRequest_ShareBulkAction, targetOwner string) error {
+	ctx := cmd.Context()
+	sc := share.NewClient(nil)
+
+	uuids := shareUuids
+	if len(uuids) == 0 {
+		if shareType == "" && shareOwner == "" && shareWorkspace == "" && sharePathPrefix == "" && shareExpiresBefore == "" && !shareNoExpiration && shareTargetUser == "" {
+			return errors.New("please provide at least one filter or a list of uuids")
+		}
+		req, er := shareInventoryRequest()
+		if er != nil {
+			return er
+		}
+		shares, er := sc.Inventory(ctx, req)
+		if er != nil {
+			return er
+		}
+		if len(shares) == 0 {
+			cmd.Println("No share matches these filters")
+			return nil
+		}
+		renderShareInventory(cmd, shares)
+		for _, s := range shares {
+			uuids = append(uuids, s.GetUuid())
+		}
+	}
+
+	if !shareAssumeYes {
+		q := fmt.Sprintf("You are about to %s %d share(s), are you sure you want to proceed", strings.ToLower(action.String()), len(uuids))
+		confirm := p.Prompt{Label: q, IsConfirm: true}
+		// Always returns an error if the end user does not confirm
+		if _, e := confirm.Run(); e != nil {
+			return nil
+		}
+	}
+
+	resp, er := sc.BulkAction(ctx, &rest.ShareBulkActionRequest{Action: action, Uuids: uuids, TargetOwner: targetOwner})
+	if er != nil {
+		return er
+	}
+	var failed int
+	table := tablewriter.NewWriter(cmd.OutOrStdout())
+	table.SetHeader([]string{"UUID", "Result"})
+	for _, r := range resp.GetResults() {
+		res := "OK"
+		if !r.GetSuccess() {
+			res = r.GetError()
+			failed++
+		}
+		table.Append([]string{r.GetUuid(), res})
+	}
+	table.Render()
+	if failed > 0 {
+		return fmt.Errorf("%d share(s) could not be processed", failed)
+	}
+	return nil
+}
@@ -0,0 +1,140 @@
+/*
+ * Copyright (c) 2025 Abstrium SAS <team (at) pydio.com>
+ * This file is part of Pydio Cells.
+ *
+ * Pydio Cells is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * Pydio Cells is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with Pydio Cells.  If not, see <http://www.gnu.org/licenses/>.
+ *
+ * The latest code can be found at <https://pydio.com>.
+ */
+
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/pydio/cells/v5/common/client/commons/idmc"
+	"github.com/pydio/cells/v5/common/errors"
+	"github.com/pydio/cells/v5/common/proto/idm"
+	"github.com/pydio/cells/v5/idm/user/guest"
+)
+
+var (
+	guestExtendLogin string
+	guestExtendDays  int
+	guestFrom        string
+	guestTo          string
+)
+
+var userGuestCmd = &cobra.Command{
+	Use:   "guest",
+	Short: "Manage external users lifecycle",
+	Long: `
+DESCRIPTION
+
+  External users are sponsored by the user who created them and expire after the lifetime configured in
+  the Delegation parameters of the authentication plugin. Sponsors are reminded by email before expiration,
+  then guests are disabled and finally deleted after a grace period.
+`,
+	Run: func(cmd *cobra.Command, args []string) {
+		cmd.Help()
+	},
+}
+
+var userGuestExtendCmd = &cobra.Command{
+	Use:   "extend",
+	Short: "Extend the validity of an external user",
+	Long: fmt.Sprintf(`
+DESCRIPTION
+
+  Set a new expiration date for an external user, counted from now. If the user was already disabled
+  on expiration, it is enabled again.
+
+EXAMPLE
+
+  $ %s admin user guest extend -u LOGIN -d 30
+
+`, os.Args[0]),
+	PreRunE: func(cmd *cobra.Command, args []string) error {
+		if guestExtendLogin == "" || guestExtendDays <= 0 {
+			return errors.New("Missing arguments")
+		}
+		return nil
+	},
+	RunE: func(cmd *cobra.Command, args []string) error {
+		ctx := cmd.Context()
+		client := idmc.UserServiceClient(ctx)
+		users, err := searchUser(ctx, client, guestExtendLogin)
+		if err != nil {
+			return err
+		}
+		if len(users) == 0 {
+			return fmt.Errorf("cannot find user %s", guestExtendLogin)
+		}
+		u := users[0]
+		if !guest.IsGuest(u) {
+			return fmt.Errorf("user %s is not an external user", u.GetLogin())
+		}
+		until := time.Now().Add(time.Duration(guestExtendDays) * 24 * time.Hour)
+		guest.Extend(u, until)
+		if _, err := client.CreateUser(ctx, &idm.CreateUserRequest{User: u}); err != nil {
+			return err
+		}
+		cmd.Printf("User %s will now expire on %s\n", u.GetLogin(), until.Format("2006-01-02"))
+		return nil
+	},
+}
+
+var userGuestTransferCmd = &cobra.Command{
+	Use:   "transfer",
+	Short: "Transfer the sponsorship of external users",
+	Long: fmt.Sprintf(`
+DESCRIPTION
+
+  Hand over all the users sponsored by a user to another sponsor, who will receive the expiration
+  reminders. Guests of deleted users are automatically transferred to the fallback sponsor if one is
+  configured.
+
+EXAMPLE
+
+  $ %s admin user guest transfer --from LEAVING --to MANAGER
+
+`, os.Args[0]),
+	PreRunE: func(cmd *cobra.Command, args []string) error {
+		if guestFrom == "" || guestTo == "" {
+			return errors.New("Missing arguments")
+		}
+		return nil
+	},
+	RunE: func(cmd *cobra.Command, args []string) error {
+		n, err := guest.Transfer(cmd.Context(), guestFrom, guestTo)
+		if err != nil {
+			return err
+		}
+		cmd.Printf("Transferred %d user(s) from %s to %s\n", n, guestFrom, guestTo)
+		return nil
+	},
+}
+
+func init() {
+	userGuestExtendCmd.Flags().StringVarP(&guestExtendLogin, "username", "u", "", "Login of the external user")
+	userGuestExtendCmd.Flags().IntVarP(&guestExtendDays, "days", "d", 0, "Number of days of validity from now")
+	userGuestTransferCmd.Flags().StringVar(&guestFrom, "from", "", "Login of the current sponsor")
+	userGuestTransferCmd.Flags().StringVar(&guestTo, "to", "", "Login of the new sponsor")
+	userGuestCmd.AddCommand(userGuestExtendCmd, userGuestTransferCmd)
+	UserCmd.AddCommand(userGuestCmd)
+}
@@ -39,6 +39,18 @@ const (
 
 type contextKey string
 
+// Authentication methods returned by Claims.AuthMethod
+const (
+	AuthMethodPassword = "password"
+	AuthMethodToken    = "token"
+	AuthMethodSSO      = "sso"
+	AuthMethodMFA      = "mfa"
+)
+
+// ScopeFeedRead restricts a token to reading the activity feeds: such tokens are only accepted
+// on the feed endpoints and cannot be used against the rest of the API.
+const ScopeFeedRead = "feed:read"
+
 type Claims struct {
 	ClientApp      interface{} `json:"aud" mapstructure:"aud"`
 	Issuer         string      `json:"iss" mapstructure:"iss"`
@@ -57,6 +69,7 @@ type Claims struct {
 	GroupPath      string      `json:"groupPath" mapstructure:"groupPath"`
 	ProvidesScopes bool        `json:"providesScopes" mapstructure:"providesScopes"`
 	Scopes         []string    `json:"scopes" mapstructure:"scopes"`
+	AuthMethods    []string    `json:"amr,omitempty" mapstructure:"amr"`
 
 	secretPair string
 }
@@ -72,6 +85,41 @@ func (c *Claims) GetClientApp() string {
 	}
 }
 
+// AuthMethod sums up how the user was authenticated, as one of the AuthMethodXXX values. It relies on the
+// "amr" claim when the identity provider sends it, and falls back to the user AuthSource otherwise.
+func (c *Claims) AuthMethod() string {
+	if c.GetClientApp() == common.ServiceGrpcNamespace_+common.ServiceToken {
+		return AuthMethodToken
+	}
+	var sso bool
+	for _, m := range c.AuthMethods {
+		switch m {
+		case "mfa", "otp", "hwk", "swk", "sms":
+			return AuthMethodMFA
+		case "fed", "sso":
+			sso = true
+		}
+	}
+	if sso || (c.AuthSource != "" && c.AuthSource != "pydio") {
+		return AuthMethodSSO
+	}
+	return AuthMethodPassword
+}
+
+// AuthMethodStrength orders authentication methods, from 0 for an unknown method to 3 for multi-factor.
+func AuthMethodStrength(method string) int {
+	switch method {
+	case AuthMethodPassword, AuthMethodToken:
+		return 1
+	case AuthMethodSSO:
+		return 2
+	case AuthMethodMFA:
+		return 3
+	default:
+		return 0
+	}
+}
+
 // GetUniqueKey returns a key that is unique to a given claim value
 func (c *Claims) GetUniqueKey() string {
 	hash := md5.New()
@@ -88,6 +136,19 @@ func (c *Claims) GetUniqueKey() string {
 
 }
 
+// HasScope checks if the claims are restricted to the given scope.
+func (c *Claims) HasScope(scope string) bool {
+	if !c.ProvidesScopes {
+		return false
+	}
+	for _, s := range c.Scopes {
+		if s == scope {
+			return true
+		}
+	}
+	return false
+}
+
 func (c *Claims) AttachSecretPair(sp string) {
 	c.secretPair = sp
 }
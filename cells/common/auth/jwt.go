@@ -129,7 +129,8 @@ func VerifyContext(ctx context.Context, user *idm.User) error {
 }
 
 type JWTVerifier struct {
-	types []ProviderType
+	types    []ProviderType
+	feedOnly bool
 }
 
 // DefaultJWTVerifier creates a ready to use JWTVerifier
@@ -146,6 +147,15 @@ func PATOnlyVerifier() *JWTVerifier {
 	}
 }
 
+// FeedVerifier only accepts personal access tokens restricted to the claim.ScopeFeedRead scope.
+// Other verifiers refuse such tokens, so that a feed URL leaked in logs cannot be used on the rest of the API.
+func FeedVerifier() *JWTVerifier {
+	return &JWTVerifier{
+		types:    []ProviderType{ProviderTypePAT},
+		feedOnly: true,
+	}
+}
+
 func (j *JWTVerifier) getProviders() []Provider {
 	var res []Provider
 	for _, provider := range providers {
@@ -319,6 +329,15 @@ func (j *JWTVerifier) Verify(ctx context.Context, rawIDToken string) (context.Co
 		return ctx, *claims, err
 	}
 
+	if claims.HasScope(claim.ScopeFeedRead) != j.feedOnly {
+		return ctx, claim.Claims{}, errors.WithMessage(errors.StatusUnauthorized, "token scope is not valid for this request")
+	}
+	if j.feedOnly {
+		// Feed scope is enforced by the verifier itself, do not let it restrict nodes access
+		claims.ProvidesScopes = false
+		claims.Scopes = nil
+	}
+
 	ctx = claim.ToContext(ctx, *claims)
 
 	return ctx, *claims, nil
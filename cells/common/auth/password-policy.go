@@ -0,0 +1,171 @@
+/*
+ * Copyright (c) 2025 Abstrium SAS <team (at) pydio.com>
+ * This file is part of Pydio Cells.
+ *
+ * Pydio Cells is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * Pydio Cells is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with Pydio Cells.  If not, see <http://www.gnu.org/licenses/>.
+ *
+ * The latest code can be found at <https://pydio.com>.
+ */
+
+package auth
+
+import (
+	"bufio"
+	"context"
+	"crypto/sha1"
+	"encoding/hex"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/pydio/cells/v5/common/config"
+	"github.com/pydio/cells/v5/common/errors"
+)
+
+const (
+	// DefaultMaxFailedLogins is the number of consecutive failed logins after which a user is locked,
+	// when PASSWORD_MAX_FAILED_LOGINS is not configured.
+	DefaultMaxFailedLogins = 10
+	// MaxPasswordHistory is the maximum number of previous password hashes kept per user.
+	MaxPasswordHistory = 24
+)
+
+// PasswordPolicy holds the rules applied to local users passwords. It is read from
+// the Security parameters of the core.auth frontend plugin.
+type PasswordPolicy struct {
+	// MinLength is the minimum number of characters
+	MinLength int
+	// MinClasses is the minimum number of character classes (lowercase, uppercase, digits, symbols)
+	MinClasses int
+	// History forbids reusing one of the last N passwords
+	History int
+	// MaxAge forces a password change once the password is older than this duration
+	MaxAge time.Duration
+	// MaxFailedLogins locks the user after N consecutive failed logins
+	MaxFailedLogins int
+	// BreachedFolder is the local folder containing a breached-passwords hash list, split by
+	// 5-characters SHA-1 prefixes (k-anonymity range files). Check is disabled if empty.
+	BreachedFolder string
+}
+
+// LoadPasswordPolicy reads the password policy from the configuration. Rules that are not
+// explicitly configured are disabled, except for the failed logins threshold.
+func LoadPasswordPolicy(ctx context.Context) *PasswordPolicy {
+	p := &PasswordPolicy{
+		MinLength:       config.Get(ctx, config.FrontendPluginPath("core.auth", "PASSWORD_MINLENGTH")...).Int(),
+		MinClasses:      config.Get(ctx, config.FrontendPluginPath("core.auth", "PASSWORD_MIN_CLASSES")...).Int(),
+		History:         config.Get(ctx, config.FrontendPluginPath("core.auth", "PASSWORD_HISTORY")...).Int(),
+		MaxAge:          time.Duration(config.Get(ctx, config.FrontendPluginPath("core.auth", "PASSWORD_MAX_AGE")...).Int()) * 24 * time.Hour,
+		MaxFailedLogins: config.Get(ctx, config.FrontendPluginPath("core.auth", "PASSWORD_MAX_FAILED_LOGINS")...).Default(DefaultMaxFailedLogins).Int(),
+	}
+	if p.History > MaxPasswordHistory {
+		p.History = MaxPasswordHistory
+	}
+	if p.MaxFailedLogins <= 0 {
+		p.MaxFailedLogins = DefaultMaxFailedLogins
+	}
+	if config.Get(ctx, config.FrontendPluginPath("core.auth", "PASSWORD_BREACHED_CHECK")...).Default(false).Bool() {
+		p.BreachedFolder = config.Get(ctx, config.FrontendPluginPath("core.auth", "PASSWORD_BREACHED_FOLDER")...).String()
+	}
+	return p
+}
+
+// Validate checks a clear password against length, character classes and breached list rules.
+// History is checked separately by the user service, as it requires the stored hashes.
+func (p *PasswordPolicy) Validate(login, password string) error {
+	if p.MinLength > 0 && utf8.RuneCountInString(password) < p.MinLength {
+		return errors.WithMessagef(errors.PasswordPolicyViolation, "password must contain at least %d characters", p.MinLength)
+	}
+	if p.MinClasses > 0 && PasswordClasses(password) < p.MinClasses {
+		return errors.WithMessagef(errors.PasswordPolicyViolation, "password must mix at least %d of lowercase, uppercase, digits and symbols", p.MinClasses)
+	}
+	if login != "" && (p.MinLength > 0 || p.MinClasses > 0) && strings.EqualFold(login, password) {
+		return errors.WithMessage(errors.PasswordPolicyViolation, "password must be different from the login")
+	}
+	if p.BreachedFolder != "" {
+		breached, er := IsBreachedPassword(p.BreachedFolder, password)
+		if er != nil {
+			return er
+		}
+		if breached {
+			return errors.WithMessage(errors.PasswordPolicyViolation, "this password appears in a list of breached passwords, please choose another one")
+		}
+	}
+	return nil
+}
+
+// Expired checks if a password changed at the given time must be renewed.
+func (p *PasswordPolicy) Expired(changedAt time.Time) bool {
+	return p.MaxAge > 0 && !changedAt.IsZero() && time.Since(changedAt) > p.MaxAge
+}
+
+// PasswordClasses counts the character classes (lowercase, uppercase, digits, symbols) used by a password.
+func PasswordClasses(password string) int {
+	var lower, upper, digit, other int
+	for _, r := range password {
+		switch {
+		case unicode.IsLower(r):
+			lower = 1
+		case unicode.IsUpper(r):
+			upper = 1
+		case unicode.IsDigit(r):
+			digit = 1
+		default:
+			other = 1
+		}
+	}
+	return lower + upper + digit + other
+}
+
+// IsBreachedPassword looks up the SHA-1 of the password in a local k-anonymity hash list.
+// The folder contains one file per 5-characters uppercase hex prefix (named PREFIX or PREFIX.txt),
+// listing the remaining 35 characters of each hash, optionally followed by ":count" - this is
+// the format of the Pwned Passwords range files. A missing prefix file means no match.
+func IsBreachedPassword(folder, password string) (bool, error) {
+	sum := sha1.Sum([]byte(password))
+	hash := strings.ToUpper(hex.EncodeToString(sum[:]))
+	prefix, suffix := hash[:5], hash[5:]
+
+	var file *os.File
+	for _, name := range []string{prefix, prefix + ".txt"} {
+		if f, er := os.Open(filepath.Join(folder, name)); er == nil {
+			file = f
+			break
+		} else if !os.IsNotExist(er) {
+			return false, errors.WithMessage(errors.StatusInternalServerError, "cannot read breached passwords list: "+er.Error())
+		}
+	}
+	if file == nil {
+		if _, er := os.Stat(folder); er != nil {
+			return false, errors.WithMessage(errors.StatusInternalServerError, "cannot find breached passwords folder: "+er.Error())
+		}
+		return false, nil
+	}
+	defer file.Close()
+
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if i := strings.Index(line, ":"); i > -1 {
+			line = line[:i]
+		}
+		if strings.EqualFold(line, suffix) {
+			return true, nil
+		}
+	}
+	return false, scanner.Err()
+}
@@ -0,0 +1,86 @@
+/*
+ * Copyright (c) 2025 Abstrium SAS <team (at) pydio.com>
+ * This file is part of Pydio Cells.
+ *
+ * Pydio Cells is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * Pydio Cells is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with Pydio Cells.  If not, see <http://www.gnu.org/licenses/>.
+ *
+ * The latest code can be found at <https://pydio.com>.
+ */
+
+package auth
+
+import (
+	"crypto/sha1"
+	"encoding/hex"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/pydio/cells/v5/common/errors"
+
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestPasswordPolicy_Validate(t *testing.T) {
+
+	Convey("Test length and character classes", t, func() {
+		p := &PasswordPolicy{MinLength: 8, MinClasses: 3}
+		So(PasswordClasses("abc"), ShouldEqual, 1)
+		So(PasswordClasses("aB3!"), ShouldEqual, 4)
+		So(PasswordClasses("élève2025"), ShouldEqual, 2)
+
+		So(errors.Is(p.Validate("john", "aB3!"), errors.PasswordPolicyViolation), ShouldBeTrue)
+		So(errors.Is(p.Validate("john", "abcdefghij"), errors.PasswordPolicyViolation), ShouldBeTrue)
+		So(errors.Is(p.Validate("john", "abcdefghij"), errors.InvalidParameters), ShouldBeTrue)
+		So(p.Validate("john", "Abcdefgh1"), ShouldBeNil)
+		So(p.Validate("Abcdefgh1", "Abcdefgh1"), ShouldNotBeNil)
+
+		empty := &PasswordPolicy{}
+		So(empty.Validate("john", "a"), ShouldBeNil)
+		So(empty.Validate("a", "a"), ShouldBeNil)
+	})
+
+	Convey("Test expiration", t, func() {
+		p := &PasswordPolicy{MaxAge: 24 * time.Hour}
+		So(p.Expired(time.Now().Add(-25*time.Hour)), ShouldBeTrue)
+		So(p.Expired(time.Now().Add(-1*time.Hour)), ShouldBeFalse)
+		So(p.Expired(time.Time{}), ShouldBeFalse)
+		So((&PasswordPolicy{}).Expired(time.Now().Add(-1000*time.Hour)), ShouldBeFalse)
+	})
+
+	Convey("Test breached passwords list", t, func() {
+		dir := t.TempDir()
+		sum := sha1.Sum([]byte("P@ssw0rd"))
+		hash := strings.ToUpper(hex.EncodeToString(sum[:]))
+		content := "0018A45C4D1DEF81644B54AB7F969B88D65:1\n" + hash[5:] + ":52000\n"
+		So(os.WriteFile(filepath.Join(dir, hash[:5]+".txt"), []byte(content), 0644), ShouldBeNil)
+
+		b, er := IsBreachedPassword(dir, "P@ssw0rd")
+		So(er, ShouldBeNil)
+		So(b, ShouldBeTrue)
+
+		b, er = IsBreachedPassword(dir, "An0ther-Unknown-Pass")
+		So(er, ShouldBeNil)
+		So(b, ShouldBeFalse)
+
+		_, er = IsBreachedPassword(filepath.Join(dir, "missing"), "P@ssw0rd")
+		So(er, ShouldNotBeNil)
+
+		p := &PasswordPolicy{MinLength: 6, BreachedFolder: dir}
+		So(errors.Is(p.Validate("john", "P@ssw0rd"), errors.PasswordPolicyViolation), ShouldBeTrue)
+		So(p.Validate("john", "An0ther-Unknown-Pass"), ShouldBeNil)
+	})
+}
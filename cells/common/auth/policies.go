@@ -42,6 +42,7 @@ func checkOIDCPolicies(ctx context.Context, user *idm.User) error {
 	subjects := permissions.PolicyRequestSubjectsFromUser(ctx, user, false)
 	policyContext := make(map[string]string)
 	permissions.PolicyContextFromMetadata(policyContext, ctx)
+	permissions.PolicyContextFromClient(policyContext, ctx)
 
 	checker, err := permissions.CachedPoliciesChecker(ctx, "oidc", policyContext)
 	if err != nil {
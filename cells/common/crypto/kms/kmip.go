@@ -0,0 +1,222 @@
+/*
+ * Copyright (c) 2025 Abstrium SAS <team (at) pydio.com>
+ * This file is part of Pydio Cells.
+ *
+ * Pydio Cells is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * Pydio Cells is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with Pydio Cells.  If not, see <http://www.gnu.org/licenses/>.
+ *
+ * The latest code can be found at <https://pydio.com>.
+ */
+
+package kms
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"net"
+	"net/url"
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/pydio/cells/v5/common/errors"
+)
+
+func init() {
+	defaultURLMux.Register("kmip", &kmipProvider{})
+}
+
+type kmipProvider struct{}
+
+// Open creates a KeyWrapper using the Encrypt/Decrypt operations of a KMIP 1.4 server, with an AES key
+// identified by the URL path, e.g. kmip://kms.example.com:5696/<unique-identifier>?cert=/path/client.pem&key=/path/client.key&ca=/path/ca.pem
+func (k *kmipProvider) Open(ctx context.Context, u *url.URL) (KeyWrapper, error) {
+	uid := strings.Trim(u.Path, "/")
+	if uid == "" {
+		return nil, errors.New("kmip URL must provide the key unique identifier as path")
+	}
+	q := u.Query()
+	tlsConfig := &tls.Config{
+		ServerName:         u.Hostname(),
+		InsecureSkipVerify: q.Get("insecure") == "true",
+	}
+	if cert, key := q.Get("cert"), q.Get("key"); cert != "" && key != "" {
+		pair, er := tls.LoadX509KeyPair(cert, key)
+		if er != nil {
+			return nil, errors.WithMessage(er, "cannot load kmip client certificate")
+		}
+		tlsConfig.Certificates = []tls.Certificate{pair}
+	}
+	if ca := q.Get("ca"); ca != "" {
+		pem, er := os.ReadFile(ca)
+		if er != nil {
+			return nil, errors.WithMessage(er, "cannot load kmip server CA")
+		}
+		pool := x509.NewCertPool()
+		pool.AppendCertsFromPEM(pem)
+		tlsConfig.RootCAs = pool
+	}
+	host := u.Host
+	if u.Port() == "" {
+		host = net.JoinHostPort(u.Hostname(), "5696")
+	}
+	return &kmipWrapper{
+		uid: uid,
+		dial: func(ctx context.Context) (net.Conn, error) {
+			d := &tls.Dialer{NetDialer: &net.Dialer{Timeout: 10 * time.Second}, Config: tlsConfig}
+			return d.DialContext(ctx, "tcp", host)
+		},
+	}, nil
+}
+
+type kmipWrapper struct {
+	sync.Mutex
+	uid  string
+	dial func(ctx context.Context) (net.Conn, error)
+	conn net.Conn
+}
+
+func (k *kmipWrapper) cryptoParams(randomIV bool) ttlv {
+	params := []ttlv{
+		ttlvEnum(tagBlockCipherMode, kmipModeGCM),
+		ttlvEnum(tagCryptographicAlgorithm, kmipAlgorithmAES),
+		ttlvInt(tagTagLength, 16),
+	}
+	if randomIV {
+		params = append(params, ttlvBool(tagRandomIV, true))
+	}
+	return ttlvStruct(tagCryptographicParameters, params...)
+}
+
+// Wrap sends an Encrypt request letting the server generate the IV. Wrapped data is
+// [ivLength(1)][iv][tagLength(1)][tag][ciphertext].
+func (k *kmipWrapper) Wrap(ctx context.Context, plain []byte) ([]byte, error) {
+	payload, er := k.call(ctx, kmipOperationEncrypt,
+		ttlvString(tagUniqueIdentifier, k.uid),
+		k.cryptoParams(true),
+		ttlvData(tagData, plain),
+	)
+	if er != nil {
+		return nil, er
+	}
+	data, _ := payload.Child(tagData)
+	iv, _ := payload.Child(tagIVCounterNonce)
+	tag, _ := payload.Child(tagAuthenticatedEncryptionTag)
+	cipherData, _ := data.Value.([]byte)
+	ivData, _ := iv.Value.([]byte)
+	tagData, _ := tag.Value.([]byte)
+	if len(cipherData) == 0 || len(ivData) == 0 || len(ivData) > 255 || len(tagData) > 255 {
+		return nil, errors.New("kmip encrypt response is missing data or IV")
+	}
+	out := append([]byte{byte(len(ivData))}, ivData...)
+	out = append(out, byte(len(tagData)))
+	out = append(out, tagData...)
+	return append(out, cipherData...), nil
+}
+
+// Unwrap sends a Decrypt request with the IV and tag stored along the wrapped data.
+func (k *kmipWrapper) Unwrap(ctx context.Context, wrapped []byte) ([]byte, error) {
+	if len(wrapped) < 1 || len(wrapped) < 2+int(wrapped[0]) {
+		return nil, errors.New("invalid kmip wrapped data")
+	}
+	iv := wrapped[1 : 1+int(wrapped[0])]
+	rest := wrapped[1+int(wrapped[0]):]
+	if len(rest) < 1+int(rest[0]) {
+		return nil, errors.New("invalid kmip wrapped data")
+	}
+	tag := rest[1 : 1+int(rest[0])]
+	cipherData := rest[1+int(rest[0]):]
+	items := []ttlv{
+		ttlvString(tagUniqueIdentifier, k.uid),
+		k.cryptoParams(false),
+		ttlvData(tagData, cipherData),
+		ttlvData(tagIVCounterNonce, iv),
+	}
+	if len(tag) > 0 {
+		items = append(items, ttlvData(tagAuthenticatedEncryptionTag, tag))
+	}
+	payload, er := k.call(ctx, kmipOperationDecrypt, items...)
+	if er != nil {
+		return nil, er
+	}
+	data, ok := payload.Child(tagData)
+	if !ok {
+		return nil, errors.New("kmip decrypt response is missing data")
+	}
+	plain, _ := data.Value.([]byte)
+	return plain, nil
+}
+
+// call sends a single batch item request and returns the response payload. Connection is kept open
+// and re-opened once if it was closed by the server.
+func (k *kmipWrapper) call(ctx context.Context, operation int32, payload ...ttlv) (ttlv, error) {
+	k.Lock()
+	defer k.Unlock()
+
+	msg := ttlvStruct(tagRequestMessage,
+		ttlvStruct(tagRequestHeader,
+			ttlvStruct(tagProtocolVersion,
+				ttlvInt(tagProtocolVersionMajor, 1),
+				ttlvInt(tagProtocolVersionMinor, 4),
+			),
+			ttlvInt(tagBatchCount, 1),
+		),
+		ttlvStruct(tagBatchItem,
+			ttlvEnum(tagOperation, operation),
+			ttlvStruct(tagRequestPayload, payload...),
+		),
+	).Marshal()
+
+	var resp ttlv
+	var er error
+	for attempt := 0; attempt < 2; attempt++ {
+		if k.conn == nil {
+			if k.conn, er = k.dial(ctx); er != nil {
+				return ttlv{}, er
+			}
+		}
+		if dl, ok := ctx.Deadline(); ok {
+			_ = k.conn.SetDeadline(dl)
+		} else {
+			_ = k.conn.SetDeadline(time.Now().Add(30 * time.Second))
+		}
+		if _, er = k.conn.Write(msg); er == nil {
+			resp, er = readTTLV(k.conn)
+		}
+		if er == nil {
+			break
+		}
+		_ = k.conn.Close()
+		k.conn = nil
+	}
+	if er != nil {
+		return ttlv{}, er
+	}
+
+	item, ok := resp.Child(tagBatchItem)
+	if !ok || resp.Tag != tagResponseMessage {
+		return ttlv{}, errors.New("invalid kmip response")
+	}
+	if status, _ := item.Child(tagResultStatus); status.Value != kmipStatusSuccess {
+		reason, _ := item.Child(tagResultReason)
+		message, _ := item.Child(tagResultMessage)
+		return ttlv{}, errors.Errorf("kmip operation failed (reason %v): %v", reason.Value, message.Value)
+	}
+	out, ok := item.Child(tagResponsePayload)
+	if !ok {
+		return ttlv{}, errors.New("kmip response is missing payload")
+	}
+	return out, nil
+}
@@ -0,0 +1,69 @@
+/*
+ * Copyright (c) 2025 Abstrium SAS <team (at) pydio.com>
+ * This file is part of Pydio Cells.
+ *
+ * Pydio Cells is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * Pydio Cells is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with Pydio Cells.  If not, see <http://www.gnu.org/licenses/>.
+ *
+ * The latest code can be found at <https://pydio.com>.
+ */
+
+// Package kms provides KeyWrapper implementations delegating keys encryption to an external Key Management System,
+// so that master keys are never stored next to the data. Wrappers are opened by URL:
+//
+//	vault-transit://host:8200/transit/key-name   HashiCorp Vault transit engine (vault-transits for https)
+//	kmip://host:5696/key-unique-identifier       KMIP server (TLS, client certificate passed in query)
+//	pkcs11://token-label/key-label?module=path   AES key in a PKCS#11 token (HSM), PIN read from PKCS11_PIN env
+//
+// PKCS#11 support requires a cgo build, it can be tested against SoftHSMv2.
+package kms
+
+import (
+	"context"
+	"sync"
+
+	"github.com/pydio/cells/v5/common/utils/openurl"
+)
+
+// KeyWrapper encrypts and decrypts small secrets (typically node keys) with a key that never leaves the KMS.
+type KeyWrapper interface {
+	Wrap(ctx context.Context, plain []byte) ([]byte, error)
+	Unwrap(ctx context.Context, wrapped []byte) ([]byte, error)
+}
+
+var (
+	defaultURLMux = openurl.NewURLMux[KeyWrapper]("kms")
+	wrappers      = map[string]KeyWrapper{}
+	wrappersLock  sync.Mutex
+)
+
+// DefaultURLMux returns the URLMux used by OpenKeyWrapper. Driver packages can use it to register their opener.
+func DefaultURLMux() *openurl.URLMux[KeyWrapper] {
+	return defaultURLMux
+}
+
+// OpenKeyWrapper opens the KeyWrapper identified by the URL given. Wrappers are kept in memory and reused
+// for a given URL.
+func OpenKeyWrapper(ctx context.Context, urlstr string) (KeyWrapper, error) {
+	wrappersLock.Lock()
+	defer wrappersLock.Unlock()
+	if w, ok := wrappers[urlstr]; ok {
+		return w, nil
+	}
+	w, er := defaultURLMux.Open(ctx, urlstr)
+	if er != nil {
+		return nil, er
+	}
+	wrappers[urlstr] = w
+	return w, nil
+}
@@ -0,0 +1,165 @@
+/*
+ * Copyright (c) 2025 Abstrium SAS <team (at) pydio.com>
+ * This file is part of Pydio Cells.
+ *
+ * Pydio Cells is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * Pydio Cells is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with Pydio Cells.  If not, see <http://www.gnu.org/licenses/>.
+ *
+ * The latest code can be found at <https://pydio.com>.
+ */
+
+package kms
+
+import (
+	"context"
+	"crypto/aes"
+	"crypto/cipher"
+	"encoding/base64"
+	"encoding/json"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/pydio/cells/v5/common/crypto"
+
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+// fakeKMIPServer answers Encrypt/Decrypt requests on conn using AES-GCM with key.
+func fakeKMIPServer(conn net.Conn, key []byte) {
+	defer conn.Close()
+	block, _ := aes.NewCipher(key)
+	gcm, _ := cipher.NewGCM(block)
+	for {
+		req, er := readTTLV(conn)
+		if er != nil {
+			return
+		}
+		op, _ := req.Path(tagBatchItem, tagOperation)
+		payload, _ := req.Path(tagBatchItem, tagRequestPayload)
+		data, _ := payload.Child(tagData)
+		var respPayload []ttlv
+		status := kmipStatusSuccess
+		switch op.Value {
+		case kmipOperationEncrypt:
+			iv, _ := crypto.RandomBytes(12)
+			sealed := gcm.Seal(nil, iv, data.Value.([]byte), nil)
+			respPayload = []ttlv{
+				ttlvData(tagData, sealed[:len(sealed)-16]),
+				ttlvData(tagIVCounterNonce, iv),
+				ttlvData(tagAuthenticatedEncryptionTag, sealed[len(sealed)-16:]),
+			}
+		case kmipOperationDecrypt:
+			iv, _ := payload.Child(tagIVCounterNonce)
+			tag, _ := payload.Child(tagAuthenticatedEncryptionTag)
+			plain, e := gcm.Open(nil, iv.Value.([]byte), append(data.Value.([]byte), tag.Value.([]byte)...), nil)
+			if e != nil {
+				status = 1
+			}
+			respPayload = []ttlv{ttlvData(tagData, plain)}
+		}
+		resp := ttlvStruct(tagResponseMessage,
+			ttlvStruct(tagResponseHeader, ttlvInt(tagBatchCount, 1)),
+			ttlvStruct(tagBatchItem,
+				op,
+				ttlvEnum(tagResultStatus, status),
+				ttlvStruct(tagResponsePayload, respPayload...),
+			),
+		)
+		if _, er = conn.Write(resp.Marshal()); er != nil {
+			return
+		}
+	}
+}
+
+func TestKeyWrappers(t *testing.T) {
+
+	ctx := context.Background()
+	secret := []byte("0123456789abcdef0123456789abcdef")
+
+	Convey("Test TTLV encoding", t, func() {
+		msg := ttlvStruct(tagRequestMessage,
+			ttlvInt(tagBatchCount, 1),
+			ttlvBool(tagRandomIV, true),
+			ttlvString(tagUniqueIdentifier, "key-1"),
+			ttlvData(tagData, []byte{1, 2, 3}),
+		)
+		raw := msg.Marshal()
+		So(len(raw)%8, ShouldEqual, 0)
+		decoded, n, er := unmarshalTTLV(raw)
+		So(er, ShouldBeNil)
+		So(n, ShouldEqual, len(raw))
+		uid, ok := decoded.Child(tagUniqueIdentifier)
+		So(ok, ShouldBeTrue)
+		So(uid.Value, ShouldEqual, "key-1")
+		data, _ := decoded.Child(tagData)
+		So(data.Value, ShouldResemble, []byte{1, 2, 3})
+		count, _ := decoded.Child(tagBatchCount)
+		So(count.Value, ShouldEqual, int32(1))
+	})
+
+	Convey("Test KMIP wrapper", t, func() {
+		key, _ := crypto.RandomBytes(32)
+		w := &kmipWrapper{uid: "key-1", dial: func(ctx context.Context) (net.Conn, error) {
+			client, server := net.Pipe()
+			go fakeKMIPServer(server, key)
+			return client, nil
+		}}
+		wrapped, er := w.Wrap(ctx, secret)
+		So(er, ShouldBeNil)
+		So(wrapped, ShouldNotResemble, secret)
+		plain, er := w.Unwrap(ctx, wrapped)
+		So(er, ShouldBeNil)
+		So(plain, ShouldResemble, secret)
+
+		wrapped[len(wrapped)-1] ^= 0xff
+		_, er = w.Unwrap(ctx, wrapped)
+		So(er, ShouldNotBeNil)
+	})
+
+	Convey("Test Vault transit wrapper", t, func() {
+		srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if r.Header.Get("X-Vault-Token") != "test-token" {
+				w.WriteHeader(http.StatusForbidden)
+				_, _ = w.Write([]byte(`{"errors":["permission denied"]}`))
+				return
+			}
+			var body map[string]string
+			_ = json.NewDecoder(r.Body).Decode(&body)
+			switch r.URL.Path {
+			case "/v1/transit/encrypt/cells":
+				_ = json.NewEncoder(w).Encode(map[string]interface{}{"data": map[string]string{"ciphertext": "vault:v1:" + body["plaintext"]}})
+			case "/v1/transit/decrypt/cells":
+				_ = json.NewEncoder(w).Encode(map[string]interface{}{"data": map[string]string{"plaintext": strings.TrimPrefix(body["ciphertext"], "vault:v1:")}})
+			default:
+				w.WriteHeader(http.StatusNotFound)
+			}
+		}))
+		defer srv.Close()
+
+		t.Setenv("VAULT_TOKEN", "test-token")
+		w, er := OpenKeyWrapper(ctx, "vault-transit://"+strings.TrimPrefix(srv.URL, "http://")+"/transit/cells")
+		So(er, ShouldBeNil)
+		wrapped, er := w.Wrap(ctx, secret)
+		So(er, ShouldBeNil)
+		So(string(wrapped), ShouldEqual, "vault:v1:"+base64.StdEncoding.EncodeToString(secret))
+		plain, er := w.Unwrap(ctx, wrapped)
+		So(er, ShouldBeNil)
+		So(plain, ShouldResemble, secret)
+
+		_, er = OpenKeyWrapper(ctx, "vault-transit://localhost:8200/cells")
+		So(er, ShouldNotBeNil)
+	})
+}
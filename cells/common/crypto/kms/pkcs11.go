@@ -0,0 +1,205 @@
+//go:build cgo
+
+/*
+ * Copyright (c) 2025 Abstrium SAS <team (at) pydio.com>
+ * This file is part of Pydio Cells.
+ *
+ * Pydio Cells is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * Pydio Cells is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with Pydio Cells.  If not, see <http://www.gnu.org/licenses/>.
+ *
+ * The latest code can be found at <https://pydio.com>.
+ */
+
+package kms
+
+import (
+	"context"
+	"net/url"
+	"os"
+	"strings"
+	"sync"
+
+	"github.com/miekg/pkcs11"
+
+	"github.com/pydio/cells/v5/common/crypto"
+	"github.com/pydio/cells/v5/common/errors"
+)
+
+const (
+	// DefaultPKCS11PinEnv is the environment variable holding the token user PIN, unless the pinEnv parameter is set
+	DefaultPKCS11PinEnv = "PKCS11_PIN"
+
+	pkcs11NonceSize = 12
+)
+
+var (
+	pkcs11Modules     = map[string]*pkcs11.Ctx{}
+	pkcs11ModulesLock sync.Mutex
+)
+
+func init() {
+	defaultURLMux.Register("pkcs11", &pkcs11Provider{})
+}
+
+type pkcs11Provider struct{}
+
+// Open loads a PKCS#11 library and finds an AES secret key by its label in a token, e.g.
+// pkcs11://token-label/key-label?module=/usr/lib/softhsm/libsofthsm2.so. The user PIN is read from the
+// environment variable named by the pinEnv parameter (PKCS11_PIN by default), it is never read from the URL.
+func (p *pkcs11Provider) Open(_ context.Context, u *url.URL) (KeyWrapper, error) {
+	q := u.Query()
+	tokenLabel := u.Hostname()
+	keyLabel := strings.Trim(u.Path, "/")
+	if tokenLabel == "" || keyLabel == "" || q.Get("module") == "" {
+		return nil, errors.New("pkcs11 URL must provide token label, key label and module path")
+	}
+	if q.Has("pin") {
+		return nil, errors.New("pkcs11 PIN cannot be passed in the URL, set it in the " + DefaultPKCS11PinEnv + " env instead")
+	}
+	pinEnv := q.Get("pinEnv")
+	if pinEnv == "" {
+		pinEnv = DefaultPKCS11PinEnv
+	}
+	pin := os.Getenv(pinEnv)
+	if pin == "" {
+		return nil, errors.New("cannot load pkcs11 PIN, make sure to set " + pinEnv + " env")
+	}
+	lib, er := loadPKCS11Module(q.Get("module"))
+	if er != nil {
+		return nil, er
+	}
+	slot, er := findPKCS11Slot(lib, tokenLabel)
+	if er != nil {
+		return nil, er
+	}
+	session, er := lib.OpenSession(slot, pkcs11.CKF_SERIAL_SESSION)
+	if er != nil {
+		return nil, errors.WithMessage(er, "cannot open pkcs11 session on token "+tokenLabel)
+	}
+	// Login state is shared by all sessions of an application on a token
+	if er = lib.Login(session, pkcs11.CKU_USER, pin); er != nil && !pkcs11Is(er, pkcs11.CKR_USER_ALREADY_LOGGED_IN) {
+		_ = lib.CloseSession(session)
+		return nil, errors.WithMessage(er, "cannot login to pkcs11 token "+tokenLabel+", check PIN")
+	}
+	key, er := findPKCS11Key(lib, session, keyLabel)
+	if er != nil {
+		_ = lib.CloseSession(session)
+		return nil, er
+	}
+	return &pkcs11Wrapper{lib: lib, session: session, key: key}, nil
+}
+
+// pkcs11Wrapper encrypts with AES-GCM inside the token. Wrapped data is the nonce followed by the ciphertext and tag.
+type pkcs11Wrapper struct {
+	sync.Mutex
+	lib     *pkcs11.Ctx
+	session pkcs11.SessionHandle
+	key     pkcs11.ObjectHandle
+}
+
+func (p *pkcs11Wrapper) Wrap(_ context.Context, plain []byte) ([]byte, error) {
+	nonce, er := crypto.RandomBytes(pkcs11NonceSize)
+	if er != nil {
+		return nil, er
+	}
+	params := pkcs11.NewGCMParams(nonce, nil, 128)
+	defer params.Free()
+
+	// Sessions cannot run concurrent operations
+	p.Lock()
+	defer p.Unlock()
+	if er = p.lib.EncryptInit(p.session, []*pkcs11.Mechanism{pkcs11.NewMechanism(pkcs11.CKM_AES_GCM, params)}, p.key); er != nil {
+		return nil, errors.WithMessage(er, "pkcs11 encrypt failed")
+	}
+	sealed, er := p.lib.Encrypt(p.session, plain)
+	if er != nil {
+		return nil, errors.WithMessage(er, "pkcs11 encrypt failed")
+	}
+	return append(nonce, sealed...), nil
+}
+
+func (p *pkcs11Wrapper) Unwrap(_ context.Context, wrapped []byte) ([]byte, error) {
+	if len(wrapped) <= pkcs11NonceSize {
+		return nil, errors.New("invalid wrapped data")
+	}
+	params := pkcs11.NewGCMParams(wrapped[:pkcs11NonceSize], nil, 128)
+	defer params.Free()
+
+	p.Lock()
+	defer p.Unlock()
+	if er := p.lib.DecryptInit(p.session, []*pkcs11.Mechanism{pkcs11.NewMechanism(pkcs11.CKM_AES_GCM, params)}, p.key); er != nil {
+		return nil, errors.WithMessage(er, "pkcs11 decrypt failed")
+	}
+	plain, er := p.lib.Decrypt(p.session, wrapped[pkcs11NonceSize:])
+	if er != nil {
+		return nil, errors.WithMessage(er, "pkcs11 decrypt failed")
+	}
+	return plain, nil
+}
+
+// loadPKCS11Module loads and initializes a PKCS#11 library once per process.
+func loadPKCS11Module(path string) (*pkcs11.Ctx, error) {
+	pkcs11ModulesLock.Lock()
+	defer pkcs11ModulesLock.Unlock()
+	if lib, ok := pkcs11Modules[path]; ok {
+		return lib, nil
+	}
+	lib := pkcs11.New(path)
+	if lib == nil {
+		return nil, errors.New("cannot load pkcs11 module " + path)
+	}
+	if er := lib.Initialize(); er != nil && !pkcs11Is(er, pkcs11.CKR_CRYPTOKI_ALREADY_INITIALIZED) {
+		lib.Destroy()
+		return nil, errors.WithMessage(er, "cannot initialize pkcs11 module "+path)
+	}
+	pkcs11Modules[path] = lib
+	return lib, nil
+}
+
+// findPKCS11Slot finds the slot holding the token with the given label.
+func findPKCS11Slot(lib *pkcs11.Ctx, tokenLabel string) (uint, error) {
+	slots, er := lib.GetSlotList(true)
+	if er != nil {
+		return 0, errors.WithMessage(er, "cannot list pkcs11 slots")
+	}
+	for _, s := range slots {
+		if info, e := lib.GetTokenInfo(s); e == nil && strings.TrimRight(info.Label, " \x00") == tokenLabel {
+			return s, nil
+		}
+	}
+	return 0, errors.New("cannot find pkcs11 token " + tokenLabel)
+}
+
+// findPKCS11Key finds the unique secret key with the given label.
+func findPKCS11Key(lib *pkcs11.Ctx, session pkcs11.SessionHandle, keyLabel string) (pkcs11.ObjectHandle, error) {
+	if er := lib.FindObjectsInit(session, []*pkcs11.Attribute{
+		pkcs11.NewAttribute(pkcs11.CKA_CLASS, pkcs11.CKO_SECRET_KEY),
+		pkcs11.NewAttribute(pkcs11.CKA_LABEL, keyLabel),
+	}); er != nil {
+		return 0, errors.WithMessage(er, "cannot search pkcs11 keys")
+	}
+	objects, _, er := lib.FindObjects(session, 2)
+	_ = lib.FindObjectsFinal(session)
+	if er != nil {
+		return 0, errors.WithMessage(er, "cannot search pkcs11 keys")
+	}
+	if len(objects) != 1 {
+		return 0, errors.Errorf("expected one pkcs11 secret key labelled %s, found %d", keyLabel, len(objects))
+	}
+	return objects[0], nil
+}
+
+func pkcs11Is(er error, code uint) bool {
+	e, ok := er.(pkcs11.Error)
+	return ok && uint(e) == code
+}
@@ -0,0 +1,137 @@
+//go:build cgo
+
+/*
+ * Copyright (c) 2025 Abstrium SAS <team (at) pydio.com>
+ * This file is part of Pydio Cells.
+ *
+ * Pydio Cells is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * Pydio Cells is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with Pydio Cells.  If not, see <http://www.gnu.org/licenses/>.
+ *
+ * The latest code can be found at <https://pydio.com>.
+ */
+
+package kms
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/miekg/pkcs11"
+
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+// softHSMModule finds the SoftHSMv2 library, from SOFTHSM2_MODULE env or from the usual install locations.
+func softHSMModule() string {
+	candidates := []string{
+		os.Getenv("SOFTHSM2_MODULE"),
+		"/usr/lib/softhsm/libsofthsm2.so",
+		"/usr/lib/x86_64-linux-gnu/softhsm/libsofthsm2.so",
+		"/usr/lib64/pkcs11/libsofthsm2.so",
+		"/usr/local/lib/softhsm/libsofthsm2.so",
+		"/opt/homebrew/lib/softhsm/libsofthsm2.so",
+	}
+	for _, c := range candidates {
+		if _, er := os.Stat(c); c != "" && er == nil {
+			return c
+		}
+	}
+	return ""
+}
+
+// initSoftHSMToken creates a token with a user PIN and an AES key in a fresh SoftHSMv2 token folder.
+func initSoftHSMToken(t *testing.T, module, token, pin, keyLabel string) {
+	dir := t.TempDir()
+	conf := filepath.Join(dir, "softhsm2.conf")
+	if er := os.MkdirAll(filepath.Join(dir, "tokens"), 0700); er != nil {
+		t.Fatal(er)
+	}
+	if er := os.WriteFile(conf, []byte("directories.tokendir = "+filepath.Join(dir, "tokens")+"\nobjectstore.backend = file\n"), 0600); er != nil {
+		t.Fatal(er)
+	}
+	t.Setenv("SOFTHSM2_CONF", conf)
+
+	lib := pkcs11.New(module)
+	must := func(er error) {
+		if er != nil {
+			t.Fatal(er)
+		}
+	}
+	must(lib.Initialize())
+	slots, er := lib.GetSlotList(false)
+	must(er)
+	must(lib.InitToken(slots[0], "so-"+pin, token))
+	// SoftHSM moves initialized tokens to a new slot
+	slot, er := findPKCS11Slot(lib, token)
+	must(er)
+	session, er := lib.OpenSession(slot, pkcs11.CKF_SERIAL_SESSION|pkcs11.CKF_RW_SESSION)
+	must(er)
+	must(lib.Login(session, pkcs11.CKU_SO, "so-"+pin))
+	must(lib.InitPIN(session, pin))
+	must(lib.Logout(session))
+	must(lib.Login(session, pkcs11.CKU_USER, pin))
+	_, er = lib.GenerateKey(session, []*pkcs11.Mechanism{pkcs11.NewMechanism(pkcs11.CKM_AES_KEY_GEN, nil)}, []*pkcs11.Attribute{
+		pkcs11.NewAttribute(pkcs11.CKA_CLASS, pkcs11.CKO_SECRET_KEY),
+		pkcs11.NewAttribute(pkcs11.CKA_KEY_TYPE, pkcs11.CKK_AES),
+		pkcs11.NewAttribute(pkcs11.CKA_VALUE_LEN, 32),
+		pkcs11.NewAttribute(pkcs11.CKA_LABEL, keyLabel),
+		pkcs11.NewAttribute(pkcs11.CKA_TOKEN, true),
+		pkcs11.NewAttribute(pkcs11.CKA_ENCRYPT, true),
+		pkcs11.NewAttribute(pkcs11.CKA_DECRYPT, true),
+		pkcs11.NewAttribute(pkcs11.CKA_SENSITIVE, true),
+		pkcs11.NewAttribute(pkcs11.CKA_EXTRACTABLE, false),
+	})
+	must(er)
+	must(lib.Logout(session))
+	must(lib.CloseSession(session))
+}
+
+func TestPKCS11Wrapper(t *testing.T) {
+	module := softHSMModule()
+	if module == "" {
+		t.Skip("SoftHSMv2 is not installed, set SOFTHSM2_MODULE to run PKCS#11 tests")
+	}
+	initSoftHSMToken(t, module, "cells", "1234", "master")
+	ctx := context.Background()
+	secret := []byte("0123456789abcdef0123456789abcdef")
+
+	Convey("Test PKCS#11 wrapper on SoftHSM", t, func() {
+		_, er := OpenKeyWrapper(ctx, "pkcs11://cells/master?module="+module+"&pin=1234")
+		So(er, ShouldNotBeNil)
+
+		t.Setenv("PKCS11_WRONG_PIN", "0000")
+		_, er = OpenKeyWrapper(ctx, "pkcs11://cells/master?module="+module+"&pinEnv=PKCS11_WRONG_PIN")
+		So(er, ShouldNotBeNil)
+
+		t.Setenv(DefaultPKCS11PinEnv, "1234")
+		w, er := OpenKeyWrapper(ctx, "pkcs11://cells/master?module="+module)
+		So(er, ShouldBeNil)
+		wrapped, er := w.Wrap(ctx, secret)
+		So(er, ShouldBeNil)
+		So(wrapped, ShouldNotResemble, secret)
+		plain, er := w.Unwrap(ctx, wrapped)
+		So(er, ShouldBeNil)
+		So(plain, ShouldResemble, secret)
+
+		wrapped[len(wrapped)-1] ^= 1
+		_, er = w.Unwrap(ctx, wrapped)
+		So(er, ShouldNotBeNil)
+
+		_, er = OpenKeyWrapper(ctx, "pkcs11://cells/unknown?module="+module)
+		So(er, ShouldNotBeNil)
+		_, er = OpenKeyWrapper(ctx, "pkcs11://other/master?module="+module)
+		So(er, ShouldNotBeNil)
+	})
+}
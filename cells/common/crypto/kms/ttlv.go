@@ -0,0 +1,229 @@
+/*
+ * Copyright (c) 2025 Abstrium SAS <team (at) pydio.com>
+ * This file is part of Pydio Cells.
+ *
+ * Pydio Cells is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * Pydio Cells is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with Pydio Cells.  If not, see <http://www.gnu.org/licenses/>.
+ *
+ * The latest code can be found at <https://pydio.com>.
+ */
+
+package kms
+
+import (
+	"encoding/binary"
+	"io"
+
+	"github.com/pydio/cells/v5/common/errors"
+)
+
+// Minimal KMIP Tag-Type-Length-Value encoding, limited to the items required by Encrypt and Decrypt operations.
+
+const (
+	ttlvStructure   byte = 0x01
+	ttlvInteger     byte = 0x02
+	ttlvEnumeration byte = 0x05
+	ttlvBoolean     byte = 0x06
+	ttlvText        byte = 0x07
+	ttlvBytes       byte = 0x08
+
+	tagAuthenticatedEncryptionTag uint32 = 0x4200FF
+	tagBatchCount                 uint32 = 0x42000D
+	tagBatchItem                  uint32 = 0x42000F
+	tagBlockCipherMode            uint32 = 0x420011
+	tagCryptographicAlgorithm     uint32 = 0x420028
+	tagCryptographicParameters    uint32 = 0x42002B
+	tagData                       uint32 = 0x4200C2
+	tagIVCounterNonce             uint32 = 0x42003D
+	tagOperation                  uint32 = 0x42005C
+	tagProtocolVersion            uint32 = 0x420069
+	tagProtocolVersionMajor       uint32 = 0x42006A
+	tagProtocolVersionMinor       uint32 = 0x42006B
+	tagRandomIV                   uint32 = 0x4200C5
+	tagRequestHeader              uint32 = 0x420077
+	tagRequestMessage             uint32 = 0x420078
+	tagRequestPayload             uint32 = 0x420079
+	tagResponseHeader             uint32 = 0x42007A
+	tagResponseMessage            uint32 = 0x42007B
+	tagResponsePayload            uint32 = 0x42007C
+	tagResultMessage              uint32 = 0x42007D
+	tagResultReason               uint32 = 0x42007E
+	tagResultStatus               uint32 = 0x42007F
+	tagTagLength                  uint32 = 0x4200CE
+	tagUniqueIdentifier           uint32 = 0x420094
+
+	kmipOperationEncrypt int32 = 0x1F
+	kmipOperationDecrypt int32 = 0x20
+	kmipModeGCM          int32 = 0x09
+	kmipAlgorithmAES     int32 = 0x03
+	kmipStatusSuccess    int32 = 0x00
+
+	ttlvMaxMessageSize = 1024 * 1024
+)
+
+// ttlv is a decoded KMIP item. Value is one of []ttlv, int32, bool, string or []byte depending on Type.
+type ttlv struct {
+	Tag   uint32
+	Type  byte
+	Value interface{}
+}
+
+func ttlvStruct(tag uint32, children ...ttlv) ttlv {
+	return ttlv{Tag: tag, Type: ttlvStructure, Value: children}
+}
+
+func ttlvInt(tag uint32, v int32) ttlv {
+	return ttlv{Tag: tag, Type: ttlvInteger, Value: v}
+}
+
+func ttlvEnum(tag uint32, v int32) ttlv {
+	return ttlv{Tag: tag, Type: ttlvEnumeration, Value: v}
+}
+
+func ttlvBool(tag uint32, v bool) ttlv {
+	return ttlv{Tag: tag, Type: ttlvBoolean, Value: v}
+}
+
+func ttlvString(tag uint32, v string) ttlv {
+	return ttlv{Tag: tag, Type: ttlvText, Value: v}
+}
+
+func ttlvData(tag uint32, v []byte) ttlv {
+	return ttlv{Tag: tag, Type: ttlvBytes, Value: v}
+}
+
+// Child returns the first direct child with the given tag.
+func (t ttlv) Child(tag uint32) (ttlv, bool) {
+	if cc, ok := t.Value.([]ttlv); ok {
+		for _, c := range cc {
+			if c.Tag == tag {
+				return c, true
+			}
+		}
+	}
+	return ttlv{}, false
+}
+
+// Path follows a list of tags from t and returns the last item found.
+func (t ttlv) Path(tags ...uint32) (ttlv, bool) {
+	current := t
+	for _, tag := range tags {
+		c, ok := current.Child(tag)
+		if !ok {
+			return ttlv{}, false
+		}
+		current = c
+	}
+	return current, true
+}
+
+// Marshal encodes the item and its children.
+func (t ttlv) Marshal() []byte {
+	return t.appendTo(nil)
+}
+
+func (t ttlv) appendTo(buf []byte) []byte {
+	var value []byte
+	switch v := t.Value.(type) {
+	case []ttlv:
+		for _, c := range v {
+			value = c.appendTo(value)
+		}
+	case int32:
+		value = binary.BigEndian.AppendUint32(nil, uint32(v))
+	case bool:
+		var b uint64
+		if v {
+			b = 1
+		}
+		value = binary.BigEndian.AppendUint64(nil, b)
+	case string:
+		value = []byte(v)
+	case []byte:
+		value = v
+	}
+	buf = append(buf, byte(t.Tag>>16), byte(t.Tag>>8), byte(t.Tag), t.Type)
+	buf = binary.BigEndian.AppendUint32(buf, uint32(len(value)))
+	buf = append(buf, value...)
+	if pad := len(value) % 8; pad != 0 {
+		buf = append(buf, make([]byte, 8-pad)...)
+	}
+	return buf
+}
+
+// unmarshalTTLV decodes a single item and returns the number of bytes consumed.
+func unmarshalTTLV(b []byte) (ttlv, int, error) {
+	if len(b) < 8 {
+		return ttlv{}, 0, errors.New("ttlv: truncated header")
+	}
+	t := ttlv{
+		Tag:  uint32(b[0])<<16 | uint32(b[1])<<8 | uint32(b[2]),
+		Type: b[3],
+	}
+	l := int(binary.BigEndian.Uint32(b[4:8]))
+	padded := l
+	if pad := l % 8; pad != 0 {
+		padded += 8 - pad
+	}
+	if len(b) < 8+l {
+		return ttlv{}, 0, errors.New("ttlv: truncated value")
+	}
+	value := b[8 : 8+l]
+	switch t.Type {
+	case ttlvStructure:
+		var children []ttlv
+		for len(value) > 0 {
+			c, n, er := unmarshalTTLV(value)
+			if er != nil {
+				return ttlv{}, 0, er
+			}
+			children = append(children, c)
+			value = value[n:]
+		}
+		t.Value = children
+	case ttlvInteger, ttlvEnumeration:
+		if l != 4 {
+			return ttlv{}, 0, errors.New("ttlv: invalid integer length")
+		}
+		t.Value = int32(binary.BigEndian.Uint32(value))
+	case ttlvBoolean:
+		if l != 8 {
+			return ttlv{}, 0, errors.New("ttlv: invalid boolean length")
+		}
+		t.Value = binary.BigEndian.Uint64(value) != 0
+	case ttlvText:
+		t.Value = string(value)
+	default:
+		t.Value = append([]byte{}, value...)
+	}
+	return t, min(8+padded, len(b)), nil
+}
+
+// readTTLV reads one full message from r.
+func readTTLV(r io.Reader) (ttlv, error) {
+	header := make([]byte, 8)
+	if _, er := io.ReadFull(r, header); er != nil {
+		return ttlv{}, er
+	}
+	l := binary.BigEndian.Uint32(header[4:8])
+	if l > ttlvMaxMessageSize {
+		return ttlv{}, errors.New("ttlv: message too large")
+	}
+	msg := make([]byte, 8+int(l))
+	copy(msg, header)
+	if _, er := io.ReadFull(r, msg[8:]); er != nil {
+		return ttlv{}, er
+	}
+	t, _, er := unmarshalTTLV(msg)
+	return t, er
+}
@@ -0,0 +1,116 @@
+/*
+ * Copyright (c) 2025 Abstrium SAS <team (at) pydio.com>
+ * This file is part of Pydio Cells.
+ *
+ * Pydio Cells is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * Pydio Cells is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with Pydio Cells.  If not, see <http://www.gnu.org/licenses/>.
+ *
+ * The latest code can be found at <https://pydio.com>.
+ */
+
+package kms
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+	"net/url"
+	"path"
+	"strings"
+
+	vault "github.com/hashicorp/vault/api"
+
+	"github.com/pydio/cells/v5/common/errors"
+)
+
+func init() {
+	defaultURLMux.Register("vault-transit", &vaultTransitProvider{})
+	defaultURLMux.Register("vault-transits", &vaultTransitProvider{})
+}
+
+type vaultTransitProvider struct{}
+
+// Open creates a KeyWrapper using Vault transit secrets engine. URL path is the transit mount path followed
+// by the key name, e.g. vault-transit://localhost:8200/transit/cells. Token is read from VAULT_TOKEN env.
+func (v *vaultTransitProvider) Open(ctx context.Context, u *url.URL) (KeyWrapper, error) {
+	p := strings.Trim(u.Path, "/")
+	mount, keyName := path.Dir(p), path.Base(p)
+	if p == "" || mount == "." {
+		return nil, errors.New("vault transit URL path must contain mount path and key name")
+	}
+
+	vc := vault.DefaultConfig()
+	if u.Scheme == "vault-transit" {
+		vc.Address = "http://" + u.Host
+	} else {
+		vc.Address = "https://" + u.Host
+	}
+	client, err := vault.NewClient(vc)
+	if err != nil {
+		return nil, fmt.Errorf("unable to initialize Vault client: %v", err)
+	}
+	if rootToken := u.Query().Get("rootToken"); rootToken != "" {
+		fmt.Println("Using root token from query string, this should not be used in production! Use $VAULT_TOKEN env instead")
+		client.SetToken(rootToken)
+	}
+	if client.Token() == "" {
+		return nil, errors.New("cannot load vault authentication token, make sure to set VAULT_TOKEN env")
+	}
+	if ns := u.Query().Get("namespace"); ns != "" {
+		client.SetNamespace(ns)
+	}
+	return &vaultTransit{
+		cli:     client,
+		mount:   mount,
+		keyName: keyName,
+	}, nil
+}
+
+type vaultTransit struct {
+	cli     *vault.Client
+	mount   string
+	keyName string
+}
+
+// Wrap calls the transit encrypt endpoint. Wrapped data is the vault ciphertext ("vault:vX:...").
+func (v *vaultTransit) Wrap(ctx context.Context, plain []byte) ([]byte, error) {
+	data, er := v.call(ctx, "encrypt", map[string]interface{}{"plaintext": base64.StdEncoding.EncodeToString(plain)}, "ciphertext")
+	if er != nil {
+		return nil, er
+	}
+	return []byte(data), nil
+}
+
+// Unwrap calls the transit decrypt endpoint.
+func (v *vaultTransit) Unwrap(ctx context.Context, wrapped []byte) ([]byte, error) {
+	data, er := v.call(ctx, "decrypt", map[string]interface{}{"ciphertext": string(wrapped)}, "plaintext")
+	if er != nil {
+		return nil, er
+	}
+	return base64.StdEncoding.DecodeString(data)
+}
+
+func (v *vaultTransit) call(ctx context.Context, op string, body map[string]interface{}, field string) (string, error) {
+	sec, er := v.cli.Logical().WriteWithContext(ctx, v.mount+"/"+op+"/"+url.PathEscape(v.keyName), body)
+	if er != nil {
+		return "", errors.WithMessage(er, "vault transit "+op+" failed")
+	}
+	if sec == nil || sec.Data == nil {
+		return "", errors.New("vault transit " + op + " returned no data")
+	}
+	value, ok := sec.Data[field].(string)
+	if !ok {
+		return "", errors.New("vault transit " + op + " response has no " + field)
+	}
+	return value, nil
+}
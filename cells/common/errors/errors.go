@@ -46,7 +46,8 @@ var (
 	StatusLocked              = tozd.BaseWrap(CellsError, "locked")
 	StatusQuotaReached        = tozd.BaseWrap(CellsError, "quota reached")
 
-	InvalidParameters = RegisterBaseSentinel(StatusBadRequest, "invalid parameters")
+	InvalidParameters       = RegisterBaseSentinel(StatusBadRequest, "invalid parameters")
+	PasswordPolicyViolation = RegisterBaseSentinel(InvalidParameters, "password does not match policy")
 
 	UserNotFound        = RegisterBaseSentinel(StatusNotFound, "user not found")
 	NodeNotFound        = RegisterBaseSentinel(StatusNotFound, "node not found")
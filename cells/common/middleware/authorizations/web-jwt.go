@@ -37,12 +37,19 @@ func IsRestApiPublicMethod(r *http.Request) bool {
 	return r.Method == "GET" && strings.HasPrefix(r.RequestURI, "/a/frontend/state")
 }
 
+// IsRestApiFeedMethod checks if request targets the activity feeds, that accept a feed-scoped
+// token passed as query parameter as most feed readers cannot set headers.
+func IsRestApiFeedMethod(r *http.Request) bool {
+	return r.Method == "GET" && strings.HasPrefix(r.RequestURI, "/a/activity/feed/")
+}
+
 // HttpWrapperJWT captures and verifies a JWT token if it's present in the headers.
 // Warning: it goes through if there is no JWT => the next handlers
 // must verify if a valid user was found or not.
 func HttpWrapperJWT(h http.Handler) http.Handler {
 
 	jwtVerifier := auth.DefaultJWTVerifier()
+	feedVerifier := auth.FeedVerifier()
 
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 
@@ -54,6 +61,17 @@ func HttpWrapperJWT(h http.Handler) http.Handler {
 		}
 
 		log.Logger(ctx).Debug("JWTHttpHandler: Checking JWT")
+		if tok := r.URL.Query().Get("token"); tok != "" && len(r.Header["Authorization"]) == 0 && IsRestApiFeedMethod(r) {
+			c, _, err := feedVerifier.Verify(ctx, tok)
+			if err != nil {
+				log.Logger(ctx).Debug("feedVerifier Error", zap.Error(err))
+				w.WriteHeader(401)
+				w.Write([]byte("Unauthorized.\n"))
+				return
+			}
+			h.ServeHTTP(w, r.WithContext(c))
+			return
+		}
 		if val, ok1 := r.Header["Authorization"]; ok1 {
 
 			whole := strings.Join(val, "")
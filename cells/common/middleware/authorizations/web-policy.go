@@ -75,6 +75,7 @@ func HttpWrapperPolicy(h http.Handler) http.Handler {
 		}
 
 		permissions.PolicyContextFromMetadata(policyRequestContext, ctx)
+		permissions.PolicyContextFromClient(policyRequestContext, ctx)
 		if len(policyRequestContext) > 0 {
 			request.Context = policyRequestContext
 		}
@@ -84,6 +84,9 @@ func HttpRequestInfoToMetadata(req *http.Request) context.Context {
 	if h, ok := req.Header["X-Forwarded-For"]; ok {
 		ips := strings.Split(strings.Join(h, ""), ",")
 		meta[keys.HttpMetaRemoteAddress] = ips[0]
+		// Keep original values for consumers that must only trust known proxies
+		meta[keys.HttpMetaForwardedFor] = strings.Join(h, ",")
+		meta[keys.HttpMetaPeerAddress] = req.RemoteAddr
 	} else if req.RemoteAddr != "" {
 		meta[keys.HttpMetaRemoteAddress] = req.RemoteAddr
 	}
@@ -23,6 +23,8 @@ package keys
 const (
 	HttpMetaExtracted     = "HttpMetaExtracted"
 	HttpMetaRemoteAddress = "RemoteAddress"
+	HttpMetaPeerAddress   = "PeerAddress"
+	HttpMetaForwardedFor  = "ForwardedFor"
 	HttpMetaRequestMethod = "RequestMethod"
 	HttpMetaRequestURI    = "RequestURI"
 	HttpMetaHost          = "RequestHost"
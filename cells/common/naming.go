@@ -39,6 +39,7 @@ type (
 // Defines all constants for services names.
 const (
 	ServiceLog         = "log"
+	ServiceAudit       = "audit"
 	ServiceConfig      = "config"
 	ServiceInstall     = "install"
 	ServiceUpdate      = "update"
@@ -68,6 +69,8 @@ const (
 	ServicePolicy    = "policy"
 	ServiceGraph     = "graph"
 	ServiceUserMeta  = "user-meta"
+	ServiceReview    = "access-review"
+	ServiceElevation = "elevation"
 
 	ServiceAclGRPC          = ServiceGrpcNamespace_ + ServiceAcl
 	ServiceMetaGRPC         = ServiceGrpcNamespace_ + ServiceMeta
@@ -79,6 +82,7 @@ const (
 	ServiceWorkspaceGRPC    = ServiceGrpcNamespace_ + ServiceWorkspace
 	ServicePolicyGRPC       = ServiceGrpcNamespace_ + ServicePolicy
 	ServiceUserMetaGRPC     = ServiceGrpcNamespace_ + ServiceUserMeta
+	ServiceReviewGRPC       = ServiceGrpcNamespace_ + ServiceReview
 	ServiceUserKeyGRPC      = ServiceGrpcNamespace_ + ServiceUserKey
 	ServiceDataObjectsGRPC  = ServiceGrpcNamespace_ + ServiceDataObjects
 	ServiceDataObjectsGRPC_ = ServiceGrpcNamespace_ + ServiceDataObjects_
@@ -102,6 +106,7 @@ const (
 	ServiceJobsGRPC         = ServiceGrpcNamespace_ + ServiceJobs
 	ServiceTasksGRPC        = ServiceGrpcNamespace_ + ServiceTasks
 	ServiceLogGRPC          = ServiceGrpcNamespace_ + ServiceLog
+	ServiceAuditGRPC        = ServiceGrpcNamespace_ + ServiceAudit
 	ServiceInstallGRPC      = ServiceGrpcNamespace_ + ServiceInstall
 
 	ServiceUserKey   = "user-key"
@@ -206,6 +211,10 @@ const (
 	MetaNamespaceNodeDraftMode       = "draft-mode"
 	MetaNamespaceGeoLocation         = "GeoLocation"
 	MetaNamespaceContents            = "Contents"
+	MetaNamespaceTier                = "tier"
+	MetaNamespaceTierAccessed        = "tier_accessed"
+	MetaNamespaceScrub               = "scrub_status"
+	MetaNamespaceFileRequestUploader = "file_request_uploader"
 	MetaRecursiveChildrenSize        = "RecursiveChildrenSize"
 	MetaRecursiveChildrenFiles       = "RecursiveChildrenFiles"
 	MetaRecursiveChildrenFolders     = "RecursiveChildrenFolders"
@@ -226,6 +235,7 @@ const (
 	XAmzMetaPrefix              = "X-Amz-Meta-"
 	XAmzMetaClearSize           = XAmzMetaPrefix + "Pydio-Clear-Size"
 	XAmzMetaClearSizeUnknown    = "unknown"
+	XAmzMetaCompression         = XAmzMetaPrefix + "Pydio-Compression"
 	XAmzMetaNodeUuid            = XAmzMetaPrefix + "Pydio-Node-Uuid"
 	XAmzMetaContentMd5          = XAmzMetaPrefix + "Content-Md5"
 	XAmzMetaDirective           = "X-Amz-Metadata-Directive"
@@ -236,6 +246,9 @@ const (
 	XPydioSiteHash              = "X-Pydio-Site-Hash"
 	XPydioDebugSession          = "X-Pydio-Debug-Session"
 	XPydioMinisite              = "X-Pydio-Minisite"
+	XPydioUploaderId            = "X-Pydio-Uploader-Id"
+	XPydioUploaderName          = "X-Pydio-Uploader-Name"
+	XPydioUploaderEmail         = "X-Pydio-Uploader-Email"
 	XContentType                = "Content-Type"
 	InputResourceUUID           = "Create-Resource-Uuid"
 	InputVersionId              = "Create-Version-Id"
@@ -249,6 +262,9 @@ const (
 	PydioProfileShared   = "shared"
 	PydioProfileAnon     = "anon"
 
+	// IdmElevationRolePrefix_ prefixes the temporary roles attached to users during a privilege elevation
+	IdmElevationRolePrefix_ = "elevation-"
+
 	CtxTargetServiceName       = "service"
 	CtxCellsMetaPrefix         = "x-cells-"
 	CtxGrpcClientCaller        = "grpc-client-caller"
@@ -314,6 +330,9 @@ var (
 		XPydioMinisite,
 		XPydioMoveUuid,
 		XPydioDebugSession,
+		XPydioUploaderId,
+		XPydioUploaderName,
+		XPydioUploaderEmail,
 	}
 
 	IdmWsInternalReservedSlugs = map[string]string{
@@ -330,6 +349,12 @@ const (
 	DocStoreIdVersioningPolicies = "versioningPolicies"
 	DocStoreIdShares             = "share"
 	DocStoreIdResetPassKeys      = "resetPasswordKeys"
+	DocStoreIdFileRequestUploads = "fileRequestUploads"
+	DocStoreIdLinkAccesses       = "linkAccesses"
+	DocStoreIdElevations         = "elevations"
+	DocStoreIdElevationTokens    = "elevationTokens"
+	DocStoreIdDataExports        = "dataExports"
+	DocStoreIdDelegatedAdmins    = "delegatedAdmins"
 )
 
 // Main code information. Set by the go linker in the resulting binary when doing 'make main'
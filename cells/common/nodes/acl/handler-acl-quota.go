@@ -63,7 +63,8 @@ var cacheConfig = cache.Config{
 	DiscardFallback: true,
 }
 
-// QuotaFilter applies storage quota limitation on a per-workspace basis.
+// QuotaFilter applies storage quota limitation on a per-workspace basis, as well as the personal, total and
+// group quotas of the user owning the target storage.
 type QuotaFilter struct {
 	abstract.Handler
 }
@@ -106,7 +107,16 @@ func (a *QuotaFilter) ReadNode(ctx context.Context, in *tree.ReadNodeRequest, op
 			return resp, nil
 		}
 	}
-	if q, u, e := a.ComputeQuota(ctx, branch.Workspace); e == nil && q > 0 {
+	q, u, e := a.ComputeQuota(ctx, branch.Workspace)
+	if e == nil && q == 0 {
+		// Fallback to the most constraining quota of the storage owner
+		if owner, er := a.StorageOwner(ctx, branch.Workspace); er == nil && owner != nil {
+			if uq, er := NewQuotaUsage(ca).InBackground().ForUser(ctx, owner); er == nil {
+				q, u = uq.Tightest()
+			}
+		}
+	}
+	if e == nil && q > 0 {
 		n := resp.Node.Clone()
 		n.MustSetMeta(common.MetaFlagWorkspaceQuota, q)
 		n.MustSetMeta(common.MetaFlagWorkspaceQuotaUsage, u)
@@ -124,7 +134,7 @@ func (a *QuotaFilter) ReadNode(ctx context.Context, in *tree.ReadNodeRequest, op
 func (a *QuotaFilter) PutObject(ctx context.Context, node *tree.Node, reader io.Reader, requestData *models.PutRequestData) (models.ObjectInfo, error) {
 
 	// Note : as the temporary file created by PutHandler is already in index,
-	// currentUsage ALREADY takes into account the input data size.
+	// currentUsage ALREADY takes into account the declared data size of new files.
 
 	if branchInfo, er := nodes.GetBranchInfo(ctx, "in"); er == nil && !branchInfo.IsInternal() {
 		if maxQuota, currentUsage, err := a.ComputeQuota(ctx, branchInfo.Workspace); err != nil {
@@ -132,6 +142,20 @@ func (a *QuotaFilter) PutObject(ctx context.Context, node *tree.Node, reader io.
 		} else if maxQuota > 0 && currentUsage > maxQuota {
 			return models.ObjectInfo{}, errors.WithMessagef(errors.StatusQuotaReached, "Your allowed quota of %d is reached", maxQuota)
 		}
+		// Declared size is only used to fail early, actual size is enforced while reading
+		uq, er := a.UserQuota(ctx, branchInfo.Workspace)
+		if er != nil {
+			return models.ObjectInfo{}, er
+		}
+		if uq != nil {
+			counted := a.countedSize(ctx, node)
+			if er := uq.Check(max(requestData.Size, 0) - counted); er != nil {
+				return models.ObjectInfo{}, er
+			}
+			if left, limited := uq.Remaining(); limited {
+				reader = &quotaReader{r: reader, left: left + counted}
+			}
+		}
 	}
 
 	return a.Next.PutObject(ctx, node, reader, requestData)
@@ -146,6 +170,23 @@ func (a *QuotaFilter) MultipartPutObjectPart(ctx context.Context, target *tree.N
 		} else if maxQuota > 0 && currentUsage > maxQuota {
 			return models.MultipartObjectPart{}, errors.WithMessagef(errors.StatusQuotaReached, "Your allowed quota of %d is reached", maxQuota)
 		}
+		uq, er := a.UserQuota(ctx, branchInfo.Workspace)
+		if er != nil {
+			return models.MultipartObjectPart{}, er
+		}
+		if uq != nil {
+			// Parts are not indexed before the upload completes, count the ones already stored
+			uploaded, er := a.uploadedSize(ctx, target, uploadID, partNumberMarker)
+			if er != nil {
+				return models.MultipartObjectPart{}, er
+			}
+			if er := uq.Check(uploaded + max(requestData.Size, 0)); er != nil {
+				return models.MultipartObjectPart{}, er
+			}
+			if left, limited := uq.Remaining(); limited {
+				reader = &quotaReader{r: reader, left: left - uploaded}
+			}
+		}
 	}
 
 	return a.Next.MultipartPutObjectPart(ctx, target, uploadID, partNumberMarker, reader, requestData)
@@ -160,6 +201,9 @@ func (a *QuotaFilter) CopyObject(ctx context.Context, from *tree.Node, to *tree.
 		} else if maxQuota > 0 && currentUsage+from.Size > maxQuota {
 			return models.ObjectInfo{}, errors.WithMessagef(errors.StatusQuotaReached, "Your allowed quota of %d is reached", maxQuota)
 		}
+		if er := a.CheckUserQuota(ctx, branchInfo.Workspace, from.Size); er != nil {
+			return models.ObjectInfo{}, er
+		}
 	}
 
 	return a.Next.CopyObject(ctx, from, to, requestData)
@@ -176,6 +220,9 @@ func (a *QuotaFilter) WrappedCanApply(srcCtx context.Context, targetCtx context.
 			} else if maxQuota > 0 && currentUsage+targetNode.Size > maxQuota {
 				return errors.WithMessagef(errors.StatusQuotaReached, "Your allowed quota of %d is reached", maxQuota)
 			}
+			if er := a.CheckUserQuota(targetCtx, bI.Workspace, targetNode.Size); er != nil {
+				return er
+			}
 		}
 	case tree.NodeChangeEvent_UPDATE_PATH:
 		src, er1 := nodes.GetBranchInfo(srcCtx, "from")
@@ -187,6 +234,9 @@ func (a *QuotaFilter) WrappedCanApply(srcCtx context.Context, targetCtx context.
 			} else if maxQuota > 0 && currentUsage+operation.GetTarget().Size > maxQuota {
 				return errors.WithMessagef(errors.StatusQuotaReached, "Your allowed quota of %d is reached", maxQuota)
 			}
+			if er := a.CheckUserQuota(targetCtx, tgt.Workspace, operation.GetTarget().Size); er != nil {
+				return er
+			}
 		}
 	}
 	return a.Next.WrappedCanApply(srcCtx, targetCtx, operation)
@@ -238,6 +288,86 @@ func (a *QuotaFilter) ComputeQuota(ctx context.Context, workspace *idm.Workspace
 	return
 }
 
+// CheckUserQuota applies the quotas of the user owning the storage of a workspace: the current user in
+// a workspace rooted on a personal folder, or the owner of a cell. Other workspaces are not concerned.
+func (a *QuotaFilter) CheckUserQuota(ctx context.Context, workspace *idm.Workspace, extra int64) error {
+	uq, er := a.UserQuota(ctx, workspace)
+	if er != nil || uq == nil {
+		return er
+	}
+	return uq.Check(extra)
+}
+
+// UserQuota loads the quotas and usages of the user owning the storage of a workspace, if any. Usages of
+// groups that are not cached are computed in background, to avoid scanning all members on each write.
+func (a *QuotaFilter) UserQuota(ctx context.Context, workspace *idm.Workspace) (*UserQuota, error) {
+	owner, er := a.StorageOwner(ctx, workspace)
+	if er != nil || owner == nil {
+		return nil, er
+	}
+	ca := cache_helper.MustResolveCache(ctx, common.CacheTypeLocal, cacheConfig)
+	return NewQuotaUsage(ca).InBackground().ForUser(ctx, owner)
+}
+
+// countedSize returns the size of the node being written that is already counted in usages: new files are
+// indexed by the put handler with their declared size before their content is written.
+func (a *QuotaFilter) countedSize(ctx context.Context, node *tree.Node) int64 {
+	if node.GetUuid() == "" {
+		return 0
+	}
+	resp, er := treec.NodeProviderClient(ctx).ReadNode(ctx, &tree.ReadNodeRequest{Node: &tree.Node{Uuid: node.GetUuid()}})
+	if er != nil || resp.GetNode().GetEtag() != common.NodeFlagEtagTemporary {
+		return 0
+	}
+	return max(resp.GetNode().GetSize(), 0)
+}
+
+// uploadedSize sums the size of the parts already stored for a multipart upload, except the part being (re-)uploaded.
+func (a *QuotaFilter) uploadedSize(ctx context.Context, target *tree.Node, uploadID string, partNumber int) (int64, error) {
+	var total int64
+	var marker int
+	for {
+		res, er := a.Next.MultipartListObjectParts(ctx, target, uploadID, marker, 1000)
+		if er != nil {
+			return 0, er
+		}
+		for _, p := range res.ObjectParts {
+			if p.PartNumber != partNumber {
+				total += p.Size
+			}
+		}
+		if !res.IsTruncated || res.NextPartNumberMarker <= marker {
+			return total, nil
+		}
+		marker = res.NextPartNumberMarker
+	}
+}
+
+// StorageOwner finds the user whose quotas apply to a workspace, if any.
+func (a *QuotaFilter) StorageOwner(ctx context.Context, workspace *idm.Workspace) (*idm.User, error) {
+	if workspace == nil {
+		return nil, nil
+	}
+	var u *idm.User
+	var er error
+	if workspace.Scope == idm.WorkspaceScope_ROOM {
+		if ownerUuid := WorkspaceOwner(workspace); ownerUuid != "" {
+			u, er = permissions.SearchUniqueUser(ctx, "", ownerUuid)
+		}
+	} else if claims, ok := claim.FromContext(ctx); ok {
+		for _, root := range workspace.RootUUIDs {
+			if _, virtual := abstract.GetVirtualProvider().ByUuid(ctx, root); virtual {
+				u, er = permissions.SearchUniqueUser(ctx, claims.Name, "")
+				break
+			}
+		}
+	}
+	if er != nil && errors.Is(er, errors.UserNotFound) {
+		return nil, nil
+	}
+	return u, er
+}
+
 // FindParentWorkspaces finds possible parents for the current workspace based on the RESOURCE_OWNER uuid.
 func (a *QuotaFilter) FindParentWorkspaces(ctx context.Context, workspace *idm.Workspace) (parentWorkspaces []*idm.Workspace, parentContext context.Context, err error) {
 
@@ -366,3 +496,23 @@ func (a *QuotaFilter) QuotaForWorkspace(ctx context.Context, workspace *idm.Work
 
 	return
 }
+
+// quotaReader fails once more bytes than the remaining quota were read, whatever the size declared by the client.
+type quotaReader struct {
+	r    io.Reader
+	left int64
+}
+
+func (q *quotaReader) Read(p []byte) (int, error) {
+	if q.left < 0 {
+		return 0, errors.WithMessage(errors.StatusQuotaReached, "Your allowed quota is reached")
+	}
+	if int64(len(p)) > q.left+1 {
+		p = p[:q.left+1]
+	}
+	n, er := q.r.Read(p)
+	if q.left -= int64(n); q.left < 0 {
+		return n, errors.WithMessage(errors.StatusQuotaReached, "Your allowed quota is reached")
+	}
+	return n, er
+}
@@ -0,0 +1,383 @@
+/*
+ * Copyright (c) 2025 Abstrium SAS <team (at) pydio.com>
+ * This file is part of Pydio Cells.
+ *
+ * Pydio Cells is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * Pydio Cells is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with Pydio Cells.  If not, see <http://www.gnu.org/licenses/>.
+ *
+ * The latest code can be found at <https://pydio.com>.
+ */
+
+package acl
+
+import (
+	"context"
+	"path"
+	"strconv"
+	"strings"
+	"sync"
+
+	"google.golang.org/protobuf/types/known/anypb"
+
+	"github.com/pydio/cells/v5/common/auth"
+	"github.com/pydio/cells/v5/common/client/commons"
+	"github.com/pydio/cells/v5/common/client/commons/idmc"
+	"github.com/pydio/cells/v5/common/client/commons/treec"
+	"github.com/pydio/cells/v5/common/errors"
+	"github.com/pydio/cells/v5/common/nodes/abstract"
+	"github.com/pydio/cells/v5/common/permissions"
+	"github.com/pydio/cells/v5/common/proto/idm"
+	"github.com/pydio/cells/v5/common/proto/service"
+	"github.com/pydio/cells/v5/common/proto/tree"
+	"github.com/pydio/cells/v5/common/utils/cache"
+)
+
+// PersonalFolderUuid is the virtual node resolving to each user personal folder.
+const PersonalFolderUuid = "my-files"
+
+// UserQuota describes the quotas applied to a user through its roles, and its current storage usage.
+// Personal applies to the user personal folder, Total to the personal folder and the roots of the cells
+// owned by the user, and Groups to the sum of the totals of all members of each group.
+type UserQuota struct {
+	User          *idm.User
+	Personal      int64
+	PersonalUsage int64
+	Total         int64
+	TotalUsage    int64
+	Groups        []*GroupQuota
+}
+
+// GroupQuota describes the quota applied to a group and the storage used by its members.
+type GroupQuota struct {
+	Uuid    string
+	Path    string
+	Quota   int64
+	Usage   int64
+	Members int
+}
+
+// Check verifies that adding extra bytes does not exceed any hard limit.
+func (q *UserQuota) Check(extra int64) error {
+	if q.Personal > 0 && q.PersonalUsage+extra > q.Personal {
+		return errors.WithMessagef(errors.StatusQuotaReached, "Your personal quota of %d is reached", q.Personal)
+	}
+	if q.Total > 0 && q.TotalUsage+extra > q.Total {
+		return errors.WithMessagef(errors.StatusQuotaReached, "Your total quota of %d is reached", q.Total)
+	}
+	for _, g := range q.Groups {
+		if g.Quota > 0 && g.Usage+extra > g.Quota {
+			return errors.WithMessagef(errors.StatusQuotaReached, "The quota of %d allowed to group %s is reached", g.Quota, g.Path)
+		}
+	}
+	return nil
+}
+
+// Remaining returns the number of bytes that can still be written before reaching the first hard limit.
+// The second value is false if no quota applies.
+func (q *UserQuota) Remaining() (left int64, limited bool) {
+	consider := func(qu, us int64) {
+		if qu > 0 && (!limited || qu-us < left) {
+			left, limited = qu-us, true
+		}
+	}
+	consider(q.Personal, q.PersonalUsage)
+	consider(q.Total, q.TotalUsage)
+	for _, g := range q.Groups {
+		consider(g.Quota, g.Usage)
+	}
+	return
+}
+
+// Tightest returns the quota and usage that are the closest to their limit, groups included.
+func (q *UserQuota) Tightest() (quota int64, usage int64) {
+	consider := func(qu, us int64) {
+		if qu > 0 && (quota == 0 || ratio(us, qu) > ratio(usage, quota)) {
+			quota, usage = qu, us
+		}
+	}
+	consider(q.Personal, q.PersonalUsage)
+	consider(q.Total, q.TotalUsage)
+	for _, g := range q.Groups {
+		consider(g.Quota, g.Usage)
+	}
+	return
+}
+
+// Ratio returns the highest usage percentage among the personal and total quotas.
+func (q *UserQuota) Ratio() int64 {
+	return max(ratio(q.PersonalUsage, q.Personal), ratio(q.TotalUsage, q.Total))
+}
+
+// Ratio returns the usage percentage of the group quota.
+func (g *GroupQuota) Ratio() int64 {
+	return ratio(g.Usage, g.Quota)
+}
+
+// QuotaUsage computes quotas and usages of users and groups from the folder sizes maintained by the index.
+// It keeps intermediate results, and should be used for one computation pass only.
+type QuotaUsage struct {
+	totals       map[string]int64
+	groups       map[string]*GroupQuota
+	groupsCache  cache.Cache
+	groupsInBack bool
+}
+
+// groupsLoading tracks the groups usages being computed in background
+var groupsLoading sync.Map
+
+// NewQuotaUsage creates a QuotaUsage. Groups usages, that require computing the usage of all their members,
+// are additionally shared through groupsCache if it is not nil.
+func NewQuotaUsage(groupsCache cache.Cache) *QuotaUsage {
+	return &QuotaUsage{
+		totals:      make(map[string]int64),
+		groups:      make(map[string]*GroupQuota),
+		groupsCache: groupsCache,
+	}
+}
+
+// InBackground makes ForUser compute the usages of groups that are not cached yet in background instead of
+// scanning all their members, so that it can be used on the upload path. Such groups are left out of the
+// result until their usage is available in the cache. It has no effect without a groups cache.
+func (q *QuotaUsage) InBackground() *QuotaUsage {
+	q.groupsInBack = q.groupsCache != nil
+	return q
+}
+
+// ForUser resolves quotas applied to a user and computes its usages, including the usages of the groups
+// carrying a quota.
+func (q *QuotaUsage) ForUser(ctx context.Context, u *idm.User) (*UserQuota, error) {
+	var roleIds []string
+	for _, r := range u.GetRoles() {
+		roleIds = append(roleIds, r.GetUuid())
+	}
+	values, er := roleQuotas(ctx, roleIds)
+	if er != nil {
+		return nil, er
+	}
+	uq := &UserQuota{User: u}
+	for _, r := range roleIds {
+		if v, ok := values[r][permissions.AclQuotaPersonal.Name]; ok {
+			uq.Personal = v
+		}
+		if v, ok := values[r][permissions.AclQuotaTotal.Name]; ok {
+			uq.Total = v
+		}
+	}
+	var personal *tree.Node
+	if uq.PersonalUsage, personal, er = q.personalUsage(ctx, u); er != nil {
+		return nil, er
+	}
+	if uq.TotalUsage, er = q.totalUsage(ctx, u, personal); er != nil {
+		return nil, er
+	}
+	q.totals[u.GetUuid()] = uq.TotalUsage
+	for _, r := range u.GetRoles() {
+		if _, ok := values[r.GetUuid()][permissions.AclQuotaGroup.Name]; !ok || r.GetUserRole() {
+			continue
+		}
+		var cached *GroupQuota
+		if q.groupsInBack && !q.groupsCache.Get("group-"+r.GetUuid(), &cached) {
+			q.loadGroup(ctx, r.GetUuid())
+			continue
+		}
+		g, e := q.ForGroup(ctx, r.GetUuid())
+		if errors.Is(e, errors.UserNotFound) {
+			// Not a group role
+			continue
+		} else if e != nil {
+			return nil, e
+		}
+		uq.Groups = append(uq.Groups, g)
+	}
+	return uq, nil
+}
+
+// ForGroup computes the usage of all users below a group, given the group uuid.
+func (q *QuotaUsage) ForGroup(ctx context.Context, groupUuid string) (*GroupQuota, error) {
+	if g, ok := q.groups[groupUuid]; ok {
+		return g, nil
+	}
+	var cached *GroupQuota
+	if q.groupsCache != nil && q.groupsCache.Get("group-"+groupUuid, &cached) {
+		q.groups[groupUuid] = cached
+		return cached, nil
+	}
+	groups, er := searchUsers(ctx, &idm.UserSingleQuery{Uuid: groupUuid})
+	if er != nil {
+		return nil, er
+	} else if len(groups) == 0 || !groups[0].GetIsGroup() {
+		return nil, errors.WithMessagef(errors.UserNotFound, "cannot find group %s", groupUuid)
+	}
+	g := &GroupQuota{
+		Uuid: groupUuid,
+		Path: path.Join("/", groups[0].GetGroupPath(), groups[0].GetGroupLabel()),
+	}
+	if values, e := roleQuotas(ctx, []string{groupUuid}); e != nil {
+		return nil, e
+	} else {
+		g.Quota = values[groupUuid][permissions.AclQuotaGroup.Name]
+	}
+	members, er := searchUsers(ctx, &idm.UserSingleQuery{GroupPath: g.Path, Recursive: true, NodeType: idm.NodeType_USER})
+	if er != nil {
+		return nil, er
+	}
+	for _, m := range members {
+		total, ok := q.totals[m.GetUuid()]
+		if !ok {
+			_, personal, e := q.personalUsage(ctx, m)
+			if e != nil {
+				return nil, e
+			}
+			if total, e = q.totalUsage(ctx, m, personal); e != nil {
+				return nil, e
+			}
+			q.totals[m.GetUuid()] = total
+		}
+		g.Usage += total
+		g.Members++
+	}
+	q.groups[groupUuid] = g
+	if q.groupsCache != nil {
+		_ = q.groupsCache.Set("group-"+groupUuid, g)
+	}
+	return g, nil
+}
+
+// loadGroup computes the usage of a group in background and stores it in the groups cache. A group is only
+// computed once at a time.
+func (q *QuotaUsage) loadGroup(ctx context.Context, groupUuid string) {
+	if _, loading := groupsLoading.LoadOrStore(groupUuid, true); loading {
+		return
+	}
+	bgCtx := context.WithoutCancel(ctx)
+	go func() {
+		defer groupsLoading.Delete(groupUuid)
+		_, _ = NewQuotaUsage(q.groupsCache).ForGroup(bgCtx, groupUuid)
+	}()
+}
+
+// personalUsage resolves the personal folder of a user to read its size.
+func (q *QuotaUsage) personalUsage(ctx context.Context, u *idm.User) (int64, *tree.Node, error) {
+	uCtx := auth.WithImpersonate(ctx, u)
+	resolved, ok := abstract.GetVirtualProvider().GetResolver(false)(uCtx, &tree.Node{Uuid: PersonalFolderUuid})
+	if !ok {
+		return 0, nil, nil
+	}
+	resp, er := treec.NodeProviderClient(ctx).ReadNode(ctx, &tree.ReadNodeRequest{Node: &tree.Node{Uuid: resolved.GetUuid()}})
+	if er != nil {
+		if errors.Is(er, errors.NodeNotFound) {
+			return 0, nil, nil
+		}
+		return 0, nil, er
+	}
+	return resp.GetNode().GetSize(), resp.GetNode(), nil
+}
+
+// totalUsage adds the sizes of the cells roots owned by a user to its personal folder size.
+// Roots located inside the personal folder are already counted.
+func (q *QuotaUsage) totalUsage(ctx context.Context, u *idm.User, personal *tree.Node) (int64, error) {
+	total := personal.GetSize()
+	rooms, er := searchWorkspaces(ctx, &idm.WorkspaceSingleQuery{Scope: idm.WorkspaceScope_ROOM}, permissions.PolicySubjectUuidPrefix+u.GetUuid())
+	if er != nil {
+		return 0, er
+	}
+	seen := map[string]bool{}
+	cli := treec.NodeProviderClient(ctx)
+	for _, ws := range rooms {
+		if WorkspaceOwner(ws) != u.GetUuid() {
+			continue
+		}
+		for _, root := range ws.GetRootUUIDs() {
+			if seen[root] {
+				continue
+			}
+			seen[root] = true
+			resp, er := cli.ReadNode(ctx, &tree.ReadNodeRequest{Node: &tree.Node{Uuid: root}})
+			if er != nil {
+				continue
+			}
+			p := resp.GetNode().GetPath()
+			if personal != nil && (p == personal.GetPath() || strings.HasPrefix(p, personal.GetPath()+"/")) {
+				continue
+			}
+			total += resp.GetNode().GetSize()
+		}
+	}
+	return total, nil
+}
+
+// WorkspaceOwner returns the uuid of the user owning a workspace, if any.
+func WorkspaceOwner(ws *idm.Workspace) (owner string) {
+	for _, p := range ws.GetPolicies() {
+		if p.GetAction() == service.ResourcePolicyAction_OWNER {
+			owner = p.GetSubject()
+		}
+	}
+	return
+}
+
+// roleQuotas loads the user and group quotas values set on a list of roles.
+func roleQuotas(ctx context.Context, roleIds []string) (map[string]map[string]int64, error) {
+	values := make(map[string]map[string]int64)
+	if len(roleIds) == 0 {
+		return values, nil
+	}
+	q, _ := anypb.New(&idm.ACLSingleQuery{
+		RoleIDs: roleIds,
+		Actions: []*idm.ACLAction{permissions.AclQuotaPersonal, permissions.AclQuotaTotal, permissions.AclQuotaGroup},
+	})
+	st, e := idmc.ACLServiceClient(ctx).SearchACL(ctx, &idm.SearchACLRequest{Query: &service.Query{SubQueries: []*anypb.Any{q}}})
+	er := commons.ForEach(st, e, func(resp *idm.SearchACLResponse) error {
+		acl := resp.GetACL()
+		v, err := strconv.ParseInt(acl.GetAction().GetValue(), 10, 64)
+		if err != nil || v <= 0 {
+			return nil
+		}
+		if _, ok := values[acl.GetRoleID()]; !ok {
+			values[acl.GetRoleID()] = make(map[string]int64)
+		}
+		values[acl.GetRoleID()][acl.GetAction().GetName()] = v
+		return nil
+	})
+	return values, er
+}
+
+func searchUsers(ctx context.Context, query *idm.UserSingleQuery) (uu []*idm.User, er error) {
+	q, _ := anypb.New(query)
+	st, e := idmc.UserServiceClient(ctx).SearchUser(ctx, &idm.SearchUserRequest{Query: &service.Query{SubQueries: []*anypb.Any{q}}})
+	er = commons.ForEach(st, e, func(resp *idm.SearchUserResponse) error {
+		uu = append(uu, resp.GetUser())
+		return nil
+	})
+	return
+}
+
+func searchWorkspaces(ctx context.Context, query *idm.WorkspaceSingleQuery, subject string) (ww []*idm.Workspace, er error) {
+	q, _ := anypb.New(query)
+	st, e := idmc.WorkspaceServiceClient(ctx).SearchWorkspace(ctx, &idm.SearchWorkspaceRequest{Query: &service.Query{
+		SubQueries:          []*anypb.Any{q},
+		ResourcePolicyQuery: &service.ResourcePolicyQuery{Subjects: []string{subject}},
+	}})
+	er = commons.ForEach(st, e, func(resp *idm.SearchWorkspaceResponse) error {
+		ww = append(ww, resp.GetWorkspace())
+		return nil
+	})
+	return
+}
+
+func ratio(usage, quota int64) int64 {
+	if quota <= 0 {
+		return 0
+	}
+	return usage * 100 / quota
+}
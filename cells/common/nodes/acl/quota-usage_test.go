@@ -0,0 +1,86 @@
+/*
+ * Copyright (c) 2025 Abstrium SAS <team (at) pydio.com>
+ * This file is part of Pydio Cells.
+ *
+ * Pydio Cells is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * Pydio Cells is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with Pydio Cells.  If not, see <http://www.gnu.org/licenses/>.
+ *
+ * The latest code can be found at <https://pydio.com>.
+ */
+
+package acl
+
+import (
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/pydio/cells/v5/common/errors"
+	"github.com/pydio/cells/v5/common/proto/idm"
+	"github.com/pydio/cells/v5/common/proto/service"
+
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestUserQuota(t *testing.T) {
+
+	Convey("Test user quotas checks", t, func() {
+		uq := &UserQuota{Personal: 100, PersonalUsage: 50, Total: 1000, TotalUsage: 900}
+		So(uq.Check(0), ShouldBeNil)
+		So(uq.Check(60), ShouldNotBeNil)
+		So(errors.Is(uq.Check(60), errors.StatusQuotaReached), ShouldBeTrue)
+		So(uq.Ratio(), ShouldEqual, 90)
+		q, u := uq.Tightest()
+		So(q, ShouldEqual, 1000)
+		So(u, ShouldEqual, 900)
+
+		uq.Groups = append(uq.Groups, &GroupQuota{Path: "/sales", Quota: 2000, Usage: 1990})
+		So(uq.Check(20), ShouldNotBeNil)
+		left, limited := uq.Remaining()
+		So(limited, ShouldBeTrue)
+		So(left, ShouldEqual, 10)
+		q, u = uq.Tightest()
+		So(q, ShouldEqual, 2000)
+		So(uq.Ratio(), ShouldEqual, 90)
+	})
+
+	Convey("Test unlimited quotas", t, func() {
+		uq := &UserQuota{PersonalUsage: 50, TotalUsage: 900}
+		So(uq.Check(1<<40), ShouldBeNil)
+		_, limited := uq.Remaining()
+		So(limited, ShouldBeFalse)
+		So(uq.Ratio(), ShouldEqual, 0)
+		q, _ := uq.Tightest()
+		So(q, ShouldEqual, 0)
+	})
+
+	Convey("Test quota reader", t, func() {
+		r := &quotaReader{r: strings.NewReader(strings.Repeat("a", 100)), left: 100}
+		data, er := io.ReadAll(r)
+		So(er, ShouldBeNil)
+		So(data, ShouldHaveLength, 100)
+
+		r = &quotaReader{r: strings.NewReader(strings.Repeat("a", 101)), left: 100}
+		_, er = io.ReadAll(r)
+		So(errors.Is(er, errors.StatusQuotaReached), ShouldBeTrue)
+	})
+
+	Convey("Test workspace owner", t, func() {
+		ws := &idm.Workspace{Policies: []*service.ResourcePolicy{
+			{Subject: "subject:owner-uuid", Action: service.ResourcePolicyAction_READ},
+			{Subject: "owner-uuid", Action: service.ResourcePolicyAction_OWNER},
+		}}
+		So(WorkspaceOwner(ws), ShouldEqual, "owner-uuid")
+		So(WorkspaceOwner(&idm.Workspace{}), ShouldBeEmpty)
+	})
+}
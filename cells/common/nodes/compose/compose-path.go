@@ -26,14 +26,18 @@ import (
 	"github.com/pydio/cells/v5/common/nodes/acl"
 	"github.com/pydio/cells/v5/common/nodes/archive"
 	"github.com/pydio/cells/v5/common/nodes/binaries"
+	"github.com/pydio/cells/v5/common/nodes/compression"
 	"github.com/pydio/cells/v5/common/nodes/core"
 	"github.com/pydio/cells/v5/common/nodes/encryption"
 	"github.com/pydio/cells/v5/common/nodes/events"
+	"github.com/pydio/cells/v5/common/nodes/filerequest"
 	"github.com/pydio/cells/v5/common/nodes/path"
 	"github.com/pydio/cells/v5/common/nodes/put"
 	"github.com/pydio/cells/v5/common/nodes/sync"
+	"github.com/pydio/cells/v5/common/nodes/tiering"
 	"github.com/pydio/cells/v5/common/nodes/version"
 	"github.com/pydio/cells/v5/common/nodes/virtual"
+	"github.com/pydio/cells/v5/common/nodes/watermark"
 )
 
 func PathClient(oo ...nodes.Option) nodes.Client {
@@ -65,7 +69,9 @@ func pathComposer(oo ...nodes.Option) []nodes.Option {
 		events.WithAudit(),
 		acl.WithRefFilter(),
 		acl.WithFilter(),
+		filerequest.WithFileRequests(),
 		events.WithRead(),
+		watermark.WithWatermark(),
 		put.WithJobsDynamicMiddlewares(),
 		put.WithPutInterceptor(),
 		put.WithHashInterceptor(),
@@ -75,8 +81,10 @@ func pathComposer(oo ...nodes.Option) []nodes.Option {
 		acl.WithQuota(),
 		sync.WithFolderTasks(), // options.SynchronousTasks
 		version.WithVersions(),
+		compression.WithCompression(),
 		encryption.WithEncryption(),
 		core.WithFlatInterceptor(),
+		tiering.WithTiering(),
 		core.WithStructInterceptor(),
 	)
 }
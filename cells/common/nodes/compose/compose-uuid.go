@@ -23,12 +23,16 @@ package compose
 import (
 	"github.com/pydio/cells/v5/common/nodes"
 	"github.com/pydio/cells/v5/common/nodes/acl"
+	"github.com/pydio/cells/v5/common/nodes/compression"
 	"github.com/pydio/cells/v5/common/nodes/core"
 	"github.com/pydio/cells/v5/common/nodes/encryption"
 	"github.com/pydio/cells/v5/common/nodes/events"
+	"github.com/pydio/cells/v5/common/nodes/filerequest"
 	"github.com/pydio/cells/v5/common/nodes/put"
+	"github.com/pydio/cells/v5/common/nodes/tiering"
 	"github.com/pydio/cells/v5/common/nodes/uuid"
 	"github.com/pydio/cells/v5/common/nodes/version"
+	"github.com/pydio/cells/v5/common/nodes/watermark"
 )
 
 func UuidClient(oo ...nodes.Option) nodes.Client {
@@ -44,7 +48,9 @@ func uuidComposer(oo ...nodes.Option) []nodes.Option {
 		uuid.WithDatasource(),
 		events.WithAudit(),
 		acl.WithFilter(),
+		filerequest.WithFileRequests(),
 		//events.WithRead(), why not?
+		watermark.WithWatermark(),
 		put.WithJobsDynamicMiddlewares(),
 		put.WithPutInterceptor(),
 		put.WithHashInterceptor(),
@@ -54,8 +60,10 @@ func uuidComposer(oo ...nodes.Option) []nodes.Option {
 		acl.WithQuota(),
 
 		version.WithVersions(),
+		compression.WithCompression(),
 		encryption.WithEncryption(),
 		core.WithFlatInterceptor(),
+		tiering.WithTiering(),
 		core.WithStructInterceptor(),
 	)
 }
@@ -31,10 +31,12 @@ import (
 	"github.com/pydio/cells/v5/common/nodes/abstract"
 	"github.com/pydio/cells/v5/common/nodes/acl"
 	"github.com/pydio/cells/v5/common/nodes/archive"
+	"github.com/pydio/cells/v5/common/nodes/compression"
 	"github.com/pydio/cells/v5/common/nodes/core"
 	"github.com/pydio/cells/v5/common/nodes/encryption"
 	"github.com/pydio/cells/v5/common/nodes/path"
 	"github.com/pydio/cells/v5/common/nodes/put"
+	"github.com/pydio/cells/v5/common/nodes/tiering"
 	"github.com/pydio/cells/v5/common/nodes/version"
 	"github.com/pydio/cells/v5/common/nodes/virtual"
 	"github.com/pydio/cells/v5/common/permissions"
@@ -72,7 +74,9 @@ func ReverseClient(oo ...nodes.Option) *Reverse {
 		archive.WithArchives(),
 		put.WithPutInterceptor(),
 		version.WithVersions(),
+		compression.WithCompression(),
 		encryption.WithEncryption(),
+		tiering.WithTiering(),
 	)
 	cl := newClient(opts...)
 	return &Reverse{
@@ -0,0 +1,103 @@
+//go:build storage || sql
+
+/*
+ * Copyright (c) 2025 Abstrium SAS <team (at) pydio.com>
+ * This file is part of Pydio Cells.
+ *
+ * Pydio Cells is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * Pydio Cells is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with Pydio Cells.  If not, see <http://www.gnu.org/licenses/>.
+ *
+ * The latest code can be found at <https://pydio.com>.
+ */
+
+package compression
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/pydio/cells/v5/common"
+	"github.com/pydio/cells/v5/common/client/grpc"
+	"github.com/pydio/cells/v5/common/nodes"
+	enc "github.com/pydio/cells/v5/common/nodes/encryption"
+	"github.com/pydio/cells/v5/common/nodes/models"
+	"github.com/pydio/cells/v5/common/proto/encryption"
+	"github.com/pydio/cells/v5/common/proto/object"
+	"github.com/pydio/cells/v5/common/proto/tree"
+	"github.com/pydio/cells/v5/common/storage/test"
+	srv_dao "github.com/pydio/cells/v5/data/key/dao/sql"
+	srv "github.com/pydio/cells/v5/data/key/grpc"
+
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+var testcases = test.TemplateSQL(srv_dao.NewKeyDAO)
+
+func TestHandler_WithEncryption(t *testing.T) {
+
+	store := newMemoryStore()
+	encHandler := &enc.Handler{}
+	encHandler.SetNextHandler(store)
+	encHandler.SetUserKeyTool(enc.NewMockUserKeyTool())
+	handler := &Handler{}
+	handler.SetNextHandler(encHandler)
+
+	grpc.RegisterMock(common.ServiceEncKeyGRPC, &encryption.NodeKeyManagerStub{
+		NodeKeyManagerServer: srv.NewNodeKeyManagerHandler(),
+	})
+
+	branchInfo := nodes.BranchInfo{}
+	branchInfo.DataSource = &object.DataSource{
+		Name:                 "test",
+		FlatStorage:          true,
+		EncryptionMode:       object.EncryptionMode_MASTER,
+		StorageConfiguration: map[string]string{object.StorageKeyCompression: object.CompressionZstd},
+	}
+
+	var sb strings.Builder
+	for i := 0; i < 60000; i++ {
+		sb.WriteString(fmt.Sprintf("line %d of a quite repetitive log file\n", i))
+	}
+	plain := sb.String()
+
+	test.RunStorageTests(testcases, t, func(ctx context.Context) {
+
+		ctx = nodes.WithBranchInfo(ctx, "in", branchInfo)
+
+		Convey("Test data is compressed then encrypted", t, func() {
+			node := &tree.Node{Path: "file.log", Uuid: "file.log"}
+			node.MustSetMeta(common.MetaNamespaceDatasourceName, "test")
+			_, e := handler.PutObject(ctx, node, strings.NewReader(plain), &models.PutRequestData{Size: int64(len(plain))})
+			So(e, ShouldBeNil)
+			So(len(store.objects["file.log"]), ShouldBeLessThan, len(plain))
+			So(strings.Contains(string(store.objects["file.log"]), string(headerMagic)), ShouldBeFalse)
+		})
+
+		Convey("Test full and ranged reads through both handlers", t, func() {
+			node := &tree.Node{Path: "file.log"}
+			node.MustSetMeta(common.MetaNamespaceDatasourceName, "test")
+			reader, e := handler.GetObject(ctx, node, &models.GetRequestData{Length: -1})
+			So(e, ShouldBeNil)
+			read, _ := io.ReadAll(reader)
+			So(string(read), ShouldEqual, plain)
+
+			reader, e = handler.GetObject(ctx, node, &models.GetRequestData{StartOffset: defaultBlockSize - 10, Length: 100})
+			So(e, ShouldBeNil)
+			read, _ = io.ReadAll(reader)
+			So(string(read), ShouldEqual, plain[defaultBlockSize-10:defaultBlockSize+90])
+		})
+	})
+}
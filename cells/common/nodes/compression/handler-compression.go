@@ -0,0 +1,329 @@
+/*
+ * Copyright (c) 2025 Abstrium SAS <team (at) pydio.com>
+ * This file is part of Pydio Cells.
+ *
+ * Pydio Cells is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * Pydio Cells is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with Pydio Cells.  If not, see <http://www.gnu.org/licenses/>.
+ *
+ * The latest code can be found at <https://pydio.com>.
+ */
+
+// Package compression provides a nodes handler transparently compressing objects on datasources
+// having the "compression" storage configuration key set.
+package compression
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"strings"
+
+	"go.uber.org/zap"
+
+	"github.com/pydio/cells/v5/common"
+	"github.com/pydio/cells/v5/common/client/grpc"
+	"github.com/pydio/cells/v5/common/errors"
+	"github.com/pydio/cells/v5/common/nodes"
+	"github.com/pydio/cells/v5/common/nodes/abstract"
+	"github.com/pydio/cells/v5/common/nodes/models"
+	"github.com/pydio/cells/v5/common/proto/encryption"
+	"github.com/pydio/cells/v5/common/proto/object"
+	"github.com/pydio/cells/v5/common/proto/tree"
+	"github.com/pydio/cells/v5/common/telemetry/log"
+)
+
+// tailFetchSize is the number of bytes read at the end of an object to load the compression table.
+// It covers 8192 blocks (8GB of plain data) in one request.
+const tailFetchSize = 64*1024 + footerSize
+
+func WithCompression() nodes.Option {
+	return func(options *nodes.RouterOptions) {
+		options.Wrappers = append(options.Wrappers, &Handler{})
+	}
+}
+
+// Handler compresses data on PutObject and decompresses it on GetObject for datasources with compression
+// enabled. It must be registered before the encryption handler, so that data is compressed then encrypted.
+// Multipart uploads are not compressed, as S3 requires a minimal size for each part.
+type Handler struct {
+	abstract.Handler
+}
+
+func (c *Handler) Adapt(h nodes.Handler, options nodes.RouterOptions) nodes.Handler {
+	c.AdaptOptions(h, options)
+	return c
+}
+
+// GetObject reads the compression table of the stored object and decompresses the blocks covering the requested range.
+// Objects that were not stored compressed (written before compression was enabled, or with multipart uploads) are
+// returned as is.
+func (c *Handler) GetObject(ctx context.Context, node *tree.Node, requestData *models.GetRequestData) (io.ReadCloser, error) {
+	if strings.HasSuffix(node.Path, common.PydioSyncHiddenFile) {
+		return c.Next.GetObject(ctx, node, requestData)
+	}
+	branchInfo, er := nodes.GetBranchInfo(ctx, "in")
+	if er != nil || !branchInfo.CompressionEnabled() {
+		return c.Next.GetObject(ctx, node, requestData)
+	}
+
+	clone := node.Clone()
+	if clone.Uuid == "" {
+		rsp, readErr := c.Next.ReadNode(ctx, &tree.ReadNodeRequest{Node: node})
+		if readErr != nil {
+			return nil, readErr
+		}
+		clone.Uuid = rsp.GetNode().GetUuid()
+	}
+	stored, err := c.storedSize(ctx, branchInfo, clone)
+	if err != nil {
+		return nil, err
+	}
+	if stored < headerSize+footerSize {
+		return c.Next.GetObject(ctx, node, requestData)
+	}
+	clone.Size = stored
+
+	table, err := c.loadTable(ctx, clone, requestData.VersionId)
+	if err != nil {
+		if compressed, hErr := c.hasHeader(ctx, clone, requestData.VersionId); hErr != nil {
+			return nil, hErr
+		} else if compressed {
+			log.Logger(ctx).Error("views.handler.compression.GetObject: cannot read compression table", clone.ZapUuid(), zap.Error(err))
+			return nil, errors.Tag(err, errors.StatusDataLoss)
+		}
+		log.Logger(ctx).Debug("views.handler.compression.GetObject: object is not compressed, reading as is", clone.ZapUuid(), zap.Error(err))
+		return c.Next.GetObject(ctx, node, requestData)
+	}
+
+	offset, length := requestData.StartOffset, requestData.Length
+	if length < 0 {
+		length = table.plainSize - offset
+	}
+	if offset < 0 || length < 0 || offset+length > table.plainSize {
+		return nil, errors.WithStack(errors.StatusOutOfRange)
+	}
+	first, last := table.blocksForRange(offset, length)
+	if length == 0 || first == -1 || last < first {
+		return io.NopCloser(strings.NewReader("")), nil
+	}
+
+	compStart := table.compressedStart[first]
+	compEnd := table.compressedStart[last] + int64(table.blocks[last].compressedSize)
+	reader, err := c.Next.GetObject(ctx, clone, &models.GetRequestData{
+		StartOffset: compStart,
+		Length:      compEnd - compStart,
+		VersionId:   requestData.VersionId,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return newDecompressingReader(reader, table.blocks[first:last+1], offset-table.plainStart[first], length), nil
+}
+
+// PutObject compresses the input stream and stores the plain size as metadata.
+func (c *Handler) PutObject(ctx context.Context, node *tree.Node, reader io.Reader, requestData *models.PutRequestData) (models.ObjectInfo, error) {
+	if strings.HasSuffix(node.Path, common.PydioSyncHiddenFile) {
+		return c.Next.PutObject(ctx, node, reader, requestData)
+	}
+	branchInfo, er := nodes.GetBranchInfo(ctx, "in")
+	if er != nil || !branchInfo.CompressionEnabled() {
+		return c.Next.PutObject(ctx, node, reader, requestData)
+	}
+	if !branchInfo.FlatStorage {
+		return models.ObjectInfo{}, errors.WithMessagef(errors.StatusForbidden, "compression is only supported on flat datasources (%s)", branchInfo.Name)
+	}
+
+	if requestData.Metadata == nil {
+		requestData.Metadata = make(map[string]string, 2)
+	}
+	plainSizeUnknown := requestData.Size < 0
+	if plainSizeUnknown {
+		requestData.Metadata[common.XAmzMetaClearSize] = common.XAmzMetaClearSizeUnknown
+	} else {
+		requestData.Metadata[common.XAmzMetaClearSize] = fmt.Sprintf("%d", requestData.Size)
+	}
+	requestData.Metadata[common.XAmzMetaCompression] = object.CompressionZstd
+	requestData.Md5Sum = nil
+	requestData.Sha256Sum = nil
+	requestData.Size = -1
+
+	compressor := newCompressingReader(reader, defaultBlockSize)
+	oi, err := c.Next.PutObject(ctx, node, compressor, requestData)
+	if err == nil && plainSizeUnknown {
+		if psErr := c.updatePlainSize(ctx, node, compressor.PlainSize()); psErr != nil {
+			log.Logger(ctx).Error("views.handler.compression.PutObject: failed to update node plain size info", zap.Error(psErr))
+		}
+	}
+	return oi, err
+}
+
+// CopyObject lets same-client copies flow (compressed data and metadata are copied along), and otherwise
+// decompresses and recompresses data on the fly if source or target datasource uses compression.
+func (c *Handler) CopyObject(ctx context.Context, from *tree.Node, to *tree.Node, requestData *models.CopyRequestData) (models.ObjectInfo, error) {
+	srcInfo, er1 := nodes.GetBranchInfo(ctx, "from")
+	destInfo, er2 := nodes.GetBranchInfo(ctx, "to")
+	if er1 != nil || er2 != nil {
+		return models.ObjectInfo{}, errors.Tag(er1, er2)
+	}
+	if !srcInfo.CompressionEnabled() && !destInfo.CompressionEnabled() {
+		return c.Next.CopyObject(ctx, from, to, requestData)
+	}
+	if destInfo.Client == srcInfo.Client {
+		return c.Next.CopyObject(ctx, from, to, requestData)
+	}
+	readCtx := nodes.WithBranchInfo(ctx, "in", srcInfo, true)
+	writeCtx := nodes.WithBranchInfo(ctx, "in", destInfo, true)
+
+	cloneFrom := from.Clone()
+	cloneTo := to.Clone()
+	if cloneFrom.Uuid == "" || cloneFrom.Size == 0 {
+		rsp, readErr := c.Next.ReadNode(readCtx, &tree.ReadNodeRequest{Node: cloneFrom})
+		if readErr != nil {
+			return models.ObjectInfo{}, readErr
+		} else if rsp.GetNode() == nil {
+			return models.ObjectInfo{}, errors.WithMessage(errors.NodeNotFound, cloneFrom.Path)
+		}
+		cloneFrom = rsp.GetNode()
+	}
+	reader, err := c.GetObject(readCtx, cloneFrom, &models.GetRequestData{StartOffset: 0, Length: -1, VersionId: requestData.SrcVersionId})
+	if err != nil {
+		return models.ObjectInfo{}, err
+	}
+	defer reader.Close()
+
+	if requestData.Metadata == nil {
+		requestData.Metadata = map[string]string{}
+	}
+	if toUuid, ok := requestData.Metadata[common.XAmzMetaNodeUuid]; ok {
+		cloneTo.Uuid = toUuid
+	} else if requestData.IsMove() {
+		cloneTo.Uuid = cloneFrom.GetUuid()
+	} else {
+		cloneTo.RenewUuidIfEmpty(cloneTo.GetUuid() == cloneFrom.GetUuid())
+	}
+	if destInfo.FlatStorage && requestData.SrcVersionId == "" {
+		// Insert in tree as temporary
+		cloneTo.Type = tree.NodeType_LEAF
+		cloneTo.Etag = common.NodeFlagEtagTemporary
+		if _, er := nodes.GetSourcesPool(ctx).GetTreeClientWrite().CreateNode(writeCtx, &tree.CreateNodeRequest{Node: cloneTo}); er != nil {
+			return models.ObjectInfo{}, er
+		}
+	}
+	putReqData := &models.PutRequestData{
+		Size:     cloneFrom.Size,
+		Metadata: requestData.Metadata,
+	}
+	putReqData.Metadata[common.XAmzMetaNodeUuid] = cloneTo.Uuid
+	delete(putReqData.Metadata, common.XAmzMetaDirective)
+	oi, err := c.PutObject(writeCtx, cloneTo, reader, putReqData)
+	if err != nil {
+		log.Logger(ctx).Error("views.handler.compression.CopyObject: Different Clients", zap.Error(err), cloneFrom.Zap("from"), cloneTo.Zap("to"))
+	}
+	return oi, err
+}
+
+// storedSize finds the size of the compressed stream. If datasource is also encrypted, the stream
+// size is the encryption plain size, otherwise it is the size of the object in storage.
+func (c *Handler) storedSize(ctx context.Context, branchInfo nodes.BranchInfo, node *tree.Node) (int64, error) {
+	if branchInfo.EncryptedWithNodeKeys() {
+		cli := encryption.NewNodeKeyManagerClient(grpc.ResolveConn(ctx, common.ServiceEncKeyGRPC))
+		rsp, er := cli.GetNodePlainSize(ctx, &encryption.GetNodePlainSizeRequest{
+			NodeId: node.Uuid,
+			UserId: "ds:" + branchInfo.Name,
+		})
+		if er != nil {
+			return 0, er
+		}
+		return rsp.GetSize(), nil
+	}
+	rsp, er := c.Next.ReadNode(ctx, &tree.ReadNodeRequest{Node: node, ObjectStats: true})
+	if er != nil {
+		return 0, er
+	}
+	return rsp.GetNode().GetSize(), nil
+}
+
+// loadTable reads the end of the object to find the compression table, and checks its consistency against
+// the stored size.
+func (c *Handler) loadTable(ctx context.Context, node *tree.Node, versionId string) (*seekTable, error) {
+	tail, er := c.readRange(ctx, node, versionId, min(node.Size, tailFetchSize))
+	if er != nil {
+		return nil, er
+	}
+	tSize, er := tableSizeFromFooter(tail)
+	if er != nil {
+		return nil, er
+	}
+	if tSize > int64(len(tail)) {
+		if tSize > node.Size-headerSize {
+			return nil, errors.New("invalid compression table size")
+		}
+		if tail, er = c.readRange(ctx, node, versionId, tSize); er != nil {
+			return nil, er
+		}
+	}
+	table, er := parseSeekTable(tail)
+	if er != nil {
+		return nil, er
+	}
+	if table.storedSize() != node.Size {
+		return nil, errors.New("compression table does not match object size")
+	}
+	return table, nil
+}
+
+// hasHeader checks if the stored stream starts with the compression header magic, to tell apart objects
+// that are compressed (and must be decoded) from objects stored as is.
+func (c *Handler) hasHeader(ctx context.Context, node *tree.Node, versionId string) (bool, error) {
+	reader, er := c.Next.GetObject(ctx, node, &models.GetRequestData{
+		StartOffset: 0,
+		Length:      headerSize,
+		VersionId:   versionId,
+	})
+	if er != nil {
+		return false, er
+	}
+	defer reader.Close()
+	head := make([]byte, headerSize)
+	if _, er = io.ReadFull(reader, head); er != nil {
+		return false, nil
+	}
+	return bytes.Equal(head, headerMagic), nil
+}
+
+// readRange reads the last length bytes of the stored stream.
+func (c *Handler) readRange(ctx context.Context, node *tree.Node, versionId string, length int64) ([]byte, error) {
+	reader, er := c.Next.GetObject(ctx, node, &models.GetRequestData{
+		StartOffset: node.Size - length,
+		Length:      length,
+		VersionId:   versionId,
+	})
+	if er != nil {
+		return nil, er
+	}
+	defer reader.Close()
+	return io.ReadAll(reader)
+}
+
+func (c *Handler) updatePlainSize(ctx context.Context, node *tree.Node, plainSize int64) error {
+	pool := nodes.GetSourcesPool(ctx)
+	r, rErr := pool.GetTreeClient().ReadNode(ctx, &tree.ReadNodeRequest{Node: &tree.Node{Uuid: node.Uuid, Path: node.Path}})
+	if rErr != nil {
+		return rErr
+	}
+	update := r.GetNode()
+	update.Size = plainSize
+	_, uErr := pool.GetTreeClientWrite().CreateNode(ctx, &tree.CreateNodeRequest{Node: update, UpdateIfExists: true})
+	return uErr
+}
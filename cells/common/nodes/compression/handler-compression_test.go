@@ -0,0 +1,174 @@
+/*
+ * Copyright (c) 2025 Abstrium SAS <team (at) pydio.com>
+ * This file is part of Pydio Cells.
+ *
+ * Pydio Cells is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * Pydio Cells is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with Pydio Cells.  If not, see <http://www.gnu.org/licenses/>.
+ *
+ * The latest code can be found at <https://pydio.com>.
+ */
+
+package compression
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"strings"
+	"testing"
+
+	"google.golang.org/grpc"
+
+	"github.com/pydio/cells/v5/common"
+	"github.com/pydio/cells/v5/common/errors"
+	"github.com/pydio/cells/v5/common/nodes"
+	"github.com/pydio/cells/v5/common/nodes/models"
+	"github.com/pydio/cells/v5/common/proto/object"
+	"github.com/pydio/cells/v5/common/proto/tree"
+
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+// memoryStore is a minimal storage mock keeping objects in memory and honoring ranged reads.
+type memoryStore struct {
+	*nodes.HandlerMock
+	objects  map[string][]byte
+	metadata map[string]map[string]string
+}
+
+func newMemoryStore() *memoryStore {
+	return &memoryStore{
+		HandlerMock: nodes.NewHandlerMock(),
+		objects:     map[string][]byte{},
+		metadata:    map[string]map[string]string{},
+	}
+}
+
+func (m *memoryStore) ReadNode(ctx context.Context, in *tree.ReadNodeRequest, opts ...grpc.CallOption) (*tree.ReadNodeResponse, error) {
+	data, ok := m.objects[in.GetNode().GetPath()]
+	if !ok {
+		return nil, errors.WithStack(errors.NodeNotFound)
+	}
+	return &tree.ReadNodeResponse{Node: &tree.Node{Path: in.GetNode().GetPath(), Uuid: in.GetNode().GetPath(), Size: int64(len(data))}}, nil
+}
+
+func (m *memoryStore) GetObject(ctx context.Context, node *tree.Node, requestData *models.GetRequestData) (io.ReadCloser, error) {
+	data, ok := m.objects[node.GetPath()]
+	if !ok {
+		return nil, errors.WithStack(errors.NodeNotFound)
+	}
+	start, end := requestData.StartOffset, int64(len(data))
+	if requestData.Length >= 0 && start+requestData.Length < end {
+		end = start + requestData.Length
+	}
+	return io.NopCloser(bytes.NewReader(data[start:end])), nil
+}
+
+func (m *memoryStore) PutObject(ctx context.Context, node *tree.Node, reader io.Reader, requestData *models.PutRequestData) (models.ObjectInfo, error) {
+	data, er := io.ReadAll(reader)
+	if er != nil {
+		return models.ObjectInfo{}, er
+	}
+	m.objects[node.GetPath()] = data
+	m.metadata[node.GetPath()] = requestData.Metadata
+	return models.ObjectInfo{Size: int64(len(data))}, nil
+}
+
+func compressionContext(flat bool) context.Context {
+	branchInfo := nodes.BranchInfo{}
+	branchInfo.DataSource = &object.DataSource{
+		Name:                 "test",
+		FlatStorage:          flat,
+		StorageConfiguration: map[string]string{object.StorageKeyCompression: object.CompressionZstd},
+	}
+	return nodes.WithBranchInfo(context.Background(), "in", branchInfo)
+}
+
+func TestHandler_PutGet(t *testing.T) {
+
+	var sb strings.Builder
+	for i := 0; i < 100000; i++ {
+		sb.WriteString(fmt.Sprintf("line %d of a quite repetitive log file\n", i))
+	}
+	plain := sb.String()
+
+	Convey("Test Put and Get on a flat datasource", t, func() {
+		store := newMemoryStore()
+		handler := &Handler{}
+		handler.SetNextHandler(store)
+		ctx := compressionContext(true)
+
+		_, er := handler.PutObject(ctx, &tree.Node{Path: "file.log"}, strings.NewReader(plain), &models.PutRequestData{Size: int64(len(plain))})
+		So(er, ShouldBeNil)
+		So(len(store.objects["file.log"]), ShouldBeLessThan, len(plain))
+		So(store.metadata["file.log"][common.XAmzMetaCompression], ShouldEqual, object.CompressionZstd)
+		So(store.metadata["file.log"][common.XAmzMetaClearSize], ShouldEqual, fmt.Sprintf("%d", len(plain)))
+
+		reader, er := handler.GetObject(ctx, &tree.Node{Path: "file.log"}, &models.GetRequestData{Length: -1})
+		So(er, ShouldBeNil)
+		read, _ := io.ReadAll(reader)
+		So(string(read), ShouldEqual, plain)
+
+		reader, er = handler.GetObject(ctx, &tree.Node{Path: "file.log"}, &models.GetRequestData{StartOffset: defaultBlockSize - 10, Length: 100})
+		So(er, ShouldBeNil)
+		read, _ = io.ReadAll(reader)
+		So(string(read), ShouldEqual, plain[defaultBlockSize-10:defaultBlockSize+90])
+	})
+
+	Convey("Test compression is refused on structured datasources", t, func() {
+		store := newMemoryStore()
+		handler := &Handler{}
+		handler.SetNextHandler(store)
+
+		_, er := handler.PutObject(compressionContext(false), &tree.Node{Path: "file.log"}, strings.NewReader(plain), &models.PutRequestData{Size: int64(len(plain))})
+		So(er, ShouldNotBeNil)
+		So(store.objects, ShouldBeEmpty)
+	})
+
+	Convey("Test objects stored before compression are read as is", t, func() {
+		store := newMemoryStore()
+		store.objects["legacy.txt"] = []byte("plain content written before compression was enabled")
+		handler := &Handler{}
+		handler.SetNextHandler(store)
+
+		reader, er := handler.GetObject(compressionContext(true), &tree.Node{Path: "legacy.txt"}, &models.GetRequestData{Length: -1})
+		So(er, ShouldBeNil)
+		read, _ := io.ReadAll(reader)
+		So(string(read), ShouldEqual, "plain content written before compression was enabled")
+	})
+
+	Convey("Test corrupted compressed objects are not served raw", t, func() {
+		store := newMemoryStore()
+		handler := &Handler{}
+		handler.SetNextHandler(store)
+		ctx := compressionContext(true)
+
+		_, er := handler.PutObject(ctx, &tree.Node{Path: "file.log"}, strings.NewReader(plain), &models.PutRequestData{Size: int64(len(plain))})
+		So(er, ShouldBeNil)
+		stored := store.objects["file.log"]
+
+		// Truncated object: table no longer matches the stored size
+		store.objects["file.log"] = append(stored[:headerSize+10:headerSize+10], stored[len(stored)-footerSize-tableEntrySize:]...)
+		_, er = handler.GetObject(ctx, &tree.Node{Path: "file.log"}, &models.GetRequestData{Length: -1})
+		So(er, ShouldNotBeNil)
+		So(errors.Is(er, errors.StatusDataLoss), ShouldBeTrue)
+
+		// Broken footer
+		broken := bytes.Clone(stored)
+		copy(broken[len(broken)-8:], "XXXXXXXX")
+		store.objects["file.log"] = broken
+		_, er = handler.GetObject(ctx, &tree.Node{Path: "file.log"}, &models.GetRequestData{Length: -1})
+		So(er, ShouldNotBeNil)
+	})
+}
@@ -0,0 +1,293 @@
+/*
+ * Copyright (c) 2025 Abstrium SAS <team (at) pydio.com>
+ * This file is part of Pydio Cells.
+ *
+ * Pydio Cells is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * Pydio Cells is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with Pydio Cells.  If not, see <http://www.gnu.org/licenses/>.
+ *
+ * The latest code can be found at <https://pydio.com>.
+ */
+
+package compression
+
+import (
+	"bytes"
+	"encoding/binary"
+	"io"
+	"sync"
+
+	"github.com/klauspost/compress/zstd"
+
+	"github.com/pydio/cells/v5/common/errors"
+)
+
+/*
+Compressed objects are stored with the following layout, all integers being little-endian:
+
+	header  | "PYZSTD01"                                  (8 bytes)
+	blocks  | N independent zstd frames                   (variable)
+	table   | N x [compressedSize uint32, plainSize uint32] (8 bytes per block)
+	footer  | [N uint32, blockSize uint32, "PYZSTEND"]     (16 bytes)
+
+Each block holds at most blockSize plain bytes, so that a plain range can be mapped to
+a range of compressed blocks by reading the table at the end of the object.
+*/
+
+const (
+	defaultBlockSize = 1024 * 1024
+	headerSize       = 8
+	footerSize       = 16
+	tableEntrySize   = 8
+)
+
+var (
+	headerMagic = []byte("PYZSTD01")
+	footerMagic = []byte("PYZSTEND")
+
+	encoderOnce sync.Once
+	encoder     *zstd.Encoder
+	decoderOnce sync.Once
+	decoder     *zstd.Decoder
+)
+
+func getEncoder() *zstd.Encoder {
+	encoderOnce.Do(func() {
+		encoder, _ = zstd.NewWriter(nil, zstd.WithEncoderConcurrency(1))
+	})
+	return encoder
+}
+
+func getDecoder() *zstd.Decoder {
+	decoderOnce.Do(func() {
+		decoder, _ = zstd.NewReader(nil, zstd.WithDecoderConcurrency(1), zstd.WithDecoderMaxMemory(4*defaultBlockSize))
+	})
+	return decoder
+}
+
+// blockEntry describes one compressed block inside the object
+type blockEntry struct {
+	compressedSize uint32
+	plainSize      uint32
+}
+
+// seekTable is the parsed table of a compressed object, with absolute offsets.
+type seekTable struct {
+	blocks          []blockEntry
+	compressedStart []int64
+	plainStart      []int64
+	plainSize       int64
+}
+
+// storedSize returns the expected size of the stored object for this table.
+func (t *seekTable) storedSize() int64 {
+	size := int64(headerSize + footerSize + len(t.blocks)*tableEntrySize)
+	for _, b := range t.blocks {
+		size += int64(b.compressedSize)
+	}
+	return size
+}
+
+// tableSizeFromFooter checks footer magic and returns the size of the table + footer.
+func tableSizeFromFooter(footer []byte) (int64, error) {
+	if len(footer) < footerSize || !bytes.Equal(footer[len(footer)-8:], footerMagic) {
+		return 0, errors.New("invalid compression footer")
+	}
+	f := footer[len(footer)-footerSize:]
+	count := binary.LittleEndian.Uint32(f[0:4])
+	return int64(count)*tableEntrySize + footerSize, nil
+}
+
+// parseSeekTable reads table and footer from the tail of an object.
+func parseSeekTable(tail []byte) (*seekTable, error) {
+	tSize, er := tableSizeFromFooter(tail)
+	if er != nil {
+		return nil, er
+	}
+	if int64(len(tail)) < tSize {
+		return nil, errors.New("compression table is truncated")
+	}
+	raw := tail[int64(len(tail))-tSize : len(tail)-footerSize]
+	count := len(raw) / tableEntrySize
+	t := &seekTable{
+		blocks:          make([]blockEntry, count),
+		compressedStart: make([]int64, count),
+		plainStart:      make([]int64, count),
+	}
+	offset := int64(headerSize)
+	for i := 0; i < count; i++ {
+		e := blockEntry{
+			compressedSize: binary.LittleEndian.Uint32(raw[i*tableEntrySize:]),
+			plainSize:      binary.LittleEndian.Uint32(raw[i*tableEntrySize+4:]),
+		}
+		t.blocks[i] = e
+		t.compressedStart[i] = offset
+		t.plainStart[i] = t.plainSize
+		offset += int64(e.compressedSize)
+		t.plainSize += int64(e.plainSize)
+	}
+	return t, nil
+}
+
+// blocksForRange finds the first and last blocks covering the plain range [offset, offset+length).
+func (t *seekTable) blocksForRange(offset, length int64) (first, last int) {
+	end := offset + length
+	first, last = -1, -1
+	for i := range t.blocks {
+		bStart := t.plainStart[i]
+		bEnd := bStart + int64(t.blocks[i].plainSize)
+		if first == -1 && offset < bEnd {
+			first = i
+		}
+		if bStart < end {
+			last = i
+		}
+	}
+	return
+}
+
+// compressingReader compresses an underlying plain reader on the fly, producing the full stored layout.
+type compressingReader struct {
+	source    io.Reader
+	blockSize int
+	plain     []byte
+	pending   []byte
+	table     []blockEntry
+	plainRead int64
+	started   bool
+	done      bool
+}
+
+func newCompressingReader(source io.Reader, blockSize int) *compressingReader {
+	if blockSize <= 0 {
+		blockSize = defaultBlockSize
+	}
+	return &compressingReader{
+		source:    source,
+		blockSize: blockSize,
+		plain:     make([]byte, blockSize),
+	}
+}
+
+// PlainSize returns the number of plain bytes read so far from the source.
+func (c *compressingReader) PlainSize() int64 {
+	return c.plainRead
+}
+
+func (c *compressingReader) Read(p []byte) (int, error) {
+	for len(c.pending) == 0 {
+		if c.done {
+			return 0, io.EOF
+		}
+		if er := c.fill(); er != nil {
+			return 0, er
+		}
+	}
+	n := copy(p, c.pending)
+	c.pending = c.pending[n:]
+	return n, nil
+}
+
+// fill prepares the next chunk of output: header, a compressed block or table and footer.
+func (c *compressingReader) fill() error {
+	if !c.started {
+		c.started = true
+		c.pending = append(c.pending[:0], headerMagic...)
+		return nil
+	}
+	n, er := io.ReadFull(c.source, c.plain)
+	if n > 0 {
+		c.plainRead += int64(n)
+		c.pending = getEncoder().EncodeAll(c.plain[:n], c.pending[:0])
+		c.table = append(c.table, blockEntry{compressedSize: uint32(len(c.pending)), plainSize: uint32(n)})
+	}
+	if er == io.EOF || er == io.ErrUnexpectedEOF {
+		if n == 0 {
+			c.pending = c.pending[:0]
+		}
+		// Last block (if any) is directly followed by table and footer
+		c.pending = c.appendTableAndFooter(c.pending)
+		c.done = true
+		return nil
+	}
+	return er
+}
+
+func (c *compressingReader) appendTableAndFooter(buf []byte) []byte {
+	for _, e := range c.table {
+		buf = binary.LittleEndian.AppendUint32(buf, e.compressedSize)
+		buf = binary.LittleEndian.AppendUint32(buf, e.plainSize)
+	}
+	buf = binary.LittleEndian.AppendUint32(buf, uint32(len(c.table)))
+	buf = binary.LittleEndian.AppendUint32(buf, uint32(c.blockSize))
+	return append(buf, footerMagic...)
+}
+
+// decompressingReader decodes a sequence of blocks and returns the requested plain window.
+type decompressingReader struct {
+	source io.ReadCloser
+	blocks []blockEntry
+	skip   int64
+	remain int64
+	buf    []byte
+	comp   []byte
+}
+
+func newDecompressingReader(source io.ReadCloser, blocks []blockEntry, skip, length int64) *decompressingReader {
+	return &decompressingReader{
+		source: source,
+		blocks: blocks,
+		skip:   skip,
+		remain: length,
+	}
+}
+
+func (d *decompressingReader) Read(p []byte) (int, error) {
+	for len(d.buf) == 0 {
+		if d.remain <= 0 || len(d.blocks) == 0 {
+			return 0, io.EOF
+		}
+		b := d.blocks[0]
+		d.blocks = d.blocks[1:]
+		if cap(d.comp) < int(b.compressedSize) {
+			d.comp = make([]byte, b.compressedSize)
+		}
+		d.comp = d.comp[:b.compressedSize]
+		if _, er := io.ReadFull(d.source, d.comp); er != nil {
+			return 0, er
+		}
+		plain, er := getDecoder().DecodeAll(d.comp, make([]byte, 0, b.plainSize))
+		if er != nil {
+			return 0, er
+		}
+		if len(plain) != int(b.plainSize) {
+			return 0, errors.New("decompressed block size does not match compression table")
+		}
+		if d.skip > 0 {
+			s := min(d.skip, int64(len(plain)))
+			plain = plain[s:]
+			d.skip -= s
+		}
+		if int64(len(plain)) > d.remain {
+			plain = plain[:d.remain]
+		}
+		d.buf = plain
+	}
+	n := copy(p, d.buf)
+	d.buf = d.buf[n:]
+	d.remain -= int64(n)
+	return n, nil
+}
+
+func (d *decompressingReader) Close() error {
+	return d.source.Close()
+}
@@ -0,0 +1,103 @@
+/*
+ * Copyright (c) 2025 Abstrium SAS <team (at) pydio.com>
+ * This file is part of Pydio Cells.
+ *
+ * Pydio Cells is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * Pydio Cells is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with Pydio Cells.  If not, see <http://www.gnu.org/licenses/>.
+ *
+ * The latest code can be found at <https://pydio.com>.
+ */
+
+package compression
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"strings"
+	"testing"
+
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func compressAll(plain []byte, blockSize int) ([]byte, *compressingReader) {
+	cr := newCompressingReader(bytes.NewReader(plain), blockSize)
+	out, _ := io.ReadAll(cr)
+	return out, cr
+}
+
+func readPlainRange(stored []byte, offset, length int64) ([]byte, error) {
+	table, er := parseSeekTable(stored)
+	if er != nil {
+		return nil, er
+	}
+	if length < 0 {
+		length = table.plainSize - offset
+	}
+	first, last := table.blocksForRange(offset, length)
+	if length == 0 || first == -1 {
+		return []byte{}, nil
+	}
+	start := table.compressedStart[first]
+	end := table.compressedStart[last] + int64(table.blocks[last].compressedSize)
+	src := io.NopCloser(bytes.NewReader(stored[start:end]))
+	return io.ReadAll(newDecompressingReader(src, table.blocks[first:last+1], offset-table.plainStart[first], length))
+}
+
+func TestCompressionBlocks(t *testing.T) {
+
+	var sb strings.Builder
+	for i := 0; i < 5000; i++ {
+		sb.WriteString(fmt.Sprintf("2025-01-01 10:00:%02d INFO line number %d\n", i%60, i))
+	}
+	plain := []byte(sb.String())
+
+	Convey("Test full round-trip", t, func() {
+		stored, cr := compressAll(plain, 4096)
+		So(cr.PlainSize(), ShouldEqual, len(plain))
+		So(len(stored), ShouldBeLessThan, len(plain))
+		So(bytes.HasPrefix(stored, headerMagic), ShouldBeTrue)
+
+		table, er := parseSeekTable(stored)
+		So(er, ShouldBeNil)
+		So(table.plainSize, ShouldEqual, len(plain))
+		So(table.storedSize(), ShouldEqual, len(stored))
+
+		read, er := readPlainRange(stored, 0, -1)
+		So(er, ShouldBeNil)
+		So(read, ShouldResemble, plain)
+	})
+
+	Convey("Test ranged reads across blocks", t, func() {
+		stored, _ := compressAll(plain, 4096)
+		for _, r := range [][2]int64{{0, 10}, {4090, 20}, {4096, 4096}, {10000, 30000}, {int64(len(plain)) - 5, 5}} {
+			read, er := readPlainRange(stored, r[0], r[1])
+			So(er, ShouldBeNil)
+			So(read, ShouldResemble, plain[r[0]:r[0]+r[1]])
+		}
+	})
+
+	Convey("Test empty input", t, func() {
+		stored, _ := compressAll([]byte{}, 4096)
+		So(len(stored), ShouldEqual, headerSize+footerSize)
+		table, er := parseSeekTable(stored)
+		So(er, ShouldBeNil)
+		So(table.plainSize, ShouldEqual, 0)
+		So(table.storedSize(), ShouldEqual, len(stored))
+	})
+
+	Convey("Test uncompressed data is rejected", t, func() {
+		_, er := parseSeekTable(plain)
+		So(er, ShouldNotBeNil)
+	})
+}
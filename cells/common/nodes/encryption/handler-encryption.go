@@ -36,7 +36,6 @@ import (
 	"github.com/pydio/cells/v5/common/nodes/abstract"
 	"github.com/pydio/cells/v5/common/nodes/models"
 	"github.com/pydio/cells/v5/common/proto/encryption"
-	"github.com/pydio/cells/v5/common/proto/object"
 	"github.com/pydio/cells/v5/common/proto/tree"
 	"github.com/pydio/cells/v5/common/telemetry/log"
 )
@@ -77,7 +76,7 @@ func (e *Handler) GetObject(ctx context.Context, node *tree.Node, requestData *m
 	}
 
 	branchInfo, er := nodes.GetBranchInfo(ctx, "in")
-	if er != nil || branchInfo.EncryptionMode != object.EncryptionMode_MASTER {
+	if er != nil || !branchInfo.EncryptedWithNodeKeys() {
 		return e.Next.GetObject(ctx, node, requestData)
 	}
 
@@ -120,13 +119,13 @@ func (e *Handler) GetObject(ctx context.Context, node *tree.Node, requestData *m
 		length = -1
 	}
 
-	keyProtectionTool, err := e.getKeyProtectionTool(ctx)
+	keyProtectionTool, err := e.getKeyProtectionTool(ctx, branchInfo)
 	if err != nil {
 		log.Logger(ctx).Error("views.handler.encryption.GetObject: failed to load key tool", zap.Error(err))
 		return nil, err
 	}
 
-	plainKey, err := keyProtectionTool.GetDecrypted(ctx, branchInfo.EncryptionKey, info.NodeKey.KeyData)
+	plainKey, err := e.openNodeKey(ctx, keyProtectionTool, branchInfo, info.NodeKey.KeyData)
 	if err != nil {
 		log.Logger(ctx).Error("views.handler.encryption.GetObject: failed to decrypt materials key", zap.String("user", dsName), zap.Error(err))
 		return nil, err
@@ -187,7 +186,7 @@ func (e *Handler) PutObject(ctx context.Context, node *tree.Node, reader io.Read
 
 	branchInfo, er := nodes.GetBranchInfo(ctx, "in")
 	var err error
-	if er != nil || branchInfo.EncryptionMode != object.EncryptionMode_MASTER {
+	if er != nil || !branchInfo.EncryptedWithNodeKeys() {
 		return e.Next.PutObject(ctx, node, reader, requestData)
 	}
 
@@ -212,7 +211,7 @@ func (e *Handler) PutObject(ctx context.Context, node *tree.Node, reader io.Read
 		clone.MustSetMeta(common.MetaNamespaceDatasourceName, branchInfo.Name)
 	}
 
-	keyProtectionTool, err := e.getKeyProtectionTool(ctx)
+	keyProtectionTool, err := e.getKeyProtectionTool(ctx, branchInfo)
 	if err != nil {
 		return models.ObjectInfo{}, err
 	}
@@ -252,7 +251,7 @@ func (e *Handler) PutObject(ctx context.Context, node *tree.Node, reader io.Read
 		}
 
 	} else {
-		encryptionKeyPlainBytes, err = keyProtectionTool.GetDecrypted(ctx, branchInfo.EncryptionKey, info.NodeKey.KeyData)
+		encryptionKeyPlainBytes, err = e.openNodeKey(ctx, keyProtectionTool, branchInfo, info.NodeKey.KeyData)
 		if err != nil {
 			log.Logger(ctx).Error("views.handler.encryption.PutObject: failed to decrypt key", zap.Error(err))
 			return models.ObjectInfo{}, err
@@ -278,7 +277,10 @@ func (e *Handler) PutObject(ctx context.Context, node *tree.Node, reader io.Read
 	if requestData.Metadata == nil {
 		requestData.Metadata = make(map[string]string, 1)
 	}
-	if requestData.Size > -1 {
+	if cs, ok := requestData.Metadata[common.XAmzMetaClearSize]; ok && cs != common.XAmzMetaClearSizeUnknown && requestData.Size == -1 {
+		// Clear size was already set by an upper handler transforming the stream (e.g. compression), keep it
+		log.Logger(ctx).Debug("Keeping clear size provided by upper handler", zap.String("s", cs))
+	} else if requestData.Size > -1 {
 		log.Logger(ctx).Debug("Adding special header to store clear size", zap.Any("s", requestData.Size))
 		requestData.Metadata[common.XAmzMetaClearSize] = fmt.Sprintf("%d", requestData.Size)
 	} else {
@@ -311,7 +313,7 @@ func (e *Handler) CopyObject(ctx context.Context, from *tree.Node, to *tree.Node
 	readCtx := nodes.WithBranchInfo(ctx, "in", srcInfo, true)
 	writeCtx := nodes.WithBranchInfo(ctx, "in", destInfo, true)
 	// Ds are not encrypted, let if flow
-	if srcInfo.EncryptionMode != object.EncryptionMode_MASTER && destInfo.EncryptionMode != object.EncryptionMode_MASTER {
+	if !srcInfo.EncryptedWithNodeKeys() && !destInfo.EncryptedWithNodeKeys() {
 		return e.Next.CopyObject(ctx, from, to, requestData)
 	}
 	if requestData.Metadata == nil {
@@ -416,7 +418,7 @@ func (e *Handler) CopyObject(ctx context.Context, from *tree.Node, to *tree.Node
 func (e *Handler) MultipartCreate(ctx context.Context, target *tree.Node, requestData *models.MultipartRequestData) (string, error) {
 	var err error
 	branchInfo, er := nodes.GetBranchInfo(ctx, "in")
-	if er != nil || branchInfo.EncryptionMode != object.EncryptionMode_MASTER {
+	if er != nil || !branchInfo.EncryptedWithNodeKeys() {
 		// Not necessary for non-encrypted data source
 		delete(requestData.Metadata, common.XAmzMetaClearSize)
 		return e.Next.MultipartCreate(ctx, target, requestData)
@@ -448,7 +450,7 @@ func (e *Handler) MultipartCreate(ctx context.Context, target *tree.Node, reques
 		clone.MustSetMeta(common.MetaNamespaceDatasourceName, branchInfo.Name)
 	}
 
-	keyProtectionTool, err := e.getKeyProtectionTool(ctx)
+	keyProtectionTool, err := e.getKeyProtectionTool(ctx, branchInfo)
 	if err != nil {
 		return "", err
 	}
@@ -498,7 +500,7 @@ func (e *Handler) MultipartCreate(ctx context.Context, target *tree.Node, reques
 func (e *Handler) MultipartPutObjectPart(ctx context.Context, target *tree.Node, uploadID string, partNumberMarker int, reader io.Reader, requestData *models.PutRequestData) (models.MultipartObjectPart, error) {
 	var err error
 	branchInfo, er := nodes.GetBranchInfo(ctx, "in")
-	if er != nil || branchInfo.EncryptionMode != object.EncryptionMode_MASTER {
+	if er != nil || !branchInfo.EncryptedWithNodeKeys() {
 		return e.Next.MultipartPutObjectPart(ctx, target, uploadID, partNumberMarker, reader, requestData)
 	}
 
@@ -523,7 +525,7 @@ func (e *Handler) MultipartPutObjectPart(ctx context.Context, target *tree.Node,
 		clone.MustSetMeta(common.MetaNamespaceDatasourceName, branchInfo.Name)
 	}
 
-	keyProtectionTool, err := e.getKeyProtectionTool(ctx)
+	keyProtectionTool, err := e.getKeyProtectionTool(ctx, branchInfo)
 	if err != nil {
 		return models.MultipartObjectPart{}, err
 	}
@@ -539,7 +541,7 @@ func (e *Handler) MultipartPutObjectPart(ctx context.Context, target *tree.Node,
 	}
 
 	var encryptionKeyPlainBytes []byte
-	encryptionKeyPlainBytes, err = keyProtectionTool.GetDecrypted(ctx, branchInfo.EncryptionKey, info.NodeKey.KeyData)
+	encryptionKeyPlainBytes, err = e.openNodeKey(ctx, keyProtectionTool, branchInfo, info.NodeKey.KeyData)
 	if err != nil {
 		log.Logger(ctx).Error("views.handler.encryption.MultiPartPutObject: failed to unseal key", zap.Error(err))
 		return models.MultipartObjectPart{}, err
@@ -632,7 +634,22 @@ func (e *Handler) createNodeInfo(ctx context.Context, node *tree.Node) (*encrypt
 	return info, nil
 }
 
-func (e *Handler) getKeyProtectionTool(ctx context.Context) (UserKeyTool, error) {
+// openNodeKey decrypts a node key with the datasource current key, falling back to previous keys
+// if a rotation is in progress.
+func (e *Handler) openNodeKey(ctx context.Context, tool UserKeyTool, branchInfo nodes.BranchInfo, sealed []byte) ([]byte, error) {
+	plain, err := tool.GetDecrypted(ctx, branchInfo.EncryptionKey, sealed)
+	if err == nil {
+		return plain, nil
+	}
+	for _, previous := range branchInfo.PreviousEncryptionKeys() {
+		if p, er := tool.GetDecrypted(ctx, previous, sealed); er == nil {
+			return p, nil
+		}
+	}
+	return nil, err
+}
+
+func (e *Handler) getKeyProtectionTool(ctx context.Context, branchInfo nodes.BranchInfo) (UserKeyTool, error) {
 	tool := e.userKeyTool
 	var err error
 	if tool == nil {
@@ -641,6 +658,9 @@ func (e *Handler) getKeyProtectionTool(ctx context.Context) (UserKeyTool, error)
 			return nil, err
 		}
 	}
+	if kmsURL := branchInfo.EncryptionKMS(); kmsURL != "" {
+		return KMSKeyTool(ctx, kmsURL, tool)
+	}
 	return tool, err
 }
 
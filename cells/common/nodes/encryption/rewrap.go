@@ -0,0 +1,83 @@
+/*
+ * Copyright (c) 2025 Abstrium SAS <team (at) pydio.com>
+ * This file is part of Pydio Cells.
+ *
+ * Pydio Cells is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * Pydio Cells is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with Pydio Cells.  If not, see <http://www.gnu.org/licenses/>.
+ *
+ * The latest code can be found at <https://pydio.com>.
+ */
+
+package encryption
+
+import (
+	"context"
+
+	"github.com/pydio/cells/v5/common"
+	"github.com/pydio/cells/v5/common/client/grpc"
+	"github.com/pydio/cells/v5/common/errors"
+	"github.com/pydio/cells/v5/common/proto/encryption"
+)
+
+// RewrapNodeKey loads the key of a node stored in datasource dsName, opens it with one of the previousKeys and
+// seals it again with newKeyID. It returns false if the node is not encrypted or if its key already opens with
+// newKeyID, which makes it safe to call repeatedly. The sealed key format is left unchanged.
+func RewrapNodeKey(ctx context.Context, tool UserKeyTool, dsName, nodeUuid, newKeyID string, previousKeys []string) (bool, error) {
+	user := "ds:" + dsName
+	cli := encryption.NewNodeKeyManagerClient(grpc.ResolveConn(ctx, common.ServiceEncKeyGRPC, grpc.WithSilentNotFound()))
+	rsp, er := cli.GetNodeInfo(ctx, &encryption.GetNodeInfoRequest{UserId: user, NodeId: nodeUuid})
+	if er != nil {
+		if errors.Is(er, errors.StatusNotFound) {
+			return false, nil
+		}
+		return false, er
+	}
+	nodeKey := rsp.GetNodeInfo().GetNodeKey()
+	if nodeKey == nil || len(nodeKey.KeyData) == 0 {
+		return false, nil
+	}
+	if _, e := tool.GetDecrypted(ctx, newKeyID, nodeKey.KeyData); e == nil {
+		return false, nil
+	}
+
+	var plain []byte
+	er = errors.New("no previous key could open node key")
+	for _, k := range previousKeys {
+		if p, e := tool.GetDecrypted(ctx, k, nodeKey.KeyData); e == nil {
+			plain, er = p, nil
+			break
+		}
+	}
+	if er != nil {
+		return false, errors.WithMessage(er, "cannot open key for node "+nodeUuid)
+	}
+
+	sealed, er := tool.GetEncrypted(ctx, newKeyID, plain)
+	if er != nil {
+		return false, er
+	}
+
+	streamer, er := newBlockStreamer(ctx, nodeUuid)
+	if er != nil {
+		return false, er
+	}
+	if er = streamer.UpdateKey(&encryption.NodeKey{
+		UserId:  user,
+		OwnerId: user,
+		KeyData: sealed,
+	}); er != nil {
+		_ = streamer.Close()
+		return false, er
+	}
+	return true, streamer.Close()
+}
@@ -80,6 +80,23 @@ func (streamer *setBlockStream) SendKey(key *encryption2.NodeKey) error {
 	return streamer.err
 }
 
+// UpdateKey replaces the data of an existing node key, e.g. when it is sealed again with a new master key.
+func (streamer *setBlockStream) UpdateKey(key *encryption2.NodeKey) error {
+	if streamer.err != nil {
+		return streamer.err
+	}
+
+	key.NodeId = streamer.nodeUuid
+
+	streamer.err = streamer.client.Send(&encryption2.SetNodeInfoRequest{
+		Action: encryption2.SetNodeInfoActionType_KEY_UPDATE,
+		SetNodeKey: &encryption2.SetNodeKeyRequest{
+			NodeKey: key,
+		},
+	})
+	return streamer.err
+}
+
 func (streamer *setBlockStream) SendBlock(block *encryption2.Block) error {
 	if streamer.err != nil {
 		return streamer.err
@@ -21,6 +21,7 @@
 package encryption
 
 import (
+	"bytes"
 	"context"
 	"encoding/base64"
 	"sync"
@@ -28,9 +29,14 @@ import (
 	"github.com/pydio/cells/v5/common"
 	"github.com/pydio/cells/v5/common/client/grpc"
 	"github.com/pydio/cells/v5/common/crypto"
+	"github.com/pydio/cells/v5/common/crypto/kms"
+	"github.com/pydio/cells/v5/common/errors"
 	"github.com/pydio/cells/v5/common/proto/encryption"
 )
 
+// kmsKeyMarker prefixes node keys wrapped by an external KMS.
+var kmsKeyMarker = []byte("pydio-kms:")
+
 // UserKeyTool describes a tool that can encrypt/decrypt data based on user context
 type UserKeyTool interface {
 	GetEncrypted(ctx context.Context, keyID string, data []byte) ([]byte, error)
@@ -96,6 +102,43 @@ func (kt *userKeyTool) GetDecrypted(ctx context.Context, keyID string, encrypted
 	if err != nil {
 		return nil, err
 	}
+	if len(encrypted) < 12 {
+		return nil, errors.New("invalid sealed key data")
+	}
 
 	return crypto.Open(keyBytes, encrypted[:12], encrypted[12:])
 }
+
+// KMSKeyTool creates a tool wrapping keys with the external KMS found at kmsURL, ignoring the keyID.
+// Data that was not wrapped by a KMS (e.g. sealed before the datasource was switched to a KMS) is
+// opened with the fallback tool.
+func KMSKeyTool(ctx context.Context, kmsURL string, fallback UserKeyTool) (UserKeyTool, error) {
+	w, err := kms.OpenKeyWrapper(ctx, kmsURL)
+	if err != nil {
+		return nil, err
+	}
+	return &kmsKeyTool{wrapper: w, fallback: fallback}, nil
+}
+
+type kmsKeyTool struct {
+	wrapper  kms.KeyWrapper
+	fallback UserKeyTool
+}
+
+func (kt *kmsKeyTool) GetEncrypted(ctx context.Context, _ string, data []byte) ([]byte, error) {
+	wrapped, err := kt.wrapper.Wrap(ctx, data)
+	if err != nil {
+		return nil, err
+	}
+	return append(append([]byte{}, kmsKeyMarker...), wrapped...), nil
+}
+
+func (kt *kmsKeyTool) GetDecrypted(ctx context.Context, keyID string, encrypted []byte) ([]byte, error) {
+	if bytes.HasPrefix(encrypted, kmsKeyMarker) {
+		return kt.wrapper.Unwrap(ctx, encrypted[len(kmsKeyMarker):])
+	}
+	if kt.fallback == nil {
+		return nil, errors.New("node key was not wrapped by KMS")
+	}
+	return kt.fallback.GetDecrypted(ctx, keyID, encrypted)
+}
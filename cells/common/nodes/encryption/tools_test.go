@@ -0,0 +1,101 @@
+/*
+ * Copyright (c) 2025 Abstrium SAS <team (at) pydio.com>
+ * This file is part of Pydio Cells.
+ *
+ * Pydio Cells is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * Pydio Cells is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with Pydio Cells.  If not, see <http://www.gnu.org/licenses/>.
+ *
+ * The latest code can be found at <https://pydio.com>.
+ */
+
+package encryption
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"testing"
+
+	"github.com/pydio/cells/v5/common/crypto"
+	"github.com/pydio/cells/v5/common/crypto/kms"
+
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+// testKMS wraps keys locally with a fixed key, standing for an external KMS.
+type testKMS struct {
+	key []byte
+}
+
+func (k *testKMS) Open(_ context.Context, _ *url.URL) (kms.KeyWrapper, error) {
+	return k, nil
+}
+
+func (k *testKMS) Wrap(_ context.Context, plain []byte) ([]byte, error) {
+	return crypto.Seal(k.key, plain)
+}
+
+func (k *testKMS) Unwrap(_ context.Context, wrapped []byte) ([]byte, error) {
+	if len(wrapped) < 12 {
+		return nil, fmt.Errorf("invalid wrapped key")
+	}
+	return crypto.Open(k.key, wrapped[:12], wrapped[12:])
+}
+
+func TestWrappedKeys(t *testing.T) {
+
+	ctx := context.Background()
+	oldKey, _ := crypto.RandomBytes(32)
+	newKey, _ := crypto.RandomBytes(32)
+	nodeKey, _ := crypto.RandomBytes(32)
+	tool := &userKeyTool{keys: map[string][]byte{"old": oldKey, "new": newKey}}
+
+	Convey("Test sealed keys keep the stored format", t, func() {
+		sealed, er := tool.GetEncrypted(ctx, "new", nodeKey)
+		So(er, ShouldBeNil)
+		plain, er := crypto.Open(newKey, sealed[:12], sealed[12:])
+		So(er, ShouldBeNil)
+		So(plain, ShouldResemble, nodeKey)
+	})
+
+	Convey("Test keys only open with their wrapping key", t, func() {
+		legacy, er := crypto.Seal(oldKey, nodeKey)
+		So(er, ShouldBeNil)
+
+		plain, er := tool.GetDecrypted(ctx, "old", legacy)
+		So(er, ShouldBeNil)
+		So(plain, ShouldResemble, nodeKey)
+
+		_, er = tool.GetDecrypted(ctx, "new", legacy)
+		So(er, ShouldNotBeNil)
+	})
+
+	Convey("Test KMS wrapped keys", t, func() {
+		kms.DefaultURLMux().Register("test-kms", &testKMS{key: oldKey})
+		kt, er := KMSKeyTool(ctx, "test-kms://master", tool)
+		So(er, ShouldBeNil)
+
+		wrapped, er := kt.GetEncrypted(ctx, "new", nodeKey)
+		So(er, ShouldBeNil)
+		plain, er := kt.GetDecrypted(ctx, "new", wrapped)
+		So(er, ShouldBeNil)
+		So(plain, ShouldResemble, nodeKey)
+
+		// Keys sealed before switching to KMS are still readable
+		sealed, er := tool.GetEncrypted(ctx, "old", nodeKey)
+		So(er, ShouldBeNil)
+		plain, er = kt.GetDecrypted(ctx, "old", sealed)
+		So(er, ShouldBeNil)
+		So(plain, ShouldResemble, nodeKey)
+	})
+}
@@ -135,6 +135,9 @@ func (h *HandlerRead) GetObject(ctx context.Context, node *tree.Node, requestDat
 				})
 			}()
 		}
+		if claims, ok := claim.FromContext(ctx); ok && claims.Public {
+			recordLinkDownload(ctx, c, node)
+		}
 		if doc != nil && linkData != nil {
 			go func() {
 				linkData.DownloadCount++
@@ -0,0 +1,104 @@
+/*
+ * Copyright (c) 2025 Abstrium SAS <team (at) pydio.com>
+ * This file is part of Pydio Cells.
+ *
+ * Pydio Cells is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * Pydio Cells is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with Pydio Cells.  If not, see <http://www.gnu.org/licenses/>.
+ *
+ * The latest code can be found at <https://pydio.com>.
+ */
+
+package events
+
+import (
+	"context"
+	"path"
+	"strings"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/pydio/cells/v5/common"
+	"github.com/pydio/cells/v5/common/client/commons/docstorec"
+	"github.com/pydio/cells/v5/common/config"
+	"github.com/pydio/cells/v5/common/middleware/keys"
+	"github.com/pydio/cells/v5/common/nodes"
+	"github.com/pydio/cells/v5/common/proto/docstore"
+	"github.com/pydio/cells/v5/common/proto/idm"
+	"github.com/pydio/cells/v5/common/proto/tree"
+	"github.com/pydio/cells/v5/common/telemetry/log"
+	"github.com/pydio/cells/v5/common/utils/geoip"
+	json "github.com/pydio/cells/v5/common/utils/jsonx"
+	"github.com/pydio/cells/v5/common/utils/propagator"
+	"github.com/pydio/cells/v5/common/utils/uuid"
+)
+
+// LinkAccessRemote returns the visitor address of a public link access. X-Forwarded-For values are only honoured
+// if the direct peer is one of the proxies listed in the share service "trustedProxies" configuration.
+func LinkAccessRemote(ctx context.Context, peer string, forwardedFor []string) string {
+	trusted := config.Get(ctx, "services", common.ServiceRestNamespace_+common.ServiceShare, "trustedProxies").StringArray()
+	return geoip.ClientAddress(peer, forwardedFor, trusted)
+}
+
+// RecordLinkAccess stores an access to a public link in the dedicated docstore. The remote address is anonymized
+// and resolved to a country if a GeoIP database is configured.
+func RecordLinkAccess(ctx context.Context, access *docstore.LinkAccess, remoteAddr string) {
+	if access.Time == 0 {
+		access.Time = time.Now().Unix()
+	}
+	if remoteAddr != "" {
+		dbPath := config.Get(ctx, "services", common.ServiceRestNamespace_+common.ServiceShare, "geoipDatabase").String()
+		access.Country = geoip.Country(dbPath, remoteAddr)
+		access.IP = geoip.AnonymizeIP(remoteAddr)
+	}
+	data, _ := json.Marshal(access)
+	if _, er := docstorec.DocStoreClient(ctx).PutDocument(ctx, &docstore.PutDocumentRequest{
+		StoreID:    common.DocStoreIdLinkAccesses,
+		DocumentID: uuid.New(),
+		Document: &docstore.Document{
+			Type:          docstore.DocumentType_JSON,
+			Owner:         access.LinkUuid,
+			Data:          string(data),
+			IndexableMeta: string(data),
+		},
+	}); er != nil {
+		log.Logger(ctx).Warn("Cannot record public link access", zap.Error(er))
+	}
+}
+
+// recordLinkDownload records a preview or download of a node read through a public link workspace.
+// Access is stored in background using the bgCtx context.
+func recordLinkDownload(ctx, bgCtx context.Context, node *tree.Node) {
+	bi, er := nodes.GetBranchInfo(ctx, "in")
+	if er != nil || bi.Workspace == nil || bi.Workspace.Scope != idm.WorkspaceScope_LINK {
+		return
+	}
+	access := &docstore.LinkAccess{
+		LinkUuid: bi.Workspace.UUID,
+		Type:     docstore.LinkAccessPreview,
+		NodePath: path.Base(node.GetPath()),
+	}
+	if uri, ok := propagator.CanonicalMeta(ctx, keys.HttpMetaRequestURI); ok && strings.Contains(uri, "attachment") {
+		access.Type = docstore.LinkAccessDownload
+	}
+	if root := bi.Root.GetPath(); root != "" && strings.HasPrefix(node.GetPath(), root) {
+		access.NodePath = path.Join(path.Base(root), strings.TrimPrefix(node.GetPath(), root))
+	}
+	remote, _ := propagator.CanonicalMeta(ctx, keys.HttpMetaRemoteAddress)
+	if peer, ok := propagator.CanonicalMeta(ctx, keys.HttpMetaPeerAddress); ok {
+		fwd, _ := propagator.CanonicalMeta(ctx, keys.HttpMetaForwardedFor)
+		remote = LinkAccessRemote(ctx, peer, []string{fwd})
+	}
+	access.UserAgent, _ = propagator.CanonicalMeta(ctx, keys.HttpMetaUserAgent)
+	go RecordLinkAccess(bgCtx, access, remote)
+}
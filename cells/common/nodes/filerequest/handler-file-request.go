@@ -0,0 +1,448 @@
+/*
+ * Copyright (c) 2025 Abstrium SAS <team (at) pydio.com>
+ * This file is part of Pydio Cells.
+ *
+ * Pydio Cells is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * Pydio Cells is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with Pydio Cells.  If not, see <http://www.gnu.org/licenses/>.
+ *
+ * The latest code can be found at <https://pydio.com>.
+ */
+
+package filerequest
+
+import (
+	"context"
+	"io"
+	"path"
+	"strconv"
+	"strings"
+	"time"
+
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+
+	"github.com/pydio/cells/v5/common"
+	"github.com/pydio/cells/v5/common/client/commons/docstorec"
+	"github.com/pydio/cells/v5/common/client/commons/treec"
+	grpc2 "github.com/pydio/cells/v5/common/client/grpc"
+	"github.com/pydio/cells/v5/common/errors"
+	"github.com/pydio/cells/v5/common/nodes"
+	"github.com/pydio/cells/v5/common/nodes/abstract"
+	"github.com/pydio/cells/v5/common/nodes/models"
+	"github.com/pydio/cells/v5/common/proto/docstore"
+	"github.com/pydio/cells/v5/common/proto/idm"
+	"github.com/pydio/cells/v5/common/proto/service"
+	"github.com/pydio/cells/v5/common/proto/tree"
+	"github.com/pydio/cells/v5/common/telemetry/log"
+	json "github.com/pydio/cells/v5/common/utils/jsonx"
+)
+
+func WithFileRequests() nodes.Option {
+	return func(options *nodes.RouterOptions) {
+		if !options.AdminView {
+			options.Wrappers = append(options.Wrappers, &Handler{})
+		}
+	}
+}
+
+// Upload is queued in the docstore for each new file, to be sent to the link owner in a digest.
+type Upload struct {
+	WorkspaceUuid  string `json:"WORKSPACE_UUID"`
+	WorkspaceLabel string `json:"WORKSPACE_LABEL"`
+	OwnerUuid      string `json:"OWNER_UUID"`
+	NodeUuid       string `json:"NODE_UUID"`
+	Name           string `json:"NAME"`
+	Size           int64  `json:"SIZE"`
+	UploaderName   string `json:"UPLOADER_NAME"`
+	UploaderEmail  string `json:"UPLOADER_EMAIL"`
+	Time           int64  `json:"TIME"`
+}
+
+// Handler applies file request restrictions on link workspaces having FileRequest options. It must be registered
+// after the ACL filter, so that standard read/write permissions are already checked.
+type Handler struct {
+	abstract.Handler
+}
+
+func (h *Handler) Adapt(c nodes.Handler, options nodes.RouterOptions) nodes.Handler {
+	h.AdaptOptions(c, options)
+	return h
+}
+
+// options returns the file request options of the current branch, or nil if it is not a file request.
+func (h *Handler) options(ctx context.Context, identifier string) (nodes.BranchInfo, *idm.FileRequestOptions) {
+	bi, er := nodes.GetBranchInfo(ctx, identifier)
+	if er != nil || bi.IsInternal() || bi.Workspace == nil || bi.Workspace.Scope != idm.WorkspaceScope_LINK {
+		return bi, nil
+	}
+	return bi, bi.Workspace.LoadAttributes().FileRequest
+}
+
+// isRoot checks if node is the root of the file request.
+func (h *Handler) isRoot(bi nodes.BranchInfo, node *tree.Node) bool {
+	if bi.Root == nil {
+		return false
+	}
+	return (node.GetUuid() != "" && node.GetUuid() == bi.Root.GetUuid()) || strings.Trim(node.GetPath(), "/") == strings.Trim(bi.Root.GetPath(), "/")
+}
+
+// checkOwned reads the node and verifies it was uploaded by the current uploader. Nodes of other uploaders are
+// reported as not found, so that their names are not disclosed.
+func (h *Handler) checkOwned(ctx context.Context, node *tree.Node) error {
+	uploader, er := UploaderFromContext(ctx)
+	if er != nil {
+		return errors.WithStack(errors.NodeNotFound)
+	}
+	resp, er := h.Next.ReadNode(ctx, &tree.ReadNodeRequest{Node: node})
+	if er != nil {
+		return er
+	}
+	if !uploader.Owns(resp.GetNode()) {
+		return errors.WithStack(errors.NodeNotFound)
+	}
+	return nil
+}
+
+// checkUpload verifies the uploader identity and the link constraints before an upload.
+func (h *Handler) checkUpload(ctx context.Context, bi nodes.BranchInfo, fr *idm.FileRequestOptions, node *tree.Node, size int64) (*Uploader, error) {
+	uploader, er := UploaderFromContext(ctx)
+	if er != nil {
+		return nil, er
+	}
+	if len(fr.AllowedExtensions) > 0 {
+		ext := path.Ext(node.GetPath())
+		allowed := false
+		for _, e := range fr.AllowedExtensions {
+			if strings.EqualFold("."+strings.TrimPrefix(e, "."), ext) {
+				allowed = true
+				break
+			}
+		}
+		if !allowed {
+			return nil, errors.WithMessagef(errors.ExtensionsNotAllowed, "extension %s is not allowed!", ext)
+		}
+	}
+	// Declared size is only used to fail early, actual size is enforced while reading
+	if left, limited := h.remaining(ctx, bi, fr, 0); limited && (left < 0 || size > left) {
+		return nil, h.limitError(fr)
+	}
+	// Never overwrite a file of another uploader
+	if resp, e := h.Next.ReadNode(ctx, &tree.ReadNodeRequest{Node: node}); e == nil && !uploader.Owns(resp.GetNode()) {
+		return nil, errors.WithMessage(errors.StatusConflict, "this file name cannot be used, please rename your file")
+	}
+	return uploader, nil
+}
+
+// remaining computes how many bytes can still be written to a file that already has written bytes. The second
+// value is false if the link has no size limit.
+func (h *Handler) remaining(ctx context.Context, bi nodes.BranchInfo, fr *idm.FileRequestOptions, written int64) (int64, bool) {
+	var left int64
+	var limited bool
+	if fr.MaxFileSize > 0 {
+		left, limited = fr.MaxFileSize-written, true
+	}
+	if fr.MaxTotalSize > 0 && bi.Root != nil {
+		var used int64
+		if resp, e := treec.NodeProviderClient(ctx).ReadNode(ctx, &tree.ReadNodeRequest{Node: &tree.Node{Uuid: bi.Root.GetUuid()}}); e == nil {
+			used = resp.GetNode().GetSize()
+		}
+		if t := fr.MaxTotalSize - used - written; !limited || t < left {
+			left, limited = t, true
+		}
+	}
+	return left, limited
+}
+
+// limitError builds the error returned when an upload exceeds the link limits.
+func (h *Handler) limitError(fr *idm.FileRequestOptions) error {
+	if fr.MaxTotalSize > 0 {
+		return errors.WithMessagef(errors.StatusQuotaReached, "file size limit (%d) or total size limit (%d) is reached", fr.MaxFileSize, fr.MaxTotalSize)
+	}
+	return errors.WithMessagef(errors.StatusQuotaReached, "file size limit is %d", fr.MaxFileSize)
+}
+
+// uploadedSize sums the size of the parts already stored for a multipart upload, except the part being (re-)uploaded.
+func (h *Handler) uploadedSize(ctx context.Context, target *tree.Node, uploadID string, partNumber int) (int64, error) {
+	var total int64
+	var marker int
+	for {
+		res, er := h.Next.MultipartListObjectParts(ctx, target, uploadID, marker, 1000)
+		if er != nil {
+			return 0, er
+		}
+		for _, p := range res.ObjectParts {
+			if p.PartNumber != partNumber {
+				total += p.Size
+			}
+		}
+		if !res.IsTruncated || res.NextPartNumberMarker <= marker {
+			return total, nil
+		}
+		marker = res.NextPartNumberMarker
+	}
+}
+
+// limitReader fails once more than a given number of bytes were read, whatever the size declared by the client.
+type limitReader struct {
+	r    io.Reader
+	left int64
+	err  error
+}
+
+func (l *limitReader) Read(p []byte) (int, error) {
+	if l.left < 0 {
+		return 0, l.err
+	}
+	if int64(len(p)) > l.left+1 {
+		p = p[:l.left+1]
+	}
+	n, er := l.r.Read(p)
+	if l.left -= int64(n); l.left < 0 {
+		return n, l.err
+	}
+	return n, er
+}
+
+// tagNode stores the uploader as metadata on the node.
+func (h *Handler) tagNode(ctx context.Context, node *tree.Node, uploader *Uploader) {
+	if node.GetUuid() == "" {
+		return
+	}
+	n := &tree.Node{Uuid: node.GetUuid(), Path: node.GetPath(), Type: node.GetType()}
+	n.MustSetMeta(common.MetaNamespaceFileRequestUploader, uploader)
+	cli := tree.NewNodeReceiverClient(grpc2.ResolveConn(ctx, common.ServiceMetaGRPC))
+	if _, er := cli.UpdateNode(ctx, &tree.UpdateNodeRequest{From: n, To: n, Silent: true}); er != nil {
+		log.Logger(ctx).Error("Cannot store file request uploader on node", node.ZapUuid(), zap.Error(er))
+	}
+}
+
+// queueUpload registers the upload in the owner digest queue.
+func (h *Handler) queueUpload(ctx context.Context, bi nodes.BranchInfo, fr *idm.FileRequestOptions, node *tree.Node, size int64, uploader *Uploader) {
+	if !fr.NotifyOwner || node.GetUuid() == "" {
+		return
+	}
+	up := &Upload{
+		WorkspaceUuid:  bi.Workspace.GetUUID(),
+		WorkspaceLabel: bi.Workspace.GetLabel(),
+		NodeUuid:       node.GetUuid(),
+		Name:           path.Base(node.GetPath()),
+		Size:           size,
+		UploaderName:   uploader.Name,
+		UploaderEmail:  uploader.Email,
+		Time:           time.Now().Unix(),
+	}
+	for _, p := range bi.Workspace.GetPolicies() {
+		if p.GetAction() == service.ResourcePolicyAction_OWNER {
+			up.OwnerUuid = p.GetSubject()
+		}
+	}
+	data, _ := json.Marshal(up)
+	if _, er := docstorec.DocStoreClient(ctx).PutDocument(ctx, &docstore.PutDocumentRequest{
+		StoreID:    common.DocStoreIdFileRequestUploads,
+		DocumentID: up.NodeUuid,
+		Document:   &docstore.Document{ID: up.NodeUuid, Owner: up.OwnerUuid, Data: string(data), IndexableMeta: string(data)},
+	}); er != nil {
+		log.Logger(ctx).Warn("Cannot queue file request upload for owner digest", node.ZapUuid(), zap.Error(er))
+	}
+}
+
+// ReadNode hides nodes uploaded by other visitors.
+func (h *Handler) ReadNode(ctx context.Context, in *tree.ReadNodeRequest, opts ...grpc.CallOption) (*tree.ReadNodeResponse, error) {
+	bi, fr := h.options(ctx, "in")
+	if fr == nil {
+		return h.Next.ReadNode(ctx, in, opts...)
+	}
+	resp, er := h.Next.ReadNode(ctx, in, opts...)
+	if er != nil || h.isRoot(bi, resp.GetNode()) {
+		return resp, er
+	}
+	if uploader, e := UploaderFromContext(ctx); e != nil || !uploader.Owns(resp.GetNode()) {
+		return nil, errors.WithStack(errors.NodeNotFound)
+	}
+	return resp, nil
+}
+
+// ListNodes only returns nodes uploaded by the current visitor.
+func (h *Handler) ListNodes(ctx context.Context, in *tree.ListNodesRequest, opts ...grpc.CallOption) (tree.NodeProvider_ListNodesClient, error) {
+	bi, fr := h.options(ctx, "in")
+	if fr == nil {
+		return h.Next.ListNodes(ctx, in, opts...)
+	}
+	if !h.isRoot(bi, in.GetNode()) {
+		if er := h.checkOwned(ctx, in.GetNode()); er != nil {
+			return nil, er
+		}
+	}
+	uploader, _ := UploaderFromContext(ctx)
+	stream, er := h.Next.ListNodes(ctx, in, opts...)
+	if er != nil {
+		return nil, er
+	}
+	s := nodes.NewWrappingStreamer(stream.Context())
+	go func() {
+		defer s.CloseSend()
+		for {
+			resp, err := stream.Recv()
+			if err != nil {
+				if !errors.IsStreamFinished(err) {
+					_ = s.SendError(err)
+				}
+				break
+			}
+			if resp == nil || uploader == nil || !uploader.Owns(resp.GetNode()) {
+				continue
+			}
+			_ = s.Send(resp)
+		}
+	}()
+	return s, nil
+}
+
+// GetObject only serves nodes uploaded by the current visitor.
+func (h *Handler) GetObject(ctx context.Context, node *tree.Node, requestData *models.GetRequestData) (io.ReadCloser, error) {
+	if _, fr := h.options(ctx, "in"); fr != nil {
+		if er := h.checkOwned(ctx, node); er != nil {
+			return nil, er
+		}
+	}
+	return h.Next.GetObject(ctx, node, requestData)
+}
+
+// CreateNode tags folders created by the current visitor.
+func (h *Handler) CreateNode(ctx context.Context, in *tree.CreateNodeRequest, opts ...grpc.CallOption) (*tree.CreateNodeResponse, error) {
+	bi, fr := h.options(ctx, "in")
+	if fr == nil || in.GetNode().IsLeaf() {
+		return h.Next.CreateNode(ctx, in, opts...)
+	}
+	uploader, er := UploaderFromContext(ctx)
+	if er != nil {
+		return nil, er
+	}
+	if parent := (&tree.Node{Path: path.Dir(in.GetNode().GetPath())}); !h.isRoot(bi, parent) {
+		if er = h.checkOwned(ctx, parent); er != nil {
+			return nil, er
+		}
+	}
+	resp, er := h.Next.CreateNode(ctx, in, opts...)
+	if er == nil {
+		h.tagNode(ctx, resp.GetNode(), uploader)
+	}
+	return resp, er
+}
+
+// UpdateNode only allows visitors to rename or move their own uploads.
+func (h *Handler) UpdateNode(ctx context.Context, in *tree.UpdateNodeRequest, opts ...grpc.CallOption) (*tree.UpdateNodeResponse, error) {
+	if _, fr := h.options(ctx, "from"); fr != nil {
+		if er := h.checkOwned(ctx, in.GetFrom()); er != nil {
+			return nil, er
+		}
+	}
+	return h.Next.UpdateNode(ctx, in, opts...)
+}
+
+// DeleteNode only allows visitors to delete their own uploads.
+func (h *Handler) DeleteNode(ctx context.Context, in *tree.DeleteNodeRequest, opts ...grpc.CallOption) (*tree.DeleteNodeResponse, error) {
+	if _, fr := h.options(ctx, "in"); fr != nil {
+		if er := h.checkOwned(ctx, in.GetNode()); er != nil {
+			return nil, er
+		}
+	}
+	return h.Next.DeleteNode(ctx, in, opts...)
+}
+
+// CopyObject only allows copying own uploads.
+func (h *Handler) CopyObject(ctx context.Context, from *tree.Node, to *tree.Node, requestData *models.CopyRequestData) (models.ObjectInfo, error) {
+	if _, fr := h.options(ctx, "from"); fr != nil {
+		if er := h.checkOwned(ctx, from); er != nil {
+			return models.ObjectInfo{}, er
+		}
+	}
+	return h.Next.CopyObject(ctx, from, to, requestData)
+}
+
+// PutObject checks uploader identity and link constraints, then tags the uploaded node.
+func (h *Handler) PutObject(ctx context.Context, node *tree.Node, reader io.Reader, requestData *models.PutRequestData) (models.ObjectInfo, error) {
+	bi, fr := h.options(ctx, "in")
+	if fr == nil {
+		return h.Next.PutObject(ctx, node, reader, requestData)
+	}
+	uploader, er := h.checkUpload(ctx, bi, fr, node, requestData.Size)
+	if er != nil {
+		return models.ObjectInfo{}, er
+	}
+	if left, limited := h.remaining(ctx, bi, fr, 0); limited {
+		reader = &limitReader{r: reader, left: left, err: h.limitError(fr)}
+	}
+	oi, er := h.Next.PutObject(ctx, node, reader, requestData)
+	if er != nil {
+		return oi, er
+	}
+	h.tagNode(ctx, node, uploader)
+	h.queueUpload(ctx, bi, fr, node, oi.Size, uploader)
+	return oi, nil
+}
+
+// MultipartCreate checks uploader identity and link constraints, then tags the node created for the upload.
+func (h *Handler) MultipartCreate(ctx context.Context, node *tree.Node, requestData *models.MultipartRequestData) (string, error) {
+	bi, fr := h.options(ctx, "in")
+	if fr == nil {
+		return h.Next.MultipartCreate(ctx, node, requestData)
+	}
+	var size int64
+	if s, ok := requestData.Metadata[common.XAmzMetaClearSize]; ok {
+		size, _ = strconv.ParseInt(s, 10, 64)
+	}
+	uploader, er := h.checkUpload(ctx, bi, fr, node, size)
+	if er != nil {
+		return "", er
+	}
+	uploadID, er := h.Next.MultipartCreate(ctx, node, requestData)
+	if er != nil {
+		return uploadID, er
+	}
+	h.tagNode(ctx, node, uploader)
+	return uploadID, nil
+}
+
+// MultipartPutObjectPart checks the parts already uploaded plus the current part against the link limits.
+func (h *Handler) MultipartPutObjectPart(ctx context.Context, target *tree.Node, uploadID string, partNumberMarker int, reader io.Reader, requestData *models.PutRequestData) (models.MultipartObjectPart, error) {
+	bi, fr := h.options(ctx, "in")
+	if fr == nil || (fr.MaxFileSize <= 0 && fr.MaxTotalSize <= 0) {
+		return h.Next.MultipartPutObjectPart(ctx, target, uploadID, partNumberMarker, reader, requestData)
+	}
+	written, er := h.uploadedSize(ctx, target, uploadID, partNumberMarker)
+	if er != nil {
+		return models.MultipartObjectPart{}, er
+	}
+	if left, limited := h.remaining(ctx, bi, fr, written); limited {
+		if left < 0 || requestData.Size > left {
+			return models.MultipartObjectPart{}, h.limitError(fr)
+		}
+		reader = &limitReader{r: reader, left: left, err: h.limitError(fr)}
+	}
+	return h.Next.MultipartPutObjectPart(ctx, target, uploadID, partNumberMarker, reader, requestData)
+}
+
+// MultipartComplete queues the upload for the owner digest.
+func (h *Handler) MultipartComplete(ctx context.Context, target *tree.Node, uploadID string, uploadedParts []models.MultipartObjectPart) (models.ObjectInfo, error) {
+	oi, er := h.Next.MultipartComplete(ctx, target, uploadID, uploadedParts)
+	if er != nil {
+		return oi, er
+	}
+	if bi, fr := h.options(ctx, "in"); fr != nil {
+		if uploader, e := UploaderFromContext(ctx); e == nil {
+			h.queueUpload(ctx, bi, fr, target, oi.Size, uploader)
+		}
+	}
+	return oi, nil
+}
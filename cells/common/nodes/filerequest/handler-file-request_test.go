@@ -0,0 +1,64 @@
+/*
+ * Copyright (c) 2025 Abstrium SAS <team (at) pydio.com>
+ * This file is part of Pydio Cells.
+ *
+ * Pydio Cells is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * Pydio Cells is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with Pydio Cells.  If not, see <http://www.gnu.org/licenses/>.
+ *
+ * The latest code can be found at <https://pydio.com>.
+ */
+
+package filerequest
+
+import (
+	"context"
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/pydio/cells/v5/common/errors"
+	"github.com/pydio/cells/v5/common/nodes"
+	"github.com/pydio/cells/v5/common/proto/idm"
+
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestUploadLimits(t *testing.T) {
+
+	h := &Handler{}
+	fr := &idm.FileRequestOptions{MaxFileSize: 10}
+
+	Convey("Test reader is limited whatever the declared size", t, func() {
+		left, limited := h.remaining(context.Background(), nodes.BranchInfo{}, fr, 0)
+		So(limited, ShouldBeTrue)
+		So(left, ShouldEqual, 10)
+
+		bb, er := io.ReadAll(&limitReader{r: strings.NewReader("0123456789"), left: left, err: h.limitError(fr)})
+		So(er, ShouldBeNil)
+		So(bb, ShouldHaveLength, 10)
+
+		_, er = io.ReadAll(&limitReader{r: strings.NewReader("0123456789A"), left: left, err: h.limitError(fr)})
+		So(errors.Is(er, errors.StatusQuotaReached), ShouldBeTrue)
+	})
+
+	Convey("Test already uploaded parts are counted", t, func() {
+		left, limited := h.remaining(context.Background(), nodes.BranchInfo{}, fr, 8)
+		So(limited, ShouldBeTrue)
+		So(left, ShouldEqual, 2)
+		left, _ = h.remaining(context.Background(), nodes.BranchInfo{}, fr, 12)
+		So(left, ShouldBeLessThan, 0)
+
+		_, limited = h.remaining(context.Background(), nodes.BranchInfo{}, &idm.FileRequestOptions{}, 12)
+		So(limited, ShouldBeFalse)
+	})
+}
@@ -0,0 +1,83 @@
+/*
+ * Copyright (c) 2025 Abstrium SAS <team (at) pydio.com>
+ * This file is part of Pydio Cells.
+ *
+ * Pydio Cells is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * Pydio Cells is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with Pydio Cells.  If not, see <http://www.gnu.org/licenses/>.
+ *
+ * The latest code can be found at <https://pydio.com>.
+ */
+
+// Package filerequest restricts link workspaces configured as file requests: visitors must identify themselves,
+// can only upload files matching the link constraints, and only see their own uploads.
+package filerequest
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"net/mail"
+	"net/url"
+	"strings"
+
+	"github.com/pydio/cells/v5/common"
+	"github.com/pydio/cells/v5/common/errors"
+	"github.com/pydio/cells/v5/common/proto/tree"
+	"github.com/pydio/cells/v5/common/utils/propagator"
+)
+
+const minUploaderIdLength = 16
+
+// Uploader identifies an anonymous visitor of a file request. It is stored as metadata on each uploaded node.
+type Uploader struct {
+	// Id is a hash of the random token generated by the visitor browser
+	Id    string `json:"id"`
+	Name  string `json:"name"`
+	Email string `json:"email"`
+}
+
+// UploaderFromContext reads uploader identity from the request headers. The random token is hashed so that
+// it is never stored as is.
+func UploaderFromContext(ctx context.Context) (*Uploader, error) {
+	token, _ := propagator.CanonicalMeta(ctx, common.XPydioUploaderId)
+	if len(token) < minUploaderIdLength {
+		return nil, errors.WithMessage(errors.StatusForbidden, "missing uploader identifier")
+	}
+	name, _ := propagator.CanonicalMeta(ctx, common.XPydioUploaderName)
+	if n, e := url.QueryUnescape(name); e == nil {
+		name = n
+	}
+	email, _ := propagator.CanonicalMeta(ctx, common.XPydioUploaderEmail)
+	if n, e := url.QueryUnescape(email); e == nil {
+		email = n
+	}
+	name = strings.TrimSpace(name)
+	email = strings.TrimSpace(email)
+	if name == "" {
+		return nil, errors.WithMessage(errors.InvalidParameters, "please provide your name")
+	}
+	if a, e := mail.ParseAddress(email); e != nil || a.Address != email {
+		return nil, errors.WithMessage(errors.InvalidParameters, "please provide a valid email address")
+	}
+	h := sha256.Sum256([]byte(token))
+	return &Uploader{Id: hex.EncodeToString(h[:]), Name: name, Email: email}, nil
+}
+
+// Owns checks if node was uploaded by this uploader.
+func (u *Uploader) Owns(node *tree.Node) bool {
+	var stored *Uploader
+	if er := node.GetMeta(common.MetaNamespaceFileRequestUploader, &stored); er != nil || stored == nil {
+		return false
+	}
+	return stored.Id == u.Id
+}
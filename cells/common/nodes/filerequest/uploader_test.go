@@ -0,0 +1,84 @@
+/*
+ * Copyright (c) 2025 Abstrium SAS <team (at) pydio.com>
+ * This file is part of Pydio Cells.
+ *
+ * Pydio Cells is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * Pydio Cells is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with Pydio Cells.  If not, see <http://www.gnu.org/licenses/>.
+ *
+ * The latest code can be found at <https://pydio.com>.
+ */
+
+package filerequest
+
+import (
+	"context"
+	"testing"
+
+	"github.com/pydio/cells/v5/common"
+	"github.com/pydio/cells/v5/common/proto/tree"
+	"github.com/pydio/cells/v5/common/utils/propagator"
+
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestUploaderFromContext(t *testing.T) {
+
+	Convey("Test uploader identification", t, func() {
+
+		_, er := UploaderFromContext(context.Background())
+		So(er, ShouldNotBeNil)
+
+		ctx := propagator.NewContext(context.Background(), map[string]string{
+			common.XPydioUploaderId:    "0123456789abcdef0123",
+			common.XPydioUploaderName:  "Jane%20Doe",
+			common.XPydioUploaderEmail: "jane@example.com",
+		})
+		u, er := UploaderFromContext(ctx)
+		So(er, ShouldBeNil)
+		So(u.Name, ShouldEqual, "Jane Doe")
+		So(u.Email, ShouldEqual, "jane@example.com")
+		So(u.Id, ShouldNotEqual, "0123456789abcdef0123")
+
+		bad := propagator.NewContext(context.Background(), map[string]string{
+			common.XPydioUploaderId:    "0123456789abcdef0123",
+			common.XPydioUploaderName:  "Jane",
+			common.XPydioUploaderEmail: "not-an-email",
+		})
+		_, er = UploaderFromContext(bad)
+		So(er, ShouldNotBeNil)
+
+		short := propagator.NewContext(context.Background(), map[string]string{
+			common.XPydioUploaderId:    "short",
+			common.XPydioUploaderName:  "Jane",
+			common.XPydioUploaderEmail: "jane@example.com",
+		})
+		_, er = UploaderFromContext(short)
+		So(er, ShouldNotBeNil)
+
+	})
+
+	Convey("Test uploader ownership", t, func() {
+
+		u := &Uploader{Id: "abc", Name: "Jane", Email: "jane@example.com"}
+		mine := &tree.Node{Path: "a.txt"}
+		mine.MustSetMeta(common.MetaNamespaceFileRequestUploader, u)
+		other := &tree.Node{Path: "b.txt"}
+		other.MustSetMeta(common.MetaNamespaceFileRequestUploader, &Uploader{Id: "def"})
+
+		So(u.Owns(mine), ShouldBeTrue)
+		So(u.Owns(other), ShouldBeFalse)
+		So(u.Owns(&tree.Node{Path: "c.txt"}), ShouldBeFalse)
+
+	})
+
+}
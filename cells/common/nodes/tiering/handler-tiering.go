@@ -0,0 +1,186 @@
+/*
+ * Copyright (c) 2025 Abstrium SAS <team (at) pydio.com>
+ * This file is part of Pydio Cells.
+ *
+ * Pydio Cells is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * Pydio Cells is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with Pydio Cells.  If not, see <http://www.gnu.org/licenses/>.
+ *
+ * The latest code can be found at <https://pydio.com>.
+ */
+
+package tiering
+
+import (
+	"context"
+	"io"
+
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+
+	"github.com/pydio/cells/v5/common"
+	"github.com/pydio/cells/v5/common/nodes"
+	"github.com/pydio/cells/v5/common/nodes/abstract"
+	"github.com/pydio/cells/v5/common/nodes/models"
+	"github.com/pydio/cells/v5/common/proto/tree"
+	"github.com/pydio/cells/v5/common/telemetry/log"
+)
+
+func WithTiering() nodes.Option {
+	return func(options *nodes.RouterOptions) {
+		options.Wrappers = append(options.Wrappers, &Handler{})
+	}
+}
+
+// Handler transparently serves objects that were moved to the cold datasource, and recalls them to the hot
+// storage in background. Objects are first looked up in the hot storage, so that reads on datasources without
+// cold objects are not slowed down. It must be registered after the flat storage handler, as it relies on the
+// node UUID to find the object.
+type Handler struct {
+	abstract.Handler
+}
+
+func (t *Handler) Adapt(h nodes.Handler, options nodes.RouterOptions) nodes.Handler {
+	t.AdaptOptions(h, options)
+	return t
+}
+
+// coldSource returns the branch info and the cold storage for a given branch. The last value is false if
+// tiering is not enabled.
+func (t *Handler) coldSource(ctx context.Context, identifier string) (nodes.BranchInfo, nodes.LoadedSource, bool) {
+	bi, er := nodes.GetBranchInfo(ctx, identifier)
+	if er != nil || bi.Binary || bi.LoadedSource.DataSource == nil || bi.TieringColdDatasource() == "" {
+		return bi, nodes.LoadedSource{}, false
+	}
+	cold, er := ColdSource(nodes.GetSourcesPool(ctx), bi.LoadedSource)
+	if er != nil {
+		log.Logger(ctx).Warn("Cannot load cold datasource for tiering", zap.String("datasource", bi.Name), zap.Error(er))
+		return bi, nodes.LoadedSource{}, false
+	}
+	return bi, cold, true
+}
+
+// isCold checks if the object exists in the cold storage.
+func (t *Handler) isCold(ctx context.Context, bi nodes.BranchInfo, cold nodes.LoadedSource, node *tree.Node) bool {
+	if node.GetUuid() == "" {
+		return false
+	}
+	_, er := cold.Client.StatObject(ctx, cold.ObjectsBucket, bi.FlatShardedPath(node.GetUuid()), nil)
+	return er == nil
+}
+
+// coldContext switches the branch to the cold storage.
+func (t *Handler) coldContext(ctx context.Context, identifier string, bi nodes.BranchInfo, cold nodes.LoadedSource) context.Context {
+	bi.LoadedSource = cold
+	return nodes.WithBranchInfo(ctx, identifier, bi)
+}
+
+// GetObject reads the object from the cold storage if it cannot be found in the hot storage, and triggers
+// its recall in background.
+func (t *Handler) GetObject(ctx context.Context, node *tree.Node, requestData *models.GetRequestData) (io.ReadCloser, error) {
+	bi, cold, ok := t.coldSource(ctx, "in")
+	if !ok {
+		return t.Next.GetObject(ctx, node, requestData)
+	}
+	reader, er := t.Next.GetObject(ctx, node, requestData)
+	if er == nil {
+		recordAccess(ctx, node.GetUuid())
+		return reader, nil
+	}
+	if !t.isCold(ctx, bi, cold, node) {
+		return nil, er
+	}
+	recordAccess(ctx, node.GetUuid())
+	recallAsync(ctx, bi.LoadedSource, cold, node)
+	return t.Next.GetObject(t.coldContext(ctx, "in", bi, cold), node, requestData)
+}
+
+// ReadNode returns the stats of the cold object if it is not found in the hot storage.
+func (t *Handler) ReadNode(ctx context.Context, in *tree.ReadNodeRequest, opts ...grpc.CallOption) (*tree.ReadNodeResponse, error) {
+	if !in.GetObjectStats() {
+		return t.Next.ReadNode(ctx, in, opts...)
+	}
+	bi, cold, ok := t.coldSource(ctx, "in")
+	if !ok {
+		return t.Next.ReadNode(ctx, in, opts...)
+	}
+	resp, er := t.Next.ReadNode(ctx, in, opts...)
+	if er != nil && t.isCold(ctx, bi, cold, in.GetNode()) {
+		return t.Next.ReadNode(t.coldContext(ctx, "in", bi, cold), in, opts...)
+	}
+	return resp, er
+}
+
+// CopyObject copies the source object from the cold storage if it is not found in the hot storage.
+func (t *Handler) CopyObject(ctx context.Context, from *tree.Node, to *tree.Node, requestData *models.CopyRequestData) (models.ObjectInfo, error) {
+	bi, cold, ok := t.coldSource(ctx, "from")
+	if !ok {
+		return t.Next.CopyObject(ctx, from, to, requestData)
+	}
+	oi, er := t.Next.CopyObject(ctx, from, to, requestData)
+	if er == nil || !t.isCold(ctx, bi, cold, from) {
+		return oi, er
+	}
+	return t.Next.CopyObject(t.coldContext(ctx, "from", bi, cold), from, to, requestData)
+}
+
+// PutObject tags the written object as hot and removes any stale copy from the cold storage.
+func (t *Handler) PutObject(ctx context.Context, node *tree.Node, reader io.Reader, requestData *models.PutRequestData) (models.ObjectInfo, error) {
+	oi, er := t.Next.PutObject(ctx, node, reader, requestData)
+	if er == nil {
+		t.written(ctx, node)
+	}
+	return oi, er
+}
+
+// MultipartComplete tags the written object as hot and removes any stale copy from the cold storage.
+func (t *Handler) MultipartComplete(ctx context.Context, target *tree.Node, uploadID string, uploadedParts []models.MultipartObjectPart) (models.ObjectInfo, error) {
+	oi, er := t.Next.MultipartComplete(ctx, target, uploadID, uploadedParts)
+	if er == nil {
+		t.written(ctx, target)
+	}
+	return oi, er
+}
+
+func (t *Handler) written(ctx context.Context, node *tree.Node) {
+	bi, cold, ok := t.coldSource(ctx, "in")
+	if !ok || node.GetUuid() == "" {
+		return
+	}
+	bg := context.WithoutCancel(ctx)
+	go func() {
+		if t.isCold(bg, bi, cold, node) {
+			if er := cold.Client.RemoveObject(bg, cold.ObjectsBucket, bi.FlatShardedPath(node.GetUuid())); er != nil {
+				log.Logger(bg).Warn("Cannot remove stale object from cold storage", node.ZapUuid(), zap.Error(er))
+			}
+		} else if node.GetStringMeta(common.MetaNamespaceTier) == Hot {
+			return
+		}
+		if er := SetTier(bg, node, Hot); er != nil {
+			log.Logger(bg).Warn("Cannot update tier metadata", node.ZapUuid(), zap.Error(er))
+		}
+	}()
+}
+
+// DeleteNode also removes the object from the cold storage.
+func (t *Handler) DeleteNode(ctx context.Context, in *tree.DeleteNodeRequest, opts ...grpc.CallOption) (*tree.DeleteNodeResponse, error) {
+	resp, er := t.Next.DeleteNode(ctx, in, opts...)
+	if er != nil || !in.GetNode().IsLeaf() || in.GetNode().GetUuid() == "" {
+		return resp, er
+	}
+	if bi, cold, ok := t.coldSource(ctx, "in"); ok {
+		if e := cold.Client.RemoveObject(ctx, cold.ObjectsBucket, bi.FlatShardedPath(in.GetNode().GetUuid())); e != nil {
+			log.Logger(ctx).Debug("Cannot remove object from cold storage", in.GetNode().ZapUuid(), zap.Error(e))
+		}
+	}
+	return resp, er
+}
@@ -0,0 +1,188 @@
+/*
+ * Copyright (c) 2025 Abstrium SAS <team (at) pydio.com>
+ * This file is part of Pydio Cells.
+ *
+ * Pydio Cells is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * Pydio Cells is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with Pydio Cells.  If not, see <http://www.gnu.org/licenses/>.
+ *
+ * The latest code can be found at <https://pydio.com>.
+ */
+
+// Package tiering moves objects of flat datasources between a hot storage (the datasource itself) and the
+// storage of a secondary cold datasource, without modifying the index. Objects keep the same key in both
+// storages, and the current location is published in the "tier" metadata.
+package tiering
+
+import (
+	"context"
+	"strings"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/pydio/cells/v5/common"
+	"github.com/pydio/cells/v5/common/client/grpc"
+	"github.com/pydio/cells/v5/common/errors"
+	"github.com/pydio/cells/v5/common/nodes"
+	"github.com/pydio/cells/v5/common/nodes/models"
+	"github.com/pydio/cells/v5/common/proto/tree"
+	"github.com/pydio/cells/v5/common/telemetry/log"
+)
+
+const (
+	Hot  = "hot"
+	Cold = "cold"
+
+	maxTrackedAccesses = 50000
+)
+
+var (
+	accessesLock sync.Mutex
+	accesses     = map[string]string{}
+
+	recallsLock sync.Mutex
+	recalls     = map[string]struct{}{}
+)
+
+// ColdSource resolves the storage receiving the cold objects of a datasource. The returned source keeps the
+// hot datasource layout (base folder, sharding), so that objects keep the same key, but uses the client and
+// bucket of the cold datasource.
+func ColdSource(pool nodes.SourcesPool, hot nodes.LoadedSource) (nodes.LoadedSource, error) {
+	name := hot.TieringColdDatasource()
+	if name == "" {
+		return nodes.LoadedSource{}, errors.WithMessagef(errors.InvalidParameters, "datasource %s has no cold datasource configured", hot.Name)
+	}
+	cold, er := pool.GetDataSourceInfo(name)
+	if er != nil {
+		return nodes.LoadedSource{}, er
+	}
+	out := nodes.WithBucketName(hot, cold.ObjectsBucket)
+	out.Client = cold.Client
+	return out, nil
+}
+
+// MoveObject moves an object between two storages, keeping its user metadata. Storages sharing the same
+// client use a server-side copy, others are streamed from one client to the other.
+func MoveObject(ctx context.Context, from, to nodes.LoadedSource, key string) error {
+	src, er := from.Client.StatObject(ctx, from.ObjectsBucket, key, nil)
+	if er != nil {
+		return er
+	}
+	meta := map[string]string{}
+	for k := range src.Metadata {
+		if strings.HasPrefix(strings.ToLower(k), strings.ToLower(common.XAmzMetaPrefix)) {
+			meta[k] = src.Metadata.Get(k)
+		}
+	}
+	if from.Client != to.Client {
+		reader, _, er := from.Client.GetObject(ctx, from.ObjectsBucket, key, models.ReadMeta{})
+		if er != nil {
+			return er
+		}
+		_, er = to.Client.PutObject(ctx, to.ObjectsBucket, key, reader, src.Size, models.PutMeta{UserMetadata: meta, ContentType: src.ContentType})
+		_ = reader.Close()
+	} else {
+		cl := to.Client
+		if src.ContentType != "" {
+			meta[common.XContentType] = src.ContentType
+		}
+		if !to.ServerIsMinio() && cl.CopyObjectMultipartThreshold() > 0 && src.Size > cl.CopyObjectMultipartThreshold() {
+			er = cl.CopyObjectMultipart(ctx, src, from.ObjectsBucket, key, to.ObjectsBucket, key, meta, nil)
+		} else {
+			_, er = cl.CopyObject(ctx, from.ObjectsBucket, key, to.ObjectsBucket, key, nil, meta, nil)
+		}
+	}
+	if er != nil {
+		return er
+	}
+	return from.Client.RemoveObject(ctx, from.ObjectsBucket, key)
+}
+
+// recallAsync moves an object back to the hot storage in background and updates its tier metadata. Concurrent
+// recalls of the same object are ignored.
+func recallAsync(ctx context.Context, hot, cold nodes.LoadedSource, node *tree.Node) {
+	recallsLock.Lock()
+	if _, ok := recalls[node.GetUuid()]; ok {
+		recallsLock.Unlock()
+		return
+	}
+	recalls[node.GetUuid()] = struct{}{}
+	recallsLock.Unlock()
+
+	bg := context.WithoutCancel(ctx)
+	go func() {
+		defer func() {
+			recallsLock.Lock()
+			delete(recalls, node.GetUuid())
+			recallsLock.Unlock()
+		}()
+		if er := MoveObject(bg, cold, hot, hot.FlatShardedPath(node.GetUuid())); er != nil {
+			log.Logger(bg).Warn("Cannot recall object from cold storage", node.ZapUuid(), zap.Error(er))
+			return
+		}
+		log.Logger(bg).Info("Recalled object from cold storage", node.ZapUuid(), zap.String("datasource", hot.TieringColdDatasource()))
+		if er := SetTier(bg, node, Hot); er != nil {
+			log.Logger(bg).Warn("Cannot update tier metadata after recall", node.ZapUuid(), zap.Error(er))
+		}
+	}()
+}
+
+// SetTier publishes the current tier of a node in its metadata.
+func SetTier(ctx context.Context, node *tree.Node, tier string) error {
+	n := &tree.Node{Uuid: node.GetUuid(), Path: node.GetPath(), Type: tree.NodeType_LEAF}
+	n.MustSetMeta(common.MetaNamespaceTier, tier)
+	cli := tree.NewNodeReceiverClient(grpc.ResolveConn(ctx, common.ServiceMetaGRPC))
+	_, er := cli.UpdateNode(ctx, &tree.UpdateNodeRequest{From: n, To: n, Silent: true})
+	return er
+}
+
+// recordAccess stores the last access day of a node in its metadata, at most once a day per node
+// for a given process.
+func recordAccess(ctx context.Context, nodeUuid string) {
+	if nodeUuid == "" {
+		return
+	}
+	day := time.Now().Format("20060102")
+	accessesLock.Lock()
+	if accesses[nodeUuid] == day {
+		accessesLock.Unlock()
+		return
+	}
+	if len(accesses) >= maxTrackedAccesses {
+		accesses = map[string]string{}
+	}
+	accesses[nodeUuid] = day
+	accessesLock.Unlock()
+
+	bg := context.WithoutCancel(ctx)
+	go func() {
+		n := &tree.Node{Uuid: nodeUuid, Type: tree.NodeType_LEAF}
+		n.MustSetMeta(common.MetaNamespaceTierAccessed, time.Now().Unix())
+		cli := tree.NewNodeReceiverClient(grpc.ResolveConn(bg, common.ServiceMetaGRPC))
+		if _, er := cli.UpdateNode(bg, &tree.UpdateNodeRequest{From: n, To: n, Silent: true}); er != nil {
+			log.Logger(bg).Warn("Cannot record node access for tiering", zap.String("uuid", nodeUuid), zap.Error(er))
+		}
+	}()
+}
+
+// LastAccess returns the last known access time of a node: the access recorded in its metadata, or
+// its modification time.
+func LastAccess(node *tree.Node) time.Time {
+	last := node.GetMTime()
+	var accessed int64
+	if er := node.GetMeta(common.MetaNamespaceTierAccessed, &accessed); er == nil && accessed > last {
+		last = accessed
+	}
+	return time.Unix(last, 0)
+}
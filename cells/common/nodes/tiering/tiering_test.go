@@ -0,0 +1,97 @@
+/*
+ * Copyright (c) 2025 Abstrium SAS <team (at) pydio.com>
+ * This file is part of Pydio Cells.
+ *
+ * Pydio Cells is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * Pydio Cells is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with Pydio Cells.  If not, see <http://www.gnu.org/licenses/>.
+ *
+ * The latest code can be found at <https://pydio.com>.
+ */
+
+package tiering
+
+import (
+	"context"
+	"io"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/pydio/cells/v5/common"
+	"github.com/pydio/cells/v5/common/nodes"
+	"github.com/pydio/cells/v5/common/nodes/models"
+	"github.com/pydio/cells/v5/common/nodes/objects/mock"
+	"github.com/pydio/cells/v5/common/proto/object"
+	"github.com/pydio/cells/v5/common/proto/tree"
+
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestTieringConfig(t *testing.T) {
+
+	Convey("Test datasource tiering configuration", t, func() {
+		ds := &object.DataSource{
+			Name: "hot",
+			StorageConfiguration: map[string]string{
+				object.StorageKeyTieringColdDatasource: "cold",
+				object.StorageKeyTieringColdAfter:      "30",
+			},
+		}
+		So(ds.TieringColdDatasource(), ShouldBeEmpty)
+		ds.FlatStorage = true
+		So(ds.TieringColdDatasource(), ShouldEqual, "cold")
+		So(ds.TieringColdAfter(), ShouldEqual, 30*24*time.Hour)
+
+		ds.StorageConfiguration[object.StorageKeyTieringColdDatasource] = "hot"
+		So(ds.TieringColdDatasource(), ShouldBeEmpty)
+		ds.StorageConfiguration[object.StorageKeyTieringColdAfter] = "never"
+		So(ds.TieringColdAfter(), ShouldEqual, 0)
+	})
+
+	Convey("Test last access time", t, func() {
+		mtime := time.Now().Add(-48 * time.Hour).Unix()
+		n := &tree.Node{Uuid: "uuid", MTime: mtime}
+		So(LastAccess(n).Unix(), ShouldEqual, mtime)
+
+		accessed := time.Now().Unix()
+		n.MustSetMeta(common.MetaNamespaceTierAccessed, accessed)
+		So(LastAccess(n).Unix(), ShouldEqual, accessed)
+
+		n.MustSetMeta(common.MetaNamespaceTierAccessed, mtime-10)
+		So(LastAccess(n).Unix(), ShouldEqual, mtime)
+	})
+
+	Convey("Test moving objects between storages", t, func() {
+		ctx := context.Background()
+		hot := nodes.LoadedSource{DataSource: &object.DataSource{Name: "hot", ObjectsBucket: "hot"}, Client: mock.New("hot")}
+		cold := nodes.LoadedSource{DataSource: &object.DataSource{Name: "cold", ObjectsBucket: "cold"}, Client: mock.New("cold")}
+		_, er := hot.Client.PutObject(ctx, "hot", "uuid", strings.NewReader("content"), 7, models.PutMeta{ContentType: "text/plain"})
+		So(er, ShouldBeNil)
+
+		So(MoveObject(ctx, hot, cold, "uuid"), ShouldBeNil)
+		_, er = hot.Client.StatObject(ctx, "hot", "uuid", nil)
+		So(er, ShouldNotBeNil)
+		reader, _, er := cold.Client.GetObject(ctx, "cold", "uuid", nil)
+		So(er, ShouldBeNil)
+		bb, _ := io.ReadAll(reader)
+		So(string(bb), ShouldEqual, "content")
+		oi, er := cold.Client.StatObject(ctx, "cold", "uuid", nil)
+		So(er, ShouldBeNil)
+		So(oi.ContentType, ShouldEqual, "text/plain")
+
+		So(MoveObject(ctx, cold, hot, "uuid"), ShouldBeNil)
+		_, er = hot.Client.StatObject(ctx, "hot", "uuid", nil)
+		So(er, ShouldBeNil)
+		So(MoveObject(ctx, cold, hot, "uuid"), ShouldNotBeNil)
+	})
+}
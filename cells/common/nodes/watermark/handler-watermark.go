@@ -0,0 +1,347 @@
+/*
+ * Copyright (c) 2025 Abstrium SAS <team (at) pydio.com>
+ * This file is part of Pydio Cells.
+ *
+ * Pydio Cells is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * Pydio Cells is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with Pydio Cells.  If not, see <http://www.gnu.org/licenses/>.
+ *
+ * The latest code can be found at <https://pydio.com>.
+ */
+
+package watermark
+
+import (
+	"bytes"
+	"context"
+	"crypto/md5"
+	"encoding/hex"
+	"io"
+	"strings"
+	"time"
+
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+
+	"github.com/pydio/cells/v5/common"
+	"github.com/pydio/cells/v5/common/auth/claim"
+	"github.com/pydio/cells/v5/common/client/commons/docstorec"
+	"github.com/pydio/cells/v5/common/errors"
+	"github.com/pydio/cells/v5/common/middleware/keys"
+	"github.com/pydio/cells/v5/common/nodes"
+	"github.com/pydio/cells/v5/common/nodes/abstract"
+	"github.com/pydio/cells/v5/common/nodes/models"
+	"github.com/pydio/cells/v5/common/permissions"
+	"github.com/pydio/cells/v5/common/proto/docstore"
+	"github.com/pydio/cells/v5/common/proto/tree"
+	"github.com/pydio/cells/v5/common/telemetry/log"
+	"github.com/pydio/cells/v5/common/utils/cache"
+	cache_helper "github.com/pydio/cells/v5/common/utils/cache/helper"
+	json "github.com/pydio/cells/v5/common/utils/jsonx"
+	"github.com/pydio/cells/v5/common/utils/propagator"
+	"github.com/pydio/cells/v5/common/utils/uuid"
+)
+
+const (
+	// maxSourceSize is the maximum size of an original file that can be watermarked in memory
+	maxSourceSize = 64 * 1024 * 1024
+	// RecipientCookie stores the token binding a public link visitor to one of the link target users
+	RecipientCookie = "pydio_link_recipient"
+)
+
+var (
+	linksCacheConfig = cache.Config{
+		Prefix:          "nodes/watermark-links",
+		Eviction:        "30s",
+		CleanWindow:     "3m",
+		DiscardFallback: true,
+	}
+	filesCacheConfig = cache.Config{
+		Prefix:          "nodes/watermark-files",
+		Eviction:        "5m",
+		CleanWindow:     "10m",
+		DiscardFallback: true,
+	}
+	recipientsCacheConfig = cache.Config{
+		Prefix:      "nodes/watermark-recipients",
+		Eviction:    "24h",
+		CleanWindow: "48h",
+	}
+)
+
+func WithWatermark() nodes.Option {
+	return func(options *nodes.RouterOptions) {
+		if !options.AdminView {
+			options.Wrappers = append(options.Wrappers, &Handler{})
+		}
+	}
+}
+
+// linkInfo is cached for each hidden user of a public link
+type linkInfo struct {
+	Enabled  bool
+	LinkUuid string
+	Label    string
+	Targets  map[string]string
+}
+
+// stampedFile is cached between the ReadNode and the GetObject calls of a same download, so that the size
+// announced to the client matches the served content.
+type stampedFile struct {
+	Data []byte
+	Etag string
+}
+
+// Handler stamps images and PDF read by visitors of public links that have the Watermark option enabled.
+// Stored originals are never modified. It must be registered after the read events handler, so that internal reads
+// used to compute the watermark are not counted as link downloads.
+type Handler struct {
+	abstract.Handler
+}
+
+func (h *Handler) Adapt(c nodes.Handler, options nodes.RouterOptions) nodes.Handler {
+	h.AdaptOptions(c, options)
+	return h
+}
+
+// ReadNode reports the size and etag of the watermarked version.
+func (h *Handler) ReadNode(ctx context.Context, in *tree.ReadNodeRequest, opts ...grpc.CallOption) (*tree.ReadNodeResponse, error) {
+	resp, er := h.Next.ReadNode(ctx, in, opts...)
+	if er != nil || !resp.GetNode().IsLeaf() {
+		return resp, er
+	}
+	format := formatFor(ctx, resp.GetNode())
+	if format == "" {
+		return resp, nil
+	}
+	spec, er := h.spec(ctx)
+	if er != nil {
+		return nil, er
+	} else if spec == nil {
+		return resp, nil
+	}
+	stamped, er := h.stamped(ctx, resp.GetNode(), spec, format)
+	if er != nil {
+		return nil, er
+	}
+	n := resp.GetNode().Clone()
+	n.Size = int64(len(stamped.Data))
+	n.Etag = stamped.Etag
+	resp.Node = n
+	return resp, nil
+}
+
+// GetObject serves the watermarked version of the file, honoring the requested range. Thumbnails produced
+// for the file are stamped the same way.
+func (h *Handler) GetObject(ctx context.Context, node *tree.Node, requestData *models.GetRequestData) (io.ReadCloser, error) {
+	format := formatFor(ctx, node)
+	if format == "" {
+		return h.Next.GetObject(ctx, node, requestData)
+	}
+	spec, er := h.spec(ctx)
+	if er != nil {
+		return nil, er
+	} else if spec == nil {
+		return h.Next.GetObject(ctx, node, requestData)
+	}
+	resp, er := h.Next.ReadNode(ctx, &tree.ReadNodeRequest{Node: node})
+	if er != nil {
+		return nil, er
+	}
+	stamped, er := h.stamped(ctx, resp.GetNode(), spec, format)
+	if er != nil {
+		return nil, er
+	}
+	data := stamped.Data
+	if requestData.StartOffset > 0 {
+		if requestData.StartOffset > int64(len(data)) {
+			return nil, errors.WithMessage(errors.InvalidParameters, "start offset is out of range")
+		}
+		data = data[requestData.StartOffset:]
+	}
+	if requestData.Length >= 0 && requestData.Length < int64(len(data)) {
+		data = data[:requestData.Length]
+	}
+	return io.NopCloser(bytes.NewReader(data)), nil
+}
+
+// formatFor returns the format of a file to stamp, or an empty string if it is not supported. Thumbnails
+// are always JPEG files.
+func formatFor(ctx context.Context, node *tree.Node) string {
+	if f := FormatOf(node.GetPath()); f != "" {
+		return f
+	}
+	if isThumbnail(ctx, node) {
+		return FormatJPEG
+	}
+	return ""
+}
+
+// isThumbnail detects reads on the thumbnails store, which is the only indexed binary store.
+func isThumbnail(ctx context.Context, node *tree.Node) bool {
+	if bi, er := nodes.GetBranchInfo(ctx, "in"); er == nil && bi.IndexedBinary {
+		return true
+	}
+	return strings.HasPrefix(node.GetPath(), common.PydioThumbstoreNamespace+"/")
+}
+
+// stamped loads the original file and applies the watermark, or reuses a recently computed version.
+func (h *Handler) stamped(ctx context.Context, node *tree.Node, spec *Spec, format string) (*stampedFile, error) {
+	text := spec.Text()
+	id := node.GetUuid()
+	if id == "" {
+		id = node.GetPath()
+	}
+	key := id + "-" + node.GetEtag() + "-" + text
+	ca, _ := cache_helper.ResolveCache(ctx, common.CacheTypeLocal, filesCacheConfig)
+	var sf *stampedFile
+	if ca != nil && ca.Get(key, &sf) {
+		return sf, nil
+	}
+	if node.GetSize() > maxSourceSize {
+		return nil, errors.WithMessage(errors.StatusForbidden, "this file is too large to be watermarked")
+	}
+	reader, er := h.Next.GetObject(ctx, node, &models.GetRequestData{Length: -1})
+	if er != nil {
+		return nil, er
+	}
+	defer reader.Close()
+	original, er := io.ReadAll(io.LimitReader(reader, maxSourceSize+1))
+	if er != nil {
+		return nil, er
+	}
+	out, er := Stamp(original, format, text)
+	if er != nil {
+		// Do not serve the original if it cannot be stamped
+		log.Logger(ctx).Warn("Cannot apply watermark on "+node.GetPath(), zap.Error(er))
+		return nil, errors.WithMessage(errors.StatusForbidden, "cannot apply watermark on this file")
+	}
+	sum := md5.Sum(out)
+	sf = &stampedFile{Data: out, Etag: hex.EncodeToString(sum[:])}
+	if ca != nil {
+		_ = ca.Set(key, sf)
+	}
+	return sf, nil
+}
+
+// spec returns the watermark to apply for the current public link visitor, or nil. It fails if link settings
+// cannot be loaded, so that originals are never served by mistake.
+func (h *Handler) spec(ctx context.Context) (*Spec, error) {
+	claims, ok := claim.FromContext(ctx)
+	if !ok || !claims.Public {
+		return nil, nil
+	}
+	info, er := h.linkInfo(ctx, claims.Name)
+	if er != nil {
+		log.Logger(ctx).Warn("Cannot load watermark settings for "+claims.Name, zap.Error(er))
+		return nil, errors.WithMessage(errors.StatusForbidden, "cannot load link settings")
+	} else if !info.Enabled {
+		return nil, nil
+	}
+	s := &Spec{Label: info.Label, Date: time.Now()}
+	if display, ok := info.Targets[boundRecipient(ctx, info.LinkUuid)]; ok {
+		s.Recipient = display
+	} else if len(info.Targets) == 1 {
+		for _, display = range info.Targets {
+			s.Recipient = display
+		}
+	}
+	return s, nil
+}
+
+// BindRecipient is called when a visitor opens a public link with the target user identifier found in the
+// invitation URL. It returns a random token to be set in the RecipientCookie: the recipient stamped on files
+// is only resolved from this server-side binding.
+func BindRecipient(ctx context.Context, linkUuid, targetId string) (string, error) {
+	ca, er := cache_helper.ResolveCache(ctx, common.CacheTypeShared, recipientsCacheConfig)
+	if er != nil {
+		return "", er
+	}
+	token := uuid.New()
+	if er := ca.Set(token, []byte(linkUuid+"/"+targetId)); er != nil {
+		return "", er
+	}
+	return token, nil
+}
+
+// boundRecipient finds the target user bound to the visitor cookie for this link, if any.
+func boundRecipient(ctx context.Context, linkUuid string) string {
+	cookies, _ := propagator.CanonicalMeta(ctx, keys.HttpMetaCookiesString)
+	var token string
+	for _, c := range strings.Split(cookies, "//") {
+		if name, value, ok := strings.Cut(c, "="); ok && name == RecipientCookie {
+			token = value
+		}
+	}
+	if token == "" {
+		return ""
+	}
+	ca, er := cache_helper.ResolveCache(ctx, common.CacheTypeShared, recipientsCacheConfig)
+	if er != nil {
+		return ""
+	}
+	var bound []byte
+	if !ca.Get(token, &bound) {
+		return ""
+	}
+	if link, target, ok := strings.Cut(string(bound), "/"); ok && link == linkUuid {
+		return target
+	}
+	return ""
+}
+
+// linkInfo finds the link used by a hidden user and loads its watermark settings.
+func (h *Handler) linkInfo(ctx context.Context, login string) (*linkInfo, error) {
+	ca, _ := cache_helper.ResolveCache(ctx, common.CacheTypeLocal, linksCacheConfig)
+	var info *linkInfo
+	if ca != nil && ca.Get(login, &info) {
+		return info, nil
+	}
+	info = &linkInfo{}
+	store := docstorec.DocStoreClient(ctx)
+	ct, can := context.WithCancel(ctx)
+	defer can()
+	for _, field := range []string{"PRESET_LOGIN", "PRELOG_USER"} {
+		stream, er := store.ListDocuments(ct, &docstore.ListDocumentsRequest{StoreID: common.DocStoreIdShares, Query: &docstore.DocumentQuery{
+			MetaQuery: "+SHARE_TYPE:minisite +" + field + ":\"" + login + "\"",
+		}})
+		if er != nil {
+			return nil, er
+		}
+		r, er := stream.Recv()
+		if er == io.EOF {
+			continue
+		} else if er != nil {
+			return nil, er
+		}
+		var sd *docstore.ShareDocument
+		if er := json.Unmarshal([]byte(r.GetDocument().GetData()), &sd); er != nil {
+			return nil, er
+		} else if !sd.Watermark {
+			break
+		}
+		info.Enabled = true
+		info.LinkUuid = sd.RepositoryId
+		if ws, e := permissions.SearchUniqueWorkspace(ctx, sd.RepositoryId, ""); e == nil && ws != nil {
+			info.Label = ws.GetLabel()
+		}
+		info.Targets = make(map[string]string, len(sd.TargetUsers))
+		for id, t := range sd.TargetUsers {
+			info.Targets[id] = t.Display
+		}
+		break
+	}
+	if ca != nil {
+		_ = ca.Set(login, info)
+	}
+	return info, nil
+}
@@ -0,0 +1,479 @@
+/*
+ * Copyright (c) 2025 Abstrium SAS <team (at) pydio.com>
+ * This file is part of Pydio Cells.
+ *
+ * Pydio Cells is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * Pydio Cells is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with Pydio Cells.  If not, see <http://www.gnu.org/licenses/>.
+ *
+ * The latest code can be found at <https://pydio.com>.
+ */
+
+package watermark
+
+import (
+	"bytes"
+	"compress/zlib"
+	"fmt"
+	"io"
+	"math"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	"golang.org/x/image/font"
+	"golang.org/x/image/font/sfnt"
+	"golang.org/x/image/math/fixed"
+
+	"github.com/pydio/cells/v5/common/errors"
+)
+
+var (
+	pdfObjRe       = regexp.MustCompile(`(?s)(\d+)\s+(\d+)\s+obj\b(.*?)\bendobj`)
+	pdfPageRe      = regexp.MustCompile(`/Type\s*/Page(?:[^A-Za-z0-9]|$)`)
+	pdfObjStmRe    = regexp.MustCompile(`/Type\s*/ObjStm\b`)
+	pdfXRefRe      = regexp.MustCompile(`/Type\s*/XRef\b`)
+	pdfHeaderRe    = regexp.MustCompile(`^%PDF-\d\.\d`)
+	pdfContentsRe  = regexp.MustCompile(`/Contents\s*(\[[^\]]*\]|\d+\s+\d+\s+R)`)
+	pdfMediaBoxRe  = regexp.MustCompile(`/MediaBox\s*\[\s*([-\d.]+)\s+([-\d.]+)\s+([-\d.]+)\s+([-\d.]+)\s*\]`)
+	pdfParentRe    = regexp.MustCompile(`/Parent\s+(\d+)\s+\d+\s+R`)
+	pdfStartXrefRe = regexp.MustCompile(`startxref\s+(\d+)`)
+	pdfSizeRe      = regexp.MustCompile(`/Size\s+(\d+)`)
+	pdfRootRe      = regexp.MustCompile(`/Root\s+\d+\s+\d+\s+R`)
+	pdfInfoRe      = regexp.MustCompile(`/Info\s+\d+\s+\d+\s+R`)
+	pdfIdRe        = regexp.MustCompile(`/ID\s*\[[^\]]*\]`)
+	pdfNRe         = regexp.MustCompile(`/N\s+(\d+)`)
+	pdfFirstRe     = regexp.MustCompile(`/First\s+(\d+)`)
+
+	defaultMediaBox = [4]float64{0, 0, 612, 792}
+)
+
+type pdfObject struct {
+	num  int
+	gen  int
+	body []byte
+}
+
+type pdfTrailer struct {
+	size int
+	root []byte
+	info []byte
+	id   []byte
+}
+
+// StampPDF rewrites the whole document: every page gets an additional content stream drawing the text as vector
+// outlines, so that no font resource has to be registered on the pages. Only the last revision of each object is
+// written, uncompressed, followed by a new cross-reference table: previous revisions and original page contents
+// cannot be recovered from the output. Encrypted documents are not supported.
+func StampPDF(data []byte, text string) ([]byte, error) {
+	trailer, er := pdfReadTrailer(data)
+	if er != nil {
+		return nil, er
+	}
+	f, er := regularFont()
+	if er != nil {
+		return nil, er
+	}
+	objects, er := pdfObjects(data)
+	if er != nil {
+		return nil, er
+	}
+	var pages []*pdfObject
+	next := trailer.size
+	for num, o := range objects {
+		if !bytes.Contains(o.body, []byte("stream")) && pdfPageRe.Match(o.body) {
+			pages = append(pages, o)
+		}
+		if num >= next {
+			next = num + 1
+		}
+	}
+	if len(pages) == 0 {
+		return nil, errors.New("cannot find any page in pdf document")
+	}
+	sort.Slice(pages, func(i, j int) bool {
+		return pages[i].num < pages[j].num
+	})
+
+	// Compute new objects: save/restore operators, one stamp per page size and updated pages
+	bodies := make(map[int][]byte, len(objects)+len(pages)+2)
+	gens := make(map[int]int, len(objects)+len(pages)+2)
+	for num, o := range objects {
+		dict := pdfDict(o.body)
+		if pdfObjStmRe.Match(dict) || pdfXRefRe.Match(dict) || bytes.Contains(dict, []byte("/Linearized")) {
+			// Containers are replaced by plain objects and the new cross-reference table
+			continue
+		}
+		bodies[num], gens[num] = o.body, o.gen
+	}
+	addStream := func(content []byte) int {
+		bodies[next], gens[next] = []byte(fmt.Sprintf("<< /Length %d >>\nstream\n%s\nendstream", len(content), content)), 0
+		next++
+		return next - 1
+	}
+	saveNum, restoreNum := addStream([]byte("q")), addStream([]byte("Q"))
+	stamps := make(map[[4]float64]int)
+	for _, p := range pages {
+		box := pdfMediaBox(objects, p)
+		stampNum, ok := stamps[box]
+		if !ok {
+			content, e := pdfStampContent(f, text, box)
+			if e != nil {
+				return nil, e
+			}
+			stampNum = addStream(content)
+			stamps[box] = stampNum
+		}
+		bodies[p.num] = pdfWithContents(bytes.TrimSpace(p.body), saveNum, restoreNum, stampNum)
+	}
+
+	out := bytes.NewBuffer(make([]byte, 0, len(data)+len(pages)*512+4096))
+	out.Write(pdfHeader(data))
+	out.WriteString("%\xe2\xe3\xcf\xd3\n")
+	offsets := make(map[int]int, len(bodies))
+	for _, num := range sortedKeys(gens) {
+		offsets[num] = out.Len()
+		_, _ = fmt.Fprintf(out, "%d %d obj\n", num, gens[num])
+		out.Write(bodies[num])
+		out.WriteString("\nendobj\n")
+	}
+
+	extra := string(trailer.root)
+	if trailer.info != nil {
+		extra += " " + string(trailer.info)
+	}
+	if trailer.id != nil {
+		extra += " " + string(trailer.id)
+	}
+	xrefOffset := out.Len()
+	offsets[0] = 0
+	out.WriteString("xref\n")
+	for _, r := range pdfXrefRuns(sortedKeys(offsets)) {
+		_, _ = fmt.Fprintf(out, "%d %d\n", r[0], r[1])
+		for n := r[0]; n < r[0]+r[1]; n++ {
+			if n == 0 {
+				out.WriteString("0000000000 65535 f\r\n")
+			} else {
+				_, _ = fmt.Fprintf(out, "%010d %05d n\r\n", offsets[n], gens[n])
+			}
+		}
+	}
+	_, _ = fmt.Fprintf(out, "trailer\n<< /Size %d %s >>\n", next, extra)
+	_, _ = fmt.Fprintf(out, "startxref\n%d\n%%%%EOF\n", xrefOffset)
+	return out.Bytes(), nil
+}
+
+// pdfHeader returns the original version line, or a default one.
+func pdfHeader(data []byte) []byte {
+	if m := pdfHeaderRe.Find(data); m != nil {
+		return append(append([]byte{}, m...), '\n')
+	}
+	return []byte("%PDF-1.7\n")
+}
+
+// pdfReadTrailer finds the last cross-reference section and reads the document root, info and identifiers.
+func pdfReadTrailer(data []byte) (*pdfTrailer, error) {
+	starts := pdfStartXrefRe.FindAllSubmatch(data, -1)
+	if len(starts) == 0 {
+		return nil, errors.New("cannot find pdf cross-reference section")
+	}
+	offset, _ := strconv.Atoi(string(starts[len(starts)-1][1]))
+	if offset <= 0 || offset >= len(data) {
+		return nil, errors.New("invalid pdf cross-reference offset")
+	}
+	t := &pdfTrailer{}
+	dict := data[offset:]
+	if bytes.HasPrefix(bytes.TrimLeft(dict, " \t\r\n"), []byte("xref")) {
+		i := bytes.Index(dict, []byte("trailer"))
+		if i < 0 {
+			return nil, errors.New("cannot find pdf trailer")
+		}
+		dict = dict[i:]
+		if j := bytes.Index(dict, []byte("startxref")); j > 0 {
+			dict = dict[:j]
+		}
+	} else if j := bytes.Index(dict, []byte("stream")); j > 0 {
+		dict = dict[:j]
+	}
+	if bytes.Contains(dict, []byte("/Encrypt")) {
+		return nil, errors.New("encrypted pdf documents are not supported")
+	}
+	m := pdfSizeRe.FindSubmatch(dict)
+	t.root = pdfRootRe.Find(dict)
+	if m == nil || t.root == nil {
+		return nil, errors.New("invalid pdf trailer")
+	}
+	t.size, _ = strconv.Atoi(string(m[1]))
+	t.info = pdfInfoRe.Find(dict)
+	t.id = pdfIdRe.Find(dict)
+	return t, nil
+}
+
+// pdfObjects lists indirect objects, including those compressed inside object streams. When an object appears
+// multiple times, the plain version found last in the file is kept. It fails if an object stream cannot be decoded.
+func pdfObjects(data []byte) (map[int]*pdfObject, error) {
+	objects := make(map[int]*pdfObject)
+	var streams []*pdfObject
+	for _, m := range pdfObjRe.FindAllSubmatchIndex(data, -1) {
+		num, _ := strconv.Atoi(string(data[m[2]:m[3]]))
+		gen, _ := strconv.Atoi(string(data[m[4]:m[5]]))
+		o := &pdfObject{num: num, gen: gen, body: data[m[6]:m[7]]}
+		objects[num] = o
+		if pdfObjStmRe.Match(pdfDict(o.body)) {
+			streams = append(streams, o)
+		}
+	}
+	for _, s := range streams {
+		oo := pdfObjectStream(s.body)
+		if len(oo) == 0 {
+			return nil, errors.New("unsupported pdf object stream")
+		}
+		for _, o := range oo {
+			if _, ok := objects[o.num]; !ok {
+				objects[o.num] = o
+			}
+		}
+	}
+	return objects, nil
+}
+
+// pdfObjectStream decodes a flate-compressed object stream.
+func pdfObjectStream(body []byte) (oo []*pdfObject) {
+	dict := pdfDict(body)
+	if !bytes.Contains(dict, []byte("/FlateDecode")) {
+		return
+	}
+	start := len(dict) + len("stream")
+	if start < len(body) && body[start] == '\r' {
+		start++
+	}
+	if start < len(body) && body[start] == '\n' {
+		start++
+	}
+	end := bytes.LastIndex(body, []byte("endstream"))
+	if end < start {
+		return
+	}
+	zr, er := zlib.NewReader(bytes.NewReader(body[start:end]))
+	if er != nil {
+		return
+	}
+	raw, _ := io.ReadAll(zr)
+	nm, fm := pdfNRe.FindSubmatch(dict), pdfFirstRe.FindSubmatch(dict)
+	if nm == nil || fm == nil {
+		return
+	}
+	n, _ := strconv.Atoi(string(nm[1]))
+	first, _ := strconv.Atoi(string(fm[1]))
+	if first > len(raw) {
+		return
+	}
+	fields := strings.Fields(string(raw[:first]))
+	if len(fields) < 2*n {
+		return
+	}
+	for i := 0; i < n; i++ {
+		num, _ := strconv.Atoi(fields[2*i])
+		off, _ := strconv.Atoi(fields[2*i+1])
+		endOff := len(raw) - first
+		if i+1 < n {
+			endOff, _ = strconv.Atoi(fields[2*i+3])
+		}
+		if off > endOff || first+endOff > len(raw) {
+			continue
+		}
+		oo = append(oo, &pdfObject{num: num, body: raw[first+off : first+endOff]})
+	}
+	return
+}
+
+// pdfDict returns the part of an object body located before its stream data, if any.
+func pdfDict(body []byte) []byte {
+	if i := bytes.Index(body, []byte("stream")); i >= 0 {
+		return body[:i]
+	}
+	return body
+}
+
+// pdfMediaBox reads the page MediaBox, possibly inherited from its parents.
+func pdfMediaBox(objects map[int]*pdfObject, page *pdfObject) [4]float64 {
+	o := page
+	for depth := 0; o != nil && depth < 32; depth++ {
+		if m := pdfMediaBoxRe.FindSubmatch(o.body); m != nil {
+			var box [4]float64
+			for i := 0; i < 4; i++ {
+				v, er := strconv.ParseFloat(string(m[i+1]), 64)
+				if er != nil {
+					return defaultMediaBox
+				}
+				box[i] = v
+			}
+			if box[2] <= box[0] || box[3] <= box[1] {
+				return defaultMediaBox
+			}
+			return box
+		}
+		p := pdfParentRe.FindSubmatch(o.body)
+		if p == nil {
+			break
+		}
+		num, _ := strconv.Atoi(string(p[1]))
+		o = objects[num]
+	}
+	return defaultMediaBox
+}
+
+// pdfWithContents wraps the existing page contents in a save/restore pair and appends the stamp stream.
+func pdfWithContents(body []byte, saveNum, restoreNum, stampNum int) []byte {
+	if m := pdfContentsRe.FindSubmatchIndex(body); m != nil {
+		orig := strings.Trim(string(body[m[2]:m[3]]), "[] \r\n\t")
+		repl := fmt.Sprintf("/Contents [%d 0 R %s %d 0 R %d 0 R]", saveNum, orig, restoreNum, stampNum)
+		return append(append(append([]byte{}, body[:m[0]]...), repl...), body[m[1]:]...)
+	}
+	i := bytes.LastIndex(body, []byte(">>"))
+	if i < 0 {
+		return body
+	}
+	return append(append(append([]byte{}, body[:i]...), fmt.Sprintf("/Contents [%d 0 R]", stampNum)...), body[i:]...)
+}
+
+// pdfStampContent draws the text outlines diagonally across the page, and filled in small size at the bottom.
+func pdfStampContent(f *sfnt.Font, text string, box [4]float64) ([]byte, error) {
+	unitWidth, er := pdfTextWidth(f, text)
+	if er != nil || unitWidth == 0 {
+		return nil, errors.New("cannot measure watermark text")
+	}
+	w, h := box[2]-box[0], box[3]-box[1]
+	size := math.Min(math.Max(0.7*math.Hypot(w, h)/unitWidth, 12), 72)
+	angle := math.Atan2(h, w)
+	cos, sin := math.Cos(angle), math.Sin(angle)
+
+	buf := &bytes.Buffer{}
+	buf.WriteString("q\n0.5 0.5 0.5 RG 0.8 w\n")
+	_, _ = fmt.Fprintf(buf, "%s %s %s %s %s %s cm\n", pdfNum(cos), pdfNum(sin), pdfNum(-sin), pdfNum(cos), pdfNum(box[0]+w/2), pdfNum(box[1]+h/2))
+	if er := pdfTextPath(buf, f, text, size, -unitWidth*size/2, -size*0.35); er != nil {
+		return nil, er
+	}
+	buf.WriteString("S\nQ\nq\n0.5 0.5 0.5 rg\n")
+	if er := pdfTextPath(buf, f, text, 8, box[0]+16, box[1]+16); er != nil {
+		return nil, er
+	}
+	buf.WriteString("f\nQ")
+	return buf.Bytes(), nil
+}
+
+// pdfTextWidth computes the advance of the text for a font size of 1.
+func pdfTextWidth(f *sfnt.Font, text string) (float64, error) {
+	var b sfnt.Buffer
+	upem := float64(f.UnitsPerEm())
+	ppem := fixed.Int26_6(f.UnitsPerEm()) << 6
+	var width float64
+	for _, r := range text {
+		adv, er := f.GlyphAdvance(&b, pdfGlyph(f, &b, r), ppem, font.HintingNone)
+		if er != nil {
+			return 0, er
+		}
+		width += float64(adv) / 64 / upem
+	}
+	return width, nil
+}
+
+// pdfTextPath writes glyph outlines as PDF path construction operators, with the baseline starting at (x, y).
+func pdfTextPath(out *bytes.Buffer, f *sfnt.Font, text string, size, x, y float64) error {
+	var b sfnt.Buffer
+	ppem := fixed.Int26_6(f.UnitsPerEm()) << 6
+	scale := size / float64(f.UnitsPerEm())
+	var pen, cx, cy float64
+	point := func(p fixed.Point26_6) (float64, float64) {
+		// Glyph coordinates have the Y axis pointing down
+		return x + (pen+float64(p.X)/64)*scale, y - float64(p.Y)/64*scale
+	}
+	for _, r := range text {
+		idx := pdfGlyph(f, &b, r)
+		segments, er := f.LoadGlyph(&b, idx, ppem, nil)
+		if er != nil {
+			return er
+		}
+		open := false
+		for _, s := range segments {
+			switch s.Op {
+			case sfnt.SegmentOpMoveTo:
+				if open {
+					out.WriteString("h\n")
+				}
+				cx, cy = point(s.Args[0])
+				_, _ = fmt.Fprintf(out, "%s %s m\n", pdfNum(cx), pdfNum(cy))
+				open = true
+			case sfnt.SegmentOpLineTo:
+				cx, cy = point(s.Args[0])
+				_, _ = fmt.Fprintf(out, "%s %s l\n", pdfNum(cx), pdfNum(cy))
+			case sfnt.SegmentOpQuadTo:
+				qx, qy := point(s.Args[0])
+				px, py := point(s.Args[1])
+				_, _ = fmt.Fprintf(out, "%s %s %s %s %s %s c\n",
+					pdfNum(cx+2*(qx-cx)/3), pdfNum(cy+2*(qy-cy)/3),
+					pdfNum(px+2*(qx-px)/3), pdfNum(py+2*(qy-py)/3),
+					pdfNum(px), pdfNum(py))
+				cx, cy = px, py
+			case sfnt.SegmentOpCubeTo:
+				ax, ay := point(s.Args[0])
+				bx, by := point(s.Args[1])
+				cx, cy = point(s.Args[2])
+				_, _ = fmt.Fprintf(out, "%s %s %s %s %s %s c\n", pdfNum(ax), pdfNum(ay), pdfNum(bx), pdfNum(by), pdfNum(cx), pdfNum(cy))
+			}
+		}
+		if open {
+			out.WriteString("h\n")
+		}
+		adv, er := f.GlyphAdvance(&b, idx, ppem, font.HintingNone)
+		if er != nil {
+			return er
+		}
+		pen += float64(adv) / 64
+	}
+	return nil
+}
+
+// pdfGlyph finds the glyph for a rune, falling back to a question mark for characters missing in the font.
+func pdfGlyph(f *sfnt.Font, b *sfnt.Buffer, r rune) sfnt.GlyphIndex {
+	if idx, er := f.GlyphIndex(b, r); er == nil && idx != 0 {
+		return idx
+	}
+	idx, _ := f.GlyphIndex(b, '?')
+	return idx
+}
+
+// pdfXrefRuns groups sorted object numbers in contiguous [start, count] subsections.
+func pdfXrefRuns(nums []int) (runs [][2]int) {
+	for _, n := range nums {
+		if l := len(runs); l > 0 && runs[l-1][0]+runs[l-1][1] == n {
+			runs[l-1][1]++
+		} else {
+			runs = append(runs, [2]int{n, 1})
+		}
+	}
+	return
+}
+
+func pdfNum(v float64) string {
+	return strconv.FormatFloat(v, 'f', 2, 64)
+}
+
+func sortedKeys(m map[int]int) []int {
+	keys := make([]int, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Ints(keys)
+	return keys
+}
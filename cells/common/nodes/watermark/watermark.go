@@ -0,0 +1,152 @@
+/*
+ * Copyright (c) 2025 Abstrium SAS <team (at) pydio.com>
+ * This file is part of Pydio Cells.
+ *
+ * Pydio Cells is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * Pydio Cells is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with Pydio Cells.  If not, see <http://www.gnu.org/licenses/>.
+ *
+ * The latest code can be found at <https://pydio.com>.
+ */
+
+// Package watermark stamps images and PDF documents served through public links configured with a watermark,
+// without altering the stored originals.
+package watermark
+
+import (
+	"bytes"
+	"image"
+	"image/color"
+	"image/draw"
+	_ "image/gif"
+	"image/jpeg"
+	"image/png"
+	"path"
+	"strings"
+	"sync"
+	"time"
+
+	"golang.org/x/image/font"
+	"golang.org/x/image/font/gofont/goregular"
+	"golang.org/x/image/font/opentype"
+	"golang.org/x/image/font/sfnt"
+	"golang.org/x/image/math/fixed"
+)
+
+const (
+	FormatJPEG = "jpeg"
+	FormatPNG  = "png"
+	FormatPDF  = "pdf"
+)
+
+var (
+	regular     *sfnt.Font
+	regularOnce sync.Once
+	regularErr  error
+)
+
+// Spec describes the text stamped on served files.
+type Spec struct {
+	Label     string
+	Recipient string
+	Date      time.Time
+}
+
+// Text builds the single line stamped on files.
+func (s *Spec) Text() string {
+	var parts []string
+	if s.Label != "" {
+		parts = append(parts, s.Label)
+	}
+	if s.Recipient != "" {
+		parts = append(parts, s.Recipient)
+	}
+	parts = append(parts, s.Date.Format("2006-01-02"))
+	return strings.Join(parts, " - ")
+}
+
+// FormatOf returns the watermark format for a given file name, or an empty string if it cannot be stamped.
+func FormatOf(name string) string {
+	switch strings.ToLower(strings.TrimPrefix(path.Ext(name), ".")) {
+	case "jpg", "jpeg":
+		return FormatJPEG
+	case "png":
+		return FormatPNG
+	case "pdf":
+		return FormatPDF
+	}
+	return ""
+}
+
+// Stamp applies the text on data, depending on its format.
+func Stamp(data []byte, format string, text string) ([]byte, error) {
+	if format == FormatPDF {
+		return StampPDF(data, text)
+	}
+	return StampImage(data, format, text)
+}
+
+// StampImage draws the text repeatedly over the whole image and re-encodes it in the given format.
+func StampImage(data []byte, format string, text string) ([]byte, error) {
+	src, _, er := image.Decode(bytes.NewReader(data))
+	if er != nil {
+		return nil, er
+	}
+	f, er := regularFont()
+	if er != nil {
+		return nil, er
+	}
+	b := src.Bounds()
+	dst := image.NewRGBA(b)
+	draw.Draw(dst, b, src, b.Min, draw.Src)
+
+	size := float64(b.Dx()) / 40
+	if size < 10 {
+		size = 10
+	}
+	face, er := opentype.NewFace(f, &opentype.FaceOptions{Size: size, DPI: 72, Hinting: font.HintingNone})
+	if er != nil {
+		return nil, er
+	}
+	defer face.Close()
+	d := &font.Drawer{
+		Dst:  dst,
+		Src:  image.NewUniform(color.NRGBA{R: 128, G: 128, B: 128, A: 110}),
+		Face: face,
+	}
+	stepX := d.MeasureString(text).Ceil() + int(size*4)
+	stepY := int(size * 6)
+	for row, y := 0, b.Min.Y+int(size*2); y < b.Max.Y; row, y = row+1, y+stepY {
+		for x := b.Min.X - (row%2)*stepX/2; x < b.Max.X; x += stepX {
+			d.Dot = fixed.P(x, y)
+			d.DrawString(text)
+		}
+	}
+
+	buf := &bytes.Buffer{}
+	if format == FormatPNG {
+		er = png.Encode(buf, dst)
+	} else {
+		er = jpeg.Encode(buf, dst, &jpeg.Options{Quality: 90})
+	}
+	if er != nil {
+		return nil, er
+	}
+	return buf.Bytes(), nil
+}
+
+func regularFont() (*sfnt.Font, error) {
+	regularOnce.Do(func() {
+		regular, regularErr = opentype.Parse(goregular.TTF)
+	})
+	return regular, regularErr
+}
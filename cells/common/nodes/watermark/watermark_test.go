@@ -0,0 +1,253 @@
+/*
+ * Copyright (c) 2025 Abstrium SAS <team (at) pydio.com>
+ * This file is part of Pydio Cells.
+ *
+ * Pydio Cells is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * Pydio Cells is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with Pydio Cells.  If not, see <http://www.gnu.org/licenses/>.
+ *
+ * The latest code can be found at <https://pydio.com>.
+ */
+
+package watermark
+
+import (
+	"bytes"
+	"compress/zlib"
+	"context"
+	"fmt"
+	"image"
+	"image/color"
+	"image/draw"
+	"image/jpeg"
+	"image/png"
+	"regexp"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/pydio/cells/v5/common"
+	"github.com/pydio/cells/v5/common/nodes"
+	"github.com/pydio/cells/v5/common/proto/tree"
+
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+// buildPDF assembles objects with a classic cross-reference table
+func buildPDF(objects []string) []byte {
+	buf := &bytes.Buffer{}
+	buf.WriteString("%PDF-1.4\n")
+	var offsets []int
+	for i, o := range objects {
+		offsets = append(offsets, buf.Len())
+		_, _ = fmt.Fprintf(buf, "%d 0 obj\n%s\nendobj\n", i+1, o)
+	}
+	xref := buf.Len()
+	_, _ = fmt.Fprintf(buf, "xref\n0 %d\n0000000000 65535 f\r\n", len(objects)+1)
+	for _, o := range offsets {
+		_, _ = fmt.Fprintf(buf, "%010d 00000 n\r\n", o)
+	}
+	_, _ = fmt.Fprintf(buf, "trailer\n<< /Size %d /Root 1 0 R >>\nstartxref\n%d\n%%%%EOF\n", len(objects)+1, xref)
+	return buf.Bytes()
+}
+
+// buildCompressedPDF stores the page inside an object stream, referenced by a cross-reference stream
+func buildCompressedPDF() []byte {
+	page := "<< /Type /Page /Parent 2 0 R /Contents 4 0 R >>"
+	header := "3 0 "
+	zb := &bytes.Buffer{}
+	zw := zlib.NewWriter(zb)
+	_, _ = zw.Write([]byte(header + page))
+	_ = zw.Close()
+
+	buf := &bytes.Buffer{}
+	buf.WriteString("%PDF-1.5\n")
+	off1 := buf.Len()
+	buf.WriteString("1 0 obj\n<< /Type /Catalog /Pages 2 0 R >>\nendobj\n")
+	off2 := buf.Len()
+	buf.WriteString("2 0 obj\n<< /Type /Pages /Kids [3 0 R] /Count 1 /MediaBox [0 0 595 842] >>\nendobj\n")
+	off4 := buf.Len()
+	buf.WriteString("4 0 obj\n<< /Length 8 >>\nstream\n0 0 m S\nendstream\nendobj\n")
+	off5 := buf.Len()
+	_, _ = fmt.Fprintf(buf, "5 0 obj\n<< /Type /ObjStm /N 1 /First %d /Filter /FlateDecode /Length %d >>\nstream\n", len(header), zb.Len())
+	buf.Write(zb.Bytes())
+	buf.WriteString("\nendstream\nendobj\n")
+	off6 := buf.Len()
+	entries := []byte{0, 0, 0, 0, 0, 255, 255}
+	for _, o := range []int{off1, off2} {
+		entries = append(entries, 1, byte(o>>24), byte(o>>16), byte(o>>8), byte(o), 0, 0)
+	}
+	entries = append(entries, 2, 0, 0, 0, 5, 0, 0)
+	for _, o := range []int{off4, off5, off6} {
+		entries = append(entries, 1, byte(o>>24), byte(o>>16), byte(o>>8), byte(o), 0, 0)
+	}
+	_, _ = fmt.Fprintf(buf, "6 0 obj\n<< /Type /XRef /Size 7 /W [1 4 2] /Root 1 0 R /Length %d >>\nstream\n", len(entries))
+	buf.Write(entries)
+	_, _ = fmt.Fprintf(buf, "\nendstream\nendobj\nstartxref\n%d\n%%%%EOF\n", off6)
+	return buf.Bytes()
+}
+
+// findObject returns the body of an object, making sure it is written only once
+func findObject(data []byte, num int) string {
+	re := regexp.MustCompile(`(?s)\b` + strconv.Itoa(num) + ` 0 obj\n(.*?)\nendobj`)
+	all := re.FindAllSubmatch(data, -1)
+	if len(all) != 1 {
+		return ""
+	}
+	return string(all[0][1])
+}
+
+// checkXref verifies that the single cross-reference table points to the expected objects
+func checkXref(out []byte) {
+	starts := regexp.MustCompile(`startxref\n(\d+)`).FindAllSubmatch(out, -1)
+	So(starts, ShouldHaveLength, 1)
+	last, _ := strconv.Atoi(string(starts[0][1]))
+	So(string(out[last:last+4]), ShouldEqual, "xref")
+	So(string(out[last:]), ShouldNotContainSubstring, "/Prev")
+	for _, m := range regexp.MustCompile(`(?m)^(\d+) (\d+)\n`).FindAllSubmatch(out[last:], -1) {
+		start, _ := strconv.Atoi(string(m[1]))
+		count, _ := strconv.Atoi(string(m[2]))
+		section := out[last+bytes.Index(out[last:], m[0])+len(m[0]):]
+		for i := 0; i < count; i++ {
+			if start+i == 0 {
+				continue
+			}
+			off, _ := strconv.Atoi(string(section[i*20 : i*20+10]))
+			So(string(out[off:]), ShouldStartWith, fmt.Sprintf("%d 0 obj", start+i))
+		}
+	}
+}
+
+func TestSpec(t *testing.T) {
+
+	Convey("Test watermark text and formats", t, func() {
+		d := time.Date(2025, 3, 4, 10, 0, 0, 0, time.UTC)
+		So((&Spec{Label: "Draft", Recipient: "john@example.com", Date: d}).Text(), ShouldEqual, "Draft - john@example.com - 2025-03-04")
+		So((&Spec{Date: d}).Text(), ShouldEqual, "2025-03-04")
+		So(FormatOf("folder/photo.JPG"), ShouldEqual, FormatJPEG)
+		So(FormatOf("photo.png"), ShouldEqual, FormatPNG)
+		So(FormatOf("doc.pdf"), ShouldEqual, FormatPDF)
+		So(FormatOf("doc.docx"), ShouldBeEmpty)
+	})
+
+}
+
+func TestStampImage(t *testing.T) {
+
+	Convey("Test image stamping", t, func() {
+		src := image.NewRGBA(image.Rect(0, 0, 400, 300))
+		for x := 0; x < 400; x++ {
+			for y := 0; y < 300; y++ {
+				src.Set(x, y, color.White)
+			}
+		}
+		buf := &bytes.Buffer{}
+		So(png.Encode(buf, src), ShouldBeNil)
+
+		out, er := StampImage(buf.Bytes(), FormatPNG, "Draft - 2025-03-04")
+		So(er, ShouldBeNil)
+		img, er := png.Decode(bytes.NewReader(out))
+		So(er, ShouldBeNil)
+		So(img.Bounds(), ShouldResemble, src.Bounds())
+		var changed bool
+		for x := 0; x < 400 && !changed; x++ {
+			for y := 0; y < 300 && !changed; y++ {
+				r, g, b, _ := img.At(x, y).RGBA()
+				changed = r != 0xffff || g != 0xffff || b != 0xffff
+			}
+		}
+		So(changed, ShouldBeTrue)
+
+		_, er = StampImage([]byte("not an image"), FormatJPEG, "text")
+		So(er, ShouldNotBeNil)
+	})
+
+	Convey("Test thumbnails stamping", t, func() {
+		ctx := context.Background()
+		So(formatFor(ctx, &tree.Node{Path: "folder/file.txt"}), ShouldBeEmpty)
+		So(formatFor(ctx, &tree.Node{Path: "folder/file.pdf"}), ShouldEqual, FormatPDF)
+		So(formatFor(ctx, &tree.Node{Path: common.PydioThumbstoreNamespace + "/node-uuid-512"}), ShouldEqual, FormatJPEG)
+		thumbCtx := nodes.WithBranchInfo(ctx, "in", nodes.BranchInfo{IndexedBinary: true})
+		So(formatFor(thumbCtx, &tree.Node{Path: "thumbs/node-uuid-256"}), ShouldEqual, FormatJPEG)
+
+		src := image.NewRGBA(image.Rect(0, 0, 256, 192))
+		draw.Draw(src, src.Bounds(), image.NewUniform(color.White), image.Point{}, draw.Src)
+		buf := &bytes.Buffer{}
+		So(jpeg.Encode(buf, src, nil), ShouldBeNil)
+
+		out, er := Stamp(buf.Bytes(), formatFor(thumbCtx, &tree.Node{Path: "thumbs/node-uuid-256.jpg"}), "Draft - 2025-03-04")
+		So(er, ShouldBeNil)
+		img, er := jpeg.Decode(bytes.NewReader(out))
+		So(er, ShouldBeNil)
+		So(img.Bounds(), ShouldResemble, src.Bounds())
+		var darker int
+		for x := 0; x < 256; x++ {
+			for y := 0; y < 192; y++ {
+				if r, _, _, _ := img.At(x, y).RGBA(); r < 0xe000 {
+					darker++
+				}
+			}
+		}
+		So(darker, ShouldBeGreaterThan, 0)
+	})
+
+}
+
+func TestStampPDF(t *testing.T) {
+
+	Convey("Test pdf stamping with a cross-reference table", t, func() {
+		original := buildPDF([]string{
+			"<< /Type /Catalog /Pages 2 0 R >>",
+			"<< /Type /Pages /Kids [3 0 R 5 0 R] /Count 2 >>",
+			"<< /Type /Page /Parent 2 0 R /MediaBox [0 0 595 842] /Contents 4 0 R >>",
+			"<< /Length 8 >>\nstream\n0 0 m S\nendstream",
+			"<< /Type /Page /Parent 2 0 R >>",
+		})
+		out, er := StampPDF(original, "Draft - john@example.com - 2025-03-04")
+		So(er, ShouldBeNil)
+		So(string(out), ShouldStartWith, "%PDF-1.4\n")
+
+		// Both pages are updated, the second one has no contents yet
+		So(findObject(out, 3), ShouldContainSubstring, "/Contents [6 0 R 4 0 R 7 0 R 8 0 R]")
+		So(findObject(out, 5), ShouldContainSubstring, "/Contents [9 0 R]")
+		So(findObject(out, 8), ShouldContainSubstring, " cm\n")
+
+		// Document is rewritten with a single cross-reference table: original pages cannot be recovered
+		So(bytes.Contains(out, []byte("/Contents 4 0 R")), ShouldBeFalse)
+		So(bytes.Count(out, []byte("%%EOF")), ShouldEqual, 1)
+		So(string(out), ShouldContainSubstring, "trailer\n<< /Size 10 /Root 1 0 R >>")
+		checkXref(out)
+	})
+
+	Convey("Test pdf stamping with object and cross-reference streams", t, func() {
+		original := buildCompressedPDF()
+		out, er := StampPDF(original, "Draft")
+		So(er, ShouldBeNil)
+		So(findObject(out, 3), ShouldContainSubstring, "/Contents [7 0 R 4 0 R 8 0 R 9 0 R]")
+
+		// Object and cross-reference streams are replaced by plain objects
+		So(bytes.Contains(out, []byte("/ObjStm")), ShouldBeFalse)
+		So(bytes.Contains(out, []byte("/XRef")), ShouldBeFalse)
+		So(findObject(out, 5), ShouldBeEmpty)
+		So(string(out), ShouldContainSubstring, "trailer\n<< /Size 10 /Root 1 0 R >>")
+		checkXref(out)
+	})
+
+	Convey("Test unsupported pdf documents", t, func() {
+		_, er := StampPDF([]byte("not a pdf"), "text")
+		So(er, ShouldNotBeNil)
+		encrypted := bytes.Replace(buildPDF([]string{"<< /Type /Catalog >>"}), []byte("/Root 1 0 R"), []byte("/Root 1 0 R /Encrypt 2 0 R"), 1)
+		_, er = StampPDF(encrypted, "text")
+		So(er, ShouldNotBeNil)
+	})
+
+}
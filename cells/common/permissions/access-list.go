@@ -61,6 +61,9 @@ var (
 	AclDeny              = &idm.ACLAction{Name: "deny", Value: "1"}
 	AclPolicy            = &idm.ACLAction{Name: "policy"}
 	AclQuota             = &idm.ACLAction{Name: "quota"}
+	AclQuotaPersonal     = &idm.ACLAction{Name: "quota_personal"}
+	AclQuotaTotal        = &idm.ACLAction{Name: "quota_total"}
+	AclQuotaGroup        = &idm.ACLAction{Name: "quota_group"}
 	AclLock              = &idm.ACLAction{Name: "lock"}
 	AclChildLock         = &idm.ACLAction{Name: "child_lock"}
 	AclContentLock       = &idm.ACLAction{Name: "content_lock"}
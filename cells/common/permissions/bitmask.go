@@ -116,6 +116,7 @@ func (f *Bitmask) HasFlag(ctx context.Context, flag BitmaskFlag, ctxNodes ...*tr
 		policyContext := make(map[string]string)
 		PolicyContextFromMetadata(policyContext, ctx)
 		PolicyContextFromClaims(policyContext, ctx)
+		PolicyContextFromClient(policyContext, ctx)
 		var subjects []string
 		for k := range f.PolicyIds {
 			subjects = append(subjects, fmt.Sprintf("policy:%s", k))
@@ -154,6 +155,7 @@ func (f *Bitmask) HasPolicyExplicitDeny(ctx context.Context, flag BitmaskFlag, c
 		policyContext := make(map[string]string)
 		PolicyContextFromMetadata(policyContext, ctx)
 		PolicyContextFromClaims(policyContext, ctx)
+		PolicyContextFromClient(policyContext, ctx)
 		var subjects []string
 		for k := range f.PolicyIds {
 			subjects = append(subjects, fmt.Sprintf("policy:%s", k))
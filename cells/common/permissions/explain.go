@@ -0,0 +1,385 @@
+/*
+ * Copyright (c) 2025 Abstrium SAS <team (at) pydio.com>
+ * This file is part of Pydio Cells.
+ *
+ * Pydio Cells is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * Pydio Cells is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with Pydio Cells.  If not, see <http://www.gnu.org/licenses/>.
+ *
+ * The latest code can be found at <https://pydio.com>.
+ */
+
+package permissions
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/pydio/cells/v5/common/auth/claim"
+	"github.com/pydio/cells/v5/common/client/commons"
+	"github.com/pydio/cells/v5/common/client/commons/treec"
+	"github.com/pydio/cells/v5/common/errors"
+	"github.com/pydio/cells/v5/common/proto/idm"
+	"github.com/pydio/cells/v5/common/proto/tree"
+	"github.com/pydio/cells/v5/common/utils/std"
+)
+
+// ExplainedACL is an ACL found on one of the explained nodes, along with the role that carries it.
+type ExplainedACL struct {
+	Action      string
+	Value       string
+	WorkspaceId string
+	RoleId      string
+	RoleLabel   string
+	RoleType    string
+	// Overridden is set when a role applied later in the roles stack redefines the permissions on the same node,
+	// or when the role does not belong to the stack at all.
+	Overridden bool
+
+	roleIndex int
+}
+
+// ExplainedNode gathers the ACLs attached to one node of the explained path.
+type ExplainedNode struct {
+	Node *tree.Node
+	ACLs []*ExplainedACL
+	// Mask is the bitmask resulting from the flatten of all roles for this node
+	Mask Bitmask
+	// Deny is set if the mask carries a deny flag
+	Deny bool
+	// Applied is set on the node that provides the effective permissions (the closest node having a mask)
+	Applied bool
+}
+
+// ExplainedPolicy is the result of the evaluation of one ACL policy against the explained path.
+type ExplainedPolicy struct {
+	PolicyId     string
+	Permission   string
+	Allowed      bool
+	ExplicitDeny bool
+}
+
+// ExplainedWorkspace is a workspace rooted on one of the explained nodes.
+type ExplainedWorkspace struct {
+	Workspace *idm.Workspace
+	RootUuid  string
+	RootPath  string
+}
+
+// Explanation details how the effective permissions of an AccessList are computed on a given node.
+type Explanation struct {
+	Roles      []*idm.Role
+	Nodes      []*ExplainedNode
+	Workspaces []*ExplainedWorkspace
+	Policies   []*ExplainedPolicy
+	// Effective is the resolved bitmask: policies are evaluated, claims scopes and denies are applied.
+	Effective Bitmask
+	// DeniedBy is the first node of the path carrying a deny, if any
+	DeniedBy *tree.Node
+	// ScopesDeny lists the permissions removed by the claims scopes
+	ScopesDeny []string
+	// Trace is a human-readable summary of the resolution, line by line
+	Trace []string
+}
+
+// Permissions lists the names of the effective flags.
+func (e *Explanation) Permissions() []string {
+	return FlagNames(e.Effective.BitmaskFlag)
+}
+
+func (e *Explanation) trace(format string, args ...interface{}) {
+	e.Trace = append(e.Trace, fmt.Sprintf(format, args...))
+}
+
+// FlagNames lists the names of the flags set in a BitmaskFlag, in flags order.
+func FlagNames(b BitmaskFlag) (names []string) {
+	for f := FlagRead; f <= FlagSync; f <<= 1 {
+		if b&f != 0 {
+			names = append(names, FlagsToNames[f])
+		}
+	}
+	return
+}
+
+// RoleType returns a short description of the role type: user, group, team, profile or role.
+func RoleType(r *idm.Role) string {
+	switch {
+	case r.UserRole:
+		return "user"
+	case r.GroupRole:
+		return "group"
+	case r.IsTeam:
+		return "team"
+	case len(r.AutoApplies) > 0:
+		return "profile"
+	default:
+		return "role"
+	}
+}
+
+// Explain replays the resolution performed by CanRead/CanWrite on the passed nodes (target node first, then
+// its parents up to the root) and records every contributing ACL, role, workspace and policy.
+func (a *AccessList) Explain(ctx context.Context, nodes ...*tree.Node) *Explanation {
+	ex := &Explanation{Roles: a.orderedRoles}
+	if len(nodes) == 0 {
+		return ex
+	}
+
+	rolesIndex := make(map[string]int, len(a.orderedRoles))
+	var stack []string
+	for i, r := range a.orderedRoles {
+		rolesIndex[r.Uuid] = i
+		stack = append(stack, fmt.Sprintf("%s (%s)", roleLabel(r), RoleType(r)))
+	}
+	ex.trace("Roles are applied in this order, the last role defining a node wins: %s", strings.Join(stack, " > "))
+
+	a.maskBULock.RLock()
+	masks := std.CloneMap(a.masksByUUIDs)
+	a.maskBULock.RUnlock()
+
+	var applied *ExplainedNode
+	for i, node := range nodes {
+		en := &ExplainedNode{Node: node}
+		winner := -1
+		for _, acl := range a.wsACLs {
+			if acl.NodeID != node.GetUuid() || acl.GetAction() == nil {
+				continue
+			}
+			if _, ok := NamesToFlags[acl.Action.Name]; !ok {
+				continue
+			}
+			ea := &ExplainedACL{
+				Action:      acl.Action.Name,
+				Value:       acl.Action.Value,
+				WorkspaceId: acl.WorkspaceID,
+				RoleId:      acl.RoleID,
+				roleIndex:   -1,
+			}
+			if idx, ok := rolesIndex[acl.RoleID]; ok {
+				ea.roleIndex = idx
+				ea.RoleLabel = roleLabel(a.orderedRoles[idx])
+				ea.RoleType = RoleType(a.orderedRoles[idx])
+				winner = max(winner, idx)
+			}
+			en.ACLs = append(en.ACLs, ea)
+		}
+		sort.SliceStable(en.ACLs, func(i, j int) bool {
+			return en.ACLs[i].roleIndex < en.ACLs[j].roleIndex
+		})
+		for _, ea := range en.ACLs {
+			ea.Overridden = ea.roleIndex == -1 || ea.roleIndex != winner
+		}
+
+		label := "parent"
+		if i == 0 {
+			label = "target"
+		}
+		if mask, ok := masks[node.GetUuid()]; ok {
+			en.Mask = mask
+			en.Deny = mask.HasFlag(ctx, FlagDeny, node)
+			if applied == nil && mask.BitmaskFlag != 0 {
+				applied = en
+				en.Applied = true
+			}
+			if en.Deny && ex.DeniedBy == nil {
+				ex.DeniedBy = node
+			}
+			ex.trace("[%s] %s: %s", label, explainNodeName(node), strings.Join(FlagNames(mask.BitmaskFlag), ", "))
+		} else {
+			ex.trace("[%s] %s: no ACL", label, explainNodeName(node))
+		}
+		for _, ea := range en.ACLs {
+			switch {
+			case ea.roleIndex == -1:
+				ex.trace("    %s from role %s is ignored, role is not applied to this user", ea.actionString(), ea.RoleId)
+			case ea.Overridden:
+				ex.trace("    %s from %s (%s) is overridden by %s", ea.actionString(), ea.RoleLabel, ea.RoleType, roleLabel(a.orderedRoles[winner]))
+			default:
+				ex.trace("    %s from %s (%s)", ea.actionString(), ea.RoleLabel, ea.RoleType)
+			}
+		}
+		ex.Nodes = append(ex.Nodes, en)
+	}
+
+	a.maskRootsLock.RLock()
+	for _, node := range nodes {
+		for wsId, roots := range a.wssRootsMasks {
+			ws, ok := a.wss[wsId]
+			if _, isRoot := roots[node.GetUuid()]; !ok || !isRoot {
+				continue
+			}
+			ex.Workspaces = append(ex.Workspaces, &ExplainedWorkspace{Workspace: ws, RootUuid: node.GetUuid(), RootPath: node.GetPath()})
+		}
+	}
+	a.maskRootsLock.RUnlock()
+	sort.Slice(ex.Workspaces, func(i, j int) bool {
+		return ex.Workspaces[i].Workspace.GetSlug() < ex.Workspaces[j].Workspace.GetSlug()
+	})
+	for _, ew := range ex.Workspaces {
+		ex.trace("Node is visible in workspace %s (%s), rooted at %s", ew.Workspace.GetLabel(), ew.Workspace.GetSlug(), explainNodeName(&tree.Node{Uuid: ew.RootUuid, Path: ew.RootPath}))
+	}
+
+	if ex.DeniedBy != nil {
+		ex.Effective = Bitmask{BitmaskFlag: FlagDeny}
+		ex.trace("Access is denied by an explicit deny on %s", explainNodeName(ex.DeniedBy))
+		return ex
+	}
+	if applied == nil {
+		ex.trace("No ACL found on the node or its parents: no access")
+		return ex
+	}
+
+	mask := applied.Mask
+	ex.trace("Effective permissions are inherited from %s", explainNodeName(applied.Node))
+	for f := FlagRead; f <= FlagSync; f <<= 1 {
+		switch f {
+		case FlagDeny, FlagPolicy:
+			continue
+		case FlagRead, FlagWrite:
+			if mask.HasFlag(ctx, f, nodes...) {
+				ex.Effective.AddFlag(f)
+			}
+		case FlagQuota:
+			if v, ok := mask.ValueFlags[f]; ok {
+				ex.Effective.AddValueFlag(f, v)
+			}
+		default:
+			if mask.BitmaskFlag&f != 0 {
+				ex.Effective.AddFlag(f)
+			}
+		}
+	}
+
+	if mask.BitmaskFlag&FlagPolicy != 0 {
+		var ids []string
+		for id := range mask.PolicyIds {
+			ids = append(ids, id)
+		}
+		sort.Strings(ids)
+		for _, id := range ids {
+			single := Bitmask{BitmaskFlag: FlagPolicy, PolicyIds: map[string]string{id: id}}
+			for _, f := range []BitmaskFlag{FlagRead, FlagWrite} {
+				ep := &ExplainedPolicy{
+					PolicyId:     id,
+					Permission:   FlagsToNames[f],
+					Allowed:      single.HasFlag(ctx, f, nodes...),
+					ExplicitDeny: single.HasPolicyExplicitDeny(ctx, f, nodes[0]),
+				}
+				ex.Policies = append(ex.Policies, ep)
+				switch {
+				case ep.ExplicitDeny:
+					ex.trace("Policy %s explicitly denies %s", id, ep.Permission)
+				case ep.Allowed:
+					ex.trace("Policy %s grants %s", id, ep.Permission)
+				default:
+					ex.trace("Policy %s does not grant %s", id, ep.Permission)
+				}
+			}
+		}
+	}
+
+	if a.hasClaimsScopes {
+		for _, f := range []BitmaskFlag{FlagRead, FlagWrite} {
+			if ex.Effective.BitmaskFlag&f != 0 && a.claimsScopesDeny(ctx, nodes[0], f) {
+				ex.Effective.BitmaskFlag &^= f
+				ex.ScopesDeny = append(ex.ScopesDeny, FlagsToNames[f])
+				ex.trace("Token scopes do not include %s on this node", FlagsToNames[f])
+			}
+		}
+	}
+
+	if names := ex.Permissions(); len(names) > 0 {
+		ex.trace("Effective permissions: %s", strings.Join(names, ", "))
+	} else {
+		ex.trace("Effective permissions: none")
+	}
+	return ex
+}
+
+// ExplainUserAccess loads a fresh AccessList for the given user and explains its permissions on the passed node,
+// which is resolved by Uuid or Path (as stored in the tree) along with its parents. Policies are evaluated
+// with the user claims. If scopes are passed, the user is considered as using a token restricted to these scopes.
+func ExplainUserAccess(ctx context.Context, login, userUuid string, node *tree.Node, scopes ...string) (*Explanation, *idm.User, error) {
+	user, er := SearchUniqueUser(ctx, login, userUuid)
+	if er != nil {
+		return nil, nil, er
+	}
+	if user.IsGroup {
+		return nil, nil, errors.WithMessagef(errors.InvalidParameters, "%s is a group, not a user", user.GroupPath)
+	}
+
+	var ancestors []*tree.Node
+	st, er := treec.NodeProviderClient(ctx).ListNodes(ctx, &tree.ListNodesRequest{Node: node, Ancestors: true})
+	if er = commons.ForEach(st, er, func(resp *tree.ListNodesResponse) error {
+		ancestors = append(ancestors, resp.GetNode())
+		return nil
+	}); er != nil {
+		return nil, nil, er
+	}
+	if len(ancestors) == 0 {
+		return nil, nil, errors.WithMessagef(errors.NodeNotFound, "cannot find node %s", explainNodeName(node))
+	}
+
+	accessList, er := AccessListFromRoles(ctx, user.Roles, true, true)
+	if er != nil {
+		return nil, nil, er
+	}
+	if len(scopes) > 0 {
+		accessList.AppendClaimsScopes(scopes)
+	}
+
+	var roles []string
+	for _, r := range user.Roles {
+		roles = append(roles, r.Uuid)
+	}
+	userCtx := claim.ToContext(ctx, claim.Claims{
+		Name:           user.Login,
+		Subject:        user.Uuid,
+		Profile:        user.Attributes[idm.UserAttrProfile],
+		Roles:          strings.Join(roles, ","),
+		GroupPath:      user.GroupPath,
+		ProvidesScopes: len(scopes) > 0,
+		Scopes:         scopes,
+	})
+
+	return accessList.Explain(userCtx, ancestors...), user, nil
+}
+
+func (e *ExplainedACL) actionString() string {
+	if e.Value == "" || e.Value == "1" {
+		return e.Action
+	}
+	return e.Action + "=" + e.Value
+}
+
+func roleLabel(r *idm.Role) string {
+	if r.Label != "" {
+		return r.Label
+	}
+	return r.Uuid
+}
+
+func explainNodeName(n *tree.Node) string {
+	p := strings.Trim(n.GetPath(), "/")
+	switch {
+	case p == "" && n.GetUuid() == "":
+		return "/"
+	case p == "":
+		return n.GetUuid()
+	case n.GetUuid() == "":
+		return p
+	default:
+		return p + " (" + n.GetUuid() + ")"
+	}
+}
@@ -0,0 +1,112 @@
+/*
+ * Copyright (c) 2025 Abstrium SAS <team (at) pydio.com>
+ * This file is part of Pydio Cells.
+ *
+ * Pydio Cells is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * Pydio Cells is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with Pydio Cells.  If not, see <http://www.gnu.org/licenses/>.
+ *
+ * The latest code can be found at <https://pydio.com>.
+ */
+
+package permissions
+
+import (
+	"context"
+	"testing"
+
+	. "github.com/smartystreets/goconvey/convey"
+
+	"github.com/pydio/cells/v5/common/proto/idm"
+)
+
+func TestAccessList_Explain(t *testing.T) {
+	Convey("Test Explain", t, func() {
+		ctx := context.Background()
+		list := NewAccessList(roles...)
+		list.AppendACLs(acls...)
+		list.AppendACLs(&idm.ACL{NodeID: "root/folder1/subfolder2", RoleID: "root", Action: AclRead})
+		list.Flatten(ctx)
+		_ = list.LoadWorkspaces(ctx, func(ctx context.Context, uuids []string) ([]*idm.Workspace, error) {
+			return []*idm.Workspace{
+				{UUID: "ws1", Slug: "ws-one"},
+				{UUID: "ws2", Slug: "ws-two"},
+			}, nil
+		})
+
+		Convey("Inherited read/write", func() {
+			ex := list.Explain(ctx, listParents("root/folder1/subfolder2/file1")...)
+			So(ex.Permissions(), ShouldResemble, []string{"read", "write"})
+			So(ex.DeniedBy, ShouldBeNil)
+			So(ex.Nodes, ShouldHaveLength, 4)
+			So(ex.Nodes[0].ACLs, ShouldBeEmpty)
+			So(ex.Nodes[1].Applied, ShouldBeTrue)
+			So(ex.Nodes[1].ACLs, ShouldHaveLength, 3)
+			// ACL from the first role is overridden by the second role
+			So(ex.Nodes[1].ACLs[0].RoleId, ShouldEqual, "root")
+			So(ex.Nodes[1].ACLs[0].Overridden, ShouldBeTrue)
+			So(ex.Nodes[1].ACLs[1].Overridden, ShouldBeFalse)
+			So(ex.Nodes[1].ACLs[2].Overridden, ShouldBeFalse)
+			So(ex.Workspaces, ShouldHaveLength, 2)
+			So(ex.Workspaces[0].Workspace.Slug, ShouldEqual, "ws-one")
+			So(ex.Trace[len(ex.Trace)-1], ShouldEqual, "Effective permissions: read, write")
+		})
+
+		Convey("Closest mask wins", func() {
+			ex := list.Explain(ctx, listParents("root/folder1/subfolder2/file2")...)
+			So(ex.Permissions(), ShouldResemble, []string{"read"})
+			So(ex.Nodes[0].Applied, ShouldBeTrue)
+			So(ex.Nodes[1].Applied, ShouldBeFalse)
+		})
+
+		Convey("Explicit deny", func() {
+			ex := list.Explain(ctx, listParents("root/folder1/subfolder1/fileA")...)
+			So(ex.Permissions(), ShouldResemble, []string{"deny"})
+			So(ex.DeniedBy, ShouldNotBeNil)
+			So(ex.DeniedBy.Uuid, ShouldEqual, "root/folder1/subfolder1")
+			So(list.CanRead(ctx, listParents("root/folder1/subfolder1/fileA")...), ShouldBeFalse)
+		})
+
+		Convey("No ACL", func() {
+			ex := list.Explain(ctx, listParents("root/folder2")...)
+			So(ex.Permissions(), ShouldBeEmpty)
+			So(ex.Trace[len(ex.Trace)-1], ShouldEqual, "No ACL found on the node or its parents: no access")
+		})
+
+		Convey("Claims scopes", func() {
+			list.AppendClaimsScopes([]string{"node:root/folder1/subfolder2/file1:r"})
+			ex := list.Explain(ctx, listParents("root/folder1/subfolder2/file1")...)
+			So(ex.Permissions(), ShouldResemble, []string{"read"})
+			So(ex.ScopesDeny, ShouldResemble, []string{"write"})
+		})
+	})
+
+	Convey("Test Explain Policies", t, func() {
+		ResolvePolicyRequest = policyMockResolver
+
+		ctx := context.Background()
+		list := NewAccessList(roles...)
+		list.AppendACLs(policyAcls...)
+		list.Flatten(ctx)
+
+		ex := list.Explain(ctx, listParents("root/folder1")...)
+		So(ex.Permissions(), ShouldResemble, []string{"read", "write"})
+		So(ex.Policies, ShouldHaveLength, 2)
+		So(ex.Policies[0].PolicyId, ShouldEqual, "meta-filter")
+		So(ex.Policies[0].Allowed, ShouldBeTrue)
+
+		ex = list.Explain(ctx, listParents("root/filtered")...)
+		So(ex.Permissions(), ShouldBeEmpty)
+		So(ex.Policies[0].Allowed, ShouldBeFalse)
+		So(ex.Policies[0].ExplicitDeny, ShouldBeTrue)
+	})
+}
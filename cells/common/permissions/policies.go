@@ -37,6 +37,7 @@ import (
 	"github.com/pydio/cells/v5/common/auth/claim"
 	"github.com/pydio/cells/v5/common/broker"
 	"github.com/pydio/cells/v5/common/client/grpc"
+	"github.com/pydio/cells/v5/common/config"
 	"github.com/pydio/cells/v5/common/middleware/keys"
 	"github.com/pydio/cells/v5/common/proto/idm"
 	"github.com/pydio/cells/v5/common/proto/tree"
@@ -56,6 +57,17 @@ const (
 	PolicyNodeMetaMTime     = "NodeMetaMTime"
 	PolicyNodeMeta_         = "NodeMeta:"
 
+	PolicyClientType = "ClientType"
+	PolicyAuthMethod = "AuthMethod"
+
+	ClientTypeWeb    = "web"
+	ClientTypeSync   = "sync"
+	ClientTypeCLI    = "cli"
+	ClientTypeMobile = "mobile"
+	ClientTypeWebDAV = "webdav"
+	ClientTypeS3     = "s3"
+	ClientTypeAPI    = "api"
+
 	PolicySubjectLoginPrefix   = "user:"
 	PolicySubjectUuidPrefix    = "subject:"
 	PolicySubjectProfilePrefix = "profile:"
@@ -143,6 +155,8 @@ func PolicyContextFromMetadata(policyContext map[string]string, ctx context.Cont
 	if ctxMeta, has := propagator.FromContextRead(ctx); has {
 		for _, key := range []string{
 			keys.HttpMetaRemoteAddress,
+			keys.HttpMetaPeerAddress,
+			keys.HttpMetaForwardedFor,
 			keys.HttpMetaUserAgent,
 			keys.HttpMetaContentType,
 			keys.HttpMetaProtocol,
@@ -194,6 +208,50 @@ func PolicyContextFromClaims(policyContext map[string]string, ctx context.Contex
 	}
 }
 
+// PolicyContextFromClient enriches the passed policyContext with the type of client sending the request
+// and the authentication method of the current user, if any.
+func PolicyContextFromClient(policyContext map[string]string, ctx context.Context) {
+	if ct := ClientTypeFromContext(ctx); ct != "" {
+		policyContext[PolicyClientType] = ct
+	}
+	if claims, ok := claim.FromContext(ctx); ok {
+		policyContext[PolicyAuthMethod] = claims.AuthMethod()
+	}
+}
+
+// ClientTypeFromContext detects the type of client, using the OAuth client of the claims first, then the gateway
+// that received the request.
+func ClientTypeFromContext(ctx context.Context) string {
+	claims, hasClaims := claim.FromContext(ctx)
+	if hasClaims {
+		switch claims.GetClientApp() {
+		case "cells-sync":
+			return ClientTypeSync
+		case "cells-client":
+			return ClientTypeCLI
+		case "cells-mobile":
+			return ClientTypeMobile
+		}
+	}
+	if uri, ok := propagator.CanonicalMeta(ctx, keys.HttpMetaRequestURI); ok {
+		if uri == common.DefaultRouteDAV || strings.HasPrefix(uri, common.DefaultRouteDAV+"/") {
+			return ClientTypeWebDAV
+		}
+		for _, r := range []string{common.DefaultRouteBucketIO, common.DefaultRouteBucketData} {
+			if uri == r || strings.HasPrefix(uri, r+"/") {
+				return ClientTypeS3
+			}
+		}
+	}
+	if !hasClaims {
+		return ""
+	}
+	if claims.GetClientApp() == config.DefaultOAuthClientID {
+		return ClientTypeWeb
+	}
+	return ClientTypeAPI
+}
+
 func loadPoliciesByResourcesType(ctx context.Context, resType string) ([]*idm.Policy, error) {
 
 	cli := idm.NewPolicyEngineServiceClient(grpc.ResolveConn(ctx, common.ServicePolicyGRPC))
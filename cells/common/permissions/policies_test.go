@@ -0,0 +1,71 @@
+/*
+ * Copyright (c) 2025 Abstrium SAS <team (at) pydio.com>
+ * This file is part of Pydio Cells.
+ *
+ * Pydio Cells is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * Pydio Cells is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with Pydio Cells.  If not, see <http://www.gnu.org/licenses/>.
+ *
+ * The latest code can be found at <https://pydio.com>.
+ */
+
+package permissions
+
+import (
+	"context"
+	"testing"
+
+	"github.com/pydio/cells/v5/common"
+	"github.com/pydio/cells/v5/common/auth/claim"
+	"github.com/pydio/cells/v5/common/middleware/keys"
+	"github.com/pydio/cells/v5/common/utils/propagator"
+
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestPolicyContextFromClient(t *testing.T) {
+
+	Convey("Detect client type and authentication method", t, func() {
+		withURI := func(uri string) context.Context {
+			return propagator.NewContext(context.Background(), map[string]string{keys.HttpMetaRequestURI: uri})
+		}
+
+		So(ClientTypeFromContext(context.Background()), ShouldEqual, "")
+		So(ClientTypeFromContext(withURI("/dav/common-files/doc.txt")), ShouldEqual, ClientTypeWebDAV)
+		So(ClientTypeFromContext(withURI("/io/personal-files/doc.txt")), ShouldEqual, ClientTypeS3)
+		So(ClientTypeFromContext(withURI("/davinci")), ShouldEqual, "")
+
+		ctx := claim.ToContext(withURI("/io/personal-files/doc.txt"), claim.Claims{ClientApp: "cells-sync"})
+		So(ClientTypeFromContext(ctx), ShouldEqual, ClientTypeSync)
+		ctx = claim.ToContext(withURI("/a/tree/stats"), claim.Claims{ClientApp: []string{"cells-frontend"}})
+		So(ClientTypeFromContext(ctx), ShouldEqual, ClientTypeWeb)
+		ctx = claim.ToContext(withURI("/a/tree/stats"), claim.Claims{ClientApp: []string{common.ServiceGrpcNamespace_ + common.ServiceToken}})
+		So(ClientTypeFromContext(ctx), ShouldEqual, ClientTypeAPI)
+
+		pc := map[string]string{}
+		PolicyContextFromClient(pc, ctx)
+		So(pc[PolicyClientType], ShouldEqual, ClientTypeAPI)
+		So(pc[PolicyAuthMethod], ShouldEqual, claim.AuthMethodToken)
+
+		for _, c := range []struct {
+			claims claim.Claims
+			method string
+		}{
+			{claims: claim.Claims{ClientApp: "cells-frontend"}, method: claim.AuthMethodPassword},
+			{claims: claim.Claims{ClientApp: "cells-frontend", AuthSource: "pydio"}, method: claim.AuthMethodPassword},
+			{claims: claim.Claims{ClientApp: "cells-frontend", AuthSource: "google"}, method: claim.AuthMethodSSO},
+			{claims: claim.Claims{ClientApp: "cells-frontend", AuthMethods: []string{"pwd", "otp"}}, method: claim.AuthMethodMFA},
+		} {
+			So(c.claims.AuthMethod(), ShouldEqual, c.method)
+		}
+	})
+}
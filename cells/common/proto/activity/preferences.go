@@ -0,0 +1,268 @@
+/*
+ * Copyright (c) 2025 Abstrium SAS <team (at) pydio.com>
+ * This file is part of Pydio Cells.
+ *
+ * Pydio Cells is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * Pydio Cells is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with Pydio Cells.  If not, see <http://www.gnu.org/licenses/>.
+ *
+ * The latest code can be found at <https://pydio.com>.
+ */
+
+package activity
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+)
+
+/* preferences.go file describes per-user notification preferences, stored as JSON in the user "notifications" attribute */
+
+// NotificationEvent is a family of activities a user can route to one or more channels.
+type NotificationEvent string
+
+// NotificationChannel is a delivery target for activities.
+type NotificationChannel string
+
+const (
+	NotificationEventCreate  NotificationEvent = "create"
+	NotificationEventChange  NotificationEvent = "change"
+	NotificationEventDelete  NotificationEvent = "delete"
+	NotificationEventRead    NotificationEvent = "read"
+	NotificationEventShare   NotificationEvent = "share"
+	NotificationEventComment NotificationEvent = "comment"
+	NotificationEventMention NotificationEvent = "mention"
+
+	// NotificationChannelInApp pushes live alerts to the web interface.
+	NotificationChannelInApp NotificationChannel = "inapp"
+	// NotificationChannelEmail sends activities by email as soon as the digest job runs (every 15 minutes).
+	NotificationChannelEmail NotificationChannel = "email"
+	// NotificationChannelDaily gathers activities in a daily email digest.
+	NotificationChannelDaily NotificationChannel = "daily"
+	// NotificationChannelWeekly gathers activities in a weekly email digest.
+	NotificationChannelWeekly NotificationChannel = "weekly"
+	// NotificationChannelWebhook posts activities as JSON to the user webhook URL.
+	NotificationChannelWebhook NotificationChannel = "webhook"
+
+	// MentionActivityName is the name of Event activities posted when a user is mentioned.
+	MentionActivityName = "Mention"
+)
+
+var (
+	// DefaultNotificationChannels are used for events that have no explicit routing, and preserve
+	// the historical behavior: everything is shown in-app and sent by email.
+	DefaultNotificationChannels = []NotificationChannel{NotificationChannelInApp, NotificationChannelEmail}
+
+	knownEvents = map[NotificationEvent]struct{}{
+		NotificationEventCreate: {}, NotificationEventChange: {}, NotificationEventDelete: {}, NotificationEventRead: {},
+		NotificationEventShare: {}, NotificationEventComment: {}, NotificationEventMention: {},
+	}
+	knownChannels = map[NotificationChannel]struct{}{
+		NotificationChannelInApp: {}, NotificationChannelEmail: {}, NotificationChannelDaily: {},
+		NotificationChannelWeekly: {}, NotificationChannelWebhook: {},
+	}
+)
+
+// QuietHours defines a daily time range (HH:MM, in the user time zone) during which live alerts are not pushed,
+// and immediate emails and webhooks are held back. End may be lower than Start to span midnight.
+type QuietHours struct {
+	Start string `json:"start"`
+	End   string `json:"end"`
+}
+
+// NotificationPreferences routes each event type to a set of channels. An event absent from Events
+// uses DefaultNotificationChannels, an event mapped to an empty list is muted. Folders holds overrides
+// keyed by folder UUID, applying to that folder and all its children.
+type NotificationPreferences struct {
+	Events     map[NotificationEvent][]NotificationChannel            `json:"events,omitempty"`
+	Folders    map[string]map[NotificationEvent][]NotificationChannel `json:"folders,omitempty"`
+	TimeZone   string                                                 `json:"timeZone,omitempty"`
+	QuietHours *QuietHours                                            `json:"quietHours,omitempty"`
+	WebhookURL string                                                 `json:"webhookUrl,omitempty"`
+}
+
+// ParseNotificationPreferences decodes and validates a JSON-encoded preferences value.
+// An empty value returns default preferences.
+func ParseNotificationPreferences(value string) (*NotificationPreferences, error) {
+	p := &NotificationPreferences{}
+	if strings.TrimSpace(value) == "" {
+		return p, nil
+	}
+	if er := json.Unmarshal([]byte(value), p); er != nil {
+		return nil, fmt.Errorf("cannot decode notification preferences: %v", er)
+	}
+	if er := p.Validate(); er != nil {
+		return nil, er
+	}
+	return p, nil
+}
+
+// Validate checks event types, channels, time zone, quiet hours and webhook URL.
+func (p *NotificationPreferences) Validate() error {
+	check := func(routes map[NotificationEvent][]NotificationChannel) error {
+		for ev, cc := range routes {
+			if _, ok := knownEvents[ev]; !ok {
+				return fmt.Errorf("unknown notification event %s", ev)
+			}
+			for _, c := range cc {
+				if _, ok := knownChannels[c]; !ok {
+					return fmt.Errorf("unknown notification channel %s", c)
+				}
+				if c == NotificationChannelWebhook && p.WebhookURL == "" {
+					return fmt.Errorf("webhook channel requires a webhook URL")
+				}
+			}
+		}
+		return nil
+	}
+	if er := check(p.Events); er != nil {
+		return er
+	}
+	for _, routes := range p.Folders {
+		if er := check(routes); er != nil {
+			return er
+		}
+	}
+	if p.TimeZone != "" {
+		if _, er := time.LoadLocation(p.TimeZone); er != nil {
+			return fmt.Errorf("invalid time zone %s", p.TimeZone)
+		}
+	}
+	if p.QuietHours != nil {
+		if _, er := parseClock(p.QuietHours.Start); er != nil {
+			return er
+		}
+		if _, er := parseClock(p.QuietHours.End); er != nil {
+			return er
+		}
+	}
+	if p.WebhookURL != "" {
+		u, er := url.Parse(p.WebhookURL)
+		if er != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+			return fmt.Errorf("invalid webhook URL %s", p.WebhookURL)
+		}
+	}
+	return nil
+}
+
+// Route returns the channels for a given event. Folders lists the UUIDs of the activity node and its
+// ancestors, from the closest to the root: the first folder override defining this event wins.
+func (p *NotificationPreferences) Route(ev NotificationEvent, folders ...string) []NotificationChannel {
+	for _, f := range folders {
+		if routes, ok := p.Folders[f]; ok {
+			if cc, ok := routes[ev]; ok {
+				return cc
+			}
+		}
+	}
+	if cc, ok := p.Events[ev]; ok {
+		return cc
+	}
+	return DefaultNotificationChannels
+}
+
+// Routes checks if the event is routed to the given channel.
+func (p *NotificationPreferences) Routes(ev NotificationEvent, channel NotificationChannel, folders ...string) bool {
+	for _, c := range p.Route(ev, folders...) {
+		if c == channel {
+			return true
+		}
+	}
+	return false
+}
+
+// HasChannel checks if the channel is used by any event or folder override.
+func (p *NotificationPreferences) HasChannel(channel NotificationChannel) bool {
+	for ev := range knownEvents {
+		if p.Routes(ev, channel) {
+			return true
+		}
+	}
+	for _, routes := range p.Folders {
+		for _, cc := range routes {
+			for _, c := range cc {
+				if c == channel {
+					return true
+				}
+			}
+		}
+	}
+	return false
+}
+
+// HasFolderOverrides tells whether routing depends on the activity location.
+func (p *NotificationPreferences) HasFolderOverrides() bool {
+	return len(p.Folders) > 0
+}
+
+// InQuietHours checks if t falls in the quiet hours, evaluated in the user time zone.
+func (p *NotificationPreferences) InQuietHours(t time.Time) bool {
+	if p.QuietHours == nil {
+		return false
+	}
+	start, er1 := parseClock(p.QuietHours.Start)
+	end, er2 := parseClock(p.QuietHours.End)
+	if er1 != nil || er2 != nil || start == end {
+		return false
+	}
+	if p.TimeZone != "" {
+		if loc, er := time.LoadLocation(p.TimeZone); er == nil {
+			t = t.In(loc)
+		}
+	}
+	now := t.Hour()*60 + t.Minute()
+	if start < end {
+		return now >= start && now < end
+	}
+	return now >= start || now < end
+}
+
+// NotificationEventFor maps an activity to its notification event type. It returns an empty string
+// for activities that are not subject to notifications.
+func NotificationEventFor(ac *Object) NotificationEvent {
+	switch ac.GetType() {
+	case ObjectType_Create:
+		return NotificationEventCreate
+	case ObjectType_Update, ObjectType_Move, ObjectType_UpdateMeta:
+		return NotificationEventChange
+	case ObjectType_Delete:
+		return NotificationEventDelete
+	case ObjectType_Read:
+		return NotificationEventRead
+	case ObjectType_Share:
+		return NotificationEventShare
+	case ObjectType_UpdateComment:
+		return NotificationEventComment
+	case ObjectType_Event:
+		if ac.GetName() == MentionActivityName {
+			return NotificationEventMention
+		}
+	}
+	return ""
+}
+
+// parseClock converts HH:MM to a number of minutes since midnight.
+func parseClock(s string) (int, error) {
+	parts := strings.Split(s, ":")
+	if len(parts) == 2 {
+		h, e1 := strconv.Atoi(parts[0])
+		m, e2 := strconv.Atoi(parts[1])
+		if e1 == nil && e2 == nil && h >= 0 && h < 24 && m >= 0 && m < 60 {
+			return h*60 + m, nil
+		}
+	}
+	return 0, fmt.Errorf("invalid quiet hours time %s, expected HH:MM", s)
+}
@@ -0,0 +1,91 @@
+/*
+ * Copyright (c) 2025 Abstrium SAS <team (at) pydio.com>
+ * This file is part of Pydio Cells.
+ *
+ * Pydio Cells is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * Pydio Cells is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with Pydio Cells.  If not, see <http://www.gnu.org/licenses/>.
+ *
+ * The latest code can be found at <https://pydio.com>.
+ */
+
+package activity
+
+import (
+	"testing"
+	"time"
+
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestNotificationPreferences(t *testing.T) {
+
+	Convey("Parse and validate preferences", t, func() {
+		p, er := ParseNotificationPreferences("")
+		So(er, ShouldBeNil)
+		So(p.Route(NotificationEventCreate), ShouldResemble, DefaultNotificationChannels)
+
+		_, er = ParseNotificationPreferences(`{"events":{"unknown":["inapp"]}}`)
+		So(er, ShouldNotBeNil)
+		_, er = ParseNotificationPreferences(`{"events":{"share":["pigeon"]}}`)
+		So(er, ShouldNotBeNil)
+		_, er = ParseNotificationPreferences(`{"events":{"share":["webhook"]}}`)
+		So(er, ShouldNotBeNil)
+		_, er = ParseNotificationPreferences(`{"events":{"share":["webhook"]},"webhookUrl":"ftp://host/hook"}`)
+		So(er, ShouldNotBeNil)
+		_, er = ParseNotificationPreferences(`{"timeZone":"Mars/Olympus"}`)
+		So(er, ShouldNotBeNil)
+		_, er = ParseNotificationPreferences(`{"quietHours":{"start":"25:00","end":"07:00"}}`)
+		So(er, ShouldNotBeNil)
+		_, er = ParseNotificationPreferences(`{"events":{"share":["webhook","daily"]},"webhookUrl":"https://example.com/hook","timeZone":"Europe/Paris","quietHours":{"start":"22:00","end":"07:30"}}`)
+		So(er, ShouldBeNil)
+	})
+
+	Convey("Route events with folder overrides", t, func() {
+		p, er := ParseNotificationPreferences(`{
+			"events":{"create":["daily"],"delete":[]},
+			"folders":{"parent":{"create":["inapp","email"]},"child":{"delete":["weekly"]}}
+		}`)
+		So(er, ShouldBeNil)
+		So(p.Route(NotificationEventCreate), ShouldResemble, []NotificationChannel{NotificationChannelDaily})
+		So(p.Route(NotificationEventDelete), ShouldBeEmpty)
+		So(p.Route(NotificationEventShare), ShouldResemble, DefaultNotificationChannels)
+		// Closest folder wins, and unset events fall back to parent overrides then to global routing
+		So(p.Routes(NotificationEventDelete, NotificationChannelWeekly, "node", "child", "parent"), ShouldBeTrue)
+		So(p.Routes(NotificationEventCreate, NotificationChannelEmail, "node", "child", "parent"), ShouldBeTrue)
+		So(p.Routes(NotificationEventCreate, NotificationChannelEmail, "other"), ShouldBeFalse)
+		So(p.HasChannel(NotificationChannelWeekly), ShouldBeTrue)
+		So(p.HasChannel(NotificationChannelWebhook), ShouldBeFalse)
+		So(p.HasFolderOverrides(), ShouldBeTrue)
+	})
+
+	Convey("Map activities to events", t, func() {
+		So(NotificationEventFor(&Object{Type: ObjectType_Create}), ShouldEqual, NotificationEventCreate)
+		So(NotificationEventFor(&Object{Type: ObjectType_Move}), ShouldEqual, NotificationEventChange)
+		So(NotificationEventFor(&Object{Type: ObjectType_Share}), ShouldEqual, NotificationEventShare)
+		So(NotificationEventFor(&Object{Type: ObjectType_UpdateComment}), ShouldEqual, NotificationEventComment)
+		So(NotificationEventFor(&Object{Type: ObjectType_Event, Name: MentionActivityName}), ShouldEqual, NotificationEventMention)
+		So(NotificationEventFor(&Object{Type: ObjectType_Event, Name: "Other"}), ShouldEqual, NotificationEvent(""))
+	})
+
+	Convey("Evaluate quiet hours in user time zone", t, func() {
+		p := &NotificationPreferences{TimeZone: "Asia/Tokyo", QuietHours: &QuietHours{Start: "22:00", End: "07:00"}}
+		// 14:00 UTC is 23:00 in Tokyo
+		So(p.InQuietHours(time.Date(2025, 3, 1, 14, 0, 0, 0, time.UTC)), ShouldBeTrue)
+		// 22:30 UTC is 07:30 in Tokyo
+		So(p.InQuietHours(time.Date(2025, 3, 1, 22, 30, 0, 0, time.UTC)), ShouldBeFalse)
+		p = &NotificationPreferences{QuietHours: &QuietHours{Start: "12:00", End: "14:00"}}
+		So(p.InQuietHours(time.Date(2025, 3, 1, 13, 0, 0, 0, time.UTC)), ShouldBeTrue)
+		So(p.InQuietHours(time.Date(2025, 3, 1, 14, 0, 0, 0, time.UTC)), ShouldBeFalse)
+		So((&NotificationPreferences{}).InQuietHours(time.Now()), ShouldBeFalse)
+	})
+}
@@ -88,6 +88,7 @@ const (
 	WsMessageType_HISTORY     WsMessageType = 4
 	WsMessageType_DELETE_MSG  WsMessageType = 5
 	WsMessageType_DELETE_ROOM WsMessageType = 6
+	WsMessageType_REACT       WsMessageType = 7
 )
 
 // Enum value maps for WsMessageType.
@@ -100,6 +101,7 @@ var (
 		4: "HISTORY",
 		5: "DELETE_MSG",
 		6: "DELETE_ROOM",
+		7: "REACT",
 	}
 	WsMessageType_value = map[string]int32{
 		"JOIN":        0,
@@ -109,6 +111,7 @@ var (
 		"HISTORY":     4,
 		"DELETE_MSG":  5,
 		"DELETE_ROOM": 6,
+		"REACT":       7,
 	}
 )
 
@@ -239,6 +242,16 @@ type ChatMessage struct {
 	// Additional information or metadata
 	Activity *activity.Object  `protobuf:"bytes,6,opt,name=Activity,proto3" json:"Activity,omitempty"`
 	Info     map[string]string `protobuf:"bytes,7,rep,name=Info,proto3" json:"Info,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// Uuid of the message this one is replying to (thread)
+	ParentUuid string `protobuf:"bytes,8,opt,name=ParentUuid,proto3" json:"ParentUuid,omitempty"`
+	// Logins of users mentioned in the message
+	Mentions []string `protobuf:"bytes,9,rep,name=Mentions,proto3" json:"Mentions,omitempty"`
+	// Emoji reactions
+	Reactions []*ChatReaction `protobuf:"bytes,10,rep,name=Reactions,proto3" json:"Reactions,omitempty"`
+	// Anchor comment to a specific version of the node
+	NodeVersionId string `protobuf:"bytes,11,opt,name=NodeVersionId,proto3" json:"NodeVersionId,omitempty"`
+	// Last edition timestamp
+	EditTimestamp int64 `protobuf:"varint,12,opt,name=EditTimestamp,proto3" json:"EditTimestamp,omitempty"`
 }
 
 func (x *ChatMessage) Reset() {
@@ -322,6 +335,96 @@ func (x *ChatMessage) GetInfo() map[string]string {
 	return nil
 }
 
+func (x *ChatMessage) GetParentUuid() string {
+	if x != nil {
+		return x.ParentUuid
+	}
+	return ""
+}
+
+func (x *ChatMessage) GetMentions() []string {
+	if x != nil {
+		return x.Mentions
+	}
+	return nil
+}
+
+func (x *ChatMessage) GetReactions() []*ChatReaction {
+	if x != nil {
+		return x.Reactions
+	}
+	return nil
+}
+
+func (x *ChatMessage) GetNodeVersionId() string {
+	if x != nil {
+		return x.NodeVersionId
+	}
+	return ""
+}
+
+func (x *ChatMessage) GetEditTimestamp() int64 {
+	if x != nil {
+		return x.EditTimestamp
+	}
+	return 0
+}
+
+type ChatReaction struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Emoji string   `protobuf:"bytes,1,opt,name=Emoji,proto3" json:"Emoji,omitempty"`
+	Users []string `protobuf:"bytes,2,rep,name=Users,proto3" json:"Users,omitempty"`
+}
+
+func (x *ChatReaction) Reset() {
+	*x = ChatReaction{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cells_chat_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ChatReaction) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChatReaction) ProtoMessage() {}
+
+func (x *ChatReaction) ProtoReflect() protoreflect.Message {
+	mi := &file_cells_chat_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChatReaction.ProtoReflect.Descriptor instead.
+func (*ChatReaction) Descriptor() ([]byte, []int) {
+	return file_cells_chat_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *ChatReaction) GetEmoji() string {
+	if x != nil {
+		return x.Emoji
+	}
+	return ""
+}
+
+func (x *ChatReaction) GetUsers() []string {
+	if x != nil {
+		return x.Users
+	}
+	return nil
+}
+
 type PutRoomRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -333,7 +436,7 @@ type PutRoomRequest struct {
 func (x *PutRoomRequest) Reset() {
 	*x = PutRoomRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_cells_chat_proto_msgTypes[2]
+		mi := &file_cells_chat_proto_msgTypes[3]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -346,7 +449,7 @@ func (x *PutRoomRequest) String() string {
 func (*PutRoomRequest) ProtoMessage() {}
 
 func (x *PutRoomRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_cells_chat_proto_msgTypes[2]
+	mi := &file_cells_chat_proto_msgTypes[3]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -359,7 +462,7 @@ func (x *PutRoomRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PutRoomRequest.ProtoReflect.Descriptor instead.
 func (*PutRoomRequest) Descriptor() ([]byte, []int) {
-	return file_cells_chat_proto_rawDescGZIP(), []int{2}
+	return file_cells_chat_proto_rawDescGZIP(), []int{3}
 }
 
 func (x *PutRoomRequest) GetRoom() *ChatRoom {
@@ -380,7 +483,7 @@ type PutRoomResponse struct {
 func (x *PutRoomResponse) Reset() {
 	*x = PutRoomResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_cells_chat_proto_msgTypes[3]
+		mi := &file_cells_chat_proto_msgTypes[4]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -393,7 +496,7 @@ func (x *PutRoomResponse) String() string {
 func (*PutRoomResponse) ProtoMessage() {}
 
 func (x *PutRoomResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_cells_chat_proto_msgTypes[3]
+	mi := &file_cells_chat_proto_msgTypes[4]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -406,7 +509,7 @@ func (x *PutRoomResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PutRoomResponse.ProtoReflect.Descriptor instead.
 func (*PutRoomResponse) Descriptor() ([]byte, []int) {
-	return file_cells_chat_proto_rawDescGZIP(), []int{3}
+	return file_cells_chat_proto_rawDescGZIP(), []int{4}
 }
 
 func (x *PutRoomResponse) GetRoom() *ChatRoom {
@@ -428,7 +531,7 @@ type PostMessageRequest struct {
 func (x *PostMessageRequest) Reset() {
 	*x = PostMessageRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_cells_chat_proto_msgTypes[4]
+		mi := &file_cells_chat_proto_msgTypes[5]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -441,7 +544,7 @@ func (x *PostMessageRequest) String() string {
 func (*PostMessageRequest) ProtoMessage() {}
 
 func (x *PostMessageRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_cells_chat_proto_msgTypes[4]
+	mi := &file_cells_chat_proto_msgTypes[5]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -454,7 +557,7 @@ func (x *PostMessageRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PostMessageRequest.ProtoReflect.Descriptor instead.
 func (*PostMessageRequest) Descriptor() ([]byte, []int) {
-	return file_cells_chat_proto_rawDescGZIP(), []int{4}
+	return file_cells_chat_proto_rawDescGZIP(), []int{5}
 }
 
 func (x *PostMessageRequest) GetMessages() []*ChatMessage {
@@ -483,7 +586,7 @@ type PostMessageResponse struct {
 func (x *PostMessageResponse) Reset() {
 	*x = PostMessageResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_cells_chat_proto_msgTypes[5]
+		mi := &file_cells_chat_proto_msgTypes[6]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -496,7 +599,7 @@ func (x *PostMessageResponse) String() string {
 func (*PostMessageResponse) ProtoMessage() {}
 
 func (x *PostMessageResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_cells_chat_proto_msgTypes[5]
+	mi := &file_cells_chat_proto_msgTypes[6]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -509,7 +612,7 @@ func (x *PostMessageResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PostMessageResponse.ProtoReflect.Descriptor instead.
 func (*PostMessageResponse) Descriptor() ([]byte, []int) {
-	return file_cells_chat_proto_rawDescGZIP(), []int{5}
+	return file_cells_chat_proto_rawDescGZIP(), []int{6}
 }
 
 func (x *PostMessageResponse) GetSuccess() bool {
@@ -538,7 +641,7 @@ type DeleteMessageRequest struct {
 func (x *DeleteMessageRequest) Reset() {
 	*x = DeleteMessageRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_cells_chat_proto_msgTypes[6]
+		mi := &file_cells_chat_proto_msgTypes[7]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -551,7 +654,7 @@ func (x *DeleteMessageRequest) String() string {
 func (*DeleteMessageRequest) ProtoMessage() {}
 
 func (x *DeleteMessageRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_cells_chat_proto_msgTypes[6]
+	mi := &file_cells_chat_proto_msgTypes[7]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -564,7 +667,7 @@ func (x *DeleteMessageRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteMessageRequest.ProtoReflect.Descriptor instead.
 func (*DeleteMessageRequest) Descriptor() ([]byte, []int) {
-	return file_cells_chat_proto_rawDescGZIP(), []int{6}
+	return file_cells_chat_proto_rawDescGZIP(), []int{7}
 }
 
 func (x *DeleteMessageRequest) GetMessages() []*ChatMessage {
@@ -592,7 +695,7 @@ type DeleteMessageResponse struct {
 func (x *DeleteMessageResponse) Reset() {
 	*x = DeleteMessageResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_cells_chat_proto_msgTypes[7]
+		mi := &file_cells_chat_proto_msgTypes[8]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -605,7 +708,7 @@ func (x *DeleteMessageResponse) String() string {
 func (*DeleteMessageResponse) ProtoMessage() {}
 
 func (x *DeleteMessageResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_cells_chat_proto_msgTypes[7]
+	mi := &file_cells_chat_proto_msgTypes[8]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -618,7 +721,7 @@ func (x *DeleteMessageResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteMessageResponse.ProtoReflect.Descriptor instead.
 func (*DeleteMessageResponse) Descriptor() ([]byte, []int) {
-	return file_cells_chat_proto_rawDescGZIP(), []int{7}
+	return file_cells_chat_proto_rawDescGZIP(), []int{8}
 }
 
 func (x *DeleteMessageResponse) GetSuccess() bool {
@@ -638,12 +741,18 @@ type ListMessagesRequest struct {
 	LastMessage string `protobuf:"bytes,2,opt,name=LastMessage,proto3" json:"LastMessage,omitempty"`
 	Offset      int64  `protobuf:"varint,3,opt,name=Offset,proto3" json:"Offset,omitempty"`
 	Limit       int64  `protobuf:"varint,4,opt,name=Limit,proto3" json:"Limit,omitempty"`
+	// Restrict to replies of a given message
+	ParentUuid string `protobuf:"bytes,5,opt,name=ParentUuid,proto3" json:"ParentUuid,omitempty"`
+	// Restrict to messages posted at or after this unix timestamp
+	Since int64 `protobuf:"varint,6,opt,name=Since,proto3" json:"Since,omitempty"`
+	// Restrict to messages posted at or before this unix timestamp
+	Until int64 `protobuf:"varint,7,opt,name=Until,proto3" json:"Until,omitempty"`
 }
 
 func (x *ListMessagesRequest) Reset() {
 	*x = ListMessagesRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_cells_chat_proto_msgTypes[8]
+		mi := &file_cells_chat_proto_msgTypes[9]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -656,7 +765,7 @@ func (x *ListMessagesRequest) String() string {
 func (*ListMessagesRequest) ProtoMessage() {}
 
 func (x *ListMessagesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_cells_chat_proto_msgTypes[8]
+	mi := &file_cells_chat_proto_msgTypes[9]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -669,7 +778,7 @@ func (x *ListMessagesRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListMessagesRequest.ProtoReflect.Descriptor instead.
 func (*ListMessagesRequest) Descriptor() ([]byte, []int) {
-	return file_cells_chat_proto_rawDescGZIP(), []int{8}
+	return file_cells_chat_proto_rawDescGZIP(), []int{9}
 }
 
 func (x *ListMessagesRequest) GetRoomUuid() string {
@@ -700,6 +809,27 @@ func (x *ListMessagesRequest) GetLimit() int64 {
 	return 0
 }
 
+func (x *ListMessagesRequest) GetParentUuid() string {
+	if x != nil {
+		return x.ParentUuid
+	}
+	return ""
+}
+
+func (x *ListMessagesRequest) GetSince() int64 {
+	if x != nil {
+		return x.Since
+	}
+	return 0
+}
+
+func (x *ListMessagesRequest) GetUntil() int64 {
+	if x != nil {
+		return x.Until
+	}
+	return 0
+}
+
 type ListMessagesResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -711,7 +841,7 @@ type ListMessagesResponse struct {
 func (x *ListMessagesResponse) Reset() {
 	*x = ListMessagesResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_cells_chat_proto_msgTypes[9]
+		mi := &file_cells_chat_proto_msgTypes[10]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -724,7 +854,7 @@ func (x *ListMessagesResponse) String() string {
 func (*ListMessagesResponse) ProtoMessage() {}
 
 func (x *ListMessagesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_cells_chat_proto_msgTypes[9]
+	mi := &file_cells_chat_proto_msgTypes[10]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -737,7 +867,7 @@ func (x *ListMessagesResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListMessagesResponse.ProtoReflect.Descriptor instead.
 func (*ListMessagesResponse) Descriptor() ([]byte, []int) {
-	return file_cells_chat_proto_rawDescGZIP(), []int{9}
+	return file_cells_chat_proto_rawDescGZIP(), []int{10}
 }
 
 func (x *ListMessagesResponse) GetMessage() *ChatMessage {
@@ -747,6 +877,132 @@ func (x *ListMessagesResponse) GetMessage() *ChatMessage {
 	return nil
 }
 
+type ReactMessageRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	RoomUuid    string `protobuf:"bytes,1,opt,name=RoomUuid,proto3" json:"RoomUuid,omitempty"`
+	MessageUuid string `protobuf:"bytes,2,opt,name=MessageUuid,proto3" json:"MessageUuid,omitempty"`
+	Emoji       string `protobuf:"bytes,3,opt,name=Emoji,proto3" json:"Emoji,omitempty"`
+	User        string `protobuf:"bytes,4,opt,name=User,proto3" json:"User,omitempty"`
+	Remove      bool   `protobuf:"varint,5,opt,name=Remove,proto3" json:"Remove,omitempty"`
+}
+
+func (x *ReactMessageRequest) Reset() {
+	*x = ReactMessageRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cells_chat_proto_msgTypes[11]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReactMessageRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReactMessageRequest) ProtoMessage() {}
+
+func (x *ReactMessageRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cells_chat_proto_msgTypes[11]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReactMessageRequest.ProtoReflect.Descriptor instead.
+func (*ReactMessageRequest) Descriptor() ([]byte, []int) {
+	return file_cells_chat_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *ReactMessageRequest) GetRoomUuid() string {
+	if x != nil {
+		return x.RoomUuid
+	}
+	return ""
+}
+
+func (x *ReactMessageRequest) GetMessageUuid() string {
+	if x != nil {
+		return x.MessageUuid
+	}
+	return ""
+}
+
+func (x *ReactMessageRequest) GetEmoji() string {
+	if x != nil {
+		return x.Emoji
+	}
+	return ""
+}
+
+func (x *ReactMessageRequest) GetUser() string {
+	if x != nil {
+		return x.User
+	}
+	return ""
+}
+
+func (x *ReactMessageRequest) GetRemove() bool {
+	if x != nil {
+		return x.Remove
+	}
+	return false
+}
+
+type ReactMessageResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Message *ChatMessage `protobuf:"bytes,1,opt,name=Message,proto3" json:"Message,omitempty"`
+}
+
+func (x *ReactMessageResponse) Reset() {
+	*x = ReactMessageResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cells_chat_proto_msgTypes[12]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReactMessageResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReactMessageResponse) ProtoMessage() {}
+
+func (x *ReactMessageResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cells_chat_proto_msgTypes[12]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReactMessageResponse.ProtoReflect.Descriptor instead.
+func (*ReactMessageResponse) Descriptor() ([]byte, []int) {
+	return file_cells_chat_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *ReactMessageResponse) GetMessage() *ChatMessage {
+	if x != nil {
+		return x.Message
+	}
+	return nil
+}
+
 type ListRoomsRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -754,12 +1010,14 @@ type ListRoomsRequest struct {
 
 	ByType     RoomType `protobuf:"varint,1,opt,name=ByType,proto3,enum=chat.RoomType" json:"ByType,omitempty"`
 	TypeObject string   `protobuf:"bytes,2,opt,name=TypeObject,proto3" json:"TypeObject,omitempty"`
+	// Load a single room by its Uuid
+	RoomUuid string `protobuf:"bytes,3,opt,name=RoomUuid,proto3" json:"RoomUuid,omitempty"`
 }
 
 func (x *ListRoomsRequest) Reset() {
 	*x = ListRoomsRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_cells_chat_proto_msgTypes[10]
+		mi := &file_cells_chat_proto_msgTypes[13]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -772,7 +1030,7 @@ func (x *ListRoomsRequest) String() string {
 func (*ListRoomsRequest) ProtoMessage() {}
 
 func (x *ListRoomsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_cells_chat_proto_msgTypes[10]
+	mi := &file_cells_chat_proto_msgTypes[13]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -785,7 +1043,7 @@ func (x *ListRoomsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListRoomsRequest.ProtoReflect.Descriptor instead.
 func (*ListRoomsRequest) Descriptor() ([]byte, []int) {
-	return file_cells_chat_proto_rawDescGZIP(), []int{10}
+	return file_cells_chat_proto_rawDescGZIP(), []int{13}
 }
 
 func (x *ListRoomsRequest) GetByType() RoomType {
@@ -802,6 +1060,13 @@ func (x *ListRoomsRequest) GetTypeObject() string {
 	return ""
 }
 
+func (x *ListRoomsRequest) GetRoomUuid() string {
+	if x != nil {
+		return x.RoomUuid
+	}
+	return ""
+}
+
 type ListRoomsResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -813,7 +1078,7 @@ type ListRoomsResponse struct {
 func (x *ListRoomsResponse) Reset() {
 	*x = ListRoomsResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_cells_chat_proto_msgTypes[11]
+		mi := &file_cells_chat_proto_msgTypes[14]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -826,7 +1091,7 @@ func (x *ListRoomsResponse) String() string {
 func (*ListRoomsResponse) ProtoMessage() {}
 
 func (x *ListRoomsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_cells_chat_proto_msgTypes[11]
+	mi := &file_cells_chat_proto_msgTypes[14]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -839,7 +1104,7 @@ func (x *ListRoomsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListRoomsResponse.ProtoReflect.Descriptor instead.
 func (*ListRoomsResponse) Descriptor() ([]byte, []int) {
-	return file_cells_chat_proto_rawDescGZIP(), []int{11}
+	return file_cells_chat_proto_rawDescGZIP(), []int{14}
 }
 
 func (x *ListRoomsResponse) GetRoom() *ChatRoom {
@@ -860,7 +1125,7 @@ type DeleteRoomRequest struct {
 func (x *DeleteRoomRequest) Reset() {
 	*x = DeleteRoomRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_cells_chat_proto_msgTypes[12]
+		mi := &file_cells_chat_proto_msgTypes[15]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -873,7 +1138,7 @@ func (x *DeleteRoomRequest) String() string {
 func (*DeleteRoomRequest) ProtoMessage() {}
 
 func (x *DeleteRoomRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_cells_chat_proto_msgTypes[12]
+	mi := &file_cells_chat_proto_msgTypes[15]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -886,7 +1151,7 @@ func (x *DeleteRoomRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteRoomRequest.ProtoReflect.Descriptor instead.
 func (*DeleteRoomRequest) Descriptor() ([]byte, []int) {
-	return file_cells_chat_proto_rawDescGZIP(), []int{12}
+	return file_cells_chat_proto_rawDescGZIP(), []int{15}
 }
 
 func (x *DeleteRoomRequest) GetRoom() *ChatRoom {
@@ -907,7 +1172,7 @@ type DeleteRoomResponse struct {
 func (x *DeleteRoomResponse) Reset() {
 	*x = DeleteRoomResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_cells_chat_proto_msgTypes[13]
+		mi := &file_cells_chat_proto_msgTypes[16]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -920,7 +1185,7 @@ func (x *DeleteRoomResponse) String() string {
 func (*DeleteRoomResponse) ProtoMessage() {}
 
 func (x *DeleteRoomResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_cells_chat_proto_msgTypes[13]
+	mi := &file_cells_chat_proto_msgTypes[16]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -933,7 +1198,7 @@ func (x *DeleteRoomResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteRoomResponse.ProtoReflect.Descriptor instead.
 func (*DeleteRoomResponse) Descriptor() ([]byte, []int) {
-	return file_cells_chat_proto_rawDescGZIP(), []int{13}
+	return file_cells_chat_proto_rawDescGZIP(), []int{16}
 }
 
 func (x *DeleteRoomResponse) GetSuccess() bool {
@@ -956,7 +1221,7 @@ type ChatEvent struct {
 func (x *ChatEvent) Reset() {
 	*x = ChatEvent{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_cells_chat_proto_msgTypes[14]
+		mi := &file_cells_chat_proto_msgTypes[17]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -969,7 +1234,7 @@ func (x *ChatEvent) String() string {
 func (*ChatEvent) ProtoMessage() {}
 
 func (x *ChatEvent) ProtoReflect() protoreflect.Message {
-	mi := &file_cells_chat_proto_msgTypes[14]
+	mi := &file_cells_chat_proto_msgTypes[17]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -982,7 +1247,7 @@ func (x *ChatEvent) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ChatEvent.ProtoReflect.Descriptor instead.
 func (*ChatEvent) Descriptor() ([]byte, []int) {
-	return file_cells_chat_proto_rawDescGZIP(), []int{14}
+	return file_cells_chat_proto_rawDescGZIP(), []int{17}
 }
 
 func (x *ChatEvent) GetMessage() *ChatMessage {
@@ -1011,15 +1276,17 @@ type WebSocketMessage struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Type    WsMessageType `protobuf:"varint,1,opt,name=Type,json=@type,proto3,enum=chat.WsMessageType" json:"Type,omitempty"`
-	Room    *ChatRoom     `protobuf:"bytes,2,opt,name=Room,proto3" json:"Room,omitempty"`
-	Message *ChatMessage  `protobuf:"bytes,3,opt,name=Message,proto3" json:"Message,omitempty"`
+	Type     WsMessageType `protobuf:"varint,1,opt,name=Type,json=@type,proto3,enum=chat.WsMessageType" json:"Type,omitempty"`
+	Room     *ChatRoom     `protobuf:"bytes,2,opt,name=Room,proto3" json:"Room,omitempty"`
+	Message  *ChatMessage  `protobuf:"bytes,3,opt,name=Message,proto3" json:"Message,omitempty"`
+	Reaction string        `protobuf:"bytes,4,opt,name=Reaction,proto3" json:"Reaction,omitempty"`
+	Remove   bool          `protobuf:"varint,5,opt,name=Remove,proto3" json:"Remove,omitempty"`
 }
 
 func (x *WebSocketMessage) Reset() {
 	*x = WebSocketMessage{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_cells_chat_proto_msgTypes[15]
+		mi := &file_cells_chat_proto_msgTypes[18]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1032,7 +1299,7 @@ func (x *WebSocketMessage) String() string {
 func (*WebSocketMessage) ProtoMessage() {}
 
 func (x *WebSocketMessage) ProtoReflect() protoreflect.Message {
-	mi := &file_cells_chat_proto_msgTypes[15]
+	mi := &file_cells_chat_proto_msgTypes[18]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1045,7 +1312,7 @@ func (x *WebSocketMessage) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use WebSocketMessage.ProtoReflect.Descriptor instead.
 func (*WebSocketMessage) Descriptor() ([]byte, []int) {
-	return file_cells_chat_proto_rawDescGZIP(), []int{15}
+	return file_cells_chat_proto_rawDescGZIP(), []int{18}
 }
 
 func (x *WebSocketMessage) GetType() WsMessageType {
@@ -1069,6 +1336,20 @@ func (x *WebSocketMessage) GetMessage() *ChatMessage {
 	return nil
 }
 
+func (x *WebSocketMessage) GetReaction() string {
+	if x != nil {
+		return x.Reaction
+	}
+	return ""
+}
+
+func (x *WebSocketMessage) GetRemove() bool {
+	if x != nil {
+		return x.Remove
+	}
+	return false
+}
+
 var File_cells_chat_proto protoreflect.FileDescriptor
 
 var file_cells_chat_proto_rawDesc = []byte{
@@ -1091,7 +1372,7 @@ var file_cells_chat_proto_rawDesc = []byte{
 	0x03, 0x28, 0x09, 0x52, 0x05, 0x55, 0x73, 0x65, 0x72, 0x73, 0x12, 0x20, 0x0a, 0x0b, 0x4c, 0x61,
 	0x73, 0x74, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x05, 0x52,
 	0x0b, 0x4c, 0x61, 0x73, 0x74, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x3a, 0x04, 0xa0, 0xfa,
-	0x2b, 0x01, 0x22, 0xab, 0x02, 0x0a, 0x0b, 0x43, 0x68, 0x61, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61,
+	0x2b, 0x01, 0x22, 0xe5, 0x03, 0x0a, 0x0b, 0x43, 0x68, 0x61, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61,
 	0x67, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x55, 0x75, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
 	0x52, 0x04, 0x55, 0x75, 0x69, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x52, 0x6f, 0x6f, 0x6d, 0x55, 0x75,
 	0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x52, 0x6f, 0x6f, 0x6d, 0x55, 0x75,
@@ -1106,112 +1387,152 @@ var file_cells_chat_proto_rawDesc = []byte{
 	0x12, 0x2f, 0x0a, 0x04, 0x49, 0x6e, 0x66, 0x6f, 0x18, 0x07, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1b,
 	0x2e, 0x63, 0x68, 0x61, 0x74, 0x2e, 0x43, 0x68, 0x61, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67,
 	0x65, 0x2e, 0x49, 0x6e, 0x66, 0x6f, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x04, 0x49, 0x6e, 0x66,
-	0x6f, 0x1a, 0x37, 0x0a, 0x09, 0x49, 0x6e, 0x66, 0x6f, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10,
-	0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79,
-	0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x3a, 0x04, 0xa0, 0xfa, 0x2b, 0x01,
-	0x22, 0x34, 0x0a, 0x0e, 0x50, 0x75, 0x74, 0x52, 0x6f, 0x6f, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x12, 0x22, 0x0a, 0x04, 0x52, 0x6f, 0x6f, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x0e, 0x2e, 0x63, 0x68, 0x61, 0x74, 0x2e, 0x43, 0x68, 0x61, 0x74, 0x52, 0x6f, 0x6f, 0x6d,
-	0x52, 0x04, 0x52, 0x6f, 0x6f, 0x6d, 0x22, 0x35, 0x0a, 0x0f, 0x50, 0x75, 0x74, 0x52, 0x6f, 0x6f,
-	0x6d, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x22, 0x0a, 0x04, 0x52, 0x6f, 0x6f,
-	0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x63, 0x68, 0x61, 0x74, 0x2e, 0x43,
-	0x68, 0x61, 0x74, 0x52, 0x6f, 0x6f, 0x6d, 0x52, 0x04, 0x52, 0x6f, 0x6f, 0x6d, 0x22, 0xdc, 0x01,
-	0x0a, 0x12, 0x50, 0x6f, 0x73, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x12, 0x2d, 0x0a, 0x08, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73,
-	0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x63, 0x68, 0x61, 0x74, 0x2e, 0x43, 0x68,
-	0x61, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x52, 0x08, 0x4d, 0x65, 0x73, 0x73, 0x61,
-	0x67, 0x65, 0x73, 0x12, 0x48, 0x0a, 0x0a, 0x4b, 0x6e, 0x6f, 0x77, 0x6e, 0x52, 0x6f, 0x6f, 0x6d,
-	0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x63, 0x68, 0x61, 0x74, 0x2e, 0x50,
-	0x6f, 0x73, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x2e, 0x4b, 0x6e, 0x6f, 0x77, 0x6e, 0x52, 0x6f, 0x6f, 0x6d, 0x73, 0x45, 0x6e, 0x74, 0x72,
-	0x79, 0x52, 0x0a, 0x4b, 0x6e, 0x6f, 0x77, 0x6e, 0x52, 0x6f, 0x6f, 0x6d, 0x73, 0x1a, 0x4d, 0x0a,
-	0x0f, 0x4b, 0x6e, 0x6f, 0x77, 0x6e, 0x52, 0x6f, 0x6f, 0x6d, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79,
-	0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b,
-	0x65, 0x79, 0x12, 0x24, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x0e, 0x2e, 0x63, 0x68, 0x61, 0x74, 0x2e, 0x43, 0x68, 0x61, 0x74, 0x52, 0x6f, 0x6f,
-	0x6d, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x5e, 0x0a, 0x13,
-	0x50, 0x6f, 0x73, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x53, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x53, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x2d, 0x0a,
-	0x08, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32,
-	0x11, 0x2e, 0x63, 0x68, 0x61, 0x74, 0x2e, 0x43, 0x68, 0x61, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61,
-	0x67, 0x65, 0x52, 0x08, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x22, 0xe0, 0x01, 0x0a,
-	0x14, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2d, 0x0a, 0x08, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
-	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x63, 0x68, 0x61, 0x74, 0x2e, 0x43,
-	0x68, 0x61, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x52, 0x08, 0x4d, 0x65, 0x73, 0x73,
-	0x61, 0x67, 0x65, 0x73, 0x12, 0x4a, 0x0a, 0x0a, 0x4b, 0x6e, 0x6f, 0x77, 0x6e, 0x52, 0x6f, 0x6f,
-	0x6d, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2a, 0x2e, 0x63, 0x68, 0x61, 0x74, 0x2e,
-	0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x2e, 0x4b, 0x6e, 0x6f, 0x77, 0x6e, 0x52, 0x6f, 0x6f, 0x6d, 0x73, 0x45,
-	0x6e, 0x74, 0x72, 0x79, 0x52, 0x0a, 0x4b, 0x6e, 0x6f, 0x77, 0x6e, 0x52, 0x6f, 0x6f, 0x6d, 0x73,
-	0x1a, 0x4d, 0x0a, 0x0f, 0x4b, 0x6e, 0x6f, 0x77, 0x6e, 0x52, 0x6f, 0x6f, 0x6d, 0x73, 0x45, 0x6e,
-	0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x24, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x63, 0x68, 0x61, 0x74, 0x2e, 0x43, 0x68, 0x61, 0x74,
-	0x52, 0x6f, 0x6f, 0x6d, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22,
-	0x31, 0x0a, 0x15, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x53, 0x75, 0x63, 0x63,
-	0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x53, 0x75, 0x63, 0x63, 0x65,
-	0x73, 0x73, 0x22, 0x81, 0x01, 0x0a, 0x13, 0x4c, 0x69, 0x73, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61,
-	0x67, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x52, 0x6f,
-	0x6f, 0x6d, 0x55, 0x75, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x52, 0x6f,
-	0x6f, 0x6d, 0x55, 0x75, 0x69, 0x64, 0x12, 0x20, 0x0a, 0x0b, 0x4c, 0x61, 0x73, 0x74, 0x4d, 0x65,
-	0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x4c, 0x61, 0x73,
-	0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x4f, 0x66, 0x66, 0x73,
-	0x65, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x4f, 0x66, 0x66, 0x73, 0x65, 0x74,
-	0x12, 0x14, 0x0a, 0x05, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52,
-	0x05, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x22, 0x43, 0x0a, 0x14, 0x4c, 0x69, 0x73, 0x74, 0x4d, 0x65,
-	0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2b,
-	0x0a, 0x07, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x11, 0x2e, 0x63, 0x68, 0x61, 0x74, 0x2e, 0x43, 0x68, 0x61, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61,
-	0x67, 0x65, 0x52, 0x07, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x5a, 0x0a, 0x10, 0x4c,
-	0x69, 0x73, 0x74, 0x52, 0x6f, 0x6f, 0x6d, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
-	0x26, 0x0a, 0x06, 0x42, 0x79, 0x54, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32,
-	0x0e, 0x2e, 0x63, 0x68, 0x61, 0x74, 0x2e, 0x52, 0x6f, 0x6f, 0x6d, 0x54, 0x79, 0x70, 0x65, 0x52,
-	0x06, 0x42, 0x79, 0x54, 0x79, 0x70, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x54, 0x79, 0x70, 0x65, 0x4f,
-	0x62, 0x6a, 0x65, 0x63, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x54, 0x79, 0x70,
-	0x65, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x22, 0x37, 0x0a, 0x11, 0x4c, 0x69, 0x73, 0x74, 0x52,
-	0x6f, 0x6f, 0x6d, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x22, 0x0a, 0x04,
-	0x52, 0x6f, 0x6f, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x63, 0x68, 0x61,
-	0x74, 0x2e, 0x43, 0x68, 0x61, 0x74, 0x52, 0x6f, 0x6f, 0x6d, 0x52, 0x04, 0x52, 0x6f, 0x6f, 0x6d,
-	0x22, 0x37, 0x0a, 0x11, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x6f, 0x6f, 0x6d, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x22, 0x0a, 0x04, 0x52, 0x6f, 0x6f, 0x6d, 0x18, 0x01, 0x20,
+	0x6f, 0x12, 0x1e, 0x0a, 0x0a, 0x50, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x55, 0x75, 0x69, 0x64, 0x18,
+	0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x50, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x55, 0x75, 0x69,
+	0x64, 0x12, 0x1a, 0x0a, 0x08, 0x4d, 0x65, 0x6e, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x09, 0x20,
+	0x03, 0x28, 0x09, 0x52, 0x08, 0x4d, 0x65, 0x6e, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x30, 0x0a,
+	0x09, 0x52, 0x65, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x0a, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x12, 0x2e, 0x63, 0x68, 0x61, 0x74, 0x2e, 0x43, 0x68, 0x61, 0x74, 0x52, 0x65, 0x61, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x09, 0x52, 0x65, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12,
+	0x24, 0x0a, 0x0d, 0x4e, 0x6f, 0x64, 0x65, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x64,
+	0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x4e, 0x6f, 0x64, 0x65, 0x56, 0x65, 0x72, 0x73,
+	0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x24, 0x0a, 0x0d, 0x45, 0x64, 0x69, 0x74, 0x54, 0x69, 0x6d,
+	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0d, 0x45, 0x64,
+	0x69, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x1a, 0x37, 0x0a, 0x09, 0x49,
+	0x6e, 0x66, 0x6f, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61,
+	0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
+	0x3a, 0x02, 0x38, 0x01, 0x3a, 0x04, 0xa0, 0xfa, 0x2b, 0x01, 0x22, 0x40, 0x0a, 0x0c, 0x43, 0x68,
+	0x61, 0x74, 0x52, 0x65, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x14, 0x0a, 0x05, 0x45, 0x6d,
+	0x6f, 0x6a, 0x69, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x45, 0x6d, 0x6f, 0x6a, 0x69,
+	0x12, 0x14, 0x0a, 0x05, 0x55, 0x73, 0x65, 0x72, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52,
+	0x05, 0x55, 0x73, 0x65, 0x72, 0x73, 0x3a, 0x04, 0xa0, 0xfa, 0x2b, 0x01, 0x22, 0x34, 0x0a, 0x0e,
+	0x50, 0x75, 0x74, 0x52, 0x6f, 0x6f, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x22,
+	0x0a, 0x04, 0x52, 0x6f, 0x6f, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x63,
+	0x68, 0x61, 0x74, 0x2e, 0x43, 0x68, 0x61, 0x74, 0x52, 0x6f, 0x6f, 0x6d, 0x52, 0x04, 0x52, 0x6f,
+	0x6f, 0x6d, 0x22, 0x35, 0x0a, 0x0f, 0x50, 0x75, 0x74, 0x52, 0x6f, 0x6f, 0x6d, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x22, 0x0a, 0x04, 0x52, 0x6f, 0x6f, 0x6d, 0x18, 0x01, 0x20,
 	0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x63, 0x68, 0x61, 0x74, 0x2e, 0x43, 0x68, 0x61, 0x74, 0x52,
-	0x6f, 0x6f, 0x6d, 0x52, 0x04, 0x52, 0x6f, 0x6f, 0x6d, 0x22, 0x2e, 0x0a, 0x12, 0x44, 0x65, 0x6c,
-	0x65, 0x74, 0x65, 0x52, 0x6f, 0x6f, 0x6d, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x6f, 0x6f, 0x6d, 0x52, 0x04, 0x52, 0x6f, 0x6f, 0x6d, 0x22, 0xdc, 0x01, 0x0a, 0x12, 0x50, 0x6f,
+	0x73, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x2d, 0x0a, 0x08, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x11, 0x2e, 0x63, 0x68, 0x61, 0x74, 0x2e, 0x43, 0x68, 0x61, 0x74, 0x4d, 0x65,
+	0x73, 0x73, 0x61, 0x67, 0x65, 0x52, 0x08, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x12,
+	0x48, 0x0a, 0x0a, 0x4b, 0x6e, 0x6f, 0x77, 0x6e, 0x52, 0x6f, 0x6f, 0x6d, 0x73, 0x18, 0x02, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x63, 0x68, 0x61, 0x74, 0x2e, 0x50, 0x6f, 0x73, 0x74, 0x4d,
+	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x4b, 0x6e,
+	0x6f, 0x77, 0x6e, 0x52, 0x6f, 0x6f, 0x6d, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0a, 0x4b,
+	0x6e, 0x6f, 0x77, 0x6e, 0x52, 0x6f, 0x6f, 0x6d, 0x73, 0x1a, 0x4d, 0x0a, 0x0f, 0x4b, 0x6e, 0x6f,
+	0x77, 0x6e, 0x52, 0x6f, 0x6f, 0x6d, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03,
+	0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x24,
+	0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e,
+	0x63, 0x68, 0x61, 0x74, 0x2e, 0x43, 0x68, 0x61, 0x74, 0x52, 0x6f, 0x6f, 0x6d, 0x52, 0x05, 0x76,
+	0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x5e, 0x0a, 0x13, 0x50, 0x6f, 0x73, 0x74,
+	0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
 	0x18, 0x0a, 0x07, 0x53, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08,
-	0x52, 0x07, 0x53, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x22, 0x7c, 0x0a, 0x09, 0x43, 0x68, 0x61,
-	0x74, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x2b, 0x0a, 0x07, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67,
+	0x52, 0x07, 0x53, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x2d, 0x0a, 0x08, 0x4d, 0x65, 0x73,
+	0x73, 0x61, 0x67, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x63, 0x68,
+	0x61, 0x74, 0x2e, 0x43, 0x68, 0x61, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x52, 0x08,
+	0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x22, 0xe0, 0x01, 0x0a, 0x14, 0x44, 0x65, 0x6c,
+	0x65, 0x74, 0x65, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x2d, 0x0a, 0x08, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x18, 0x01, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x63, 0x68, 0x61, 0x74, 0x2e, 0x43, 0x68, 0x61, 0x74, 0x4d,
+	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x52, 0x08, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73,
+	0x12, 0x4a, 0x0a, 0x0a, 0x4b, 0x6e, 0x6f, 0x77, 0x6e, 0x52, 0x6f, 0x6f, 0x6d, 0x73, 0x18, 0x02,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x2a, 0x2e, 0x63, 0x68, 0x61, 0x74, 0x2e, 0x44, 0x65, 0x6c, 0x65,
+	0x74, 0x65, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x2e, 0x4b, 0x6e, 0x6f, 0x77, 0x6e, 0x52, 0x6f, 0x6f, 0x6d, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79,
+	0x52, 0x0a, 0x4b, 0x6e, 0x6f, 0x77, 0x6e, 0x52, 0x6f, 0x6f, 0x6d, 0x73, 0x1a, 0x4d, 0x0a, 0x0f,
+	0x4b, 0x6e, 0x6f, 0x77, 0x6e, 0x52, 0x6f, 0x6f, 0x6d, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12,
+	0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65,
+	0x79, 0x12, 0x24, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x0e, 0x2e, 0x63, 0x68, 0x61, 0x74, 0x2e, 0x43, 0x68, 0x61, 0x74, 0x52, 0x6f, 0x6f, 0x6d,
+	0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x31, 0x0a, 0x15, 0x44,
+	0x65, 0x6c, 0x65, 0x74, 0x65, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x53, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x53, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x22, 0xcd,
+	0x01, 0x0a, 0x13, 0x4c, 0x69, 0x73, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x52, 0x6f, 0x6f, 0x6d, 0x55, 0x75,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x52, 0x6f, 0x6f, 0x6d, 0x55, 0x75,
+	0x69, 0x64, 0x12, 0x20, 0x0a, 0x0b, 0x4c, 0x61, 0x73, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x4c, 0x61, 0x73, 0x74, 0x4d, 0x65, 0x73,
+	0x73, 0x61, 0x67, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x4f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x4f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x12, 0x14, 0x0a, 0x05,
+	0x4c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x4c, 0x69, 0x6d,
+	0x69, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x50, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x55, 0x75, 0x69, 0x64,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x50, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x55, 0x75,
+	0x69, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x53, 0x69, 0x6e, 0x63, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x05, 0x53, 0x69, 0x6e, 0x63, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x55, 0x6e, 0x74, 0x69,
+	0x6c, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x55, 0x6e, 0x74, 0x69, 0x6c, 0x22, 0x43,
+	0x0a, 0x14, 0x4c, 0x69, 0x73, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2b, 0x0a, 0x07, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67,
 	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x63, 0x68, 0x61, 0x74, 0x2e, 0x43,
 	0x68, 0x61, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x52, 0x07, 0x4d, 0x65, 0x73, 0x73,
-	0x61, 0x67, 0x65, 0x12, 0x22, 0x0a, 0x04, 0x52, 0x6f, 0x6f, 0x6d, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x0e, 0x2e, 0x63, 0x68, 0x61, 0x74, 0x2e, 0x43, 0x68, 0x61, 0x74, 0x52, 0x6f, 0x6f,
-	0x6d, 0x52, 0x04, 0x52, 0x6f, 0x6f, 0x6d, 0x12, 0x18, 0x0a, 0x07, 0x44, 0x65, 0x74, 0x61, 0x69,
-	0x6c, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c,
-	0x73, 0x3a, 0x04, 0xa0, 0xfa, 0x2b, 0x01, 0x22, 0x93, 0x01, 0x0a, 0x10, 0x57, 0x65, 0x62, 0x53,
-	0x6f, 0x63, 0x6b, 0x65, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x28, 0x0a, 0x04,
-	0x54, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x13, 0x2e, 0x63, 0x68, 0x61,
-	0x74, 0x2e, 0x57, 0x73, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x54, 0x79, 0x70, 0x65, 0x52,
-	0x05, 0x40, 0x74, 0x79, 0x70, 0x65, 0x12, 0x22, 0x0a, 0x04, 0x52, 0x6f, 0x6f, 0x6d, 0x18, 0x02,
+	0x61, 0x67, 0x65, 0x22, 0x95, 0x01, 0x0a, 0x13, 0x52, 0x65, 0x61, 0x63, 0x74, 0x4d, 0x65, 0x73,
+	0x73, 0x61, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x52,
+	0x6f, 0x6f, 0x6d, 0x55, 0x75, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x52,
+	0x6f, 0x6f, 0x6d, 0x55, 0x75, 0x69, 0x64, 0x12, 0x20, 0x0a, 0x0b, 0x4d, 0x65, 0x73, 0x73, 0x61,
+	0x67, 0x65, 0x55, 0x75, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x4d, 0x65,
+	0x73, 0x73, 0x61, 0x67, 0x65, 0x55, 0x75, 0x69, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x45, 0x6d, 0x6f,
+	0x6a, 0x69, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x45, 0x6d, 0x6f, 0x6a, 0x69, 0x12,
+	0x12, 0x0a, 0x04, 0x55, 0x73, 0x65, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x55,
+	0x73, 0x65, 0x72, 0x12, 0x16, 0x0a, 0x06, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x06, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x22, 0x43, 0x0a, 0x14, 0x52,
+	0x65, 0x61, 0x63, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x2b, 0x0a, 0x07, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x63, 0x68, 0x61, 0x74, 0x2e, 0x43, 0x68, 0x61, 0x74,
+	0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x52, 0x07, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
+	0x22, 0x76, 0x0a, 0x10, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x6f, 0x6f, 0x6d, 0x73, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x26, 0x0a, 0x06, 0x42, 0x79, 0x54, 0x79, 0x70, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0e, 0x32, 0x0e, 0x2e, 0x63, 0x68, 0x61, 0x74, 0x2e, 0x52, 0x6f, 0x6f, 0x6d,
+	0x54, 0x79, 0x70, 0x65, 0x52, 0x06, 0x42, 0x79, 0x54, 0x79, 0x70, 0x65, 0x12, 0x1e, 0x0a, 0x0a,
+	0x54, 0x79, 0x70, 0x65, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0a, 0x54, 0x79, 0x70, 0x65, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x12, 0x1a, 0x0a, 0x08,
+	0x52, 0x6f, 0x6f, 0x6d, 0x55, 0x75, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08,
+	0x52, 0x6f, 0x6f, 0x6d, 0x55, 0x75, 0x69, 0x64, 0x22, 0x37, 0x0a, 0x11, 0x4c, 0x69, 0x73, 0x74,
+	0x52, 0x6f, 0x6f, 0x6d, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x22, 0x0a,
+	0x04, 0x52, 0x6f, 0x6f, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x63, 0x68,
+	0x61, 0x74, 0x2e, 0x43, 0x68, 0x61, 0x74, 0x52, 0x6f, 0x6f, 0x6d, 0x52, 0x04, 0x52, 0x6f, 0x6f,
+	0x6d, 0x22, 0x37, 0x0a, 0x11, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x6f, 0x6f, 0x6d, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x22, 0x0a, 0x04, 0x52, 0x6f, 0x6f, 0x6d, 0x18, 0x01,
 	0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x63, 0x68, 0x61, 0x74, 0x2e, 0x43, 0x68, 0x61, 0x74,
-	0x52, 0x6f, 0x6f, 0x6d, 0x52, 0x04, 0x52, 0x6f, 0x6f, 0x6d, 0x12, 0x2b, 0x0a, 0x07, 0x4d, 0x65,
-	0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x63, 0x68,
-	0x61, 0x74, 0x2e, 0x43, 0x68, 0x61, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x52, 0x07,
-	0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x3a, 0x04, 0xa0, 0xfa, 0x2b, 0x01, 0x2a, 0x42, 0x0a,
-	0x08, 0x52, 0x6f, 0x6f, 0x6d, 0x54, 0x79, 0x70, 0x65, 0x12, 0x0a, 0x0a, 0x06, 0x47, 0x4c, 0x4f,
-	0x42, 0x41, 0x4c, 0x10, 0x00, 0x12, 0x0d, 0x0a, 0x09, 0x57, 0x4f, 0x52, 0x4b, 0x53, 0x50, 0x41,
-	0x43, 0x45, 0x10, 0x01, 0x12, 0x08, 0x0a, 0x04, 0x55, 0x53, 0x45, 0x52, 0x10, 0x02, 0x12, 0x08,
-	0x0a, 0x04, 0x4e, 0x4f, 0x44, 0x45, 0x10, 0x03, 0x12, 0x07, 0x0a, 0x03, 0x41, 0x4e, 0x59, 0x10,
-	0x04, 0x2a, 0x6d, 0x0a, 0x0d, 0x57, 0x73, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x54, 0x79,
-	0x70, 0x65, 0x12, 0x08, 0x0a, 0x04, 0x4a, 0x4f, 0x49, 0x4e, 0x10, 0x00, 0x12, 0x09, 0x0a, 0x05,
-	0x4c, 0x45, 0x41, 0x56, 0x45, 0x10, 0x01, 0x12, 0x08, 0x0a, 0x04, 0x50, 0x4f, 0x53, 0x54, 0x10,
-	0x02, 0x12, 0x0f, 0x0a, 0x0b, 0x52, 0x4f, 0x4f, 0x4d, 0x5f, 0x55, 0x50, 0x44, 0x41, 0x54, 0x45,
-	0x10, 0x03, 0x12, 0x0b, 0x0a, 0x07, 0x48, 0x49, 0x53, 0x54, 0x4f, 0x52, 0x59, 0x10, 0x04, 0x12,
-	0x0e, 0x0a, 0x0a, 0x44, 0x45, 0x4c, 0x45, 0x54, 0x45, 0x5f, 0x4d, 0x53, 0x47, 0x10, 0x05, 0x12,
-	0x0f, 0x0a, 0x0b, 0x44, 0x45, 0x4c, 0x45, 0x54, 0x45, 0x5f, 0x52, 0x4f, 0x4f, 0x4d, 0x10, 0x06,
-	0x32, 0x9d, 0x03, 0x0a, 0x0b, 0x43, 0x68, 0x61, 0x74, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
+	0x52, 0x6f, 0x6f, 0x6d, 0x52, 0x04, 0x52, 0x6f, 0x6f, 0x6d, 0x22, 0x2e, 0x0a, 0x12, 0x44, 0x65,
+	0x6c, 0x65, 0x74, 0x65, 0x52, 0x6f, 0x6f, 0x6d, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x18, 0x0a, 0x07, 0x53, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x07, 0x53, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x22, 0x7c, 0x0a, 0x09, 0x43, 0x68,
+	0x61, 0x74, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x2b, 0x0a, 0x07, 0x4d, 0x65, 0x73, 0x73, 0x61,
+	0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x63, 0x68, 0x61, 0x74, 0x2e,
+	0x43, 0x68, 0x61, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x52, 0x07, 0x4d, 0x65, 0x73,
+	0x73, 0x61, 0x67, 0x65, 0x12, 0x22, 0x0a, 0x04, 0x52, 0x6f, 0x6f, 0x6d, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x63, 0x68, 0x61, 0x74, 0x2e, 0x43, 0x68, 0x61, 0x74, 0x52, 0x6f,
+	0x6f, 0x6d, 0x52, 0x04, 0x52, 0x6f, 0x6f, 0x6d, 0x12, 0x18, 0x0a, 0x07, 0x44, 0x65, 0x74, 0x61,
+	0x69, 0x6c, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x44, 0x65, 0x74, 0x61, 0x69,
+	0x6c, 0x73, 0x3a, 0x04, 0xa0, 0xfa, 0x2b, 0x01, 0x22, 0xc7, 0x01, 0x0a, 0x10, 0x57, 0x65, 0x62,
+	0x53, 0x6f, 0x63, 0x6b, 0x65, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x28, 0x0a,
+	0x04, 0x54, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x13, 0x2e, 0x63, 0x68,
+	0x61, 0x74, 0x2e, 0x57, 0x73, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x54, 0x79, 0x70, 0x65,
+	0x52, 0x05, 0x40, 0x74, 0x79, 0x70, 0x65, 0x12, 0x22, 0x0a, 0x04, 0x52, 0x6f, 0x6f, 0x6d, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x63, 0x68, 0x61, 0x74, 0x2e, 0x43, 0x68, 0x61,
+	0x74, 0x52, 0x6f, 0x6f, 0x6d, 0x52, 0x04, 0x52, 0x6f, 0x6f, 0x6d, 0x12, 0x2b, 0x0a, 0x07, 0x4d,
+	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x63,
+	0x68, 0x61, 0x74, 0x2e, 0x43, 0x68, 0x61, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x52,
+	0x07, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x52, 0x65, 0x61, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x52, 0x65, 0x61, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x3a, 0x04, 0xa0, 0xfa,
+	0x2b, 0x01, 0x2a, 0x42, 0x0a, 0x08, 0x52, 0x6f, 0x6f, 0x6d, 0x54, 0x79, 0x70, 0x65, 0x12, 0x0a,
+	0x0a, 0x06, 0x47, 0x4c, 0x4f, 0x42, 0x41, 0x4c, 0x10, 0x00, 0x12, 0x0d, 0x0a, 0x09, 0x57, 0x4f,
+	0x52, 0x4b, 0x53, 0x50, 0x41, 0x43, 0x45, 0x10, 0x01, 0x12, 0x08, 0x0a, 0x04, 0x55, 0x53, 0x45,
+	0x52, 0x10, 0x02, 0x12, 0x08, 0x0a, 0x04, 0x4e, 0x4f, 0x44, 0x45, 0x10, 0x03, 0x12, 0x07, 0x0a,
+	0x03, 0x41, 0x4e, 0x59, 0x10, 0x04, 0x2a, 0x78, 0x0a, 0x0d, 0x57, 0x73, 0x4d, 0x65, 0x73, 0x73,
+	0x61, 0x67, 0x65, 0x54, 0x79, 0x70, 0x65, 0x12, 0x08, 0x0a, 0x04, 0x4a, 0x4f, 0x49, 0x4e, 0x10,
+	0x00, 0x12, 0x09, 0x0a, 0x05, 0x4c, 0x45, 0x41, 0x56, 0x45, 0x10, 0x01, 0x12, 0x08, 0x0a, 0x04,
+	0x50, 0x4f, 0x53, 0x54, 0x10, 0x02, 0x12, 0x0f, 0x0a, 0x0b, 0x52, 0x4f, 0x4f, 0x4d, 0x5f, 0x55,
+	0x50, 0x44, 0x41, 0x54, 0x45, 0x10, 0x03, 0x12, 0x0b, 0x0a, 0x07, 0x48, 0x49, 0x53, 0x54, 0x4f,
+	0x52, 0x59, 0x10, 0x04, 0x12, 0x0e, 0x0a, 0x0a, 0x44, 0x45, 0x4c, 0x45, 0x54, 0x45, 0x5f, 0x4d,
+	0x53, 0x47, 0x10, 0x05, 0x12, 0x0f, 0x0a, 0x0b, 0x44, 0x45, 0x4c, 0x45, 0x54, 0x45, 0x5f, 0x52,
+	0x4f, 0x4f, 0x4d, 0x10, 0x06, 0x12, 0x09, 0x0a, 0x05, 0x52, 0x45, 0x41, 0x43, 0x54, 0x10, 0x07,
+	0x32, 0xe4, 0x03, 0x0a, 0x0b, 0x43, 0x68, 0x61, 0x74, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
 	0x12, 0x36, 0x0a, 0x07, 0x50, 0x75, 0x74, 0x52, 0x6f, 0x6f, 0x6d, 0x12, 0x14, 0x2e, 0x63, 0x68,
 	0x61, 0x74, 0x2e, 0x50, 0x75, 0x74, 0x52, 0x6f, 0x6f, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
 	0x74, 0x1a, 0x15, 0x2e, 0x63, 0x68, 0x61, 0x74, 0x2e, 0x50, 0x75, 0x74, 0x52, 0x6f, 0x6f, 0x6d,
@@ -1237,10 +1558,14 @@ var file_cells_chat_proto_rawDesc = []byte{
 	0x65, 0x6c, 0x65, 0x74, 0x65, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75,
 	0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x63, 0x68, 0x61, 0x74, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74,
 	0x65, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x42, 0x2d, 0x5a, 0x2b, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x70,
-	0x79, 0x64, 0x69, 0x6f, 0x2f, 0x63, 0x65, 0x6c, 0x6c, 0x73, 0x2f, 0x76, 0x35, 0x2f, 0x63, 0x6f,
-	0x6d, 0x6d, 0x6f, 0x6e, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x63, 0x68, 0x61, 0x74, 0x62,
-	0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x12, 0x45, 0x0a, 0x0c, 0x52, 0x65, 0x61, 0x63, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
+	0x12, 0x19, 0x2e, 0x63, 0x68, 0x61, 0x74, 0x2e, 0x52, 0x65, 0x61, 0x63, 0x74, 0x4d, 0x65, 0x73,
+	0x73, 0x61, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x63, 0x68,
+	0x61, 0x74, 0x2e, 0x52, 0x65, 0x61, 0x63, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x42, 0x2d, 0x5a, 0x2b, 0x67, 0x69, 0x74, 0x68, 0x75,
+	0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x70, 0x79, 0x64, 0x69, 0x6f, 0x2f, 0x63, 0x65, 0x6c, 0x6c,
+	0x73, 0x2f, 0x76, 0x35, 0x2f, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2f, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x2f, 0x63, 0x68, 0x61, 0x74, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -1256,70 +1581,77 @@ func file_cells_chat_proto_rawDescGZIP() []byte {
 }
 
 var file_cells_chat_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
-var file_cells_chat_proto_msgTypes = make([]protoimpl.MessageInfo, 19)
+var file_cells_chat_proto_msgTypes = make([]protoimpl.MessageInfo, 22)
 var file_cells_chat_proto_goTypes = []any{
 	(RoomType)(0),                 // 0: chat.RoomType
 	(WsMessageType)(0),            // 1: chat.WsMessageType
 	(*ChatRoom)(nil),              // 2: chat.ChatRoom
 	(*ChatMessage)(nil),           // 3: chat.ChatMessage
-	(*PutRoomRequest)(nil),        // 4: chat.PutRoomRequest
-	(*PutRoomResponse)(nil),       // 5: chat.PutRoomResponse
-	(*PostMessageRequest)(nil),    // 6: chat.PostMessageRequest
-	(*PostMessageResponse)(nil),   // 7: chat.PostMessageResponse
-	(*DeleteMessageRequest)(nil),  // 8: chat.DeleteMessageRequest
-	(*DeleteMessageResponse)(nil), // 9: chat.DeleteMessageResponse
-	(*ListMessagesRequest)(nil),   // 10: chat.ListMessagesRequest
-	(*ListMessagesResponse)(nil),  // 11: chat.ListMessagesResponse
-	(*ListRoomsRequest)(nil),      // 12: chat.ListRoomsRequest
-	(*ListRoomsResponse)(nil),     // 13: chat.ListRoomsResponse
-	(*DeleteRoomRequest)(nil),     // 14: chat.DeleteRoomRequest
-	(*DeleteRoomResponse)(nil),    // 15: chat.DeleteRoomResponse
-	(*ChatEvent)(nil),             // 16: chat.ChatEvent
-	(*WebSocketMessage)(nil),      // 17: chat.WebSocketMessage
-	nil,                           // 18: chat.ChatMessage.InfoEntry
-	nil,                           // 19: chat.PostMessageRequest.KnownRoomsEntry
-	nil,                           // 20: chat.DeleteMessageRequest.KnownRoomsEntry
-	(*activity.Object)(nil),       // 21: activity.Object
+	(*ChatReaction)(nil),          // 4: chat.ChatReaction
+	(*PutRoomRequest)(nil),        // 5: chat.PutRoomRequest
+	(*PutRoomResponse)(nil),       // 6: chat.PutRoomResponse
+	(*PostMessageRequest)(nil),    // 7: chat.PostMessageRequest
+	(*PostMessageResponse)(nil),   // 8: chat.PostMessageResponse
+	(*DeleteMessageRequest)(nil),  // 9: chat.DeleteMessageRequest
+	(*DeleteMessageResponse)(nil), // 10: chat.DeleteMessageResponse
+	(*ListMessagesRequest)(nil),   // 11: chat.ListMessagesRequest
+	(*ListMessagesResponse)(nil),  // 12: chat.ListMessagesResponse
+	(*ReactMessageRequest)(nil),   // 13: chat.ReactMessageRequest
+	(*ReactMessageResponse)(nil),  // 14: chat.ReactMessageResponse
+	(*ListRoomsRequest)(nil),      // 15: chat.ListRoomsRequest
+	(*ListRoomsResponse)(nil),     // 16: chat.ListRoomsResponse
+	(*DeleteRoomRequest)(nil),     // 17: chat.DeleteRoomRequest
+	(*DeleteRoomResponse)(nil),    // 18: chat.DeleteRoomResponse
+	(*ChatEvent)(nil),             // 19: chat.ChatEvent
+	(*WebSocketMessage)(nil),      // 20: chat.WebSocketMessage
+	nil,                           // 21: chat.ChatMessage.InfoEntry
+	nil,                           // 22: chat.PostMessageRequest.KnownRoomsEntry
+	nil,                           // 23: chat.DeleteMessageRequest.KnownRoomsEntry
+	(*activity.Object)(nil),       // 24: activity.Object
 }
 var file_cells_chat_proto_depIdxs = []int32{
 	0,  // 0: chat.ChatRoom.Type:type_name -> chat.RoomType
-	21, // 1: chat.ChatMessage.Activity:type_name -> activity.Object
-	18, // 2: chat.ChatMessage.Info:type_name -> chat.ChatMessage.InfoEntry
-	2,  // 3: chat.PutRoomRequest.Room:type_name -> chat.ChatRoom
-	2,  // 4: chat.PutRoomResponse.Room:type_name -> chat.ChatRoom
-	3,  // 5: chat.PostMessageRequest.Messages:type_name -> chat.ChatMessage
-	19, // 6: chat.PostMessageRequest.KnownRooms:type_name -> chat.PostMessageRequest.KnownRoomsEntry
-	3,  // 7: chat.PostMessageResponse.Messages:type_name -> chat.ChatMessage
-	3,  // 8: chat.DeleteMessageRequest.Messages:type_name -> chat.ChatMessage
-	20, // 9: chat.DeleteMessageRequest.KnownRooms:type_name -> chat.DeleteMessageRequest.KnownRoomsEntry
-	3,  // 10: chat.ListMessagesResponse.Message:type_name -> chat.ChatMessage
-	0,  // 11: chat.ListRoomsRequest.ByType:type_name -> chat.RoomType
-	2,  // 12: chat.ListRoomsResponse.Room:type_name -> chat.ChatRoom
-	2,  // 13: chat.DeleteRoomRequest.Room:type_name -> chat.ChatRoom
-	3,  // 14: chat.ChatEvent.Message:type_name -> chat.ChatMessage
-	2,  // 15: chat.ChatEvent.Room:type_name -> chat.ChatRoom
-	1,  // 16: chat.WebSocketMessage.Type:type_name -> chat.WsMessageType
-	2,  // 17: chat.WebSocketMessage.Room:type_name -> chat.ChatRoom
-	3,  // 18: chat.WebSocketMessage.Message:type_name -> chat.ChatMessage
-	2,  // 19: chat.PostMessageRequest.KnownRoomsEntry.value:type_name -> chat.ChatRoom
-	2,  // 20: chat.DeleteMessageRequest.KnownRoomsEntry.value:type_name -> chat.ChatRoom
-	4,  // 21: chat.ChatService.PutRoom:input_type -> chat.PutRoomRequest
-	14, // 22: chat.ChatService.DeleteRoom:input_type -> chat.DeleteRoomRequest
-	12, // 23: chat.ChatService.ListRooms:input_type -> chat.ListRoomsRequest
-	10, // 24: chat.ChatService.ListMessages:input_type -> chat.ListMessagesRequest
-	6,  // 25: chat.ChatService.PostMessage:input_type -> chat.PostMessageRequest
-	8,  // 26: chat.ChatService.DeleteMessage:input_type -> chat.DeleteMessageRequest
-	5,  // 27: chat.ChatService.PutRoom:output_type -> chat.PutRoomResponse
-	15, // 28: chat.ChatService.DeleteRoom:output_type -> chat.DeleteRoomResponse
-	13, // 29: chat.ChatService.ListRooms:output_type -> chat.ListRoomsResponse
-	11, // 30: chat.ChatService.ListMessages:output_type -> chat.ListMessagesResponse
-	7,  // 31: chat.ChatService.PostMessage:output_type -> chat.PostMessageResponse
-	9,  // 32: chat.ChatService.DeleteMessage:output_type -> chat.DeleteMessageResponse
-	27, // [27:33] is the sub-list for method output_type
-	21, // [21:27] is the sub-list for method input_type
-	21, // [21:21] is the sub-list for extension type_name
-	21, // [21:21] is the sub-list for extension extendee
-	0,  // [0:21] is the sub-list for field type_name
+	24, // 1: chat.ChatMessage.Activity:type_name -> activity.Object
+	21, // 2: chat.ChatMessage.Info:type_name -> chat.ChatMessage.InfoEntry
+	4,  // 3: chat.ChatMessage.Reactions:type_name -> chat.ChatReaction
+	2,  // 4: chat.PutRoomRequest.Room:type_name -> chat.ChatRoom
+	2,  // 5: chat.PutRoomResponse.Room:type_name -> chat.ChatRoom
+	3,  // 6: chat.PostMessageRequest.Messages:type_name -> chat.ChatMessage
+	22, // 7: chat.PostMessageRequest.KnownRooms:type_name -> chat.PostMessageRequest.KnownRoomsEntry
+	3,  // 8: chat.PostMessageResponse.Messages:type_name -> chat.ChatMessage
+	3,  // 9: chat.DeleteMessageRequest.Messages:type_name -> chat.ChatMessage
+	23, // 10: chat.DeleteMessageRequest.KnownRooms:type_name -> chat.DeleteMessageRequest.KnownRoomsEntry
+	3,  // 11: chat.ListMessagesResponse.Message:type_name -> chat.ChatMessage
+	3,  // 12: chat.ReactMessageResponse.Message:type_name -> chat.ChatMessage
+	0,  // 13: chat.ListRoomsRequest.ByType:type_name -> chat.RoomType
+	2,  // 14: chat.ListRoomsResponse.Room:type_name -> chat.ChatRoom
+	2,  // 15: chat.DeleteRoomRequest.Room:type_name -> chat.ChatRoom
+	3,  // 16: chat.ChatEvent.Message:type_name -> chat.ChatMessage
+	2,  // 17: chat.ChatEvent.Room:type_name -> chat.ChatRoom
+	1,  // 18: chat.WebSocketMessage.Type:type_name -> chat.WsMessageType
+	2,  // 19: chat.WebSocketMessage.Room:type_name -> chat.ChatRoom
+	3,  // 20: chat.WebSocketMessage.Message:type_name -> chat.ChatMessage
+	2,  // 21: chat.PostMessageRequest.KnownRoomsEntry.value:type_name -> chat.ChatRoom
+	2,  // 22: chat.DeleteMessageRequest.KnownRoomsEntry.value:type_name -> chat.ChatRoom
+	5,  // 23: chat.ChatService.PutRoom:input_type -> chat.PutRoomRequest
+	17, // 24: chat.ChatService.DeleteRoom:input_type -> chat.DeleteRoomRequest
+	15, // 25: chat.ChatService.ListRooms:input_type -> chat.ListRoomsRequest
+	11, // 26: chat.ChatService.ListMessages:input_type -> chat.ListMessagesRequest
+	7,  // 27: chat.ChatService.PostMessage:input_type -> chat.PostMessageRequest
+	9,  // 28: chat.ChatService.DeleteMessage:input_type -> chat.DeleteMessageRequest
+	13, // 29: chat.ChatService.ReactMessage:input_type -> chat.ReactMessageRequest
+	6,  // 30: chat.ChatService.PutRoom:output_type -> chat.PutRoomResponse
+	18, // 31: chat.ChatService.DeleteRoom:output_type -> chat.DeleteRoomResponse
+	16, // 32: chat.ChatService.ListRooms:output_type -> chat.ListRoomsResponse
+	12, // 33: chat.ChatService.ListMessages:output_type -> chat.ListMessagesResponse
+	8,  // 34: chat.ChatService.PostMessage:output_type -> chat.PostMessageResponse
+	10, // 35: chat.ChatService.DeleteMessage:output_type -> chat.DeleteMessageResponse
+	14, // 36: chat.ChatService.ReactMessage:output_type -> chat.ReactMessageResponse
+	30, // [30:37] is the sub-list for method output_type
+	23, // [23:30] is the sub-list for method input_type
+	23, // [23:23] is the sub-list for extension type_name
+	23, // [23:23] is the sub-list for extension extendee
+	0,  // [0:23] is the sub-list for field type_name
 }
 
 func init() { file_cells_chat_proto_init() }
@@ -1353,7 +1685,7 @@ func file_cells_chat_proto_init() {
 			}
 		}
 		file_cells_chat_proto_msgTypes[2].Exporter = func(v any, i int) any {
-			switch v := v.(*PutRoomRequest); i {
+			switch v := v.(*ChatReaction); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1365,7 +1697,7 @@ func file_cells_chat_proto_init() {
 			}
 		}
 		file_cells_chat_proto_msgTypes[3].Exporter = func(v any, i int) any {
-			switch v := v.(*PutRoomResponse); i {
+			switch v := v.(*PutRoomRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1377,7 +1709,7 @@ func file_cells_chat_proto_init() {
 			}
 		}
 		file_cells_chat_proto_msgTypes[4].Exporter = func(v any, i int) any {
-			switch v := v.(*PostMessageRequest); i {
+			switch v := v.(*PutRoomResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1389,7 +1721,7 @@ func file_cells_chat_proto_init() {
 			}
 		}
 		file_cells_chat_proto_msgTypes[5].Exporter = func(v any, i int) any {
-			switch v := v.(*PostMessageResponse); i {
+			switch v := v.(*PostMessageRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1401,7 +1733,7 @@ func file_cells_chat_proto_init() {
 			}
 		}
 		file_cells_chat_proto_msgTypes[6].Exporter = func(v any, i int) any {
-			switch v := v.(*DeleteMessageRequest); i {
+			switch v := v.(*PostMessageResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1413,7 +1745,7 @@ func file_cells_chat_proto_init() {
 			}
 		}
 		file_cells_chat_proto_msgTypes[7].Exporter = func(v any, i int) any {
-			switch v := v.(*DeleteMessageResponse); i {
+			switch v := v.(*DeleteMessageRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1425,7 +1757,7 @@ func file_cells_chat_proto_init() {
 			}
 		}
 		file_cells_chat_proto_msgTypes[8].Exporter = func(v any, i int) any {
-			switch v := v.(*ListMessagesRequest); i {
+			switch v := v.(*DeleteMessageResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1437,7 +1769,7 @@ func file_cells_chat_proto_init() {
 			}
 		}
 		file_cells_chat_proto_msgTypes[9].Exporter = func(v any, i int) any {
-			switch v := v.(*ListMessagesResponse); i {
+			switch v := v.(*ListMessagesRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1449,7 +1781,7 @@ func file_cells_chat_proto_init() {
 			}
 		}
 		file_cells_chat_proto_msgTypes[10].Exporter = func(v any, i int) any {
-			switch v := v.(*ListRoomsRequest); i {
+			switch v := v.(*ListMessagesResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1461,7 +1793,7 @@ func file_cells_chat_proto_init() {
 			}
 		}
 		file_cells_chat_proto_msgTypes[11].Exporter = func(v any, i int) any {
-			switch v := v.(*ListRoomsResponse); i {
+			switch v := v.(*ReactMessageRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1473,7 +1805,7 @@ func file_cells_chat_proto_init() {
 			}
 		}
 		file_cells_chat_proto_msgTypes[12].Exporter = func(v any, i int) any {
-			switch v := v.(*DeleteRoomRequest); i {
+			switch v := v.(*ReactMessageResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1485,7 +1817,7 @@ func file_cells_chat_proto_init() {
 			}
 		}
 		file_cells_chat_proto_msgTypes[13].Exporter = func(v any, i int) any {
-			switch v := v.(*DeleteRoomResponse); i {
+			switch v := v.(*ListRoomsRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1497,7 +1829,7 @@ func file_cells_chat_proto_init() {
 			}
 		}
 		file_cells_chat_proto_msgTypes[14].Exporter = func(v any, i int) any {
-			switch v := v.(*ChatEvent); i {
+			switch v := v.(*ListRoomsResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1509,6 +1841,42 @@ func file_cells_chat_proto_init() {
 			}
 		}
 		file_cells_chat_proto_msgTypes[15].Exporter = func(v any, i int) any {
+			switch v := v.(*DeleteRoomRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cells_chat_proto_msgTypes[16].Exporter = func(v any, i int) any {
+			switch v := v.(*DeleteRoomResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cells_chat_proto_msgTypes[17].Exporter = func(v any, i int) any {
+			switch v := v.(*ChatEvent); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cells_chat_proto_msgTypes[18].Exporter = func(v any, i int) any {
 			switch v := v.(*WebSocketMessage); i {
 			case 0:
 				return &v.state
@@ -1527,7 +1895,7 @@ func file_cells_chat_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_cells_chat_proto_rawDesc,
 			NumEnums:      2,
-			NumMessages:   19,
+			NumMessages:   22,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -141,6 +141,11 @@ type ChatMessageGetter interface {
 	GetTimestamp() int64
 	GetActivity() *activity.Object
 	GetInfo() map[string]string
+	GetParentUuid() string
+	GetMentions() []string
+	GetReactions() []*ChatReaction
+	GetNodeVersionId() string
+	GetEditTimestamp() int64
 }
 
 type ChatMessageSetter interface {
@@ -151,6 +156,11 @@ type ChatMessageSetter interface {
 	SetTimestamp(int64)
 	SetActivity(*activity.Object)
 	SetInfo(map[string]string)
+	SetParentUuid(string)
+	SetMentions([]string)
+	SetReactions([]*ChatReaction)
+	SetNodeVersionId(string)
+	SetEditTimestamp(int64)
 }
 
 func (x *ChatMessage) SetUuid(v string) {
@@ -202,6 +212,87 @@ func (x *ChatMessage) SetInfo(v map[string]string) {
 
 	x.Info = v
 }
+func (x *ChatMessage) SetParentUuid(v string) {
+	if x == nil {
+		x = new(ChatMessage)
+	}
+
+	x.ParentUuid = v
+}
+func (x *ChatMessage) SetMentions(v []string) {
+	if x == nil {
+		x = new(ChatMessage)
+	}
+
+	x.Mentions = v
+}
+func (x *ChatMessage) SetReactions(v []*ChatReaction) {
+	if x == nil {
+		x = new(ChatMessage)
+	}
+
+	x.Reactions = v
+}
+func (x *ChatMessage) SetNodeVersionId(v string) {
+	if x == nil {
+		x = new(ChatMessage)
+	}
+
+	x.NodeVersionId = v
+}
+func (x *ChatMessage) SetEditTimestamp(v int64) {
+	if x == nil {
+		x = new(ChatMessage)
+	}
+
+	x.EditTimestamp = v
+}
+
+type IChatReaction interface {
+	proto.Message
+	ChatReactionGetter
+	ChatReactionSetter
+}
+
+func NewIChatReaction(x any) error {
+	v := reflect.ValueOf(x)
+	for v.Kind() == reflect.Ptr {
+		if v.IsNil() && v.CanAddr() {
+			v.Set(reflect.New(v.Type().Elem()))
+		}
+
+		v = v.Elem()
+	}
+	if !v.IsValid() {
+		return errors.New("not initialized")
+	}
+	return nil
+}
+
+type ChatReactionGetter interface {
+	GetEmoji() string
+	GetUsers() []string
+}
+
+type ChatReactionSetter interface {
+	SetEmoji(string)
+	SetUsers([]string)
+}
+
+func (x *ChatReaction) SetEmoji(v string) {
+	if x == nil {
+		x = new(ChatReaction)
+	}
+
+	x.Emoji = v
+}
+func (x *ChatReaction) SetUsers(v []string) {
+	if x == nil {
+		x = new(ChatReaction)
+	}
+
+	x.Users = v
+}
 
 type IChatEvent interface {
 	proto.Message
@@ -283,12 +374,16 @@ type WebSocketMessageGetter interface {
 	GetType() WsMessageType
 	GetRoom() *ChatRoom
 	GetMessage() *ChatMessage
+	GetReaction() string
+	GetRemove() bool
 }
 
 type WebSocketMessageSetter interface {
 	SetType(WsMessageType)
 	SetRoom(*ChatRoom)
 	SetMessage(*ChatMessage)
+	SetReaction(string)
+	SetRemove(bool)
 }
 
 func (x *WebSocketMessage) SetType(v WsMessageType) {
@@ -312,3 +407,17 @@ func (x *WebSocketMessage) SetMessage(v *ChatMessage) {
 
 	x.Message = v
 }
+func (x *WebSocketMessage) SetReaction(v string) {
+	if x == nil {
+		x = new(WebSocketMessage)
+	}
+
+	x.Reaction = v
+}
+func (x *WebSocketMessage) SetRemove(v bool) {
+	if x == nil {
+		x = new(WebSocketMessage)
+	}
+
+	x.Remove = v
+}
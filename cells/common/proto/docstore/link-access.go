@@ -0,0 +1,38 @@
+/*
+ * Copyright (c) 2025 Abstrium SAS <team (at) pydio.com>
+ * This file is part of Pydio Cells.
+ *
+ * Pydio Cells is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * Pydio Cells is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with Pydio Cells.  If not, see <http://www.gnu.org/licenses/>.
+ *
+ * The latest code can be found at <https://pydio.com>.
+ */
+
+package docstore
+
+const (
+	LinkAccessView     = "view"
+	LinkAccessPreview  = "preview"
+	LinkAccessDownload = "download"
+)
+
+// LinkAccess is a Json Marshallable record of a visit to a public link. IP is anonymized before storage.
+type LinkAccess struct {
+	LinkUuid  string `json:"LINK_UUID"`
+	Type      string `json:"TYPE"`
+	Time      int64  `json:"TIME"`
+	IP        string `json:"IP,omitempty"`
+	Country   string `json:"COUNTRY,omitempty"`
+	UserAgent string `json:"USER_AGENT,omitempty"`
+	NodePath  string `json:"NODE_PATH,omitempty"`
+}
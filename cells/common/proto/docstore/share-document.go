@@ -20,6 +20,8 @@
 
 package docstore
 
+import "time"
+
 type TargetUserEntry struct {
 	Display       string `json:"display"`
 	DownloadCount int32  `json:"download_count"`
@@ -44,4 +46,23 @@ type ShareDocument struct {
 	RestrictToTargetUsers bool                        `json:"RESTRICT_TO_TARGET_USERS"`
 	OwnerId               string                      `json:"OWNER_ID"`
 	PreUserUuid           string                      `json:"USER_UUID"`
+	StartTime             int64                       `json:"START_TIME,omitempty"`
+	ActivationNotified    bool                        `json:"ACTIVATION_NOTIFIED,omitempty"`
+	ExpirationNotified    bool                        `json:"EXPIRATION_NOTIFIED,omitempty"`
+	Watermark             bool                        `json:"WATERMARK,omitempty"`
+}
+
+// IsPending returns true if the link start date is not reached yet.
+func (s *ShareDocument) IsPending(now time.Time) bool {
+	return s.StartTime > 0 && now.Before(time.Unix(s.StartTime, 0))
+}
+
+// IsExpired returns true if the link expiration date is passed.
+func (s *ShareDocument) IsExpired(now time.Time) bool {
+	return s.ExpireTime > 0 && now.After(time.Unix(s.ExpireTime, 0))
+}
+
+// IsExhausted returns true if the maximum number of downloads is reached.
+func (s *ShareDocument) IsExhausted() bool {
+	return s.DownloadLimit > 0 && s.DownloadCount >= s.DownloadLimit
 }
@@ -24,10 +24,11 @@ const (
 type SetNodeInfoActionType int32
 
 const (
-	SetNodeInfoActionType_KEY   SetNodeInfoActionType = 0
-	SetNodeInfoActionType_BLOCK SetNodeInfoActionType = 1
-	SetNodeInfoActionType_CLEAR SetNodeInfoActionType = 2
-	SetNodeInfoActionType_CLOSE SetNodeInfoActionType = 3
+	SetNodeInfoActionType_KEY        SetNodeInfoActionType = 0
+	SetNodeInfoActionType_BLOCK      SetNodeInfoActionType = 1
+	SetNodeInfoActionType_CLEAR      SetNodeInfoActionType = 2
+	SetNodeInfoActionType_CLOSE      SetNodeInfoActionType = 3
+	SetNodeInfoActionType_KEY_UPDATE SetNodeInfoActionType = 4
 )
 
 // Enum value maps for SetNodeInfoActionType.
@@ -37,12 +38,14 @@ var (
 		1: "BLOCK",
 		2: "CLEAR",
 		3: "CLOSE",
+		4: "KEY_UPDATE",
 	}
 	SetNodeInfoActionType_value = map[string]int32{
-		"KEY":   0,
-		"BLOCK": 1,
-		"CLEAR": 2,
-		"CLOSE": 3,
+		"KEY":        0,
+		"BLOCK":      1,
+		"CLEAR":      2,
+		"CLOSE":      3,
+		"KEY_UPDATE": 4,
 	}
 )
 
@@ -1269,8 +1272,8 @@ type Block struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	OwnerId    string `protobuf:"bytes,1,opt,name=OwnerId,proto3" json:"OwnerId,omitempty"`
-	PartId     uint32 `protobuf:"varint,2,opt,name=PartId,proto3" json:"PartId,omitempty"`
+	OwnerId    string `protobuf:"bytes,1,opt,name=OwnerId,proto3" json:"OwnerId,omitempty" gorm:"column:owner;type:varchar(255);"`
+	PartId     uint32 `protobuf:"varint,2,opt,name=PartId,proto3" json:"PartId,omitempty" gorm:"column:part_id;"`
 	Position   uint32 `protobuf:"varint,3,opt,name=Position,proto3" json:"Position,omitempty"`
 	HeaderSize uint32 `protobuf:"varint,4,opt,name=HeaderSize,proto3" json:"HeaderSize,omitempty"`
 	BlockSize  uint32 `protobuf:"varint,5,opt,name=BlockSize,proto3" json:"BlockSize,omitempty"`
@@ -2641,11 +2644,12 @@ var file_cells_encryption_proto_rawDesc = []byte{
 	0x43, 0x6f, 0x70, 0x79, 0x55, 0x75, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c,
 	0x4e, 0x6f, 0x64, 0x65, 0x43, 0x6f, 0x70, 0x79, 0x55, 0x75, 0x69, 0x64, 0x22, 0x16, 0x0a, 0x14,
 	0x43, 0x6f, 0x70, 0x79, 0x4e, 0x6f, 0x64, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x2a, 0x41, 0x0a, 0x15, 0x53, 0x65, 0x74, 0x4e, 0x6f, 0x64, 0x65, 0x49,
+	0x6f, 0x6e, 0x73, 0x65, 0x2a, 0x51, 0x0a, 0x15, 0x53, 0x65, 0x74, 0x4e, 0x6f, 0x64, 0x65, 0x49,
 	0x6e, 0x66, 0x6f, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x12, 0x07, 0x0a,
 	0x03, 0x4b, 0x45, 0x59, 0x10, 0x00, 0x12, 0x09, 0x0a, 0x05, 0x42, 0x4c, 0x4f, 0x43, 0x4b, 0x10,
 	0x01, 0x12, 0x09, 0x0a, 0x05, 0x43, 0x4c, 0x45, 0x41, 0x52, 0x10, 0x02, 0x12, 0x09, 0x0a, 0x05,
-	0x43, 0x4c, 0x4f, 0x53, 0x45, 0x10, 0x03, 0x32, 0xd8, 0x04, 0x0a, 0x0c, 0x55, 0x73, 0x65, 0x72,
+	0x43, 0x4c, 0x4f, 0x53, 0x45, 0x10, 0x03, 0x12, 0x0e, 0x0a, 0x0a, 0x4b, 0x45, 0x59, 0x5f, 0x55,
+	0x50, 0x44, 0x41, 0x54, 0x45, 0x10, 0x04, 0x32, 0xd8, 0x04, 0x0a, 0x0c, 0x55, 0x73, 0x65, 0x72,
 	0x4b, 0x65, 0x79, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x12, 0x41, 0x0a, 0x06, 0x41, 0x64, 0x64, 0x4b,
 	0x65, 0x79, 0x12, 0x19, 0x2e, 0x65, 0x6e, 0x63, 0x72, 0x79, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x2e,
 	0x41, 0x64, 0x64, 0x4b, 0x65, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e,
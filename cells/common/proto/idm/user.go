@@ -32,6 +32,16 @@ const (
 	UserAttrPassHashed    = UserAttrPrivatePrefix + "password_hashed"
 	UserAttrLabelLike     = UserAttrPrivatePrefix + "labelLike"
 	UserAttrOrigin        = UserAttrPrivatePrefix + "origin"
+	// UserAttrSponsor stores the login of the internal user who invited an external user
+	UserAttrSponsor = UserAttrPrivatePrefix + "sponsor"
+	// UserAttrGuestExpiration stores the unix timestamp at which an external user is disabled
+	UserAttrGuestExpiration = UserAttrPrivatePrefix + "guestExpiration"
+	// UserAttrGuestReminded stores the expiration for which the sponsor was last reminded
+	UserAttrGuestReminded = UserAttrPrivatePrefix + "guestReminded"
+	// UserAttrGuestDisabled stores the unix timestamp at which an external user was disabled on expiration
+	UserAttrGuestDisabled = UserAttrPrivatePrefix + "guestDisabled"
+	// UserAttrQuotaWarned stores the last quota warning threshold reached by a user or a group
+	UserAttrQuotaWarned = UserAttrPrivatePrefix + "quotaWarned"
 
 	UserAttrDisplayName = "displayName"
 	UserAttrProfile     = "profile"
@@ -40,6 +50,10 @@ const (
 	UserAttrHasEmail    = "hasEmail"
 	UserAttrAuthSource  = "AuthSource"
 	UserAttrHidden      = "hidden"
+	// UserAttrNotifications stores JSON-encoded notification preferences, see activity.NotificationPreferences
+	UserAttrNotifications = "notifications"
+	// UserAttrEmailBounce flags the user email address as invalid after a permanent delivery failure
+	UserAttrEmailBounce = "emailBounce"
 )
 
 func (u *User) WithPublicData(ctx context.Context, policiesContextEditable bool) *User {
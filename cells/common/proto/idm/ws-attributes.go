@@ -33,6 +33,16 @@ type WsAttributes struct {
 	QuotaValue      string `json:"QUOTA,omitempty"`
 	MetaLayout      string `json:"META_LAYOUT,omitempty"`
 	ShareExpiration int64  `json:"shareExpiration,omitempty"`
+
+	FileRequest *FileRequestOptions `json:"fileRequest,omitempty"`
+}
+
+// FileRequestOptions are set on link workspaces used as upload-only file requests.
+type FileRequestOptions struct {
+	AllowedExtensions []string `json:"allowedExtensions,omitempty"`
+	MaxFileSize       int64    `json:"maxFileSize,omitempty"`
+	MaxTotalSize      int64    `json:"maxTotalSize,omitempty"`
+	NotifyOwner       bool     `json:"notifyOwner,omitempty"`
 }
 
 func (m *Workspace) LoadAttributes() *WsAttributes {
@@ -304,3 +304,118 @@ var LogRecorder_ServiceDesc = grpc.ServiceDesc{
 	},
 	Metadata: "cells-log.proto",
 }
+
+// AuditTrailClient is the client API for AuditTrail service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type AuditTrailClient interface {
+	// ListRecords streams audit records in sequence order, starting at a given sequence number.
+	ListRecords(ctx context.Context, in *ListAuditRecordsRequest, opts ...grpc.CallOption) (AuditTrail_ListRecordsClient, error)
+}
+
+type auditTrailClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewAuditTrailClient(cc grpc.ClientConnInterface) AuditTrailClient {
+	return &auditTrailClient{cc}
+}
+
+func (c *auditTrailClient) ListRecords(ctx context.Context, in *ListAuditRecordsRequest, opts ...grpc.CallOption) (AuditTrail_ListRecordsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &AuditTrail_ServiceDesc.Streams[0], "/log.AuditTrail/ListRecords", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &auditTrailListRecordsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type AuditTrail_ListRecordsClient interface {
+	Recv() (*AuditRecord, error)
+	grpc.ClientStream
+}
+
+type auditTrailListRecordsClient struct {
+	grpc.ClientStream
+}
+
+func (x *auditTrailListRecordsClient) Recv() (*AuditRecord, error) {
+	m := new(AuditRecord)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// AuditTrailServer is the server API for AuditTrail service.
+// All implementations must embed UnimplementedAuditTrailServer
+// for forward compatibility
+type AuditTrailServer interface {
+	// ListRecords streams audit records in sequence order, starting at a given sequence number.
+	ListRecords(*ListAuditRecordsRequest, AuditTrail_ListRecordsServer) error
+	mustEmbedUnimplementedAuditTrailServer()
+}
+
+// UnimplementedAuditTrailServer must be embedded to have forward compatible implementations.
+type UnimplementedAuditTrailServer struct {
+}
+
+func (UnimplementedAuditTrailServer) ListRecords(*ListAuditRecordsRequest, AuditTrail_ListRecordsServer) error {
+	return status.Errorf(codes.Unimplemented, "method ListRecords not implemented")
+}
+func (UnimplementedAuditTrailServer) mustEmbedUnimplementedAuditTrailServer() {}
+
+// UnsafeAuditTrailServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to AuditTrailServer will
+// result in compilation errors.
+type UnsafeAuditTrailServer interface {
+	mustEmbedUnimplementedAuditTrailServer()
+}
+
+func RegisterAuditTrailServer(s grpc.ServiceRegistrar, srv AuditTrailServer) {
+	s.RegisterService(&AuditTrail_ServiceDesc, srv)
+}
+
+func _AuditTrail_ListRecords_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ListAuditRecordsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(AuditTrailServer).ListRecords(m, &auditTrailListRecordsServer{stream})
+}
+
+type AuditTrail_ListRecordsServer interface {
+	Send(*AuditRecord) error
+	grpc.ServerStream
+}
+
+type auditTrailListRecordsServer struct {
+	grpc.ServerStream
+}
+
+func (x *auditTrailListRecordsServer) Send(m *AuditRecord) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+// AuditTrail_ServiceDesc is the grpc.ServiceDesc for AuditTrail service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var AuditTrail_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "log.AuditTrail",
+	HandlerType: (*AuditTrailServer)(nil),
+	Methods:     []grpc.MethodDesc{},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "ListRecords",
+			Handler:       _AuditTrail_ListRecords_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "cells-log.proto",
+}
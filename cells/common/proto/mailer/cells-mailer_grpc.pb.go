@@ -20,6 +20,9 @@ const _ = grpc.SupportPackageIsVersion7
 type MailerServiceClient interface {
 	SendMail(ctx context.Context, in *SendMailRequest, opts ...grpc.CallOption) (*SendMailResponse, error)
 	ConsumeQueue(ctx context.Context, in *ConsumeQueueRequest, opts ...grpc.CallOption) (*ConsumeQueueResponse, error)
+	ListQueue(ctx context.Context, in *ListQueueRequest, opts ...grpc.CallOption) (*ListQueueResponse, error)
+	RetryQueue(ctx context.Context, in *QueueActionRequest, opts ...grpc.CallOption) (*QueueActionResponse, error)
+	DiscardQueue(ctx context.Context, in *QueueActionRequest, opts ...grpc.CallOption) (*QueueActionResponse, error)
 }
 
 type mailerServiceClient struct {
@@ -48,12 +51,42 @@ func (c *mailerServiceClient) ConsumeQueue(ctx context.Context, in *ConsumeQueue
 	return out, nil
 }
 
+func (c *mailerServiceClient) ListQueue(ctx context.Context, in *ListQueueRequest, opts ...grpc.CallOption) (*ListQueueResponse, error) {
+	out := new(ListQueueResponse)
+	err := c.cc.Invoke(ctx, "/mailer.MailerService/ListQueue", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mailerServiceClient) RetryQueue(ctx context.Context, in *QueueActionRequest, opts ...grpc.CallOption) (*QueueActionResponse, error) {
+	out := new(QueueActionResponse)
+	err := c.cc.Invoke(ctx, "/mailer.MailerService/RetryQueue", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mailerServiceClient) DiscardQueue(ctx context.Context, in *QueueActionRequest, opts ...grpc.CallOption) (*QueueActionResponse, error) {
+	out := new(QueueActionResponse)
+	err := c.cc.Invoke(ctx, "/mailer.MailerService/DiscardQueue", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MailerServiceServer is the server API for MailerService service.
 // All implementations must embed UnimplementedMailerServiceServer
 // for forward compatibility
 type MailerServiceServer interface {
 	SendMail(context.Context, *SendMailRequest) (*SendMailResponse, error)
 	ConsumeQueue(context.Context, *ConsumeQueueRequest) (*ConsumeQueueResponse, error)
+	ListQueue(context.Context, *ListQueueRequest) (*ListQueueResponse, error)
+	RetryQueue(context.Context, *QueueActionRequest) (*QueueActionResponse, error)
+	DiscardQueue(context.Context, *QueueActionRequest) (*QueueActionResponse, error)
 	mustEmbedUnimplementedMailerServiceServer()
 }
 
@@ -67,6 +100,15 @@ func (UnimplementedMailerServiceServer) SendMail(context.Context, *SendMailReque
 func (UnimplementedMailerServiceServer) ConsumeQueue(context.Context, *ConsumeQueueRequest) (*ConsumeQueueResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ConsumeQueue not implemented")
 }
+func (UnimplementedMailerServiceServer) ListQueue(context.Context, *ListQueueRequest) (*ListQueueResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListQueue not implemented")
+}
+func (UnimplementedMailerServiceServer) RetryQueue(context.Context, *QueueActionRequest) (*QueueActionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RetryQueue not implemented")
+}
+func (UnimplementedMailerServiceServer) DiscardQueue(context.Context, *QueueActionRequest) (*QueueActionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DiscardQueue not implemented")
+}
 func (UnimplementedMailerServiceServer) mustEmbedUnimplementedMailerServiceServer() {}
 
 // UnsafeMailerServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -116,6 +158,60 @@ func _MailerService_ConsumeQueue_Handler(srv interface{}, ctx context.Context, d
 	return interceptor(ctx, in, info, handler)
 }
 
+func _MailerService_ListQueue_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListQueueRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MailerServiceServer).ListQueue(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/mailer.MailerService/ListQueue",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MailerServiceServer).ListQueue(ctx, req.(*ListQueueRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MailerService_RetryQueue_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueueActionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MailerServiceServer).RetryQueue(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/mailer.MailerService/RetryQueue",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MailerServiceServer).RetryQueue(ctx, req.(*QueueActionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MailerService_DiscardQueue_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueueActionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MailerServiceServer).DiscardQueue(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/mailer.MailerService/DiscardQueue",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MailerServiceServer).DiscardQueue(ctx, req.(*QueueActionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // MailerService_ServiceDesc is the grpc.ServiceDesc for MailerService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -131,6 +227,18 @@ var MailerService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ConsumeQueue",
 			Handler:    _MailerService_ConsumeQueue_Handler,
 		},
+		{
+			MethodName: "ListQueue",
+			Handler:    _MailerService_ListQueue_Handler,
+		},
+		{
+			MethodName: "RetryQueue",
+			Handler:    _MailerService_RetryQueue_Handler,
+		},
+		{
+			MethodName: "DiscardQueue",
+			Handler:    _MailerService_DiscardQueue_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cells-mailer.proto",
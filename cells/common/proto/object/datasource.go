@@ -26,6 +26,7 @@ import (
 	"path"
 	"strconv"
 	"strings"
+	"time"
 
 	"go.uber.org/zap/zapcore"
 
@@ -55,9 +56,15 @@ const (
 	StorageKeyInitFromBucket   = "initFromBucket"
 	StorageKeyInitFromSnapshot = "initFromSnapshot"
 	StorageKeyHashingVersion   = "hashingVersion"
+	StorageKeyCompression      = "compression"
+
+	StorageKeyEncryptionPreviousKeys = "encryptionPreviousKeys"
+	StorageKeyTieringColdDatasource  = "tieringColdDatasource"
+	StorageKeyTieringColdAfter       = "tieringColdAfter"
 
 	AmazonS3Endpoint      = "s3.amazonaws.com"
 	CurrentHashingVersion = "v4"
+	CompressionZstd       = "zstd"
 )
 
 func (d *DataSource) ClientConfig(ctx context.Context, p SecretProvider) configx.Values {
@@ -188,6 +195,66 @@ func (d *DataSource) IsInternal() bool {
 	return false
 }
 
+// CompressionEnabled checks if StorageConfiguration["compression"] key is set to a supported codec
+func (d *DataSource) CompressionEnabled() bool {
+	if d == nil {
+		return false
+	}
+	c, _ := d.ConfigurationByKey(StorageKeyCompression)
+	return c == CompressionZstd
+}
+
+// PreviousEncryptionKeys lists keys that were used before the current EncryptionKey and that may still be
+// required to open node keys until a key rotation is finished.
+func (d *DataSource) PreviousEncryptionKeys() (kk []string) {
+	pk, _ := d.ConfigurationByKey(StorageKeyEncryptionPreviousKeys)
+	for _, k := range strings.Split(pk, ",") {
+		if k = strings.TrimSpace(k); k != "" && k != d.EncryptionKey {
+			kk = append(kk, k)
+		}
+	}
+	return
+}
+
+// EncryptedWithNodeKeys checks if files are encrypted with per-node keys, these keys being wrapped either by
+// a master key managed by Cells (MASTER) or by an external KMS (KMS).
+func (d *DataSource) EncryptedWithNodeKeys() bool {
+	return d != nil && (d.EncryptionMode == EncryptionMode_MASTER || d.EncryptionMode == EncryptionMode_KMS)
+}
+
+// EncryptionKMS returns the URL of the external KMS used to wrap node keys, if any. When EncryptionMode is KMS,
+// the EncryptionKey field holds this URL instead of a Cells master key ID.
+func (d *DataSource) EncryptionKMS() string {
+	if d == nil || d.EncryptionMode != EncryptionMode_KMS {
+		return ""
+	}
+	return d.EncryptionKey
+}
+
+// TieringColdDatasource returns the name of the datasource receiving cold files, if tiering is enabled. It may
+// live on a different storage. Tiering is only supported on flat datasources, as objects are moved without
+// touching the index.
+func (d *DataSource) TieringColdDatasource() string {
+	if d == nil || !d.FlatStorage {
+		return ""
+	}
+	c, _ := d.ConfigurationByKey(StorageKeyTieringColdDatasource)
+	if c == d.Name {
+		return ""
+	}
+	return c
+}
+
+// TieringColdAfter reads the number of days without access after which a file is moved to the cold bucket.
+func (d *DataSource) TieringColdAfter() time.Duration {
+	v, _ := d.ConfigurationByKey(StorageKeyTieringColdAfter)
+	days, _ := strconv.Atoi(v)
+	if days <= 0 {
+		return 0
+	}
+	return time.Duration(days) * 24 * time.Hour
+}
+
 func (d *DataSource) FlatShardedPath(nodeId string) string {
 	if d.ObjectsBaseFolder != "" {
 		nodeId = path.Join(d.ObjectsBaseFolder, nodeId)
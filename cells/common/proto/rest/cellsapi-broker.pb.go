@@ -8,6 +8,7 @@ package rest
 
 import (
 	activity "github.com/pydio/cells/v5/common/proto/activity"
+	chat "github.com/pydio/cells/v5/common/proto/chat"
 	log "github.com/pydio/cells/v5/common/proto/log"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
@@ -117,6 +118,694 @@ func (x *SubscriptionsCollection) GetSubscriptions() []*activity.Subscription {
 	return nil
 }
 
+// Request for exporting activities as a syndication feed
+type ActivityFeedRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Feed format: atom, rss or json
+	Format string `protobuf:"bytes,1,opt,name=Format,proto3" json:"Format,omitempty"`
+	// Follow a folder by its uuid instead of the user inbox
+	NodeId string `protobuf:"bytes,2,opt,name=NodeId,proto3" json:"NodeId,omitempty"`
+	// Follow a workspace by its slug instead of the user inbox
+	WorkspaceSlug string `protobuf:"bytes,3,opt,name=WorkspaceSlug,proto3" json:"WorkspaceSlug,omitempty"`
+	// Maximum number of entries, defaults to 50
+	Limit int32 `protobuf:"varint,4,opt,name=Limit,proto3" json:"Limit,omitempty"`
+	// Language used to render the entries
+	Language string `protobuf:"bytes,5,opt,name=Language,proto3" json:"Language,omitempty"`
+}
+
+func (x *ActivityFeedRequest) Reset() {
+	*x = ActivityFeedRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cellsapi_broker_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ActivityFeedRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ActivityFeedRequest) ProtoMessage() {}
+
+func (x *ActivityFeedRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cellsapi_broker_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ActivityFeedRequest.ProtoReflect.Descriptor instead.
+func (*ActivityFeedRequest) Descriptor() ([]byte, []int) {
+	return file_cellsapi_broker_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *ActivityFeedRequest) GetFormat() string {
+	if x != nil {
+		return x.Format
+	}
+	return ""
+}
+
+func (x *ActivityFeedRequest) GetNodeId() string {
+	if x != nil {
+		return x.NodeId
+	}
+	return ""
+}
+
+func (x *ActivityFeedRequest) GetWorkspaceSlug() string {
+	if x != nil {
+		return x.WorkspaceSlug
+	}
+	return ""
+}
+
+func (x *ActivityFeedRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *ActivityFeedRequest) GetLanguage() string {
+	if x != nil {
+		return x.Language
+	}
+	return ""
+}
+
+// Request for a read-only token restricted to the activity feeds
+type ActivityFeedTokenRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Label displayed in the personal access tokens list
+	Label string `protobuf:"bytes,1,opt,name=Label,proto3" json:"Label,omitempty"`
+}
+
+func (x *ActivityFeedTokenRequest) Reset() {
+	*x = ActivityFeedTokenRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cellsapi_broker_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ActivityFeedTokenRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ActivityFeedTokenRequest) ProtoMessage() {}
+
+func (x *ActivityFeedTokenRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cellsapi_broker_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ActivityFeedTokenRequest.ProtoReflect.Descriptor instead.
+func (*ActivityFeedTokenRequest) Descriptor() ([]byte, []int) {
+	return file_cellsapi_broker_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *ActivityFeedTokenRequest) GetLabel() string {
+	if x != nil {
+		return x.Label
+	}
+	return ""
+}
+
+// Token to be passed as token query parameter of the feed URL
+type ActivityFeedTokenResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	AccessToken string `protobuf:"bytes,1,opt,name=AccessToken,proto3" json:"AccessToken,omitempty"`
+	// Token expiration as unix timestamp
+	ExpiresAt int64 `protobuf:"varint,2,opt,name=ExpiresAt,proto3" json:"ExpiresAt,omitempty"`
+}
+
+func (x *ActivityFeedTokenResponse) Reset() {
+	*x = ActivityFeedTokenResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cellsapi_broker_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ActivityFeedTokenResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ActivityFeedTokenResponse) ProtoMessage() {}
+
+func (x *ActivityFeedTokenResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cellsapi_broker_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ActivityFeedTokenResponse.ProtoReflect.Descriptor instead.
+func (*ActivityFeedTokenResponse) Descriptor() ([]byte, []int) {
+	return file_cellsapi_broker_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *ActivityFeedTokenResponse) GetAccessToken() string {
+	if x != nil {
+		return x.AccessToken
+	}
+	return ""
+}
+
+func (x *ActivityFeedTokenResponse) GetExpiresAt() int64 {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return 0
+}
+
+// Request for listing chat rooms attached to an object
+type ChatRoomsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Type of object the rooms are attached to
+	Type chat.RoomType `protobuf:"varint,1,opt,name=Type,proto3,enum=chat.RoomType" json:"Type,omitempty"`
+	// Object identifier, e.g. a node Uuid for NODE rooms
+	TypeObject string `protobuf:"bytes,2,opt,name=TypeObject,proto3" json:"TypeObject,omitempty"`
+}
+
+func (x *ChatRoomsRequest) Reset() {
+	*x = ChatRoomsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cellsapi_broker_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ChatRoomsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChatRoomsRequest) ProtoMessage() {}
+
+func (x *ChatRoomsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cellsapi_broker_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChatRoomsRequest.ProtoReflect.Descriptor instead.
+func (*ChatRoomsRequest) Descriptor() ([]byte, []int) {
+	return file_cellsapi_broker_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *ChatRoomsRequest) GetType() chat.RoomType {
+	if x != nil {
+		return x.Type
+	}
+	return chat.RoomType(0)
+}
+
+func (x *ChatRoomsRequest) GetTypeObject() string {
+	if x != nil {
+		return x.TypeObject
+	}
+	return ""
+}
+
+// Collection of chat rooms
+type ChatRoomsCollection struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Rooms []*chat.ChatRoom `protobuf:"bytes,1,rep,name=Rooms,proto3" json:"Rooms,omitempty"`
+}
+
+func (x *ChatRoomsCollection) Reset() {
+	*x = ChatRoomsCollection{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cellsapi_broker_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ChatRoomsCollection) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChatRoomsCollection) ProtoMessage() {}
+
+func (x *ChatRoomsCollection) ProtoReflect() protoreflect.Message {
+	mi := &file_cellsapi_broker_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChatRoomsCollection.ProtoReflect.Descriptor instead.
+func (*ChatRoomsCollection) Descriptor() ([]byte, []int) {
+	return file_cellsapi_broker_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *ChatRoomsCollection) GetRooms() []*chat.ChatRoom {
+	if x != nil {
+		return x.Rooms
+	}
+	return nil
+}
+
+// Request for listing messages of a chat room
+type ChatMessagesRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Room Uuid
+	RoomUuid string `protobuf:"bytes,1,opt,name=RoomUuid,proto3" json:"RoomUuid,omitempty"`
+	// Restrict to replies to a given message
+	ParentUuid string `protobuf:"bytes,2,opt,name=ParentUuid,proto3" json:"ParentUuid,omitempty"`
+	// Skip the N most recent messages
+	Offset int64 `protobuf:"varint,3,opt,name=Offset,proto3" json:"Offset,omitempty"`
+	// Maximum number of messages, defaults to 50
+	Limit int64 `protobuf:"varint,4,opt,name=Limit,proto3" json:"Limit,omitempty"`
+	// Only messages posted at or after this unix timestamp
+	Since int64 `protobuf:"varint,5,opt,name=Since,proto3" json:"Since,omitempty"`
+	// Only messages posted at or before this unix timestamp
+	Until int64 `protobuf:"varint,6,opt,name=Until,proto3" json:"Until,omitempty"`
+}
+
+func (x *ChatMessagesRequest) Reset() {
+	*x = ChatMessagesRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cellsapi_broker_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ChatMessagesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChatMessagesRequest) ProtoMessage() {}
+
+func (x *ChatMessagesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cellsapi_broker_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChatMessagesRequest.ProtoReflect.Descriptor instead.
+func (*ChatMessagesRequest) Descriptor() ([]byte, []int) {
+	return file_cellsapi_broker_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *ChatMessagesRequest) GetRoomUuid() string {
+	if x != nil {
+		return x.RoomUuid
+	}
+	return ""
+}
+
+func (x *ChatMessagesRequest) GetParentUuid() string {
+	if x != nil {
+		return x.ParentUuid
+	}
+	return ""
+}
+
+func (x *ChatMessagesRequest) GetOffset() int64 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+func (x *ChatMessagesRequest) GetLimit() int64 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *ChatMessagesRequest) GetSince() int64 {
+	if x != nil {
+		return x.Since
+	}
+	return 0
+}
+
+func (x *ChatMessagesRequest) GetUntil() int64 {
+	if x != nil {
+		return x.Until
+	}
+	return 0
+}
+
+// Collection of chat messages, in chronological order
+type ChatMessagesCollection struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Messages []*chat.ChatMessage `protobuf:"bytes,1,rep,name=Messages,proto3" json:"Messages,omitempty"`
+	// Older messages are available with a bigger offset
+	HasMore bool `protobuf:"varint,2,opt,name=HasMore,proto3" json:"HasMore,omitempty"`
+}
+
+func (x *ChatMessagesCollection) Reset() {
+	*x = ChatMessagesCollection{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cellsapi_broker_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ChatMessagesCollection) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChatMessagesCollection) ProtoMessage() {}
+
+func (x *ChatMessagesCollection) ProtoReflect() protoreflect.Message {
+	mi := &file_cellsapi_broker_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChatMessagesCollection.ProtoReflect.Descriptor instead.
+func (*ChatMessagesCollection) Descriptor() ([]byte, []int) {
+	return file_cellsapi_broker_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *ChatMessagesCollection) GetMessages() []*chat.ChatMessage {
+	if x != nil {
+		return x.Messages
+	}
+	return nil
+}
+
+func (x *ChatMessagesCollection) GetHasMore() bool {
+	if x != nil {
+		return x.HasMore
+	}
+	return false
+}
+
+// Request for deleting a chat message
+type ChatDeleteMessageRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Room Uuid
+	RoomUuid string `protobuf:"bytes,1,opt,name=RoomUuid,proto3" json:"RoomUuid,omitempty"`
+	// Message Uuid
+	Uuid string `protobuf:"bytes,2,opt,name=Uuid,proto3" json:"Uuid,omitempty"`
+}
+
+func (x *ChatDeleteMessageRequest) Reset() {
+	*x = ChatDeleteMessageRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cellsapi_broker_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ChatDeleteMessageRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChatDeleteMessageRequest) ProtoMessage() {}
+
+func (x *ChatDeleteMessageRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cellsapi_broker_proto_msgTypes[9]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChatDeleteMessageRequest.ProtoReflect.Descriptor instead.
+func (*ChatDeleteMessageRequest) Descriptor() ([]byte, []int) {
+	return file_cellsapi_broker_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *ChatDeleteMessageRequest) GetRoomUuid() string {
+	if x != nil {
+		return x.RoomUuid
+	}
+	return ""
+}
+
+func (x *ChatDeleteMessageRequest) GetUuid() string {
+	if x != nil {
+		return x.Uuid
+	}
+	return ""
+}
+
+// Delivery status notification to process
+type MailBounceRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Raw message (RFC 3464 multipart/report) as received by the bounce mailbox
+	Message string `protobuf:"bytes,1,opt,name=Message,proto3" json:"Message,omitempty"`
+}
+
+func (x *MailBounceRequest) Reset() {
+	*x = MailBounceRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cellsapi_broker_proto_msgTypes[10]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MailBounceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MailBounceRequest) ProtoMessage() {}
+
+func (x *MailBounceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cellsapi_broker_proto_msgTypes[10]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MailBounceRequest.ProtoReflect.Descriptor instead.
+func (*MailBounceRequest) Descriptor() ([]byte, []int) {
+	return file_cellsapi_broker_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *MailBounceRequest) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+// Per-recipient result of a bounce processing
+type MailBounce struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Recipient address
+	Recipient string `protobuf:"bytes,1,opt,name=Recipient,proto3" json:"Recipient,omitempty"`
+	// Reported action (failed, delayed, delivered...)
+	Action string `protobuf:"bytes,2,opt,name=Action,proto3" json:"Action,omitempty"`
+	// Reported status code (e.g. 5.1.1)
+	Status string `protobuf:"bytes,3,opt,name=Status,proto3" json:"Status,omitempty"`
+	// Diagnostic message of the remote server
+	Diagnostic string `protobuf:"bytes,4,opt,name=Diagnostic,proto3" json:"Diagnostic,omitempty"`
+	// Logins of the users whose email address was flagged as invalid
+	Users []string `protobuf:"bytes,5,rep,name=Users,proto3" json:"Users,omitempty"`
+}
+
+func (x *MailBounce) Reset() {
+	*x = MailBounce{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cellsapi_broker_proto_msgTypes[11]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MailBounce) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MailBounce) ProtoMessage() {}
+
+func (x *MailBounce) ProtoReflect() protoreflect.Message {
+	mi := &file_cellsapi_broker_proto_msgTypes[11]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MailBounce.ProtoReflect.Descriptor instead.
+func (*MailBounce) Descriptor() ([]byte, []int) {
+	return file_cellsapi_broker_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *MailBounce) GetRecipient() string {
+	if x != nil {
+		return x.Recipient
+	}
+	return ""
+}
+
+func (x *MailBounce) GetAction() string {
+	if x != nil {
+		return x.Action
+	}
+	return ""
+}
+
+func (x *MailBounce) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *MailBounce) GetDiagnostic() string {
+	if x != nil {
+		return x.Diagnostic
+	}
+	return ""
+}
+
+func (x *MailBounce) GetUsers() []string {
+	if x != nil {
+		return x.Users
+	}
+	return nil
+}
+
+// Result of a bounce processing
+type MailBounceResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Bounces []*MailBounce `protobuf:"bytes,1,rep,name=Bounces,proto3" json:"Bounces,omitempty"`
+}
+
+func (x *MailBounceResponse) Reset() {
+	*x = MailBounceResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cellsapi_broker_proto_msgTypes[12]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MailBounceResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MailBounceResponse) ProtoMessage() {}
+
+func (x *MailBounceResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cellsapi_broker_proto_msgTypes[12]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MailBounceResponse.ProtoReflect.Descriptor instead.
+func (*MailBounceResponse) Descriptor() ([]byte, []int) {
+	return file_cellsapi_broker_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *MailBounceResponse) GetBounces() []*MailBounce {
+	if x != nil {
+		return x.Bounces
+	}
+	return nil
+}
+
 // Collection of serialized log messages
 type LogCollection struct {
 	state         protoimpl.MessageState
@@ -129,7 +818,7 @@ type LogCollection struct {
 func (x *LogCollection) Reset() {
 	*x = LogCollection{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_cellsapi_broker_proto_msgTypes[2]
+		mi := &file_cellsapi_broker_proto_msgTypes[13]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -142,7 +831,7 @@ func (x *LogCollection) String() string {
 func (*LogCollection) ProtoMessage() {}
 
 func (x *LogCollection) ProtoReflect() protoreflect.Message {
-	mi := &file_cellsapi_broker_proto_msgTypes[2]
+	mi := &file_cellsapi_broker_proto_msgTypes[13]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -155,7 +844,7 @@ func (x *LogCollection) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use LogCollection.ProtoReflect.Descriptor instead.
 func (*LogCollection) Descriptor() ([]byte, []int) {
-	return file_cellsapi_broker_proto_rawDescGZIP(), []int{2}
+	return file_cellsapi_broker_proto_rawDescGZIP(), []int{13}
 }
 
 func (x *LogCollection) GetLines() []*log.Log {
@@ -177,7 +866,7 @@ type LogMessageCollection struct {
 func (x *LogMessageCollection) Reset() {
 	*x = LogMessageCollection{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_cellsapi_broker_proto_msgTypes[3]
+		mi := &file_cellsapi_broker_proto_msgTypes[14]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -190,7 +879,7 @@ func (x *LogMessageCollection) String() string {
 func (*LogMessageCollection) ProtoMessage() {}
 
 func (x *LogMessageCollection) ProtoReflect() protoreflect.Message {
-	mi := &file_cellsapi_broker_proto_msgTypes[3]
+	mi := &file_cellsapi_broker_proto_msgTypes[14]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -203,7 +892,7 @@ func (x *LogMessageCollection) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use LogMessageCollection.ProtoReflect.Descriptor instead.
 func (*LogMessageCollection) Descriptor() ([]byte, []int) {
-	return file_cellsapi_broker_proto_rawDescGZIP(), []int{3}
+	return file_cellsapi_broker_proto_rawDescGZIP(), []int{14}
 }
 
 func (x *LogMessageCollection) GetLogs() []*log.LogMessage {
@@ -227,7 +916,7 @@ type TimeRangeResultCollection struct {
 func (x *TimeRangeResultCollection) Reset() {
 	*x = TimeRangeResultCollection{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_cellsapi_broker_proto_msgTypes[4]
+		mi := &file_cellsapi_broker_proto_msgTypes[15]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -240,7 +929,7 @@ func (x *TimeRangeResultCollection) String() string {
 func (*TimeRangeResultCollection) ProtoMessage() {}
 
 func (x *TimeRangeResultCollection) ProtoReflect() protoreflect.Message {
-	mi := &file_cellsapi_broker_proto_msgTypes[4]
+	mi := &file_cellsapi_broker_proto_msgTypes[15]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -253,7 +942,7 @@ func (x *TimeRangeResultCollection) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use TimeRangeResultCollection.ProtoReflect.Descriptor instead.
 func (*TimeRangeResultCollection) Descriptor() ([]byte, []int) {
-	return file_cellsapi_broker_proto_rawDescGZIP(), []int{4}
+	return file_cellsapi_broker_proto_rawDescGZIP(), []int{15}
 }
 
 func (x *TimeRangeResultCollection) GetResults() []*log.TimeRangeResult {
@@ -277,35 +966,103 @@ var file_cellsapi_broker_proto_rawDesc = []byte{
 	0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x04, 0x72, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x63,
 	0x65, 0x6c, 0x6c, 0x73, 0x2d, 0x61, 0x63, 0x74, 0x69, 0x76, 0x69, 0x74, 0x79, 0x73, 0x74, 0x72,
 	0x65, 0x61, 0x6d, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x0f, 0x63, 0x65, 0x6c, 0x6c, 0x73,
-	0x2d, 0x6c, 0x6f, 0x67, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x48, 0x0a, 0x14, 0x41, 0x63,
-	0x74, 0x69, 0x76, 0x69, 0x74, 0x69, 0x65, 0x73, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69,
-	0x6f, 0x6e, 0x12, 0x30, 0x0a, 0x0a, 0x61, 0x63, 0x74, 0x69, 0x76, 0x69, 0x74, 0x69, 0x65, 0x73,
-	0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x61, 0x63, 0x74, 0x69, 0x76, 0x69, 0x74,
-	0x79, 0x2e, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x0a, 0x61, 0x63, 0x74, 0x69, 0x76, 0x69,
-	0x74, 0x69, 0x65, 0x73, 0x22, 0x57, 0x0a, 0x17, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70,
-	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12,
-	0x3c, 0x0a, 0x0d, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73,
-	0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x61, 0x63, 0x74, 0x69, 0x76, 0x69, 0x74,
-	0x79, 0x2e, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0d,
-	0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0x2f, 0x0a,
-	0x0d, 0x4c, 0x6f, 0x67, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1e,
-	0x0a, 0x05, 0x6c, 0x69, 0x6e, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x08, 0x2e,
-	0x6c, 0x6f, 0x67, 0x2e, 0x4c, 0x6f, 0x67, 0x52, 0x05, 0x6c, 0x69, 0x6e, 0x65, 0x73, 0x22, 0x3b,
-	0x0a, 0x14, 0x4c, 0x6f, 0x67, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x43, 0x6f, 0x6c, 0x6c,
-	0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x23, 0x0a, 0x04, 0x4c, 0x6f, 0x67, 0x73, 0x18, 0x01,
-	0x20, 0x03, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x6c, 0x6f, 0x67, 0x2e, 0x4c, 0x6f, 0x67, 0x4d, 0x65,
-	0x73, 0x73, 0x61, 0x67, 0x65, 0x52, 0x04, 0x4c, 0x6f, 0x67, 0x73, 0x22, 0x77, 0x0a, 0x19, 0x54,
-	0x69, 0x6d, 0x65, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x43, 0x6f,
-	0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x2e, 0x0a, 0x07, 0x52, 0x65, 0x73, 0x75,
-	0x6c, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x6c, 0x6f, 0x67, 0x2e,
-	0x54, 0x69, 0x6d, 0x65, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x52,
-	0x07, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x12, 0x2a, 0x0a, 0x05, 0x4c, 0x69, 0x6e, 0x6b,
-	0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x6c, 0x6f, 0x67, 0x2e, 0x54, 0x69,
-	0x6d, 0x65, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x43, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x52, 0x05, 0x4c,
-	0x69, 0x6e, 0x6b, 0x73, 0x42, 0x2d, 0x5a, 0x2b, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63,
-	0x6f, 0x6d, 0x2f, 0x70, 0x79, 0x64, 0x69, 0x6f, 0x2f, 0x63, 0x65, 0x6c, 0x6c, 0x73, 0x2f, 0x76,
-	0x35, 0x2f, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x72,
-	0x65, 0x73, 0x74, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x2d, 0x6c, 0x6f, 0x67, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x10, 0x63, 0x65, 0x6c, 0x6c,
+	0x73, 0x2d, 0x63, 0x68, 0x61, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x48, 0x0a, 0x14,
+	0x41, 0x63, 0x74, 0x69, 0x76, 0x69, 0x74, 0x69, 0x65, 0x73, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x30, 0x0a, 0x0a, 0x61, 0x63, 0x74, 0x69, 0x76, 0x69, 0x74, 0x69,
+	0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x61, 0x63, 0x74, 0x69, 0x76,
+	0x69, 0x74, 0x79, 0x2e, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x0a, 0x61, 0x63, 0x74, 0x69,
+	0x76, 0x69, 0x74, 0x69, 0x65, 0x73, 0x22, 0x57, 0x0a, 0x17, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72,
+	0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x12, 0x3c, 0x0a, 0x0d, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f,
+	0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x61, 0x63, 0x74, 0x69, 0x76,
+	0x69, 0x74, 0x79, 0x2e, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e,
+	0x52, 0x0d, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22,
+	0x9d, 0x01, 0x0a, 0x13, 0x41, 0x63, 0x74, 0x69, 0x76, 0x69, 0x74, 0x79, 0x46, 0x65, 0x65, 0x64,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x46, 0x6f, 0x72, 0x6d, 0x61,
+	0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x46, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x12,
+	0x16, 0x0a, 0x06, 0x4e, 0x6f, 0x64, 0x65, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x06, 0x4e, 0x6f, 0x64, 0x65, 0x49, 0x64, 0x12, 0x24, 0x0a, 0x0d, 0x57, 0x6f, 0x72, 0x6b, 0x73,
+	0x70, 0x61, 0x63, 0x65, 0x53, 0x6c, 0x75, 0x67, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d,
+	0x57, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x53, 0x6c, 0x75, 0x67, 0x12, 0x14, 0x0a,
+	0x05, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x4c, 0x69,
+	0x6d, 0x69, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x4c, 0x61, 0x6e, 0x67, 0x75, 0x61, 0x67, 0x65, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x4c, 0x61, 0x6e, 0x67, 0x75, 0x61, 0x67, 0x65, 0x22,
+	0x30, 0x0a, 0x18, 0x41, 0x63, 0x74, 0x69, 0x76, 0x69, 0x74, 0x79, 0x46, 0x65, 0x65, 0x64, 0x54,
+	0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x4c,
+	0x61, 0x62, 0x65, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x4c, 0x61, 0x62, 0x65,
+	0x6c, 0x22, 0x5b, 0x0a, 0x19, 0x41, 0x63, 0x74, 0x69, 0x76, 0x69, 0x74, 0x79, 0x46, 0x65, 0x65,
+	0x64, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x20,
+	0x0a, 0x0b, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0b, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x54, 0x6f, 0x6b, 0x65, 0x6e,
+	0x12, 0x1c, 0x0a, 0x09, 0x45, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x41, 0x74, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x09, 0x45, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x41, 0x74, 0x22, 0x56,
+	0x0a, 0x10, 0x43, 0x68, 0x61, 0x74, 0x52, 0x6f, 0x6f, 0x6d, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x22, 0x0a, 0x04, 0x54, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e,
+	0x32, 0x0e, 0x2e, 0x63, 0x68, 0x61, 0x74, 0x2e, 0x52, 0x6f, 0x6f, 0x6d, 0x54, 0x79, 0x70, 0x65,
+	0x52, 0x04, 0x54, 0x79, 0x70, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x54, 0x79, 0x70, 0x65, 0x4f, 0x62,
+	0x6a, 0x65, 0x63, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x54, 0x79, 0x70, 0x65,
+	0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x22, 0x3b, 0x0a, 0x13, 0x43, 0x68, 0x61, 0x74, 0x52, 0x6f,
+	0x6f, 0x6d, 0x73, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x24, 0x0a,
+	0x05, 0x52, 0x6f, 0x6f, 0x6d, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x63,
+	0x68, 0x61, 0x74, 0x2e, 0x43, 0x68, 0x61, 0x74, 0x52, 0x6f, 0x6f, 0x6d, 0x52, 0x05, 0x52, 0x6f,
+	0x6f, 0x6d, 0x73, 0x22, 0xab, 0x01, 0x0a, 0x13, 0x43, 0x68, 0x61, 0x74, 0x4d, 0x65, 0x73, 0x73,
+	0x61, 0x67, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x52,
+	0x6f, 0x6f, 0x6d, 0x55, 0x75, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x52,
+	0x6f, 0x6f, 0x6d, 0x55, 0x75, 0x69, 0x64, 0x12, 0x1e, 0x0a, 0x0a, 0x50, 0x61, 0x72, 0x65, 0x6e,
+	0x74, 0x55, 0x75, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x50, 0x61, 0x72,
+	0x65, 0x6e, 0x74, 0x55, 0x75, 0x69, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x4f, 0x66, 0x66, 0x73, 0x65,
+	0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x4f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x12,
+	0x14, 0x0a, 0x05, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05,
+	0x4c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x53, 0x69, 0x6e, 0x63, 0x65, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x53, 0x69, 0x6e, 0x63, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x55,
+	0x6e, 0x74, 0x69, 0x6c, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x55, 0x6e, 0x74, 0x69,
+	0x6c, 0x22, 0x61, 0x0a, 0x16, 0x43, 0x68, 0x61, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
+	0x73, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x2d, 0x0a, 0x08, 0x4d,
+	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x11, 0x2e,
+	0x63, 0x68, 0x61, 0x74, 0x2e, 0x43, 0x68, 0x61, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
+	0x52, 0x08, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x48, 0x61,
+	0x73, 0x4d, 0x6f, 0x72, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x48, 0x61, 0x73,
+	0x4d, 0x6f, 0x72, 0x65, 0x22, 0x4a, 0x0a, 0x18, 0x43, 0x68, 0x61, 0x74, 0x44, 0x65, 0x6c, 0x65,
+	0x74, 0x65, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x1a, 0x0a, 0x08, 0x52, 0x6f, 0x6f, 0x6d, 0x55, 0x75, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x08, 0x52, 0x6f, 0x6f, 0x6d, 0x55, 0x75, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04,
+	0x55, 0x75, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x55, 0x75, 0x69, 0x64,
+	0x22, 0x2d, 0x0a, 0x11, 0x4d, 0x61, 0x69, 0x6c, 0x42, 0x6f, 0x75, 0x6e, 0x63, 0x65, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22,
+	0x90, 0x01, 0x0a, 0x0a, 0x4d, 0x61, 0x69, 0x6c, 0x42, 0x6f, 0x75, 0x6e, 0x63, 0x65, 0x12, 0x1c,
+	0x0a, 0x09, 0x52, 0x65, 0x63, 0x69, 0x70, 0x69, 0x65, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x09, 0x52, 0x65, 0x63, 0x69, 0x70, 0x69, 0x65, 0x6e, 0x74, 0x12, 0x16, 0x0a, 0x06,
+	0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x41, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x1e, 0x0a, 0x0a,
+	0x44, 0x69, 0x61, 0x67, 0x6e, 0x6f, 0x73, 0x74, 0x69, 0x63, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0a, 0x44, 0x69, 0x61, 0x67, 0x6e, 0x6f, 0x73, 0x74, 0x69, 0x63, 0x12, 0x14, 0x0a, 0x05,
+	0x55, 0x73, 0x65, 0x72, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x09, 0x52, 0x05, 0x55, 0x73, 0x65,
+	0x72, 0x73, 0x22, 0x40, 0x0a, 0x12, 0x4d, 0x61, 0x69, 0x6c, 0x42, 0x6f, 0x75, 0x6e, 0x63, 0x65,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2a, 0x0a, 0x07, 0x42, 0x6f, 0x75, 0x6e,
+	0x63, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x72, 0x65, 0x73, 0x74,
+	0x2e, 0x4d, 0x61, 0x69, 0x6c, 0x42, 0x6f, 0x75, 0x6e, 0x63, 0x65, 0x52, 0x07, 0x42, 0x6f, 0x75,
+	0x6e, 0x63, 0x65, 0x73, 0x22, 0x2f, 0x0a, 0x0d, 0x4c, 0x6f, 0x67, 0x43, 0x6f, 0x6c, 0x6c, 0x65,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1e, 0x0a, 0x05, 0x6c, 0x69, 0x6e, 0x65, 0x73, 0x18, 0x01,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x08, 0x2e, 0x6c, 0x6f, 0x67, 0x2e, 0x4c, 0x6f, 0x67, 0x52, 0x05,
+	0x6c, 0x69, 0x6e, 0x65, 0x73, 0x22, 0x3b, 0x0a, 0x14, 0x4c, 0x6f, 0x67, 0x4d, 0x65, 0x73, 0x73,
+	0x61, 0x67, 0x65, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x23, 0x0a,
+	0x04, 0x4c, 0x6f, 0x67, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x6c, 0x6f,
+	0x67, 0x2e, 0x4c, 0x6f, 0x67, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x52, 0x04, 0x4c, 0x6f,
+	0x67, 0x73, 0x22, 0x77, 0x0a, 0x19, 0x54, 0x69, 0x6d, 0x65, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x52,
+	0x65, 0x73, 0x75, 0x6c, 0x74, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12,
+	0x2e, 0x0a, 0x07, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x14, 0x2e, 0x6c, 0x6f, 0x67, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x52, 0x61, 0x6e, 0x67, 0x65,
+	0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x07, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x12,
+	0x2a, 0x0a, 0x05, 0x4c, 0x69, 0x6e, 0x6b, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14,
+	0x2e, 0x6c, 0x6f, 0x67, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x43, 0x75,
+	0x72, 0x73, 0x6f, 0x72, 0x52, 0x05, 0x4c, 0x69, 0x6e, 0x6b, 0x73, 0x42, 0x2d, 0x5a, 0x2b, 0x67,
+	0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x70, 0x79, 0x64, 0x69, 0x6f, 0x2f,
+	0x63, 0x65, 0x6c, 0x6c, 0x73, 0x2f, 0x76, 0x35, 0x2f, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2f,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x72, 0x65, 0x73, 0x74, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x33,
 }
 
 var (
@@ -320,32 +1077,50 @@ func file_cellsapi_broker_proto_rawDescGZIP() []byte {
 	return file_cellsapi_broker_proto_rawDescData
 }
 
-var file_cellsapi_broker_proto_msgTypes = make([]protoimpl.MessageInfo, 5)
+var file_cellsapi_broker_proto_msgTypes = make([]protoimpl.MessageInfo, 16)
 var file_cellsapi_broker_proto_goTypes = []any{
 	(*ActivitiesCollection)(nil),      // 0: rest.ActivitiesCollection
 	(*SubscriptionsCollection)(nil),   // 1: rest.SubscriptionsCollection
-	(*LogCollection)(nil),             // 2: rest.LogCollection
-	(*LogMessageCollection)(nil),      // 3: rest.LogMessageCollection
-	(*TimeRangeResultCollection)(nil), // 4: rest.TimeRangeResultCollection
-	(*activity.Object)(nil),           // 5: activity.Object
-	(*activity.Subscription)(nil),     // 6: activity.Subscription
-	(*log.Log)(nil),                   // 7: log.Log
-	(*log.LogMessage)(nil),            // 8: log.LogMessage
-	(*log.TimeRangeResult)(nil),       // 9: log.TimeRangeResult
-	(*log.TimeRangeCursor)(nil),       // 10: log.TimeRangeCursor
+	(*ActivityFeedRequest)(nil),       // 2: rest.ActivityFeedRequest
+	(*ActivityFeedTokenRequest)(nil),  // 3: rest.ActivityFeedTokenRequest
+	(*ActivityFeedTokenResponse)(nil), // 4: rest.ActivityFeedTokenResponse
+	(*ChatRoomsRequest)(nil),          // 5: rest.ChatRoomsRequest
+	(*ChatRoomsCollection)(nil),       // 6: rest.ChatRoomsCollection
+	(*ChatMessagesRequest)(nil),       // 7: rest.ChatMessagesRequest
+	(*ChatMessagesCollection)(nil),    // 8: rest.ChatMessagesCollection
+	(*ChatDeleteMessageRequest)(nil),  // 9: rest.ChatDeleteMessageRequest
+	(*MailBounceRequest)(nil),         // 10: rest.MailBounceRequest
+	(*MailBounce)(nil),                // 11: rest.MailBounce
+	(*MailBounceResponse)(nil),        // 12: rest.MailBounceResponse
+	(*LogCollection)(nil),             // 13: rest.LogCollection
+	(*LogMessageCollection)(nil),      // 14: rest.LogMessageCollection
+	(*TimeRangeResultCollection)(nil), // 15: rest.TimeRangeResultCollection
+	(*activity.Object)(nil),           // 16: activity.Object
+	(*activity.Subscription)(nil),     // 17: activity.Subscription
+	(chat.RoomType)(0),                // 18: chat.RoomType
+	(*chat.ChatRoom)(nil),             // 19: chat.ChatRoom
+	(*chat.ChatMessage)(nil),          // 20: chat.ChatMessage
+	(*log.Log)(nil),                   // 21: log.Log
+	(*log.LogMessage)(nil),            // 22: log.LogMessage
+	(*log.TimeRangeResult)(nil),       // 23: log.TimeRangeResult
+	(*log.TimeRangeCursor)(nil),       // 24: log.TimeRangeCursor
 }
 var file_cellsapi_broker_proto_depIdxs = []int32{
-	5,  // 0: rest.ActivitiesCollection.activities:type_name -> activity.Object
-	6,  // 1: rest.SubscriptionsCollection.subscriptions:type_name -> activity.Subscription
-	7,  // 2: rest.LogCollection.lines:type_name -> log.Log
-	8,  // 3: rest.LogMessageCollection.Logs:type_name -> log.LogMessage
-	9,  // 4: rest.TimeRangeResultCollection.Results:type_name -> log.TimeRangeResult
-	10, // 5: rest.TimeRangeResultCollection.Links:type_name -> log.TimeRangeCursor
-	6,  // [6:6] is the sub-list for method output_type
-	6,  // [6:6] is the sub-list for method input_type
-	6,  // [6:6] is the sub-list for extension type_name
-	6,  // [6:6] is the sub-list for extension extendee
-	0,  // [0:6] is the sub-list for field type_name
+	16, // 0: rest.ActivitiesCollection.activities:type_name -> activity.Object
+	17, // 1: rest.SubscriptionsCollection.subscriptions:type_name -> activity.Subscription
+	18, // 2: rest.ChatRoomsRequest.Type:type_name -> chat.RoomType
+	19, // 3: rest.ChatRoomsCollection.Rooms:type_name -> chat.ChatRoom
+	20, // 4: rest.ChatMessagesCollection.Messages:type_name -> chat.ChatMessage
+	11, // 5: rest.MailBounceResponse.Bounces:type_name -> rest.MailBounce
+	21, // 6: rest.LogCollection.lines:type_name -> log.Log
+	22, // 7: rest.LogMessageCollection.Logs:type_name -> log.LogMessage
+	23, // 8: rest.TimeRangeResultCollection.Results:type_name -> log.TimeRangeResult
+	24, // 9: rest.TimeRangeResultCollection.Links:type_name -> log.TimeRangeCursor
+	10, // [10:10] is the sub-list for method output_type
+	10, // [10:10] is the sub-list for method input_type
+	10, // [10:10] is the sub-list for extension type_name
+	10, // [10:10] is the sub-list for extension extendee
+	0,  // [0:10] is the sub-list for field type_name
 }
 
 func init() { file_cellsapi_broker_proto_init() }
@@ -379,7 +1154,7 @@ func file_cellsapi_broker_proto_init() {
 			}
 		}
 		file_cellsapi_broker_proto_msgTypes[2].Exporter = func(v any, i int) any {
-			switch v := v.(*LogCollection); i {
+			switch v := v.(*ActivityFeedRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -391,7 +1166,7 @@ func file_cellsapi_broker_proto_init() {
 			}
 		}
 		file_cellsapi_broker_proto_msgTypes[3].Exporter = func(v any, i int) any {
-			switch v := v.(*LogMessageCollection); i {
+			switch v := v.(*ActivityFeedTokenRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -403,6 +1178,138 @@ func file_cellsapi_broker_proto_init() {
 			}
 		}
 		file_cellsapi_broker_proto_msgTypes[4].Exporter = func(v any, i int) any {
+			switch v := v.(*ActivityFeedTokenResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cellsapi_broker_proto_msgTypes[5].Exporter = func(v any, i int) any {
+			switch v := v.(*ChatRoomsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cellsapi_broker_proto_msgTypes[6].Exporter = func(v any, i int) any {
+			switch v := v.(*ChatRoomsCollection); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cellsapi_broker_proto_msgTypes[7].Exporter = func(v any, i int) any {
+			switch v := v.(*ChatMessagesRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cellsapi_broker_proto_msgTypes[8].Exporter = func(v any, i int) any {
+			switch v := v.(*ChatMessagesCollection); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cellsapi_broker_proto_msgTypes[9].Exporter = func(v any, i int) any {
+			switch v := v.(*ChatDeleteMessageRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cellsapi_broker_proto_msgTypes[10].Exporter = func(v any, i int) any {
+			switch v := v.(*MailBounceRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cellsapi_broker_proto_msgTypes[11].Exporter = func(v any, i int) any {
+			switch v := v.(*MailBounce); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cellsapi_broker_proto_msgTypes[12].Exporter = func(v any, i int) any {
+			switch v := v.(*MailBounceResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cellsapi_broker_proto_msgTypes[13].Exporter = func(v any, i int) any {
+			switch v := v.(*LogCollection); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cellsapi_broker_proto_msgTypes[14].Exporter = func(v any, i int) any {
+			switch v := v.(*LogMessageCollection); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cellsapi_broker_proto_msgTypes[15].Exporter = func(v any, i int) any {
 			switch v := v.(*TimeRangeResultCollection); i {
 			case 0:
 				return &v.state
@@ -421,7 +1328,7 @@ func file_cellsapi_broker_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_cellsapi_broker_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   5,
+			NumMessages:   16,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
@@ -9,6 +9,7 @@ package rest
 import (
 	idm "github.com/pydio/cells/v5/common/proto/idm"
 	service "github.com/pydio/cells/v5/common/proto/service"
+	tree "github.com/pydio/cells/v5/common/proto/tree"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
 	reflect "reflect"
@@ -22,6 +23,62 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+// Status of a privilege elevation
+type ElevationStatus int32
+
+const (
+	ElevationStatus_ElevationPending  ElevationStatus = 0
+	ElevationStatus_ElevationActive   ElevationStatus = 1
+	ElevationStatus_ElevationRejected ElevationStatus = 2
+	ElevationStatus_ElevationExpired  ElevationStatus = 3
+	ElevationStatus_ElevationRevoked  ElevationStatus = 4
+)
+
+// Enum value maps for ElevationStatus.
+var (
+	ElevationStatus_name = map[int32]string{
+		0: "ElevationPending",
+		1: "ElevationActive",
+		2: "ElevationRejected",
+		3: "ElevationExpired",
+		4: "ElevationRevoked",
+	}
+	ElevationStatus_value = map[string]int32{
+		"ElevationPending":  0,
+		"ElevationActive":   1,
+		"ElevationRejected": 2,
+		"ElevationExpired":  3,
+		"ElevationRevoked":  4,
+	}
+)
+
+func (x ElevationStatus) Enum() *ElevationStatus {
+	p := new(ElevationStatus)
+	*p = x
+	return p
+}
+
+func (x ElevationStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ElevationStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_cellsapi_idm_proto_enumTypes[0].Descriptor()
+}
+
+func (ElevationStatus) Type() protoreflect.EnumType {
+	return &file_cellsapi_idm_proto_enumTypes[0]
+}
+
+func (x ElevationStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ElevationStatus.Descriptor instead.
+func (ElevationStatus) EnumDescriptor() ([]byte, []int) {
+	return file_cellsapi_idm_proto_rawDescGZIP(), []int{0}
+}
+
 type ResourcePolicyQuery_QueryType int32
 
 const (
@@ -58,11 +115,11 @@ func (x ResourcePolicyQuery_QueryType) String() string {
 }
 
 func (ResourcePolicyQuery_QueryType) Descriptor() protoreflect.EnumDescriptor {
-	return file_cellsapi_idm_proto_enumTypes[0].Descriptor()
+	return file_cellsapi_idm_proto_enumTypes[1].Descriptor()
 }
 
 func (ResourcePolicyQuery_QueryType) Type() protoreflect.EnumType {
-	return &file_cellsapi_idm_proto_enumTypes[0]
+	return &file_cellsapi_idm_proto_enumTypes[1]
 }
 
 func (x ResourcePolicyQuery_QueryType) Number() protoreflect.EnumNumber {
@@ -463,6 +520,103 @@ func (x *UsersCollection) GetTotal() int32 {
 	return 0
 }
 
+// Request to download a personal data export archive
+type UserDataExportRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Token received when the archive was built
+	Token string `protobuf:"bytes,1,opt,name=Token,proto3" json:"Token,omitempty"`
+}
+
+func (x *UserDataExportRequest) Reset() {
+	*x = UserDataExportRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cellsapi_idm_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UserDataExportRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UserDataExportRequest) ProtoMessage() {}
+
+func (x *UserDataExportRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cellsapi_idm_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UserDataExportRequest.ProtoReflect.Descriptor instead.
+func (*UserDataExportRequest) Descriptor() ([]byte, []int) {
+	return file_cellsapi_idm_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *UserDataExportRequest) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+// Not used, endpoint returns the zip archive as octet-stream
+type UserDataExportResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Data []byte `protobuf:"bytes,1,opt,name=Data,proto3" json:"Data,omitempty"`
+}
+
+func (x *UserDataExportResponse) Reset() {
+	*x = UserDataExportResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cellsapi_idm_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UserDataExportResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UserDataExportResponse) ProtoMessage() {}
+
+func (x *UserDataExportResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cellsapi_idm_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UserDataExportResponse.ProtoReflect.Descriptor instead.
+func (*UserDataExportResponse) Descriptor() ([]byte, []int) {
+	return file_cellsapi_idm_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *UserDataExportResponse) GetData() []byte {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
 // Binding Response
 type BindResponse struct {
 	state         protoimpl.MessageState
@@ -475,7 +629,7 @@ type BindResponse struct {
 func (x *BindResponse) Reset() {
 	*x = BindResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_cellsapi_idm_proto_msgTypes[5]
+		mi := &file_cellsapi_idm_proto_msgTypes[7]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -488,7 +642,7 @@ func (x *BindResponse) String() string {
 func (*BindResponse) ProtoMessage() {}
 
 func (x *BindResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_cellsapi_idm_proto_msgTypes[5]
+	mi := &file_cellsapi_idm_proto_msgTypes[7]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -501,7 +655,7 @@ func (x *BindResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use BindResponse.ProtoReflect.Descriptor instead.
 func (*BindResponse) Descriptor() ([]byte, []int) {
-	return file_cellsapi_idm_proto_rawDescGZIP(), []int{5}
+	return file_cellsapi_idm_proto_rawDescGZIP(), []int{7}
 }
 
 func (x *BindResponse) GetSuccess() bool {
@@ -534,7 +688,7 @@ type SearchACLRequest struct {
 func (x *SearchACLRequest) Reset() {
 	*x = SearchACLRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_cellsapi_idm_proto_msgTypes[6]
+		mi := &file_cellsapi_idm_proto_msgTypes[8]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -547,7 +701,7 @@ func (x *SearchACLRequest) String() string {
 func (*SearchACLRequest) ProtoMessage() {}
 
 func (x *SearchACLRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_cellsapi_idm_proto_msgTypes[6]
+	mi := &file_cellsapi_idm_proto_msgTypes[8]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -560,7 +714,7 @@ func (x *SearchACLRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SearchACLRequest.ProtoReflect.Descriptor instead.
 func (*SearchACLRequest) Descriptor() ([]byte, []int) {
-	return file_cellsapi_idm_proto_rawDescGZIP(), []int{6}
+	return file_cellsapi_idm_proto_rawDescGZIP(), []int{8}
 }
 
 func (x *SearchACLRequest) GetQueries() []*idm.ACLSingleQuery {
@@ -620,7 +774,7 @@ type ACLCollection struct {
 func (x *ACLCollection) Reset() {
 	*x = ACLCollection{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_cellsapi_idm_proto_msgTypes[7]
+		mi := &file_cellsapi_idm_proto_msgTypes[9]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -633,7 +787,7 @@ func (x *ACLCollection) String() string {
 func (*ACLCollection) ProtoMessage() {}
 
 func (x *ACLCollection) ProtoReflect() protoreflect.Message {
-	mi := &file_cellsapi_idm_proto_msgTypes[7]
+	mi := &file_cellsapi_idm_proto_msgTypes[9]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -646,7 +800,7 @@ func (x *ACLCollection) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ACLCollection.ProtoReflect.Descriptor instead.
 func (*ACLCollection) Descriptor() ([]byte, []int) {
-	return file_cellsapi_idm_proto_rawDescGZIP(), []int{7}
+	return file_cellsapi_idm_proto_rawDescGZIP(), []int{9}
 }
 
 func (x *ACLCollection) GetACLs() []*idm.ACL {
@@ -663,45 +817,41 @@ func (x *ACLCollection) GetTotal() int32 {
 	return 0
 }
 
-// Rest request for searching workspaces
-type SearchWorkspaceRequest struct {
+// Request for explaining the effective permissions of a user on a node
+type ExplainACLRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// Atomic queries that will be combined using the OperationType (AND or OR)
-	Queries []*idm.WorkspaceSingleQuery `protobuf:"bytes,1,rep,name=Queries,proto3" json:"Queries,omitempty"`
-	// Policies queries to filter the search context
-	ResourcePolicyQuery *ResourcePolicyQuery `protobuf:"bytes,7,opt,name=ResourcePolicyQuery,proto3" json:"ResourcePolicyQuery,omitempty"`
-	// Start listing at a given position
-	Offset int64 `protobuf:"varint,2,opt,name=Offset,proto3" json:"Offset,omitempty"`
-	// Limit the number of results
-	Limit int64 `protobuf:"varint,3,opt,name=Limit,proto3" json:"Limit,omitempty"`
-	// Group results
-	GroupBy int32 `protobuf:"varint,4,opt,name=GroupBy,proto3" json:"GroupBy,omitempty"`
-	// Return counts only, no actual results
-	CountOnly bool `protobuf:"varint,5,opt,name=CountOnly,proto3" json:"CountOnly,omitempty"`
-	// Single queries will be combined using this operation AND or OR logic
-	Operation service.OperationType `protobuf:"varint,6,opt,name=Operation,proto3,enum=service.OperationType" json:"Operation,omitempty"`
+	// Login of the user
+	Login string `protobuf:"bytes,1,opt,name=Login,proto3" json:"Login,omitempty"`
+	// Uuid of the user, can be used instead of Login
+	UserUuid string `protobuf:"bytes,2,opt,name=UserUuid,proto3" json:"UserUuid,omitempty"`
+	// Path of the node, as stored in the tree (datasource/folder/file)
+	Path string `protobuf:"bytes,3,opt,name=Path,proto3" json:"Path,omitempty"`
+	// Uuid of the node, can be used instead of Path
+	NodeUuid string `protobuf:"bytes,4,opt,name=NodeUuid,proto3" json:"NodeUuid,omitempty"`
+	// Explain as if the user was using a token restricted to these scopes (node:uuid:rw)
+	Scopes []string `protobuf:"bytes,5,rep,name=Scopes,proto3" json:"Scopes,omitempty"`
 }
 
-func (x *SearchWorkspaceRequest) Reset() {
-	*x = SearchWorkspaceRequest{}
+func (x *ExplainACLRequest) Reset() {
+	*x = ExplainACLRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_cellsapi_idm_proto_msgTypes[8]
+		mi := &file_cellsapi_idm_proto_msgTypes[10]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *SearchWorkspaceRequest) String() string {
+func (x *ExplainACLRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SearchWorkspaceRequest) ProtoMessage() {}
+func (*ExplainACLRequest) ProtoMessage() {}
 
-func (x *SearchWorkspaceRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_cellsapi_idm_proto_msgTypes[8]
+func (x *ExplainACLRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cellsapi_idm_proto_msgTypes[10]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -712,89 +862,85 @@ func (x *SearchWorkspaceRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SearchWorkspaceRequest.ProtoReflect.Descriptor instead.
-func (*SearchWorkspaceRequest) Descriptor() ([]byte, []int) {
-	return file_cellsapi_idm_proto_rawDescGZIP(), []int{8}
-}
-
-func (x *SearchWorkspaceRequest) GetQueries() []*idm.WorkspaceSingleQuery {
-	if x != nil {
-		return x.Queries
-	}
-	return nil
-}
-
-func (x *SearchWorkspaceRequest) GetResourcePolicyQuery() *ResourcePolicyQuery {
-	if x != nil {
-		return x.ResourcePolicyQuery
-	}
-	return nil
+// Deprecated: Use ExplainACLRequest.ProtoReflect.Descriptor instead.
+func (*ExplainACLRequest) Descriptor() ([]byte, []int) {
+	return file_cellsapi_idm_proto_rawDescGZIP(), []int{10}
 }
 
-func (x *SearchWorkspaceRequest) GetOffset() int64 {
+func (x *ExplainACLRequest) GetLogin() string {
 	if x != nil {
-		return x.Offset
+		return x.Login
 	}
-	return 0
+	return ""
 }
 
-func (x *SearchWorkspaceRequest) GetLimit() int64 {
+func (x *ExplainACLRequest) GetUserUuid() string {
 	if x != nil {
-		return x.Limit
+		return x.UserUuid
 	}
-	return 0
+	return ""
 }
 
-func (x *SearchWorkspaceRequest) GetGroupBy() int32 {
+func (x *ExplainACLRequest) GetPath() string {
 	if x != nil {
-		return x.GroupBy
+		return x.Path
 	}
-	return 0
+	return ""
 }
 
-func (x *SearchWorkspaceRequest) GetCountOnly() bool {
+func (x *ExplainACLRequest) GetNodeUuid() string {
 	if x != nil {
-		return x.CountOnly
+		return x.NodeUuid
 	}
-	return false
+	return ""
 }
 
-func (x *SearchWorkspaceRequest) GetOperation() service.OperationType {
+func (x *ExplainACLRequest) GetScopes() []string {
 	if x != nil {
-		return x.Operation
+		return x.Scopes
 	}
-	return service.OperationType(0)
+	return nil
 }
 
-// Rest response for workspace search
-type WorkspaceCollection struct {
+// ACL contributing to the permissions of an explained node
+type ExplainedACL struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// List of workspaces
-	Workspaces []*idm.Workspace `protobuf:"bytes,1,rep,name=Workspaces,proto3" json:"Workspaces,omitempty"`
-	// Total number of results
-	Total int32 `protobuf:"varint,2,opt,name=Total,proto3" json:"Total,omitempty"`
-}
-
-func (x *WorkspaceCollection) Reset() {
-	*x = WorkspaceCollection{}
+	// ACL action name
+	Action string `protobuf:"bytes,1,opt,name=Action,proto3" json:"Action,omitempty"`
+	// ACL action value
+	Value string `protobuf:"bytes,2,opt,name=Value,proto3" json:"Value,omitempty"`
+	// Workspace this ACL belongs to, if any
+	WorkspaceId string `protobuf:"bytes,3,opt,name=WorkspaceId,proto3" json:"WorkspaceId,omitempty"`
+	// Role carrying this ACL
+	RoleId string `protobuf:"bytes,4,opt,name=RoleId,proto3" json:"RoleId,omitempty"`
+	// Label of the role
+	RoleLabel string `protobuf:"bytes,5,opt,name=RoleLabel,proto3" json:"RoleLabel,omitempty"`
+	// Type of role: user, group, team, profile or role
+	RoleType string `protobuf:"bytes,6,opt,name=RoleType,proto3" json:"RoleType,omitempty"`
+	// Whether this ACL is overridden by a role applied later
+	Overridden bool `protobuf:"varint,7,opt,name=Overridden,proto3" json:"Overridden,omitempty"`
+}
+
+func (x *ExplainedACL) Reset() {
+	*x = ExplainedACL{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_cellsapi_idm_proto_msgTypes[9]
+		mi := &file_cellsapi_idm_proto_msgTypes[11]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *WorkspaceCollection) String() string {
+func (x *ExplainedACL) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*WorkspaceCollection) ProtoMessage() {}
+func (*ExplainedACL) ProtoMessage() {}
 
-func (x *WorkspaceCollection) ProtoReflect() protoreflect.Message {
-	mi := &file_cellsapi_idm_proto_msgTypes[9]
+func (x *ExplainedACL) ProtoReflect() protoreflect.Message {
+	mi := &file_cellsapi_idm_proto_msgTypes[11]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -805,51 +951,96 @@ func (x *WorkspaceCollection) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use WorkspaceCollection.ProtoReflect.Descriptor instead.
-func (*WorkspaceCollection) Descriptor() ([]byte, []int) {
-	return file_cellsapi_idm_proto_rawDescGZIP(), []int{9}
+// Deprecated: Use ExplainedACL.ProtoReflect.Descriptor instead.
+func (*ExplainedACL) Descriptor() ([]byte, []int) {
+	return file_cellsapi_idm_proto_rawDescGZIP(), []int{11}
 }
 
-func (x *WorkspaceCollection) GetWorkspaces() []*idm.Workspace {
+func (x *ExplainedACL) GetAction() string {
 	if x != nil {
-		return x.Workspaces
+		return x.Action
 	}
-	return nil
+	return ""
 }
 
-func (x *WorkspaceCollection) GetTotal() int32 {
+func (x *ExplainedACL) GetValue() string {
 	if x != nil {
-		return x.Total
+		return x.Value
 	}
-	return 0
+	return ""
 }
 
-// Collection of UserMeta
-type UserMetaCollection struct {
+func (x *ExplainedACL) GetWorkspaceId() string {
+	if x != nil {
+		return x.WorkspaceId
+	}
+	return ""
+}
+
+func (x *ExplainedACL) GetRoleId() string {
+	if x != nil {
+		return x.RoleId
+	}
+	return ""
+}
+
+func (x *ExplainedACL) GetRoleLabel() string {
+	if x != nil {
+		return x.RoleLabel
+	}
+	return ""
+}
+
+func (x *ExplainedACL) GetRoleType() string {
+	if x != nil {
+		return x.RoleType
+	}
+	return ""
+}
+
+func (x *ExplainedACL) GetOverridden() bool {
+	if x != nil {
+		return x.Overridden
+	}
+	return false
+}
+
+// One node of the explained path, from the target node up to the root
+type ExplainedNode struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Metadatas []*idm.UserMeta `protobuf:"bytes,1,rep,name=Metadatas,proto3" json:"Metadatas,omitempty"`
-}
-
-func (x *UserMetaCollection) Reset() {
-	*x = UserMetaCollection{}
+	Node *tree.Node `protobuf:"bytes,1,opt,name=Node,proto3" json:"Node,omitempty"`
+	// ACLs attached to this node
+	ACLs []*ExplainedACL `protobuf:"bytes,2,rep,name=ACLs,proto3" json:"ACLs,omitempty"`
+	// Permissions of the flattened mask for this node
+	Permissions []string `protobuf:"bytes,3,rep,name=Permissions,proto3" json:"Permissions,omitempty"`
+	// Policies attached to the flattened mask for this node
+	PolicyIds []string `protobuf:"bytes,4,rep,name=PolicyIds,proto3" json:"PolicyIds,omitempty"`
+	// Whether this node carries a deny
+	Deny bool `protobuf:"varint,5,opt,name=Deny,proto3" json:"Deny,omitempty"`
+	// Whether this node provides the effective permissions
+	Applied bool `protobuf:"varint,6,opt,name=Applied,proto3" json:"Applied,omitempty"`
+}
+
+func (x *ExplainedNode) Reset() {
+	*x = ExplainedNode{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_cellsapi_idm_proto_msgTypes[10]
+		mi := &file_cellsapi_idm_proto_msgTypes[12]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *UserMetaCollection) String() string {
+func (x *ExplainedNode) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UserMetaCollection) ProtoMessage() {}
+func (*ExplainedNode) ProtoMessage() {}
 
-func (x *UserMetaCollection) ProtoReflect() protoreflect.Message {
-	mi := &file_cellsapi_idm_proto_msgTypes[10]
+func (x *ExplainedNode) ProtoReflect() protoreflect.Message {
+	mi := &file_cellsapi_idm_proto_msgTypes[12]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -860,45 +1051,1236 @@ func (x *UserMetaCollection) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UserMetaCollection.ProtoReflect.Descriptor instead.
-func (*UserMetaCollection) Descriptor() ([]byte, []int) {
-	return file_cellsapi_idm_proto_rawDescGZIP(), []int{10}
+// Deprecated: Use ExplainedNode.ProtoReflect.Descriptor instead.
+func (*ExplainedNode) Descriptor() ([]byte, []int) {
+	return file_cellsapi_idm_proto_rawDescGZIP(), []int{12}
 }
 
-func (x *UserMetaCollection) GetMetadatas() []*idm.UserMeta {
+func (x *ExplainedNode) GetNode() *tree.Node {
 	if x != nil {
-		return x.Metadatas
+		return x.Node
 	}
 	return nil
 }
 
-// Collection of Meta Namespaces
-type UserMetaNamespaceCollection struct {
+func (x *ExplainedNode) GetACLs() []*ExplainedACL {
+	if x != nil {
+		return x.ACLs
+	}
+	return nil
+}
+
+func (x *ExplainedNode) GetPermissions() []string {
+	if x != nil {
+		return x.Permissions
+	}
+	return nil
+}
+
+func (x *ExplainedNode) GetPolicyIds() []string {
+	if x != nil {
+		return x.PolicyIds
+	}
+	return nil
+}
+
+func (x *ExplainedNode) GetDeny() bool {
+	if x != nil {
+		return x.Deny
+	}
+	return false
+}
+
+func (x *ExplainedNode) GetApplied() bool {
+	if x != nil {
+		return x.Applied
+	}
+	return false
+}
+
+// Result of a policy evaluation on the explained path
+type ExplainedPolicy struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// List of user meta Namespaces
-	Namespaces []*idm.UserMetaNamespace `protobuf:"bytes,1,rep,name=Namespaces,proto3" json:"Namespaces,omitempty"`
+	PolicyId     string `protobuf:"bytes,1,opt,name=PolicyId,proto3" json:"PolicyId,omitempty"`
+	Permission   string `protobuf:"bytes,2,opt,name=Permission,proto3" json:"Permission,omitempty"`
+	Allowed      bool   `protobuf:"varint,3,opt,name=Allowed,proto3" json:"Allowed,omitempty"`
+	ExplicitDeny bool   `protobuf:"varint,4,opt,name=ExplicitDeny,proto3" json:"ExplicitDeny,omitempty"`
+}
+
+func (x *ExplainedPolicy) Reset() {
+	*x = ExplainedPolicy{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cellsapi_idm_proto_msgTypes[13]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ExplainedPolicy) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExplainedPolicy) ProtoMessage() {}
+
+func (x *ExplainedPolicy) ProtoReflect() protoreflect.Message {
+	mi := &file_cellsapi_idm_proto_msgTypes[13]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExplainedPolicy.ProtoReflect.Descriptor instead.
+func (*ExplainedPolicy) Descriptor() ([]byte, []int) {
+	return file_cellsapi_idm_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *ExplainedPolicy) GetPolicyId() string {
+	if x != nil {
+		return x.PolicyId
+	}
+	return ""
+}
+
+func (x *ExplainedPolicy) GetPermission() string {
+	if x != nil {
+		return x.Permission
+	}
+	return ""
+}
+
+func (x *ExplainedPolicy) GetAllowed() bool {
+	if x != nil {
+		return x.Allowed
+	}
+	return false
+}
+
+func (x *ExplainedPolicy) GetExplicitDeny() bool {
+	if x != nil {
+		return x.ExplicitDeny
+	}
+	return false
+}
+
+// Workspace rooted on one of the explained nodes
+type ExplainedWorkspace struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Workspace *idm.Workspace `protobuf:"bytes,1,opt,name=Workspace,proto3" json:"Workspace,omitempty"`
+	RootUuid  string         `protobuf:"bytes,2,opt,name=RootUuid,proto3" json:"RootUuid,omitempty"`
+	RootPath  string         `protobuf:"bytes,3,opt,name=RootPath,proto3" json:"RootPath,omitempty"`
+}
+
+func (x *ExplainedWorkspace) Reset() {
+	*x = ExplainedWorkspace{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cellsapi_idm_proto_msgTypes[14]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ExplainedWorkspace) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExplainedWorkspace) ProtoMessage() {}
+
+func (x *ExplainedWorkspace) ProtoReflect() protoreflect.Message {
+	mi := &file_cellsapi_idm_proto_msgTypes[14]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExplainedWorkspace.ProtoReflect.Descriptor instead.
+func (*ExplainedWorkspace) Descriptor() ([]byte, []int) {
+	return file_cellsapi_idm_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *ExplainedWorkspace) GetWorkspace() *idm.Workspace {
+	if x != nil {
+		return x.Workspace
+	}
+	return nil
+}
+
+func (x *ExplainedWorkspace) GetRootUuid() string {
+	if x != nil {
+		return x.RootUuid
+	}
+	return ""
+}
+
+func (x *ExplainedWorkspace) GetRootPath() string {
+	if x != nil {
+		return x.RootPath
+	}
+	return ""
+}
+
+// Detailed computation of the effective permissions of a user on a node
+type ExplainACLResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	User *idm.User  `protobuf:"bytes,1,opt,name=User,proto3" json:"User,omitempty"`
+	Node *tree.Node `protobuf:"bytes,2,opt,name=Node,proto3" json:"Node,omitempty"`
+	// Effective permissions
+	Permissions []string `protobuf:"bytes,3,rep,name=Permissions,proto3" json:"Permissions,omitempty"`
+	// Roles of the user, in the order they are applied
+	Roles []*idm.Role `protobuf:"bytes,4,rep,name=Roles,proto3" json:"Roles,omitempty"`
+	// Target node and its parents
+	Nodes []*ExplainedNode `protobuf:"bytes,5,rep,name=Nodes,proto3" json:"Nodes,omitempty"`
+	// Workspaces through which this node is visible
+	Workspaces []*ExplainedWorkspace `protobuf:"bytes,6,rep,name=Workspaces,proto3" json:"Workspaces,omitempty"`
+	// Policies evaluated on the node
+	Policies []*ExplainedPolicy `protobuf:"bytes,7,rep,name=Policies,proto3" json:"Policies,omitempty"`
+	// Uuid of the node carrying an explicit deny, if any
+	DeniedBy string `protobuf:"bytes,8,opt,name=DeniedBy,proto3" json:"DeniedBy,omitempty"`
+	// Permissions removed by the token scopes
+	ScopesDeny []string `protobuf:"bytes,9,rep,name=ScopesDeny,proto3" json:"ScopesDeny,omitempty"`
+	// Human-readable trace of the resolution
+	Trace []string `protobuf:"bytes,10,rep,name=Trace,proto3" json:"Trace,omitempty"`
+}
+
+func (x *ExplainACLResponse) Reset() {
+	*x = ExplainACLResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cellsapi_idm_proto_msgTypes[15]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ExplainACLResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExplainACLResponse) ProtoMessage() {}
+
+func (x *ExplainACLResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cellsapi_idm_proto_msgTypes[15]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExplainACLResponse.ProtoReflect.Descriptor instead.
+func (*ExplainACLResponse) Descriptor() ([]byte, []int) {
+	return file_cellsapi_idm_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *ExplainACLResponse) GetUser() *idm.User {
+	if x != nil {
+		return x.User
+	}
+	return nil
+}
+
+func (x *ExplainACLResponse) GetNode() *tree.Node {
+	if x != nil {
+		return x.Node
+	}
+	return nil
+}
+
+func (x *ExplainACLResponse) GetPermissions() []string {
+	if x != nil {
+		return x.Permissions
+	}
+	return nil
+}
+
+func (x *ExplainACLResponse) GetRoles() []*idm.Role {
+	if x != nil {
+		return x.Roles
+	}
+	return nil
+}
+
+func (x *ExplainACLResponse) GetNodes() []*ExplainedNode {
+	if x != nil {
+		return x.Nodes
+	}
+	return nil
+}
+
+func (x *ExplainACLResponse) GetWorkspaces() []*ExplainedWorkspace {
+	if x != nil {
+		return x.Workspaces
+	}
+	return nil
+}
+
+func (x *ExplainACLResponse) GetPolicies() []*ExplainedPolicy {
+	if x != nil {
+		return x.Policies
+	}
+	return nil
+}
+
+func (x *ExplainACLResponse) GetDeniedBy() string {
+	if x != nil {
+		return x.DeniedBy
+	}
+	return ""
+}
+
+func (x *ExplainACLResponse) GetScopesDeny() []string {
+	if x != nil {
+		return x.ScopesDeny
+	}
+	return nil
+}
+
+func (x *ExplainACLResponse) GetTrace() []string {
+	if x != nil {
+		return x.Trace
+	}
+	return nil
+}
+
+// Rest request for searching workspaces
+type SearchWorkspaceRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Atomic queries that will be combined using the OperationType (AND or OR)
+	Queries []*idm.WorkspaceSingleQuery `protobuf:"bytes,1,rep,name=Queries,proto3" json:"Queries,omitempty"`
+	// Policies queries to filter the search context
+	ResourcePolicyQuery *ResourcePolicyQuery `protobuf:"bytes,7,opt,name=ResourcePolicyQuery,proto3" json:"ResourcePolicyQuery,omitempty"`
+	// Start listing at a given position
+	Offset int64 `protobuf:"varint,2,opt,name=Offset,proto3" json:"Offset,omitempty"`
+	// Limit the number of results
+	Limit int64 `protobuf:"varint,3,opt,name=Limit,proto3" json:"Limit,omitempty"`
+	// Group results
+	GroupBy int32 `protobuf:"varint,4,opt,name=GroupBy,proto3" json:"GroupBy,omitempty"`
+	// Return counts only, no actual results
+	CountOnly bool `protobuf:"varint,5,opt,name=CountOnly,proto3" json:"CountOnly,omitempty"`
+	// Single queries will be combined using this operation AND or OR logic
+	Operation service.OperationType `protobuf:"varint,6,opt,name=Operation,proto3,enum=service.OperationType" json:"Operation,omitempty"`
+}
+
+func (x *SearchWorkspaceRequest) Reset() {
+	*x = SearchWorkspaceRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cellsapi_idm_proto_msgTypes[16]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SearchWorkspaceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchWorkspaceRequest) ProtoMessage() {}
+
+func (x *SearchWorkspaceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cellsapi_idm_proto_msgTypes[16]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchWorkspaceRequest.ProtoReflect.Descriptor instead.
+func (*SearchWorkspaceRequest) Descriptor() ([]byte, []int) {
+	return file_cellsapi_idm_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *SearchWorkspaceRequest) GetQueries() []*idm.WorkspaceSingleQuery {
+	if x != nil {
+		return x.Queries
+	}
+	return nil
+}
+
+func (x *SearchWorkspaceRequest) GetResourcePolicyQuery() *ResourcePolicyQuery {
+	if x != nil {
+		return x.ResourcePolicyQuery
+	}
+	return nil
+}
+
+func (x *SearchWorkspaceRequest) GetOffset() int64 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+func (x *SearchWorkspaceRequest) GetLimit() int64 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *SearchWorkspaceRequest) GetGroupBy() int32 {
+	if x != nil {
+		return x.GroupBy
+	}
+	return 0
+}
+
+func (x *SearchWorkspaceRequest) GetCountOnly() bool {
+	if x != nil {
+		return x.CountOnly
+	}
+	return false
+}
+
+func (x *SearchWorkspaceRequest) GetOperation() service.OperationType {
+	if x != nil {
+		return x.Operation
+	}
+	return service.OperationType(0)
+}
+
+// Rest response for workspace search
+type WorkspaceCollection struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// List of workspaces
+	Workspaces []*idm.Workspace `protobuf:"bytes,1,rep,name=Workspaces,proto3" json:"Workspaces,omitempty"`
+	// Total number of results
+	Total int32 `protobuf:"varint,2,opt,name=Total,proto3" json:"Total,omitempty"`
+}
+
+func (x *WorkspaceCollection) Reset() {
+	*x = WorkspaceCollection{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cellsapi_idm_proto_msgTypes[17]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *WorkspaceCollection) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WorkspaceCollection) ProtoMessage() {}
+
+func (x *WorkspaceCollection) ProtoReflect() protoreflect.Message {
+	mi := &file_cellsapi_idm_proto_msgTypes[17]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WorkspaceCollection.ProtoReflect.Descriptor instead.
+func (*WorkspaceCollection) Descriptor() ([]byte, []int) {
+	return file_cellsapi_idm_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *WorkspaceCollection) GetWorkspaces() []*idm.Workspace {
+	if x != nil {
+		return x.Workspaces
+	}
+	return nil
+}
+
+func (x *WorkspaceCollection) GetTotal() int32 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+// Collection of UserMeta
+type UserMetaCollection struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Metadatas []*idm.UserMeta `protobuf:"bytes,1,rep,name=Metadatas,proto3" json:"Metadatas,omitempty"`
+}
+
+func (x *UserMetaCollection) Reset() {
+	*x = UserMetaCollection{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cellsapi_idm_proto_msgTypes[18]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UserMetaCollection) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UserMetaCollection) ProtoMessage() {}
+
+func (x *UserMetaCollection) ProtoReflect() protoreflect.Message {
+	mi := &file_cellsapi_idm_proto_msgTypes[18]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UserMetaCollection.ProtoReflect.Descriptor instead.
+func (*UserMetaCollection) Descriptor() ([]byte, []int) {
+	return file_cellsapi_idm_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *UserMetaCollection) GetMetadatas() []*idm.UserMeta {
+	if x != nil {
+		return x.Metadatas
+	}
+	return nil
+}
+
+// Collection of Meta Namespaces
+type UserMetaNamespaceCollection struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// List of user meta Namespaces
+	Namespaces []*idm.UserMetaNamespace `protobuf:"bytes,1,rep,name=Namespaces,proto3" json:"Namespaces,omitempty"`
+}
+
+func (x *UserMetaNamespaceCollection) Reset() {
+	*x = UserMetaNamespaceCollection{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cellsapi_idm_proto_msgTypes[19]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UserMetaNamespaceCollection) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UserMetaNamespaceCollection) ProtoMessage() {}
+
+func (x *UserMetaNamespaceCollection) ProtoReflect() protoreflect.Message {
+	mi := &file_cellsapi_idm_proto_msgTypes[19]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UserMetaNamespaceCollection.ProtoReflect.Descriptor instead.
+func (*UserMetaNamespaceCollection) Descriptor() ([]byte, []int) {
+	return file_cellsapi_idm_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *UserMetaNamespaceCollection) GetNamespaces() []*idm.UserMetaNamespace {
+	if x != nil {
+		return x.Namespaces
+	}
+	return nil
+}
+
+type ListUserMetaTagsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// List user meta tags for this namespace
+	Namespace string `protobuf:"bytes,1,opt,name=Namespace,proto3" json:"Namespace,omitempty"`
+}
+
+func (x *ListUserMetaTagsRequest) Reset() {
+	*x = ListUserMetaTagsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cellsapi_idm_proto_msgTypes[20]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListUserMetaTagsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListUserMetaTagsRequest) ProtoMessage() {}
+
+func (x *ListUserMetaTagsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cellsapi_idm_proto_msgTypes[20]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListUserMetaTagsRequest.ProtoReflect.Descriptor instead.
+func (*ListUserMetaTagsRequest) Descriptor() ([]byte, []int) {
+	return file_cellsapi_idm_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *ListUserMetaTagsRequest) GetNamespace() string {
+	if x != nil {
+		return x.Namespace
+	}
+	return ""
+}
+
+type ListUserMetaTagsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// List of existing tags values
+	Tags []string `protobuf:"bytes,1,rep,name=Tags,proto3" json:"Tags,omitempty"`
+}
+
+func (x *ListUserMetaTagsResponse) Reset() {
+	*x = ListUserMetaTagsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cellsapi_idm_proto_msgTypes[21]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListUserMetaTagsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListUserMetaTagsResponse) ProtoMessage() {}
+
+func (x *ListUserMetaTagsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cellsapi_idm_proto_msgTypes[21]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListUserMetaTagsResponse.ProtoReflect.Descriptor instead.
+func (*ListUserMetaTagsResponse) Descriptor() ([]byte, []int) {
+	return file_cellsapi_idm_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *ListUserMetaTagsResponse) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+type PutUserMetaTagRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Add a tag value for this namespace
+	Namespace string `protobuf:"bytes,1,opt,name=Namespace,proto3" json:"Namespace,omitempty"`
+	// New tag value
+	Tag string `protobuf:"bytes,2,opt,name=Tag,proto3" json:"Tag,omitempty"`
+}
+
+func (x *PutUserMetaTagRequest) Reset() {
+	*x = PutUserMetaTagRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cellsapi_idm_proto_msgTypes[22]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PutUserMetaTagRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PutUserMetaTagRequest) ProtoMessage() {}
+
+func (x *PutUserMetaTagRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cellsapi_idm_proto_msgTypes[22]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PutUserMetaTagRequest.ProtoReflect.Descriptor instead.
+func (*PutUserMetaTagRequest) Descriptor() ([]byte, []int) {
+	return file_cellsapi_idm_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *PutUserMetaTagRequest) GetNamespace() string {
+	if x != nil {
+		return x.Namespace
+	}
+	return ""
+}
+
+func (x *PutUserMetaTagRequest) GetTag() string {
+	if x != nil {
+		return x.Tag
+	}
+	return ""
+}
+
+type PutUserMetaTagResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Operation success
+	Success bool `protobuf:"varint,1,opt,name=Success,proto3" json:"Success,omitempty"`
+}
+
+func (x *PutUserMetaTagResponse) Reset() {
+	*x = PutUserMetaTagResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cellsapi_idm_proto_msgTypes[23]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PutUserMetaTagResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PutUserMetaTagResponse) ProtoMessage() {}
+
+func (x *PutUserMetaTagResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cellsapi_idm_proto_msgTypes[23]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PutUserMetaTagResponse.ProtoReflect.Descriptor instead.
+func (*PutUserMetaTagResponse) Descriptor() ([]byte, []int) {
+	return file_cellsapi_idm_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *PutUserMetaTagResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+type DeleteUserMetaTagsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Delete tags from this namespace
+	Namespace string `protobuf:"bytes,1,opt,name=Namespace,proto3" json:"Namespace,omitempty"`
+	// Delete this tag
+	Tags string `protobuf:"bytes,2,opt,name=Tags,proto3" json:"Tags,omitempty"`
+}
+
+func (x *DeleteUserMetaTagsRequest) Reset() {
+	*x = DeleteUserMetaTagsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cellsapi_idm_proto_msgTypes[24]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeleteUserMetaTagsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteUserMetaTagsRequest) ProtoMessage() {}
+
+func (x *DeleteUserMetaTagsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cellsapi_idm_proto_msgTypes[24]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteUserMetaTagsRequest.ProtoReflect.Descriptor instead.
+func (*DeleteUserMetaTagsRequest) Descriptor() ([]byte, []int) {
+	return file_cellsapi_idm_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *DeleteUserMetaTagsRequest) GetNamespace() string {
+	if x != nil {
+		return x.Namespace
+	}
+	return ""
+}
+
+func (x *DeleteUserMetaTagsRequest) GetTags() string {
+	if x != nil {
+		return x.Tags
+	}
+	return ""
+}
+
+type DeleteUserMetaTagsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Success bool `protobuf:"varint,1,opt,name=Success,proto3" json:"Success,omitempty"`
+}
+
+func (x *DeleteUserMetaTagsResponse) Reset() {
+	*x = DeleteUserMetaTagsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cellsapi_idm_proto_msgTypes[25]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeleteUserMetaTagsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteUserMetaTagsResponse) ProtoMessage() {}
+
+func (x *DeleteUserMetaTagsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cellsapi_idm_proto_msgTypes[25]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteUserMetaTagsResponse.ProtoReflect.Descriptor instead.
+func (*DeleteUserMetaTagsResponse) Descriptor() ([]byte, []int) {
+	return file_cellsapi_idm_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *DeleteUserMetaTagsResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+type UserBookmarksRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	All bool `protobuf:"varint,1,opt,name=All,proto3" json:"All,omitempty"`
+}
+
+func (x *UserBookmarksRequest) Reset() {
+	*x = UserBookmarksRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cellsapi_idm_proto_msgTypes[26]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UserBookmarksRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UserBookmarksRequest) ProtoMessage() {}
+
+func (x *UserBookmarksRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cellsapi_idm_proto_msgTypes[26]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UserBookmarksRequest.ProtoReflect.Descriptor instead.
+func (*UserBookmarksRequest) Descriptor() ([]byte, []int) {
+	return file_cellsapi_idm_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *UserBookmarksRequest) GetAll() bool {
+	if x != nil {
+		return x.All
+	}
+	return false
+}
+
+// Rest request for revocation. Token is not mandatory, if not set
+// request will use current JWT token
+type RevokeRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Pass a specific Token ID to be revoked. If empty, request will use current JWT
+	TokenId string `protobuf:"bytes,1,opt,name=TokenId,proto3" json:"TokenId,omitempty"`
+}
+
+func (x *RevokeRequest) Reset() {
+	*x = RevokeRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cellsapi_idm_proto_msgTypes[27]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RevokeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevokeRequest) ProtoMessage() {}
+
+func (x *RevokeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cellsapi_idm_proto_msgTypes[27]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RevokeRequest.ProtoReflect.Descriptor instead.
+func (*RevokeRequest) Descriptor() ([]byte, []int) {
+	return file_cellsapi_idm_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *RevokeRequest) GetTokenId() string {
+	if x != nil {
+		return x.TokenId
+	}
+	return ""
+}
+
+// Rest response
+type RevokeResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Success bool   `protobuf:"varint,1,opt,name=Success,proto3" json:"Success,omitempty"`
+	Message string `protobuf:"bytes,2,opt,name=Message,proto3" json:"Message,omitempty"`
+}
+
+func (x *RevokeResponse) Reset() {
+	*x = RevokeResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cellsapi_idm_proto_msgTypes[28]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RevokeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevokeResponse) ProtoMessage() {}
+
+func (x *RevokeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cellsapi_idm_proto_msgTypes[28]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RevokeResponse.ProtoReflect.Descriptor instead.
+func (*RevokeResponse) Descriptor() ([]byte, []int) {
+	return file_cellsapi_idm_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *RevokeResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *RevokeResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+type ResetPasswordTokenRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Start a ResetPassword workflow for this user
+	UserLogin string `protobuf:"bytes,1,opt,name=UserLogin,proto3" json:"UserLogin,omitempty"`
+	Create    bool   `protobuf:"varint,2,opt,name=Create,proto3" json:"Create,omitempty"`
+}
+
+func (x *ResetPasswordTokenRequest) Reset() {
+	*x = ResetPasswordTokenRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cellsapi_idm_proto_msgTypes[29]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ResetPasswordTokenRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResetPasswordTokenRequest) ProtoMessage() {}
+
+func (x *ResetPasswordTokenRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cellsapi_idm_proto_msgTypes[29]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResetPasswordTokenRequest.ProtoReflect.Descriptor instead.
+func (*ResetPasswordTokenRequest) Descriptor() ([]byte, []int) {
+	return file_cellsapi_idm_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *ResetPasswordTokenRequest) GetUserLogin() string {
+	if x != nil {
+		return x.UserLogin
+	}
+	return ""
+}
+
+func (x *ResetPasswordTokenRequest) GetCreate() bool {
+	if x != nil {
+		return x.Create
+	}
+	return false
+}
+
+type ResetPasswordTokenResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Success bool   `protobuf:"varint,1,opt,name=Success,proto3" json:"Success,omitempty"`
+	Message string `protobuf:"bytes,2,opt,name=Message,proto3" json:"Message,omitempty"`
+}
+
+func (x *ResetPasswordTokenResponse) Reset() {
+	*x = ResetPasswordTokenResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cellsapi_idm_proto_msgTypes[30]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ResetPasswordTokenResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResetPasswordTokenResponse) ProtoMessage() {}
+
+func (x *ResetPasswordTokenResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cellsapi_idm_proto_msgTypes[30]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResetPasswordTokenResponse.ProtoReflect.Descriptor instead.
+func (*ResetPasswordTokenResponse) Descriptor() ([]byte, []int) {
+	return file_cellsapi_idm_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *ResetPasswordTokenResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *ResetPasswordTokenResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+type ResetPasswordRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Token generated by the previous step of the reset password workflow
+	ResetPasswordToken string `protobuf:"bytes,1,opt,name=ResetPasswordToken,proto3" json:"ResetPasswordToken,omitempty"`
+	// User Login
+	UserLogin string `protobuf:"bytes,2,opt,name=UserLogin,proto3" json:"UserLogin,omitempty"`
+	// New password to be stored for this user
+	NewPassword string `protobuf:"bytes,3,opt,name=NewPassword,proto3" json:"NewPassword,omitempty"`
+}
+
+func (x *ResetPasswordRequest) Reset() {
+	*x = ResetPasswordRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cellsapi_idm_proto_msgTypes[31]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ResetPasswordRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResetPasswordRequest) ProtoMessage() {}
+
+func (x *ResetPasswordRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cellsapi_idm_proto_msgTypes[31]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResetPasswordRequest.ProtoReflect.Descriptor instead.
+func (*ResetPasswordRequest) Descriptor() ([]byte, []int) {
+	return file_cellsapi_idm_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *ResetPasswordRequest) GetResetPasswordToken() string {
+	if x != nil {
+		return x.ResetPasswordToken
+	}
+	return ""
+}
+
+func (x *ResetPasswordRequest) GetUserLogin() string {
+	if x != nil {
+		return x.UserLogin
+	}
+	return ""
+}
+
+func (x *ResetPasswordRequest) GetNewPassword() string {
+	if x != nil {
+		return x.NewPassword
+	}
+	return ""
+}
+
+type ResetPasswordResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Success bool   `protobuf:"varint,1,opt,name=Success,proto3" json:"Success,omitempty"`
+	Message string `protobuf:"bytes,2,opt,name=Message,proto3" json:"Message,omitempty"`
 }
 
-func (x *UserMetaNamespaceCollection) Reset() {
-	*x = UserMetaNamespaceCollection{}
+func (x *ResetPasswordResponse) Reset() {
+	*x = ResetPasswordResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_cellsapi_idm_proto_msgTypes[11]
+		mi := &file_cellsapi_idm_proto_msgTypes[32]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *UserMetaNamespaceCollection) String() string {
+func (x *ResetPasswordResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UserMetaNamespaceCollection) ProtoMessage() {}
+func (*ResetPasswordResponse) ProtoMessage() {}
 
-func (x *UserMetaNamespaceCollection) ProtoReflect() protoreflect.Message {
-	mi := &file_cellsapi_idm_proto_msgTypes[11]
+func (x *ResetPasswordResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cellsapi_idm_proto_msgTypes[32]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -909,44 +2291,51 @@ func (x *UserMetaNamespaceCollection) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UserMetaNamespaceCollection.ProtoReflect.Descriptor instead.
-func (*UserMetaNamespaceCollection) Descriptor() ([]byte, []int) {
-	return file_cellsapi_idm_proto_rawDescGZIP(), []int{11}
+// Deprecated: Use ResetPasswordResponse.ProtoReflect.Descriptor instead.
+func (*ResetPasswordResponse) Descriptor() ([]byte, []int) {
+	return file_cellsapi_idm_proto_rawDescGZIP(), []int{32}
 }
 
-func (x *UserMetaNamespaceCollection) GetNamespaces() []*idm.UserMetaNamespace {
+func (x *ResetPasswordResponse) GetSuccess() bool {
 	if x != nil {
-		return x.Namespaces
+		return x.Success
 	}
-	return nil
+	return false
 }
 
-type ListUserMetaTagsRequest struct {
+func (x *ResetPasswordResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+type DocumentAccessTokenRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// List user meta tags for this namespace
-	Namespace string `protobuf:"bytes,1,opt,name=Namespace,proto3" json:"Namespace,omitempty"`
+	Path     string `protobuf:"bytes,1,opt,name=Path,proto3" json:"Path,omitempty"`
+	ClientID string `protobuf:"bytes,2,opt,name=ClientID,proto3" json:"ClientID,omitempty"`
 }
 
-func (x *ListUserMetaTagsRequest) Reset() {
-	*x = ListUserMetaTagsRequest{}
+func (x *DocumentAccessTokenRequest) Reset() {
+	*x = DocumentAccessTokenRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_cellsapi_idm_proto_msgTypes[12]
+		mi := &file_cellsapi_idm_proto_msgTypes[33]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ListUserMetaTagsRequest) String() string {
+func (x *DocumentAccessTokenRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListUserMetaTagsRequest) ProtoMessage() {}
+func (*DocumentAccessTokenRequest) ProtoMessage() {}
 
-func (x *ListUserMetaTagsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_cellsapi_idm_proto_msgTypes[12]
+func (x *DocumentAccessTokenRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cellsapi_idm_proto_msgTypes[33]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -957,44 +2346,50 @@ func (x *ListUserMetaTagsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListUserMetaTagsRequest.ProtoReflect.Descriptor instead.
-func (*ListUserMetaTagsRequest) Descriptor() ([]byte, []int) {
-	return file_cellsapi_idm_proto_rawDescGZIP(), []int{12}
+// Deprecated: Use DocumentAccessTokenRequest.ProtoReflect.Descriptor instead.
+func (*DocumentAccessTokenRequest) Descriptor() ([]byte, []int) {
+	return file_cellsapi_idm_proto_rawDescGZIP(), []int{33}
 }
 
-func (x *ListUserMetaTagsRequest) GetNamespace() string {
+func (x *DocumentAccessTokenRequest) GetPath() string {
 	if x != nil {
-		return x.Namespace
+		return x.Path
 	}
 	return ""
 }
 
-type ListUserMetaTagsResponse struct {
+func (x *DocumentAccessTokenRequest) GetClientID() string {
+	if x != nil {
+		return x.ClientID
+	}
+	return ""
+}
+
+type DocumentAccessTokenResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// List of existing tags values
-	Tags []string `protobuf:"bytes,1,rep,name=Tags,proto3" json:"Tags,omitempty"`
+	AccessToken string `protobuf:"bytes,1,opt,name=AccessToken,proto3" json:"AccessToken,omitempty"`
 }
 
-func (x *ListUserMetaTagsResponse) Reset() {
-	*x = ListUserMetaTagsResponse{}
+func (x *DocumentAccessTokenResponse) Reset() {
+	*x = DocumentAccessTokenResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_cellsapi_idm_proto_msgTypes[13]
+		mi := &file_cellsapi_idm_proto_msgTypes[34]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ListUserMetaTagsResponse) String() string {
+func (x *DocumentAccessTokenResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListUserMetaTagsResponse) ProtoMessage() {}
+func (*DocumentAccessTokenResponse) ProtoMessage() {}
 
-func (x *ListUserMetaTagsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_cellsapi_idm_proto_msgTypes[13]
+func (x *DocumentAccessTokenResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cellsapi_idm_proto_msgTypes[34]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1005,46 +2400,66 @@ func (x *ListUserMetaTagsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListUserMetaTagsResponse.ProtoReflect.Descriptor instead.
-func (*ListUserMetaTagsResponse) Descriptor() ([]byte, []int) {
-	return file_cellsapi_idm_proto_rawDescGZIP(), []int{13}
+// Deprecated: Use DocumentAccessTokenResponse.ProtoReflect.Descriptor instead.
+func (*DocumentAccessTokenResponse) Descriptor() ([]byte, []int) {
+	return file_cellsapi_idm_proto_rawDescGZIP(), []int{34}
 }
 
-func (x *ListUserMetaTagsResponse) GetTags() []string {
+func (x *DocumentAccessTokenResponse) GetAccessToken() string {
 	if x != nil {
-		return x.Tags
+		return x.AccessToken
 	}
-	return nil
+	return ""
 }
 
-type PutUserMetaTagRequest struct {
+// Temporary attachment of a role to a user, approved by a third party
+type Elevation struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// Add a tag value for this namespace
-	Namespace string `protobuf:"bytes,1,opt,name=Namespace,proto3" json:"Namespace,omitempty"`
-	// New tag value
-	Tag string `protobuf:"bytes,2,opt,name=Tag,proto3" json:"Tag,omitempty"`
-}
-
-func (x *PutUserMetaTagRequest) Reset() {
-	*x = PutUserMetaTagRequest{}
+	Uuid string `protobuf:"bytes,1,opt,name=Uuid,proto3" json:"Uuid,omitempty"`
+	// Login of the user requesting the role
+	UserLogin string `protobuf:"bytes,2,opt,name=UserLogin,proto3" json:"UserLogin,omitempty"`
+	UserUuid  string `protobuf:"bytes,3,opt,name=UserUuid,proto3" json:"UserUuid,omitempty"`
+	// Requested role
+	RoleId    string `protobuf:"bytes,4,opt,name=RoleId,proto3" json:"RoleId,omitempty"`
+	RoleLabel string `protobuf:"bytes,5,opt,name=RoleLabel,proto3" json:"RoleLabel,omitempty"`
+	// Reason given by the requester
+	Justification string `protobuf:"bytes,6,opt,name=Justification,proto3" json:"Justification,omitempty"`
+	// Duration of the elevation in seconds, starting at approval
+	Duration int64           `protobuf:"varint,7,opt,name=Duration,proto3" json:"Duration,omitempty"`
+	Status   ElevationStatus `protobuf:"varint,8,opt,name=Status,proto3,enum=rest.ElevationStatus" json:"Status,omitempty"`
+	// Login of the user who approved or rejected the request
+	Approver string `protobuf:"bytes,9,opt,name=Approver,proto3" json:"Approver,omitempty"`
+	// Optional comment left by the approver
+	Comment   string `protobuf:"bytes,10,opt,name=Comment,proto3" json:"Comment,omitempty"`
+	CreatedAt int32  `protobuf:"varint,11,opt,name=CreatedAt,proto3" json:"CreatedAt,omitempty"`
+	DecidedAt int32  `protobuf:"varint,12,opt,name=DecidedAt,proto3" json:"DecidedAt,omitempty"`
+	ExpiresAt int32  `protobuf:"varint,13,opt,name=ExpiresAt,proto3" json:"ExpiresAt,omitempty"`
+	// Profile granted during the elevation, if any
+	Profile string `protobuf:"bytes,14,opt,name=Profile,proto3" json:"Profile,omitempty"`
+	// Profile restored at the end of the elevation
+	PreviousProfile string `protobuf:"bytes,15,opt,name=PreviousProfile,proto3" json:"PreviousProfile,omitempty"`
+}
+
+func (x *Elevation) Reset() {
+	*x = Elevation{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_cellsapi_idm_proto_msgTypes[14]
+		mi := &file_cellsapi_idm_proto_msgTypes[35]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *PutUserMetaTagRequest) String() string {
+func (x *Elevation) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PutUserMetaTagRequest) ProtoMessage() {}
+func (*Elevation) ProtoMessage() {}
 
-func (x *PutUserMetaTagRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_cellsapi_idm_proto_msgTypes[14]
+func (x *Elevation) ProtoReflect() protoreflect.Message {
+	mi := &file_cellsapi_idm_proto_msgTypes[35]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1055,51 +2470,147 @@ func (x *PutUserMetaTagRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PutUserMetaTagRequest.ProtoReflect.Descriptor instead.
-func (*PutUserMetaTagRequest) Descriptor() ([]byte, []int) {
-	return file_cellsapi_idm_proto_rawDescGZIP(), []int{14}
+// Deprecated: Use Elevation.ProtoReflect.Descriptor instead.
+func (*Elevation) Descriptor() ([]byte, []int) {
+	return file_cellsapi_idm_proto_rawDescGZIP(), []int{35}
 }
 
-func (x *PutUserMetaTagRequest) GetNamespace() string {
+func (x *Elevation) GetUuid() string {
 	if x != nil {
-		return x.Namespace
+		return x.Uuid
 	}
 	return ""
 }
 
-func (x *PutUserMetaTagRequest) GetTag() string {
+func (x *Elevation) GetUserLogin() string {
 	if x != nil {
-		return x.Tag
+		return x.UserLogin
 	}
 	return ""
 }
 
-type PutUserMetaTagResponse struct {
+func (x *Elevation) GetUserUuid() string {
+	if x != nil {
+		return x.UserUuid
+	}
+	return ""
+}
+
+func (x *Elevation) GetRoleId() string {
+	if x != nil {
+		return x.RoleId
+	}
+	return ""
+}
+
+func (x *Elevation) GetRoleLabel() string {
+	if x != nil {
+		return x.RoleLabel
+	}
+	return ""
+}
+
+func (x *Elevation) GetJustification() string {
+	if x != nil {
+		return x.Justification
+	}
+	return ""
+}
+
+func (x *Elevation) GetDuration() int64 {
+	if x != nil {
+		return x.Duration
+	}
+	return 0
+}
+
+func (x *Elevation) GetStatus() ElevationStatus {
+	if x != nil {
+		return x.Status
+	}
+	return ElevationStatus_ElevationPending
+}
+
+func (x *Elevation) GetApprover() string {
+	if x != nil {
+		return x.Approver
+	}
+	return ""
+}
+
+func (x *Elevation) GetComment() string {
+	if x != nil {
+		return x.Comment
+	}
+	return ""
+}
+
+func (x *Elevation) GetCreatedAt() int32 {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return 0
+}
+
+func (x *Elevation) GetDecidedAt() int32 {
+	if x != nil {
+		return x.DecidedAt
+	}
+	return 0
+}
+
+func (x *Elevation) GetExpiresAt() int32 {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return 0
+}
+
+func (x *Elevation) GetProfile() string {
+	if x != nil {
+		return x.Profile
+	}
+	return ""
+}
+
+func (x *Elevation) GetPreviousProfile() string {
+	if x != nil {
+		return x.PreviousProfile
+	}
+	return ""
+}
+
+// Request a temporary role for the current user
+type CreateElevationRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// Operation success
-	Success bool `protobuf:"varint,1,opt,name=Success,proto3" json:"Success,omitempty"`
+	RoleId        string `protobuf:"bytes,1,opt,name=RoleId,proto3" json:"RoleId,omitempty"`
+	Justification string `protobuf:"bytes,2,opt,name=Justification,proto3" json:"Justification,omitempty"`
+	// Duration in seconds
+	Duration int64 `protobuf:"varint,3,opt,name=Duration,proto3" json:"Duration,omitempty"`
+	// Optionally request the admin profile for the same duration
+	Profile string `protobuf:"bytes,4,opt,name=Profile,proto3" json:"Profile,omitempty"`
 }
 
-func (x *PutUserMetaTagResponse) Reset() {
-	*x = PutUserMetaTagResponse{}
+func (x *CreateElevationRequest) Reset() {
+	*x = CreateElevationRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_cellsapi_idm_proto_msgTypes[15]
+		mi := &file_cellsapi_idm_proto_msgTypes[36]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *PutUserMetaTagResponse) String() string {
+func (x *CreateElevationRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PutUserMetaTagResponse) ProtoMessage() {}
+func (*CreateElevationRequest) ProtoMessage() {}
 
-func (x *PutUserMetaTagResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_cellsapi_idm_proto_msgTypes[15]
+func (x *CreateElevationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cellsapi_idm_proto_msgTypes[36]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1110,46 +2621,70 @@ func (x *PutUserMetaTagResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PutUserMetaTagResponse.ProtoReflect.Descriptor instead.
-func (*PutUserMetaTagResponse) Descriptor() ([]byte, []int) {
-	return file_cellsapi_idm_proto_rawDescGZIP(), []int{15}
+// Deprecated: Use CreateElevationRequest.ProtoReflect.Descriptor instead.
+func (*CreateElevationRequest) Descriptor() ([]byte, []int) {
+	return file_cellsapi_idm_proto_rawDescGZIP(), []int{36}
 }
 
-func (x *PutUserMetaTagResponse) GetSuccess() bool {
+func (x *CreateElevationRequest) GetRoleId() string {
 	if x != nil {
-		return x.Success
+		return x.RoleId
 	}
-	return false
+	return ""
 }
 
-type DeleteUserMetaTagsRequest struct {
+func (x *CreateElevationRequest) GetJustification() string {
+	if x != nil {
+		return x.Justification
+	}
+	return ""
+}
+
+func (x *CreateElevationRequest) GetDuration() int64 {
+	if x != nil {
+		return x.Duration
+	}
+	return 0
+}
+
+func (x *CreateElevationRequest) GetProfile() string {
+	if x != nil {
+		return x.Profile
+	}
+	return ""
+}
+
+// List elevations. Users only see their own requests, approvers see all of them
+type ListElevationsRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// Delete tags from this namespace
-	Namespace string `protobuf:"bytes,1,opt,name=Namespace,proto3" json:"Namespace,omitempty"`
-	// Delete this tag
-	Tags string `protobuf:"bytes,2,opt,name=Tags,proto3" json:"Tags,omitempty"`
+	// Filter by status, all if empty
+	Status []ElevationStatus `protobuf:"varint,1,rep,packed,name=Status,proto3,enum=rest.ElevationStatus" json:"Status,omitempty"`
+	// Filter by requester
+	UserLogin string `protobuf:"bytes,2,opt,name=UserLogin,proto3" json:"UserLogin,omitempty"`
+	Offset    int32  `protobuf:"varint,3,opt,name=Offset,proto3" json:"Offset,omitempty"`
+	Limit     int32  `protobuf:"varint,4,opt,name=Limit,proto3" json:"Limit,omitempty"`
 }
 
-func (x *DeleteUserMetaTagsRequest) Reset() {
-	*x = DeleteUserMetaTagsRequest{}
+func (x *ListElevationsRequest) Reset() {
+	*x = ListElevationsRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_cellsapi_idm_proto_msgTypes[16]
+		mi := &file_cellsapi_idm_proto_msgTypes[37]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *DeleteUserMetaTagsRequest) String() string {
+func (x *ListElevationsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteUserMetaTagsRequest) ProtoMessage() {}
+func (*ListElevationsRequest) ProtoMessage() {}
 
-func (x *DeleteUserMetaTagsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_cellsapi_idm_proto_msgTypes[16]
+func (x *ListElevationsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cellsapi_idm_proto_msgTypes[37]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1160,50 +2695,65 @@ func (x *DeleteUserMetaTagsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteUserMetaTagsRequest.ProtoReflect.Descriptor instead.
-func (*DeleteUserMetaTagsRequest) Descriptor() ([]byte, []int) {
-	return file_cellsapi_idm_proto_rawDescGZIP(), []int{16}
+// Deprecated: Use ListElevationsRequest.ProtoReflect.Descriptor instead.
+func (*ListElevationsRequest) Descriptor() ([]byte, []int) {
+	return file_cellsapi_idm_proto_rawDescGZIP(), []int{37}
 }
 
-func (x *DeleteUserMetaTagsRequest) GetNamespace() string {
+func (x *ListElevationsRequest) GetStatus() []ElevationStatus {
 	if x != nil {
-		return x.Namespace
+		return x.Status
 	}
-	return ""
+	return nil
 }
 
-func (x *DeleteUserMetaTagsRequest) GetTags() string {
+func (x *ListElevationsRequest) GetUserLogin() string {
 	if x != nil {
-		return x.Tags
+		return x.UserLogin
 	}
 	return ""
 }
 
-type DeleteUserMetaTagsResponse struct {
+func (x *ListElevationsRequest) GetOffset() int32 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+func (x *ListElevationsRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type ElevationCollection struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Success bool `protobuf:"varint,1,opt,name=Success,proto3" json:"Success,omitempty"`
+	Elevations []*Elevation `protobuf:"bytes,1,rep,name=Elevations,proto3" json:"Elevations,omitempty"`
+	Total      int32        `protobuf:"varint,2,opt,name=Total,proto3" json:"Total,omitempty"`
 }
 
-func (x *DeleteUserMetaTagsResponse) Reset() {
-	*x = DeleteUserMetaTagsResponse{}
+func (x *ElevationCollection) Reset() {
+	*x = ElevationCollection{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_cellsapi_idm_proto_msgTypes[17]
+		mi := &file_cellsapi_idm_proto_msgTypes[38]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *DeleteUserMetaTagsResponse) String() string {
+func (x *ElevationCollection) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteUserMetaTagsResponse) ProtoMessage() {}
+func (*ElevationCollection) ProtoMessage() {}
 
-func (x *DeleteUserMetaTagsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_cellsapi_idm_proto_msgTypes[17]
+func (x *ElevationCollection) ProtoReflect() protoreflect.Message {
+	mi := &file_cellsapi_idm_proto_msgTypes[38]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1214,43 +2764,53 @@ func (x *DeleteUserMetaTagsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteUserMetaTagsResponse.ProtoReflect.Descriptor instead.
-func (*DeleteUserMetaTagsResponse) Descriptor() ([]byte, []int) {
-	return file_cellsapi_idm_proto_rawDescGZIP(), []int{17}
+// Deprecated: Use ElevationCollection.ProtoReflect.Descriptor instead.
+func (*ElevationCollection) Descriptor() ([]byte, []int) {
+	return file_cellsapi_idm_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *ElevationCollection) GetElevations() []*Elevation {
+	if x != nil {
+		return x.Elevations
+	}
+	return nil
 }
 
-func (x *DeleteUserMetaTagsResponse) GetSuccess() bool {
+func (x *ElevationCollection) GetTotal() int32 {
 	if x != nil {
-		return x.Success
+		return x.Total
 	}
-	return false
+	return 0
 }
 
-type UserBookmarksRequest struct {
+// Approve or reject a pending elevation
+type DecideElevationRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	All bool `protobuf:"varint,1,opt,name=All,proto3" json:"All,omitempty"`
+	Uuid    string `protobuf:"bytes,1,opt,name=Uuid,proto3" json:"Uuid,omitempty"`
+	Approve bool   `protobuf:"varint,2,opt,name=Approve,proto3" json:"Approve,omitempty"`
+	Comment string `protobuf:"bytes,3,opt,name=Comment,proto3" json:"Comment,omitempty"`
 }
 
-func (x *UserBookmarksRequest) Reset() {
-	*x = UserBookmarksRequest{}
+func (x *DecideElevationRequest) Reset() {
+	*x = DecideElevationRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_cellsapi_idm_proto_msgTypes[18]
+		mi := &file_cellsapi_idm_proto_msgTypes[39]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *UserBookmarksRequest) String() string {
+func (x *DecideElevationRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UserBookmarksRequest) ProtoMessage() {}
+func (*DecideElevationRequest) ProtoMessage() {}
 
-func (x *UserBookmarksRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_cellsapi_idm_proto_msgTypes[18]
+func (x *DecideElevationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cellsapi_idm_proto_msgTypes[39]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1261,46 +2821,58 @@ func (x *UserBookmarksRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UserBookmarksRequest.ProtoReflect.Descriptor instead.
-func (*UserBookmarksRequest) Descriptor() ([]byte, []int) {
-	return file_cellsapi_idm_proto_rawDescGZIP(), []int{18}
+// Deprecated: Use DecideElevationRequest.ProtoReflect.Descriptor instead.
+func (*DecideElevationRequest) Descriptor() ([]byte, []int) {
+	return file_cellsapi_idm_proto_rawDescGZIP(), []int{39}
 }
 
-func (x *UserBookmarksRequest) GetAll() bool {
+func (x *DecideElevationRequest) GetUuid() string {
 	if x != nil {
-		return x.All
+		return x.Uuid
+	}
+	return ""
+}
+
+func (x *DecideElevationRequest) GetApprove() bool {
+	if x != nil {
+		return x.Approve
 	}
 	return false
 }
 
-// Rest request for revocation. Token is not mandatory, if not set
-// request will use current JWT token
-type RevokeRequest struct {
+func (x *DecideElevationRequest) GetComment() string {
+	if x != nil {
+		return x.Comment
+	}
+	return ""
+}
+
+// End an active elevation before its expiration
+type RevokeElevationRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// Pass a specific Token ID to be revoked. If empty, request will use current JWT
-	TokenId string `protobuf:"bytes,1,opt,name=TokenId,proto3" json:"TokenId,omitempty"`
+	Uuid string `protobuf:"bytes,1,opt,name=Uuid,proto3" json:"Uuid,omitempty"`
 }
 
-func (x *RevokeRequest) Reset() {
-	*x = RevokeRequest{}
+func (x *RevokeElevationRequest) Reset() {
+	*x = RevokeElevationRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_cellsapi_idm_proto_msgTypes[19]
+		mi := &file_cellsapi_idm_proto_msgTypes[40]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *RevokeRequest) String() string {
+func (x *RevokeElevationRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RevokeRequest) ProtoMessage() {}
+func (*RevokeElevationRequest) ProtoMessage() {}
 
-func (x *RevokeRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_cellsapi_idm_proto_msgTypes[19]
+func (x *RevokeElevationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cellsapi_idm_proto_msgTypes[40]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1311,45 +2883,46 @@ func (x *RevokeRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RevokeRequest.ProtoReflect.Descriptor instead.
-func (*RevokeRequest) Descriptor() ([]byte, []int) {
-	return file_cellsapi_idm_proto_rawDescGZIP(), []int{19}
+// Deprecated: Use RevokeElevationRequest.ProtoReflect.Descriptor instead.
+func (*RevokeElevationRequest) Descriptor() ([]byte, []int) {
+	return file_cellsapi_idm_proto_rawDescGZIP(), []int{40}
 }
 
-func (x *RevokeRequest) GetTokenId() string {
+func (x *RevokeElevationRequest) GetUuid() string {
 	if x != nil {
-		return x.TokenId
+		return x.Uuid
 	}
 	return ""
 }
 
-// Rest response
-type RevokeResponse struct {
+// Approve or reject a pending elevation using the token received by email
+type ElevationTokenRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Success bool   `protobuf:"varint,1,opt,name=Success,proto3" json:"Success,omitempty"`
-	Message string `protobuf:"bytes,2,opt,name=Message,proto3" json:"Message,omitempty"`
+	Token   string `protobuf:"bytes,1,opt,name=Token,proto3" json:"Token,omitempty"`
+	Reject  bool   `protobuf:"varint,2,opt,name=Reject,proto3" json:"Reject,omitempty"`
+	Comment string `protobuf:"bytes,3,opt,name=Comment,proto3" json:"Comment,omitempty"`
 }
 
-func (x *RevokeResponse) Reset() {
-	*x = RevokeResponse{}
+func (x *ElevationTokenRequest) Reset() {
+	*x = ElevationTokenRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_cellsapi_idm_proto_msgTypes[20]
+		mi := &file_cellsapi_idm_proto_msgTypes[41]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *RevokeResponse) String() string {
+func (x *ElevationTokenRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RevokeResponse) ProtoMessage() {}
+func (*ElevationTokenRequest) ProtoMessage() {}
 
-func (x *RevokeResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_cellsapi_idm_proto_msgTypes[20]
+func (x *ElevationTokenRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cellsapi_idm_proto_msgTypes[41]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1360,52 +2933,64 @@ func (x *RevokeResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RevokeResponse.ProtoReflect.Descriptor instead.
-func (*RevokeResponse) Descriptor() ([]byte, []int) {
-	return file_cellsapi_idm_proto_rawDescGZIP(), []int{20}
+// Deprecated: Use ElevationTokenRequest.ProtoReflect.Descriptor instead.
+func (*ElevationTokenRequest) Descriptor() ([]byte, []int) {
+	return file_cellsapi_idm_proto_rawDescGZIP(), []int{41}
 }
 
-func (x *RevokeResponse) GetSuccess() bool {
+func (x *ElevationTokenRequest) GetToken() string {
 	if x != nil {
-		return x.Success
+		return x.Token
+	}
+	return ""
+}
+
+func (x *ElevationTokenRequest) GetReject() bool {
+	if x != nil {
+		return x.Reject
 	}
 	return false
 }
 
-func (x *RevokeResponse) GetMessage() string {
+func (x *ElevationTokenRequest) GetComment() string {
 	if x != nil {
-		return x.Message
+		return x.Comment
 	}
 	return ""
 }
 
-type ResetPasswordTokenRequest struct {
+// Administration rights delegated to the members of a role, restricted to a groups subtree
+type DelegatedAdmin struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// Start a ResetPassword workflow for this user
-	UserLogin string `protobuf:"bytes,1,opt,name=UserLogin,proto3" json:"UserLogin,omitempty"`
-	Create    bool   `protobuf:"varint,2,opt,name=Create,proto3" json:"Create,omitempty"`
+	// Role whose members are delegated administrators
+	RoleUuid  string `protobuf:"bytes,1,opt,name=RoleUuid,proto3" json:"RoleUuid,omitempty"`
+	RoleLabel string `protobuf:"bytes,2,opt,name=RoleLabel,proto3" json:"RoleLabel,omitempty"`
+	// Root of the subtree, users and groups below it can be managed
+	GroupPath string `protobuf:"bytes,3,opt,name=GroupPath,proto3" json:"GroupPath,omitempty"`
+	// Roles that delegated administrators can assign inside the subtree
+	AssignableRoles []string `protobuf:"bytes,4,rep,name=AssignableRoles,proto3" json:"AssignableRoles,omitempty"`
 }
 
-func (x *ResetPasswordTokenRequest) Reset() {
-	*x = ResetPasswordTokenRequest{}
+func (x *DelegatedAdmin) Reset() {
+	*x = DelegatedAdmin{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_cellsapi_idm_proto_msgTypes[21]
+		mi := &file_cellsapi_idm_proto_msgTypes[42]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ResetPasswordTokenRequest) String() string {
+func (x *DelegatedAdmin) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ResetPasswordTokenRequest) ProtoMessage() {}
+func (*DelegatedAdmin) ProtoMessage() {}
 
-func (x *ResetPasswordTokenRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_cellsapi_idm_proto_msgTypes[21]
+func (x *DelegatedAdmin) ProtoReflect() protoreflect.Message {
+	mi := &file_cellsapi_idm_proto_msgTypes[42]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1416,51 +3001,66 @@ func (x *ResetPasswordTokenRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ResetPasswordTokenRequest.ProtoReflect.Descriptor instead.
-func (*ResetPasswordTokenRequest) Descriptor() ([]byte, []int) {
-	return file_cellsapi_idm_proto_rawDescGZIP(), []int{21}
+// Deprecated: Use DelegatedAdmin.ProtoReflect.Descriptor instead.
+func (*DelegatedAdmin) Descriptor() ([]byte, []int) {
+	return file_cellsapi_idm_proto_rawDescGZIP(), []int{42}
 }
 
-func (x *ResetPasswordTokenRequest) GetUserLogin() string {
+func (x *DelegatedAdmin) GetRoleUuid() string {
 	if x != nil {
-		return x.UserLogin
+		return x.RoleUuid
 	}
 	return ""
 }
 
-func (x *ResetPasswordTokenRequest) GetCreate() bool {
+func (x *DelegatedAdmin) GetRoleLabel() string {
 	if x != nil {
-		return x.Create
+		return x.RoleLabel
 	}
-	return false
+	return ""
 }
 
-type ResetPasswordTokenResponse struct {
+func (x *DelegatedAdmin) GetGroupPath() string {
+	if x != nil {
+		return x.GroupPath
+	}
+	return ""
+}
+
+func (x *DelegatedAdmin) GetAssignableRoles() []string {
+	if x != nil {
+		return x.AssignableRoles
+	}
+	return nil
+}
+
+// List delegations. Delegated administrators only see their own delegations
+type ListDelegatedAdminsRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Success bool   `protobuf:"varint,1,opt,name=Success,proto3" json:"Success,omitempty"`
-	Message string `protobuf:"bytes,2,opt,name=Message,proto3" json:"Message,omitempty"`
+	// Filter by role
+	RoleUuid string `protobuf:"bytes,1,opt,name=RoleUuid,proto3" json:"RoleUuid,omitempty"`
 }
 
-func (x *ResetPasswordTokenResponse) Reset() {
-	*x = ResetPasswordTokenResponse{}
+func (x *ListDelegatedAdminsRequest) Reset() {
+	*x = ListDelegatedAdminsRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_cellsapi_idm_proto_msgTypes[22]
+		mi := &file_cellsapi_idm_proto_msgTypes[43]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ResetPasswordTokenResponse) String() string {
+func (x *ListDelegatedAdminsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ResetPasswordTokenResponse) ProtoMessage() {}
+func (*ListDelegatedAdminsRequest) ProtoMessage() {}
 
-func (x *ResetPasswordTokenResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_cellsapi_idm_proto_msgTypes[22]
+func (x *ListDelegatedAdminsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cellsapi_idm_proto_msgTypes[43]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1471,55 +3071,43 @@ func (x *ResetPasswordTokenResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ResetPasswordTokenResponse.ProtoReflect.Descriptor instead.
-func (*ResetPasswordTokenResponse) Descriptor() ([]byte, []int) {
-	return file_cellsapi_idm_proto_rawDescGZIP(), []int{22}
-}
-
-func (x *ResetPasswordTokenResponse) GetSuccess() bool {
-	if x != nil {
-		return x.Success
-	}
-	return false
+// Deprecated: Use ListDelegatedAdminsRequest.ProtoReflect.Descriptor instead.
+func (*ListDelegatedAdminsRequest) Descriptor() ([]byte, []int) {
+	return file_cellsapi_idm_proto_rawDescGZIP(), []int{43}
 }
 
-func (x *ResetPasswordTokenResponse) GetMessage() string {
+func (x *ListDelegatedAdminsRequest) GetRoleUuid() string {
 	if x != nil {
-		return x.Message
+		return x.RoleUuid
 	}
 	return ""
 }
 
-type ResetPasswordRequest struct {
+type DelegatedAdminCollection struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// Token generated by the previous step of the reset password workflow
-	ResetPasswordToken string `protobuf:"bytes,1,opt,name=ResetPasswordToken,proto3" json:"ResetPasswordToken,omitempty"`
-	// User Login
-	UserLogin string `protobuf:"bytes,2,opt,name=UserLogin,proto3" json:"UserLogin,omitempty"`
-	// New password to be stored for this user
-	NewPassword string `protobuf:"bytes,3,opt,name=NewPassword,proto3" json:"NewPassword,omitempty"`
+	Delegations []*DelegatedAdmin `protobuf:"bytes,1,rep,name=Delegations,proto3" json:"Delegations,omitempty"`
 }
 
-func (x *ResetPasswordRequest) Reset() {
-	*x = ResetPasswordRequest{}
+func (x *DelegatedAdminCollection) Reset() {
+	*x = DelegatedAdminCollection{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_cellsapi_idm_proto_msgTypes[23]
+		mi := &file_cellsapi_idm_proto_msgTypes[44]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ResetPasswordRequest) String() string {
+func (x *DelegatedAdminCollection) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ResetPasswordRequest) ProtoMessage() {}
+func (*DelegatedAdminCollection) ProtoMessage() {}
 
-func (x *ResetPasswordRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_cellsapi_idm_proto_msgTypes[23]
+func (x *DelegatedAdminCollection) ProtoReflect() protoreflect.Message {
+	mi := &file_cellsapi_idm_proto_msgTypes[44]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1530,58 +3118,47 @@ func (x *ResetPasswordRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ResetPasswordRequest.ProtoReflect.Descriptor instead.
-func (*ResetPasswordRequest) Descriptor() ([]byte, []int) {
-	return file_cellsapi_idm_proto_rawDescGZIP(), []int{23}
-}
-
-func (x *ResetPasswordRequest) GetResetPasswordToken() string {
-	if x != nil {
-		return x.ResetPasswordToken
-	}
-	return ""
-}
-
-func (x *ResetPasswordRequest) GetUserLogin() string {
-	if x != nil {
-		return x.UserLogin
-	}
-	return ""
+// Deprecated: Use DelegatedAdminCollection.ProtoReflect.Descriptor instead.
+func (*DelegatedAdminCollection) Descriptor() ([]byte, []int) {
+	return file_cellsapi_idm_proto_rawDescGZIP(), []int{44}
 }
 
-func (x *ResetPasswordRequest) GetNewPassword() string {
+func (x *DelegatedAdminCollection) GetDelegations() []*DelegatedAdmin {
 	if x != nil {
-		return x.NewPassword
+		return x.Delegations
 	}
-	return ""
+	return nil
 }
 
-type ResetPasswordResponse struct {
+// Compute storage usage and quotas of users and groups
+type QuotaUsageRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Success bool   `protobuf:"varint,1,opt,name=Success,proto3" json:"Success,omitempty"`
-	Message string `protobuf:"bytes,2,opt,name=Message,proto3" json:"Message,omitempty"`
+	// Group to report on, defaults to the root group
+	GroupPath string `protobuf:"bytes,1,opt,name=GroupPath,proto3" json:"GroupPath,omitempty"`
+	// Include users and groups of all sub-groups
+	Recursive bool `protobuf:"varint,2,opt,name=Recursive,proto3" json:"Recursive,omitempty"`
 }
 
-func (x *ResetPasswordResponse) Reset() {
-	*x = ResetPasswordResponse{}
+func (x *QuotaUsageRequest) Reset() {
+	*x = QuotaUsageRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_cellsapi_idm_proto_msgTypes[24]
+		mi := &file_cellsapi_idm_proto_msgTypes[45]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ResetPasswordResponse) String() string {
+func (x *QuotaUsageRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ResetPasswordResponse) ProtoMessage() {}
+func (*QuotaUsageRequest) ProtoMessage() {}
 
-func (x *ResetPasswordResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_cellsapi_idm_proto_msgTypes[24]
+func (x *QuotaUsageRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cellsapi_idm_proto_msgTypes[45]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1592,51 +3169,63 @@ func (x *ResetPasswordResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ResetPasswordResponse.ProtoReflect.Descriptor instead.
-func (*ResetPasswordResponse) Descriptor() ([]byte, []int) {
-	return file_cellsapi_idm_proto_rawDescGZIP(), []int{24}
+// Deprecated: Use QuotaUsageRequest.ProtoReflect.Descriptor instead.
+func (*QuotaUsageRequest) Descriptor() ([]byte, []int) {
+	return file_cellsapi_idm_proto_rawDescGZIP(), []int{45}
 }
 
-func (x *ResetPasswordResponse) GetSuccess() bool {
+func (x *QuotaUsageRequest) GetGroupPath() string {
 	if x != nil {
-		return x.Success
+		return x.GroupPath
 	}
-	return false
+	return ""
 }
 
-func (x *ResetPasswordResponse) GetMessage() string {
+func (x *QuotaUsageRequest) GetRecursive() bool {
 	if x != nil {
-		return x.Message
+		return x.Recursive
 	}
-	return ""
+	return false
 }
 
-type DocumentAccessTokenRequest struct {
+// Storage usage of a user or a group, in bytes. Quotas are zero when not set
+type QuotaUsage struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Path     string `protobuf:"bytes,1,opt,name=Path,proto3" json:"Path,omitempty"`
-	ClientID string `protobuf:"bytes,2,opt,name=ClientID,proto3" json:"ClientID,omitempty"`
-}
-
-func (x *DocumentAccessTokenRequest) Reset() {
-	*x = DocumentAccessTokenRequest{}
+	Uuid string `protobuf:"bytes,1,opt,name=Uuid,proto3" json:"Uuid,omitempty"`
+	// Login of a user or full path of a group
+	Label     string `protobuf:"bytes,2,opt,name=Label,proto3" json:"Label,omitempty"`
+	GroupPath string `protobuf:"bytes,3,opt,name=GroupPath,proto3" json:"GroupPath,omitempty"`
+	IsGroup   bool   `protobuf:"varint,4,opt,name=IsGroup,proto3" json:"IsGroup,omitempty"`
+	// Personal folder usage and quota, for users
+	PersonalUsage int64 `protobuf:"varint,5,opt,name=PersonalUsage,proto3" json:"PersonalUsage,omitempty"`
+	PersonalQuota int64 `protobuf:"varint,6,opt,name=PersonalQuota,proto3" json:"PersonalQuota,omitempty"`
+	// Personal folder and owned cells for users, sum of the members usages for groups
+	Usage int64 `protobuf:"varint,7,opt,name=Usage,proto3" json:"Usage,omitempty"`
+	Quota int64 `protobuf:"varint,8,opt,name=Quota,proto3" json:"Quota,omitempty"`
+	// Number of users below a group
+	Members int32 `protobuf:"varint,9,opt,name=Members,proto3" json:"Members,omitempty"`
+}
+
+func (x *QuotaUsage) Reset() {
+	*x = QuotaUsage{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_cellsapi_idm_proto_msgTypes[25]
+		mi := &file_cellsapi_idm_proto_msgTypes[46]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *DocumentAccessTokenRequest) String() string {
+func (x *QuotaUsage) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DocumentAccessTokenRequest) ProtoMessage() {}
+func (*QuotaUsage) ProtoMessage() {}
 
-func (x *DocumentAccessTokenRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_cellsapi_idm_proto_msgTypes[25]
+func (x *QuotaUsage) ProtoReflect() protoreflect.Message {
+	mi := &file_cellsapi_idm_proto_msgTypes[46]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1647,50 +3236,101 @@ func (x *DocumentAccessTokenRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DocumentAccessTokenRequest.ProtoReflect.Descriptor instead.
-func (*DocumentAccessTokenRequest) Descriptor() ([]byte, []int) {
-	return file_cellsapi_idm_proto_rawDescGZIP(), []int{25}
+// Deprecated: Use QuotaUsage.ProtoReflect.Descriptor instead.
+func (*QuotaUsage) Descriptor() ([]byte, []int) {
+	return file_cellsapi_idm_proto_rawDescGZIP(), []int{46}
 }
 
-func (x *DocumentAccessTokenRequest) GetPath() string {
+func (x *QuotaUsage) GetUuid() string {
 	if x != nil {
-		return x.Path
+		return x.Uuid
 	}
 	return ""
 }
 
-func (x *DocumentAccessTokenRequest) GetClientID() string {
+func (x *QuotaUsage) GetLabel() string {
 	if x != nil {
-		return x.ClientID
+		return x.Label
 	}
 	return ""
 }
 
-type DocumentAccessTokenResponse struct {
+func (x *QuotaUsage) GetGroupPath() string {
+	if x != nil {
+		return x.GroupPath
+	}
+	return ""
+}
+
+func (x *QuotaUsage) GetIsGroup() bool {
+	if x != nil {
+		return x.IsGroup
+	}
+	return false
+}
+
+func (x *QuotaUsage) GetPersonalUsage() int64 {
+	if x != nil {
+		return x.PersonalUsage
+	}
+	return 0
+}
+
+func (x *QuotaUsage) GetPersonalQuota() int64 {
+	if x != nil {
+		return x.PersonalQuota
+	}
+	return 0
+}
+
+func (x *QuotaUsage) GetUsage() int64 {
+	if x != nil {
+		return x.Usage
+	}
+	return 0
+}
+
+func (x *QuotaUsage) GetQuota() int64 {
+	if x != nil {
+		return x.Quota
+	}
+	return 0
+}
+
+func (x *QuotaUsage) GetMembers() int32 {
+	if x != nil {
+		return x.Members
+	}
+	return 0
+}
+
+type QuotaUsageCollection struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	AccessToken string `protobuf:"bytes,1,opt,name=AccessToken,proto3" json:"AccessToken,omitempty"`
+	Usages []*QuotaUsage `protobuf:"bytes,1,rep,name=Usages,proto3" json:"Usages,omitempty"`
+	// Sum of the usages of the reported users
+	TotalUsage int64 `protobuf:"varint,2,opt,name=TotalUsage,proto3" json:"TotalUsage,omitempty"`
 }
 
-func (x *DocumentAccessTokenResponse) Reset() {
-	*x = DocumentAccessTokenResponse{}
+func (x *QuotaUsageCollection) Reset() {
+	*x = QuotaUsageCollection{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_cellsapi_idm_proto_msgTypes[26]
+		mi := &file_cellsapi_idm_proto_msgTypes[47]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *DocumentAccessTokenResponse) String() string {
+func (x *QuotaUsageCollection) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DocumentAccessTokenResponse) ProtoMessage() {}
+func (*QuotaUsageCollection) ProtoMessage() {}
 
-func (x *DocumentAccessTokenResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_cellsapi_idm_proto_msgTypes[26]
+func (x *QuotaUsageCollection) ProtoReflect() protoreflect.Message {
+	mi := &file_cellsapi_idm_proto_msgTypes[47]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1701,16 +3341,23 @@ func (x *DocumentAccessTokenResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DocumentAccessTokenResponse.ProtoReflect.Descriptor instead.
-func (*DocumentAccessTokenResponse) Descriptor() ([]byte, []int) {
-	return file_cellsapi_idm_proto_rawDescGZIP(), []int{26}
+// Deprecated: Use QuotaUsageCollection.ProtoReflect.Descriptor instead.
+func (*QuotaUsageCollection) Descriptor() ([]byte, []int) {
+	return file_cellsapi_idm_proto_rawDescGZIP(), []int{47}
 }
 
-func (x *DocumentAccessTokenResponse) GetAccessToken() string {
+func (x *QuotaUsageCollection) GetUsages() []*QuotaUsage {
+	if x != nil {
+		return x.Usages
+	}
+	return nil
+}
+
+func (x *QuotaUsageCollection) GetTotalUsage() int64 {
 	if x != nil {
-		return x.AccessToken
+		return x.TotalUsage
 	}
-	return ""
+	return 0
 }
 
 var File_cellsapi_idm_proto protoreflect.FileDescriptor
@@ -1720,190 +3367,395 @@ var file_cellsapi_idm_proto_rawDesc = []byte{
 	0x72, 0x6f, 0x74, 0x6f, 0x12, 0x04, 0x72, 0x65, 0x73, 0x74, 0x1a, 0x0f, 0x63, 0x65, 0x6c, 0x6c,
 	0x73, 0x2d, 0x69, 0x64, 0x6d, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x13, 0x63, 0x65, 0x6c,
 	0x6c, 0x73, 0x2d, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x22, 0x9d, 0x01, 0x0a, 0x13, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x50, 0x6f, 0x6c,
-	0x69, 0x63, 0x79, 0x51, 0x75, 0x65, 0x72, 0x79, 0x12, 0x37, 0x0a, 0x04, 0x54, 0x79, 0x70, 0x65,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x23, 0x2e, 0x72, 0x65, 0x73, 0x74, 0x2e, 0x52, 0x65,
-	0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x51, 0x75, 0x65, 0x72,
-	0x79, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x54, 0x79, 0x70,
-	0x65, 0x12, 0x16, 0x0a, 0x06, 0x55, 0x73, 0x65, 0x72, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x06, 0x55, 0x73, 0x65, 0x72, 0x49, 0x64, 0x22, 0x35, 0x0a, 0x09, 0x51, 0x75, 0x65,
-	0x72, 0x79, 0x54, 0x79, 0x70, 0x65, 0x12, 0x0b, 0x0a, 0x07, 0x43, 0x4f, 0x4e, 0x54, 0x45, 0x58,
-	0x54, 0x10, 0x00, 0x12, 0x07, 0x0a, 0x03, 0x41, 0x4e, 0x59, 0x10, 0x01, 0x12, 0x08, 0x0a, 0x04,
-	0x4e, 0x4f, 0x4e, 0x45, 0x10, 0x02, 0x12, 0x08, 0x0a, 0x04, 0x55, 0x53, 0x45, 0x52, 0x10, 0x03,
-	0x22, 0xac, 0x02, 0x0a, 0x11, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x52, 0x6f, 0x6c, 0x65, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2e, 0x0a, 0x07, 0x51, 0x75, 0x65, 0x72, 0x69, 0x65,
-	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x69, 0x64, 0x6d, 0x2e, 0x52, 0x6f,
-	0x6c, 0x65, 0x53, 0x69, 0x6e, 0x67, 0x6c, 0x65, 0x51, 0x75, 0x65, 0x72, 0x79, 0x52, 0x07, 0x51,
-	0x75, 0x65, 0x72, 0x69, 0x65, 0x73, 0x12, 0x4b, 0x0a, 0x13, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72,
-	0x63, 0x65, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x51, 0x75, 0x65, 0x72, 0x79, 0x18, 0x07, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x72, 0x65, 0x73, 0x74, 0x2e, 0x52, 0x65, 0x73, 0x6f, 0x75,
-	0x72, 0x63, 0x65, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x51, 0x75, 0x65, 0x72, 0x79, 0x52, 0x13,
+	0x1a, 0x10, 0x63, 0x65, 0x6c, 0x6c, 0x73, 0x2d, 0x74, 0x72, 0x65, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x22, 0x9d, 0x01, 0x0a, 0x13, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x50,
+	0x6f, 0x6c, 0x69, 0x63, 0x79, 0x51, 0x75, 0x65, 0x72, 0x79, 0x12, 0x37, 0x0a, 0x04, 0x54, 0x79,
+	0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x23, 0x2e, 0x72, 0x65, 0x73, 0x74, 0x2e,
+	0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x51, 0x75,
+	0x65, 0x72, 0x79, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x54,
+	0x79, 0x70, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x55, 0x73, 0x65, 0x72, 0x49, 0x64, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x06, 0x55, 0x73, 0x65, 0x72, 0x49, 0x64, 0x22, 0x35, 0x0a, 0x09, 0x51,
+	0x75, 0x65, 0x72, 0x79, 0x54, 0x79, 0x70, 0x65, 0x12, 0x0b, 0x0a, 0x07, 0x43, 0x4f, 0x4e, 0x54,
+	0x45, 0x58, 0x54, 0x10, 0x00, 0x12, 0x07, 0x0a, 0x03, 0x41, 0x4e, 0x59, 0x10, 0x01, 0x12, 0x08,
+	0x0a, 0x04, 0x4e, 0x4f, 0x4e, 0x45, 0x10, 0x02, 0x12, 0x08, 0x0a, 0x04, 0x55, 0x53, 0x45, 0x52,
+	0x10, 0x03, 0x22, 0xac, 0x02, 0x0a, 0x11, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x52, 0x6f, 0x6c,
+	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2e, 0x0a, 0x07, 0x51, 0x75, 0x65, 0x72,
+	0x69, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x69, 0x64, 0x6d, 0x2e,
+	0x52, 0x6f, 0x6c, 0x65, 0x53, 0x69, 0x6e, 0x67, 0x6c, 0x65, 0x51, 0x75, 0x65, 0x72, 0x79, 0x52,
+	0x07, 0x51, 0x75, 0x65, 0x72, 0x69, 0x65, 0x73, 0x12, 0x4b, 0x0a, 0x13, 0x52, 0x65, 0x73, 0x6f,
+	0x75, 0x72, 0x63, 0x65, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x51, 0x75, 0x65, 0x72, 0x79, 0x18,
+	0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x72, 0x65, 0x73, 0x74, 0x2e, 0x52, 0x65, 0x73,
+	0x6f, 0x75, 0x72, 0x63, 0x65, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x51, 0x75, 0x65, 0x72, 0x79,
+	0x52, 0x13, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79,
+	0x51, 0x75, 0x65, 0x72, 0x79, 0x12, 0x16, 0x0a, 0x06, 0x4f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x4f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x12, 0x14, 0x0a,
+	0x05, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x4c, 0x69,
+	0x6d, 0x69, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x42, 0x79, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x42, 0x79, 0x12, 0x1c, 0x0a,
+	0x09, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x4f, 0x6e, 0x6c, 0x79, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x09, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x4f, 0x6e, 0x6c, 0x79, 0x12, 0x34, 0x0a, 0x09, 0x4f,
+	0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x16,
+	0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x52, 0x09, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x22, 0x48, 0x0a, 0x0f, 0x52, 0x6f, 0x6c, 0x65, 0x73, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1f, 0x0a, 0x05, 0x52, 0x6f, 0x6c, 0x65, 0x73, 0x18, 0x01, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x09, 0x2e, 0x69, 0x64, 0x6d, 0x2e, 0x52, 0x6f, 0x6c, 0x65, 0x52, 0x05,
+	0x52, 0x6f, 0x6c, 0x65, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x22, 0xac, 0x02, 0x0a, 0x11,
+	0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x2e, 0x0a, 0x07, 0x51, 0x75, 0x65, 0x72, 0x69, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x14, 0x2e, 0x69, 0x64, 0x6d, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x53, 0x69, 0x6e,
+	0x67, 0x6c, 0x65, 0x51, 0x75, 0x65, 0x72, 0x79, 0x52, 0x07, 0x51, 0x75, 0x65, 0x72, 0x69, 0x65,
+	0x73, 0x12, 0x4b, 0x0a, 0x13, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x50, 0x6f, 0x6c,
+	0x69, 0x63, 0x79, 0x51, 0x75, 0x65, 0x72, 0x79, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19,
+	0x2e, 0x72, 0x65, 0x73, 0x74, 0x2e, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x50, 0x6f,
+	0x6c, 0x69, 0x63, 0x79, 0x51, 0x75, 0x65, 0x72, 0x79, 0x52, 0x13, 0x52, 0x65, 0x73, 0x6f, 0x75,
+	0x72, 0x63, 0x65, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x51, 0x75, 0x65, 0x72, 0x79, 0x12, 0x16,
+	0x0a, 0x06, 0x4f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06,
+	0x4f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x18, 0x0a, 0x07,
+	0x47, 0x72, 0x6f, 0x75, 0x70, 0x42, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x47,
+	0x72, 0x6f, 0x75, 0x70, 0x42, 0x79, 0x12, 0x1c, 0x0a, 0x09, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x4f,
+	0x6e, 0x6c, 0x79, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x43, 0x6f, 0x75, 0x6e, 0x74,
+	0x4f, 0x6e, 0x6c, 0x79, 0x12, 0x34, 0x0a, 0x09, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x16, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63,
+	0x65, 0x2e, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x52,
+	0x09, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x6b, 0x0a, 0x0f, 0x55, 0x73,
+	0x65, 0x72, 0x73, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x21, 0x0a,
+	0x06, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x09, 0x2e,
+	0x69, 0x64, 0x6d, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x52, 0x06, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x73,
+	0x12, 0x1f, 0x0a, 0x05, 0x55, 0x73, 0x65, 0x72, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x09, 0x2e, 0x69, 0x64, 0x6d, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x52, 0x05, 0x55, 0x73, 0x65, 0x72,
+	0x73, 0x12, 0x14, 0x0a, 0x05, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x05, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x22, 0x2d, 0x0a, 0x15, 0x55, 0x73, 0x65, 0x72, 0x44,
+	0x61, 0x74, 0x61, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x14, 0x0a, 0x05, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x05, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x2c, 0x0a, 0x16, 0x55, 0x73, 0x65, 0x72, 0x44, 0x61,
+	0x74, 0x61, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x12, 0x0a, 0x04, 0x44, 0x61, 0x74, 0x61, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04,
+	0x44, 0x61, 0x74, 0x61, 0x22, 0x28, 0x0a, 0x0c, 0x42, 0x69, 0x6e, 0x64, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x53, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x53, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x22, 0xdd,
+	0x01, 0x0a, 0x10, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x41, 0x43, 0x4c, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x2d, 0x0a, 0x07, 0x51, 0x75, 0x65, 0x72, 0x69, 0x65, 0x73, 0x18, 0x01,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x69, 0x64, 0x6d, 0x2e, 0x41, 0x43, 0x4c, 0x53, 0x69,
+	0x6e, 0x67, 0x6c, 0x65, 0x51, 0x75, 0x65, 0x72, 0x79, 0x52, 0x07, 0x51, 0x75, 0x65, 0x72, 0x69,
+	0x65, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x4f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x06, 0x4f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x4c, 0x69,
+	0x6d, 0x69, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x4c, 0x69, 0x6d, 0x69, 0x74,
+	0x12, 0x18, 0x0a, 0x07, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x42, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x07, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x42, 0x79, 0x12, 0x1c, 0x0a, 0x09, 0x43, 0x6f,
+	0x75, 0x6e, 0x74, 0x4f, 0x6e, 0x6c, 0x79, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x43,
+	0x6f, 0x75, 0x6e, 0x74, 0x4f, 0x6e, 0x6c, 0x79, 0x12, 0x34, 0x0a, 0x09, 0x4f, 0x70, 0x65, 0x72,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x16, 0x2e, 0x73, 0x65,
+	0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54,
+	0x79, 0x70, 0x65, 0x52, 0x09, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x43,
+	0x0a, 0x0d, 0x41, 0x43, 0x4c, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12,
+	0x1c, 0x0a, 0x04, 0x41, 0x43, 0x4c, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x08, 0x2e,
+	0x69, 0x64, 0x6d, 0x2e, 0x41, 0x43, 0x4c, 0x52, 0x04, 0x41, 0x43, 0x4c, 0x73, 0x12, 0x14, 0x0a,
+	0x05, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x54, 0x6f,
+	0x74, 0x61, 0x6c, 0x22, 0x8d, 0x01, 0x0a, 0x11, 0x45, 0x78, 0x70, 0x6c, 0x61, 0x69, 0x6e, 0x41,
+	0x43, 0x4c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x4c, 0x6f, 0x67,
+	0x69, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x12,
+	0x1a, 0x0a, 0x08, 0x55, 0x73, 0x65, 0x72, 0x55, 0x75, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x08, 0x55, 0x73, 0x65, 0x72, 0x55, 0x75, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x50,
+	0x61, 0x74, 0x68, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x50, 0x61, 0x74, 0x68, 0x12,
+	0x1a, 0x0a, 0x08, 0x4e, 0x6f, 0x64, 0x65, 0x55, 0x75, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x08, 0x4e, 0x6f, 0x64, 0x65, 0x55, 0x75, 0x69, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x53,
+	0x63, 0x6f, 0x70, 0x65, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x09, 0x52, 0x06, 0x53, 0x63, 0x6f,
+	0x70, 0x65, 0x73, 0x22, 0xd0, 0x01, 0x0a, 0x0c, 0x45, 0x78, 0x70, 0x6c, 0x61, 0x69, 0x6e, 0x65,
+	0x64, 0x41, 0x43, 0x4c, 0x12, 0x16, 0x0a, 0x06, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x14, 0x0a, 0x05,
+	0x56, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x56, 0x61, 0x6c,
+	0x75, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x57, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x49,
+	0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x57, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61,
+	0x63, 0x65, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x52, 0x6f, 0x6c, 0x65, 0x49, 0x64, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x52, 0x6f, 0x6c, 0x65, 0x49, 0x64, 0x12, 0x1c, 0x0a, 0x09,
+	0x52, 0x6f, 0x6c, 0x65, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x09, 0x52, 0x6f, 0x6c, 0x65, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x12, 0x1a, 0x0a, 0x08, 0x52, 0x6f,
+	0x6c, 0x65, 0x54, 0x79, 0x70, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x52, 0x6f,
+	0x6c, 0x65, 0x54, 0x79, 0x70, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x4f, 0x76, 0x65, 0x72, 0x72, 0x69,
+	0x64, 0x64, 0x65, 0x6e, 0x18, 0x07, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x4f, 0x76, 0x65, 0x72,
+	0x72, 0x69, 0x64, 0x64, 0x65, 0x6e, 0x22, 0xc5, 0x01, 0x0a, 0x0d, 0x45, 0x78, 0x70, 0x6c, 0x61,
+	0x69, 0x6e, 0x65, 0x64, 0x4e, 0x6f, 0x64, 0x65, 0x12, 0x1e, 0x0a, 0x04, 0x4e, 0x6f, 0x64, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0a, 0x2e, 0x74, 0x72, 0x65, 0x65, 0x2e, 0x4e, 0x6f,
+	0x64, 0x65, 0x52, 0x04, 0x4e, 0x6f, 0x64, 0x65, 0x12, 0x26, 0x0a, 0x04, 0x41, 0x43, 0x4c, 0x73,
+	0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x72, 0x65, 0x73, 0x74, 0x2e, 0x45, 0x78,
+	0x70, 0x6c, 0x61, 0x69, 0x6e, 0x65, 0x64, 0x41, 0x43, 0x4c, 0x52, 0x04, 0x41, 0x43, 0x4c, 0x73,
+	0x12, 0x20, 0x0a, 0x0b, 0x50, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x18,
+	0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0b, 0x50, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f,
+	0x6e, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x49, 0x64, 0x73, 0x18,
+	0x04, 0x20, 0x03, 0x28, 0x09, 0x52, 0x09, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x49, 0x64, 0x73,
+	0x12, 0x12, 0x0a, 0x04, 0x44, 0x65, 0x6e, 0x79, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x04,
+	0x44, 0x65, 0x6e, 0x79, 0x12, 0x18, 0x0a, 0x07, 0x41, 0x70, 0x70, 0x6c, 0x69, 0x65, 0x64, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x41, 0x70, 0x70, 0x6c, 0x69, 0x65, 0x64, 0x22, 0x8b,
+	0x01, 0x0a, 0x0f, 0x45, 0x78, 0x70, 0x6c, 0x61, 0x69, 0x6e, 0x65, 0x64, 0x50, 0x6f, 0x6c, 0x69,
+	0x63, 0x79, 0x12, 0x1a, 0x0a, 0x08, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x49, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x49, 0x64, 0x12, 0x1e,
+	0x0a, 0x0a, 0x50, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0a, 0x50, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x18,
+	0x0a, 0x07, 0x41, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x07, 0x41, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x64, 0x12, 0x22, 0x0a, 0x0c, 0x45, 0x78, 0x70, 0x6c,
+	0x69, 0x63, 0x69, 0x74, 0x44, 0x65, 0x6e, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0c,
+	0x45, 0x78, 0x70, 0x6c, 0x69, 0x63, 0x69, 0x74, 0x44, 0x65, 0x6e, 0x79, 0x22, 0x7a, 0x0a, 0x12,
+	0x45, 0x78, 0x70, 0x6c, 0x61, 0x69, 0x6e, 0x65, 0x64, 0x57, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61,
+	0x63, 0x65, 0x12, 0x2c, 0x0a, 0x09, 0x57, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x69, 0x64, 0x6d, 0x2e, 0x57, 0x6f, 0x72, 0x6b,
+	0x73, 0x70, 0x61, 0x63, 0x65, 0x52, 0x09, 0x57, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65,
+	0x12, 0x1a, 0x0a, 0x08, 0x52, 0x6f, 0x6f, 0x74, 0x55, 0x75, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x08, 0x52, 0x6f, 0x6f, 0x74, 0x55, 0x75, 0x69, 0x64, 0x12, 0x1a, 0x0a, 0x08,
+	0x52, 0x6f, 0x6f, 0x74, 0x50, 0x61, 0x74, 0x68, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08,
+	0x52, 0x6f, 0x6f, 0x74, 0x50, 0x61, 0x74, 0x68, 0x22, 0x80, 0x03, 0x0a, 0x12, 0x45, 0x78, 0x70,
+	0x6c, 0x61, 0x69, 0x6e, 0x41, 0x43, 0x4c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x1d, 0x0a, 0x04, 0x55, 0x73, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x09, 0x2e,
+	0x69, 0x64, 0x6d, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x52, 0x04, 0x55, 0x73, 0x65, 0x72, 0x12, 0x1e,
+	0x0a, 0x04, 0x4e, 0x6f, 0x64, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0a, 0x2e, 0x74,
+	0x72, 0x65, 0x65, 0x2e, 0x4e, 0x6f, 0x64, 0x65, 0x52, 0x04, 0x4e, 0x6f, 0x64, 0x65, 0x12, 0x20,
+	0x0a, 0x0b, 0x50, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x03, 0x20,
+	0x03, 0x28, 0x09, 0x52, 0x0b, 0x50, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73,
+	0x12, 0x1f, 0x0a, 0x05, 0x52, 0x6f, 0x6c, 0x65, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x09, 0x2e, 0x69, 0x64, 0x6d, 0x2e, 0x52, 0x6f, 0x6c, 0x65, 0x52, 0x05, 0x52, 0x6f, 0x6c, 0x65,
+	0x73, 0x12, 0x29, 0x0a, 0x05, 0x4e, 0x6f, 0x64, 0x65, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x13, 0x2e, 0x72, 0x65, 0x73, 0x74, 0x2e, 0x45, 0x78, 0x70, 0x6c, 0x61, 0x69, 0x6e, 0x65,
+	0x64, 0x4e, 0x6f, 0x64, 0x65, 0x52, 0x05, 0x4e, 0x6f, 0x64, 0x65, 0x73, 0x12, 0x38, 0x0a, 0x0a,
+	0x57, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x18, 0x2e, 0x72, 0x65, 0x73, 0x74, 0x2e, 0x45, 0x78, 0x70, 0x6c, 0x61, 0x69, 0x6e, 0x65,
+	0x64, 0x57, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x52, 0x0a, 0x57, 0x6f, 0x72, 0x6b,
+	0x73, 0x70, 0x61, 0x63, 0x65, 0x73, 0x12, 0x31, 0x0a, 0x08, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x69,
+	0x65, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x72, 0x65, 0x73, 0x74, 0x2e,
+	0x45, 0x78, 0x70, 0x6c, 0x61, 0x69, 0x6e, 0x65, 0x64, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x52,
+	0x08, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x69, 0x65, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x44, 0x65, 0x6e,
+	0x69, 0x65, 0x64, 0x42, 0x79, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x44, 0x65, 0x6e,
+	0x69, 0x65, 0x64, 0x42, 0x79, 0x12, 0x1e, 0x0a, 0x0a, 0x53, 0x63, 0x6f, 0x70, 0x65, 0x73, 0x44,
+	0x65, 0x6e, 0x79, 0x18, 0x09, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0a, 0x53, 0x63, 0x6f, 0x70, 0x65,
+	0x73, 0x44, 0x65, 0x6e, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x54, 0x72, 0x61, 0x63, 0x65, 0x18, 0x0a,
+	0x20, 0x03, 0x28, 0x09, 0x52, 0x05, 0x54, 0x72, 0x61, 0x63, 0x65, 0x22, 0xb6, 0x02, 0x0a, 0x16,
+	0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x57, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x33, 0x0a, 0x07, 0x51, 0x75, 0x65, 0x72, 0x69, 0x65,
+	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x69, 0x64, 0x6d, 0x2e, 0x57, 0x6f,
+	0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x53, 0x69, 0x6e, 0x67, 0x6c, 0x65, 0x51, 0x75, 0x65,
+	0x72, 0x79, 0x52, 0x07, 0x51, 0x75, 0x65, 0x72, 0x69, 0x65, 0x73, 0x12, 0x4b, 0x0a, 0x13, 0x52,
+	0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x51, 0x75, 0x65,
+	0x72, 0x79, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x72, 0x65, 0x73, 0x74, 0x2e,
 	0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x51, 0x75,
-	0x65, 0x72, 0x79, 0x12, 0x16, 0x0a, 0x06, 0x4f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x03, 0x52, 0x06, 0x4f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x4c,
-	0x69, 0x6d, 0x69, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x4c, 0x69, 0x6d, 0x69,
-	0x74, 0x12, 0x18, 0x0a, 0x07, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x42, 0x79, 0x18, 0x04, 0x20, 0x01,
-	0x28, 0x05, 0x52, 0x07, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x42, 0x79, 0x12, 0x1c, 0x0a, 0x09, 0x43,
-	0x6f, 0x75, 0x6e, 0x74, 0x4f, 0x6e, 0x6c, 0x79, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09,
-	0x43, 0x6f, 0x75, 0x6e, 0x74, 0x4f, 0x6e, 0x6c, 0x79, 0x12, 0x34, 0x0a, 0x09, 0x4f, 0x70, 0x65,
-	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x16, 0x2e, 0x73,
-	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x54, 0x79, 0x70, 0x65, 0x52, 0x09, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22,
-	0x48, 0x0a, 0x0f, 0x52, 0x6f, 0x6c, 0x65, 0x73, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69,
-	0x6f, 0x6e, 0x12, 0x1f, 0x0a, 0x05, 0x52, 0x6f, 0x6c, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
-	0x0b, 0x32, 0x09, 0x2e, 0x69, 0x64, 0x6d, 0x2e, 0x52, 0x6f, 0x6c, 0x65, 0x52, 0x05, 0x52, 0x6f,
-	0x6c, 0x65, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x05, 0x52, 0x05, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x22, 0xac, 0x02, 0x0a, 0x11, 0x53, 0x65,
-	0x61, 0x72, 0x63, 0x68, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
-	0x2e, 0x0a, 0x07, 0x51, 0x75, 0x65, 0x72, 0x69, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
-	0x32, 0x14, 0x2e, 0x69, 0x64, 0x6d, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x53, 0x69, 0x6e, 0x67, 0x6c,
-	0x65, 0x51, 0x75, 0x65, 0x72, 0x79, 0x52, 0x07, 0x51, 0x75, 0x65, 0x72, 0x69, 0x65, 0x73, 0x12,
-	0x4b, 0x0a, 0x13, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x50, 0x6f, 0x6c, 0x69, 0x63,
-	0x79, 0x51, 0x75, 0x65, 0x72, 0x79, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x72,
-	0x65, 0x73, 0x74, 0x2e, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x50, 0x6f, 0x6c, 0x69,
-	0x63, 0x79, 0x51, 0x75, 0x65, 0x72, 0x79, 0x52, 0x13, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63,
-	0x65, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x51, 0x75, 0x65, 0x72, 0x79, 0x12, 0x16, 0x0a, 0x06,
-	0x4f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x4f, 0x66,
-	0x66, 0x73, 0x65, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x03, 0x20,
-	0x01, 0x28, 0x03, 0x52, 0x05, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x47, 0x72,
-	0x6f, 0x75, 0x70, 0x42, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x47, 0x72, 0x6f,
-	0x75, 0x70, 0x42, 0x79, 0x12, 0x1c, 0x0a, 0x09, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x4f, 0x6e, 0x6c,
-	0x79, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x4f, 0x6e,
-	0x6c, 0x79, 0x12, 0x34, 0x0a, 0x09, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18,
-	0x06, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x16, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e,
-	0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x52, 0x09, 0x4f,
-	0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x6b, 0x0a, 0x0f, 0x55, 0x73, 0x65, 0x72,
-	0x73, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x21, 0x0a, 0x06, 0x47,
-	0x72, 0x6f, 0x75, 0x70, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x09, 0x2e, 0x69, 0x64,
-	0x6d, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x52, 0x06, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x12, 0x1f,
-	0x0a, 0x05, 0x55, 0x73, 0x65, 0x72, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x09, 0x2e,
-	0x69, 0x64, 0x6d, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x52, 0x05, 0x55, 0x73, 0x65, 0x72, 0x73, 0x12,
-	0x14, 0x0a, 0x05, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05,
-	0x54, 0x6f, 0x74, 0x61, 0x6c, 0x22, 0x28, 0x0a, 0x0c, 0x42, 0x69, 0x6e, 0x64, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x53, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x53, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x22,
-	0xdd, 0x01, 0x0a, 0x10, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x41, 0x43, 0x4c, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x12, 0x2d, 0x0a, 0x07, 0x51, 0x75, 0x65, 0x72, 0x69, 0x65, 0x73, 0x18,
-	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x69, 0x64, 0x6d, 0x2e, 0x41, 0x43, 0x4c, 0x53,
-	0x69, 0x6e, 0x67, 0x6c, 0x65, 0x51, 0x75, 0x65, 0x72, 0x79, 0x52, 0x07, 0x51, 0x75, 0x65, 0x72,
-	0x69, 0x65, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x4f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x03, 0x52, 0x06, 0x4f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x4c,
-	0x69, 0x6d, 0x69, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x4c, 0x69, 0x6d, 0x69,
-	0x74, 0x12, 0x18, 0x0a, 0x07, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x42, 0x79, 0x18, 0x04, 0x20, 0x01,
-	0x28, 0x05, 0x52, 0x07, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x42, 0x79, 0x12, 0x1c, 0x0a, 0x09, 0x43,
-	0x6f, 0x75, 0x6e, 0x74, 0x4f, 0x6e, 0x6c, 0x79, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09,
-	0x43, 0x6f, 0x75, 0x6e, 0x74, 0x4f, 0x6e, 0x6c, 0x79, 0x12, 0x34, 0x0a, 0x09, 0x4f, 0x70, 0x65,
-	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x16, 0x2e, 0x73,
-	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x54, 0x79, 0x70, 0x65, 0x52, 0x09, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22,
-	0x43, 0x0a, 0x0d, 0x41, 0x43, 0x4c, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e,
-	0x12, 0x1c, 0x0a, 0x04, 0x41, 0x43, 0x4c, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x08,
-	0x2e, 0x69, 0x64, 0x6d, 0x2e, 0x41, 0x43, 0x4c, 0x52, 0x04, 0x41, 0x43, 0x4c, 0x73, 0x12, 0x14,
-	0x0a, 0x05, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x54,
-	0x6f, 0x74, 0x61, 0x6c, 0x22, 0xb6, 0x02, 0x0a, 0x16, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x57,
-	0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
-	0x33, 0x0a, 0x07, 0x51, 0x75, 0x65, 0x72, 0x69, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
-	0x32, 0x19, 0x2e, 0x69, 0x64, 0x6d, 0x2e, 0x57, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65,
-	0x53, 0x69, 0x6e, 0x67, 0x6c, 0x65, 0x51, 0x75, 0x65, 0x72, 0x79, 0x52, 0x07, 0x51, 0x75, 0x65,
-	0x72, 0x69, 0x65, 0x73, 0x12, 0x4b, 0x0a, 0x13, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65,
-	0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x51, 0x75, 0x65, 0x72, 0x79, 0x18, 0x07, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x19, 0x2e, 0x72, 0x65, 0x73, 0x74, 0x2e, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63,
-	0x65, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x51, 0x75, 0x65, 0x72, 0x79, 0x52, 0x13, 0x52, 0x65,
-	0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x51, 0x75, 0x65, 0x72,
-	0x79, 0x12, 0x16, 0x0a, 0x06, 0x4f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x03, 0x52, 0x06, 0x4f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x4c, 0x69, 0x6d,
-	0x69, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x12,
-	0x18, 0x0a, 0x07, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x42, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05,
-	0x52, 0x07, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x42, 0x79, 0x12, 0x1c, 0x0a, 0x09, 0x43, 0x6f, 0x75,
-	0x6e, 0x74, 0x4f, 0x6e, 0x6c, 0x79, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x43, 0x6f,
-	0x75, 0x6e, 0x74, 0x4f, 0x6e, 0x6c, 0x79, 0x12, 0x34, 0x0a, 0x09, 0x4f, 0x70, 0x65, 0x72, 0x61,
-	0x74, 0x69, 0x6f, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x16, 0x2e, 0x73, 0x65, 0x72,
-	0x76, 0x69, 0x63, 0x65, 0x2e, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x79,
-	0x70, 0x65, 0x52, 0x09, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x5b, 0x0a,
-	0x13, 0x57, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63,
-	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x2e, 0x0a, 0x0a, 0x57, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63,
-	0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x69, 0x64, 0x6d, 0x2e, 0x57,
-	0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x52, 0x0a, 0x57, 0x6f, 0x72, 0x6b, 0x73, 0x70,
-	0x61, 0x63, 0x65, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x05, 0x52, 0x05, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x22, 0x41, 0x0a, 0x12, 0x55, 0x73,
-	0x65, 0x72, 0x4d, 0x65, 0x74, 0x61, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e,
-	0x12, 0x2b, 0x0a, 0x09, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x73, 0x18, 0x01, 0x20,
-	0x03, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x69, 0x64, 0x6d, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x4d, 0x65,
-	0x74, 0x61, 0x52, 0x09, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x73, 0x22, 0x55, 0x0a,
-	0x1b, 0x55, 0x73, 0x65, 0x72, 0x4d, 0x65, 0x74, 0x61, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61,
-	0x63, 0x65, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x36, 0x0a, 0x0a,
-	0x4e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
-	0x32, 0x16, 0x2e, 0x69, 0x64, 0x6d, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x4d, 0x65, 0x74, 0x61, 0x4e,
-	0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x52, 0x0a, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x70,
-	0x61, 0x63, 0x65, 0x73, 0x22, 0x37, 0x0a, 0x17, 0x4c, 0x69, 0x73, 0x74, 0x55, 0x73, 0x65, 0x72,
-	0x4d, 0x65, 0x74, 0x61, 0x54, 0x61, 0x67, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
-	0x1c, 0x0a, 0x09, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x09, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x22, 0x2e, 0x0a,
-	0x18, 0x4c, 0x69, 0x73, 0x74, 0x55, 0x73, 0x65, 0x72, 0x4d, 0x65, 0x74, 0x61, 0x54, 0x61, 0x67,
-	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x54, 0x61, 0x67,
-	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x04, 0x54, 0x61, 0x67, 0x73, 0x22, 0x47, 0x0a,
-	0x15, 0x50, 0x75, 0x74, 0x55, 0x73, 0x65, 0x72, 0x4d, 0x65, 0x74, 0x61, 0x54, 0x61, 0x67, 0x52,
+	0x65, 0x72, 0x79, 0x52, 0x13, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x50, 0x6f, 0x6c,
+	0x69, 0x63, 0x79, 0x51, 0x75, 0x65, 0x72, 0x79, 0x12, 0x16, 0x0a, 0x06, 0x4f, 0x66, 0x66, 0x73,
+	0x65, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x4f, 0x66, 0x66, 0x73, 0x65, 0x74,
+	0x12, 0x14, 0x0a, 0x05, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x05, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x42,
+	0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x42, 0x79,
+	0x12, 0x1c, 0x0a, 0x09, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x4f, 0x6e, 0x6c, 0x79, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x09, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x4f, 0x6e, 0x6c, 0x79, 0x12, 0x34,
+	0x0a, 0x09, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x0e, 0x32, 0x16, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x4f, 0x70, 0x65, 0x72,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x52, 0x09, 0x4f, 0x70, 0x65, 0x72, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x22, 0x5b, 0x0a, 0x13, 0x57, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63,
+	0x65, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x2e, 0x0a, 0x0a, 0x57,
+	0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x0e, 0x2e, 0x69, 0x64, 0x6d, 0x2e, 0x57, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x52,
+	0x0a, 0x57, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x54,
+	0x6f, 0x74, 0x61, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x54, 0x6f, 0x74, 0x61,
+	0x6c, 0x22, 0x41, 0x0a, 0x12, 0x55, 0x73, 0x65, 0x72, 0x4d, 0x65, 0x74, 0x61, 0x43, 0x6f, 0x6c,
+	0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x2b, 0x0a, 0x09, 0x4d, 0x65, 0x74, 0x61, 0x64,
+	0x61, 0x74, 0x61, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x69, 0x64, 0x6d,
+	0x2e, 0x55, 0x73, 0x65, 0x72, 0x4d, 0x65, 0x74, 0x61, 0x52, 0x09, 0x4d, 0x65, 0x74, 0x61, 0x64,
+	0x61, 0x74, 0x61, 0x73, 0x22, 0x55, 0x0a, 0x1b, 0x55, 0x73, 0x65, 0x72, 0x4d, 0x65, 0x74, 0x61,
+	0x4e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x12, 0x36, 0x0a, 0x0a, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65,
+	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x69, 0x64, 0x6d, 0x2e, 0x55, 0x73,
+	0x65, 0x72, 0x4d, 0x65, 0x74, 0x61, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x52,
+	0x0a, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x73, 0x22, 0x37, 0x0a, 0x17, 0x4c,
+	0x69, 0x73, 0x74, 0x55, 0x73, 0x65, 0x72, 0x4d, 0x65, 0x74, 0x61, 0x54, 0x61, 0x67, 0x73, 0x52,
 	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x70,
 	0x61, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x4e, 0x61, 0x6d, 0x65, 0x73,
-	0x70, 0x61, 0x63, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x54, 0x61, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x03, 0x54, 0x61, 0x67, 0x22, 0x32, 0x0a, 0x16, 0x50, 0x75, 0x74, 0x55, 0x73, 0x65,
-	0x72, 0x4d, 0x65, 0x74, 0x61, 0x54, 0x61, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x12, 0x18, 0x0a, 0x07, 0x53, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x08, 0x52, 0x07, 0x53, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x22, 0x4d, 0x0a, 0x19, 0x44, 0x65,
-	0x6c, 0x65, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x4d, 0x65, 0x74, 0x61, 0x54, 0x61, 0x67, 0x73,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x4e, 0x61, 0x6d, 0x65, 0x73,
-	0x70, 0x61, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x4e, 0x61, 0x6d, 0x65,
-	0x73, 0x70, 0x61, 0x63, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x54, 0x61, 0x67, 0x73, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x04, 0x54, 0x61, 0x67, 0x73, 0x22, 0x36, 0x0a, 0x1a, 0x44, 0x65, 0x6c,
-	0x65, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x4d, 0x65, 0x74, 0x61, 0x54, 0x61, 0x67, 0x73, 0x52,
+	0x70, 0x61, 0x63, 0x65, 0x22, 0x2e, 0x0a, 0x18, 0x4c, 0x69, 0x73, 0x74, 0x55, 0x73, 0x65, 0x72,
+	0x4d, 0x65, 0x74, 0x61, 0x54, 0x61, 0x67, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x12, 0x0a, 0x04, 0x54, 0x61, 0x67, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x04,
+	0x54, 0x61, 0x67, 0x73, 0x22, 0x47, 0x0a, 0x15, 0x50, 0x75, 0x74, 0x55, 0x73, 0x65, 0x72, 0x4d,
+	0x65, 0x74, 0x61, 0x54, 0x61, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1c, 0x0a,
+	0x09, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x09, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x54,
+	0x61, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x54, 0x61, 0x67, 0x22, 0x32, 0x0a,
+	0x16, 0x50, 0x75, 0x74, 0x55, 0x73, 0x65, 0x72, 0x4d, 0x65, 0x74, 0x61, 0x54, 0x61, 0x67, 0x52,
 	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x53, 0x75, 0x63, 0x63, 0x65,
 	0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x53, 0x75, 0x63, 0x63, 0x65, 0x73,
-	0x73, 0x22, 0x28, 0x0a, 0x14, 0x55, 0x73, 0x65, 0x72, 0x42, 0x6f, 0x6f, 0x6b, 0x6d, 0x61, 0x72,
-	0x6b, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x41, 0x6c, 0x6c,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x03, 0x41, 0x6c, 0x6c, 0x22, 0x29, 0x0a, 0x0d, 0x52,
-	0x65, 0x76, 0x6f, 0x6b, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x07,
-	0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x54,
-	0x6f, 0x6b, 0x65, 0x6e, 0x49, 0x64, 0x22, 0x44, 0x0a, 0x0e, 0x52, 0x65, 0x76, 0x6f, 0x6b, 0x65,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x53, 0x75, 0x63, 0x63,
-	0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x53, 0x75, 0x63, 0x63, 0x65,
-	0x73, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x07, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x51, 0x0a, 0x19,
-	0x52, 0x65, 0x73, 0x65, 0x74, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x54, 0x6f, 0x6b,
-	0x65, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x55, 0x73, 0x65,
-	0x72, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x55, 0x73,
-	0x65, 0x72, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x43, 0x72, 0x65, 0x61, 0x74,
-	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x22,
-	0x50, 0x0a, 0x1a, 0x52, 0x65, 0x73, 0x65, 0x74, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64,
-	0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a,
-	0x07, 0x53, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07,
-	0x53, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x4d, 0x65, 0x73, 0x73, 0x61,
-	0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67,
-	0x65, 0x22, 0x86, 0x01, 0x0a, 0x14, 0x52, 0x65, 0x73, 0x65, 0x74, 0x50, 0x61, 0x73, 0x73, 0x77,
-	0x6f, 0x72, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2e, 0x0a, 0x12, 0x52, 0x65,
-	0x73, 0x65, 0x74, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x54, 0x6f, 0x6b, 0x65, 0x6e,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x12, 0x52, 0x65, 0x73, 0x65, 0x74, 0x50, 0x61, 0x73,
-	0x73, 0x77, 0x6f, 0x72, 0x64, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x1c, 0x0a, 0x09, 0x55, 0x73,
-	0x65, 0x72, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x55,
-	0x73, 0x65, 0x72, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x12, 0x20, 0x0a, 0x0b, 0x4e, 0x65, 0x77, 0x50,
-	0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x4e,
-	0x65, 0x77, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x22, 0x4b, 0x0a, 0x15, 0x52, 0x65,
-	0x73, 0x65, 0x74, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x53, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x53, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x18, 0x0a,
-	0x07, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
-	0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x4c, 0x0a, 0x1a, 0x44, 0x6f, 0x63, 0x75, 0x6d,
-	0x65, 0x6e, 0x74, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x50, 0x61, 0x74, 0x68, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x04, 0x50, 0x61, 0x74, 0x68, 0x12, 0x1a, 0x0a, 0x08, 0x43, 0x6c, 0x69,
-	0x65, 0x6e, 0x74, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x43, 0x6c, 0x69,
-	0x65, 0x6e, 0x74, 0x49, 0x44, 0x22, 0x3f, 0x0a, 0x1b, 0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e,
-	0x74, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x54, 0x6f,
-	0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x41, 0x63, 0x63, 0x65, 0x73,
-	0x73, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x42, 0x2d, 0x5a, 0x2b, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62,
-	0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x70, 0x79, 0x64, 0x69, 0x6f, 0x2f, 0x63, 0x65, 0x6c, 0x6c, 0x73,
-	0x2f, 0x76, 0x35, 0x2f, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x2f, 0x72, 0x65, 0x73, 0x74, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x73, 0x22, 0x4d, 0x0a, 0x19, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x4d,
+	0x65, 0x74, 0x61, 0x54, 0x61, 0x67, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1c,
+	0x0a, 0x09, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x09, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x12, 0x12, 0x0a, 0x04,
+	0x54, 0x61, 0x67, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x54, 0x61, 0x67, 0x73,
+	0x22, 0x36, 0x0a, 0x1a, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x4d, 0x65,
+	0x74, 0x61, 0x54, 0x61, 0x67, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18,
+	0x0a, 0x07, 0x53, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x07, 0x53, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x22, 0x28, 0x0a, 0x14, 0x55, 0x73, 0x65, 0x72,
+	0x42, 0x6f, 0x6f, 0x6b, 0x6d, 0x61, 0x72, 0x6b, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x10, 0x0a, 0x03, 0x41, 0x6c, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x03, 0x41,
+	0x6c, 0x6c, 0x22, 0x29, 0x0a, 0x0d, 0x52, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x49, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x49, 0x64, 0x22, 0x44, 0x0a,
+	0x0e, 0x52, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x18, 0x0a, 0x07, 0x53, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x07, 0x53, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x4d, 0x65, 0x73,
+	0x73, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x4d, 0x65, 0x73, 0x73,
+	0x61, 0x67, 0x65, 0x22, 0x51, 0x0a, 0x19, 0x52, 0x65, 0x73, 0x65, 0x74, 0x50, 0x61, 0x73, 0x73,
+	0x77, 0x6f, 0x72, 0x64, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x1c, 0x0a, 0x09, 0x55, 0x73, 0x65, 0x72, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x09, 0x55, 0x73, 0x65, 0x72, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x12, 0x16,
+	0x0a, 0x06, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06,
+	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x22, 0x50, 0x0a, 0x1a, 0x52, 0x65, 0x73, 0x65, 0x74, 0x50,
+	0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x53, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x53, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x18,
+	0x0a, 0x07, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x07, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x86, 0x01, 0x0a, 0x14, 0x52, 0x65, 0x73,
+	0x65, 0x74, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x2e, 0x0a, 0x12, 0x52, 0x65, 0x73, 0x65, 0x74, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f,
+	0x72, 0x64, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x12, 0x52,
+	0x65, 0x73, 0x65, 0x74, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x54, 0x6f, 0x6b, 0x65,
+	0x6e, 0x12, 0x1c, 0x0a, 0x09, 0x55, 0x73, 0x65, 0x72, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x55, 0x73, 0x65, 0x72, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x12,
+	0x20, 0x0a, 0x0b, 0x4e, 0x65, 0x77, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x4e, 0x65, 0x77, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72,
+	0x64, 0x22, 0x4b, 0x0a, 0x15, 0x52, 0x65, 0x73, 0x65, 0x74, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f,
+	0x72, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x53, 0x75,
+	0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x53, 0x75, 0x63,
+	0x63, 0x65, 0x73, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x4c,
+	0x0a, 0x1a, 0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73,
+	0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04,
+	0x50, 0x61, 0x74, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x50, 0x61, 0x74, 0x68,
+	0x12, 0x1a, 0x0a, 0x08, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x08, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x49, 0x44, 0x22, 0x3f, 0x0a, 0x1b,
+	0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x54, 0x6f,
+	0x6b, 0x65, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x41,
+	0x63, 0x63, 0x65, 0x73, 0x73, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0b, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0xd4, 0x03,
+	0x0a, 0x09, 0x45, 0x6c, 0x65, 0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x12, 0x0a, 0x04, 0x55,
+	0x75, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x55, 0x75, 0x69, 0x64, 0x12,
+	0x1c, 0x0a, 0x09, 0x55, 0x73, 0x65, 0x72, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x09, 0x55, 0x73, 0x65, 0x72, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x12, 0x1a, 0x0a,
+	0x08, 0x55, 0x73, 0x65, 0x72, 0x55, 0x75, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x08, 0x55, 0x73, 0x65, 0x72, 0x55, 0x75, 0x69, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x52, 0x6f, 0x6c,
+	0x65, 0x49, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x52, 0x6f, 0x6c, 0x65, 0x49,
+	0x64, 0x12, 0x1c, 0x0a, 0x09, 0x52, 0x6f, 0x6c, 0x65, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x52, 0x6f, 0x6c, 0x65, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x12,
+	0x24, 0x0a, 0x0d, 0x4a, 0x75, 0x73, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x4a, 0x75, 0x73, 0x74, 0x69, 0x66, 0x69, 0x63,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1a, 0x0a, 0x08, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x12, 0x2d, 0x0a, 0x06, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28,
+	0x0e, 0x32, 0x15, 0x2e, 0x72, 0x65, 0x73, 0x74, 0x2e, 0x45, 0x6c, 0x65, 0x76, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x12, 0x1a, 0x0a, 0x08, 0x41, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x72, 0x18, 0x09, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x08, 0x41, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x72, 0x12, 0x18, 0x0a, 0x07,
+	0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x43,
+	0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x64, 0x41, 0x74, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x43, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x64, 0x41, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x44, 0x65, 0x63, 0x69, 0x64, 0x65, 0x64, 0x41,
+	0x74, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x44, 0x65, 0x63, 0x69, 0x64, 0x65, 0x64,
+	0x41, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x45, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x41, 0x74, 0x18,
+	0x0d, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x45, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x41, 0x74,
+	0x12, 0x18, 0x0a, 0x07, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x18, 0x0e, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x07, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x12, 0x28, 0x0a, 0x0f, 0x50, 0x72,
+	0x65, 0x76, 0x69, 0x6f, 0x75, 0x73, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x18, 0x0f, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0f, 0x50, 0x72, 0x65, 0x76, 0x69, 0x6f, 0x75, 0x73, 0x50, 0x72, 0x6f,
+	0x66, 0x69, 0x6c, 0x65, 0x22, 0x8c, 0x01, 0x0a, 0x16, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x45,
+	0x6c, 0x65, 0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x16, 0x0a, 0x06, 0x52, 0x6f, 0x6c, 0x65, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x06, 0x52, 0x6f, 0x6c, 0x65, 0x49, 0x64, 0x12, 0x24, 0x0a, 0x0d, 0x4a, 0x75, 0x73, 0x74, 0x69,
+	0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d,
+	0x4a, 0x75, 0x73, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1a, 0x0a,
+	0x08, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x08, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x18, 0x0a, 0x07, 0x50, 0x72, 0x6f,
+	0x66, 0x69, 0x6c, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x50, 0x72, 0x6f, 0x66,
+	0x69, 0x6c, 0x65, 0x22, 0x92, 0x01, 0x0a, 0x15, 0x4c, 0x69, 0x73, 0x74, 0x45, 0x6c, 0x65, 0x76,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2d, 0x0a,
+	0x06, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0e, 0x32, 0x15, 0x2e,
+	0x72, 0x65, 0x73, 0x74, 0x2e, 0x45, 0x6c, 0x65, 0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x1c, 0x0a, 0x09,
+	0x55, 0x73, 0x65, 0x72, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x09, 0x55, 0x73, 0x65, 0x72, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x4f, 0x66,
+	0x66, 0x73, 0x65, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x4f, 0x66, 0x66, 0x73,
+	0x65, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x05, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x22, 0x5c, 0x0a, 0x13, 0x45, 0x6c, 0x65, 0x76,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12,
+	0x2f, 0x0a, 0x0a, 0x45, 0x6c, 0x65, 0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x72, 0x65, 0x73, 0x74, 0x2e, 0x45, 0x6c, 0x65, 0x76, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0a, 0x45, 0x6c, 0x65, 0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73,
+	0x12, 0x14, 0x0a, 0x05, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x05, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x22, 0x60, 0x0a, 0x16, 0x44, 0x65, 0x63, 0x69, 0x64, 0x65,
+	0x45, 0x6c, 0x65, 0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x12, 0x0a, 0x04, 0x55, 0x75, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
+	0x55, 0x75, 0x69, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x41, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x41, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x12, 0x18,
+	0x0a, 0x07, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x07, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x22, 0x2c, 0x0a, 0x16, 0x52, 0x65, 0x76, 0x6f,
+	0x6b, 0x65, 0x45, 0x6c, 0x65, 0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x55, 0x75, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x04, 0x55, 0x75, 0x69, 0x64, 0x22, 0x5f, 0x0a, 0x15, 0x45, 0x6c, 0x65, 0x76, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x14, 0x0a, 0x05, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
+	0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x52, 0x65, 0x6a, 0x65, 0x63, 0x74, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x52, 0x65, 0x6a, 0x65, 0x63, 0x74, 0x12, 0x18, 0x0a,
+	0x07, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
+	0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x22, 0x92, 0x01, 0x0a, 0x0e, 0x44, 0x65, 0x6c, 0x65,
+	0x67, 0x61, 0x74, 0x65, 0x64, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x12, 0x1a, 0x0a, 0x08, 0x52, 0x6f,
+	0x6c, 0x65, 0x55, 0x75, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x52, 0x6f,
+	0x6c, 0x65, 0x55, 0x75, 0x69, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x52, 0x6f, 0x6c, 0x65, 0x4c, 0x61,
+	0x62, 0x65, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x52, 0x6f, 0x6c, 0x65, 0x4c,
+	0x61, 0x62, 0x65, 0x6c, 0x12, 0x1c, 0x0a, 0x09, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x50, 0x61, 0x74,
+	0x68, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x50, 0x61,
+	0x74, 0x68, 0x12, 0x28, 0x0a, 0x0f, 0x41, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x62, 0x6c, 0x65,
+	0x52, 0x6f, 0x6c, 0x65, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0f, 0x41, 0x73, 0x73,
+	0x69, 0x67, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x52, 0x6f, 0x6c, 0x65, 0x73, 0x22, 0x38, 0x0a, 0x1a,
+	0x4c, 0x69, 0x73, 0x74, 0x44, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x65, 0x64, 0x41, 0x64, 0x6d,
+	0x69, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x52, 0x6f,
+	0x6c, 0x65, 0x55, 0x75, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x52, 0x6f,
+	0x6c, 0x65, 0x55, 0x75, 0x69, 0x64, 0x22, 0x52, 0x0a, 0x18, 0x44, 0x65, 0x6c, 0x65, 0x67, 0x61,
+	0x74, 0x65, 0x64, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x12, 0x36, 0x0a, 0x0b, 0x44, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x72, 0x65, 0x73, 0x74, 0x2e, 0x44,
+	0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x65, 0x64, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x52, 0x0b, 0x44,
+	0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0x4f, 0x0a, 0x11, 0x51, 0x75,
+	0x6f, 0x74, 0x61, 0x55, 0x73, 0x61, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x1c, 0x0a, 0x09, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x50, 0x61, 0x74, 0x68, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x09, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x50, 0x61, 0x74, 0x68, 0x12, 0x1c, 0x0a,
+	0x09, 0x52, 0x65, 0x63, 0x75, 0x72, 0x73, 0x69, 0x76, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x09, 0x52, 0x65, 0x63, 0x75, 0x72, 0x73, 0x69, 0x76, 0x65, 0x22, 0x80, 0x02, 0x0a, 0x0a,
+	0x51, 0x75, 0x6f, 0x74, 0x61, 0x55, 0x73, 0x61, 0x67, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x55, 0x75,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x55, 0x75, 0x69, 0x64, 0x12, 0x14,
+	0x0a, 0x05, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x4c,
+	0x61, 0x62, 0x65, 0x6c, 0x12, 0x1c, 0x0a, 0x09, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x50, 0x61, 0x74,
+	0x68, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x50, 0x61,
+	0x74, 0x68, 0x12, 0x18, 0x0a, 0x07, 0x49, 0x73, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x07, 0x49, 0x73, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x12, 0x24, 0x0a, 0x0d,
+	0x50, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x61, 0x6c, 0x55, 0x73, 0x61, 0x67, 0x65, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x0d, 0x50, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x61, 0x6c, 0x55, 0x73, 0x61,
+	0x67, 0x65, 0x12, 0x24, 0x0a, 0x0d, 0x50, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x61, 0x6c, 0x51, 0x75,
+	0x6f, 0x74, 0x61, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0d, 0x50, 0x65, 0x72, 0x73, 0x6f,
+	0x6e, 0x61, 0x6c, 0x51, 0x75, 0x6f, 0x74, 0x61, 0x12, 0x14, 0x0a, 0x05, 0x55, 0x73, 0x61, 0x67,
+	0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x55, 0x73, 0x61, 0x67, 0x65, 0x12, 0x14,
+	0x0a, 0x05, 0x51, 0x75, 0x6f, 0x74, 0x61, 0x18, 0x08, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x51,
+	0x75, 0x6f, 0x74, 0x61, 0x12, 0x18, 0x0a, 0x07, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x18,
+	0x09, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x22, 0x60,
+	0x0a, 0x14, 0x51, 0x75, 0x6f, 0x74, 0x61, 0x55, 0x73, 0x61, 0x67, 0x65, 0x43, 0x6f, 0x6c, 0x6c,
+	0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x28, 0x0a, 0x06, 0x55, 0x73, 0x61, 0x67, 0x65, 0x73,
+	0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x72, 0x65, 0x73, 0x74, 0x2e, 0x51, 0x75,
+	0x6f, 0x74, 0x61, 0x55, 0x73, 0x61, 0x67, 0x65, 0x52, 0x06, 0x55, 0x73, 0x61, 0x67, 0x65, 0x73,
+	0x12, 0x1e, 0x0a, 0x0a, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x55, 0x73, 0x61, 0x67, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x55, 0x73, 0x61, 0x67, 0x65,
+	0x2a, 0x7f, 0x0a, 0x0f, 0x45, 0x6c, 0x65, 0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x12, 0x14, 0x0a, 0x10, 0x45, 0x6c, 0x65, 0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x50, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x10, 0x00, 0x12, 0x13, 0x0a, 0x0f, 0x45, 0x6c, 0x65,
+	0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x41, 0x63, 0x74, 0x69, 0x76, 0x65, 0x10, 0x01, 0x12, 0x15,
+	0x0a, 0x11, 0x45, 0x6c, 0x65, 0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x6a, 0x65, 0x63,
+	0x74, 0x65, 0x64, 0x10, 0x02, 0x12, 0x14, 0x0a, 0x10, 0x45, 0x6c, 0x65, 0x76, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x45, 0x78, 0x70, 0x69, 0x72, 0x65, 0x64, 0x10, 0x03, 0x12, 0x14, 0x0a, 0x10, 0x45,
+	0x6c, 0x65, 0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x64, 0x10,
+	0x04, 0x42, 0x2d, 0x5a, 0x2b, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f,
+	0x70, 0x79, 0x64, 0x69, 0x6f, 0x2f, 0x63, 0x65, 0x6c, 0x6c, 0x73, 0x2f, 0x76, 0x35, 0x2f, 0x63,
+	0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x72, 0x65, 0x73, 0x74,
+	0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -1918,74 +3770,111 @@ func file_cellsapi_idm_proto_rawDescGZIP() []byte {
 	return file_cellsapi_idm_proto_rawDescData
 }
 
-var file_cellsapi_idm_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
-var file_cellsapi_idm_proto_msgTypes = make([]protoimpl.MessageInfo, 27)
+var file_cellsapi_idm_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
+var file_cellsapi_idm_proto_msgTypes = make([]protoimpl.MessageInfo, 48)
 var file_cellsapi_idm_proto_goTypes = []any{
-	(ResourcePolicyQuery_QueryType)(0),  // 0: rest.ResourcePolicyQuery.QueryType
-	(*ResourcePolicyQuery)(nil),         // 1: rest.ResourcePolicyQuery
-	(*SearchRoleRequest)(nil),           // 2: rest.SearchRoleRequest
-	(*RolesCollection)(nil),             // 3: rest.RolesCollection
-	(*SearchUserRequest)(nil),           // 4: rest.SearchUserRequest
-	(*UsersCollection)(nil),             // 5: rest.UsersCollection
-	(*BindResponse)(nil),                // 6: rest.BindResponse
-	(*SearchACLRequest)(nil),            // 7: rest.SearchACLRequest
-	(*ACLCollection)(nil),               // 8: rest.ACLCollection
-	(*SearchWorkspaceRequest)(nil),      // 9: rest.SearchWorkspaceRequest
-	(*WorkspaceCollection)(nil),         // 10: rest.WorkspaceCollection
-	(*UserMetaCollection)(nil),          // 11: rest.UserMetaCollection
-	(*UserMetaNamespaceCollection)(nil), // 12: rest.UserMetaNamespaceCollection
-	(*ListUserMetaTagsRequest)(nil),     // 13: rest.ListUserMetaTagsRequest
-	(*ListUserMetaTagsResponse)(nil),    // 14: rest.ListUserMetaTagsResponse
-	(*PutUserMetaTagRequest)(nil),       // 15: rest.PutUserMetaTagRequest
-	(*PutUserMetaTagResponse)(nil),      // 16: rest.PutUserMetaTagResponse
-	(*DeleteUserMetaTagsRequest)(nil),   // 17: rest.DeleteUserMetaTagsRequest
-	(*DeleteUserMetaTagsResponse)(nil),  // 18: rest.DeleteUserMetaTagsResponse
-	(*UserBookmarksRequest)(nil),        // 19: rest.UserBookmarksRequest
-	(*RevokeRequest)(nil),               // 20: rest.RevokeRequest
-	(*RevokeResponse)(nil),              // 21: rest.RevokeResponse
-	(*ResetPasswordTokenRequest)(nil),   // 22: rest.ResetPasswordTokenRequest
-	(*ResetPasswordTokenResponse)(nil),  // 23: rest.ResetPasswordTokenResponse
-	(*ResetPasswordRequest)(nil),        // 24: rest.ResetPasswordRequest
-	(*ResetPasswordResponse)(nil),       // 25: rest.ResetPasswordResponse
-	(*DocumentAccessTokenRequest)(nil),  // 26: rest.DocumentAccessTokenRequest
-	(*DocumentAccessTokenResponse)(nil), // 27: rest.DocumentAccessTokenResponse
-	(*idm.RoleSingleQuery)(nil),         // 28: idm.RoleSingleQuery
-	(service.OperationType)(0),          // 29: service.OperationType
-	(*idm.Role)(nil),                    // 30: idm.Role
-	(*idm.UserSingleQuery)(nil),         // 31: idm.UserSingleQuery
-	(*idm.User)(nil),                    // 32: idm.User
-	(*idm.ACLSingleQuery)(nil),          // 33: idm.ACLSingleQuery
-	(*idm.ACL)(nil),                     // 34: idm.ACL
-	(*idm.WorkspaceSingleQuery)(nil),    // 35: idm.WorkspaceSingleQuery
-	(*idm.Workspace)(nil),               // 36: idm.Workspace
-	(*idm.UserMeta)(nil),                // 37: idm.UserMeta
-	(*idm.UserMetaNamespace)(nil),       // 38: idm.UserMetaNamespace
+	(ElevationStatus)(0),                // 0: rest.ElevationStatus
+	(ResourcePolicyQuery_QueryType)(0),  // 1: rest.ResourcePolicyQuery.QueryType
+	(*ResourcePolicyQuery)(nil),         // 2: rest.ResourcePolicyQuery
+	(*SearchRoleRequest)(nil),           // 3: rest.SearchRoleRequest
+	(*RolesCollection)(nil),             // 4: rest.RolesCollection
+	(*SearchUserRequest)(nil),           // 5: rest.SearchUserRequest
+	(*UsersCollection)(nil),             // 6: rest.UsersCollection
+	(*UserDataExportRequest)(nil),       // 7: rest.UserDataExportRequest
+	(*UserDataExportResponse)(nil),      // 8: rest.UserDataExportResponse
+	(*BindResponse)(nil),                // 9: rest.BindResponse
+	(*SearchACLRequest)(nil),            // 10: rest.SearchACLRequest
+	(*ACLCollection)(nil),               // 11: rest.ACLCollection
+	(*ExplainACLRequest)(nil),           // 12: rest.ExplainACLRequest
+	(*ExplainedACL)(nil),                // 13: rest.ExplainedACL
+	(*ExplainedNode)(nil),               // 14: rest.ExplainedNode
+	(*ExplainedPolicy)(nil),             // 15: rest.ExplainedPolicy
+	(*ExplainedWorkspace)(nil),          // 16: rest.ExplainedWorkspace
+	(*ExplainACLResponse)(nil),          // 17: rest.ExplainACLResponse
+	(*SearchWorkspaceRequest)(nil),      // 18: rest.SearchWorkspaceRequest
+	(*WorkspaceCollection)(nil),         // 19: rest.WorkspaceCollection
+	(*UserMetaCollection)(nil),          // 20: rest.UserMetaCollection
+	(*UserMetaNamespaceCollection)(nil), // 21: rest.UserMetaNamespaceCollection
+	(*ListUserMetaTagsRequest)(nil),     // 22: rest.ListUserMetaTagsRequest
+	(*ListUserMetaTagsResponse)(nil),    // 23: rest.ListUserMetaTagsResponse
+	(*PutUserMetaTagRequest)(nil),       // 24: rest.PutUserMetaTagRequest
+	(*PutUserMetaTagResponse)(nil),      // 25: rest.PutUserMetaTagResponse
+	(*DeleteUserMetaTagsRequest)(nil),   // 26: rest.DeleteUserMetaTagsRequest
+	(*DeleteUserMetaTagsResponse)(nil),  // 27: rest.DeleteUserMetaTagsResponse
+	(*UserBookmarksRequest)(nil),        // 28: rest.UserBookmarksRequest
+	(*RevokeRequest)(nil),               // 29: rest.RevokeRequest
+	(*RevokeResponse)(nil),              // 30: rest.RevokeResponse
+	(*ResetPasswordTokenRequest)(nil),   // 31: rest.ResetPasswordTokenRequest
+	(*ResetPasswordTokenResponse)(nil),  // 32: rest.ResetPasswordTokenResponse
+	(*ResetPasswordRequest)(nil),        // 33: rest.ResetPasswordRequest
+	(*ResetPasswordResponse)(nil),       // 34: rest.ResetPasswordResponse
+	(*DocumentAccessTokenRequest)(nil),  // 35: rest.DocumentAccessTokenRequest
+	(*DocumentAccessTokenResponse)(nil), // 36: rest.DocumentAccessTokenResponse
+	(*Elevation)(nil),                   // 37: rest.Elevation
+	(*CreateElevationRequest)(nil),      // 38: rest.CreateElevationRequest
+	(*ListElevationsRequest)(nil),       // 39: rest.ListElevationsRequest
+	(*ElevationCollection)(nil),         // 40: rest.ElevationCollection
+	(*DecideElevationRequest)(nil),      // 41: rest.DecideElevationRequest
+	(*RevokeElevationRequest)(nil),      // 42: rest.RevokeElevationRequest
+	(*ElevationTokenRequest)(nil),       // 43: rest.ElevationTokenRequest
+	(*DelegatedAdmin)(nil),              // 44: rest.DelegatedAdmin
+	(*ListDelegatedAdminsRequest)(nil),  // 45: rest.ListDelegatedAdminsRequest
+	(*DelegatedAdminCollection)(nil),    // 46: rest.DelegatedAdminCollection
+	(*QuotaUsageRequest)(nil),           // 47: rest.QuotaUsageRequest
+	(*QuotaUsage)(nil),                  // 48: rest.QuotaUsage
+	(*QuotaUsageCollection)(nil),        // 49: rest.QuotaUsageCollection
+	(*idm.RoleSingleQuery)(nil),         // 50: idm.RoleSingleQuery
+	(service.OperationType)(0),          // 51: service.OperationType
+	(*idm.Role)(nil),                    // 52: idm.Role
+	(*idm.UserSingleQuery)(nil),         // 53: idm.UserSingleQuery
+	(*idm.User)(nil),                    // 54: idm.User
+	(*idm.ACLSingleQuery)(nil),          // 55: idm.ACLSingleQuery
+	(*idm.ACL)(nil),                     // 56: idm.ACL
+	(*tree.Node)(nil),                   // 57: tree.Node
+	(*idm.Workspace)(nil),               // 58: idm.Workspace
+	(*idm.WorkspaceSingleQuery)(nil),    // 59: idm.WorkspaceSingleQuery
+	(*idm.UserMeta)(nil),                // 60: idm.UserMeta
+	(*idm.UserMetaNamespace)(nil),       // 61: idm.UserMetaNamespace
 }
 var file_cellsapi_idm_proto_depIdxs = []int32{
-	0,  // 0: rest.ResourcePolicyQuery.Type:type_name -> rest.ResourcePolicyQuery.QueryType
-	28, // 1: rest.SearchRoleRequest.Queries:type_name -> idm.RoleSingleQuery
-	1,  // 2: rest.SearchRoleRequest.ResourcePolicyQuery:type_name -> rest.ResourcePolicyQuery
-	29, // 3: rest.SearchRoleRequest.Operation:type_name -> service.OperationType
-	30, // 4: rest.RolesCollection.Roles:type_name -> idm.Role
-	31, // 5: rest.SearchUserRequest.Queries:type_name -> idm.UserSingleQuery
-	1,  // 6: rest.SearchUserRequest.ResourcePolicyQuery:type_name -> rest.ResourcePolicyQuery
-	29, // 7: rest.SearchUserRequest.Operation:type_name -> service.OperationType
-	32, // 8: rest.UsersCollection.Groups:type_name -> idm.User
-	32, // 9: rest.UsersCollection.Users:type_name -> idm.User
-	33, // 10: rest.SearchACLRequest.Queries:type_name -> idm.ACLSingleQuery
-	29, // 11: rest.SearchACLRequest.Operation:type_name -> service.OperationType
-	34, // 12: rest.ACLCollection.ACLs:type_name -> idm.ACL
-	35, // 13: rest.SearchWorkspaceRequest.Queries:type_name -> idm.WorkspaceSingleQuery
-	1,  // 14: rest.SearchWorkspaceRequest.ResourcePolicyQuery:type_name -> rest.ResourcePolicyQuery
-	29, // 15: rest.SearchWorkspaceRequest.Operation:type_name -> service.OperationType
-	36, // 16: rest.WorkspaceCollection.Workspaces:type_name -> idm.Workspace
-	37, // 17: rest.UserMetaCollection.Metadatas:type_name -> idm.UserMeta
-	38, // 18: rest.UserMetaNamespaceCollection.Namespaces:type_name -> idm.UserMetaNamespace
-	19, // [19:19] is the sub-list for method output_type
-	19, // [19:19] is the sub-list for method input_type
-	19, // [19:19] is the sub-list for extension type_name
-	19, // [19:19] is the sub-list for extension extendee
-	0,  // [0:19] is the sub-list for field type_name
+	1,  // 0: rest.ResourcePolicyQuery.Type:type_name -> rest.ResourcePolicyQuery.QueryType
+	50, // 1: rest.SearchRoleRequest.Queries:type_name -> idm.RoleSingleQuery
+	2,  // 2: rest.SearchRoleRequest.ResourcePolicyQuery:type_name -> rest.ResourcePolicyQuery
+	51, // 3: rest.SearchRoleRequest.Operation:type_name -> service.OperationType
+	52, // 4: rest.RolesCollection.Roles:type_name -> idm.Role
+	53, // 5: rest.SearchUserRequest.Queries:type_name -> idm.UserSingleQuery
+	2,  // 6: rest.SearchUserRequest.ResourcePolicyQuery:type_name -> rest.ResourcePolicyQuery
+	51, // 7: rest.SearchUserRequest.Operation:type_name -> service.OperationType
+	54, // 8: rest.UsersCollection.Groups:type_name -> idm.User
+	54, // 9: rest.UsersCollection.Users:type_name -> idm.User
+	55, // 10: rest.SearchACLRequest.Queries:type_name -> idm.ACLSingleQuery
+	51, // 11: rest.SearchACLRequest.Operation:type_name -> service.OperationType
+	56, // 12: rest.ACLCollection.ACLs:type_name -> idm.ACL
+	57, // 13: rest.ExplainedNode.Node:type_name -> tree.Node
+	13, // 14: rest.ExplainedNode.ACLs:type_name -> rest.ExplainedACL
+	58, // 15: rest.ExplainedWorkspace.Workspace:type_name -> idm.Workspace
+	54, // 16: rest.ExplainACLResponse.User:type_name -> idm.User
+	57, // 17: rest.ExplainACLResponse.Node:type_name -> tree.Node
+	52, // 18: rest.ExplainACLResponse.Roles:type_name -> idm.Role
+	14, // 19: rest.ExplainACLResponse.Nodes:type_name -> rest.ExplainedNode
+	16, // 20: rest.ExplainACLResponse.Workspaces:type_name -> rest.ExplainedWorkspace
+	15, // 21: rest.ExplainACLResponse.Policies:type_name -> rest.ExplainedPolicy
+	59, // 22: rest.SearchWorkspaceRequest.Queries:type_name -> idm.WorkspaceSingleQuery
+	2,  // 23: rest.SearchWorkspaceRequest.ResourcePolicyQuery:type_name -> rest.ResourcePolicyQuery
+	51, // 24: rest.SearchWorkspaceRequest.Operation:type_name -> service.OperationType
+	58, // 25: rest.WorkspaceCollection.Workspaces:type_name -> idm.Workspace
+	60, // 26: rest.UserMetaCollection.Metadatas:type_name -> idm.UserMeta
+	61, // 27: rest.UserMetaNamespaceCollection.Namespaces:type_name -> idm.UserMetaNamespace
+	0,  // 28: rest.Elevation.Status:type_name -> rest.ElevationStatus
+	0,  // 29: rest.ListElevationsRequest.Status:type_name -> rest.ElevationStatus
+	37, // 30: rest.ElevationCollection.Elevations:type_name -> rest.Elevation
+	44, // 31: rest.DelegatedAdminCollection.Delegations:type_name -> rest.DelegatedAdmin
+	48, // 32: rest.QuotaUsageCollection.Usages:type_name -> rest.QuotaUsage
+	33, // [33:33] is the sub-list for method output_type
+	33, // [33:33] is the sub-list for method input_type
+	33, // [33:33] is the sub-list for extension type_name
+	33, // [33:33] is the sub-list for extension extendee
+	0,  // [0:33] is the sub-list for field type_name
 }
 
 func init() { file_cellsapi_idm_proto_init() }
@@ -2055,7 +3944,7 @@ func file_cellsapi_idm_proto_init() {
 			}
 		}
 		file_cellsapi_idm_proto_msgTypes[5].Exporter = func(v any, i int) any {
-			switch v := v.(*BindResponse); i {
+			switch v := v.(*UserDataExportRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2067,7 +3956,7 @@ func file_cellsapi_idm_proto_init() {
 			}
 		}
 		file_cellsapi_idm_proto_msgTypes[6].Exporter = func(v any, i int) any {
-			switch v := v.(*SearchACLRequest); i {
+			switch v := v.(*UserDataExportResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2079,7 +3968,7 @@ func file_cellsapi_idm_proto_init() {
 			}
 		}
 		file_cellsapi_idm_proto_msgTypes[7].Exporter = func(v any, i int) any {
-			switch v := v.(*ACLCollection); i {
+			switch v := v.(*BindResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2091,7 +3980,7 @@ func file_cellsapi_idm_proto_init() {
 			}
 		}
 		file_cellsapi_idm_proto_msgTypes[8].Exporter = func(v any, i int) any {
-			switch v := v.(*SearchWorkspaceRequest); i {
+			switch v := v.(*SearchACLRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2103,7 +3992,7 @@ func file_cellsapi_idm_proto_init() {
 			}
 		}
 		file_cellsapi_idm_proto_msgTypes[9].Exporter = func(v any, i int) any {
-			switch v := v.(*WorkspaceCollection); i {
+			switch v := v.(*ACLCollection); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2115,7 +4004,7 @@ func file_cellsapi_idm_proto_init() {
 			}
 		}
 		file_cellsapi_idm_proto_msgTypes[10].Exporter = func(v any, i int) any {
-			switch v := v.(*UserMetaCollection); i {
+			switch v := v.(*ExplainACLRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2127,7 +4016,7 @@ func file_cellsapi_idm_proto_init() {
 			}
 		}
 		file_cellsapi_idm_proto_msgTypes[11].Exporter = func(v any, i int) any {
-			switch v := v.(*UserMetaNamespaceCollection); i {
+			switch v := v.(*ExplainedACL); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2139,7 +4028,7 @@ func file_cellsapi_idm_proto_init() {
 			}
 		}
 		file_cellsapi_idm_proto_msgTypes[12].Exporter = func(v any, i int) any {
-			switch v := v.(*ListUserMetaTagsRequest); i {
+			switch v := v.(*ExplainedNode); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2151,7 +4040,7 @@ func file_cellsapi_idm_proto_init() {
 			}
 		}
 		file_cellsapi_idm_proto_msgTypes[13].Exporter = func(v any, i int) any {
-			switch v := v.(*ListUserMetaTagsResponse); i {
+			switch v := v.(*ExplainedPolicy); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2163,7 +4052,7 @@ func file_cellsapi_idm_proto_init() {
 			}
 		}
 		file_cellsapi_idm_proto_msgTypes[14].Exporter = func(v any, i int) any {
-			switch v := v.(*PutUserMetaTagRequest); i {
+			switch v := v.(*ExplainedWorkspace); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2175,7 +4064,7 @@ func file_cellsapi_idm_proto_init() {
 			}
 		}
 		file_cellsapi_idm_proto_msgTypes[15].Exporter = func(v any, i int) any {
-			switch v := v.(*PutUserMetaTagResponse); i {
+			switch v := v.(*ExplainACLResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2187,7 +4076,7 @@ func file_cellsapi_idm_proto_init() {
 			}
 		}
 		file_cellsapi_idm_proto_msgTypes[16].Exporter = func(v any, i int) any {
-			switch v := v.(*DeleteUserMetaTagsRequest); i {
+			switch v := v.(*SearchWorkspaceRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2199,7 +4088,7 @@ func file_cellsapi_idm_proto_init() {
 			}
 		}
 		file_cellsapi_idm_proto_msgTypes[17].Exporter = func(v any, i int) any {
-			switch v := v.(*DeleteUserMetaTagsResponse); i {
+			switch v := v.(*WorkspaceCollection); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2211,7 +4100,7 @@ func file_cellsapi_idm_proto_init() {
 			}
 		}
 		file_cellsapi_idm_proto_msgTypes[18].Exporter = func(v any, i int) any {
-			switch v := v.(*UserBookmarksRequest); i {
+			switch v := v.(*UserMetaCollection); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2223,7 +4112,7 @@ func file_cellsapi_idm_proto_init() {
 			}
 		}
 		file_cellsapi_idm_proto_msgTypes[19].Exporter = func(v any, i int) any {
-			switch v := v.(*RevokeRequest); i {
+			switch v := v.(*UserMetaNamespaceCollection); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2235,7 +4124,7 @@ func file_cellsapi_idm_proto_init() {
 			}
 		}
 		file_cellsapi_idm_proto_msgTypes[20].Exporter = func(v any, i int) any {
-			switch v := v.(*RevokeResponse); i {
+			switch v := v.(*ListUserMetaTagsRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2247,7 +4136,7 @@ func file_cellsapi_idm_proto_init() {
 			}
 		}
 		file_cellsapi_idm_proto_msgTypes[21].Exporter = func(v any, i int) any {
-			switch v := v.(*ResetPasswordTokenRequest); i {
+			switch v := v.(*ListUserMetaTagsResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2259,7 +4148,7 @@ func file_cellsapi_idm_proto_init() {
 			}
 		}
 		file_cellsapi_idm_proto_msgTypes[22].Exporter = func(v any, i int) any {
-			switch v := v.(*ResetPasswordTokenResponse); i {
+			switch v := v.(*PutUserMetaTagRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2271,7 +4160,7 @@ func file_cellsapi_idm_proto_init() {
 			}
 		}
 		file_cellsapi_idm_proto_msgTypes[23].Exporter = func(v any, i int) any {
-			switch v := v.(*ResetPasswordRequest); i {
+			switch v := v.(*PutUserMetaTagResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2283,7 +4172,7 @@ func file_cellsapi_idm_proto_init() {
 			}
 		}
 		file_cellsapi_idm_proto_msgTypes[24].Exporter = func(v any, i int) any {
-			switch v := v.(*ResetPasswordResponse); i {
+			switch v := v.(*DeleteUserMetaTagsRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2295,7 +4184,7 @@ func file_cellsapi_idm_proto_init() {
 			}
 		}
 		file_cellsapi_idm_proto_msgTypes[25].Exporter = func(v any, i int) any {
-			switch v := v.(*DocumentAccessTokenRequest); i {
+			switch v := v.(*DeleteUserMetaTagsResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2307,6 +4196,102 @@ func file_cellsapi_idm_proto_init() {
 			}
 		}
 		file_cellsapi_idm_proto_msgTypes[26].Exporter = func(v any, i int) any {
+			switch v := v.(*UserBookmarksRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cellsapi_idm_proto_msgTypes[27].Exporter = func(v any, i int) any {
+			switch v := v.(*RevokeRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cellsapi_idm_proto_msgTypes[28].Exporter = func(v any, i int) any {
+			switch v := v.(*RevokeResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cellsapi_idm_proto_msgTypes[29].Exporter = func(v any, i int) any {
+			switch v := v.(*ResetPasswordTokenRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cellsapi_idm_proto_msgTypes[30].Exporter = func(v any, i int) any {
+			switch v := v.(*ResetPasswordTokenResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cellsapi_idm_proto_msgTypes[31].Exporter = func(v any, i int) any {
+			switch v := v.(*ResetPasswordRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cellsapi_idm_proto_msgTypes[32].Exporter = func(v any, i int) any {
+			switch v := v.(*ResetPasswordResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cellsapi_idm_proto_msgTypes[33].Exporter = func(v any, i int) any {
+			switch v := v.(*DocumentAccessTokenRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cellsapi_idm_proto_msgTypes[34].Exporter = func(v any, i int) any {
 			switch v := v.(*DocumentAccessTokenResponse); i {
 			case 0:
 				return &v.state
@@ -2318,14 +4303,170 @@ func file_cellsapi_idm_proto_init() {
 				return nil
 			}
 		}
+		file_cellsapi_idm_proto_msgTypes[35].Exporter = func(v any, i int) any {
+			switch v := v.(*Elevation); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cellsapi_idm_proto_msgTypes[36].Exporter = func(v any, i int) any {
+			switch v := v.(*CreateElevationRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cellsapi_idm_proto_msgTypes[37].Exporter = func(v any, i int) any {
+			switch v := v.(*ListElevationsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cellsapi_idm_proto_msgTypes[38].Exporter = func(v any, i int) any {
+			switch v := v.(*ElevationCollection); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cellsapi_idm_proto_msgTypes[39].Exporter = func(v any, i int) any {
+			switch v := v.(*DecideElevationRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cellsapi_idm_proto_msgTypes[40].Exporter = func(v any, i int) any {
+			switch v := v.(*RevokeElevationRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cellsapi_idm_proto_msgTypes[41].Exporter = func(v any, i int) any {
+			switch v := v.(*ElevationTokenRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cellsapi_idm_proto_msgTypes[42].Exporter = func(v any, i int) any {
+			switch v := v.(*DelegatedAdmin); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cellsapi_idm_proto_msgTypes[43].Exporter = func(v any, i int) any {
+			switch v := v.(*ListDelegatedAdminsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cellsapi_idm_proto_msgTypes[44].Exporter = func(v any, i int) any {
+			switch v := v.(*DelegatedAdminCollection); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cellsapi_idm_proto_msgTypes[45].Exporter = func(v any, i int) any {
+			switch v := v.(*QuotaUsageRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cellsapi_idm_proto_msgTypes[46].Exporter = func(v any, i int) any {
+			switch v := v.(*QuotaUsage); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cellsapi_idm_proto_msgTypes[47].Exporter = func(v any, i int) any {
+			switch v := v.(*QuotaUsageCollection); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_cellsapi_idm_proto_rawDesc,
-			NumEnums:      1,
-			NumMessages:   27,
+			NumEnums:      2,
+			NumMessages:   48,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
@@ -540,7 +540,7 @@ func (x MetaUpdate_Op) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use MetaUpdate_Op.Descriptor instead.
 func (MetaUpdate_Op) EnumDescriptor() ([]byte, []int) {
-	return file_cellsapi_rest_v2_proto_rawDescGZIP(), []int{51, 0}
+	return file_cellsapi_rest_v2_proto_rawDescGZIP(), []int{54, 0}
 }
 
 // Info about content locking
@@ -2521,7 +2521,6 @@ type LookupRequest struct {
 	// Will be deprecated
 	//
 	// Types that are assignable to Input:
-	//
 	//	*LookupRequest_Locators
 	//	*LookupRequest_Query
 	Input isLookupRequest_Input `protobuf_oneof:"Input"`
@@ -3484,7 +3483,6 @@ type ActionParameters struct {
 	// Should be deprecated in favor of ActionOptions
 	JsonParameters string `protobuf:"bytes,5,opt,name=JsonParameters,proto3" json:"JsonParameters,omitempty"`
 	// Types that are assignable to ActionOptions:
-	//
 	//	*ActionParameters_DeleteOptions
 	//	*ActionParameters_CopyMoveOptions
 	//	*ActionParameters_ExtractCompressOptions
@@ -4346,6 +4344,260 @@ func (x *PublicLinkDeleteSuccess) GetMessage() string {
 	return ""
 }
 
+// Request for a public link access analytics
+type PublicLinkAnalyticsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	LinkUuid string `protobuf:"bytes,1,opt,name=LinkUuid,proto3" json:"LinkUuid,omitempty"`
+	// Set to "csv" to download accesses as a CSV file
+	Format string `protobuf:"bytes,2,opt,name=Format,proto3" json:"Format,omitempty"`
+	// Paginate accesses list, ignored for CSV export
+	Offset int64 `protobuf:"varint,3,opt,name=Offset,proto3" json:"Offset,omitempty"`
+	Limit  int64 `protobuf:"varint,4,opt,name=Limit,proto3" json:"Limit,omitempty"`
+}
+
+func (x *PublicLinkAnalyticsRequest) Reset() {
+	*x = PublicLinkAnalyticsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cellsapi_rest_v2_proto_msgTypes[51]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PublicLinkAnalyticsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PublicLinkAnalyticsRequest) ProtoMessage() {}
+
+func (x *PublicLinkAnalyticsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cellsapi_rest_v2_proto_msgTypes[51]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PublicLinkAnalyticsRequest.ProtoReflect.Descriptor instead.
+func (*PublicLinkAnalyticsRequest) Descriptor() ([]byte, []int) {
+	return file_cellsapi_rest_v2_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *PublicLinkAnalyticsRequest) GetLinkUuid() string {
+	if x != nil {
+		return x.LinkUuid
+	}
+	return ""
+}
+
+func (x *PublicLinkAnalyticsRequest) GetFormat() string {
+	if x != nil {
+		return x.Format
+	}
+	return ""
+}
+
+func (x *PublicLinkAnalyticsRequest) GetOffset() int64 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+func (x *PublicLinkAnalyticsRequest) GetLimit() int64 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+// Single access to a public link
+type PublicLinkAccess struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// One of view, preview or download
+	Type string `protobuf:"bytes,1,opt,name=Type,proto3" json:"Type,omitempty"`
+	Time int64  `protobuf:"varint,2,opt,name=Time,proto3" json:"Time,omitempty"`
+	// Anonymized IP address
+	IP string `protobuf:"bytes,3,opt,name=IP,proto3" json:"IP,omitempty"`
+	// ISO code of the visitor country, if a GeoIP database is configured
+	Country   string `protobuf:"bytes,4,opt,name=Country,proto3" json:"Country,omitempty"`
+	UserAgent string `protobuf:"bytes,5,opt,name=UserAgent,proto3" json:"UserAgent,omitempty"`
+	// Path of the file relative to the link root
+	NodePath string `protobuf:"bytes,6,opt,name=NodePath,proto3" json:"NodePath,omitempty"`
+}
+
+func (x *PublicLinkAccess) Reset() {
+	*x = PublicLinkAccess{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cellsapi_rest_v2_proto_msgTypes[52]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PublicLinkAccess) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PublicLinkAccess) ProtoMessage() {}
+
+func (x *PublicLinkAccess) ProtoReflect() protoreflect.Message {
+	mi := &file_cellsapi_rest_v2_proto_msgTypes[52]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PublicLinkAccess.ProtoReflect.Descriptor instead.
+func (*PublicLinkAccess) Descriptor() ([]byte, []int) {
+	return file_cellsapi_rest_v2_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *PublicLinkAccess) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *PublicLinkAccess) GetTime() int64 {
+	if x != nil {
+		return x.Time
+	}
+	return 0
+}
+
+func (x *PublicLinkAccess) GetIP() string {
+	if x != nil {
+		return x.IP
+	}
+	return ""
+}
+
+func (x *PublicLinkAccess) GetCountry() string {
+	if x != nil {
+		return x.Country
+	}
+	return ""
+}
+
+func (x *PublicLinkAccess) GetUserAgent() string {
+	if x != nil {
+		return x.UserAgent
+	}
+	return ""
+}
+
+func (x *PublicLinkAccess) GetNodePath() string {
+	if x != nil {
+		return x.NodePath
+	}
+	return ""
+}
+
+// Access analytics of a public link
+type PublicLinkAnalytics struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	LinkUuid   string              `protobuf:"bytes,1,opt,name=LinkUuid,proto3" json:"LinkUuid,omitempty"`
+	Views      int32               `protobuf:"varint,2,opt,name=Views,proto3" json:"Views,omitempty"`
+	Previews   int32               `protobuf:"varint,3,opt,name=Previews,proto3" json:"Previews,omitempty"`
+	Downloads  int32               `protobuf:"varint,4,opt,name=Downloads,proto3" json:"Downloads,omitempty"`
+	Accesses   []*PublicLinkAccess `protobuf:"bytes,5,rep,name=Accesses,proto3" json:"Accesses,omitempty"`
+	Pagination *Pagination         `protobuf:"bytes,6,opt,name=Pagination,proto3" json:"Pagination,omitempty"`
+}
+
+func (x *PublicLinkAnalytics) Reset() {
+	*x = PublicLinkAnalytics{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cellsapi_rest_v2_proto_msgTypes[53]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PublicLinkAnalytics) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PublicLinkAnalytics) ProtoMessage() {}
+
+func (x *PublicLinkAnalytics) ProtoReflect() protoreflect.Message {
+	mi := &file_cellsapi_rest_v2_proto_msgTypes[53]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PublicLinkAnalytics.ProtoReflect.Descriptor instead.
+func (*PublicLinkAnalytics) Descriptor() ([]byte, []int) {
+	return file_cellsapi_rest_v2_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *PublicLinkAnalytics) GetLinkUuid() string {
+	if x != nil {
+		return x.LinkUuid
+	}
+	return ""
+}
+
+func (x *PublicLinkAnalytics) GetViews() int32 {
+	if x != nil {
+		return x.Views
+	}
+	return 0
+}
+
+func (x *PublicLinkAnalytics) GetPreviews() int32 {
+	if x != nil {
+		return x.Previews
+	}
+	return 0
+}
+
+func (x *PublicLinkAnalytics) GetDownloads() int32 {
+	if x != nil {
+		return x.Downloads
+	}
+	return 0
+}
+
+func (x *PublicLinkAnalytics) GetAccesses() []*PublicLinkAccess {
+	if x != nil {
+		return x.Accesses
+	}
+	return nil
+}
+
+func (x *PublicLinkAnalytics) GetPagination() *Pagination {
+	if x != nil {
+		return x.Pagination
+	}
+	return nil
+}
+
 // Update operation on Metadata
 type MetaUpdate struct {
 	state         protoimpl.MessageState
@@ -4359,7 +4611,7 @@ type MetaUpdate struct {
 func (x *MetaUpdate) Reset() {
 	*x = MetaUpdate{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_cellsapi_rest_v2_proto_msgTypes[51]
+		mi := &file_cellsapi_rest_v2_proto_msgTypes[54]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -4372,7 +4624,7 @@ func (x *MetaUpdate) String() string {
 func (*MetaUpdate) ProtoMessage() {}
 
 func (x *MetaUpdate) ProtoReflect() protoreflect.Message {
-	mi := &file_cellsapi_rest_v2_proto_msgTypes[51]
+	mi := &file_cellsapi_rest_v2_proto_msgTypes[54]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4385,7 +4637,7 @@ func (x *MetaUpdate) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use MetaUpdate.ProtoReflect.Descriptor instead.
 func (*MetaUpdate) Descriptor() ([]byte, []int) {
-	return file_cellsapi_rest_v2_proto_rawDescGZIP(), []int{51}
+	return file_cellsapi_rest_v2_proto_rawDescGZIP(), []int{54}
 }
 
 func (x *MetaUpdate) GetOperation() MetaUpdate_Op {
@@ -4414,7 +4666,7 @@ type MetaToggle struct {
 func (x *MetaToggle) Reset() {
 	*x = MetaToggle{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_cellsapi_rest_v2_proto_msgTypes[52]
+		mi := &file_cellsapi_rest_v2_proto_msgTypes[55]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -4427,7 +4679,7 @@ func (x *MetaToggle) String() string {
 func (*MetaToggle) ProtoMessage() {}
 
 func (x *MetaToggle) ProtoReflect() protoreflect.Message {
-	mi := &file_cellsapi_rest_v2_proto_msgTypes[52]
+	mi := &file_cellsapi_rest_v2_proto_msgTypes[55]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4440,7 +4692,7 @@ func (x *MetaToggle) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use MetaToggle.ProtoReflect.Descriptor instead.
 func (*MetaToggle) Descriptor() ([]byte, []int) {
-	return file_cellsapi_rest_v2_proto_rawDescGZIP(), []int{52}
+	return file_cellsapi_rest_v2_proto_rawDescGZIP(), []int{55}
 }
 
 func (x *MetaToggle) GetValue() bool {
@@ -4467,7 +4719,7 @@ type NodeUpdates struct {
 func (x *NodeUpdates) Reset() {
 	*x = NodeUpdates{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_cellsapi_rest_v2_proto_msgTypes[53]
+		mi := &file_cellsapi_rest_v2_proto_msgTypes[56]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -4480,7 +4732,7 @@ func (x *NodeUpdates) String() string {
 func (*NodeUpdates) ProtoMessage() {}
 
 func (x *NodeUpdates) ProtoReflect() protoreflect.Message {
-	mi := &file_cellsapi_rest_v2_proto_msgTypes[53]
+	mi := &file_cellsapi_rest_v2_proto_msgTypes[56]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4493,7 +4745,7 @@ func (x *NodeUpdates) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use NodeUpdates.ProtoReflect.Descriptor instead.
 func (*NodeUpdates) Descriptor() ([]byte, []int) {
-	return file_cellsapi_rest_v2_proto_rawDescGZIP(), []int{53}
+	return file_cellsapi_rest_v2_proto_rawDescGZIP(), []int{56}
 }
 
 func (x *NodeUpdates) GetMetaUpdates() []*MetaUpdate {
@@ -4530,7 +4782,7 @@ type PatchNodeRequest struct {
 func (x *PatchNodeRequest) Reset() {
 	*x = PatchNodeRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_cellsapi_rest_v2_proto_msgTypes[54]
+		mi := &file_cellsapi_rest_v2_proto_msgTypes[57]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -4543,7 +4795,7 @@ func (x *PatchNodeRequest) String() string {
 func (*PatchNodeRequest) ProtoMessage() {}
 
 func (x *PatchNodeRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_cellsapi_rest_v2_proto_msgTypes[54]
+	mi := &file_cellsapi_rest_v2_proto_msgTypes[57]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4556,7 +4808,7 @@ func (x *PatchNodeRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PatchNodeRequest.ProtoReflect.Descriptor instead.
 func (*PatchNodeRequest) Descriptor() ([]byte, []int) {
-	return file_cellsapi_rest_v2_proto_rawDescGZIP(), []int{54}
+	return file_cellsapi_rest_v2_proto_rawDescGZIP(), []int{57}
 }
 
 func (x *PatchNodeRequest) GetUuid() string {
@@ -4585,7 +4837,7 @@ type BatchUpdateMetaList struct {
 func (x *BatchUpdateMetaList) Reset() {
 	*x = BatchUpdateMetaList{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_cellsapi_rest_v2_proto_msgTypes[55]
+		mi := &file_cellsapi_rest_v2_proto_msgTypes[58]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -4598,7 +4850,7 @@ func (x *BatchUpdateMetaList) String() string {
 func (*BatchUpdateMetaList) ProtoMessage() {}
 
 func (x *BatchUpdateMetaList) ProtoReflect() protoreflect.Message {
-	mi := &file_cellsapi_rest_v2_proto_msgTypes[55]
+	mi := &file_cellsapi_rest_v2_proto_msgTypes[58]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4611,7 +4863,7 @@ func (x *BatchUpdateMetaList) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use BatchUpdateMetaList.ProtoReflect.Descriptor instead.
 func (*BatchUpdateMetaList) Descriptor() ([]byte, []int) {
-	return file_cellsapi_rest_v2_proto_rawDescGZIP(), []int{55}
+	return file_cellsapi_rest_v2_proto_rawDescGZIP(), []int{58}
 }
 
 func (x *BatchUpdateMetaList) GetUpdates() []*MetaUpdate {
@@ -4634,7 +4886,7 @@ type NamespaceValuesOperation struct {
 func (x *NamespaceValuesOperation) Reset() {
 	*x = NamespaceValuesOperation{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_cellsapi_rest_v2_proto_msgTypes[56]
+		mi := &file_cellsapi_rest_v2_proto_msgTypes[59]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -4647,7 +4899,7 @@ func (x *NamespaceValuesOperation) String() string {
 func (*NamespaceValuesOperation) ProtoMessage() {}
 
 func (x *NamespaceValuesOperation) ProtoReflect() protoreflect.Message {
-	mi := &file_cellsapi_rest_v2_proto_msgTypes[56]
+	mi := &file_cellsapi_rest_v2_proto_msgTypes[59]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4660,7 +4912,7 @@ func (x *NamespaceValuesOperation) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use NamespaceValuesOperation.ProtoReflect.Descriptor instead.
 func (*NamespaceValuesOperation) Descriptor() ([]byte, []int) {
-	return file_cellsapi_rest_v2_proto_rawDescGZIP(), []int{56}
+	return file_cellsapi_rest_v2_proto_rawDescGZIP(), []int{59}
 }
 
 func (x *NamespaceValuesOperation) GetOperation() NsOp {
@@ -4691,7 +4943,7 @@ type NamespaceValuesRequest struct {
 func (x *NamespaceValuesRequest) Reset() {
 	*x = NamespaceValuesRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_cellsapi_rest_v2_proto_msgTypes[57]
+		mi := &file_cellsapi_rest_v2_proto_msgTypes[60]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -4704,7 +4956,7 @@ func (x *NamespaceValuesRequest) String() string {
 func (*NamespaceValuesRequest) ProtoMessage() {}
 
 func (x *NamespaceValuesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_cellsapi_rest_v2_proto_msgTypes[57]
+	mi := &file_cellsapi_rest_v2_proto_msgTypes[60]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4717,7 +4969,7 @@ func (x *NamespaceValuesRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use NamespaceValuesRequest.ProtoReflect.Descriptor instead.
 func (*NamespaceValuesRequest) Descriptor() ([]byte, []int) {
-	return file_cellsapi_rest_v2_proto_rawDescGZIP(), []int{57}
+	return file_cellsapi_rest_v2_proto_rawDescGZIP(), []int{60}
 }
 
 func (x *NamespaceValuesRequest) GetNamespace() string {
@@ -4747,7 +4999,7 @@ type ListNamespaceValuesRequest struct {
 func (x *ListNamespaceValuesRequest) Reset() {
 	*x = ListNamespaceValuesRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_cellsapi_rest_v2_proto_msgTypes[58]
+		mi := &file_cellsapi_rest_v2_proto_msgTypes[61]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -4760,7 +5012,7 @@ func (x *ListNamespaceValuesRequest) String() string {
 func (*ListNamespaceValuesRequest) ProtoMessage() {}
 
 func (x *ListNamespaceValuesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_cellsapi_rest_v2_proto_msgTypes[58]
+	mi := &file_cellsapi_rest_v2_proto_msgTypes[61]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4773,7 +5025,7 @@ func (x *ListNamespaceValuesRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListNamespaceValuesRequest.ProtoReflect.Descriptor instead.
 func (*ListNamespaceValuesRequest) Descriptor() ([]byte, []int) {
-	return file_cellsapi_rest_v2_proto_rawDescGZIP(), []int{58}
+	return file_cellsapi_rest_v2_proto_rawDescGZIP(), []int{61}
 }
 
 func (x *ListNamespaceValuesRequest) GetNamespace() string {
@@ -4796,7 +5048,7 @@ type NamespaceValuesResponse struct {
 func (x *NamespaceValuesResponse) Reset() {
 	*x = NamespaceValuesResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_cellsapi_rest_v2_proto_msgTypes[59]
+		mi := &file_cellsapi_rest_v2_proto_msgTypes[62]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -4809,7 +5061,7 @@ func (x *NamespaceValuesResponse) String() string {
 func (*NamespaceValuesResponse) ProtoMessage() {}
 
 func (x *NamespaceValuesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_cellsapi_rest_v2_proto_msgTypes[59]
+	mi := &file_cellsapi_rest_v2_proto_msgTypes[62]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4822,7 +5074,7 @@ func (x *NamespaceValuesResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use NamespaceValuesResponse.ProtoReflect.Descriptor instead.
 func (*NamespaceValuesResponse) Descriptor() ([]byte, []int) {
-	return file_cellsapi_rest_v2_proto_rawDescGZIP(), []int{59}
+	return file_cellsapi_rest_v2_proto_rawDescGZIP(), []int{62}
 }
 
 func (x *NamespaceValuesResponse) GetValues() []string {
@@ -4844,7 +5096,7 @@ type LookupFilter_SizeRange struct {
 func (x *LookupFilter_SizeRange) Reset() {
 	*x = LookupFilter_SizeRange{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_cellsapi_rest_v2_proto_msgTypes[60]
+		mi := &file_cellsapi_rest_v2_proto_msgTypes[63]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -4857,7 +5109,7 @@ func (x *LookupFilter_SizeRange) String() string {
 func (*LookupFilter_SizeRange) ProtoMessage() {}
 
 func (x *LookupFilter_SizeRange) ProtoReflect() protoreflect.Message {
-	mi := &file_cellsapi_rest_v2_proto_msgTypes[60]
+	mi := &file_cellsapi_rest_v2_proto_msgTypes[63]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4900,7 +5152,7 @@ type LookupFilter_DateRange struct {
 func (x *LookupFilter_DateRange) Reset() {
 	*x = LookupFilter_DateRange{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_cellsapi_rest_v2_proto_msgTypes[61]
+		mi := &file_cellsapi_rest_v2_proto_msgTypes[64]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -4913,7 +5165,7 @@ func (x *LookupFilter_DateRange) String() string {
 func (*LookupFilter_DateRange) ProtoMessage() {}
 
 func (x *LookupFilter_DateRange) ProtoReflect() protoreflect.Message {
-	mi := &file_cellsapi_rest_v2_proto_msgTypes[61]
+	mi := &file_cellsapi_rest_v2_proto_msgTypes[64]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4962,7 +5214,7 @@ type LookupFilter_PathPrefix struct {
 func (x *LookupFilter_PathPrefix) Reset() {
 	*x = LookupFilter_PathPrefix{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_cellsapi_rest_v2_proto_msgTypes[62]
+		mi := &file_cellsapi_rest_v2_proto_msgTypes[65]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -4975,7 +5227,7 @@ func (x *LookupFilter_PathPrefix) String() string {
 func (*LookupFilter_PathPrefix) ProtoMessage() {}
 
 func (x *LookupFilter_PathPrefix) ProtoReflect() protoreflect.Message {
-	mi := &file_cellsapi_rest_v2_proto_msgTypes[62]
+	mi := &file_cellsapi_rest_v2_proto_msgTypes[65]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5017,7 +5269,7 @@ type LookupFilter_TextSearch struct {
 func (x *LookupFilter_TextSearch) Reset() {
 	*x = LookupFilter_TextSearch{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_cellsapi_rest_v2_proto_msgTypes[63]
+		mi := &file_cellsapi_rest_v2_proto_msgTypes[66]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -5030,7 +5282,7 @@ func (x *LookupFilter_TextSearch) String() string {
 func (*LookupFilter_TextSearch) ProtoMessage() {}
 
 func (x *LookupFilter_TextSearch) ProtoReflect() protoreflect.Message {
-	mi := &file_cellsapi_rest_v2_proto_msgTypes[63]
+	mi := &file_cellsapi_rest_v2_proto_msgTypes[66]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5073,7 +5325,7 @@ type LookupFilter_MetaFilter struct {
 func (x *LookupFilter_MetaFilter) Reset() {
 	*x = LookupFilter_MetaFilter{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_cellsapi_rest_v2_proto_msgTypes[64]
+		mi := &file_cellsapi_rest_v2_proto_msgTypes[67]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -5086,7 +5338,7 @@ func (x *LookupFilter_MetaFilter) String() string {
 func (*LookupFilter_MetaFilter) ProtoMessage() {}
 
 func (x *LookupFilter_MetaFilter) ProtoReflect() protoreflect.Message {
-	mi := &file_cellsapi_rest_v2_proto_msgTypes[64]
+	mi := &file_cellsapi_rest_v2_proto_msgTypes[67]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5137,7 +5389,7 @@ type LookupFilter_StatusFilter struct {
 func (x *LookupFilter_StatusFilter) Reset() {
 	*x = LookupFilter_StatusFilter{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_cellsapi_rest_v2_proto_msgTypes[65]
+		mi := &file_cellsapi_rest_v2_proto_msgTypes[68]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -5150,7 +5402,7 @@ func (x *LookupFilter_StatusFilter) String() string {
 func (*LookupFilter_StatusFilter) ProtoMessage() {}
 
 func (x *LookupFilter_StatusFilter) ProtoReflect() protoreflect.Message {
-	mi := &file_cellsapi_rest_v2_proto_msgTypes[65]
+	mi := &file_cellsapi_rest_v2_proto_msgTypes[68]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5818,267 +6070,308 @@ var file_cellsapi_rest_v2_proto_rawDesc = []byte{
 	0x73, 0x12, 0x12, 0x0a, 0x04, 0x55, 0x75, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
 	0x04, 0x55, 0x75, 0x69, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
 	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22,
-	0x90, 0x01, 0x0a, 0x0a, 0x4d, 0x65, 0x74, 0x61, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x12, 0x36,
-	0x0a, 0x09, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x0e, 0x32, 0x13, 0x2e, 0x72, 0x65, 0x73, 0x74, 0x2e, 0x4d, 0x65, 0x74, 0x61, 0x55, 0x70, 0x64,
-	0x61, 0x74, 0x65, 0x2e, 0x4f, 0x70, 0x42, 0x03, 0xe0, 0x41, 0x02, 0x52, 0x09, 0x4f, 0x70, 0x65,
-	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x2f, 0x0a, 0x08, 0x55, 0x73, 0x65, 0x72, 0x4d, 0x65,
-	0x74, 0x61, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x72, 0x65, 0x73, 0x74, 0x2e,
-	0x55, 0x73, 0x65, 0x72, 0x4d, 0x65, 0x74, 0x61, 0x42, 0x03, 0xe0, 0x41, 0x02, 0x52, 0x08, 0x55,
-	0x73, 0x65, 0x72, 0x4d, 0x65, 0x74, 0x61, 0x22, 0x19, 0x0a, 0x02, 0x4f, 0x70, 0x12, 0x07, 0x0a,
-	0x03, 0x50, 0x55, 0x54, 0x10, 0x00, 0x12, 0x0a, 0x0a, 0x06, 0x44, 0x45, 0x4c, 0x45, 0x54, 0x45,
-	0x10, 0x01, 0x22, 0x27, 0x0a, 0x0a, 0x4d, 0x65, 0x74, 0x61, 0x54, 0x6f, 0x67, 0x67, 0x6c, 0x65,
-	0x12, 0x19, 0x0a, 0x05, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x42,
-	0x03, 0xe0, 0x41, 0x02, 0x52, 0x05, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x22, 0xa3, 0x01, 0x0a, 0x0b,
-	0x4e, 0x6f, 0x64, 0x65, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x73, 0x12, 0x32, 0x0a, 0x0b, 0x4d,
-	0x65, 0x74, 0x61, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
-	0x32, 0x10, 0x2e, 0x72, 0x65, 0x73, 0x74, 0x2e, 0x4d, 0x65, 0x74, 0x61, 0x55, 0x70, 0x64, 0x61,
-	0x74, 0x65, 0x52, 0x0b, 0x4d, 0x65, 0x74, 0x61, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x73, 0x12,
-	0x2c, 0x0a, 0x08, 0x42, 0x6f, 0x6f, 0x6b, 0x6d, 0x61, 0x72, 0x6b, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x10, 0x2e, 0x72, 0x65, 0x73, 0x74, 0x2e, 0x4d, 0x65, 0x74, 0x61, 0x54, 0x6f, 0x67,
-	0x67, 0x6c, 0x65, 0x52, 0x08, 0x42, 0x6f, 0x6f, 0x6b, 0x6d, 0x61, 0x72, 0x6b, 0x12, 0x32, 0x0a,
-	0x0b, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x4c, 0x6f, 0x63, 0x6b, 0x18, 0x03, 0x20, 0x01,
+	0x83, 0x01, 0x0a, 0x1a, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x4c, 0x69, 0x6e, 0x6b, 0x41, 0x6e,
+	0x61, 0x6c, 0x79, 0x74, 0x69, 0x63, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1f,
+	0x0a, 0x08, 0x4c, 0x69, 0x6e, 0x6b, 0x55, 0x75, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x42, 0x03, 0xe0, 0x41, 0x02, 0x52, 0x08, 0x4c, 0x69, 0x6e, 0x6b, 0x55, 0x75, 0x69, 0x64, 0x12,
+	0x16, 0x0a, 0x06, 0x46, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x06, 0x46, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x4f, 0x66, 0x66, 0x73, 0x65,
+	0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x4f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x12,
+	0x14, 0x0a, 0x05, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05,
+	0x4c, 0x69, 0x6d, 0x69, 0x74, 0x22, 0x9e, 0x01, 0x0a, 0x10, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63,
+	0x4c, 0x69, 0x6e, 0x6b, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x54, 0x79,
+	0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x54, 0x79, 0x70, 0x65, 0x12, 0x12,
+	0x0a, 0x04, 0x54, 0x69, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x54, 0x69,
+	0x6d, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x49, 0x50, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02,
+	0x49, 0x50, 0x12, 0x18, 0x0a, 0x07, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x72, 0x79, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x07, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x1c, 0x0a, 0x09,
+	0x55, 0x73, 0x65, 0x72, 0x41, 0x67, 0x65, 0x6e, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x09, 0x55, 0x73, 0x65, 0x72, 0x41, 0x67, 0x65, 0x6e, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x4e, 0x6f,
+	0x64, 0x65, 0x50, 0x61, 0x74, 0x68, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x4e, 0x6f,
+	0x64, 0x65, 0x50, 0x61, 0x74, 0x68, 0x22, 0xe7, 0x01, 0x0a, 0x13, 0x50, 0x75, 0x62, 0x6c, 0x69,
+	0x63, 0x4c, 0x69, 0x6e, 0x6b, 0x41, 0x6e, 0x61, 0x6c, 0x79, 0x74, 0x69, 0x63, 0x73, 0x12, 0x1a,
+	0x0a, 0x08, 0x4c, 0x69, 0x6e, 0x6b, 0x55, 0x75, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x08, 0x4c, 0x69, 0x6e, 0x6b, 0x55, 0x75, 0x69, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x56, 0x69,
+	0x65, 0x77, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x56, 0x69, 0x65, 0x77, 0x73,
+	0x12, 0x1a, 0x0a, 0x08, 0x50, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x73, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x08, 0x50, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x73, 0x12, 0x1c, 0x0a, 0x09,
+	0x44, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x09, 0x44, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x73, 0x12, 0x32, 0x0a, 0x08, 0x41, 0x63,
+	0x63, 0x65, 0x73, 0x73, 0x65, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x72,
+	0x65, 0x73, 0x74, 0x2e, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x4c, 0x69, 0x6e, 0x6b, 0x41, 0x63,
+	0x63, 0x65, 0x73, 0x73, 0x52, 0x08, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x65, 0x73, 0x12, 0x30,
+	0x0a, 0x0a, 0x50, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x10, 0x2e, 0x72, 0x65, 0x73, 0x74, 0x2e, 0x50, 0x61, 0x67, 0x69, 0x6e, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0a, 0x50, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x22, 0x90, 0x01, 0x0a, 0x0a, 0x4d, 0x65, 0x74, 0x61, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x12,
+	0x36, 0x0a, 0x09, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0e, 0x32, 0x13, 0x2e, 0x72, 0x65, 0x73, 0x74, 0x2e, 0x4d, 0x65, 0x74, 0x61, 0x55, 0x70,
+	0x64, 0x61, 0x74, 0x65, 0x2e, 0x4f, 0x70, 0x42, 0x03, 0xe0, 0x41, 0x02, 0x52, 0x09, 0x4f, 0x70,
+	0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x2f, 0x0a, 0x08, 0x55, 0x73, 0x65, 0x72, 0x4d,
+	0x65, 0x74, 0x61, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x72, 0x65, 0x73, 0x74,
+	0x2e, 0x55, 0x73, 0x65, 0x72, 0x4d, 0x65, 0x74, 0x61, 0x42, 0x03, 0xe0, 0x41, 0x02, 0x52, 0x08,
+	0x55, 0x73, 0x65, 0x72, 0x4d, 0x65, 0x74, 0x61, 0x22, 0x19, 0x0a, 0x02, 0x4f, 0x70, 0x12, 0x07,
+	0x0a, 0x03, 0x50, 0x55, 0x54, 0x10, 0x00, 0x12, 0x0a, 0x0a, 0x06, 0x44, 0x45, 0x4c, 0x45, 0x54,
+	0x45, 0x10, 0x01, 0x22, 0x27, 0x0a, 0x0a, 0x4d, 0x65, 0x74, 0x61, 0x54, 0x6f, 0x67, 0x67, 0x6c,
+	0x65, 0x12, 0x19, 0x0a, 0x05, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08,
+	0x42, 0x03, 0xe0, 0x41, 0x02, 0x52, 0x05, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x22, 0xa3, 0x01, 0x0a,
+	0x0b, 0x4e, 0x6f, 0x64, 0x65, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x73, 0x12, 0x32, 0x0a, 0x0b,
+	0x4d, 0x65, 0x74, 0x61, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x10, 0x2e, 0x72, 0x65, 0x73, 0x74, 0x2e, 0x4d, 0x65, 0x74, 0x61, 0x55, 0x70, 0x64,
+	0x61, 0x74, 0x65, 0x52, 0x0b, 0x4d, 0x65, 0x74, 0x61, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x73,
+	0x12, 0x2c, 0x0a, 0x08, 0x42, 0x6f, 0x6f, 0x6b, 0x6d, 0x61, 0x72, 0x6b, 0x18, 0x02, 0x20, 0x01,
 	0x28, 0x0b, 0x32, 0x10, 0x2e, 0x72, 0x65, 0x73, 0x74, 0x2e, 0x4d, 0x65, 0x74, 0x61, 0x54, 0x6f,
-	0x67, 0x67, 0x6c, 0x65, 0x52, 0x0b, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x4c, 0x6f, 0x63,
-	0x6b, 0x22, 0x60, 0x0a, 0x10, 0x50, 0x61, 0x74, 0x63, 0x68, 0x4e, 0x6f, 0x64, 0x65, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x04, 0x55, 0x75, 0x69, 0x64, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x42, 0x03, 0xe0, 0x41, 0x02, 0x52, 0x04, 0x55, 0x75, 0x69, 0x64, 0x12, 0x33,
-	0x0a, 0x0b, 0x4e, 0x6f, 0x64, 0x65, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x73, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x72, 0x65, 0x73, 0x74, 0x2e, 0x4e, 0x6f, 0x64, 0x65, 0x55,
-	0x70, 0x64, 0x61, 0x74, 0x65, 0x73, 0x52, 0x0b, 0x4e, 0x6f, 0x64, 0x65, 0x55, 0x70, 0x64, 0x61,
-	0x74, 0x65, 0x73, 0x22, 0x46, 0x0a, 0x13, 0x42, 0x61, 0x74, 0x63, 0x68, 0x55, 0x70, 0x64, 0x61,
-	0x74, 0x65, 0x4d, 0x65, 0x74, 0x61, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x2f, 0x0a, 0x07, 0x55, 0x70,
-	0x64, 0x61, 0x74, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x72, 0x65,
-	0x73, 0x74, 0x2e, 0x4d, 0x65, 0x74, 0x61, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x42, 0x03, 0xe0,
-	0x41, 0x02, 0x52, 0x07, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x73, 0x22, 0x66, 0x0a, 0x18, 0x4e,
-	0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x4f, 0x70,
-	0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x2d, 0x0a, 0x09, 0x4f, 0x70, 0x65, 0x72, 0x61,
-	0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0a, 0x2e, 0x72, 0x65, 0x73,
-	0x74, 0x2e, 0x4e, 0x73, 0x4f, 0x70, 0x42, 0x03, 0xe0, 0x41, 0x02, 0x52, 0x09, 0x4f, 0x70, 0x65,
-	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1b, 0x0a, 0x06, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x73,
-	0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x42, 0x03, 0xe0, 0x41, 0x02, 0x52, 0x06, 0x56, 0x61, 0x6c,
-	0x75, 0x65, 0x73, 0x22, 0x79, 0x0a, 0x16, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65,
+	0x67, 0x67, 0x6c, 0x65, 0x52, 0x08, 0x42, 0x6f, 0x6f, 0x6b, 0x6d, 0x61, 0x72, 0x6b, 0x12, 0x32,
+	0x0a, 0x0b, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x4c, 0x6f, 0x63, 0x6b, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x72, 0x65, 0x73, 0x74, 0x2e, 0x4d, 0x65, 0x74, 0x61, 0x54,
+	0x6f, 0x67, 0x67, 0x6c, 0x65, 0x52, 0x0b, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x4c, 0x6f,
+	0x63, 0x6b, 0x22, 0x60, 0x0a, 0x10, 0x50, 0x61, 0x74, 0x63, 0x68, 0x4e, 0x6f, 0x64, 0x65, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x04, 0x55, 0x75, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x42, 0x03, 0xe0, 0x41, 0x02, 0x52, 0x04, 0x55, 0x75, 0x69, 0x64, 0x12,
+	0x33, 0x0a, 0x0b, 0x4e, 0x6f, 0x64, 0x65, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x73, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x72, 0x65, 0x73, 0x74, 0x2e, 0x4e, 0x6f, 0x64, 0x65,
+	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x73, 0x52, 0x0b, 0x4e, 0x6f, 0x64, 0x65, 0x55, 0x70, 0x64,
+	0x61, 0x74, 0x65, 0x73, 0x22, 0x46, 0x0a, 0x13, 0x42, 0x61, 0x74, 0x63, 0x68, 0x55, 0x70, 0x64,
+	0x61, 0x74, 0x65, 0x4d, 0x65, 0x74, 0x61, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x2f, 0x0a, 0x07, 0x55,
+	0x70, 0x64, 0x61, 0x74, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x72,
+	0x65, 0x73, 0x74, 0x2e, 0x4d, 0x65, 0x74, 0x61, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x42, 0x03,
+	0xe0, 0x41, 0x02, 0x52, 0x07, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x73, 0x22, 0x66, 0x0a, 0x18,
+	0x4e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x4f,
+	0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x2d, 0x0a, 0x09, 0x4f, 0x70, 0x65, 0x72,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0a, 0x2e, 0x72, 0x65,
+	0x73, 0x74, 0x2e, 0x4e, 0x73, 0x4f, 0x70, 0x42, 0x03, 0xe0, 0x41, 0x02, 0x52, 0x09, 0x4f, 0x70,
+	0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1b, 0x0a, 0x06, 0x56, 0x61, 0x6c, 0x75, 0x65,
+	0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x42, 0x03, 0xe0, 0x41, 0x02, 0x52, 0x06, 0x56, 0x61,
+	0x6c, 0x75, 0x65, 0x73, 0x22, 0x79, 0x0a, 0x16, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63,
+	0x65, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x21,
+	0x0a, 0x09, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x42, 0x03, 0xe0, 0x41, 0x02, 0x52, 0x09, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63,
+	0x65, 0x12, 0x3c, 0x0a, 0x09, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x72, 0x65, 0x73, 0x74, 0x2e, 0x4e, 0x61, 0x6d, 0x65,
+	0x73, 0x70, 0x61, 0x63, 0x65, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x4f, 0x70, 0x65, 0x72, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x09, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22,
+	0x3f, 0x0a, 0x1a, 0x4c, 0x69, 0x73, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65,
 	0x56, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x21, 0x0a,
 	0x09, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
 	0x42, 0x03, 0xe0, 0x41, 0x02, 0x52, 0x09, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65,
-	0x12, 0x3c, 0x0a, 0x09, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x72, 0x65, 0x73, 0x74, 0x2e, 0x4e, 0x61, 0x6d, 0x65, 0x73,
-	0x70, 0x61, 0x63, 0x65, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74,
-	0x69, 0x6f, 0x6e, 0x52, 0x09, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x3f,
-	0x0a, 0x1a, 0x4c, 0x69, 0x73, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x56,
-	0x61, 0x6c, 0x75, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x21, 0x0a, 0x09,
-	0x4e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42,
-	0x03, 0xe0, 0x41, 0x02, 0x52, 0x09, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x22,
-	0x31, 0x0a, 0x17, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x56, 0x61, 0x6c, 0x75,
-	0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x56, 0x61,
-	0x6c, 0x75, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x06, 0x56, 0x61, 0x6c, 0x75,
-	0x65, 0x73, 0x2a, 0x4b, 0x0a, 0x04, 0x4d, 0x6f, 0x64, 0x65, 0x12, 0x0b, 0x0a, 0x07, 0x44, 0x65,
-	0x66, 0x61, 0x75, 0x6c, 0x74, 0x10, 0x00, 0x12, 0x10, 0x0a, 0x0c, 0x4e, 0x6f, 0x64, 0x65, 0x52,
-	0x65, 0x61, 0x64, 0x4f, 0x6e, 0x6c, 0x79, 0x10, 0x01, 0x12, 0x11, 0x0a, 0x0d, 0x4e, 0x6f, 0x64,
-	0x65, 0x57, 0x72, 0x69, 0x74, 0x65, 0x4f, 0x6e, 0x6c, 0x79, 0x10, 0x02, 0x12, 0x11, 0x0a, 0x0d,
-	0x4c, 0x65, 0x76, 0x65, 0x6c, 0x52, 0x65, 0x61, 0x64, 0x4f, 0x6e, 0x6c, 0x79, 0x10, 0x03, 0x2a,
-	0xa2, 0x01, 0x0a, 0x04, 0x46, 0x6c, 0x61, 0x67, 0x12, 0x14, 0x0a, 0x10, 0x57, 0x69, 0x74, 0x68,
-	0x4d, 0x65, 0x74, 0x61, 0x44, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x73, 0x10, 0x00, 0x12, 0x14,
-	0x0a, 0x10, 0x57, 0x69, 0x74, 0x68, 0x4d, 0x65, 0x74, 0x61, 0x43, 0x6f, 0x72, 0x65, 0x4f, 0x6e,
-	0x6c, 0x79, 0x10, 0x01, 0x12, 0x10, 0x0a, 0x0c, 0x57, 0x69, 0x74, 0x68, 0x4d, 0x65, 0x74, 0x61,
-	0x4e, 0x6f, 0x6e, 0x65, 0x10, 0x02, 0x12, 0x13, 0x0a, 0x0f, 0x57, 0x69, 0x74, 0x68, 0x56, 0x65,
-	0x72, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x41, 0x6c, 0x6c, 0x10, 0x03, 0x12, 0x15, 0x0a, 0x11, 0x57,
-	0x69, 0x74, 0x68, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x44, 0x72, 0x61, 0x66, 0x74,
-	0x10, 0x04, 0x12, 0x19, 0x0a, 0x15, 0x57, 0x69, 0x74, 0x68, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f,
-	0x6e, 0x73, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x65, 0x64, 0x10, 0x05, 0x12, 0x15, 0x0a,
-	0x11, 0x57, 0x69, 0x74, 0x68, 0x50, 0x72, 0x65, 0x53, 0x69, 0x67, 0x6e, 0x65, 0x64, 0x55, 0x52,
-	0x4c, 0x73, 0x10, 0x06, 0x2a, 0x4a, 0x0a, 0x0d, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x73,
-	0x54, 0x79, 0x70, 0x65, 0x73, 0x12, 0x0f, 0x0a, 0x0b, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e,
-	0x73, 0x41, 0x6c, 0x6c, 0x10, 0x00, 0x12, 0x11, 0x0a, 0x0d, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f,
-	0x6e, 0x73, 0x44, 0x72, 0x61, 0x66, 0x74, 0x10, 0x01, 0x12, 0x15, 0x0a, 0x11, 0x56, 0x65, 0x72,
-	0x73, 0x69, 0x6f, 0x6e, 0x73, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x65, 0x64, 0x10, 0x02,
-	0x2a, 0x58, 0x0a, 0x0e, 0x55, 0x73, 0x65, 0x72, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x79,
-	0x70, 0x65, 0x12, 0x0a, 0x0a, 0x06, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x10, 0x00, 0x12, 0x0b,
-	0x0a, 0x07, 0x72, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x10, 0x01, 0x12, 0x08, 0x0a, 0x04, 0x63,
-	0x6f, 0x70, 0x79, 0x10, 0x02, 0x12, 0x08, 0x0a, 0x04, 0x6d, 0x6f, 0x76, 0x65, 0x10, 0x03, 0x12,
-	0x0b, 0x0a, 0x07, 0x65, 0x78, 0x74, 0x72, 0x61, 0x63, 0x74, 0x10, 0x04, 0x12, 0x0c, 0x0a, 0x08,
-	0x63, 0x6f, 0x6d, 0x70, 0x72, 0x65, 0x73, 0x73, 0x10, 0x05, 0x2a, 0x2d, 0x0a, 0x0c, 0x41, 0x63,
-	0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x0d, 0x0a, 0x09, 0x50, 0x65,
-	0x72, 0x66, 0x6f, 0x72, 0x6d, 0x65, 0x64, 0x10, 0x00, 0x12, 0x0e, 0x0a, 0x0a, 0x42, 0x61, 0x63,
-	0x6b, 0x67, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x10, 0x01, 0x2a, 0x1b, 0x0a, 0x04, 0x4e, 0x73, 0x4f,
-	0x70, 0x12, 0x07, 0x0a, 0x03, 0x50, 0x55, 0x54, 0x10, 0x00, 0x12, 0x0a, 0x0a, 0x06, 0x44, 0x45,
-	0x4c, 0x45, 0x54, 0x45, 0x10, 0x01, 0x32, 0xdd, 0x13, 0x0a, 0x0b, 0x4e, 0x6f, 0x64, 0x65, 0x53,
-	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x59, 0x0a, 0x06, 0x4c, 0x6f, 0x6f, 0x6b, 0x75, 0x70,
-	0x12, 0x13, 0x2e, 0x72, 0x65, 0x73, 0x74, 0x2e, 0x4c, 0x6f, 0x6f, 0x6b, 0x75, 0x70, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x14, 0x2e, 0x72, 0x65, 0x73, 0x74, 0x2e, 0x4e, 0x6f, 0x64,
-	0x65, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x24, 0x92, 0x41, 0x0e,
-	0x62, 0x0c, 0x0a, 0x0a, 0x0a, 0x06, 0x42, 0x65, 0x61, 0x72, 0x65, 0x72, 0x12, 0x00, 0x82, 0xd3,
-	0xe4, 0x93, 0x02, 0x0d, 0x3a, 0x01, 0x2a, 0x22, 0x08, 0x2f, 0x6e, 0x2f, 0x6e, 0x6f, 0x64, 0x65,
-	0x73, 0x12, 0x4f, 0x0a, 0x06, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x12, 0x13, 0x2e, 0x72, 0x65,
-	0x73, 0x74, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x1a, 0x14, 0x2e, 0x72, 0x65, 0x73, 0x74, 0x2e, 0x4e, 0x6f, 0x64, 0x65, 0x43, 0x6f, 0x6c, 0x6c,
-	0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x1a, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x14, 0x3a, 0x01,
-	0x2a, 0x22, 0x0f, 0x2f, 0x6e, 0x2f, 0x6e, 0x6f, 0x64, 0x65, 0x73, 0x2f, 0x63, 0x72, 0x65, 0x61,
-	0x74, 0x65, 0x12, 0x67, 0x0a, 0x0b, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x43, 0x68, 0x65, 0x63,
-	0x6b, 0x12, 0x18, 0x2e, 0x72, 0x65, 0x73, 0x74, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x43,
-	0x68, 0x65, 0x63, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e, 0x72, 0x65,
-	0x73, 0x74, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x23, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1d, 0x3a, 0x01,
-	0x2a, 0x22, 0x18, 0x2f, 0x6e, 0x2f, 0x6e, 0x6f, 0x64, 0x65, 0x73, 0x2f, 0x63, 0x72, 0x65, 0x61,
-	0x74, 0x65, 0x2f, 0x70, 0x72, 0x65, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x12, 0x5d, 0x0a, 0x0d, 0x55,
-	0x73, 0x65, 0x72, 0x42, 0x6f, 0x6f, 0x6b, 0x6d, 0x61, 0x72, 0x6b, 0x73, 0x12, 0x1a, 0x2e, 0x72,
-	0x65, 0x73, 0x74, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x42, 0x6f, 0x6f, 0x6b, 0x6d, 0x61, 0x72, 0x6b,
-	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x14, 0x2e, 0x72, 0x65, 0x73, 0x74, 0x2e,
-	0x4e, 0x6f, 0x64, 0x65, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x1a,
-	0x82, 0xd3, 0xe4, 0x93, 0x02, 0x14, 0x12, 0x12, 0x2f, 0x6e, 0x2f, 0x6e, 0x6f, 0x64, 0x65, 0x73,
-	0x2f, 0x62, 0x6f, 0x6f, 0x6b, 0x6d, 0x61, 0x72, 0x6b, 0x73, 0x12, 0x42, 0x0a, 0x09, 0x47, 0x65,
-	0x74, 0x42, 0x79, 0x55, 0x75, 0x69, 0x64, 0x12, 0x11, 0x2e, 0x72, 0x65, 0x73, 0x74, 0x2e, 0x4e,
-	0x6f, 0x64, 0x65, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x6f, 0x72, 0x1a, 0x0a, 0x2e, 0x72, 0x65, 0x73,
-	0x74, 0x2e, 0x4e, 0x6f, 0x64, 0x65, 0x22, 0x16, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x10, 0x12, 0x0e,
-	0x2f, 0x6e, 0x2f, 0x6e, 0x6f, 0x64, 0x65, 0x2f, 0x7b, 0x55, 0x75, 0x69, 0x64, 0x7d, 0x12, 0x54,
-	0x0a, 0x09, 0x50, 0x61, 0x74, 0x63, 0x68, 0x4e, 0x6f, 0x64, 0x65, 0x12, 0x16, 0x2e, 0x72, 0x65,
-	0x73, 0x74, 0x2e, 0x50, 0x61, 0x74, 0x63, 0x68, 0x4e, 0x6f, 0x64, 0x65, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x1a, 0x0a, 0x2e, 0x72, 0x65, 0x73, 0x74, 0x2e, 0x4e, 0x6f, 0x64, 0x65, 0x22,
-	0x23, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1d, 0x3a, 0x0b, 0x4e, 0x6f, 0x64, 0x65, 0x55, 0x70, 0x64,
-	0x61, 0x74, 0x65, 0x73, 0x32, 0x0e, 0x2f, 0x6e, 0x2f, 0x6e, 0x6f, 0x64, 0x65, 0x2f, 0x7b, 0x55,
-	0x75, 0x69, 0x64, 0x7d, 0x12, 0x6e, 0x0a, 0x0b, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x4e,
-	0x6f, 0x64, 0x65, 0x12, 0x18, 0x2e, 0x72, 0x65, 0x73, 0x74, 0x2e, 0x50, 0x75, 0x62, 0x6c, 0x69,
-	0x73, 0x68, 0x4e, 0x6f, 0x64, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e,
-	0x72, 0x65, 0x73, 0x74, 0x2e, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x4e, 0x6f, 0x64, 0x65,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x2a, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x24,
-	0x3a, 0x0a, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x65, 0x74, 0x65, 0x72, 0x73, 0x22, 0x16, 0x2f, 0x6e,
-	0x2f, 0x6e, 0x6f, 0x64, 0x65, 0x2f, 0x7b, 0x55, 0x75, 0x69, 0x64, 0x7d, 0x2f, 0x70, 0x75, 0x62,
-	0x6c, 0x69, 0x73, 0x68, 0x12, 0x8c, 0x01, 0x0a, 0x0e, 0x50, 0x72, 0x6f, 0x6d, 0x6f, 0x74, 0x65,
-	0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x1b, 0x2e, 0x72, 0x65, 0x73, 0x74, 0x2e, 0x50,
-	0x72, 0x6f, 0x6d, 0x6f, 0x74, 0x65, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x72, 0x65, 0x73, 0x74, 0x2e, 0x50, 0x72, 0x6f, 0x6d,
-	0x6f, 0x74, 0x65, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x22, 0x3f, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x39, 0x3a, 0x0a, 0x50, 0x61, 0x72, 0x61,
-	0x6d, 0x65, 0x74, 0x65, 0x72, 0x73, 0x22, 0x2b, 0x2f, 0x6e, 0x2f, 0x6e, 0x6f, 0x64, 0x65, 0x2f,
+	0x22, 0x31, 0x0a, 0x17, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x56, 0x61, 0x6c,
+	0x75, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x56,
+	0x61, 0x6c, 0x75, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x06, 0x56, 0x61, 0x6c,
+	0x75, 0x65, 0x73, 0x2a, 0x4b, 0x0a, 0x04, 0x4d, 0x6f, 0x64, 0x65, 0x12, 0x0b, 0x0a, 0x07, 0x44,
+	0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x10, 0x00, 0x12, 0x10, 0x0a, 0x0c, 0x4e, 0x6f, 0x64, 0x65,
+	0x52, 0x65, 0x61, 0x64, 0x4f, 0x6e, 0x6c, 0x79, 0x10, 0x01, 0x12, 0x11, 0x0a, 0x0d, 0x4e, 0x6f,
+	0x64, 0x65, 0x57, 0x72, 0x69, 0x74, 0x65, 0x4f, 0x6e, 0x6c, 0x79, 0x10, 0x02, 0x12, 0x11, 0x0a,
+	0x0d, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x52, 0x65, 0x61, 0x64, 0x4f, 0x6e, 0x6c, 0x79, 0x10, 0x03,
+	0x2a, 0xa2, 0x01, 0x0a, 0x04, 0x46, 0x6c, 0x61, 0x67, 0x12, 0x14, 0x0a, 0x10, 0x57, 0x69, 0x74,
+	0x68, 0x4d, 0x65, 0x74, 0x61, 0x44, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x73, 0x10, 0x00, 0x12,
+	0x14, 0x0a, 0x10, 0x57, 0x69, 0x74, 0x68, 0x4d, 0x65, 0x74, 0x61, 0x43, 0x6f, 0x72, 0x65, 0x4f,
+	0x6e, 0x6c, 0x79, 0x10, 0x01, 0x12, 0x10, 0x0a, 0x0c, 0x57, 0x69, 0x74, 0x68, 0x4d, 0x65, 0x74,
+	0x61, 0x4e, 0x6f, 0x6e, 0x65, 0x10, 0x02, 0x12, 0x13, 0x0a, 0x0f, 0x57, 0x69, 0x74, 0x68, 0x56,
+	0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x41, 0x6c, 0x6c, 0x10, 0x03, 0x12, 0x15, 0x0a, 0x11,
+	0x57, 0x69, 0x74, 0x68, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x44, 0x72, 0x61, 0x66,
+	0x74, 0x10, 0x04, 0x12, 0x19, 0x0a, 0x15, 0x57, 0x69, 0x74, 0x68, 0x56, 0x65, 0x72, 0x73, 0x69,
+	0x6f, 0x6e, 0x73, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x65, 0x64, 0x10, 0x05, 0x12, 0x15,
+	0x0a, 0x11, 0x57, 0x69, 0x74, 0x68, 0x50, 0x72, 0x65, 0x53, 0x69, 0x67, 0x6e, 0x65, 0x64, 0x55,
+	0x52, 0x4c, 0x73, 0x10, 0x06, 0x2a, 0x4a, 0x0a, 0x0d, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e,
+	0x73, 0x54, 0x79, 0x70, 0x65, 0x73, 0x12, 0x0f, 0x0a, 0x0b, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f,
+	0x6e, 0x73, 0x41, 0x6c, 0x6c, 0x10, 0x00, 0x12, 0x11, 0x0a, 0x0d, 0x56, 0x65, 0x72, 0x73, 0x69,
+	0x6f, 0x6e, 0x73, 0x44, 0x72, 0x61, 0x66, 0x74, 0x10, 0x01, 0x12, 0x15, 0x0a, 0x11, 0x56, 0x65,
+	0x72, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x65, 0x64, 0x10,
+	0x02, 0x2a, 0x58, 0x0a, 0x0e, 0x55, 0x73, 0x65, 0x72, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x54,
+	0x79, 0x70, 0x65, 0x12, 0x0a, 0x0a, 0x06, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x10, 0x00, 0x12,
+	0x0b, 0x0a, 0x07, 0x72, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x10, 0x01, 0x12, 0x08, 0x0a, 0x04,
+	0x63, 0x6f, 0x70, 0x79, 0x10, 0x02, 0x12, 0x08, 0x0a, 0x04, 0x6d, 0x6f, 0x76, 0x65, 0x10, 0x03,
+	0x12, 0x0b, 0x0a, 0x07, 0x65, 0x78, 0x74, 0x72, 0x61, 0x63, 0x74, 0x10, 0x04, 0x12, 0x0c, 0x0a,
+	0x08, 0x63, 0x6f, 0x6d, 0x70, 0x72, 0x65, 0x73, 0x73, 0x10, 0x05, 0x2a, 0x2d, 0x0a, 0x0c, 0x41,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x0d, 0x0a, 0x09, 0x50,
+	0x65, 0x72, 0x66, 0x6f, 0x72, 0x6d, 0x65, 0x64, 0x10, 0x00, 0x12, 0x0e, 0x0a, 0x0a, 0x42, 0x61,
+	0x63, 0x6b, 0x67, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x10, 0x01, 0x2a, 0x1b, 0x0a, 0x04, 0x4e, 0x73,
+	0x4f, 0x70, 0x12, 0x07, 0x0a, 0x03, 0x50, 0x55, 0x54, 0x10, 0x00, 0x12, 0x0a, 0x0a, 0x06, 0x44,
+	0x45, 0x4c, 0x45, 0x54, 0x45, 0x10, 0x01, 0x32, 0xda, 0x14, 0x0a, 0x0b, 0x4e, 0x6f, 0x64, 0x65,
+	0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x59, 0x0a, 0x06, 0x4c, 0x6f, 0x6f, 0x6b, 0x75,
+	0x70, 0x12, 0x13, 0x2e, 0x72, 0x65, 0x73, 0x74, 0x2e, 0x4c, 0x6f, 0x6f, 0x6b, 0x75, 0x70, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x14, 0x2e, 0x72, 0x65, 0x73, 0x74, 0x2e, 0x4e, 0x6f,
+	0x64, 0x65, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x24, 0x92, 0x41,
+	0x0e, 0x62, 0x0c, 0x0a, 0x0a, 0x0a, 0x06, 0x42, 0x65, 0x61, 0x72, 0x65, 0x72, 0x12, 0x00, 0x82,
+	0xd3, 0xe4, 0x93, 0x02, 0x0d, 0x3a, 0x01, 0x2a, 0x22, 0x08, 0x2f, 0x6e, 0x2f, 0x6e, 0x6f, 0x64,
+	0x65, 0x73, 0x12, 0x4f, 0x0a, 0x06, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x12, 0x13, 0x2e, 0x72,
+	0x65, 0x73, 0x74, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x14, 0x2e, 0x72, 0x65, 0x73, 0x74, 0x2e, 0x4e, 0x6f, 0x64, 0x65, 0x43, 0x6f, 0x6c,
+	0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x1a, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x14, 0x3a,
+	0x01, 0x2a, 0x22, 0x0f, 0x2f, 0x6e, 0x2f, 0x6e, 0x6f, 0x64, 0x65, 0x73, 0x2f, 0x63, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x12, 0x67, 0x0a, 0x0b, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x43, 0x68, 0x65,
+	0x63, 0x6b, 0x12, 0x18, 0x2e, 0x72, 0x65, 0x73, 0x74, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x43, 0x68, 0x65, 0x63, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e, 0x72,
+	0x65, 0x73, 0x74, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x23, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1d, 0x3a,
+	0x01, 0x2a, 0x22, 0x18, 0x2f, 0x6e, 0x2f, 0x6e, 0x6f, 0x64, 0x65, 0x73, 0x2f, 0x63, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x2f, 0x70, 0x72, 0x65, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x12, 0x5d, 0x0a, 0x0d,
+	0x55, 0x73, 0x65, 0x72, 0x42, 0x6f, 0x6f, 0x6b, 0x6d, 0x61, 0x72, 0x6b, 0x73, 0x12, 0x1a, 0x2e,
+	0x72, 0x65, 0x73, 0x74, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x42, 0x6f, 0x6f, 0x6b, 0x6d, 0x61, 0x72,
+	0x6b, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x14, 0x2e, 0x72, 0x65, 0x73, 0x74,
+	0x2e, 0x4e, 0x6f, 0x64, 0x65, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x22,
+	0x1a, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x14, 0x12, 0x12, 0x2f, 0x6e, 0x2f, 0x6e, 0x6f, 0x64, 0x65,
+	0x73, 0x2f, 0x62, 0x6f, 0x6f, 0x6b, 0x6d, 0x61, 0x72, 0x6b, 0x73, 0x12, 0x42, 0x0a, 0x09, 0x47,
+	0x65, 0x74, 0x42, 0x79, 0x55, 0x75, 0x69, 0x64, 0x12, 0x11, 0x2e, 0x72, 0x65, 0x73, 0x74, 0x2e,
+	0x4e, 0x6f, 0x64, 0x65, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x6f, 0x72, 0x1a, 0x0a, 0x2e, 0x72, 0x65,
+	0x73, 0x74, 0x2e, 0x4e, 0x6f, 0x64, 0x65, 0x22, 0x16, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x10, 0x12,
+	0x0e, 0x2f, 0x6e, 0x2f, 0x6e, 0x6f, 0x64, 0x65, 0x2f, 0x7b, 0x55, 0x75, 0x69, 0x64, 0x7d, 0x12,
+	0x54, 0x0a, 0x09, 0x50, 0x61, 0x74, 0x63, 0x68, 0x4e, 0x6f, 0x64, 0x65, 0x12, 0x16, 0x2e, 0x72,
+	0x65, 0x73, 0x74, 0x2e, 0x50, 0x61, 0x74, 0x63, 0x68, 0x4e, 0x6f, 0x64, 0x65, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x0a, 0x2e, 0x72, 0x65, 0x73, 0x74, 0x2e, 0x4e, 0x6f, 0x64, 0x65,
+	0x22, 0x23, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1d, 0x3a, 0x0b, 0x4e, 0x6f, 0x64, 0x65, 0x55, 0x70,
+	0x64, 0x61, 0x74, 0x65, 0x73, 0x32, 0x0e, 0x2f, 0x6e, 0x2f, 0x6e, 0x6f, 0x64, 0x65, 0x2f, 0x7b,
+	0x55, 0x75, 0x69, 0x64, 0x7d, 0x12, 0x6e, 0x0a, 0x0b, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68,
+	0x4e, 0x6f, 0x64, 0x65, 0x12, 0x18, 0x2e, 0x72, 0x65, 0x73, 0x74, 0x2e, 0x50, 0x75, 0x62, 0x6c,
+	0x69, 0x73, 0x68, 0x4e, 0x6f, 0x64, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x19,
+	0x2e, 0x72, 0x65, 0x73, 0x74, 0x2e, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x4e, 0x6f, 0x64,
+	0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x2a, 0x82, 0xd3, 0xe4, 0x93, 0x02,
+	0x24, 0x3a, 0x0a, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x65, 0x74, 0x65, 0x72, 0x73, 0x22, 0x16, 0x2f,
+	0x6e, 0x2f, 0x6e, 0x6f, 0x64, 0x65, 0x2f, 0x7b, 0x55, 0x75, 0x69, 0x64, 0x7d, 0x2f, 0x70, 0x75,
+	0x62, 0x6c, 0x69, 0x73, 0x68, 0x12, 0x8c, 0x01, 0x0a, 0x0e, 0x50, 0x72, 0x6f, 0x6d, 0x6f, 0x74,
+	0x65, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x1b, 0x2e, 0x72, 0x65, 0x73, 0x74, 0x2e,
+	0x50, 0x72, 0x6f, 0x6d, 0x6f, 0x74, 0x65, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x72, 0x65, 0x73, 0x74, 0x2e, 0x50, 0x72, 0x6f,
+	0x6d, 0x6f, 0x74, 0x65, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x3f, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x39, 0x3a, 0x0a, 0x50, 0x61, 0x72,
+	0x61, 0x6d, 0x65, 0x74, 0x65, 0x72, 0x73, 0x22, 0x2b, 0x2f, 0x6e, 0x2f, 0x6e, 0x6f, 0x64, 0x65,
+	0x2f, 0x7b, 0x55, 0x75, 0x69, 0x64, 0x7d, 0x2f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x73,
+	0x2f, 0x7b, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x7d, 0x2f, 0x70, 0x72, 0x6f,
+	0x6d, 0x6f, 0x74, 0x65, 0x12, 0x75, 0x0a, 0x0d, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x56, 0x65,
+	0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x1a, 0x2e, 0x72, 0x65, 0x73, 0x74, 0x2e, 0x44, 0x65, 0x6c,
+	0x65, 0x74, 0x65, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x1b, 0x2e, 0x72, 0x65, 0x73, 0x74, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x56,
+	0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x2b,
+	0x82, 0xd3, 0xe4, 0x93, 0x02, 0x25, 0x2a, 0x23, 0x2f, 0x6e, 0x2f, 0x6e, 0x6f, 0x64, 0x65, 0x2f,
 	0x7b, 0x55, 0x75, 0x69, 0x64, 0x7d, 0x2f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x2f,
-	0x7b, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x7d, 0x2f, 0x70, 0x72, 0x6f, 0x6d,
-	0x6f, 0x74, 0x65, 0x12, 0x75, 0x0a, 0x0d, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x56, 0x65, 0x72,
-	0x73, 0x69, 0x6f, 0x6e, 0x12, 0x1a, 0x2e, 0x72, 0x65, 0x73, 0x74, 0x2e, 0x44, 0x65, 0x6c, 0x65,
-	0x74, 0x65, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x1a, 0x1b, 0x2e, 0x72, 0x65, 0x73, 0x74, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x56, 0x65,
-	0x72, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x2b, 0x82,
-	0xd3, 0xe4, 0x93, 0x02, 0x25, 0x2a, 0x23, 0x2f, 0x6e, 0x2f, 0x6e, 0x6f, 0x64, 0x65, 0x2f, 0x7b,
-	0x55, 0x75, 0x69, 0x64, 0x7d, 0x2f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x2f, 0x7b,
-	0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x7d, 0x12, 0x6a, 0x0a, 0x0c, 0x4e, 0x6f,
-	0x64, 0x65, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x19, 0x2e, 0x72, 0x65, 0x73,
-	0x74, 0x2e, 0x4e, 0x6f, 0x64, 0x65, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x17, 0x2e, 0x72, 0x65, 0x73, 0x74, 0x2e, 0x56, 0x65, 0x72,
-	0x73, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x26,
-	0x82, 0xd3, 0xe4, 0x93, 0x02, 0x20, 0x3a, 0x05, 0x51, 0x75, 0x65, 0x72, 0x79, 0x22, 0x17, 0x2f,
-	0x6e, 0x2f, 0x6e, 0x6f, 0x64, 0x65, 0x2f, 0x7b, 0x55, 0x75, 0x69, 0x64, 0x7d, 0x2f, 0x76, 0x65,
-	0x72, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x70, 0x0a, 0x10, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
-	0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x4c, 0x69, 0x6e, 0x6b, 0x12, 0x1b, 0x2e, 0x72, 0x65, 0x73,
-	0x74, 0x2e, 0x4e, 0x6f, 0x64, 0x65, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x4c, 0x69, 0x6e, 0x6b,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0f, 0x2e, 0x72, 0x65, 0x73, 0x74, 0x2e, 0x53,
-	0x68, 0x61, 0x72, 0x65, 0x4c, 0x69, 0x6e, 0x6b, 0x22, 0x2e, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x28,
-	0x3a, 0x11, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x4c, 0x69, 0x6e, 0x6b, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x22, 0x13, 0x2f, 0x6e, 0x2f, 0x6e, 0x6f, 0x64, 0x65, 0x2f, 0x7b, 0x55, 0x75,
-	0x69, 0x64, 0x7d, 0x2f, 0x6c, 0x69, 0x6e, 0x6b, 0x12, 0x55, 0x0a, 0x0a, 0x53, 0x65, 0x61, 0x72,
-	0x63, 0x68, 0x4d, 0x65, 0x74, 0x61, 0x12, 0x1a, 0x2e, 0x69, 0x64, 0x6d, 0x2e, 0x53, 0x65, 0x61,
-	0x72, 0x63, 0x68, 0x55, 0x73, 0x65, 0x72, 0x4d, 0x65, 0x74, 0x61, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x1a, 0x12, 0x2e, 0x72, 0x65, 0x73, 0x74, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x4d, 0x65,
-	0x74, 0x61, 0x4c, 0x69, 0x73, 0x74, 0x22, 0x17, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x11, 0x3a, 0x01,
-	0x2a, 0x22, 0x0c, 0x2f, 0x6e, 0x2f, 0x6d, 0x65, 0x74, 0x61, 0x2f, 0x66, 0x69, 0x6e, 0x64, 0x12,
-	0x61, 0x0a, 0x0f, 0x42, 0x61, 0x74, 0x63, 0x68, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4d, 0x65,
-	0x74, 0x61, 0x12, 0x19, 0x2e, 0x72, 0x65, 0x73, 0x74, 0x2e, 0x42, 0x61, 0x74, 0x63, 0x68, 0x55,
-	0x70, 0x64, 0x61, 0x74, 0x65, 0x4d, 0x65, 0x74, 0x61, 0x4c, 0x69, 0x73, 0x74, 0x1a, 0x19, 0x2e,
-	0x72, 0x65, 0x73, 0x74, 0x2e, 0x42, 0x61, 0x74, 0x63, 0x68, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
-	0x4d, 0x65, 0x74, 0x61, 0x4c, 0x69, 0x73, 0x74, 0x22, 0x18, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x12,
-	0x3a, 0x01, 0x2a, 0x32, 0x0d, 0x2f, 0x6e, 0x2f, 0x6d, 0x65, 0x74, 0x61, 0x2f, 0x62, 0x61, 0x74,
-	0x63, 0x68, 0x12, 0x71, 0x0a, 0x0e, 0x4c, 0x69, 0x73, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x70,
-	0x61, 0x63, 0x65, 0x73, 0x12, 0x21, 0x2e, 0x69, 0x64, 0x6d, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x55,
-	0x73, 0x65, 0x72, 0x4d, 0x65, 0x74, 0x61, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x72, 0x65, 0x73, 0x74, 0x2e, 0x55,
-	0x73, 0x65, 0x72, 0x4d, 0x65, 0x74, 0x61, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65,
-	0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x19, 0x82, 0xd3, 0xe4, 0x93,
-	0x02, 0x13, 0x12, 0x11, 0x2f, 0x6e, 0x2f, 0x6d, 0x65, 0x74, 0x61, 0x2f, 0x6e, 0x61, 0x6d, 0x65,
-	0x73, 0x70, 0x61, 0x63, 0x65, 0x12, 0x7d, 0x0a, 0x13, 0x4c, 0x69, 0x73, 0x74, 0x4e, 0x61, 0x6d,
-	0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x12, 0x20, 0x2e, 0x72,
-	0x65, 0x73, 0x74, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63,
-	0x65, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d,
-	0x2e, 0x72, 0x65, 0x73, 0x74, 0x2e, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x56,
-	0x61, 0x6c, 0x75, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x25, 0x82,
-	0xd3, 0xe4, 0x93, 0x02, 0x1f, 0x12, 0x1d, 0x2f, 0x6e, 0x2f, 0x6d, 0x65, 0x74, 0x61, 0x2f, 0x6e,
-	0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x2f, 0x7b, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x70,
-	0x61, 0x63, 0x65, 0x7d, 0x12, 0x86, 0x01, 0x0a, 0x15, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4e,
-	0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x12, 0x1c,
-	0x2e, 0x72, 0x65, 0x73, 0x74, 0x2e, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x56,
-	0x61, 0x6c, 0x75, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x72,
-	0x65, 0x73, 0x74, 0x2e, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x56, 0x61, 0x6c,
-	0x75, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x30, 0x82, 0xd3, 0xe4,
-	0x93, 0x02, 0x2a, 0x3a, 0x09, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x32, 0x1d,
-	0x2f, 0x6e, 0x2f, 0x6d, 0x65, 0x74, 0x61, 0x2f, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63,
-	0x65, 0x2f, 0x7b, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x7d, 0x12, 0x59, 0x0a,
-	0x0d, 0x47, 0x65, 0x74, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x4c, 0x69, 0x6e, 0x6b, 0x12, 0x1b,
-	0x2e, 0x72, 0x65, 0x73, 0x74, 0x2e, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x4c, 0x69, 0x6e, 0x6b,
-	0x55, 0x75, 0x69, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0f, 0x2e, 0x72, 0x65,
-	0x73, 0x74, 0x2e, 0x53, 0x68, 0x61, 0x72, 0x65, 0x4c, 0x69, 0x6e, 0x6b, 0x22, 0x1a, 0x82, 0xd3,
-	0xe4, 0x93, 0x02, 0x14, 0x12, 0x12, 0x2f, 0x6e, 0x2f, 0x6c, 0x69, 0x6e, 0x6b, 0x2f, 0x7b, 0x4c,
-	0x69, 0x6e, 0x6b, 0x55, 0x75, 0x69, 0x64, 0x7d, 0x12, 0x71, 0x0a, 0x10, 0x55, 0x70, 0x64, 0x61,
-	0x74, 0x65, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x4c, 0x69, 0x6e, 0x6b, 0x12, 0x1d, 0x2e, 0x72,
-	0x65, 0x73, 0x74, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63,
-	0x4c, 0x69, 0x6e, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0f, 0x2e, 0x72, 0x65,
-	0x73, 0x74, 0x2e, 0x53, 0x68, 0x61, 0x72, 0x65, 0x4c, 0x69, 0x6e, 0x6b, 0x22, 0x2d, 0x82, 0xd3,
-	0xe4, 0x93, 0x02, 0x27, 0x3a, 0x11, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x4c, 0x69, 0x6e, 0x6b,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x32, 0x12, 0x2f, 0x6e, 0x2f, 0x6c, 0x69, 0x6e, 0x6b,
-	0x2f, 0x7b, 0x4c, 0x69, 0x6e, 0x6b, 0x55, 0x75, 0x69, 0x64, 0x7d, 0x12, 0x6a, 0x0a, 0x10, 0x44,
-	0x65, 0x6c, 0x65, 0x74, 0x65, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x4c, 0x69, 0x6e, 0x6b, 0x12,
+	0x7b, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x7d, 0x12, 0x6a, 0x0a, 0x0c, 0x4e,
+	0x6f, 0x64, 0x65, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x19, 0x2e, 0x72, 0x65,
+	0x73, 0x74, 0x2e, 0x4e, 0x6f, 0x64, 0x65, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x17, 0x2e, 0x72, 0x65, 0x73, 0x74, 0x2e, 0x56, 0x65,
+	0x72, 0x73, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x22,
+	0x26, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x20, 0x3a, 0x05, 0x51, 0x75, 0x65, 0x72, 0x79, 0x22, 0x17,
+	0x2f, 0x6e, 0x2f, 0x6e, 0x6f, 0x64, 0x65, 0x2f, 0x7b, 0x55, 0x75, 0x69, 0x64, 0x7d, 0x2f, 0x76,
+	0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x70, 0x0a, 0x10, 0x43, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x4c, 0x69, 0x6e, 0x6b, 0x12, 0x1b, 0x2e, 0x72, 0x65,
+	0x73, 0x74, 0x2e, 0x4e, 0x6f, 0x64, 0x65, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x4c, 0x69, 0x6e,
+	0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0f, 0x2e, 0x72, 0x65, 0x73, 0x74, 0x2e,
+	0x53, 0x68, 0x61, 0x72, 0x65, 0x4c, 0x69, 0x6e, 0x6b, 0x22, 0x2e, 0x82, 0xd3, 0xe4, 0x93, 0x02,
+	0x28, 0x3a, 0x11, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x4c, 0x69, 0x6e, 0x6b, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x22, 0x13, 0x2f, 0x6e, 0x2f, 0x6e, 0x6f, 0x64, 0x65, 0x2f, 0x7b, 0x55,
+	0x75, 0x69, 0x64, 0x7d, 0x2f, 0x6c, 0x69, 0x6e, 0x6b, 0x12, 0x55, 0x0a, 0x0a, 0x53, 0x65, 0x61,
+	0x72, 0x63, 0x68, 0x4d, 0x65, 0x74, 0x61, 0x12, 0x1a, 0x2e, 0x69, 0x64, 0x6d, 0x2e, 0x53, 0x65,
+	0x61, 0x72, 0x63, 0x68, 0x55, 0x73, 0x65, 0x72, 0x4d, 0x65, 0x74, 0x61, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x12, 0x2e, 0x72, 0x65, 0x73, 0x74, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x4d,
+	0x65, 0x74, 0x61, 0x4c, 0x69, 0x73, 0x74, 0x22, 0x17, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x11, 0x3a,
+	0x01, 0x2a, 0x22, 0x0c, 0x2f, 0x6e, 0x2f, 0x6d, 0x65, 0x74, 0x61, 0x2f, 0x66, 0x69, 0x6e, 0x64,
+	0x12, 0x61, 0x0a, 0x0f, 0x42, 0x61, 0x74, 0x63, 0x68, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4d,
+	0x65, 0x74, 0x61, 0x12, 0x19, 0x2e, 0x72, 0x65, 0x73, 0x74, 0x2e, 0x42, 0x61, 0x74, 0x63, 0x68,
+	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4d, 0x65, 0x74, 0x61, 0x4c, 0x69, 0x73, 0x74, 0x1a, 0x19,
+	0x2e, 0x72, 0x65, 0x73, 0x74, 0x2e, 0x42, 0x61, 0x74, 0x63, 0x68, 0x55, 0x70, 0x64, 0x61, 0x74,
+	0x65, 0x4d, 0x65, 0x74, 0x61, 0x4c, 0x69, 0x73, 0x74, 0x22, 0x18, 0x82, 0xd3, 0xe4, 0x93, 0x02,
+	0x12, 0x3a, 0x01, 0x2a, 0x32, 0x0d, 0x2f, 0x6e, 0x2f, 0x6d, 0x65, 0x74, 0x61, 0x2f, 0x62, 0x61,
+	0x74, 0x63, 0x68, 0x12, 0x71, 0x0a, 0x0e, 0x4c, 0x69, 0x73, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x73,
+	0x70, 0x61, 0x63, 0x65, 0x73, 0x12, 0x21, 0x2e, 0x69, 0x64, 0x6d, 0x2e, 0x4c, 0x69, 0x73, 0x74,
+	0x55, 0x73, 0x65, 0x72, 0x4d, 0x65, 0x74, 0x61, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63,
+	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x72, 0x65, 0x73, 0x74, 0x2e,
+	0x55, 0x73, 0x65, 0x72, 0x4d, 0x65, 0x74, 0x61, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63,
+	0x65, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x19, 0x82, 0xd3, 0xe4,
+	0x93, 0x02, 0x13, 0x12, 0x11, 0x2f, 0x6e, 0x2f, 0x6d, 0x65, 0x74, 0x61, 0x2f, 0x6e, 0x61, 0x6d,
+	0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x12, 0x7d, 0x0a, 0x13, 0x4c, 0x69, 0x73, 0x74, 0x4e, 0x61,
+	0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x12, 0x20, 0x2e,
+	0x72, 0x65, 0x73, 0x74, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61,
+	0x63, 0x65, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x1d, 0x2e, 0x72, 0x65, 0x73, 0x74, 0x2e, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65,
+	0x56, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x25,
+	0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1f, 0x12, 0x1d, 0x2f, 0x6e, 0x2f, 0x6d, 0x65, 0x74, 0x61, 0x2f,
+	0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x2f, 0x7b, 0x4e, 0x61, 0x6d, 0x65, 0x73,
+	0x70, 0x61, 0x63, 0x65, 0x7d, 0x12, 0x86, 0x01, 0x0a, 0x15, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
+	0x4e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x12,
+	0x1c, 0x2e, 0x72, 0x65, 0x73, 0x74, 0x2e, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65,
+	0x56, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e,
+	0x72, 0x65, 0x73, 0x74, 0x2e, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x56, 0x61,
+	0x6c, 0x75, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x30, 0x82, 0xd3,
+	0xe4, 0x93, 0x02, 0x2a, 0x3a, 0x09, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x32,
+	0x1d, 0x2f, 0x6e, 0x2f, 0x6d, 0x65, 0x74, 0x61, 0x2f, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61,
+	0x63, 0x65, 0x2f, 0x7b, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x7d, 0x12, 0x59,
+	0x0a, 0x0d, 0x47, 0x65, 0x74, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x4c, 0x69, 0x6e, 0x6b, 0x12,
 	0x1b, 0x2e, 0x72, 0x65, 0x73, 0x74, 0x2e, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x4c, 0x69, 0x6e,
-	0x6b, 0x55, 0x75, 0x69, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x72,
-	0x65, 0x73, 0x74, 0x2e, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x4c, 0x69, 0x6e, 0x6b, 0x44, 0x65,
-	0x6c, 0x65, 0x74, 0x65, 0x53, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x22, 0x1a, 0x82, 0xd3, 0xe4,
-	0x93, 0x02, 0x14, 0x2a, 0x12, 0x2f, 0x6e, 0x2f, 0x6c, 0x69, 0x6e, 0x6b, 0x2f, 0x7b, 0x4c, 0x69,
-	0x6e, 0x6b, 0x55, 0x75, 0x69, 0x64, 0x7d, 0x12, 0x6e, 0x0a, 0x0d, 0x50, 0x65, 0x72, 0x66, 0x6f,
-	0x72, 0x6d, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1a, 0x2e, 0x72, 0x65, 0x73, 0x74, 0x2e,
-	0x50, 0x65, 0x72, 0x66, 0x6f, 0x72, 0x6d, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x72, 0x65, 0x73, 0x74, 0x2e, 0x50, 0x65, 0x72, 0x66,
-	0x6f, 0x72, 0x6d, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x22, 0x24, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1e, 0x3a, 0x0a, 0x50, 0x61, 0x72, 0x61, 0x6d,
-	0x65, 0x74, 0x65, 0x72, 0x73, 0x22, 0x10, 0x2f, 0x6e, 0x2f, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e,
-	0x2f, 0x7b, 0x4e, 0x61, 0x6d, 0x65, 0x7d, 0x12, 0x67, 0x0a, 0x14, 0x42, 0x61, 0x63, 0x6b, 0x67,
-	0x72, 0x6f, 0x75, 0x6e, 0x64, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x12,
-	0x13, 0x2e, 0x72, 0x65, 0x73, 0x74, 0x2e, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x72, 0x65, 0x73, 0x74, 0x2e, 0x42, 0x61, 0x63, 0x6b,
-	0x67, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x22, 0x82, 0xd3,
-	0xe4, 0x93, 0x02, 0x1c, 0x12, 0x1a, 0x2f, 0x6e, 0x2f, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2f,
-	0x7b, 0x4e, 0x61, 0x6d, 0x65, 0x7d, 0x2f, 0x7b, 0x4a, 0x6f, 0x62, 0x55, 0x75, 0x69, 0x64, 0x7d,
-	0x12, 0x7a, 0x0a, 0x17, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x42, 0x61, 0x63, 0x6b, 0x67,
-	0x72, 0x6f, 0x75, 0x6e, 0x64, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1a, 0x2e, 0x72, 0x65,
-	0x73, 0x74, 0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x72, 0x65, 0x73, 0x74, 0x2e, 0x42,
-	0x61, 0x63, 0x6b, 0x67, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x22,
-	0x2b, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x25, 0x3a, 0x07, 0x43, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64,
-	0x32, 0x1a, 0x2f, 0x6e, 0x2f, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x7b, 0x4e, 0x61, 0x6d,
-	0x65, 0x7d, 0x2f, 0x7b, 0x4a, 0x6f, 0x62, 0x55, 0x75, 0x69, 0x64, 0x7d, 0x12, 0x4c, 0x0a, 0x0f,
-	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x53, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12,
-	0x0f, 0x2e, 0x72, 0x65, 0x73, 0x74, 0x2e, 0x53, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e,
-	0x1a, 0x0f, 0x2e, 0x72, 0x65, 0x73, 0x74, 0x2e, 0x53, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f,
-	0x6e, 0x22, 0x17, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x11, 0x3a, 0x01, 0x2a, 0x22, 0x0c, 0x2f, 0x6e,
-	0x2f, 0x73, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x5a, 0x0a, 0x09, 0x54, 0x65,
-	0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x73, 0x12, 0x1a, 0x2e, 0x72, 0x65, 0x73, 0x74, 0x2e, 0x4c,
-	0x69, 0x73, 0x74, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x72, 0x65, 0x73, 0x74, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x54,
-	0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x22, 0x14, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x0e, 0x12, 0x0c, 0x2f, 0x6e, 0x2f, 0x74, 0x65, 0x6d,
-	0x70, 0x6c, 0x61, 0x74, 0x65, 0x73, 0x42, 0x97, 0x02, 0x92, 0x41, 0xe6, 0x01, 0x12, 0x36, 0x0a,
-	0x14, 0x50, 0x79, 0x64, 0x69, 0x6f, 0x20, 0x43, 0x65, 0x6c, 0x6c, 0x73, 0x20, 0x52, 0x65, 0x73,
-	0x74, 0x20, 0x41, 0x50, 0x49, 0x22, 0x1a, 0x0a, 0x05, 0x50, 0x79, 0x64, 0x69, 0x6f, 0x12, 0x11,
+	0x6b, 0x55, 0x75, 0x69, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0f, 0x2e, 0x72,
+	0x65, 0x73, 0x74, 0x2e, 0x53, 0x68, 0x61, 0x72, 0x65, 0x4c, 0x69, 0x6e, 0x6b, 0x22, 0x1a, 0x82,
+	0xd3, 0xe4, 0x93, 0x02, 0x14, 0x12, 0x12, 0x2f, 0x6e, 0x2f, 0x6c, 0x69, 0x6e, 0x6b, 0x2f, 0x7b,
+	0x4c, 0x69, 0x6e, 0x6b, 0x55, 0x75, 0x69, 0x64, 0x7d, 0x12, 0x71, 0x0a, 0x10, 0x55, 0x70, 0x64,
+	0x61, 0x74, 0x65, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x4c, 0x69, 0x6e, 0x6b, 0x12, 0x1d, 0x2e,
+	0x72, 0x65, 0x73, 0x74, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x75, 0x62, 0x6c, 0x69,
+	0x63, 0x4c, 0x69, 0x6e, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0f, 0x2e, 0x72,
+	0x65, 0x73, 0x74, 0x2e, 0x53, 0x68, 0x61, 0x72, 0x65, 0x4c, 0x69, 0x6e, 0x6b, 0x22, 0x2d, 0x82,
+	0xd3, 0xe4, 0x93, 0x02, 0x27, 0x3a, 0x11, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x4c, 0x69, 0x6e,
+	0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x32, 0x12, 0x2f, 0x6e, 0x2f, 0x6c, 0x69, 0x6e,
+	0x6b, 0x2f, 0x7b, 0x4c, 0x69, 0x6e, 0x6b, 0x55, 0x75, 0x69, 0x64, 0x7d, 0x12, 0x6a, 0x0a, 0x10,
+	0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x4c, 0x69, 0x6e, 0x6b,
+	0x12, 0x1b, 0x2e, 0x72, 0x65, 0x73, 0x74, 0x2e, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x4c, 0x69,
+	0x6e, 0x6b, 0x55, 0x75, 0x69, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e,
+	0x72, 0x65, 0x73, 0x74, 0x2e, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x4c, 0x69, 0x6e, 0x6b, 0x44,
+	0x65, 0x6c, 0x65, 0x74, 0x65, 0x53, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x22, 0x1a, 0x82, 0xd3,
+	0xe4, 0x93, 0x02, 0x14, 0x2a, 0x12, 0x2f, 0x6e, 0x2f, 0x6c, 0x69, 0x6e, 0x6b, 0x2f, 0x7b, 0x4c,
+	0x69, 0x6e, 0x6b, 0x55, 0x75, 0x69, 0x64, 0x7d, 0x12, 0x7b, 0x0a, 0x16, 0x47, 0x65, 0x74, 0x50,
+	0x75, 0x62, 0x6c, 0x69, 0x63, 0x4c, 0x69, 0x6e, 0x6b, 0x41, 0x6e, 0x61, 0x6c, 0x79, 0x74, 0x69,
+	0x63, 0x73, 0x12, 0x20, 0x2e, 0x72, 0x65, 0x73, 0x74, 0x2e, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63,
+	0x4c, 0x69, 0x6e, 0x6b, 0x41, 0x6e, 0x61, 0x6c, 0x79, 0x74, 0x69, 0x63, 0x73, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e, 0x72, 0x65, 0x73, 0x74, 0x2e, 0x50, 0x75, 0x62, 0x6c,
+	0x69, 0x63, 0x4c, 0x69, 0x6e, 0x6b, 0x41, 0x6e, 0x61, 0x6c, 0x79, 0x74, 0x69, 0x63, 0x73, 0x22,
+	0x24, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1e, 0x12, 0x1c, 0x2f, 0x6e, 0x2f, 0x6c, 0x69, 0x6e, 0x6b,
+	0x2f, 0x7b, 0x4c, 0x69, 0x6e, 0x6b, 0x55, 0x75, 0x69, 0x64, 0x7d, 0x2f, 0x61, 0x6e, 0x61, 0x6c,
+	0x79, 0x74, 0x69, 0x63, 0x73, 0x12, 0x6e, 0x0a, 0x0d, 0x50, 0x65, 0x72, 0x66, 0x6f, 0x72, 0x6d,
+	0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1a, 0x2e, 0x72, 0x65, 0x73, 0x74, 0x2e, 0x50, 0x65,
+	0x72, 0x66, 0x6f, 0x72, 0x6d, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x72, 0x65, 0x73, 0x74, 0x2e, 0x50, 0x65, 0x72, 0x66, 0x6f, 0x72,
+	0x6d, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x24, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1e, 0x3a, 0x0a, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x65, 0x74,
+	0x65, 0x72, 0x73, 0x22, 0x10, 0x2f, 0x6e, 0x2f, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x7b,
+	0x4e, 0x61, 0x6d, 0x65, 0x7d, 0x12, 0x67, 0x0a, 0x14, 0x42, 0x61, 0x63, 0x6b, 0x67, 0x72, 0x6f,
+	0x75, 0x6e, 0x64, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x13, 0x2e,
+	0x72, 0x65, 0x73, 0x74, 0x2e, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x16, 0x2e, 0x72, 0x65, 0x73, 0x74, 0x2e, 0x42, 0x61, 0x63, 0x6b, 0x67, 0x72,
+	0x6f, 0x75, 0x6e, 0x64, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x22, 0x82, 0xd3, 0xe4, 0x93,
+	0x02, 0x1c, 0x12, 0x1a, 0x2f, 0x6e, 0x2f, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x7b, 0x4e,
+	0x61, 0x6d, 0x65, 0x7d, 0x2f, 0x7b, 0x4a, 0x6f, 0x62, 0x55, 0x75, 0x69, 0x64, 0x7d, 0x12, 0x7a,
+	0x0a, 0x17, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x42, 0x61, 0x63, 0x6b, 0x67, 0x72, 0x6f,
+	0x75, 0x6e, 0x64, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1a, 0x2e, 0x72, 0x65, 0x73, 0x74,
+	0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x72, 0x65, 0x73, 0x74, 0x2e, 0x42, 0x61, 0x63,
+	0x6b, 0x67, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x2b, 0x82,
+	0xd3, 0xe4, 0x93, 0x02, 0x25, 0x3a, 0x07, 0x43, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x32, 0x1a,
+	0x2f, 0x6e, 0x2f, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x7b, 0x4e, 0x61, 0x6d, 0x65, 0x7d,
+	0x2f, 0x7b, 0x4a, 0x6f, 0x62, 0x55, 0x75, 0x69, 0x64, 0x7d, 0x12, 0x4c, 0x0a, 0x0f, 0x43, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x53, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x0f, 0x2e,
+	0x72, 0x65, 0x73, 0x74, 0x2e, 0x53, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x1a, 0x0f,
+	0x2e, 0x72, 0x65, 0x73, 0x74, 0x2e, 0x53, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x22,
+	0x17, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x11, 0x3a, 0x01, 0x2a, 0x22, 0x0c, 0x2f, 0x6e, 0x2f, 0x73,
+	0x65, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x5a, 0x0a, 0x09, 0x54, 0x65, 0x6d, 0x70,
+	0x6c, 0x61, 0x74, 0x65, 0x73, 0x12, 0x1a, 0x2e, 0x72, 0x65, 0x73, 0x74, 0x2e, 0x4c, 0x69, 0x73,
+	0x74, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x1b, 0x2e, 0x72, 0x65, 0x73, 0x74, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x54, 0x65, 0x6d,
+	0x70, 0x6c, 0x61, 0x74, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x14,
+	0x82, 0xd3, 0xe4, 0x93, 0x02, 0x0e, 0x12, 0x0c, 0x2f, 0x6e, 0x2f, 0x74, 0x65, 0x6d, 0x70, 0x6c,
+	0x61, 0x74, 0x65, 0x73, 0x42, 0x97, 0x02, 0x92, 0x41, 0xe6, 0x01, 0x12, 0x36, 0x0a, 0x14, 0x50,
+	0x79, 0x64, 0x69, 0x6f, 0x20, 0x43, 0x65, 0x6c, 0x6c, 0x73, 0x20, 0x52, 0x65, 0x73, 0x74, 0x20,
+	0x41, 0x50, 0x49, 0x22, 0x1a, 0x0a, 0x05, 0x50, 0x79, 0x64, 0x69, 0x6f, 0x12, 0x11, 0x68, 0x74,
+	0x74, 0x70, 0x73, 0x3a, 0x2f, 0x2f, 0x70, 0x79, 0x64, 0x69, 0x6f, 0x2e, 0x63, 0x6f, 0x6d, 0x32,
+	0x02, 0x76, 0x32, 0x2a, 0x03, 0x01, 0x02, 0x04, 0x32, 0x10, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x10, 0x61, 0x70, 0x70, 0x6c,
+	0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x6a, 0x73, 0x6f, 0x6e, 0x5a, 0x43, 0x0a, 0x41,
+	0x0a, 0x06, 0x42, 0x65, 0x61, 0x72, 0x65, 0x72, 0x12, 0x37, 0x08, 0x02, 0x12, 0x22, 0x45, 0x78,
+	0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x20, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x20, 0x69, 0x73, 0x20,
+	0x27, 0x42, 0x65, 0x61, 0x72, 0x65, 0x72, 0x20, 0x7b, 0x54, 0x4f, 0x4b, 0x45, 0x4e, 0x7d, 0x27,
+	0x1a, 0x0d, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x20,
+	0x02, 0x62, 0x0c, 0x0a, 0x0a, 0x0a, 0x06, 0x42, 0x65, 0x61, 0x72, 0x65, 0x72, 0x12, 0x00, 0x72,
+	0x30, 0x0a, 0x1b, 0x4d, 0x6f, 0x72, 0x65, 0x20, 0x61, 0x62, 0x6f, 0x75, 0x74, 0x20, 0x50, 0x79,
+	0x64, 0x69, 0x6f, 0x20, 0x43, 0x65, 0x6c, 0x6c, 0x73, 0x20, 0x41, 0x70, 0x69, 0x73, 0x12, 0x11,
 	0x68, 0x74, 0x74, 0x70, 0x73, 0x3a, 0x2f, 0x2f, 0x70, 0x79, 0x64, 0x69, 0x6f, 0x2e, 0x63, 0x6f,
-	0x6d, 0x32, 0x02, 0x76, 0x32, 0x2a, 0x03, 0x01, 0x02, 0x04, 0x32, 0x10, 0x61, 0x70, 0x70, 0x6c,
-	0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x10, 0x61, 0x70,
-	0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x6a, 0x73, 0x6f, 0x6e, 0x5a, 0x43,
-	0x0a, 0x41, 0x0a, 0x06, 0x42, 0x65, 0x61, 0x72, 0x65, 0x72, 0x12, 0x37, 0x08, 0x02, 0x12, 0x22,
-	0x45, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x20, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x20, 0x69,
-	0x73, 0x20, 0x27, 0x42, 0x65, 0x61, 0x72, 0x65, 0x72, 0x20, 0x7b, 0x54, 0x4f, 0x4b, 0x45, 0x4e,
-	0x7d, 0x27, 0x1a, 0x0d, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f,
-	0x6e, 0x20, 0x02, 0x62, 0x0c, 0x0a, 0x0a, 0x0a, 0x06, 0x42, 0x65, 0x61, 0x72, 0x65, 0x72, 0x12,
-	0x00, 0x72, 0x30, 0x0a, 0x1b, 0x4d, 0x6f, 0x72, 0x65, 0x20, 0x61, 0x62, 0x6f, 0x75, 0x74, 0x20,
-	0x50, 0x79, 0x64, 0x69, 0x6f, 0x20, 0x43, 0x65, 0x6c, 0x6c, 0x73, 0x20, 0x41, 0x70, 0x69, 0x73,
-	0x12, 0x11, 0x68, 0x74, 0x74, 0x70, 0x73, 0x3a, 0x2f, 0x2f, 0x70, 0x79, 0x64, 0x69, 0x6f, 0x2e,
-	0x63, 0x6f, 0x6d, 0x5a, 0x2b, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f,
-	0x70, 0x79, 0x64, 0x69, 0x6f, 0x2f, 0x63, 0x65, 0x6c, 0x6c, 0x73, 0x2f, 0x76, 0x35, 0x2f, 0x63,
-	0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x72, 0x65, 0x73, 0x74,
-	0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x6d, 0x5a, 0x2b, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x70, 0x79,
+	0x64, 0x69, 0x6f, 0x2f, 0x63, 0x65, 0x6c, 0x6c, 0x73, 0x2f, 0x76, 0x35, 0x2f, 0x63, 0x6f, 0x6d,
+	0x6d, 0x6f, 0x6e, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x72, 0x65, 0x73, 0x74, 0x62, 0x06,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -6094,7 +6387,7 @@ func file_cellsapi_rest_v2_proto_rawDescGZIP() []byte {
 }
 
 var file_cellsapi_rest_v2_proto_enumTypes = make([]protoimpl.EnumInfo, 10)
-var file_cellsapi_rest_v2_proto_msgTypes = make([]protoimpl.MessageInfo, 66)
+var file_cellsapi_rest_v2_proto_msgTypes = make([]protoimpl.MessageInfo, 69)
 var file_cellsapi_rest_v2_proto_goTypes = []any{
 	(Mode)(0),                       // 0: rest.Mode
 	(Flag)(0),                       // 1: rest.Flag
@@ -6157,52 +6450,55 @@ var file_cellsapi_rest_v2_proto_goTypes = []any{
 	(*UpdatePublicLinkRequest)(nil),              // 58: rest.UpdatePublicLinkRequest
 	(*PublicLinkUuidRequest)(nil),                // 59: rest.PublicLinkUuidRequest
 	(*PublicLinkDeleteSuccess)(nil),              // 60: rest.PublicLinkDeleteSuccess
-	(*MetaUpdate)(nil),                           // 61: rest.MetaUpdate
-	(*MetaToggle)(nil),                           // 62: rest.MetaToggle
-	(*NodeUpdates)(nil),                          // 63: rest.NodeUpdates
-	(*PatchNodeRequest)(nil),                     // 64: rest.PatchNodeRequest
-	(*BatchUpdateMetaList)(nil),                  // 65: rest.BatchUpdateMetaList
-	(*NamespaceValuesOperation)(nil),             // 66: rest.NamespaceValuesOperation
-	(*NamespaceValuesRequest)(nil),               // 67: rest.NamespaceValuesRequest
-	(*ListNamespaceValuesRequest)(nil),           // 68: rest.ListNamespaceValuesRequest
-	(*NamespaceValuesResponse)(nil),              // 69: rest.NamespaceValuesResponse
-	(*LookupFilter_SizeRange)(nil),               // 70: rest.LookupFilter.SizeRange
-	(*LookupFilter_DateRange)(nil),               // 71: rest.LookupFilter.DateRange
-	(*LookupFilter_PathPrefix)(nil),              // 72: rest.LookupFilter.PathPrefix
-	(*LookupFilter_TextSearch)(nil),              // 73: rest.LookupFilter.TextSearch
-	(*LookupFilter_MetaFilter)(nil),              // 74: rest.LookupFilter.MetaFilter
-	(*LookupFilter_StatusFilter)(nil),            // 75: rest.LookupFilter.StatusFilter
-	(idm.WorkspaceScope)(0),                      // 76: idm.WorkspaceScope
-	(tree.NodeType)(0),                           // 77: tree.NodeType
-	(*ShareLink)(nil),                            // 78: rest.ShareLink
-	(*activity.Object)(nil),                      // 79: activity.Object
-	(*activity.Subscription)(nil),                // 80: activity.Subscription
-	(*tree.SearchFacet)(nil),                     // 81: tree.SearchFacet
-	(*Pagination)(nil),                           // 82: rest.Pagination
-	(*tree.Query)(nil),                           // 83: tree.Query
-	(jobs.TaskStatus)(0),                         // 84: jobs.TaskStatus
-	(*jobs.CtrlCommand)(nil),                     // 85: jobs.CtrlCommand
-	(*UserBookmarksRequest)(nil),                 // 86: rest.UserBookmarksRequest
-	(*idm.SearchUserMetaRequest)(nil),            // 87: idm.SearchUserMetaRequest
-	(*idm.ListUserMetaNamespaceRequest)(nil),     // 88: idm.ListUserMetaNamespaceRequest
-	(*ListTemplatesRequest)(nil),                 // 89: rest.ListTemplatesRequest
-	(*UserMetaNamespaceCollection)(nil),          // 90: rest.UserMetaNamespaceCollection
-	(*ListTemplatesResponse)(nil),                // 91: rest.ListTemplatesResponse
+	(*PublicLinkAnalyticsRequest)(nil),           // 61: rest.PublicLinkAnalyticsRequest
+	(*PublicLinkAccess)(nil),                     // 62: rest.PublicLinkAccess
+	(*PublicLinkAnalytics)(nil),                  // 63: rest.PublicLinkAnalytics
+	(*MetaUpdate)(nil),                           // 64: rest.MetaUpdate
+	(*MetaToggle)(nil),                           // 65: rest.MetaToggle
+	(*NodeUpdates)(nil),                          // 66: rest.NodeUpdates
+	(*PatchNodeRequest)(nil),                     // 67: rest.PatchNodeRequest
+	(*BatchUpdateMetaList)(nil),                  // 68: rest.BatchUpdateMetaList
+	(*NamespaceValuesOperation)(nil),             // 69: rest.NamespaceValuesOperation
+	(*NamespaceValuesRequest)(nil),               // 70: rest.NamespaceValuesRequest
+	(*ListNamespaceValuesRequest)(nil),           // 71: rest.ListNamespaceValuesRequest
+	(*NamespaceValuesResponse)(nil),              // 72: rest.NamespaceValuesResponse
+	(*LookupFilter_SizeRange)(nil),               // 73: rest.LookupFilter.SizeRange
+	(*LookupFilter_DateRange)(nil),               // 74: rest.LookupFilter.DateRange
+	(*LookupFilter_PathPrefix)(nil),              // 75: rest.LookupFilter.PathPrefix
+	(*LookupFilter_TextSearch)(nil),              // 76: rest.LookupFilter.TextSearch
+	(*LookupFilter_MetaFilter)(nil),              // 77: rest.LookupFilter.MetaFilter
+	(*LookupFilter_StatusFilter)(nil),            // 78: rest.LookupFilter.StatusFilter
+	(idm.WorkspaceScope)(0),                      // 79: idm.WorkspaceScope
+	(tree.NodeType)(0),                           // 80: tree.NodeType
+	(*ShareLink)(nil),                            // 81: rest.ShareLink
+	(*activity.Object)(nil),                      // 82: activity.Object
+	(*activity.Subscription)(nil),                // 83: activity.Subscription
+	(*tree.SearchFacet)(nil),                     // 84: tree.SearchFacet
+	(*Pagination)(nil),                           // 85: rest.Pagination
+	(*tree.Query)(nil),                           // 86: tree.Query
+	(jobs.TaskStatus)(0),                         // 87: jobs.TaskStatus
+	(*jobs.CtrlCommand)(nil),                     // 88: jobs.CtrlCommand
+	(*UserBookmarksRequest)(nil),                 // 89: rest.UserBookmarksRequest
+	(*idm.SearchUserMetaRequest)(nil),            // 90: idm.SearchUserMetaRequest
+	(*idm.ListUserMetaNamespaceRequest)(nil),     // 91: idm.ListUserMetaNamespaceRequest
+	(*ListTemplatesRequest)(nil),                 // 92: rest.ListTemplatesRequest
+	(*UserMetaNamespaceCollection)(nil),          // 93: rest.UserMetaNamespaceCollection
+	(*ListTemplatesResponse)(nil),                // 94: rest.ListTemplatesResponse
 }
 var file_cellsapi_rest_v2_proto_depIdxs = []int32{
-	76,  // 0: rest.ContextWorkspace.Scope:type_name -> idm.WorkspaceScope
+	79,  // 0: rest.ContextWorkspace.Scope:type_name -> idm.WorkspaceScope
 	13,  // 1: rest.FilePreview.PreSignedGET:type_name -> rest.PreSignedURL
 	18,  // 2: rest.UserMetaList.UserMeta:type_name -> rest.UserMeta
-	77,  // 3: rest.Node.Type:type_name -> tree.NodeType
+	80,  // 3: rest.Node.Type:type_name -> tree.NodeType
 	0,   // 4: rest.Node.Mode:type_name -> rest.Mode
 	13,  // 5: rest.Node.PreSignedGET:type_name -> rest.PreSignedURL
 	11,  // 6: rest.Node.ContextWorkspace:type_name -> rest.ContextWorkspace
 	12,  // 7: rest.Node.DataSourceFeatures:type_name -> rest.DataSourceFeatures
 	10,  // 8: rest.Node.ContentLock:type_name -> rest.LockInfo
 	15,  // 9: rest.Node.Previews:type_name -> rest.FilePreview
-	78,  // 10: rest.Node.Shares:type_name -> rest.ShareLink
-	79,  // 11: rest.Node.Activities:type_name -> activity.Object
-	80,  // 12: rest.Node.Subscriptions:type_name -> activity.Subscription
+	81,  // 10: rest.Node.Shares:type_name -> rest.ShareLink
+	82,  // 11: rest.Node.Activities:type_name -> activity.Object
+	83,  // 12: rest.Node.Subscriptions:type_name -> activity.Subscription
 	14,  // 13: rest.Node.ImageMeta:type_name -> rest.ImageMeta
 	16,  // 14: rest.Node.Metadata:type_name -> rest.JsonMeta
 	17,  // 15: rest.Node.FolderMeta:type_name -> rest.CountMeta
@@ -6210,11 +6506,11 @@ var file_cellsapi_rest_v2_proto_depIdxs = []int32{
 	24,  // 17: rest.Node.Versions:type_name -> rest.Version
 	19,  // 18: rest.Node.VersionMeta:type_name -> rest.VersionMeta
 	21,  // 19: rest.NodeCollection.Nodes:type_name -> rest.Node
-	81,  // 20: rest.NodeCollection.Facets:type_name -> tree.SearchFacet
-	82,  // 21: rest.NodeCollection.Pagination:type_name -> rest.Pagination
+	84,  // 20: rest.NodeCollection.Facets:type_name -> tree.SearchFacet
+	85,  // 21: rest.NodeCollection.Pagination:type_name -> rest.Pagination
 	24,  // 22: rest.VersionCollection.Versions:type_name -> rest.Version
 	22,  // 23: rest.IncomingNode.Locator:type_name -> rest.NodeLocator
-	77,  // 24: rest.IncomingNode.Type:type_name -> tree.NodeType
+	80,  // 24: rest.IncomingNode.Type:type_name -> tree.NodeType
 	18,  // 25: rest.IncomingNode.Metadata:type_name -> rest.UserMeta
 	26,  // 26: rest.CreateRequest.Inputs:type_name -> rest.IncomingNode
 	26,  // 27: rest.CreateCheckRequest.Inputs:type_name -> rest.IncomingNode
@@ -6224,18 +6520,18 @@ var file_cellsapi_rest_v2_proto_depIdxs = []int32{
 	22,  // 31: rest.NodeLocators.Many:type_name -> rest.NodeLocator
 	22,  // 32: rest.LookupScope.Root:type_name -> rest.NodeLocator
 	22,  // 33: rest.LookupScope.Nodes:type_name -> rest.NodeLocator
-	73,  // 34: rest.LookupFilter.Text:type_name -> rest.LookupFilter.TextSearch
-	77,  // 35: rest.LookupFilter.Type:type_name -> tree.NodeType
-	70,  // 36: rest.LookupFilter.Size:type_name -> rest.LookupFilter.SizeRange
-	71,  // 37: rest.LookupFilter.Date:type_name -> rest.LookupFilter.DateRange
-	74,  // 38: rest.LookupFilter.Metadata:type_name -> rest.LookupFilter.MetaFilter
-	75,  // 39: rest.LookupFilter.Status:type_name -> rest.LookupFilter.StatusFilter
-	72,  // 40: rest.LookupFilter.Prefixes:type_name -> rest.LookupFilter.PathPrefix
+	76,  // 34: rest.LookupFilter.Text:type_name -> rest.LookupFilter.TextSearch
+	80,  // 35: rest.LookupFilter.Type:type_name -> tree.NodeType
+	73,  // 36: rest.LookupFilter.Size:type_name -> rest.LookupFilter.SizeRange
+	74,  // 37: rest.LookupFilter.Date:type_name -> rest.LookupFilter.DateRange
+	77,  // 38: rest.LookupFilter.Metadata:type_name -> rest.LookupFilter.MetaFilter
+	78,  // 39: rest.LookupFilter.Status:type_name -> rest.LookupFilter.StatusFilter
+	75,  // 40: rest.LookupFilter.Prefixes:type_name -> rest.LookupFilter.PathPrefix
 	32,  // 41: rest.LookupRequest.Scope:type_name -> rest.LookupScope
 	33,  // 42: rest.LookupRequest.Filters:type_name -> rest.LookupFilter
 	1,   // 43: rest.LookupRequest.Flags:type_name -> rest.Flag
 	31,  // 44: rest.LookupRequest.Locators:type_name -> rest.NodeLocators
-	83,  // 45: rest.LookupRequest.Query:type_name -> tree.Query
+	86,  // 45: rest.LookupRequest.Query:type_name -> tree.Query
 	2,   // 46: rest.NodeVersionsFilter.FilterBy:type_name -> rest.VersionsTypes
 	35,  // 47: rest.NodeVersionsRequest.Query:type_name -> rest.NodeVersionsFilter
 	39,  // 48: rest.PromoteVersionRequest.Parameters:type_name -> rest.PromoteParameters
@@ -6248,85 +6544,89 @@ var file_cellsapi_rest_v2_proto_depIdxs = []int32{
 	46,  // 55: rest.ActionParameters.DeleteOptions:type_name -> rest.ActionOptionsDelete
 	47,  // 56: rest.ActionParameters.CopyMoveOptions:type_name -> rest.ActionOptionsCopyMove
 	48,  // 57: rest.ActionParameters.ExtractCompressOptions:type_name -> rest.ActionOptionsExtractCompress
-	84,  // 58: rest.ActionParameters.AwaitStatus:type_name -> jobs.TaskStatus
+	87,  // 58: rest.ActionParameters.AwaitStatus:type_name -> jobs.TaskStatus
 	3,   // 59: rest.ActionRequest.Name:type_name -> rest.UserActionType
 	3,   // 60: rest.PerformActionRequest.Name:type_name -> rest.UserActionType
 	49,  // 61: rest.PerformActionRequest.Parameters:type_name -> rest.ActionParameters
 	3,   // 62: rest.ControlActionRequest.Name:type_name -> rest.UserActionType
-	85,  // 63: rest.ControlActionRequest.Command:type_name -> jobs.CtrlCommand
+	88,  // 63: rest.ControlActionRequest.Command:type_name -> jobs.CtrlCommand
 	4,   // 64: rest.PerformActionResponse.Status:type_name -> rest.ActionStatus
 	21,  // 65: rest.PerformActionResponse.AffectedNodes:type_name -> rest.Node
 	54,  // 66: rest.PerformActionResponse.BackgroundActions:type_name -> rest.BackgroundAction
-	84,  // 67: rest.BackgroundAction.Status:type_name -> jobs.TaskStatus
+	87,  // 67: rest.BackgroundAction.Status:type_name -> jobs.TaskStatus
 	21,  // 68: rest.Selection.Nodes:type_name -> rest.Node
-	78,  // 69: rest.PublicLinkRequest.Link:type_name -> rest.ShareLink
+	81,  // 69: rest.PublicLinkRequest.Link:type_name -> rest.ShareLink
 	56,  // 70: rest.NodePublicLinkRequest.PublicLinkRequest:type_name -> rest.PublicLinkRequest
 	56,  // 71: rest.UpdatePublicLinkRequest.PublicLinkRequest:type_name -> rest.PublicLinkRequest
-	9,   // 72: rest.MetaUpdate.Operation:type_name -> rest.MetaUpdate.Op
-	18,  // 73: rest.MetaUpdate.UserMeta:type_name -> rest.UserMeta
-	61,  // 74: rest.NodeUpdates.MetaUpdates:type_name -> rest.MetaUpdate
-	62,  // 75: rest.NodeUpdates.Bookmark:type_name -> rest.MetaToggle
-	62,  // 76: rest.NodeUpdates.ContentLock:type_name -> rest.MetaToggle
-	63,  // 77: rest.PatchNodeRequest.NodeUpdates:type_name -> rest.NodeUpdates
-	61,  // 78: rest.BatchUpdateMetaList.Updates:type_name -> rest.MetaUpdate
-	5,   // 79: rest.NamespaceValuesOperation.Operation:type_name -> rest.NsOp
-	66,  // 80: rest.NamespaceValuesRequest.Operation:type_name -> rest.NamespaceValuesOperation
-	6,   // 81: rest.LookupFilter.TextSearch.SearchIn:type_name -> rest.LookupFilter.TextSearch.In
-	7,   // 82: rest.LookupFilter.MetaFilter.Operation:type_name -> rest.LookupFilter.MetaFilter.Op
-	8,   // 83: rest.LookupFilter.StatusFilter.Deleted:type_name -> rest.LookupFilter.StatusFilter.DeletedStatus
-	34,  // 84: rest.NodeService.Lookup:input_type -> rest.LookupRequest
-	27,  // 85: rest.NodeService.Create:input_type -> rest.CreateRequest
-	28,  // 86: rest.NodeService.CreateCheck:input_type -> rest.CreateCheckRequest
-	86,  // 87: rest.NodeService.UserBookmarks:input_type -> rest.UserBookmarksRequest
-	22,  // 88: rest.NodeService.GetByUuid:input_type -> rest.NodeLocator
-	64,  // 89: rest.NodeService.PatchNode:input_type -> rest.PatchNodeRequest
-	45,  // 90: rest.NodeService.PublishNode:input_type -> rest.PublishNodeRequest
-	40,  // 91: rest.NodeService.PromoteVersion:input_type -> rest.PromoteVersionRequest
-	37,  // 92: rest.NodeService.DeleteVersion:input_type -> rest.DeleteVersionRequest
-	36,  // 93: rest.NodeService.NodeVersions:input_type -> rest.NodeVersionsRequest
-	57,  // 94: rest.NodeService.CreatePublicLink:input_type -> rest.NodePublicLinkRequest
-	87,  // 95: rest.NodeService.SearchMeta:input_type -> idm.SearchUserMetaRequest
-	65,  // 96: rest.NodeService.BatchUpdateMeta:input_type -> rest.BatchUpdateMetaList
-	88,  // 97: rest.NodeService.ListNamespaces:input_type -> idm.ListUserMetaNamespaceRequest
-	68,  // 98: rest.NodeService.ListNamespaceValues:input_type -> rest.ListNamespaceValuesRequest
-	67,  // 99: rest.NodeService.UpdateNamespaceValues:input_type -> rest.NamespaceValuesRequest
-	59,  // 100: rest.NodeService.GetPublicLink:input_type -> rest.PublicLinkUuidRequest
-	58,  // 101: rest.NodeService.UpdatePublicLink:input_type -> rest.UpdatePublicLinkRequest
-	59,  // 102: rest.NodeService.DeletePublicLink:input_type -> rest.PublicLinkUuidRequest
-	51,  // 103: rest.NodeService.PerformAction:input_type -> rest.PerformActionRequest
-	50,  // 104: rest.NodeService.BackgroundActionInfo:input_type -> rest.ActionRequest
-	52,  // 105: rest.NodeService.ControlBackgroundAction:input_type -> rest.ControlActionRequest
-	55,  // 106: rest.NodeService.CreateSelection:input_type -> rest.Selection
-	89,  // 107: rest.NodeService.Templates:input_type -> rest.ListTemplatesRequest
-	23,  // 108: rest.NodeService.Lookup:output_type -> rest.NodeCollection
-	23,  // 109: rest.NodeService.Create:output_type -> rest.NodeCollection
-	30,  // 110: rest.NodeService.CreateCheck:output_type -> rest.CreateCheckResponse
-	23,  // 111: rest.NodeService.UserBookmarks:output_type -> rest.NodeCollection
-	21,  // 112: rest.NodeService.GetByUuid:output_type -> rest.Node
-	21,  // 113: rest.NodeService.PatchNode:output_type -> rest.Node
-	44,  // 114: rest.NodeService.PublishNode:output_type -> rest.PublishNodeResponse
-	41,  // 115: rest.NodeService.PromoteVersion:output_type -> rest.PromoteVersionResponse
-	38,  // 116: rest.NodeService.DeleteVersion:output_type -> rest.DeleteVersionResponse
-	25,  // 117: rest.NodeService.NodeVersions:output_type -> rest.VersionCollection
-	78,  // 118: rest.NodeService.CreatePublicLink:output_type -> rest.ShareLink
-	20,  // 119: rest.NodeService.SearchMeta:output_type -> rest.UserMetaList
-	65,  // 120: rest.NodeService.BatchUpdateMeta:output_type -> rest.BatchUpdateMetaList
-	90,  // 121: rest.NodeService.ListNamespaces:output_type -> rest.UserMetaNamespaceCollection
-	69,  // 122: rest.NodeService.ListNamespaceValues:output_type -> rest.NamespaceValuesResponse
-	69,  // 123: rest.NodeService.UpdateNamespaceValues:output_type -> rest.NamespaceValuesResponse
-	78,  // 124: rest.NodeService.GetPublicLink:output_type -> rest.ShareLink
-	78,  // 125: rest.NodeService.UpdatePublicLink:output_type -> rest.ShareLink
-	60,  // 126: rest.NodeService.DeletePublicLink:output_type -> rest.PublicLinkDeleteSuccess
-	53,  // 127: rest.NodeService.PerformAction:output_type -> rest.PerformActionResponse
-	54,  // 128: rest.NodeService.BackgroundActionInfo:output_type -> rest.BackgroundAction
-	54,  // 129: rest.NodeService.ControlBackgroundAction:output_type -> rest.BackgroundAction
-	55,  // 130: rest.NodeService.CreateSelection:output_type -> rest.Selection
-	91,  // 131: rest.NodeService.Templates:output_type -> rest.ListTemplatesResponse
-	108, // [108:132] is the sub-list for method output_type
-	84,  // [84:108] is the sub-list for method input_type
-	84,  // [84:84] is the sub-list for extension type_name
-	84,  // [84:84] is the sub-list for extension extendee
-	0,   // [0:84] is the sub-list for field type_name
+	62,  // 72: rest.PublicLinkAnalytics.Accesses:type_name -> rest.PublicLinkAccess
+	85,  // 73: rest.PublicLinkAnalytics.Pagination:type_name -> rest.Pagination
+	9,   // 74: rest.MetaUpdate.Operation:type_name -> rest.MetaUpdate.Op
+	18,  // 75: rest.MetaUpdate.UserMeta:type_name -> rest.UserMeta
+	64,  // 76: rest.NodeUpdates.MetaUpdates:type_name -> rest.MetaUpdate
+	65,  // 77: rest.NodeUpdates.Bookmark:type_name -> rest.MetaToggle
+	65,  // 78: rest.NodeUpdates.ContentLock:type_name -> rest.MetaToggle
+	66,  // 79: rest.PatchNodeRequest.NodeUpdates:type_name -> rest.NodeUpdates
+	64,  // 80: rest.BatchUpdateMetaList.Updates:type_name -> rest.MetaUpdate
+	5,   // 81: rest.NamespaceValuesOperation.Operation:type_name -> rest.NsOp
+	69,  // 82: rest.NamespaceValuesRequest.Operation:type_name -> rest.NamespaceValuesOperation
+	6,   // 83: rest.LookupFilter.TextSearch.SearchIn:type_name -> rest.LookupFilter.TextSearch.In
+	7,   // 84: rest.LookupFilter.MetaFilter.Operation:type_name -> rest.LookupFilter.MetaFilter.Op
+	8,   // 85: rest.LookupFilter.StatusFilter.Deleted:type_name -> rest.LookupFilter.StatusFilter.DeletedStatus
+	34,  // 86: rest.NodeService.Lookup:input_type -> rest.LookupRequest
+	27,  // 87: rest.NodeService.Create:input_type -> rest.CreateRequest
+	28,  // 88: rest.NodeService.CreateCheck:input_type -> rest.CreateCheckRequest
+	89,  // 89: rest.NodeService.UserBookmarks:input_type -> rest.UserBookmarksRequest
+	22,  // 90: rest.NodeService.GetByUuid:input_type -> rest.NodeLocator
+	67,  // 91: rest.NodeService.PatchNode:input_type -> rest.PatchNodeRequest
+	45,  // 92: rest.NodeService.PublishNode:input_type -> rest.PublishNodeRequest
+	40,  // 93: rest.NodeService.PromoteVersion:input_type -> rest.PromoteVersionRequest
+	37,  // 94: rest.NodeService.DeleteVersion:input_type -> rest.DeleteVersionRequest
+	36,  // 95: rest.NodeService.NodeVersions:input_type -> rest.NodeVersionsRequest
+	57,  // 96: rest.NodeService.CreatePublicLink:input_type -> rest.NodePublicLinkRequest
+	90,  // 97: rest.NodeService.SearchMeta:input_type -> idm.SearchUserMetaRequest
+	68,  // 98: rest.NodeService.BatchUpdateMeta:input_type -> rest.BatchUpdateMetaList
+	91,  // 99: rest.NodeService.ListNamespaces:input_type -> idm.ListUserMetaNamespaceRequest
+	71,  // 100: rest.NodeService.ListNamespaceValues:input_type -> rest.ListNamespaceValuesRequest
+	70,  // 101: rest.NodeService.UpdateNamespaceValues:input_type -> rest.NamespaceValuesRequest
+	59,  // 102: rest.NodeService.GetPublicLink:input_type -> rest.PublicLinkUuidRequest
+	58,  // 103: rest.NodeService.UpdatePublicLink:input_type -> rest.UpdatePublicLinkRequest
+	59,  // 104: rest.NodeService.DeletePublicLink:input_type -> rest.PublicLinkUuidRequest
+	61,  // 105: rest.NodeService.GetPublicLinkAnalytics:input_type -> rest.PublicLinkAnalyticsRequest
+	51,  // 106: rest.NodeService.PerformAction:input_type -> rest.PerformActionRequest
+	50,  // 107: rest.NodeService.BackgroundActionInfo:input_type -> rest.ActionRequest
+	52,  // 108: rest.NodeService.ControlBackgroundAction:input_type -> rest.ControlActionRequest
+	55,  // 109: rest.NodeService.CreateSelection:input_type -> rest.Selection
+	92,  // 110: rest.NodeService.Templates:input_type -> rest.ListTemplatesRequest
+	23,  // 111: rest.NodeService.Lookup:output_type -> rest.NodeCollection
+	23,  // 112: rest.NodeService.Create:output_type -> rest.NodeCollection
+	30,  // 113: rest.NodeService.CreateCheck:output_type -> rest.CreateCheckResponse
+	23,  // 114: rest.NodeService.UserBookmarks:output_type -> rest.NodeCollection
+	21,  // 115: rest.NodeService.GetByUuid:output_type -> rest.Node
+	21,  // 116: rest.NodeService.PatchNode:output_type -> rest.Node
+	44,  // 117: rest.NodeService.PublishNode:output_type -> rest.PublishNodeResponse
+	41,  // 118: rest.NodeService.PromoteVersion:output_type -> rest.PromoteVersionResponse
+	38,  // 119: rest.NodeService.DeleteVersion:output_type -> rest.DeleteVersionResponse
+	25,  // 120: rest.NodeService.NodeVersions:output_type -> rest.VersionCollection
+	81,  // 121: rest.NodeService.CreatePublicLink:output_type -> rest.ShareLink
+	20,  // 122: rest.NodeService.SearchMeta:output_type -> rest.UserMetaList
+	68,  // 123: rest.NodeService.BatchUpdateMeta:output_type -> rest.BatchUpdateMetaList
+	93,  // 124: rest.NodeService.ListNamespaces:output_type -> rest.UserMetaNamespaceCollection
+	72,  // 125: rest.NodeService.ListNamespaceValues:output_type -> rest.NamespaceValuesResponse
+	72,  // 126: rest.NodeService.UpdateNamespaceValues:output_type -> rest.NamespaceValuesResponse
+	81,  // 127: rest.NodeService.GetPublicLink:output_type -> rest.ShareLink
+	81,  // 128: rest.NodeService.UpdatePublicLink:output_type -> rest.ShareLink
+	60,  // 129: rest.NodeService.DeletePublicLink:output_type -> rest.PublicLinkDeleteSuccess
+	63,  // 130: rest.NodeService.GetPublicLinkAnalytics:output_type -> rest.PublicLinkAnalytics
+	53,  // 131: rest.NodeService.PerformAction:output_type -> rest.PerformActionResponse
+	54,  // 132: rest.NodeService.BackgroundActionInfo:output_type -> rest.BackgroundAction
+	54,  // 133: rest.NodeService.ControlBackgroundAction:output_type -> rest.BackgroundAction
+	55,  // 134: rest.NodeService.CreateSelection:output_type -> rest.Selection
+	94,  // 135: rest.NodeService.Templates:output_type -> rest.ListTemplatesResponse
+	111, // [111:136] is the sub-list for method output_type
+	86,  // [86:111] is the sub-list for method input_type
+	86,  // [86:86] is the sub-list for extension type_name
+	86,  // [86:86] is the sub-list for extension extendee
+	0,   // [0:86] is the sub-list for field type_name
 }
 
 func init() { file_cellsapi_rest_v2_proto_init() }
@@ -6958,7 +7258,7 @@ func file_cellsapi_rest_v2_proto_init() {
 			}
 		}
 		file_cellsapi_rest_v2_proto_msgTypes[51].Exporter = func(v any, i int) any {
-			switch v := v.(*MetaUpdate); i {
+			switch v := v.(*PublicLinkAnalyticsRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6970,7 +7270,7 @@ func file_cellsapi_rest_v2_proto_init() {
 			}
 		}
 		file_cellsapi_rest_v2_proto_msgTypes[52].Exporter = func(v any, i int) any {
-			switch v := v.(*MetaToggle); i {
+			switch v := v.(*PublicLinkAccess); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6982,7 +7282,7 @@ func file_cellsapi_rest_v2_proto_init() {
 			}
 		}
 		file_cellsapi_rest_v2_proto_msgTypes[53].Exporter = func(v any, i int) any {
-			switch v := v.(*NodeUpdates); i {
+			switch v := v.(*PublicLinkAnalytics); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6994,7 +7294,7 @@ func file_cellsapi_rest_v2_proto_init() {
 			}
 		}
 		file_cellsapi_rest_v2_proto_msgTypes[54].Exporter = func(v any, i int) any {
-			switch v := v.(*PatchNodeRequest); i {
+			switch v := v.(*MetaUpdate); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7006,7 +7306,7 @@ func file_cellsapi_rest_v2_proto_init() {
 			}
 		}
 		file_cellsapi_rest_v2_proto_msgTypes[55].Exporter = func(v any, i int) any {
-			switch v := v.(*BatchUpdateMetaList); i {
+			switch v := v.(*MetaToggle); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7018,7 +7318,7 @@ func file_cellsapi_rest_v2_proto_init() {
 			}
 		}
 		file_cellsapi_rest_v2_proto_msgTypes[56].Exporter = func(v any, i int) any {
-			switch v := v.(*NamespaceValuesOperation); i {
+			switch v := v.(*NodeUpdates); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7030,7 +7330,7 @@ func file_cellsapi_rest_v2_proto_init() {
 			}
 		}
 		file_cellsapi_rest_v2_proto_msgTypes[57].Exporter = func(v any, i int) any {
-			switch v := v.(*NamespaceValuesRequest); i {
+			switch v := v.(*PatchNodeRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7042,7 +7342,7 @@ func file_cellsapi_rest_v2_proto_init() {
 			}
 		}
 		file_cellsapi_rest_v2_proto_msgTypes[58].Exporter = func(v any, i int) any {
-			switch v := v.(*ListNamespaceValuesRequest); i {
+			switch v := v.(*BatchUpdateMetaList); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7054,7 +7354,7 @@ func file_cellsapi_rest_v2_proto_init() {
 			}
 		}
 		file_cellsapi_rest_v2_proto_msgTypes[59].Exporter = func(v any, i int) any {
-			switch v := v.(*NamespaceValuesResponse); i {
+			switch v := v.(*NamespaceValuesOperation); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7066,7 +7366,7 @@ func file_cellsapi_rest_v2_proto_init() {
 			}
 		}
 		file_cellsapi_rest_v2_proto_msgTypes[60].Exporter = func(v any, i int) any {
-			switch v := v.(*LookupFilter_SizeRange); i {
+			switch v := v.(*NamespaceValuesRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7078,7 +7378,7 @@ func file_cellsapi_rest_v2_proto_init() {
 			}
 		}
 		file_cellsapi_rest_v2_proto_msgTypes[61].Exporter = func(v any, i int) any {
-			switch v := v.(*LookupFilter_DateRange); i {
+			switch v := v.(*ListNamespaceValuesRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7090,7 +7390,7 @@ func file_cellsapi_rest_v2_proto_init() {
 			}
 		}
 		file_cellsapi_rest_v2_proto_msgTypes[62].Exporter = func(v any, i int) any {
-			switch v := v.(*LookupFilter_PathPrefix); i {
+			switch v := v.(*NamespaceValuesResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7102,7 +7402,7 @@ func file_cellsapi_rest_v2_proto_init() {
 			}
 		}
 		file_cellsapi_rest_v2_proto_msgTypes[63].Exporter = func(v any, i int) any {
-			switch v := v.(*LookupFilter_TextSearch); i {
+			switch v := v.(*LookupFilter_SizeRange); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7114,7 +7414,7 @@ func file_cellsapi_rest_v2_proto_init() {
 			}
 		}
 		file_cellsapi_rest_v2_proto_msgTypes[64].Exporter = func(v any, i int) any {
-			switch v := v.(*LookupFilter_MetaFilter); i {
+			switch v := v.(*LookupFilter_DateRange); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7126,6 +7426,42 @@ func file_cellsapi_rest_v2_proto_init() {
 			}
 		}
 		file_cellsapi_rest_v2_proto_msgTypes[65].Exporter = func(v any, i int) any {
+			switch v := v.(*LookupFilter_PathPrefix); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cellsapi_rest_v2_proto_msgTypes[66].Exporter = func(v any, i int) any {
+			switch v := v.(*LookupFilter_TextSearch); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cellsapi_rest_v2_proto_msgTypes[67].Exporter = func(v any, i int) any {
+			switch v := v.(*LookupFilter_MetaFilter); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cellsapi_rest_v2_proto_msgTypes[68].Exporter = func(v any, i int) any {
 			switch v := v.(*LookupFilter_StatusFilter); i {
 			case 0:
 				return &v.state
@@ -7153,7 +7489,7 @@ func file_cellsapi_rest_v2_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_cellsapi_rest_v2_proto_rawDesc,
 			NumEnums:      10,
-			NumMessages:   66,
+			NumMessages:   69,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -77,6 +77,63 @@ func (ShareLinkAccessType) EnumDescriptor() ([]byte, []int) {
 	return file_cellsapi_share_proto_rawDescGZIP(), []int{0}
 }
 
+// Computed state of a public link
+type ShareLinkState int32
+
+const (
+	// Link can be accessed
+	ShareLinkState_LinkActive ShareLinkState = 0
+	// AccessStart date is not reached yet
+	ShareLinkState_LinkPending ShareLinkState = 1
+	// AccessEnd date is passed
+	ShareLinkState_LinkExpired ShareLinkState = 2
+	// Maximum number of downloads is reached
+	ShareLinkState_LinkExhausted ShareLinkState = 3
+)
+
+// Enum value maps for ShareLinkState.
+var (
+	ShareLinkState_name = map[int32]string{
+		0: "LinkActive",
+		1: "LinkPending",
+		2: "LinkExpired",
+		3: "LinkExhausted",
+	}
+	ShareLinkState_value = map[string]int32{
+		"LinkActive":    0,
+		"LinkPending":   1,
+		"LinkExpired":   2,
+		"LinkExhausted": 3,
+	}
+)
+
+func (x ShareLinkState) Enum() *ShareLinkState {
+	p := new(ShareLinkState)
+	*p = x
+	return p
+}
+
+func (x ShareLinkState) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ShareLinkState) Descriptor() protoreflect.EnumDescriptor {
+	return file_cellsapi_share_proto_enumTypes[1].Descriptor()
+}
+
+func (ShareLinkState) Type() protoreflect.EnumType {
+	return &file_cellsapi_share_proto_enumTypes[1]
+}
+
+func (x ShareLinkState) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ShareLinkState.Descriptor instead.
+func (ShareLinkState) EnumDescriptor() ([]byte, []int) {
+	return file_cellsapi_share_proto_rawDescGZIP(), []int{1}
+}
+
 type ListSharedResourcesRequest_ListShareType int32
 
 const (
@@ -110,11 +167,11 @@ func (x ListSharedResourcesRequest_ListShareType) String() string {
 }
 
 func (ListSharedResourcesRequest_ListShareType) Descriptor() protoreflect.EnumDescriptor {
-	return file_cellsapi_share_proto_enumTypes[1].Descriptor()
+	return file_cellsapi_share_proto_enumTypes[2].Descriptor()
 }
 
 func (ListSharedResourcesRequest_ListShareType) Type() protoreflect.EnumType {
-	return &file_cellsapi_share_proto_enumTypes[1]
+	return &file_cellsapi_share_proto_enumTypes[2]
 }
 
 func (x ListSharedResourcesRequest_ListShareType) Number() protoreflect.EnumNumber {
@@ -123,7 +180,59 @@ func (x ListSharedResourcesRequest_ListShareType) Number() protoreflect.EnumNumb
 
 // Deprecated: Use ListSharedResourcesRequest_ListShareType.Descriptor instead.
 func (ListSharedResourcesRequest_ListShareType) EnumDescriptor() ([]byte, []int) {
-	return file_cellsapi_share_proto_rawDescGZIP(), []int{12, 0}
+	return file_cellsapi_share_proto_rawDescGZIP(), []int{13, 0}
+}
+
+type ShareBulkActionRequest_ShareBulkAction int32
+
+const (
+	ShareBulkActionRequest_NO_ACTION ShareBulkActionRequest_ShareBulkAction = 0
+	ShareBulkActionRequest_EXPIRE    ShareBulkActionRequest_ShareBulkAction = 1
+	ShareBulkActionRequest_TRANSFER  ShareBulkActionRequest_ShareBulkAction = 2
+	ShareBulkActionRequest_DELETE    ShareBulkActionRequest_ShareBulkAction = 3
+)
+
+// Enum value maps for ShareBulkActionRequest_ShareBulkAction.
+var (
+	ShareBulkActionRequest_ShareBulkAction_name = map[int32]string{
+		0: "NO_ACTION",
+		1: "EXPIRE",
+		2: "TRANSFER",
+		3: "DELETE",
+	}
+	ShareBulkActionRequest_ShareBulkAction_value = map[string]int32{
+		"NO_ACTION": 0,
+		"EXPIRE":    1,
+		"TRANSFER":  2,
+		"DELETE":    3,
+	}
+)
+
+func (x ShareBulkActionRequest_ShareBulkAction) Enum() *ShareBulkActionRequest_ShareBulkAction {
+	p := new(ShareBulkActionRequest_ShareBulkAction)
+	*p = x
+	return p
+}
+
+func (x ShareBulkActionRequest_ShareBulkAction) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ShareBulkActionRequest_ShareBulkAction) Descriptor() protoreflect.EnumDescriptor {
+	return file_cellsapi_share_proto_enumTypes[3].Descriptor()
+}
+
+func (ShareBulkActionRequest_ShareBulkAction) Type() protoreflect.EnumType {
+	return &file_cellsapi_share_proto_enumTypes[3]
+}
+
+func (x ShareBulkActionRequest_ShareBulkAction) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ShareBulkActionRequest_ShareBulkAction.Descriptor instead.
+func (ShareBulkActionRequest_ShareBulkAction) EnumDescriptor() ([]byte, []int) {
+	return file_cellsapi_share_proto_rawDescGZIP(), []int{20, 0}
 }
 
 // Group collected acls by subjects
@@ -332,6 +441,82 @@ func (x *Cell) GetPoliciesContextEditable() bool {
 	return false
 }
 
+// Options for links used as upload-only file requests
+type ShareLinkFileRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Allowed file extensions, without leading dot (empty for all)
+	AllowedExtensions []string `protobuf:"bytes,1,rep,name=AllowedExtensions,proto3" json:"AllowedExtensions,omitempty"`
+	// Maximum size of a single uploaded file in bytes (0 for no limit)
+	MaxFileSize int64 `protobuf:"varint,2,opt,name=MaxFileSize,proto3" json:"MaxFileSize,omitempty"`
+	// Maximum total size of all uploaded files in bytes (0 for no limit)
+	MaxTotalSize int64 `protobuf:"varint,3,opt,name=MaxTotalSize,proto3" json:"MaxTotalSize,omitempty"`
+	// Send a digest of new uploads to the link owner
+	NotifyOwner bool `protobuf:"varint,4,opt,name=NotifyOwner,proto3" json:"NotifyOwner,omitempty"`
+}
+
+func (x *ShareLinkFileRequest) Reset() {
+	*x = ShareLinkFileRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cellsapi_share_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ShareLinkFileRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ShareLinkFileRequest) ProtoMessage() {}
+
+func (x *ShareLinkFileRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cellsapi_share_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ShareLinkFileRequest.ProtoReflect.Descriptor instead.
+func (*ShareLinkFileRequest) Descriptor() ([]byte, []int) {
+	return file_cellsapi_share_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *ShareLinkFileRequest) GetAllowedExtensions() []string {
+	if x != nil {
+		return x.AllowedExtensions
+	}
+	return nil
+}
+
+func (x *ShareLinkFileRequest) GetMaxFileSize() int64 {
+	if x != nil {
+		return x.MaxFileSize
+	}
+	return 0
+}
+
+func (x *ShareLinkFileRequest) GetMaxTotalSize() int64 {
+	if x != nil {
+		return x.MaxTotalSize
+	}
+	return 0
+}
+
+func (x *ShareLinkFileRequest) GetNotifyOwner() bool {
+	if x != nil {
+		return x.NotifyOwner
+	}
+	return false
+}
+
 type ShareLinkTargetUser struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -344,7 +529,7 @@ type ShareLinkTargetUser struct {
 func (x *ShareLinkTargetUser) Reset() {
 	*x = ShareLinkTargetUser{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_cellsapi_share_proto_msgTypes[2]
+		mi := &file_cellsapi_share_proto_msgTypes[3]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -357,7 +542,7 @@ func (x *ShareLinkTargetUser) String() string {
 func (*ShareLinkTargetUser) ProtoMessage() {}
 
 func (x *ShareLinkTargetUser) ProtoReflect() protoreflect.Message {
-	mi := &file_cellsapi_share_proto_msgTypes[2]
+	mi := &file_cellsapi_share_proto_msgTypes[3]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -370,7 +555,7 @@ func (x *ShareLinkTargetUser) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ShareLinkTargetUser.ProtoReflect.Descriptor instead.
 func (*ShareLinkTargetUser) Descriptor() ([]byte, []int) {
-	return file_cellsapi_share_proto_rawDescGZIP(), []int{2}
+	return file_cellsapi_share_proto_rawDescGZIP(), []int{3}
 }
 
 func (x *ShareLinkTargetUser) GetDisplay() string {
@@ -409,7 +594,7 @@ type ShareLink struct {
 	UserLogin string `protobuf:"bytes,7,opt,name=UserLogin,proto3" json:"UserLogin,omitempty"`
 	// Whether a password is required or not to access the link
 	PasswordRequired bool `protobuf:"varint,8,opt,name=PasswordRequired,proto3" json:"PasswordRequired,omitempty"`
-	// Timestamp of start date for enabling the share (not implemented yet)
+	// Timestamp of start date for enabling the share
 	AccessStart int64 `protobuf:"varint,9,opt,name=AccessStart,proto3" json:"AccessStart,omitempty"`
 	// Timestamp after which the share is disabled
 	AccessEnd int64 `protobuf:"varint,10,opt,name=AccessEnd,proto3" json:"AccessEnd,omitempty"`
@@ -431,12 +616,18 @@ type ShareLink struct {
 	Policies []*service.ResourcePolicy `protobuf:"bytes,18,rep,name=Policies,proto3" json:"Policies,omitempty"`
 	// Whether policies are currently editable or not
 	PoliciesContextEditable bool `protobuf:"varint,19,opt,name=PoliciesContextEditable,proto3" json:"PoliciesContextEditable,omitempty"`
+	// Current state of the link, computed from dates and downloads (read-only)
+	State ShareLinkState `protobuf:"varint,20,opt,name=State,proto3,enum=rest.ShareLinkState" json:"State,omitempty"`
+	// If set, link is a file request: visitors can only upload and see their own uploads
+	FileRequest *ShareLinkFileRequest `protobuf:"bytes,21,opt,name=FileRequest,proto3" json:"FileRequest,omitempty"`
+	// Stamp images and PDF served through the link with its label, the recipient and the current date
+	Watermark bool `protobuf:"varint,22,opt,name=Watermark,proto3" json:"Watermark,omitempty"`
 }
 
 func (x *ShareLink) Reset() {
 	*x = ShareLink{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_cellsapi_share_proto_msgTypes[3]
+		mi := &file_cellsapi_share_proto_msgTypes[4]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -449,7 +640,7 @@ func (x *ShareLink) String() string {
 func (*ShareLink) ProtoMessage() {}
 
 func (x *ShareLink) ProtoReflect() protoreflect.Message {
-	mi := &file_cellsapi_share_proto_msgTypes[3]
+	mi := &file_cellsapi_share_proto_msgTypes[4]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -462,7 +653,7 @@ func (x *ShareLink) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ShareLink.ProtoReflect.Descriptor instead.
 func (*ShareLink) Descriptor() ([]byte, []int) {
-	return file_cellsapi_share_proto_rawDescGZIP(), []int{3}
+	return file_cellsapi_share_proto_rawDescGZIP(), []int{4}
 }
 
 func (x *ShareLink) GetUuid() string {
@@ -598,6 +789,27 @@ func (x *ShareLink) GetPoliciesContextEditable() bool {
 	return false
 }
 
+func (x *ShareLink) GetState() ShareLinkState {
+	if x != nil {
+		return x.State
+	}
+	return ShareLinkState_LinkActive
+}
+
+func (x *ShareLink) GetFileRequest() *ShareLinkFileRequest {
+	if x != nil {
+		return x.FileRequest
+	}
+	return nil
+}
+
+func (x *ShareLink) GetWatermark() bool {
+	if x != nil {
+		return x.Watermark
+	}
+	return false
+}
+
 // Request for creating a Cell
 type PutCellRequest struct {
 	state         protoimpl.MessageState
@@ -613,7 +825,7 @@ type PutCellRequest struct {
 func (x *PutCellRequest) Reset() {
 	*x = PutCellRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_cellsapi_share_proto_msgTypes[4]
+		mi := &file_cellsapi_share_proto_msgTypes[5]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -626,7 +838,7 @@ func (x *PutCellRequest) String() string {
 func (*PutCellRequest) ProtoMessage() {}
 
 func (x *PutCellRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_cellsapi_share_proto_msgTypes[4]
+	mi := &file_cellsapi_share_proto_msgTypes[5]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -639,7 +851,7 @@ func (x *PutCellRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PutCellRequest.ProtoReflect.Descriptor instead.
 func (*PutCellRequest) Descriptor() ([]byte, []int) {
-	return file_cellsapi_share_proto_rawDescGZIP(), []int{4}
+	return file_cellsapi_share_proto_rawDescGZIP(), []int{5}
 }
 
 func (x *PutCellRequest) GetRoom() *Cell {
@@ -669,7 +881,7 @@ type GetCellRequest struct {
 func (x *GetCellRequest) Reset() {
 	*x = GetCellRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_cellsapi_share_proto_msgTypes[5]
+		mi := &file_cellsapi_share_proto_msgTypes[6]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -682,7 +894,7 @@ func (x *GetCellRequest) String() string {
 func (*GetCellRequest) ProtoMessage() {}
 
 func (x *GetCellRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_cellsapi_share_proto_msgTypes[5]
+	mi := &file_cellsapi_share_proto_msgTypes[6]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -695,7 +907,7 @@ func (x *GetCellRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetCellRequest.ProtoReflect.Descriptor instead.
 func (*GetCellRequest) Descriptor() ([]byte, []int) {
-	return file_cellsapi_share_proto_rawDescGZIP(), []int{5}
+	return file_cellsapi_share_proto_rawDescGZIP(), []int{6}
 }
 
 func (x *GetCellRequest) GetUuid() string {
@@ -718,7 +930,7 @@ type DeleteCellRequest struct {
 func (x *DeleteCellRequest) Reset() {
 	*x = DeleteCellRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_cellsapi_share_proto_msgTypes[6]
+		mi := &file_cellsapi_share_proto_msgTypes[7]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -731,7 +943,7 @@ func (x *DeleteCellRequest) String() string {
 func (*DeleteCellRequest) ProtoMessage() {}
 
 func (x *DeleteCellRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_cellsapi_share_proto_msgTypes[6]
+	mi := &file_cellsapi_share_proto_msgTypes[7]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -744,7 +956,7 @@ func (x *DeleteCellRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteCellRequest.ProtoReflect.Descriptor instead.
 func (*DeleteCellRequest) Descriptor() ([]byte, []int) {
-	return file_cellsapi_share_proto_rawDescGZIP(), []int{6}
+	return file_cellsapi_share_proto_rawDescGZIP(), []int{7}
 }
 
 func (x *DeleteCellRequest) GetUuid() string {
@@ -766,7 +978,7 @@ type DeleteCellResponse struct {
 func (x *DeleteCellResponse) Reset() {
 	*x = DeleteCellResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_cellsapi_share_proto_msgTypes[7]
+		mi := &file_cellsapi_share_proto_msgTypes[8]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -779,7 +991,7 @@ func (x *DeleteCellResponse) String() string {
 func (*DeleteCellResponse) ProtoMessage() {}
 
 func (x *DeleteCellResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_cellsapi_share_proto_msgTypes[7]
+	mi := &file_cellsapi_share_proto_msgTypes[8]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -792,7 +1004,7 @@ func (x *DeleteCellResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteCellResponse.ProtoReflect.Descriptor instead.
 func (*DeleteCellResponse) Descriptor() ([]byte, []int) {
-	return file_cellsapi_share_proto_rawDescGZIP(), []int{7}
+	return file_cellsapi_share_proto_rawDescGZIP(), []int{8}
 }
 
 func (x *DeleteCellResponse) GetSuccess() bool {
@@ -814,7 +1026,7 @@ type GetShareLinkRequest struct {
 func (x *GetShareLinkRequest) Reset() {
 	*x = GetShareLinkRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_cellsapi_share_proto_msgTypes[8]
+		mi := &file_cellsapi_share_proto_msgTypes[9]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -827,7 +1039,7 @@ func (x *GetShareLinkRequest) String() string {
 func (*GetShareLinkRequest) ProtoMessage() {}
 
 func (x *GetShareLinkRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_cellsapi_share_proto_msgTypes[8]
+	mi := &file_cellsapi_share_proto_msgTypes[9]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -840,7 +1052,7 @@ func (x *GetShareLinkRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetShareLinkRequest.ProtoReflect.Descriptor instead.
 func (*GetShareLinkRequest) Descriptor() ([]byte, []int) {
-	return file_cellsapi_share_proto_rawDescGZIP(), []int{8}
+	return file_cellsapi_share_proto_rawDescGZIP(), []int{9}
 }
 
 func (x *GetShareLinkRequest) GetUuid() string {
@@ -871,7 +1083,7 @@ type PutShareLinkRequest struct {
 func (x *PutShareLinkRequest) Reset() {
 	*x = PutShareLinkRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_cellsapi_share_proto_msgTypes[9]
+		mi := &file_cellsapi_share_proto_msgTypes[10]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -884,7 +1096,7 @@ func (x *PutShareLinkRequest) String() string {
 func (*PutShareLinkRequest) ProtoMessage() {}
 
 func (x *PutShareLinkRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_cellsapi_share_proto_msgTypes[9]
+	mi := &file_cellsapi_share_proto_msgTypes[10]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -897,7 +1109,7 @@ func (x *PutShareLinkRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PutShareLinkRequest.ProtoReflect.Descriptor instead.
 func (*PutShareLinkRequest) Descriptor() ([]byte, []int) {
-	return file_cellsapi_share_proto_rawDescGZIP(), []int{9}
+	return file_cellsapi_share_proto_rawDescGZIP(), []int{10}
 }
 
 func (x *PutShareLinkRequest) GetShareLink() *ShareLink {
@@ -948,7 +1160,7 @@ type DeleteShareLinkRequest struct {
 func (x *DeleteShareLinkRequest) Reset() {
 	*x = DeleteShareLinkRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_cellsapi_share_proto_msgTypes[10]
+		mi := &file_cellsapi_share_proto_msgTypes[11]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -961,7 +1173,7 @@ func (x *DeleteShareLinkRequest) String() string {
 func (*DeleteShareLinkRequest) ProtoMessage() {}
 
 func (x *DeleteShareLinkRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_cellsapi_share_proto_msgTypes[10]
+	mi := &file_cellsapi_share_proto_msgTypes[11]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -974,7 +1186,7 @@ func (x *DeleteShareLinkRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteShareLinkRequest.ProtoReflect.Descriptor instead.
 func (*DeleteShareLinkRequest) Descriptor() ([]byte, []int) {
-	return file_cellsapi_share_proto_rawDescGZIP(), []int{10}
+	return file_cellsapi_share_proto_rawDescGZIP(), []int{11}
 }
 
 func (x *DeleteShareLinkRequest) GetUuid() string {
@@ -997,7 +1209,7 @@ type DeleteShareLinkResponse struct {
 func (x *DeleteShareLinkResponse) Reset() {
 	*x = DeleteShareLinkResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_cellsapi_share_proto_msgTypes[11]
+		mi := &file_cellsapi_share_proto_msgTypes[12]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1010,7 +1222,7 @@ func (x *DeleteShareLinkResponse) String() string {
 func (*DeleteShareLinkResponse) ProtoMessage() {}
 
 func (x *DeleteShareLinkResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_cellsapi_share_proto_msgTypes[11]
+	mi := &file_cellsapi_share_proto_msgTypes[12]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1023,7 +1235,7 @@ func (x *DeleteShareLinkResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteShareLinkResponse.ProtoReflect.Descriptor instead.
 func (*DeleteShareLinkResponse) Descriptor() ([]byte, []int) {
-	return file_cellsapi_share_proto_rawDescGZIP(), []int{11}
+	return file_cellsapi_share_proto_rawDescGZIP(), []int{12}
 }
 
 func (x *DeleteShareLinkResponse) GetSuccess() bool {
@@ -1055,7 +1267,7 @@ type ListSharedResourcesRequest struct {
 func (x *ListSharedResourcesRequest) Reset() {
 	*x = ListSharedResourcesRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_cellsapi_share_proto_msgTypes[12]
+		mi := &file_cellsapi_share_proto_msgTypes[13]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1068,7 +1280,7 @@ func (x *ListSharedResourcesRequest) String() string {
 func (*ListSharedResourcesRequest) ProtoMessage() {}
 
 func (x *ListSharedResourcesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_cellsapi_share_proto_msgTypes[12]
+	mi := &file_cellsapi_share_proto_msgTypes[13]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1081,7 +1293,7 @@ func (x *ListSharedResourcesRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListSharedResourcesRequest.ProtoReflect.Descriptor instead.
 func (*ListSharedResourcesRequest) Descriptor() ([]byte, []int) {
-	return file_cellsapi_share_proto_rawDescGZIP(), []int{12}
+	return file_cellsapi_share_proto_rawDescGZIP(), []int{13}
 }
 
 func (x *ListSharedResourcesRequest) GetShareType() ListSharedResourcesRequest_ListShareType {
@@ -1135,7 +1347,7 @@ type ListSharedResourcesResponse struct {
 func (x *ListSharedResourcesResponse) Reset() {
 	*x = ListSharedResourcesResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_cellsapi_share_proto_msgTypes[13]
+		mi := &file_cellsapi_share_proto_msgTypes[14]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1148,7 +1360,7 @@ func (x *ListSharedResourcesResponse) String() string {
 func (*ListSharedResourcesResponse) ProtoMessage() {}
 
 func (x *ListSharedResourcesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_cellsapi_share_proto_msgTypes[13]
+	mi := &file_cellsapi_share_proto_msgTypes[14]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1161,7 +1373,7 @@ func (x *ListSharedResourcesResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListSharedResourcesResponse.ProtoReflect.Descriptor instead.
 func (*ListSharedResourcesResponse) Descriptor() ([]byte, []int) {
-	return file_cellsapi_share_proto_rawDescGZIP(), []int{13}
+	return file_cellsapi_share_proto_rawDescGZIP(), []int{14}
 }
 
 func (x *ListSharedResourcesResponse) GetResources() []*ListSharedResourcesResponse_SharedResource {
@@ -1206,7 +1418,7 @@ type UpdateSharePoliciesRequest struct {
 func (x *UpdateSharePoliciesRequest) Reset() {
 	*x = UpdateSharePoliciesRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_cellsapi_share_proto_msgTypes[14]
+		mi := &file_cellsapi_share_proto_msgTypes[15]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1219,7 +1431,7 @@ func (x *UpdateSharePoliciesRequest) String() string {
 func (*UpdateSharePoliciesRequest) ProtoMessage() {}
 
 func (x *UpdateSharePoliciesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_cellsapi_share_proto_msgTypes[14]
+	mi := &file_cellsapi_share_proto_msgTypes[15]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1232,7 +1444,7 @@ func (x *UpdateSharePoliciesRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateSharePoliciesRequest.ProtoReflect.Descriptor instead.
 func (*UpdateSharePoliciesRequest) Descriptor() ([]byte, []int) {
-	return file_cellsapi_share_proto_rawDescGZIP(), []int{14}
+	return file_cellsapi_share_proto_rawDescGZIP(), []int{15}
 }
 
 func (x *UpdateSharePoliciesRequest) GetUuid() string {
@@ -1262,7 +1474,7 @@ type UpdateSharePoliciesResponse struct {
 func (x *UpdateSharePoliciesResponse) Reset() {
 	*x = UpdateSharePoliciesResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_cellsapi_share_proto_msgTypes[15]
+		mi := &file_cellsapi_share_proto_msgTypes[16]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1275,7 +1487,7 @@ func (x *UpdateSharePoliciesResponse) String() string {
 func (*UpdateSharePoliciesResponse) ProtoMessage() {}
 
 func (x *UpdateSharePoliciesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_cellsapi_share_proto_msgTypes[15]
+	mi := &file_cellsapi_share_proto_msgTypes[16]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1288,7 +1500,7 @@ func (x *UpdateSharePoliciesResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateSharePoliciesResponse.ProtoReflect.Descriptor instead.
 func (*UpdateSharePoliciesResponse) Descriptor() ([]byte, []int) {
-	return file_cellsapi_share_proto_rawDescGZIP(), []int{15}
+	return file_cellsapi_share_proto_rawDescGZIP(), []int{16}
 }
 
 func (x *UpdateSharePoliciesResponse) GetSuccess() bool {
@@ -1312,34 +1524,44 @@ func (x *UpdateSharePoliciesResponse) GetPoliciesContextEditable() bool {
 	return false
 }
 
-// Container for ShareLink or Cell
-type ListSharedResourcesResponse_SharedResource struct {
+type ShareInventoryRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Node  *tree.Node `protobuf:"bytes,1,opt,name=Node,proto3" json:"Node,omitempty"`
-	Link  *ShareLink `protobuf:"bytes,2,opt,name=Link,proto3" json:"Link,omitempty"`
-	Cells []*Cell    `protobuf:"bytes,3,rep,name=Cells,proto3" json:"Cells,omitempty"`
-}
-
-func (x *ListSharedResourcesResponse_SharedResource) Reset() {
-	*x = ListSharedResourcesResponse_SharedResource{}
+	// Filter output to a given type
+	ShareType ListSharedResourcesRequest_ListShareType `protobuf:"varint,1,opt,name=ShareType,proto3,enum=rest.ListSharedResourcesRequest_ListShareType" json:"ShareType,omitempty"`
+	// Restrict to shares owned by this user (login or uuid)
+	Owner string `protobuf:"bytes,2,opt,name=Owner,proto3" json:"Owner,omitempty"`
+	// Restrict to shares whose root is inside this workspace (uuid or slug)
+	Workspace string `protobuf:"bytes,3,opt,name=Workspace,proto3" json:"Workspace,omitempty"`
+	// Restrict to shares whose root admin path starts with this prefix
+	PathPrefix string `protobuf:"bytes,4,opt,name=PathPrefix,proto3" json:"PathPrefix,omitempty"`
+	// Restrict to shares expiring before this timestamp
+	ExpiresBefore int64 `protobuf:"varint,5,opt,name=ExpiresBefore,proto3" json:"ExpiresBefore,omitempty"`
+	// Restrict to shares that never expire
+	NoExpiration bool `protobuf:"varint,6,opt,name=NoExpiration,proto3" json:"NoExpiration,omitempty"`
+	// Restrict to shares targeting this user (login, uuid or email)
+	TargetUser string `protobuf:"bytes,7,opt,name=TargetUser,proto3" json:"TargetUser,omitempty"`
+}
+
+func (x *ShareInventoryRequest) Reset() {
+	*x = ShareInventoryRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_cellsapi_share_proto_msgTypes[18]
+		mi := &file_cellsapi_share_proto_msgTypes[17]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ListSharedResourcesResponse_SharedResource) String() string {
+func (x *ShareInventoryRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListSharedResourcesResponse_SharedResource) ProtoMessage() {}
+func (*ShareInventoryRequest) ProtoMessage() {}
 
-func (x *ListSharedResourcesResponse_SharedResource) ProtoReflect() protoreflect.Message {
-	mi := &file_cellsapi_share_proto_msgTypes[18]
+func (x *ShareInventoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cellsapi_share_proto_msgTypes[17]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1350,53 +1572,479 @@ func (x *ListSharedResourcesResponse_SharedResource) ProtoReflect() protoreflect
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListSharedResourcesResponse_SharedResource.ProtoReflect.Descriptor instead.
-func (*ListSharedResourcesResponse_SharedResource) Descriptor() ([]byte, []int) {
-	return file_cellsapi_share_proto_rawDescGZIP(), []int{13, 0}
+// Deprecated: Use ShareInventoryRequest.ProtoReflect.Descriptor instead.
+func (*ShareInventoryRequest) Descriptor() ([]byte, []int) {
+	return file_cellsapi_share_proto_rawDescGZIP(), []int{17}
 }
 
-func (x *ListSharedResourcesResponse_SharedResource) GetNode() *tree.Node {
+func (x *ShareInventoryRequest) GetShareType() ListSharedResourcesRequest_ListShareType {
 	if x != nil {
-		return x.Node
+		return x.ShareType
 	}
-	return nil
+	return ListSharedResourcesRequest_ANY
 }
 
-func (x *ListSharedResourcesResponse_SharedResource) GetLink() *ShareLink {
+func (x *ShareInventoryRequest) GetOwner() string {
 	if x != nil {
-		return x.Link
+		return x.Owner
 	}
-	return nil
+	return ""
 }
 
-func (x *ListSharedResourcesResponse_SharedResource) GetCells() []*Cell {
+func (x *ShareInventoryRequest) GetWorkspace() string {
 	if x != nil {
-		return x.Cells
+		return x.Workspace
 	}
-	return nil
+	return ""
 }
 
-var File_cellsapi_share_proto protoreflect.FileDescriptor
+func (x *ShareInventoryRequest) GetPathPrefix() string {
+	if x != nil {
+		return x.PathPrefix
+	}
+	return ""
+}
 
-var file_cellsapi_share_proto_rawDesc = []byte{
-	0x0a, 0x14, 0x63, 0x65, 0x6c, 0x6c, 0x73, 0x61, 0x70, 0x69, 0x2d, 0x73, 0x68, 0x61, 0x72, 0x65,
-	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x04, 0x72, 0x65, 0x73, 0x74, 0x1a, 0x13, 0x63, 0x65,
-	0x6c, 0x6c, 0x73, 0x2d, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x1a, 0x0f, 0x63, 0x65, 0x6c, 0x6c, 0x73, 0x2d, 0x69, 0x64, 0x6d, 0x2e, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x1a, 0x10, 0x63, 0x65, 0x6c, 0x6c, 0x73, 0x2d, 0x74, 0x72, 0x65, 0x65, 0x2e, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1b, 0x62, 0x75, 0x66, 0x2f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61,
-	0x74, 0x65, 0x2f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x22, 0xca, 0x01, 0x0a, 0x07, 0x43, 0x65, 0x6c, 0x6c, 0x41, 0x63, 0x6c, 0x12, 0x16, 0x0a,
-	0x06, 0x52, 0x6f, 0x6c, 0x65, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x52,
-	0x6f, 0x6c, 0x65, 0x49, 0x64, 0x12, 0x28, 0x0a, 0x07, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73,
-	0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x69, 0x64, 0x6d, 0x2e, 0x41, 0x43, 0x4c,
-	0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x07, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12,
-	0x1e, 0x0a, 0x0a, 0x49, 0x73, 0x55, 0x73, 0x65, 0x72, 0x52, 0x6f, 0x6c, 0x65, 0x18, 0x03, 0x20,
-	0x01, 0x28, 0x08, 0x52, 0x0a, 0x49, 0x73, 0x55, 0x73, 0x65, 0x72, 0x52, 0x6f, 0x6c, 0x65, 0x12,
-	0x1d, 0x0a, 0x04, 0x55, 0x73, 0x65, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x09, 0x2e,
-	0x69, 0x64, 0x6d, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x52, 0x04, 0x55, 0x73, 0x65, 0x72, 0x12, 0x1f,
-	0x0a, 0x05, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x09, 0x2e,
-	0x69, 0x64, 0x6d, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x52, 0x05, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x12,
+func (x *ShareInventoryRequest) GetExpiresBefore() int64 {
+	if x != nil {
+		return x.ExpiresBefore
+	}
+	return 0
+}
+
+func (x *ShareInventoryRequest) GetNoExpiration() bool {
+	if x != nil {
+		return x.NoExpiration
+	}
+	return false
+}
+
+func (x *ShareInventoryRequest) GetTargetUser() string {
+	if x != nil {
+		return x.TargetUser
+	}
+	return ""
+}
+
+type ShareInventoryEntry struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Cell or Link UUID
+	Uuid string `protobuf:"bytes,1,opt,name=Uuid,proto3" json:"Uuid,omitempty"`
+	// Type of share
+	Type ListSharedResourcesRequest_ListShareType `protobuf:"varint,2,opt,name=Type,proto3,enum=rest.ListSharedResourcesRequest_ListShareType" json:"Type,omitempty"`
+	// Label of the share
+	Label string `protobuf:"bytes,3,opt,name=Label,proto3" json:"Label,omitempty"`
+	// Owner uuid
+	OwnerUuid string `protobuf:"bytes,4,opt,name=OwnerUuid,proto3" json:"OwnerUuid,omitempty"`
+	// Owner login
+	OwnerLogin string `protobuf:"bytes,5,opt,name=OwnerLogin,proto3" json:"OwnerLogin,omitempty"`
+	// Admin paths of the shared nodes
+	RootPaths []string `protobuf:"bytes,6,rep,name=RootPaths,proto3" json:"RootPaths,omitempty"`
+	// Expiration timestamp, 0 if share never expires
+	AccessEnd int64 `protobuf:"varint,7,opt,name=AccessEnd,proto3" json:"AccessEnd,omitempty"`
+	// Users or groups this share is targeted to
+	TargetUsers []string `protobuf:"bytes,8,rep,name=TargetUsers,proto3" json:"TargetUsers,omitempty"`
+}
+
+func (x *ShareInventoryEntry) Reset() {
+	*x = ShareInventoryEntry{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cellsapi_share_proto_msgTypes[18]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ShareInventoryEntry) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ShareInventoryEntry) ProtoMessage() {}
+
+func (x *ShareInventoryEntry) ProtoReflect() protoreflect.Message {
+	mi := &file_cellsapi_share_proto_msgTypes[18]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ShareInventoryEntry.ProtoReflect.Descriptor instead.
+func (*ShareInventoryEntry) Descriptor() ([]byte, []int) {
+	return file_cellsapi_share_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *ShareInventoryEntry) GetUuid() string {
+	if x != nil {
+		return x.Uuid
+	}
+	return ""
+}
+
+func (x *ShareInventoryEntry) GetType() ListSharedResourcesRequest_ListShareType {
+	if x != nil {
+		return x.Type
+	}
+	return ListSharedResourcesRequest_ANY
+}
+
+func (x *ShareInventoryEntry) GetLabel() string {
+	if x != nil {
+		return x.Label
+	}
+	return ""
+}
+
+func (x *ShareInventoryEntry) GetOwnerUuid() string {
+	if x != nil {
+		return x.OwnerUuid
+	}
+	return ""
+}
+
+func (x *ShareInventoryEntry) GetOwnerLogin() string {
+	if x != nil {
+		return x.OwnerLogin
+	}
+	return ""
+}
+
+func (x *ShareInventoryEntry) GetRootPaths() []string {
+	if x != nil {
+		return x.RootPaths
+	}
+	return nil
+}
+
+func (x *ShareInventoryEntry) GetAccessEnd() int64 {
+	if x != nil {
+		return x.AccessEnd
+	}
+	return 0
+}
+
+func (x *ShareInventoryEntry) GetTargetUsers() []string {
+	if x != nil {
+		return x.TargetUsers
+	}
+	return nil
+}
+
+type ShareInventoryResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Shares []*ShareInventoryEntry `protobuf:"bytes,1,rep,name=Shares,proto3" json:"Shares,omitempty"`
+}
+
+func (x *ShareInventoryResponse) Reset() {
+	*x = ShareInventoryResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cellsapi_share_proto_msgTypes[19]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ShareInventoryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ShareInventoryResponse) ProtoMessage() {}
+
+func (x *ShareInventoryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cellsapi_share_proto_msgTypes[19]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ShareInventoryResponse.ProtoReflect.Descriptor instead.
+func (*ShareInventoryResponse) Descriptor() ([]byte, []int) {
+	return file_cellsapi_share_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *ShareInventoryResponse) GetShares() []*ShareInventoryEntry {
+	if x != nil {
+		return x.Shares
+	}
+	return nil
+}
+
+type ShareBulkActionRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Action to apply
+	Action ShareBulkActionRequest_ShareBulkAction `protobuf:"varint,1,opt,name=Action,proto3,enum=rest.ShareBulkActionRequest_ShareBulkAction" json:"Action,omitempty"`
+	// Cells or Links UUIDs
+	Uuids []string `protobuf:"bytes,2,rep,name=Uuids,proto3" json:"Uuids,omitempty"`
+	// New owner (login or uuid) for TRANSFER action
+	TargetOwner string `protobuf:"bytes,3,opt,name=TargetOwner,proto3" json:"TargetOwner,omitempty"`
+}
+
+func (x *ShareBulkActionRequest) Reset() {
+	*x = ShareBulkActionRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cellsapi_share_proto_msgTypes[20]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ShareBulkActionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ShareBulkActionRequest) ProtoMessage() {}
+
+func (x *ShareBulkActionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cellsapi_share_proto_msgTypes[20]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ShareBulkActionRequest.ProtoReflect.Descriptor instead.
+func (*ShareBulkActionRequest) Descriptor() ([]byte, []int) {
+	return file_cellsapi_share_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *ShareBulkActionRequest) GetAction() ShareBulkActionRequest_ShareBulkAction {
+	if x != nil {
+		return x.Action
+	}
+	return ShareBulkActionRequest_NO_ACTION
+}
+
+func (x *ShareBulkActionRequest) GetUuids() []string {
+	if x != nil {
+		return x.Uuids
+	}
+	return nil
+}
+
+func (x *ShareBulkActionRequest) GetTargetOwner() string {
+	if x != nil {
+		return x.TargetOwner
+	}
+	return ""
+}
+
+type ShareBulkActionResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Results []*ShareBulkActionResponse_Result `protobuf:"bytes,1,rep,name=Results,proto3" json:"Results,omitempty"`
+}
+
+func (x *ShareBulkActionResponse) Reset() {
+	*x = ShareBulkActionResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cellsapi_share_proto_msgTypes[21]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ShareBulkActionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ShareBulkActionResponse) ProtoMessage() {}
+
+func (x *ShareBulkActionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cellsapi_share_proto_msgTypes[21]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ShareBulkActionResponse.ProtoReflect.Descriptor instead.
+func (*ShareBulkActionResponse) Descriptor() ([]byte, []int) {
+	return file_cellsapi_share_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *ShareBulkActionResponse) GetResults() []*ShareBulkActionResponse_Result {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
+// Container for ShareLink or Cell
+type ListSharedResourcesResponse_SharedResource struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Node  *tree.Node `protobuf:"bytes,1,opt,name=Node,proto3" json:"Node,omitempty"`
+	Link  *ShareLink `protobuf:"bytes,2,opt,name=Link,proto3" json:"Link,omitempty"`
+	Cells []*Cell    `protobuf:"bytes,3,rep,name=Cells,proto3" json:"Cells,omitempty"`
+}
+
+func (x *ListSharedResourcesResponse_SharedResource) Reset() {
+	*x = ListSharedResourcesResponse_SharedResource{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cellsapi_share_proto_msgTypes[24]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListSharedResourcesResponse_SharedResource) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListSharedResourcesResponse_SharedResource) ProtoMessage() {}
+
+func (x *ListSharedResourcesResponse_SharedResource) ProtoReflect() protoreflect.Message {
+	mi := &file_cellsapi_share_proto_msgTypes[24]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListSharedResourcesResponse_SharedResource.ProtoReflect.Descriptor instead.
+func (*ListSharedResourcesResponse_SharedResource) Descriptor() ([]byte, []int) {
+	return file_cellsapi_share_proto_rawDescGZIP(), []int{14, 0}
+}
+
+func (x *ListSharedResourcesResponse_SharedResource) GetNode() *tree.Node {
+	if x != nil {
+		return x.Node
+	}
+	return nil
+}
+
+func (x *ListSharedResourcesResponse_SharedResource) GetLink() *ShareLink {
+	if x != nil {
+		return x.Link
+	}
+	return nil
+}
+
+func (x *ListSharedResourcesResponse_SharedResource) GetCells() []*Cell {
+	if x != nil {
+		return x.Cells
+	}
+	return nil
+}
+
+type ShareBulkActionResponse_Result struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Uuid    string `protobuf:"bytes,1,opt,name=Uuid,proto3" json:"Uuid,omitempty"`
+	Success bool   `protobuf:"varint,2,opt,name=Success,proto3" json:"Success,omitempty"`
+	Error   string `protobuf:"bytes,3,opt,name=Error,proto3" json:"Error,omitempty"`
+}
+
+func (x *ShareBulkActionResponse_Result) Reset() {
+	*x = ShareBulkActionResponse_Result{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cellsapi_share_proto_msgTypes[25]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ShareBulkActionResponse_Result) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ShareBulkActionResponse_Result) ProtoMessage() {}
+
+func (x *ShareBulkActionResponse_Result) ProtoReflect() protoreflect.Message {
+	mi := &file_cellsapi_share_proto_msgTypes[25]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ShareBulkActionResponse_Result.ProtoReflect.Descriptor instead.
+func (*ShareBulkActionResponse_Result) Descriptor() ([]byte, []int) {
+	return file_cellsapi_share_proto_rawDescGZIP(), []int{21, 0}
+}
+
+func (x *ShareBulkActionResponse_Result) GetUuid() string {
+	if x != nil {
+		return x.Uuid
+	}
+	return ""
+}
+
+func (x *ShareBulkActionResponse_Result) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *ShareBulkActionResponse_Result) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+var File_cellsapi_share_proto protoreflect.FileDescriptor
+
+var file_cellsapi_share_proto_rawDesc = []byte{
+	0x0a, 0x14, 0x63, 0x65, 0x6c, 0x6c, 0x73, 0x61, 0x70, 0x69, 0x2d, 0x73, 0x68, 0x61, 0x72, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x04, 0x72, 0x65, 0x73, 0x74, 0x1a, 0x13, 0x63, 0x65,
+	0x6c, 0x6c, 0x73, 0x2d, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x1a, 0x0f, 0x63, 0x65, 0x6c, 0x6c, 0x73, 0x2d, 0x69, 0x64, 0x6d, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x1a, 0x10, 0x63, 0x65, 0x6c, 0x6c, 0x73, 0x2d, 0x74, 0x72, 0x65, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1b, 0x62, 0x75, 0x66, 0x2f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61,
+	0x74, 0x65, 0x2f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x22, 0xca, 0x01, 0x0a, 0x07, 0x43, 0x65, 0x6c, 0x6c, 0x41, 0x63, 0x6c, 0x12, 0x16, 0x0a,
+	0x06, 0x52, 0x6f, 0x6c, 0x65, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x52,
+	0x6f, 0x6c, 0x65, 0x49, 0x64, 0x12, 0x28, 0x0a, 0x07, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73,
+	0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x69, 0x64, 0x6d, 0x2e, 0x41, 0x43, 0x4c,
+	0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x07, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12,
+	0x1e, 0x0a, 0x0a, 0x49, 0x73, 0x55, 0x73, 0x65, 0x72, 0x52, 0x6f, 0x6c, 0x65, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x0a, 0x49, 0x73, 0x55, 0x73, 0x65, 0x72, 0x52, 0x6f, 0x6c, 0x65, 0x12,
+	0x1d, 0x0a, 0x04, 0x55, 0x73, 0x65, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x09, 0x2e,
+	0x69, 0x64, 0x6d, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x52, 0x04, 0x55, 0x73, 0x65, 0x72, 0x12, 0x1f,
+	0x0a, 0x05, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x09, 0x2e,
+	0x69, 0x64, 0x6d, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x52, 0x05, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x12,
 	0x1d, 0x0a, 0x04, 0x52, 0x6f, 0x6c, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x09, 0x2e,
 	0x69, 0x64, 0x6d, 0x2e, 0x52, 0x6f, 0x6c, 0x65, 0x52, 0x04, 0x52, 0x6f, 0x6c, 0x65, 0x22, 0x8f,
 	0x03, 0x0a, 0x04, 0x43, 0x65, 0x6c, 0x6c, 0x12, 0x12, 0x0a, 0x04, 0x55, 0x75, 0x69, 0x64, 0x18,
@@ -1424,165 +2072,256 @@ var file_cellsapi_share_proto_rawDesc = []byte{
 	0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x23, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
 	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x72, 0x65, 0x73, 0x74, 0x2e, 0x43, 0x65,
 	0x6c, 0x6c, 0x41, 0x63, 0x6c, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01,
-	0x22, 0x55, 0x0a, 0x13, 0x53, 0x68, 0x61, 0x72, 0x65, 0x4c, 0x69, 0x6e, 0x6b, 0x54, 0x61, 0x72,
-	0x67, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x12, 0x18, 0x0a, 0x07, 0x44, 0x69, 0x73, 0x70, 0x6c,
-	0x61, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x44, 0x69, 0x73, 0x70, 0x6c, 0x61,
-	0x79, 0x12, 0x24, 0x0a, 0x0d, 0x44, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x43, 0x6f, 0x75,
-	0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0d, 0x44, 0x6f, 0x77, 0x6e, 0x6c, 0x6f,
-	0x61, 0x64, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0xee, 0x06, 0x0a, 0x09, 0x53, 0x68, 0x61, 0x72,
-	0x65, 0x4c, 0x69, 0x6e, 0x6b, 0x12, 0x12, 0x0a, 0x04, 0x55, 0x75, 0x69, 0x64, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x04, 0x55, 0x75, 0x69, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x4c, 0x69, 0x6e,
-	0x6b, 0x48, 0x61, 0x73, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x4c, 0x69, 0x6e,
-	0x6b, 0x48, 0x61, 0x73, 0x68, 0x12, 0x18, 0x0a, 0x07, 0x4c, 0x69, 0x6e, 0x6b, 0x55, 0x72, 0x6c,
-	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x4c, 0x69, 0x6e, 0x6b, 0x55, 0x72, 0x6c, 0x12,
-	0x1e, 0x0a, 0x05, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x42, 0x08,
-	0xba, 0x48, 0x05, 0x72, 0x03, 0x18, 0xf4, 0x03, 0x52, 0x05, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x12,
-	0x2a, 0x0a, 0x0b, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x05,
-	0x20, 0x01, 0x28, 0x09, 0x42, 0x08, 0xba, 0x48, 0x05, 0x72, 0x03, 0x18, 0xe8, 0x07, 0x52, 0x0b,
-	0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1a, 0x0a, 0x08, 0x55,
-	0x73, 0x65, 0x72, 0x55, 0x75, 0x69, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x55,
-	0x73, 0x65, 0x72, 0x55, 0x75, 0x69, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x55, 0x73, 0x65, 0x72, 0x4c,
-	0x6f, 0x67, 0x69, 0x6e, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x55, 0x73, 0x65, 0x72,
-	0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x12, 0x2a, 0x0a, 0x10, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72,
-	0x64, 0x52, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x18, 0x08, 0x20, 0x01, 0x28, 0x08, 0x52,
-	0x10, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x52, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65,
-	0x64, 0x12, 0x20, 0x0a, 0x0b, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x53, 0x74, 0x61, 0x72, 0x74,
-	0x18, 0x09, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x53, 0x74,
-	0x61, 0x72, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x45, 0x6e, 0x64,
-	0x18, 0x0a, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x45, 0x6e,
-	0x64, 0x12, 0x22, 0x0a, 0x0c, 0x4d, 0x61, 0x78, 0x44, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64,
-	0x73, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x4d, 0x61, 0x78, 0x44, 0x6f, 0x77, 0x6e,
-	0x6c, 0x6f, 0x61, 0x64, 0x73, 0x12, 0x2a, 0x0a, 0x10, 0x43, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74,
-	0x44, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x73, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x03, 0x52,
-	0x10, 0x43, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x44, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64,
-	0x73, 0x12, 0x2a, 0x0a, 0x10, 0x56, 0x69, 0x65, 0x77, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74,
-	0x65, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x56, 0x69, 0x65,
-	0x77, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x42, 0x0a,
-	0x0b, 0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x73, 0x18, 0x0e, 0x20, 0x03,
-	0x28, 0x0b, 0x32, 0x20, 0x2e, 0x72, 0x65, 0x73, 0x74, 0x2e, 0x53, 0x68, 0x61, 0x72, 0x65, 0x4c,
-	0x69, 0x6e, 0x6b, 0x2e, 0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x73, 0x45,
-	0x6e, 0x74, 0x72, 0x79, 0x52, 0x0b, 0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72,
-	0x73, 0x12, 0x34, 0x0a, 0x15, 0x52, 0x65, 0x73, 0x74, 0x72, 0x69, 0x63, 0x74, 0x54, 0x6f, 0x54,
-	0x61, 0x72, 0x67, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x73, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x08,
-	0x52, 0x15, 0x52, 0x65, 0x73, 0x74, 0x72, 0x69, 0x63, 0x74, 0x54, 0x6f, 0x54, 0x61, 0x72, 0x67,
-	0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x73, 0x12, 0x28, 0x0a, 0x09, 0x52, 0x6f, 0x6f, 0x74, 0x4e,
-	0x6f, 0x64, 0x65, 0x73, 0x18, 0x10, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0a, 0x2e, 0x74, 0x72, 0x65,
-	0x65, 0x2e, 0x4e, 0x6f, 0x64, 0x65, 0x52, 0x09, 0x52, 0x6f, 0x6f, 0x74, 0x4e, 0x6f, 0x64, 0x65,
-	0x73, 0x12, 0x3b, 0x0a, 0x0b, 0x50, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73,
-	0x18, 0x11, 0x20, 0x03, 0x28, 0x0e, 0x32, 0x19, 0x2e, 0x72, 0x65, 0x73, 0x74, 0x2e, 0x53, 0x68,
-	0x61, 0x72, 0x65, 0x4c, 0x69, 0x6e, 0x6b, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x54, 0x79, 0x70,
-	0x65, 0x52, 0x0b, 0x50, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x33,
-	0x0a, 0x08, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x69, 0x65, 0x73, 0x18, 0x12, 0x20, 0x03, 0x28, 0x0b,
-	0x32, 0x17, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x52, 0x65, 0x73, 0x6f, 0x75,
-	0x72, 0x63, 0x65, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x52, 0x08, 0x50, 0x6f, 0x6c, 0x69, 0x63,
-	0x69, 0x65, 0x73, 0x12, 0x38, 0x0a, 0x17, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x69, 0x65, 0x73, 0x43,
-	0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x45, 0x64, 0x69, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x18, 0x13,
-	0x20, 0x01, 0x28, 0x08, 0x52, 0x17, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x69, 0x65, 0x73, 0x43, 0x6f,
-	0x6e, 0x74, 0x65, 0x78, 0x74, 0x45, 0x64, 0x69, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x1a, 0x59, 0x0a,
-	0x10, 0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x73, 0x45, 0x6e, 0x74, 0x72,
-	0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03,
-	0x6b, 0x65, 0x79, 0x12, 0x2f, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x19, 0x2e, 0x72, 0x65, 0x73, 0x74, 0x2e, 0x53, 0x68, 0x61, 0x72, 0x65, 0x4c,
-	0x69, 0x6e, 0x6b, 0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x52, 0x05, 0x76,
-	0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x5a, 0x0a, 0x0e, 0x50, 0x75, 0x74, 0x43,
-	0x65, 0x6c, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1e, 0x0a, 0x04, 0x52, 0x6f,
-	0x6f, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0a, 0x2e, 0x72, 0x65, 0x73, 0x74, 0x2e,
-	0x43, 0x65, 0x6c, 0x6c, 0x52, 0x04, 0x52, 0x6f, 0x6f, 0x6d, 0x12, 0x28, 0x0a, 0x0f, 0x43, 0x72,
-	0x65, 0x61, 0x74, 0x65, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x52, 0x6f, 0x6f, 0x74, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x08, 0x52, 0x0f, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x45, 0x6d, 0x70, 0x74, 0x79,
-	0x52, 0x6f, 0x6f, 0x74, 0x22, 0x24, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x43, 0x65, 0x6c, 0x6c, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x55, 0x75, 0x69, 0x64, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x55, 0x75, 0x69, 0x64, 0x22, 0x27, 0x0a, 0x11, 0x44, 0x65,
-	0x6c, 0x65, 0x74, 0x65, 0x43, 0x65, 0x6c, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
-	0x12, 0x0a, 0x04, 0x55, 0x75, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x55,
-	0x75, 0x69, 0x64, 0x22, 0x2e, 0x0a, 0x12, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x43, 0x65, 0x6c,
-	0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x53, 0x75, 0x63,
-	0x63, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x53, 0x75, 0x63, 0x63,
-	0x65, 0x73, 0x73, 0x22, 0x29, 0x0a, 0x13, 0x47, 0x65, 0x74, 0x53, 0x68, 0x61, 0x72, 0x65, 0x4c,
-	0x69, 0x6e, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x55, 0x75,
-	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x55, 0x75, 0x69, 0x64, 0x22, 0xea,
-	0x01, 0x0a, 0x13, 0x50, 0x75, 0x74, 0x53, 0x68, 0x61, 0x72, 0x65, 0x4c, 0x69, 0x6e, 0x6b, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2d, 0x0a, 0x09, 0x53, 0x68, 0x61, 0x72, 0x65, 0x4c,
-	0x69, 0x6e, 0x6b, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x72, 0x65, 0x73, 0x74,
-	0x2e, 0x53, 0x68, 0x61, 0x72, 0x65, 0x4c, 0x69, 0x6e, 0x6b, 0x52, 0x09, 0x53, 0x68, 0x61, 0x72,
-	0x65, 0x4c, 0x69, 0x6e, 0x6b, 0x12, 0x28, 0x0a, 0x0f, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72,
-	0x64, 0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0f,
-	0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x12,
-	0x26, 0x0a, 0x0e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72,
-	0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x50,
-	0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x12, 0x26, 0x0a, 0x0e, 0x55, 0x70, 0x64, 0x61, 0x74,
-	0x65, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x0e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x12,
-	0x2a, 0x0a, 0x10, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x48,
-	0x61, 0x73, 0x68, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x55, 0x70, 0x64, 0x61, 0x74,
-	0x65, 0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x48, 0x61, 0x73, 0x68, 0x22, 0x2c, 0x0a, 0x16, 0x44,
-	0x65, 0x6c, 0x65, 0x74, 0x65, 0x53, 0x68, 0x61, 0x72, 0x65, 0x4c, 0x69, 0x6e, 0x6b, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x55, 0x75, 0x69, 0x64, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x04, 0x55, 0x75, 0x69, 0x64, 0x22, 0x33, 0x0a, 0x17, 0x44, 0x65, 0x6c,
-	0x65, 0x74, 0x65, 0x53, 0x68, 0x61, 0x72, 0x65, 0x4c, 0x69, 0x6e, 0x6b, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x53, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x53, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x22, 0x8a,
-	0x02, 0x0a, 0x1a, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x68, 0x61, 0x72, 0x65, 0x64, 0x52, 0x65, 0x73,
-	0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x4c, 0x0a,
-	0x09, 0x53, 0x68, 0x61, 0x72, 0x65, 0x54, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e,
-	0x32, 0x2e, 0x2e, 0x72, 0x65, 0x73, 0x74, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x68, 0x61, 0x72,
-	0x65, 0x64, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x68, 0x61, 0x72, 0x65, 0x54, 0x79, 0x70, 0x65,
-	0x52, 0x09, 0x53, 0x68, 0x61, 0x72, 0x65, 0x54, 0x79, 0x70, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x53,
-	0x75, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x53, 0x75,
-	0x62, 0x6a, 0x65, 0x63, 0x74, 0x12, 0x26, 0x0a, 0x0e, 0x4f, 0x77, 0x6e, 0x65, 0x64, 0x42, 0x79,
-	0x53, 0x75, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0e, 0x4f,
-	0x77, 0x6e, 0x65, 0x64, 0x42, 0x79, 0x53, 0x75, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x12, 0x16, 0x0a,
-	0x06, 0x4f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x4f,
-	0x66, 0x66, 0x73, 0x65, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x06,
-	0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x22, 0x2e, 0x0a, 0x0d, 0x4c,
-	0x69, 0x73, 0x74, 0x53, 0x68, 0x61, 0x72, 0x65, 0x54, 0x79, 0x70, 0x65, 0x12, 0x07, 0x0a, 0x03,
-	0x41, 0x4e, 0x59, 0x10, 0x00, 0x12, 0x09, 0x0a, 0x05, 0x4c, 0x49, 0x4e, 0x4b, 0x53, 0x10, 0x01,
-	0x12, 0x09, 0x0a, 0x05, 0x43, 0x45, 0x4c, 0x4c, 0x53, 0x10, 0x02, 0x22, 0xaa, 0x02, 0x0a, 0x1b,
-	0x4c, 0x69, 0x73, 0x74, 0x53, 0x68, 0x61, 0x72, 0x65, 0x64, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72,
-	0x63, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4e, 0x0a, 0x09, 0x52,
-	0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x30,
-	0x2e, 0x72, 0x65, 0x73, 0x74, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x68, 0x61, 0x72, 0x65, 0x64,
-	0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x2e, 0x53, 0x68, 0x61, 0x72, 0x65, 0x64, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65,
-	0x52, 0x09, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x4f,
-	0x66, 0x66, 0x73, 0x65, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x4f, 0x66, 0x66,
-	0x73, 0x65, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x03, 0x20, 0x01,
-	0x28, 0x05, 0x52, 0x05, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x54, 0x6f, 0x74,
-	0x61, 0x6c, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x1a,
-	0x77, 0x0a, 0x0e, 0x53, 0x68, 0x61, 0x72, 0x65, 0x64, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63,
-	0x65, 0x12, 0x1e, 0x0a, 0x04, 0x4e, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x0a, 0x2e, 0x74, 0x72, 0x65, 0x65, 0x2e, 0x4e, 0x6f, 0x64, 0x65, 0x52, 0x04, 0x4e, 0x6f, 0x64,
-	0x65, 0x12, 0x23, 0x0a, 0x04, 0x4c, 0x69, 0x6e, 0x6b, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x0f, 0x2e, 0x72, 0x65, 0x73, 0x74, 0x2e, 0x53, 0x68, 0x61, 0x72, 0x65, 0x4c, 0x69, 0x6e, 0x6b,
-	0x52, 0x04, 0x4c, 0x69, 0x6e, 0x6b, 0x12, 0x20, 0x0a, 0x05, 0x43, 0x65, 0x6c, 0x6c, 0x73, 0x18,
-	0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0a, 0x2e, 0x72, 0x65, 0x73, 0x74, 0x2e, 0x43, 0x65, 0x6c,
-	0x6c, 0x52, 0x05, 0x43, 0x65, 0x6c, 0x6c, 0x73, 0x22, 0x65, 0x0a, 0x1a, 0x55, 0x70, 0x64, 0x61,
-	0x74, 0x65, 0x53, 0x68, 0x61, 0x72, 0x65, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x69, 0x65, 0x73, 0x52,
+	0x22, 0xac, 0x01, 0x0a, 0x14, 0x53, 0x68, 0x61, 0x72, 0x65, 0x4c, 0x69, 0x6e, 0x6b, 0x46, 0x69,
+	0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2c, 0x0a, 0x11, 0x41, 0x6c, 0x6c,
+	0x6f, 0x77, 0x65, 0x64, 0x45, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01,
+	0x20, 0x03, 0x28, 0x09, 0x52, 0x11, 0x41, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x64, 0x45, 0x78, 0x74,
+	0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x20, 0x0a, 0x0b, 0x4d, 0x61, 0x78, 0x46, 0x69,
+	0x6c, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x4d, 0x61,
+	0x78, 0x46, 0x69, 0x6c, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x22, 0x0a, 0x0c, 0x4d, 0x61, 0x78,
+	0x54, 0x6f, 0x74, 0x61, 0x6c, 0x53, 0x69, 0x7a, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x0c, 0x4d, 0x61, 0x78, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x20, 0x0a,
+	0x0b, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x79, 0x4f, 0x77, 0x6e, 0x65, 0x72, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x0b, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x79, 0x4f, 0x77, 0x6e, 0x65, 0x72, 0x22,
+	0x55, 0x0a, 0x13, 0x53, 0x68, 0x61, 0x72, 0x65, 0x4c, 0x69, 0x6e, 0x6b, 0x54, 0x61, 0x72, 0x67,
+	0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x12, 0x18, 0x0a, 0x07, 0x44, 0x69, 0x73, 0x70, 0x6c, 0x61,
+	0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x44, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79,
+	0x12, 0x24, 0x0a, 0x0d, 0x44, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x43, 0x6f, 0x75, 0x6e,
+	0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0d, 0x44, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61,
+	0x64, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0xf6, 0x07, 0x0a, 0x09, 0x53, 0x68, 0x61, 0x72, 0x65,
+	0x4c, 0x69, 0x6e, 0x6b, 0x12, 0x12, 0x0a, 0x04, 0x55, 0x75, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x04, 0x55, 0x75, 0x69, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x4c, 0x69, 0x6e, 0x6b,
+	0x48, 0x61, 0x73, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x4c, 0x69, 0x6e, 0x6b,
+	0x48, 0x61, 0x73, 0x68, 0x12, 0x18, 0x0a, 0x07, 0x4c, 0x69, 0x6e, 0x6b, 0x55, 0x72, 0x6c, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x4c, 0x69, 0x6e, 0x6b, 0x55, 0x72, 0x6c, 0x12, 0x1e,
+	0x0a, 0x05, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x42, 0x08, 0xba,
+	0x48, 0x05, 0x72, 0x03, 0x18, 0xf4, 0x03, 0x52, 0x05, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x12, 0x2a,
+	0x0a, 0x0b, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x09, 0x42, 0x08, 0xba, 0x48, 0x05, 0x72, 0x03, 0x18, 0xe8, 0x07, 0x52, 0x0b, 0x44,
+	0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1a, 0x0a, 0x08, 0x55, 0x73,
+	0x65, 0x72, 0x55, 0x75, 0x69, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x55, 0x73,
+	0x65, 0x72, 0x55, 0x75, 0x69, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x55, 0x73, 0x65, 0x72, 0x4c, 0x6f,
+	0x67, 0x69, 0x6e, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x55, 0x73, 0x65, 0x72, 0x4c,
+	0x6f, 0x67, 0x69, 0x6e, 0x12, 0x2a, 0x0a, 0x10, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64,
+	0x52, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x18, 0x08, 0x20, 0x01, 0x28, 0x08, 0x52, 0x10,
+	0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x52, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64,
+	0x12, 0x20, 0x0a, 0x0b, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x53, 0x74, 0x61, 0x72, 0x74, 0x18,
+	0x09, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x53, 0x74, 0x61,
+	0x72, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x45, 0x6e, 0x64, 0x18,
+	0x0a, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x45, 0x6e, 0x64,
+	0x12, 0x22, 0x0a, 0x0c, 0x4d, 0x61, 0x78, 0x44, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x73,
+	0x18, 0x0b, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x4d, 0x61, 0x78, 0x44, 0x6f, 0x77, 0x6e, 0x6c,
+	0x6f, 0x61, 0x64, 0x73, 0x12, 0x2a, 0x0a, 0x10, 0x43, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x44,
+	0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x73, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x03, 0x52, 0x10,
+	0x43, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x44, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x73,
+	0x12, 0x2a, 0x0a, 0x10, 0x56, 0x69, 0x65, 0x77, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65,
+	0x4e, 0x61, 0x6d, 0x65, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x56, 0x69, 0x65, 0x77,
+	0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x42, 0x0a, 0x0b,
+	0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x73, 0x18, 0x0e, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x20, 0x2e, 0x72, 0x65, 0x73, 0x74, 0x2e, 0x53, 0x68, 0x61, 0x72, 0x65, 0x4c, 0x69,
+	0x6e, 0x6b, 0x2e, 0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x73, 0x45, 0x6e,
+	0x74, 0x72, 0x79, 0x52, 0x0b, 0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x73,
+	0x12, 0x34, 0x0a, 0x15, 0x52, 0x65, 0x73, 0x74, 0x72, 0x69, 0x63, 0x74, 0x54, 0x6f, 0x54, 0x61,
+	0x72, 0x67, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x73, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x15, 0x52, 0x65, 0x73, 0x74, 0x72, 0x69, 0x63, 0x74, 0x54, 0x6f, 0x54, 0x61, 0x72, 0x67, 0x65,
+	0x74, 0x55, 0x73, 0x65, 0x72, 0x73, 0x12, 0x28, 0x0a, 0x09, 0x52, 0x6f, 0x6f, 0x74, 0x4e, 0x6f,
+	0x64, 0x65, 0x73, 0x18, 0x10, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0a, 0x2e, 0x74, 0x72, 0x65, 0x65,
+	0x2e, 0x4e, 0x6f, 0x64, 0x65, 0x52, 0x09, 0x52, 0x6f, 0x6f, 0x74, 0x4e, 0x6f, 0x64, 0x65, 0x73,
+	0x12, 0x3b, 0x0a, 0x0b, 0x50, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x18,
+	0x11, 0x20, 0x03, 0x28, 0x0e, 0x32, 0x19, 0x2e, 0x72, 0x65, 0x73, 0x74, 0x2e, 0x53, 0x68, 0x61,
+	0x72, 0x65, 0x4c, 0x69, 0x6e, 0x6b, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x54, 0x79, 0x70, 0x65,
+	0x52, 0x0b, 0x50, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x33, 0x0a,
+	0x08, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x69, 0x65, 0x73, 0x18, 0x12, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x17, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72,
+	0x63, 0x65, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x52, 0x08, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x69,
+	0x65, 0x73, 0x12, 0x38, 0x0a, 0x17, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x69, 0x65, 0x73, 0x43, 0x6f,
+	0x6e, 0x74, 0x65, 0x78, 0x74, 0x45, 0x64, 0x69, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x18, 0x13, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x17, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x69, 0x65, 0x73, 0x43, 0x6f, 0x6e,
+	0x74, 0x65, 0x78, 0x74, 0x45, 0x64, 0x69, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x12, 0x2a, 0x0a, 0x05,
+	0x53, 0x74, 0x61, 0x74, 0x65, 0x18, 0x14, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x14, 0x2e, 0x72, 0x65,
+	0x73, 0x74, 0x2e, 0x53, 0x68, 0x61, 0x72, 0x65, 0x4c, 0x69, 0x6e, 0x6b, 0x53, 0x74, 0x61, 0x74,
+	0x65, 0x52, 0x05, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x3c, 0x0a, 0x0b, 0x46, 0x69, 0x6c, 0x65,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x18, 0x15, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e,
+	0x72, 0x65, 0x73, 0x74, 0x2e, 0x53, 0x68, 0x61, 0x72, 0x65, 0x4c, 0x69, 0x6e, 0x6b, 0x46, 0x69,
+	0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x0b, 0x46, 0x69, 0x6c, 0x65, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x57, 0x61, 0x74, 0x65, 0x72, 0x6d,
+	0x61, 0x72, 0x6b, 0x18, 0x16, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x57, 0x61, 0x74, 0x65, 0x72,
+	0x6d, 0x61, 0x72, 0x6b, 0x1a, 0x59, 0x0a, 0x10, 0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x55, 0x73,
+	0x65, 0x72, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x2f, 0x0a, 0x05, 0x76, 0x61,
+	0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x72, 0x65, 0x73, 0x74,
+	0x2e, 0x53, 0x68, 0x61, 0x72, 0x65, 0x4c, 0x69, 0x6e, 0x6b, 0x54, 0x61, 0x72, 0x67, 0x65, 0x74,
+	0x55, 0x73, 0x65, 0x72, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22,
+	0x5a, 0x0a, 0x0e, 0x50, 0x75, 0x74, 0x43, 0x65, 0x6c, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x1e, 0x0a, 0x04, 0x52, 0x6f, 0x6f, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x0a, 0x2e, 0x72, 0x65, 0x73, 0x74, 0x2e, 0x43, 0x65, 0x6c, 0x6c, 0x52, 0x04, 0x52, 0x6f, 0x6f,
+	0x6d, 0x12, 0x28, 0x0a, 0x0f, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x45, 0x6d, 0x70, 0x74, 0x79,
+	0x52, 0x6f, 0x6f, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0f, 0x43, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x52, 0x6f, 0x6f, 0x74, 0x22, 0x24, 0x0a, 0x0e, 0x47,
+	0x65, 0x74, 0x43, 0x65, 0x6c, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a,
+	0x04, 0x55, 0x75, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x55, 0x75, 0x69,
+	0x64, 0x22, 0x27, 0x0a, 0x11, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x43, 0x65, 0x6c, 0x6c, 0x52,
 	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x55, 0x75, 0x69, 0x64, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x55, 0x75, 0x69, 0x64, 0x12, 0x33, 0x0a, 0x08, 0x50, 0x6f,
-	0x6c, 0x69, 0x63, 0x69, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x73,
-	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x50,
-	0x6f, 0x6c, 0x69, 0x63, 0x79, 0x52, 0x08, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x69, 0x65, 0x73, 0x22,
-	0xa6, 0x01, 0x0a, 0x1b, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x53, 0x68, 0x61, 0x72, 0x65, 0x50,
-	0x6f, 0x6c, 0x69, 0x63, 0x69, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
-	0x18, 0x0a, 0x07, 0x53, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08,
-	0x52, 0x07, 0x53, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x33, 0x0a, 0x08, 0x50, 0x6f, 0x6c,
-	0x69, 0x63, 0x69, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x73, 0x65,
-	0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x50, 0x6f,
-	0x6c, 0x69, 0x63, 0x79, 0x52, 0x08, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x69, 0x65, 0x73, 0x12, 0x38,
-	0x0a, 0x17, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x69, 0x65, 0x73, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x78,
-	0x74, 0x45, 0x64, 0x69, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52,
-	0x17, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x69, 0x65, 0x73, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74,
-	0x45, 0x64, 0x69, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x2a, 0x4a, 0x0a, 0x13, 0x53, 0x68, 0x61, 0x72,
-	0x65, 0x4c, 0x69, 0x6e, 0x6b, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x54, 0x79, 0x70, 0x65, 0x12,
-	0x0c, 0x0a, 0x08, 0x4e, 0x6f, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x10, 0x00, 0x12, 0x0b, 0x0a,
-	0x07, 0x50, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x10, 0x01, 0x12, 0x0c, 0x0a, 0x08, 0x44, 0x6f,
-	0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x10, 0x02, 0x12, 0x0a, 0x0a, 0x06, 0x55, 0x70, 0x6c, 0x6f,
-	0x61, 0x64, 0x10, 0x03, 0x42, 0x2d, 0x5a, 0x2b, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63,
-	0x6f, 0x6d, 0x2f, 0x70, 0x79, 0x64, 0x69, 0x6f, 0x2f, 0x63, 0x65, 0x6c, 0x6c, 0x73, 0x2f, 0x76,
-	0x35, 0x2f, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x72,
-	0x65, 0x73, 0x74, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x55, 0x75, 0x69, 0x64, 0x22, 0x2e, 0x0a, 0x12, 0x44, 0x65,
+	0x6c, 0x65, 0x74, 0x65, 0x43, 0x65, 0x6c, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x18, 0x0a, 0x07, 0x53, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x07, 0x53, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x22, 0x29, 0x0a, 0x13, 0x47, 0x65,
+	0x74, 0x53, 0x68, 0x61, 0x72, 0x65, 0x4c, 0x69, 0x6e, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x12, 0x0a, 0x04, 0x55, 0x75, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x04, 0x55, 0x75, 0x69, 0x64, 0x22, 0xea, 0x01, 0x0a, 0x13, 0x50, 0x75, 0x74, 0x53, 0x68, 0x61,
+	0x72, 0x65, 0x4c, 0x69, 0x6e, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2d, 0x0a,
+	0x09, 0x53, 0x68, 0x61, 0x72, 0x65, 0x4c, 0x69, 0x6e, 0x6b, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x0f, 0x2e, 0x72, 0x65, 0x73, 0x74, 0x2e, 0x53, 0x68, 0x61, 0x72, 0x65, 0x4c, 0x69, 0x6e,
+	0x6b, 0x52, 0x09, 0x53, 0x68, 0x61, 0x72, 0x65, 0x4c, 0x69, 0x6e, 0x6b, 0x12, 0x28, 0x0a, 0x0f,
+	0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0f, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x45,
+	0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x12, 0x26, 0x0a, 0x0e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e,
+	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x12, 0x26,
+	0x0a, 0x0e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x61,
+	0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x12, 0x2a, 0x0a, 0x10, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
+	0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x48, 0x61, 0x73, 0x68, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x10, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x48, 0x61,
+	0x73, 0x68, 0x22, 0x2c, 0x0a, 0x16, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x53, 0x68, 0x61, 0x72,
+	0x65, 0x4c, 0x69, 0x6e, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04,
+	0x55, 0x75, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x55, 0x75, 0x69, 0x64,
+	0x22, 0x33, 0x0a, 0x17, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x53, 0x68, 0x61, 0x72, 0x65, 0x4c,
+	0x69, 0x6e, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x53,
+	0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x53, 0x75,
+	0x63, 0x63, 0x65, 0x73, 0x73, 0x22, 0x8a, 0x02, 0x0a, 0x1a, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x68,
+	0x61, 0x72, 0x65, 0x64, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x4c, 0x0a, 0x09, 0x53, 0x68, 0x61, 0x72, 0x65, 0x54, 0x79, 0x70,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x2e, 0x2e, 0x72, 0x65, 0x73, 0x74, 0x2e, 0x4c,
+	0x69, 0x73, 0x74, 0x53, 0x68, 0x61, 0x72, 0x65, 0x64, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63,
+	0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x68,
+	0x61, 0x72, 0x65, 0x54, 0x79, 0x70, 0x65, 0x52, 0x09, 0x53, 0x68, 0x61, 0x72, 0x65, 0x54, 0x79,
+	0x70, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x53, 0x75, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x07, 0x53, 0x75, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x12, 0x26, 0x0a, 0x0e,
+	0x4f, 0x77, 0x6e, 0x65, 0x64, 0x42, 0x79, 0x53, 0x75, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x0e, 0x4f, 0x77, 0x6e, 0x65, 0x64, 0x42, 0x79, 0x53, 0x75, 0x62,
+	0x6a, 0x65, 0x63, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x4f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x4f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x12, 0x14, 0x0a, 0x05,
+	0x4c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x4c, 0x69, 0x6d,
+	0x69, 0x74, 0x22, 0x2e, 0x0a, 0x0d, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x68, 0x61, 0x72, 0x65, 0x54,
+	0x79, 0x70, 0x65, 0x12, 0x07, 0x0a, 0x03, 0x41, 0x4e, 0x59, 0x10, 0x00, 0x12, 0x09, 0x0a, 0x05,
+	0x4c, 0x49, 0x4e, 0x4b, 0x53, 0x10, 0x01, 0x12, 0x09, 0x0a, 0x05, 0x43, 0x45, 0x4c, 0x4c, 0x53,
+	0x10, 0x02, 0x22, 0xaa, 0x02, 0x0a, 0x1b, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x68, 0x61, 0x72, 0x65,
+	0x64, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x4e, 0x0a, 0x09, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x18,
+	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x30, 0x2e, 0x72, 0x65, 0x73, 0x74, 0x2e, 0x4c, 0x69, 0x73,
+	0x74, 0x53, 0x68, 0x61, 0x72, 0x65, 0x64, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x53, 0x68, 0x61, 0x72, 0x65, 0x64, 0x52,
+	0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x09, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63,
+	0x65, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x4f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x06, 0x4f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x4c, 0x69,
+	0x6d, 0x69, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x4c, 0x69, 0x6d, 0x69, 0x74,
+	0x12, 0x14, 0x0a, 0x05, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x05, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x1a, 0x77, 0x0a, 0x0e, 0x53, 0x68, 0x61, 0x72, 0x65, 0x64,
+	0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x12, 0x1e, 0x0a, 0x04, 0x4e, 0x6f, 0x64, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0a, 0x2e, 0x74, 0x72, 0x65, 0x65, 0x2e, 0x4e, 0x6f,
+	0x64, 0x65, 0x52, 0x04, 0x4e, 0x6f, 0x64, 0x65, 0x12, 0x23, 0x0a, 0x04, 0x4c, 0x69, 0x6e, 0x6b,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x72, 0x65, 0x73, 0x74, 0x2e, 0x53, 0x68,
+	0x61, 0x72, 0x65, 0x4c, 0x69, 0x6e, 0x6b, 0x52, 0x04, 0x4c, 0x69, 0x6e, 0x6b, 0x12, 0x20, 0x0a,
+	0x05, 0x43, 0x65, 0x6c, 0x6c, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0a, 0x2e, 0x72,
+	0x65, 0x73, 0x74, 0x2e, 0x43, 0x65, 0x6c, 0x6c, 0x52, 0x05, 0x43, 0x65, 0x6c, 0x6c, 0x73, 0x22,
+	0x65, 0x0a, 0x1a, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x53, 0x68, 0x61, 0x72, 0x65, 0x50, 0x6f,
+	0x6c, 0x69, 0x63, 0x69, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a,
+	0x04, 0x55, 0x75, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x55, 0x75, 0x69,
+	0x64, 0x12, 0x33, 0x0a, 0x08, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x69, 0x65, 0x73, 0x18, 0x02, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x52, 0x65,
+	0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x52, 0x08, 0x50, 0x6f,
+	0x6c, 0x69, 0x63, 0x69, 0x65, 0x73, 0x22, 0xa6, 0x01, 0x0a, 0x1b, 0x55, 0x70, 0x64, 0x61, 0x74,
+	0x65, 0x53, 0x68, 0x61, 0x72, 0x65, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x69, 0x65, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x53, 0x75, 0x63, 0x63, 0x65, 0x73,
+	0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x53, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73,
+	0x12, 0x33, 0x0a, 0x08, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x69, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x17, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x52, 0x65, 0x73,
+	0x6f, 0x75, 0x72, 0x63, 0x65, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x52, 0x08, 0x50, 0x6f, 0x6c,
+	0x69, 0x63, 0x69, 0x65, 0x73, 0x12, 0x38, 0x0a, 0x17, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x69, 0x65,
+	0x73, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x45, 0x64, 0x69, 0x74, 0x61, 0x62, 0x6c, 0x65,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x17, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x69, 0x65, 0x73,
+	0x43, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x45, 0x64, 0x69, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x22,
+	0xa3, 0x02, 0x0a, 0x15, 0x53, 0x68, 0x61, 0x72, 0x65, 0x49, 0x6e, 0x76, 0x65, 0x6e, 0x74, 0x6f,
+	0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x4c, 0x0a, 0x09, 0x53, 0x68, 0x61,
+	0x72, 0x65, 0x54, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x2e, 0x2e, 0x72,
+	0x65, 0x73, 0x74, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x68, 0x61, 0x72, 0x65, 0x64, 0x52, 0x65,
+	0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x4c,
+	0x69, 0x73, 0x74, 0x53, 0x68, 0x61, 0x72, 0x65, 0x54, 0x79, 0x70, 0x65, 0x52, 0x09, 0x53, 0x68,
+	0x61, 0x72, 0x65, 0x54, 0x79, 0x70, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x4f, 0x77, 0x6e, 0x65, 0x72,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x4f, 0x77, 0x6e, 0x65, 0x72, 0x12, 0x1c, 0x0a,
+	0x09, 0x57, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x09, 0x57, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x50,
+	0x61, 0x74, 0x68, 0x50, 0x72, 0x65, 0x66, 0x69, 0x78, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0a, 0x50, 0x61, 0x74, 0x68, 0x50, 0x72, 0x65, 0x66, 0x69, 0x78, 0x12, 0x24, 0x0a, 0x0d, 0x45,
+	0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x42, 0x65, 0x66, 0x6f, 0x72, 0x65, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x0d, 0x45, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x42, 0x65, 0x66, 0x6f, 0x72,
+	0x65, 0x12, 0x22, 0x0a, 0x0c, 0x4e, 0x6f, 0x45, 0x78, 0x70, 0x69, 0x72, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0c, 0x4e, 0x6f, 0x45, 0x78, 0x70, 0x69, 0x72,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1e, 0x0a, 0x0a, 0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x55,
+	0x73, 0x65, 0x72, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x54, 0x61, 0x72, 0x67, 0x65,
+	0x74, 0x55, 0x73, 0x65, 0x72, 0x22, 0x9f, 0x02, 0x0a, 0x13, 0x53, 0x68, 0x61, 0x72, 0x65, 0x49,
+	0x6e, 0x76, 0x65, 0x6e, 0x74, 0x6f, 0x72, 0x79, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x12, 0x0a,
+	0x04, 0x55, 0x75, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x55, 0x75, 0x69,
+	0x64, 0x12, 0x42, 0x0a, 0x04, 0x54, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32,
+	0x2e, 0x2e, 0x72, 0x65, 0x73, 0x74, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x68, 0x61, 0x72, 0x65,
+	0x64, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x68, 0x61, 0x72, 0x65, 0x54, 0x79, 0x70, 0x65, 0x52,
+	0x04, 0x54, 0x79, 0x70, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x12, 0x1c, 0x0a, 0x09, 0x4f,
+	0x77, 0x6e, 0x65, 0x72, 0x55, 0x75, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09,
+	0x4f, 0x77, 0x6e, 0x65, 0x72, 0x55, 0x75, 0x69, 0x64, 0x12, 0x1e, 0x0a, 0x0a, 0x4f, 0x77, 0x6e,
+	0x65, 0x72, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x4f,
+	0x77, 0x6e, 0x65, 0x72, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x12, 0x1c, 0x0a, 0x09, 0x52, 0x6f, 0x6f,
+	0x74, 0x50, 0x61, 0x74, 0x68, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x09, 0x52, 0x09, 0x52, 0x6f,
+	0x6f, 0x74, 0x50, 0x61, 0x74, 0x68, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x41, 0x63, 0x63, 0x65, 0x73,
+	0x73, 0x45, 0x6e, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x41, 0x63, 0x63, 0x65,
+	0x73, 0x73, 0x45, 0x6e, 0x64, 0x12, 0x20, 0x0a, 0x0b, 0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x55,
+	0x73, 0x65, 0x72, 0x73, 0x18, 0x08, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0b, 0x54, 0x61, 0x72, 0x67,
+	0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x73, 0x22, 0x4b, 0x0a, 0x16, 0x53, 0x68, 0x61, 0x72, 0x65,
+	0x49, 0x6e, 0x76, 0x65, 0x6e, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x31, 0x0a, 0x06, 0x53, 0x68, 0x61, 0x72, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x19, 0x2e, 0x72, 0x65, 0x73, 0x74, 0x2e, 0x53, 0x68, 0x61, 0x72, 0x65, 0x49, 0x6e,
+	0x76, 0x65, 0x6e, 0x74, 0x6f, 0x72, 0x79, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x06, 0x53, 0x68,
+	0x61, 0x72, 0x65, 0x73, 0x22, 0xde, 0x01, 0x0a, 0x16, 0x53, 0x68, 0x61, 0x72, 0x65, 0x42, 0x75,
+	0x6c, 0x6b, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x44, 0x0a, 0x06, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32,
+	0x2c, 0x2e, 0x72, 0x65, 0x73, 0x74, 0x2e, 0x53, 0x68, 0x61, 0x72, 0x65, 0x42, 0x75, 0x6c, 0x6b,
+	0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x53, 0x68,
+	0x61, 0x72, 0x65, 0x42, 0x75, 0x6c, 0x6b, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x06, 0x41,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x14, 0x0a, 0x05, 0x55, 0x75, 0x69, 0x64, 0x73, 0x18, 0x02,
+	0x20, 0x03, 0x28, 0x09, 0x52, 0x05, 0x55, 0x75, 0x69, 0x64, 0x73, 0x12, 0x20, 0x0a, 0x0b, 0x54,
+	0x61, 0x72, 0x67, 0x65, 0x74, 0x4f, 0x77, 0x6e, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0b, 0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x4f, 0x77, 0x6e, 0x65, 0x72, 0x22, 0x46, 0x0a,
+	0x0f, 0x53, 0x68, 0x61, 0x72, 0x65, 0x42, 0x75, 0x6c, 0x6b, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x12, 0x0d, 0x0a, 0x09, 0x4e, 0x4f, 0x5f, 0x41, 0x43, 0x54, 0x49, 0x4f, 0x4e, 0x10, 0x00, 0x12,
+	0x0a, 0x0a, 0x06, 0x45, 0x58, 0x50, 0x49, 0x52, 0x45, 0x10, 0x01, 0x12, 0x0c, 0x0a, 0x08, 0x54,
+	0x52, 0x41, 0x4e, 0x53, 0x46, 0x45, 0x52, 0x10, 0x02, 0x12, 0x0a, 0x0a, 0x06, 0x44, 0x45, 0x4c,
+	0x45, 0x54, 0x45, 0x10, 0x03, 0x22, 0xa7, 0x01, 0x0a, 0x17, 0x53, 0x68, 0x61, 0x72, 0x65, 0x42,
+	0x75, 0x6c, 0x6b, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x3e, 0x0a, 0x07, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x24, 0x2e, 0x72, 0x65, 0x73, 0x74, 0x2e, 0x53, 0x68, 0x61, 0x72, 0x65, 0x42,
+	0x75, 0x6c, 0x6b, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x2e, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x07, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74,
+	0x73, 0x1a, 0x4c, 0x0a, 0x06, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x55,
+	0x75, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x55, 0x75, 0x69, 0x64, 0x12,
+	0x18, 0x0a, 0x07, 0x53, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x07, 0x53, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x45, 0x72, 0x72,
+	0x6f, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x2a,
+	0x4a, 0x0a, 0x13, 0x53, 0x68, 0x61, 0x72, 0x65, 0x4c, 0x69, 0x6e, 0x6b, 0x41, 0x63, 0x63, 0x65,
+	0x73, 0x73, 0x54, 0x79, 0x70, 0x65, 0x12, 0x0c, 0x0a, 0x08, 0x4e, 0x6f, 0x41, 0x63, 0x63, 0x65,
+	0x73, 0x73, 0x10, 0x00, 0x12, 0x0b, 0x0a, 0x07, 0x50, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x10,
+	0x01, 0x12, 0x0c, 0x0a, 0x08, 0x44, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x10, 0x02, 0x12,
+	0x0a, 0x0a, 0x06, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x10, 0x03, 0x2a, 0x55, 0x0a, 0x0e, 0x53,
+	0x68, 0x61, 0x72, 0x65, 0x4c, 0x69, 0x6e, 0x6b, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x0e, 0x0a,
+	0x0a, 0x4c, 0x69, 0x6e, 0x6b, 0x41, 0x63, 0x74, 0x69, 0x76, 0x65, 0x10, 0x00, 0x12, 0x0f, 0x0a,
+	0x0b, 0x4c, 0x69, 0x6e, 0x6b, 0x50, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x10, 0x01, 0x12, 0x0f,
+	0x0a, 0x0b, 0x4c, 0x69, 0x6e, 0x6b, 0x45, 0x78, 0x70, 0x69, 0x72, 0x65, 0x64, 0x10, 0x02, 0x12,
+	0x11, 0x0a, 0x0d, 0x4c, 0x69, 0x6e, 0x6b, 0x45, 0x78, 0x68, 0x61, 0x75, 0x73, 0x74, 0x65, 0x64,
+	0x10, 0x03, 0x42, 0x2d, 0x5a, 0x2b, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d,
+	0x2f, 0x70, 0x79, 0x64, 0x69, 0x6f, 0x2f, 0x63, 0x65, 0x6c, 0x6c, 0x73, 0x2f, 0x76, 0x35, 0x2f,
+	0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x72, 0x65, 0x73,
+	0x74, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -1597,64 +2336,80 @@ func file_cellsapi_share_proto_rawDescGZIP() []byte {
 	return file_cellsapi_share_proto_rawDescData
 }
 
-var file_cellsapi_share_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
-var file_cellsapi_share_proto_msgTypes = make([]protoimpl.MessageInfo, 19)
+var file_cellsapi_share_proto_enumTypes = make([]protoimpl.EnumInfo, 4)
+var file_cellsapi_share_proto_msgTypes = make([]protoimpl.MessageInfo, 26)
 var file_cellsapi_share_proto_goTypes = []any{
 	(ShareLinkAccessType)(0),                           // 0: rest.ShareLinkAccessType
-	(ListSharedResourcesRequest_ListShareType)(0),      // 1: rest.ListSharedResourcesRequest.ListShareType
-	(*CellAcl)(nil),                                    // 2: rest.CellAcl
-	(*Cell)(nil),                                       // 3: rest.Cell
-	(*ShareLinkTargetUser)(nil),                        // 4: rest.ShareLinkTargetUser
-	(*ShareLink)(nil),                                  // 5: rest.ShareLink
-	(*PutCellRequest)(nil),                             // 6: rest.PutCellRequest
-	(*GetCellRequest)(nil),                             // 7: rest.GetCellRequest
-	(*DeleteCellRequest)(nil),                          // 8: rest.DeleteCellRequest
-	(*DeleteCellResponse)(nil),                         // 9: rest.DeleteCellResponse
-	(*GetShareLinkRequest)(nil),                        // 10: rest.GetShareLinkRequest
-	(*PutShareLinkRequest)(nil),                        // 11: rest.PutShareLinkRequest
-	(*DeleteShareLinkRequest)(nil),                     // 12: rest.DeleteShareLinkRequest
-	(*DeleteShareLinkResponse)(nil),                    // 13: rest.DeleteShareLinkResponse
-	(*ListSharedResourcesRequest)(nil),                 // 14: rest.ListSharedResourcesRequest
-	(*ListSharedResourcesResponse)(nil),                // 15: rest.ListSharedResourcesResponse
-	(*UpdateSharePoliciesRequest)(nil),                 // 16: rest.UpdateSharePoliciesRequest
-	(*UpdateSharePoliciesResponse)(nil),                // 17: rest.UpdateSharePoliciesResponse
-	nil,                                                // 18: rest.Cell.ACLsEntry
-	nil,                                                // 19: rest.ShareLink.TargetUsersEntry
-	(*ListSharedResourcesResponse_SharedResource)(nil), // 20: rest.ListSharedResourcesResponse.SharedResource
-	(*idm.ACLAction)(nil),                              // 21: idm.ACLAction
-	(*idm.User)(nil),                                   // 22: idm.User
-	(*idm.Role)(nil),                                   // 23: idm.Role
-	(*tree.Node)(nil),                                  // 24: tree.Node
-	(*service.ResourcePolicy)(nil),                     // 25: service.ResourcePolicy
+	(ShareLinkState)(0),                                // 1: rest.ShareLinkState
+	(ListSharedResourcesRequest_ListShareType)(0),      // 2: rest.ListSharedResourcesRequest.ListShareType
+	(ShareBulkActionRequest_ShareBulkAction)(0),        // 3: rest.ShareBulkActionRequest.ShareBulkAction
+	(*CellAcl)(nil),                                    // 4: rest.CellAcl
+	(*Cell)(nil),                                       // 5: rest.Cell
+	(*ShareLinkFileRequest)(nil),                       // 6: rest.ShareLinkFileRequest
+	(*ShareLinkTargetUser)(nil),                        // 7: rest.ShareLinkTargetUser
+	(*ShareLink)(nil),                                  // 8: rest.ShareLink
+	(*PutCellRequest)(nil),                             // 9: rest.PutCellRequest
+	(*GetCellRequest)(nil),                             // 10: rest.GetCellRequest
+	(*DeleteCellRequest)(nil),                          // 11: rest.DeleteCellRequest
+	(*DeleteCellResponse)(nil),                         // 12: rest.DeleteCellResponse
+	(*GetShareLinkRequest)(nil),                        // 13: rest.GetShareLinkRequest
+	(*PutShareLinkRequest)(nil),                        // 14: rest.PutShareLinkRequest
+	(*DeleteShareLinkRequest)(nil),                     // 15: rest.DeleteShareLinkRequest
+	(*DeleteShareLinkResponse)(nil),                    // 16: rest.DeleteShareLinkResponse
+	(*ListSharedResourcesRequest)(nil),                 // 17: rest.ListSharedResourcesRequest
+	(*ListSharedResourcesResponse)(nil),                // 18: rest.ListSharedResourcesResponse
+	(*UpdateSharePoliciesRequest)(nil),                 // 19: rest.UpdateSharePoliciesRequest
+	(*UpdateSharePoliciesResponse)(nil),                // 20: rest.UpdateSharePoliciesResponse
+	(*ShareInventoryRequest)(nil),                      // 21: rest.ShareInventoryRequest
+	(*ShareInventoryEntry)(nil),                        // 22: rest.ShareInventoryEntry
+	(*ShareInventoryResponse)(nil),                     // 23: rest.ShareInventoryResponse
+	(*ShareBulkActionRequest)(nil),                     // 24: rest.ShareBulkActionRequest
+	(*ShareBulkActionResponse)(nil),                    // 25: rest.ShareBulkActionResponse
+	nil,                                                // 26: rest.Cell.ACLsEntry
+	nil,                                                // 27: rest.ShareLink.TargetUsersEntry
+	(*ListSharedResourcesResponse_SharedResource)(nil), // 28: rest.ListSharedResourcesResponse.SharedResource
+	(*ShareBulkActionResponse_Result)(nil),             // 29: rest.ShareBulkActionResponse.Result
+	(*idm.ACLAction)(nil),                              // 30: idm.ACLAction
+	(*idm.User)(nil),                                   // 31: idm.User
+	(*idm.Role)(nil),                                   // 32: idm.Role
+	(*tree.Node)(nil),                                  // 33: tree.Node
+	(*service.ResourcePolicy)(nil),                     // 34: service.ResourcePolicy
 }
 var file_cellsapi_share_proto_depIdxs = []int32{
-	21, // 0: rest.CellAcl.Actions:type_name -> idm.ACLAction
-	22, // 1: rest.CellAcl.User:type_name -> idm.User
-	22, // 2: rest.CellAcl.Group:type_name -> idm.User
-	23, // 3: rest.CellAcl.Role:type_name -> idm.Role
-	24, // 4: rest.Cell.RootNodes:type_name -> tree.Node
-	18, // 5: rest.Cell.ACLs:type_name -> rest.Cell.ACLsEntry
-	25, // 6: rest.Cell.Policies:type_name -> service.ResourcePolicy
-	19, // 7: rest.ShareLink.TargetUsers:type_name -> rest.ShareLink.TargetUsersEntry
-	24, // 8: rest.ShareLink.RootNodes:type_name -> tree.Node
+	30, // 0: rest.CellAcl.Actions:type_name -> idm.ACLAction
+	31, // 1: rest.CellAcl.User:type_name -> idm.User
+	31, // 2: rest.CellAcl.Group:type_name -> idm.User
+	32, // 3: rest.CellAcl.Role:type_name -> idm.Role
+	33, // 4: rest.Cell.RootNodes:type_name -> tree.Node
+	26, // 5: rest.Cell.ACLs:type_name -> rest.Cell.ACLsEntry
+	34, // 6: rest.Cell.Policies:type_name -> service.ResourcePolicy
+	27, // 7: rest.ShareLink.TargetUsers:type_name -> rest.ShareLink.TargetUsersEntry
+	33, // 8: rest.ShareLink.RootNodes:type_name -> tree.Node
 	0,  // 9: rest.ShareLink.Permissions:type_name -> rest.ShareLinkAccessType
-	25, // 10: rest.ShareLink.Policies:type_name -> service.ResourcePolicy
-	3,  // 11: rest.PutCellRequest.Room:type_name -> rest.Cell
-	5,  // 12: rest.PutShareLinkRequest.ShareLink:type_name -> rest.ShareLink
-	1,  // 13: rest.ListSharedResourcesRequest.ShareType:type_name -> rest.ListSharedResourcesRequest.ListShareType
-	20, // 14: rest.ListSharedResourcesResponse.Resources:type_name -> rest.ListSharedResourcesResponse.SharedResource
-	25, // 15: rest.UpdateSharePoliciesRequest.Policies:type_name -> service.ResourcePolicy
-	25, // 16: rest.UpdateSharePoliciesResponse.Policies:type_name -> service.ResourcePolicy
-	2,  // 17: rest.Cell.ACLsEntry.value:type_name -> rest.CellAcl
-	4,  // 18: rest.ShareLink.TargetUsersEntry.value:type_name -> rest.ShareLinkTargetUser
-	24, // 19: rest.ListSharedResourcesResponse.SharedResource.Node:type_name -> tree.Node
-	5,  // 20: rest.ListSharedResourcesResponse.SharedResource.Link:type_name -> rest.ShareLink
-	3,  // 21: rest.ListSharedResourcesResponse.SharedResource.Cells:type_name -> rest.Cell
-	22, // [22:22] is the sub-list for method output_type
-	22, // [22:22] is the sub-list for method input_type
-	22, // [22:22] is the sub-list for extension type_name
-	22, // [22:22] is the sub-list for extension extendee
-	0,  // [0:22] is the sub-list for field type_name
+	34, // 10: rest.ShareLink.Policies:type_name -> service.ResourcePolicy
+	1,  // 11: rest.ShareLink.State:type_name -> rest.ShareLinkState
+	6,  // 12: rest.ShareLink.FileRequest:type_name -> rest.ShareLinkFileRequest
+	5,  // 13: rest.PutCellRequest.Room:type_name -> rest.Cell
+	8,  // 14: rest.PutShareLinkRequest.ShareLink:type_name -> rest.ShareLink
+	2,  // 15: rest.ListSharedResourcesRequest.ShareType:type_name -> rest.ListSharedResourcesRequest.ListShareType
+	28, // 16: rest.ListSharedResourcesResponse.Resources:type_name -> rest.ListSharedResourcesResponse.SharedResource
+	34, // 17: rest.UpdateSharePoliciesRequest.Policies:type_name -> service.ResourcePolicy
+	34, // 18: rest.UpdateSharePoliciesResponse.Policies:type_name -> service.ResourcePolicy
+	2,  // 19: rest.ShareInventoryRequest.ShareType:type_name -> rest.ListSharedResourcesRequest.ListShareType
+	2,  // 20: rest.ShareInventoryEntry.Type:type_name -> rest.ListSharedResourcesRequest.ListShareType
+	22, // 21: rest.ShareInventoryResponse.Shares:type_name -> rest.ShareInventoryEntry
+	3,  // 22: rest.ShareBulkActionRequest.Action:type_name -> rest.ShareBulkActionRequest.ShareBulkAction
+	29, // 23: rest.ShareBulkActionResponse.Results:type_name -> rest.ShareBulkActionResponse.Result
+	4,  // 24: rest.Cell.ACLsEntry.value:type_name -> rest.CellAcl
+	7,  // 25: rest.ShareLink.TargetUsersEntry.value:type_name -> rest.ShareLinkTargetUser
+	33, // 26: rest.ListSharedResourcesResponse.SharedResource.Node:type_name -> tree.Node
+	8,  // 27: rest.ListSharedResourcesResponse.SharedResource.Link:type_name -> rest.ShareLink
+	5,  // 28: rest.ListSharedResourcesResponse.SharedResource.Cells:type_name -> rest.Cell
+	29, // [29:29] is the sub-list for method output_type
+	29, // [29:29] is the sub-list for method input_type
+	29, // [29:29] is the sub-list for extension type_name
+	29, // [29:29] is the sub-list for extension extendee
+	0,  // [0:29] is the sub-list for field type_name
 }
 
 func init() { file_cellsapi_share_proto_init() }
@@ -1688,7 +2443,7 @@ func file_cellsapi_share_proto_init() {
 			}
 		}
 		file_cellsapi_share_proto_msgTypes[2].Exporter = func(v any, i int) any {
-			switch v := v.(*ShareLinkTargetUser); i {
+			switch v := v.(*ShareLinkFileRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1700,7 +2455,7 @@ func file_cellsapi_share_proto_init() {
 			}
 		}
 		file_cellsapi_share_proto_msgTypes[3].Exporter = func(v any, i int) any {
-			switch v := v.(*ShareLink); i {
+			switch v := v.(*ShareLinkTargetUser); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1712,7 +2467,7 @@ func file_cellsapi_share_proto_init() {
 			}
 		}
 		file_cellsapi_share_proto_msgTypes[4].Exporter = func(v any, i int) any {
-			switch v := v.(*PutCellRequest); i {
+			switch v := v.(*ShareLink); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1724,7 +2479,7 @@ func file_cellsapi_share_proto_init() {
 			}
 		}
 		file_cellsapi_share_proto_msgTypes[5].Exporter = func(v any, i int) any {
-			switch v := v.(*GetCellRequest); i {
+			switch v := v.(*PutCellRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1736,7 +2491,7 @@ func file_cellsapi_share_proto_init() {
 			}
 		}
 		file_cellsapi_share_proto_msgTypes[6].Exporter = func(v any, i int) any {
-			switch v := v.(*DeleteCellRequest); i {
+			switch v := v.(*GetCellRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1748,7 +2503,7 @@ func file_cellsapi_share_proto_init() {
 			}
 		}
 		file_cellsapi_share_proto_msgTypes[7].Exporter = func(v any, i int) any {
-			switch v := v.(*DeleteCellResponse); i {
+			switch v := v.(*DeleteCellRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1760,7 +2515,7 @@ func file_cellsapi_share_proto_init() {
 			}
 		}
 		file_cellsapi_share_proto_msgTypes[8].Exporter = func(v any, i int) any {
-			switch v := v.(*GetShareLinkRequest); i {
+			switch v := v.(*DeleteCellResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1772,7 +2527,7 @@ func file_cellsapi_share_proto_init() {
 			}
 		}
 		file_cellsapi_share_proto_msgTypes[9].Exporter = func(v any, i int) any {
-			switch v := v.(*PutShareLinkRequest); i {
+			switch v := v.(*GetShareLinkRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1784,7 +2539,7 @@ func file_cellsapi_share_proto_init() {
 			}
 		}
 		file_cellsapi_share_proto_msgTypes[10].Exporter = func(v any, i int) any {
-			switch v := v.(*DeleteShareLinkRequest); i {
+			switch v := v.(*PutShareLinkRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1796,7 +2551,7 @@ func file_cellsapi_share_proto_init() {
 			}
 		}
 		file_cellsapi_share_proto_msgTypes[11].Exporter = func(v any, i int) any {
-			switch v := v.(*DeleteShareLinkResponse); i {
+			switch v := v.(*DeleteShareLinkRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1808,7 +2563,7 @@ func file_cellsapi_share_proto_init() {
 			}
 		}
 		file_cellsapi_share_proto_msgTypes[12].Exporter = func(v any, i int) any {
-			switch v := v.(*ListSharedResourcesRequest); i {
+			switch v := v.(*DeleteShareLinkResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1820,7 +2575,7 @@ func file_cellsapi_share_proto_init() {
 			}
 		}
 		file_cellsapi_share_proto_msgTypes[13].Exporter = func(v any, i int) any {
-			switch v := v.(*ListSharedResourcesResponse); i {
+			switch v := v.(*ListSharedResourcesRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1832,7 +2587,7 @@ func file_cellsapi_share_proto_init() {
 			}
 		}
 		file_cellsapi_share_proto_msgTypes[14].Exporter = func(v any, i int) any {
-			switch v := v.(*UpdateSharePoliciesRequest); i {
+			switch v := v.(*ListSharedResourcesResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1844,6 +2599,18 @@ func file_cellsapi_share_proto_init() {
 			}
 		}
 		file_cellsapi_share_proto_msgTypes[15].Exporter = func(v any, i int) any {
+			switch v := v.(*UpdateSharePoliciesRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cellsapi_share_proto_msgTypes[16].Exporter = func(v any, i int) any {
 			switch v := v.(*UpdateSharePoliciesResponse); i {
 			case 0:
 				return &v.state
@@ -1855,7 +2622,67 @@ func file_cellsapi_share_proto_init() {
 				return nil
 			}
 		}
+		file_cellsapi_share_proto_msgTypes[17].Exporter = func(v any, i int) any {
+			switch v := v.(*ShareInventoryRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 		file_cellsapi_share_proto_msgTypes[18].Exporter = func(v any, i int) any {
+			switch v := v.(*ShareInventoryEntry); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cellsapi_share_proto_msgTypes[19].Exporter = func(v any, i int) any {
+			switch v := v.(*ShareInventoryResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cellsapi_share_proto_msgTypes[20].Exporter = func(v any, i int) any {
+			switch v := v.(*ShareBulkActionRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cellsapi_share_proto_msgTypes[21].Exporter = func(v any, i int) any {
+			switch v := v.(*ShareBulkActionResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cellsapi_share_proto_msgTypes[24].Exporter = func(v any, i int) any {
 			switch v := v.(*ListSharedResourcesResponse_SharedResource); i {
 			case 0:
 				return &v.state
@@ -1867,14 +2694,26 @@ func file_cellsapi_share_proto_init() {
 				return nil
 			}
 		}
+		file_cellsapi_share_proto_msgTypes[25].Exporter = func(v any, i int) any {
+			switch v := v.(*ShareBulkActionResponse_Result); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_cellsapi_share_proto_rawDesc,
-			NumEnums:      2,
-			NumMessages:   19,
+			NumEnums:      4,
+			NumMessages:   26,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
@@ -526,6 +526,9 @@ func (m *manager) initTelemetry(ctx context.Context, bootstrap config.Store, sto
 			log.DefaultStdoutLogger(common.LogLevel.String(), common.LogJSON),
 			log.DefaultJsonLogger(dirLog, common.ServiceLogGRPC),
 		},
+		Auditers: []log.LoggerConfig{
+			log.DefaultJsonLogger("", common.ServiceAuditGRPC),
+		},
 	}
 
 	// Read from bootstrap
@@ -449,6 +449,7 @@ type Crepo struct {
 	Attraccess_type              string            `xml:"access_type,attr,omitempty"  json:",omitempty"`
 	Attracl                      string            `xml:"acl,attr,omitempty"  json:",omitempty"`
 	AttrallowCrossRepositoryCopy string            `xml:"allowCrossRepositoryCopy,attr,omitempty"  json:",omitempty"`
+	Attrfile_request             string            `xml:"file_request,attr,omitempty"  json:",omitempty"`
 	Attrid                       string            `xml:"id,attr,omitempty"  json:",omitempty"`
 	Attrowner                    string            `xml:"owner,attr,omitempty"  json:",omitempty"`
 	AttrrepositorySlug           string            `xml:"repositorySlug,attr,omitempty"  json:",omitempty"`
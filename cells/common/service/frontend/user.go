@@ -367,6 +367,9 @@ func (u *User) publishWorkspaces(ctx context.Context, status RequestStatus, pool
 		} else if ws.Scope == idm.WorkspaceScope_LINK {
 			repo.Attrowner = "shared"
 			repo.Attrrepository_type = "link"
+			if ws.LoadAttributes().FileRequest != nil {
+				repo.Attrfile_request = "true"
+			}
 			if ws.Label == "{{RefLabel}}" && len(ws.RootUUIDs) == 1 {
 				// Load unique node to re-build label
 				router := compose.UuidClient()
@@ -36,6 +36,15 @@ import (
 	"github.com/pydio/cells/v5/common/utils/propagator"
 )
 
+var elevationsResolver func(ctx context.Context, login string) []string
+
+// RegisterElevationsResolver sets the function returning the active elevations of a user. Claims roles are
+// only refreshed with the token, so log entries are tagged from the live elevations state instead.
+// The resolver is called for every log entry and must not block.
+func RegisterElevationsResolver(f func(ctx context.Context, login string) []string) {
+	elevationsResolver = f
+}
+
 // RichContext enriches the passed logger with as much info as possible
 func RichContext(ctx context.Context, logger log.ZapLogger, fields ...zapcore.Field) log.ZapLogger {
 
@@ -100,6 +109,11 @@ func RichContext(ctx context.Context, logger log.ZapLogger, fields ...zapcore.Fi
 			zap.String(common.KeyProfile, claims.Profile),
 			zap.String(common.KeyRoles, claims.Roles),
 		)
+		if elevationsResolver != nil {
+			for _, id := range elevationsResolver(ctx, claims.Name) {
+				fields = append(fields, zap.String(common.KeyElevationId, id))
+			}
+		}
 	} else if u := claim.UserNameFromContext(ctx); u != "" && u != common.PydioSystemUsername {
 		fields = append(fields,
 			zap.String(common.KeyUsername, u),
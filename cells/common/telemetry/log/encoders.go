@@ -76,6 +76,7 @@ var (
 		common.KeyGroupPath,
 		common.KeyProfile,
 		common.KeyRoles,
+		common.KeyElevationId,
 	}
 
 	consoleNamedColors map[string]int
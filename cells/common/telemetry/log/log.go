@@ -62,8 +62,9 @@ var (
 
 	StdOut *os.File
 
-	currentConfig []LoggerConfig
-	currentSvc    otel.Service
+	currentConfig   []LoggerConfig
+	currentAuditers []LoggerConfig
+	currentSvc      otel.Service
 
 	//mainClosers   []io.Closer
 	customSyncers []LoggerConfig
@@ -103,6 +104,15 @@ func Init(svc otel.Service, conf []LoggerConfig, ww ...ContextWrapper) {
 	}, func(ctx context.Context) {
 		ReadyLogSyncerContext = ctx
 	})
+	SetAuditerInit(func(ctx context.Context) (*zap.Logger, []io.Closer) {
+		// Audit messages are sent to main loggers and to the dedicated audit outputs
+		var fullConfig []LoggerConfig
+		fullConfig = append(fullConfig, currentConfig...)
+		fullConfig = append(fullConfig, customSyncers...)
+		fullConfig = append(fullConfig, currentAuditers...)
+		cores, closers, _ := LoadCores(ctx, currentSvc, fullConfig)
+		return zap.New(zapcore.NewTee(cores...)), closers
+	}, func(ctx context.Context) {})
 	if len(ww) > 0 {
 		contextWrapper = ww[0]
 	}
@@ -115,6 +125,13 @@ func ReloadMainLogger(scv otel.Service, cfg []LoggerConfig) {
 	resetLoggerPool(mainLoggerPool)
 }
 
+// ReloadAuditer passes an updated config for audit-only outputs and force auditer reset
+func ReloadAuditer(scv otel.Service, cfg []LoggerConfig) {
+	currentSvc = scv
+	currentAuditers = cfg
+	resetLoggerPool(auditLoggerPool)
+}
+
 // RegisterWriteSyncer optional writers for logs
 func RegisterWriteSyncer(syncer LoggerConfig) {
 	customSyncers = append(customSyncers, syncer)
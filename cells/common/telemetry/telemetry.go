@@ -53,6 +53,9 @@ Sample JSON config for telemetry
 	{"level":"=debug","encoding":"console","outputs":["file:///Users/charles/Library/Application Support/Pydio/cells5/logs/pydio_request_debug.log"],"filters":{"X-Pydio-Debug-Session":"true"}},
 	{"level":"debug","encoding":"json","outputs":["otlp://localhost:4318"]}
 ],
+"auditers": [
+	{"level":"info","encoding":"json","outputs":["service:///?service=pydio.grpc.audit"]}
+],
 "tracing": {
 	"outputs": [
 	  "jaeger://localhost:14268/api/traces",
@@ -79,6 +82,7 @@ Sample JSON config for telemetry
 type Config struct {
 	OTelService otel.Service       `json:"otelService" yaml:"otel_service"`
 	Loggers     []log.LoggerConfig `json:"loggers" yaml:"loggers"`
+	Auditers    []log.LoggerConfig `json:"auditers" yaml:"auditers"`
 	Tracing     tracing.Config     `json:"tracing" yaml:"tracing"`
 	Metrics     metrics.Config     `json:"metrics" yaml:"metrics"`
 	Profiling   profile.Config     `json:"profiling" yaml:"profiling"`
@@ -126,6 +130,7 @@ func (c *Config) Reload(ctx context.Context, runtimeLevel string, runtimeJSON bo
 
 	c.overrideStdoutFromRuntime(runtimeLevel, runtimeJSON)
 	log.ReloadMainLogger(c.OTelService, c.Loggers)
+	log.ReloadAuditer(c.OTelService, c.Auditers)
 
 	var errs []error
 	if len(c.Tracing.Outputs) > 0 {
@@ -58,6 +58,11 @@ Unlike the standard 'user' tree operations, that must be always prefixed with a
 		Short: "ActivityStreams (AS2) endpoints",
 		Long:  `This service provides listing to the AS2 feeds for various objects (users activities, user alerts, files/folders activities, etc...)`,
 	},
+	"ChatService": {
+		Title: "Chat Service",
+		Short: "Comments on files and folders",
+		Long:  `This service provides endpoints for reading and posting comments attached to files and folders, including threads, mentions and reactions. Access to a room follows the permissions of the node it is attached to.`,
+	},
 	"FrontendService": {
 		Title: "Frontend Service",
 		Short: "Web-based frontend service",
@@ -118,6 +123,16 @@ Unlike the standard 'user' tree operations, that must be always prefixed with a
 		Short: "Access to the search index",
 		Long:  `This service provides a rich API for indexing and searching the files and folders.`,
 	},
+	"AccessReviewService": {
+		Title: "Access Review Service",
+		Short: "Certification campaigns of users accesses",
+		Long:  `This service provides endpoints for running access review campaigns: administrators start campaigns on a set of workspaces, workspace owners approve or revoke the accesses of each user, and revocations are applied to the ACLs.`,
+	},
+	"ElevationService": {
+		Title: "Elevation Service",
+		Short: "Time-boxed privilege elevations",
+		Long:  `This service lets users request a role for a limited duration with a justification. Approvers accept or reject the request, from the API or from the link sent by email, and the role rights are automatically revoked when the elevation expires.`,
+	},
 	"ACLService": {
 		Title: "ACL Service",
 		Short: "Access Control List",
@@ -0,0 +1,141 @@
+/*
+ * Copyright (c) 2025 Abstrium SAS <team (at) pydio.com>
+ * This file is part of Pydio Cells.
+ *
+ * Pydio Cells is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * Pydio Cells is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with Pydio Cells.  If not, see <http://www.gnu.org/licenses/>.
+ *
+ * The latest code can be found at <https://pydio.com>.
+ */
+
+// Package geoip provides IP anonymization and offline country lookup based on a MaxMind-compatible database file.
+package geoip
+
+import (
+	"net"
+	"strings"
+	"sync"
+
+	"github.com/oschwald/maxminddb-golang"
+)
+
+var (
+	readers   = make(map[string]*maxminddb.Reader)
+	readersMu sync.Mutex
+)
+
+type countryRecord struct {
+	Country struct {
+		IsoCode string `maxminddb:"iso_code"`
+	} `maxminddb:"country"`
+}
+
+// AnonymizeIP truncates an IP address so that it cannot identify a single host: the last octet of IPv4
+// addresses is zeroed, IPv6 addresses are truncated to their /48 prefix. A port suffix is ignored.
+func AnonymizeIP(addr string) string {
+	ip := parseIP(addr)
+	if ip == nil {
+		return ""
+	}
+	if v4 := ip.To4(); v4 != nil {
+		return v4.Mask(net.CIDRMask(24, 32)).String()
+	}
+	return ip.Mask(net.CIDRMask(48, 128)).String()
+}
+
+// Country returns the ISO code of the country of the given address, using the database file found at dbPath.
+// It returns an empty string if the database is not configured, cannot be opened or has no record for this address.
+func Country(dbPath string, addr string) string {
+	if dbPath == "" {
+		return ""
+	}
+	ip := parseIP(addr)
+	if ip == nil {
+		return ""
+	}
+	reader, er := openReader(dbPath)
+	if er != nil {
+		return ""
+	}
+	var rec countryRecord
+	if er := reader.Lookup(ip, &rec); er != nil {
+		return ""
+	}
+	return rec.Country.IsoCode
+}
+
+// ClientAddress resolves the address of the client behind a chain of proxies. X-Forwarded-For values are
+// walked from right to left and only honoured while the hop that appended them is listed in trustedProxies
+// (IPs or CIDRs). Without trusted proxies, the direct peer address is returned.
+func ClientAddress(peer string, forwardedFor []string, trustedProxies []string) string {
+	var nets []*net.IPNet
+	for _, t := range trustedProxies {
+		t = strings.TrimSpace(t)
+		if !strings.Contains(t, "/") {
+			if ip := net.ParseIP(t); ip != nil && ip.To4() != nil {
+				t += "/32"
+			} else {
+				t += "/128"
+			}
+		}
+		if _, n, e := net.ParseCIDR(t); e == nil {
+			nets = append(nets, n)
+		}
+	}
+	trusted := func(addr string) bool {
+		ip := parseIP(addr)
+		if ip == nil {
+			return false
+		}
+		for _, n := range nets {
+			if n.Contains(ip) {
+				return true
+			}
+		}
+		return false
+	}
+	var hops []string
+	for _, h := range forwardedFor {
+		for _, a := range strings.Split(h, ",") {
+			if a = strings.TrimSpace(a); a != "" {
+				hops = append(hops, a)
+			}
+		}
+	}
+	client := peer
+	for i := len(hops) - 1; i >= 0 && trusted(client); i-- {
+		client = hops[i]
+	}
+	return client
+}
+
+func openReader(dbPath string) (*maxminddb.Reader, error) {
+	readersMu.Lock()
+	defer readersMu.Unlock()
+	if r, ok := readers[dbPath]; ok {
+		return r, nil
+	}
+	r, er := maxminddb.Open(dbPath)
+	if er != nil {
+		return nil, er
+	}
+	readers[dbPath] = r
+	return r, nil
+}
+
+func parseIP(addr string) net.IP {
+	if h, _, e := net.SplitHostPort(addr); e == nil {
+		addr = h
+	}
+	return net.ParseIP(addr)
+}
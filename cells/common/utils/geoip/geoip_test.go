@@ -0,0 +1,54 @@
+/*
+ * Copyright (c) 2025 Abstrium SAS <team (at) pydio.com>
+ * This file is part of Pydio Cells.
+ *
+ * Pydio Cells is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * Pydio Cells is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with Pydio Cells.  If not, see <http://www.gnu.org/licenses/>.
+ *
+ * The latest code can be found at <https://pydio.com>.
+ */
+
+package geoip
+
+import (
+	"testing"
+
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestAnonymizeIP(t *testing.T) {
+
+	Convey("Test IP anonymization", t, func() {
+		So(AnonymizeIP("192.168.10.45"), ShouldEqual, "192.168.10.0")
+		So(AnonymizeIP("192.168.10.45:51234"), ShouldEqual, "192.168.10.0")
+		So(AnonymizeIP("2001:db8:85a3:8d3:1319:8a2e:370:7348"), ShouldEqual, "2001:db8:85a3::")
+		So(AnonymizeIP("[2001:db8:85a3::1]:443"), ShouldEqual, "2001:db8:85a3::")
+		So(AnonymizeIP("not-an-ip"), ShouldEqual, "")
+	})
+
+	Convey("Test country lookup without database", t, func() {
+		So(Country("", "8.8.8.8"), ShouldEqual, "")
+		So(Country("/non/existing.mmdb", "8.8.8.8"), ShouldEqual, "")
+	})
+
+	Convey("Test client address behind proxies", t, func() {
+		So(ClientAddress("203.0.113.7:4433", []string{"1.2.3.4"}, nil), ShouldEqual, "203.0.113.7:4433")
+		So(ClientAddress("203.0.113.7:4433", []string{"1.2.3.4"}, []string{"10.0.0.0/8"}), ShouldEqual, "203.0.113.7:4433")
+		So(ClientAddress("10.0.0.2:4433", []string{"1.2.3.4"}, []string{"10.0.0.0/8"}), ShouldEqual, "1.2.3.4")
+		So(ClientAddress("10.0.0.2:4433", nil, []string{"10.0.0.0/8"}), ShouldEqual, "10.0.0.2:4433")
+		// Spoofed left-most values are ignored
+		So(ClientAddress("10.0.0.2:4433", []string{"6.6.6.6, 1.2.3.4"}, []string{"10.0.0.0/8"}), ShouldEqual, "1.2.3.4")
+		So(ClientAddress("10.0.0.2:4433", []string{"6.6.6.6", "1.2.3.4, 10.0.0.3"}, []string{"10.0.0.2", "10.0.0.3"}), ShouldEqual, "1.2.3.4")
+	})
+
+}
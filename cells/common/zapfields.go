@@ -54,22 +54,27 @@ const (
 	AuditWsDelete = "18"
 
 	// S3 Objects
-	AuditObjectGet = "21"
-	AuditObjectPut = "22"
+	AuditObjectGet    = "21"
+	AuditObjectPut    = "22"
+	AuditQuotaWarning = "23"
 
 	// Users, Group, Roles
-	AuditUserCreate  = "41"
-	AuditUserRead    = "42"
-	AuditUserUpdate  = "43"
-	AuditUserDelete  = "44"
-	AuditGroupCreate = "46"
-	AuditGroupRead   = "47"
-	AuditGroupUpdate = "48"
-	AuditGroupDelete = "49"
-	AuditRoleCreate  = "51"
-	AuditRoleRead    = "52"
-	AuditRoleUpdate  = "53"
-	AuditRoleDelete  = "54"
+	AuditUserCreate    = "41"
+	AuditUserRead      = "42"
+	AuditUserUpdate    = "43"
+	AuditUserDelete    = "44"
+	AuditGroupCreate   = "46"
+	AuditGroupRead     = "47"
+	AuditGroupUpdate   = "48"
+	AuditGroupDelete   = "49"
+	AuditRoleCreate    = "51"
+	AuditRoleRead      = "52"
+	AuditRoleUpdate    = "53"
+	AuditRoleDelete    = "54"
+	AuditDataExport    = "55"
+	AuditDataDownload  = "56"
+	AuditGuestDisable  = "57"
+	AuditGuestTransfer = "58"
 
 	// Policies
 	AuditPolicyGroupStore  = "61"
@@ -86,6 +91,20 @@ const (
 	AuditLinkRead   = "76"
 	AuditLinkUpdate = "77"
 	AuditLinkDelete = "78"
+
+	// Encryption Keys
+	AuditEncryptionKeyRotate = "81"
+
+	// Access Reviews
+	AuditReviewCampaignStart = "91"
+	AuditReviewItemApprove   = "92"
+	AuditReviewItemRevoke    = "93"
+
+	// Privilege Elevations
+	AuditElevationRequest = "101"
+	AuditElevationApprove = "102"
+	AuditElevationReject  = "103"
+	AuditElevationEnd     = "104"
 )
 
 // Known audit message IDs
@@ -125,6 +144,8 @@ const (
 	KeyRoles    = "Roles"
 	KeyProfile  = "Profile"
 
+	KeyElevationId = "ElevationId"
+
 	KeyUser     = "User"
 	KeyUsername = "UserName"
 	KeyUserUuid = "UserUuid"
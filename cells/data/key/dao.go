@@ -46,6 +46,7 @@ type DAO interface {
 	DeleteNode(ctx context.Context, nodeUuid string) error
 
 	SaveNodeKey(ctx context.Context, nodeKey *encryption.NodeKey) error
+	UpdateNodeKey(ctx context.Context, nodeKey *encryption.NodeKey) error
 	GetNodeKey(ctx context.Context, node string, user string) (*encryption.NodeKey, error)
 	DeleteNodeKey(ctx context.Context, nodeKey *encryption.NodeKey) error
 }
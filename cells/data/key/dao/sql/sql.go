@@ -250,6 +250,22 @@ func (s *sqlimpl) SaveNodeKey(ctx context.Context, key *encryption.NodeKey) erro
 	return nil
 }
 
+// UpdateNodeKey replaces the data of the existing key(s) of a given node and user.
+func (s *sqlimpl) UpdateNodeKey(ctx context.Context, key *encryption.NodeKey) error {
+	tx := s.Session(ctx).Model(&encryption.NodeKey{}).
+		Where(&encryption.NodeKey{NodeId: key.NodeId, UserId: key.UserId}).
+		Updates(map[string]interface{}{"key_data": key.KeyData})
+	if tx.Error != nil {
+		return tx.Error
+	}
+	if tx.RowsAffected == 0 {
+		return errors.WithMessagef(errors.KeyNotFound, "no key found for node id %s", key.NodeId)
+	}
+
+	log.Logger(ctx).Debug("UpdateNodeKey", zap.String("nodeId", key.NodeId), zap.String("user", key.UserId))
+	return nil
+}
+
 func (s *sqlimpl) GetNodeKey(ctx context.Context, nodeUuid string, user string) (*encryption.NodeKey, error) {
 	var row *encryption.NodeKey
 	log.Logger(ctx).Debug("GetNodeKey", zap.Any("nodeId", nodeUuid), zap.Any("user", user))
@@ -89,6 +89,25 @@ func TestCrudKeys(t *testing.T) {
 
 		})
 
+		Convey("Test Update Key", t, func() {
+			err := mockDAO.UpdateNodeKey(ctx, &encryption.NodeKey{
+				NodeId:  "node_id",
+				UserId:  "pydio",
+				KeyData: []byte("new-key"),
+			})
+			So(err, ShouldBeNil)
+			k, err := mockDAO.GetNodeKey(ctx, "node_id", "pydio")
+			So(err, ShouldBeNil)
+			So(string(k.KeyData), ShouldEqual, "new-key")
+
+			err = mockDAO.UpdateNodeKey(ctx, &encryption.NodeKey{
+				NodeId:  "unknown_node",
+				UserId:  "pydio",
+				KeyData: []byte("new-key"),
+			})
+			So(err, ShouldNotBeNil)
+		})
+
 		Convey("Test Delete Key", t, func() {
 
 			err := mockDAO.DeleteNodeKey(ctx, &encryption.NodeKey{
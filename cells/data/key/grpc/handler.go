@@ -213,6 +213,13 @@ func (km *NodeKeyManagerHandler) SetNodeInfo(stream encryption.NodeKeyManager_Se
 				log.Logger(ctx).Error("failed to save key", zap.Error(err))
 			}
 
+		case encryption.SetNodeInfoActionType_KEY_UPDATE:
+
+			if err = dao.UpdateNodeKey(ctx, req.SetNodeKey.NodeKey); err != nil {
+				log.Logger(ctx).Error("failed to update key", zap.Error(err))
+				return err
+			}
+
 		case encryption.SetNodeInfoActionType_CLEAR:
 
 			if err = dao.ClearNodeEncryptedBlockInfo(ctx, req.SetBlock.NodeUuid); err != nil {
@@ -96,6 +96,10 @@ func (s *Handler) PutDataSource(req *restful.Request, resp *restful.Response) er
 		return errors.WithMessage(errors.InvalidParameters, "datasource name contains an invalid character, please use alphanumeric characters")
 	}
 
+	if ds.CompressionEnabled() && !ds.FlatStorage {
+		return errors.WithMessage(errors.InvalidParameters, "compression is only supported on flat datasources")
+	}
+
 	ctx := req.Request.Context()
 
 	// Handle / and \ for OS
@@ -117,6 +121,14 @@ func (s *Handler) PutDataSource(req *restful.Request, resp *restful.Response) er
 	var initialVersioningEmpty bool
 	if update {
 		initialVersioningEmpty = initialDs.VersioningPolicyName == ""
+		if initialDs.EncryptionMode == object.EncryptionMode_MASTER && ds.EncryptionMode == object.EncryptionMode_KMS {
+			// Keys sealed with the master key must still open after switching to an external KMS
+			if ds.StorageConfiguration == nil {
+				ds.StorageConfiguration = map[string]string{}
+			}
+			previous := append([]string{initialDs.EncryptionKey}, initialDs.PreviousEncryptionKeys()...)
+			ds.StorageConfiguration[object.StorageKeyEncryptionPreviousKeys] = strings.Join(previous, ",")
+		}
 	} else {
 		// Set default value for hashing version on new datasources
 		if ds.StorageConfiguration == nil {
@@ -0,0 +1,95 @@
+/*
+ * Copyright 2007-2025 Charles du Jeu - Abstrium SAS <team (at) pyd.io>
+ * This file is part of Pydio.
+ *
+ * Pydio is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * Pydio is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with Pydio.  If not, see <http://www.gnu.org/licenses/>.
+ *
+ * The latest code can be found at <https://pydio.com>.
+ */
+
+const STORAGE_KEY = 'pydio.file-request.uploader';
+
+/**
+ * Identity of an anonymous visitor of a file request link. A random identifier is generated once
+ * and kept in the browser, along with the name and email entered by the visitor, and sent
+ * with each request as X-Pydio-Uploader-* headers.
+ */
+class FileRequestUploader {
+
+    /**
+     * @param pydio {Pydio}
+     * @return {boolean} True if the active workspace is a file request
+     */
+    static isRequired(pydio){
+        const repo = pydio && pydio.user && pydio.user.getActiveRepositoryObject();
+        return !!(repo && repo.isFileRequest());
+    }
+
+    /**
+     * @return {{id: string, name: string, email: string}}
+     */
+    static getIdentity(){
+        let identity = {};
+        try{
+            identity = JSON.parse(localStorage.getItem(STORAGE_KEY)) || {};
+        }catch (e) {}
+        if(!identity.id){
+            const bb = new Uint8Array(16);
+            window.crypto.getRandomValues(bb);
+            identity.id = Array.from(bb).map(b => b.toString(16).padStart(2, '0')).join('');
+            FileRequestUploader.save(identity);
+        }
+        return {name: '', email: '', ...identity};
+    }
+
+    /**
+     * @param name {string}
+     * @param email {string}
+     */
+    static setIdentity(name, email){
+        FileRequestUploader.save({...FileRequestUploader.getIdentity(), name, email});
+    }
+
+    /**
+     * @return {boolean} True if name and email are filled
+     */
+    static isComplete(){
+        const {name, email} = FileRequestUploader.getIdentity();
+        return !!(name && email && email.indexOf('@') > 0);
+    }
+
+    /**
+     * @param pydio {Pydio}
+     * @return {Object} Headers to append to requests, empty if not in a file request
+     */
+    static getHeaders(pydio){
+        if(!FileRequestUploader.isRequired(pydio)){
+            return {};
+        }
+        const {id, name, email} = FileRequestUploader.getIdentity();
+        return {
+            'X-Pydio-Uploader-Id': id,
+            'X-Pydio-Uploader-Name': encodeURIComponent(name),
+            'X-Pydio-Uploader-Email': encodeURIComponent(email)
+        };
+    }
+
+    static save(identity){
+        try{
+            localStorage.setItem(STORAGE_KEY, JSON.stringify(identity));
+        }catch (e) {}
+    }
+}
+
+export {FileRequestUploader as default}
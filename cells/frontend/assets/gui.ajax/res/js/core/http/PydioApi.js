@@ -27,6 +27,7 @@ import Throttle from 'superagent-throttle'
 import lscache from 'lscache'
 import {RestCreateSelectionRequest, TreeNode, TreeServiceApi} from 'cells-sdk';
 import awsLoader from "./awsLoader";
+import FileRequestUploader from "./FileRequestUploader";
 import {debounce} from 'lodash'
 
 const DUMMY_SECRET='gatewaysecret'
@@ -306,6 +307,7 @@ class PydioApi{
                         partSize: PydioApi.getMultipartPartSize(),
                         queueSize: PydioApi.getMultipartPartQueueSize(),
                         leavePartsOnError:false,
+                        customHeaders: FileRequestUploader.getHeaders(this.getPydioObject()),
                     });
                     managed.on('httpUploadProgress', onProgress);
                     managed.send((e,d) => {
@@ -358,7 +360,8 @@ class PydioApi{
                     url: signed,
                     headers: {
                         'Content-Type': 'application/octet-stream',
-                        ...metaHeaders
+                        ...metaHeaders,
+                        ...FileRequestUploader.getHeaders(this.getPydioObject())
                     }
                 }
             })
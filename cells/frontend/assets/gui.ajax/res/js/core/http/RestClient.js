@@ -24,6 +24,7 @@ import dayjs from 'dayjs'
 import qs from 'query-string'
 import {ApiClient, JobsServiceApi, RestUserJobRequest, RestFrontSessionRequest, RestFrontSessionResponse} from 'cells-sdk';
 import lscache from 'lscache'
+import FileRequestUploader from './FileRequestUploader'
 
 // Override parseDate method to support ISO8601 cross-browser
 ApiClient.parseDate = function (str) {
@@ -236,6 +237,7 @@ class RestClient extends ApiClient{
         if (this.pydio.user && this.pydio.user.getPreference("lang")) {
             headerParams["X-Pydio-Language"] = this.pydio.user.getPreference("lang");
         }
+        Object.assign(headerParams, FileRequestUploader.getHeaders(this.pydio));
 
         return this.getOrUpdateJwt()
             .then(token => token)
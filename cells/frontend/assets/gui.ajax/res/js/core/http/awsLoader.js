@@ -8,6 +8,19 @@ export default () => {
 
             _partsRetries = []
 
+            constructor(options = {}) {
+                super(options);
+                const {customHeaders} = options;
+                if(customHeaders && Object.keys(customHeaders).length) {
+                    // Append custom headers to every request issued by this upload (parts, create, complete)
+                    const original = this.service.setupRequestListeners.bind(this.service);
+                    this.service.setupRequestListeners = (request) => {
+                        original(request);
+                        request.on('build', () => Object.assign(request.httpRequest.headers, customHeaders));
+                    };
+                }
+            }
+
             progress(info, data) {
                 const upload = this._managedUpload;
                 if (this.operation === 'putObject') {
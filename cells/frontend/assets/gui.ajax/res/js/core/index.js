@@ -54,6 +54,7 @@ import CellModel from "./model/CellModel";
 import IdmObjectHelper from "./model/IdmObjectHelper";
 import PydioWebSocket from "./http/PydioWebSocket";
 import Policies from "./http/Policies";
+import FileRequestUploader from "./http/FileRequestUploader";
 import * as SDK from "cells-sdk";
 
 const PydioUsers = {
@@ -167,6 +168,8 @@ if(originalRequire) {
                 return PydioWebSocket
             case 'pydio/http/policies':
                 return Policies
+            case 'pydio/http/file-request-uploader':
+                return FileRequestUploader
             case 'pydio':
                 return Pydio
             case 'cells-sdk':
@@ -201,6 +201,13 @@ export default class Repository {
         return this._repositoryType;
     }
 
+    /**
+     * @returns {boolean} True if this link workspace is an upload-only file request
+     */
+    isFileRequest(){
+        return !!this._fileRequest;
+    }
+
     /**
      * @returns {string}
      */
@@ -223,6 +230,9 @@ export default class Repository {
 		if(repoNode.getAttribute('repository_type')){
 			this._repositoryType = repoNode.getAttribute('repository_type');
 		}
+		if(repoNode.getAttribute('file_request') === "true"){
+			this._fileRequest = true;
+		}
 		if(repoNode.getAttribute('access_status')){
 			this._accessStatus = repoNode.getAttribute('access_status');
 		}
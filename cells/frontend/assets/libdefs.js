@@ -52,6 +52,7 @@ const PydioCoreRequires = {
     'http/MetaCacheService.js'  :'pydio/http/meta-cache-service',
     'http/PydioWebSocket.js'    :'pydio/http/websocket',
     'http/Policies.js'          :'pydio/http/policies',
+    'http/FileRequestUploader.js':'pydio/http/file-request-uploader',
     'Pydio'                     :'pydio'
 };
 
@@ -30,6 +30,7 @@ import FolderItem from './FolderItem'
 import Session from './Session'
 
 import PydioApi from 'pydio/http/api'
+import FileRequestUploader from 'pydio/http/file-request-uploader'
 import {TreeServiceApi, RestCreateNodesRequest, TreeNode, TreeNodeType} from 'cells-sdk'
 
 import MetaNodeProvider from 'pydio/model/meta-node-provider'
@@ -54,9 +55,20 @@ class Store extends Observable{
 
     // Required for backward compat
     getAutoStart(){
+        if(!Store.uploaderIdentified()){
+            return false;
+        }
         return Configs.getInstance().getAutoStart();
     }
 
+    /**
+     * File requests require the uploader name and email before sending anything
+     * @return {boolean}
+     */
+    static uploaderIdentified(){
+        return !FileRequestUploader.isRequired(Pydio.getInstance()) || FileRequestUploader.isComplete();
+    }
+
     static openUploadDialog(confirm = false){
         if(confirm){
             Pydio.getInstance().getController().fireAction("upload", {confirmDialog: true});
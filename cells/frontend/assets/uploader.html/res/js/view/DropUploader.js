@@ -26,7 +26,8 @@ import UploadOptionsPane from './UploadOptionsPane'
 import ClearOptionsPane from './ClearOptionsPane'
 import TransfersList from './TransfersList'
 import ConfirmExists from './ConfirmExists'
-import {Paper, Toolbar, RaisedButton, FlatButton, IconButton, FontIcon} from 'material-ui'
+import FileRequestUploader from 'pydio/http/file-request-uploader'
+import {Paper, Toolbar, RaisedButton, FlatButton, IconButton, FontIcon, TextField} from 'material-ui'
 
 class DropUploader extends React.Component {
     
@@ -52,6 +53,7 @@ class DropUploader extends React.Component {
             sessions: store.getSessions(),
             storeRunning: store.isRunning(),
             confirmDialog: props.confirmDialog,
+            uploader: FileRequestUploader.getIdentity(),
         }; 
     }
 
@@ -80,9 +82,18 @@ class DropUploader extends React.Component {
 
     start(e){
         e.preventDefault();
+        if(!UploaderModel.Store.uploaderIdentified()){
+            return;
+        }
         UploaderModel.Store.getInstance().resume();
     }
 
+    updateUploader(field, value){
+        const uploader = {...this.state.uploader, [field]: value};
+        FileRequestUploader.setIdentity(uploader.name, uploader.email);
+        this.setState({uploader});
+    }
+
     pause(e){
         UploaderModel.Store.getInstance().pause()
     }
@@ -162,7 +173,7 @@ class DropUploader extends React.Component {
         let messages = Pydio.getInstance().MessageHash;
         const {showDismiss, onDismiss} = this.props;
         const connectDropTarget = this.props.connectDropTarget || (c => {return c});
-        const {configs, showOptions, optionsAnchorEl, showClear, clearAnchorEl, sessions, storeRunning, confirmDialog} = this.state;
+        const {configs, showOptions, optionsAnchorEl, showClear, clearAnchorEl, sessions, storeRunning, confirmDialog, uploader} = this.state;
         const store = UploaderModel.Store.getInstance();
 
         let listEmpty = true;
@@ -176,7 +187,7 @@ class DropUploader extends React.Component {
             <div style={{position:'relative'}}>
                 <div style={{position: 'relative', display:'flex', alignItems:'center', paddingLeft: 16, paddingRight: 16, paddingTop: 8, width: '100%'}}>
                     <h3 style={{marginBottom: 16, display:'none'}}>{messages['html_uploader.dialog.title']}</h3>
-                    <FlatButton icon={<FontIcon style={{fontSize:16}} className="mdi mdi-play"/>} label={messages['html_uploader.start']} onClick={this.start.bind(this)} disabled={store.isRunning() || !store.hasQueue()}/>
+                    <FlatButton icon={<FontIcon style={{fontSize:16}} className="mdi mdi-play"/>} label={messages['html_uploader.start']} onClick={this.start.bind(this)} disabled={store.isRunning() || !store.hasQueue() || !UploaderModel.Store.uploaderIdentified()}/>
                     <FlatButton icon={<FontIcon style={{fontSize:16}} className="mdi mdi-pause"/>} label={messages['html_uploader.pause']} onClick={this.pause.bind(this)} disabled={!store.isRunning()}/>
                     <FlatButton icon={<FontIcon style={{fontSize:16}} className="mdi mdi-delete"/>} label={<span>{messages['html_uploader.clear']}<span className={"mdi mdi-menu-down"}/></span>} onClick={this.openClear.bind(this)} disabled={listEmpty}/>
 
@@ -184,6 +195,24 @@ class DropUploader extends React.Component {
                     <FlatButton primary={true} label={messages['html_uploader.options']} onClick={this.toggleOptions.bind(this)}/>
                     {showDismiss && <IconButton iconClassName={"mdi mdi-close"} style={{padding:14}} onClick={()=>onDismiss()}/>}
                 </div>
+                {FileRequestUploader.isRequired(Pydio.getInstance()) &&
+                    <div style={{display:'flex', alignItems:'baseline', paddingLeft: 16, paddingRight: 16}}>
+                        <span style={{flex: 1}}>{messages['html_uploader.file-request.legend']}</span>
+                        <TextField
+                            style={{marginLeft: 8, width: 180}}
+                            hintText={messages['html_uploader.file-request.name']}
+                            value={uploader.name}
+                            onChange={(e, v) => this.updateUploader('name', v)}
+                        />
+                        <TextField
+                            style={{marginLeft: 8, width: 220}}
+                            type={"email"}
+                            hintText={messages['html_uploader.file-request.email']}
+                            value={uploader.email}
+                            onChange={(e, v) => this.updateUploader('email', v)}
+                        />
+                    </div>
+                }
                 <FileDropZone
                     className="transparent-dropzone"
                     ref="dropzone"
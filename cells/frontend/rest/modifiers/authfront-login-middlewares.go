@@ -32,6 +32,7 @@ import (
 	"google.golang.org/protobuf/types/known/anypb"
 
 	"github.com/pydio/cells/v5/common"
+	"github.com/pydio/cells/v5/common/auth"
 	"github.com/pydio/cells/v5/common/broker"
 	"github.com/pydio/cells/v5/common/client/grpc"
 	"github.com/pydio/cells/v5/common/errors"
@@ -44,6 +45,7 @@ import (
 	"github.com/pydio/cells/v5/common/telemetry/tracing"
 	"github.com/pydio/cells/v5/common/utils/i18n/languages"
 	json "github.com/pydio/cells/v5/common/utils/jsonx"
+	"github.com/pydio/cells/v5/idm/share"
 )
 
 // LoginSuccessWrapper wraps functionalities after user was successfully logged in
@@ -88,6 +90,18 @@ func LoginSuccessWrapper(middleware frontend.AuthMiddleware) frontend.AuthMiddle
 				log.Logger(ctx).Error("Denied login for hidden user " + user.Login + " on main interface")
 				return errors.WithStack(errors.LoginNotAllowed) // serviceerrors.Unauthorized("hidden.user.nominisite", "You are not allowed to log in to this interface")
 			}
+			// Public link users cannot log in before the link start date
+			if user.IsHidden() {
+				if linkData, er := share.NewClient(nil).SearchHashDocumentForUser(ctx, user.Login); er == nil && linkData != nil && linkData.IsPending(time.Now()) {
+					log.Auditer(ctx).Error(
+						"Hidden user ["+user.Login+"] tried to log in before link start date",
+						log.GetAuditId(common.AuditLoginPolicyDenial),
+						zap.String(common.KeyUserUuid, user.Uuid),
+						zap.String(common.KeyWorkspaceUuid, linkData.RepositoryId),
+					)
+					return errors.WithMessage(errors.LoginNotAllowed, "this link is not active yet")
+				}
+			}
 		}
 
 		// Checking user is locked
@@ -191,7 +205,7 @@ func LoginFailedWrapper(middleware frontend.AuthMiddleware) frontend.AuthMiddlew
 
 		username := in.AuthInfo["login"]
 
-		const maxFailedLogins = 10
+		maxFailedLogins := int64(auth.LoadPasswordPolicy(ctx).MaxFailedLogins)
 
 		// Searching user for attributes
 		user, _ := permissions.SearchUniqueUser(ctx, username, "")
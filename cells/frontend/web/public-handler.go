@@ -49,7 +49,9 @@ import (
 	"github.com/pydio/cells/v5/common/nodes"
 	"github.com/pydio/cells/v5/common/nodes/abstract"
 	"github.com/pydio/cells/v5/common/nodes/acl"
+	"github.com/pydio/cells/v5/common/nodes/events"
 	"github.com/pydio/cells/v5/common/nodes/models"
+	"github.com/pydio/cells/v5/common/nodes/watermark"
 	"github.com/pydio/cells/v5/common/permissions"
 	"github.com/pydio/cells/v5/common/proto/docstore"
 	"github.com/pydio/cells/v5/common/proto/idm"
@@ -117,14 +119,20 @@ func (h *PublicHandler) computeTplConf(req *http.Request, linkId string) (status
 		}
 	}
 
+	// Check start time
+	if linkData.IsPending(time.Now()) {
+		tplConf.ErrorMessage = "This link is not active yet, it will be available on " + time.Unix(linkData.StartTime, 0).UTC().Format("2006-01-02 15:04 MST") + "."
+		return 403, tplConf, linkData
+	}
+
 	// Check expiration time
-	if linkData.ExpireTime > 0 && time.Now().After(time.Unix(linkData.ExpireTime, 0)) {
+	if linkData.IsExpired(time.Now()) {
 		tplConf.ErrorMessage = "This link has expired. Please contact the person who sent it to you."
 		return 404, tplConf, linkData
 	}
 
 	// Check number of downloads
-	if linkData.DownloadLimit > 0 && linkData.DownloadCount >= linkData.DownloadLimit {
+	if linkData.IsExhausted() {
 		tplConf.ErrorMessage = "This link has expired (number of maximum downloads has been reached)."
 		return 404, tplConf, linkData
 	}
@@ -220,6 +228,9 @@ func (h *PublicHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	h.recordView(r, linkData)
+	h.bindRecipient(w, r, linkData)
+
 	w.Header().Set("Content-Type", "text/html; charset=utf8")
 	for hK, hV := range config.Get(r.Context(), "frontend", "secureHeaders").StringMap() {
 		w.Header().Set(hK, hV)
@@ -237,6 +248,42 @@ func (h *PublicHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 	_ = h.tpl.Execute(w, tplConf)
 }
 
+// recordView stores a "view" access for the link analytics
+func (h *PublicHandler) recordView(r *http.Request, linkData *docstore.ShareDocument) {
+	remote := events.LinkAccessRemote(r.Context(), r.RemoteAddr, r.Header.Values("X-Forwarded-For"))
+	access := &docstore.LinkAccess{
+		LinkUuid:  linkData.RepositoryId,
+		Type:      docstore.LinkAccessView,
+		UserAgent: r.UserAgent(),
+	}
+	go events.RecordLinkAccess(propagator.ForkedBackgroundWithMeta(r.Context()), access, remote)
+}
+
+// bindRecipient remembers which target user opened a watermarked link, so that their name is stamped on files
+func (h *PublicHandler) bindRecipient(w http.ResponseWriter, r *http.Request, linkData *docstore.ShareDocument) {
+	targetId := r.URL.Query().Get("u")
+	if !linkData.Watermark || targetId == "" {
+		return
+	}
+	if _, ok := linkData.TargetUsers[targetId]; !ok {
+		return
+	}
+	token, er := watermark.BindRecipient(r.Context(), linkData.RepositoryId, targetId)
+	if er != nil {
+		log.Logger(r.Context()).Warn("Cannot bind link recipient", zap.Error(er))
+		return
+	}
+	http.SetCookie(w, &http.Cookie{
+		Name:     watermark.RecipientCookie,
+		Value:    token,
+		Path:     "/",
+		MaxAge:   int((24 * time.Hour).Seconds()),
+		HttpOnly: true,
+		Secure:   r.TLS != nil || r.Header.Get("X-Forwarded-Proto") == "https",
+		SameSite: http.SameSiteLaxMode,
+	})
+}
+
 // ServeDAV forwards requests to a DAV handler (see gateway/dav package)
 func (h *PublicHandler) ServeDAV(w http.ResponseWriter, r *http.Request, linkId string, linkData *docstore.ShareDocument, inputPath string) error {
 
@@ -21,13 +21,16 @@
 package restv2
 
 import (
+	"net/http"
 	"slices"
+	"strconv"
 
 	restful "github.com/emicklei/go-restful/v3"
 
 	"github.com/pydio/cells/v5/common/errors"
 	"github.com/pydio/cells/v5/common/proto/rest"
 	"github.com/pydio/cells/v5/common/proto/tree"
+	"github.com/pydio/cells/v5/idm/share"
 )
 
 // CreatePublicLink responds to POST /node/link/{Uuid}/link
@@ -116,6 +119,34 @@ func (h *Handler) DeletePublicLink(req *restful.Request, resp *restful.Response)
 	})
 }
 
+// GetPublicLinkAnalytics responds to GET /node/link/{LinkUuid}/analytics
+// Output rest.PublicLinkAnalytics, or a CSV file if Format=csv
+func (h *Handler) GetPublicLinkAnalytics(req *restful.Request, resp *restful.Response) error {
+	uuid := req.PathParameter("LinkUuid")
+	ctx := req.Request.Context()
+
+	if req.QueryParameter("Format") != "csv" {
+		offset, _ := strconv.ParseInt(req.QueryParameter("Offset"), 10, 64)
+		limit, _ := strconv.ParseInt(req.QueryParameter("Limit"), 10, 64)
+		if limit <= 0 {
+			limit = 100
+		}
+		an, er := h.SharesHandler.GetShareClient().LinkAnalytics(ctx, uuid, offset, limit)
+		if er != nil {
+			return er
+		}
+		return resp.WriteEntity(an)
+	}
+	an, er := h.SharesHandler.GetShareClient().LinkAnalytics(ctx, uuid, 0, 0)
+	if er != nil {
+		return er
+	}
+	resp.Header().Set("Content-Type", "text/csv; charset=utf-8")
+	resp.Header().Set("Content-Disposition", "attachment; filename=\"link-"+uuid+"-analytics.csv\"")
+	resp.WriteHeader(http.StatusOK)
+	return share.WriteLinkAnalyticsCSV(resp, an)
+}
+
 func (h *Handler) linkTemplateName(link *rest.ShareLink, node *tree.Node) string {
 	// If a custom value is used, ignore
 	if link.ViewTemplateName != "" && !slices.Contains([]string{"pydio_unique_strip", "pydio_unique_dl", "pydio_shared_folder"}, link.ViewTemplateName) {
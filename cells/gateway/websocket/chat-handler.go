@@ -356,6 +356,27 @@ func (c *ChatHandler) initHandlers() {
 				}
 			}
 
+		case chat.WsMessageType_REACT:
+
+			log.Logger(ct).Debug("React", zap.Any("msg", chatMsg))
+			if chatMsg.Message == nil {
+				break
+			}
+			if room, found := c.roomInSession(ct, session, chatMsg.Message.RoomUuid); !found || room.readonly {
+				log.Logger(ct).Error("Not authorized to react in this room")
+				break
+			}
+			_, e := chatClient.ReactMessage(ct, &chat.ReactMessageRequest{
+				RoomUuid:    chatMsg.Message.RoomUuid,
+				MessageUuid: chatMsg.Message.Uuid,
+				Emoji:       chatMsg.Reaction,
+				User:        userName,
+				Remove:      chatMsg.Remove,
+			})
+			if e != nil {
+				log.Logger(ct).Error("Error while reacting to message", zap.Any("msg", chatMsg), zap.Error(e))
+			}
+
 		}
 
 	})
@@ -0,0 +1,121 @@
+/*
+ * Copyright (c) 2025 Abstrium SAS <team (at) pydio.com>
+ * This file is part of Pydio Cells.
+ *
+ * Pydio Cells is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * Pydio Cells is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with Pydio Cells.  If not, see <http://www.gnu.org/licenses/>.
+ *
+ * The latest code can be found at <https://pydio.com>.
+ */
+
+package rest
+
+import (
+	"sort"
+
+	restful "github.com/emicklei/go-restful/v3"
+	"go.uber.org/zap"
+
+	"github.com/pydio/cells/v5/common"
+	"github.com/pydio/cells/v5/common/auth/claim"
+	"github.com/pydio/cells/v5/common/errors"
+	"github.com/pydio/cells/v5/common/permissions"
+	"github.com/pydio/cells/v5/common/proto/idm"
+	"github.com/pydio/cells/v5/common/proto/rest"
+	"github.com/pydio/cells/v5/common/proto/tree"
+	"github.com/pydio/cells/v5/common/telemetry/log"
+)
+
+// ExplainAcl computes the effective permissions of a user on a node and returns every ACL, role,
+// workspace and policy that contributed to the result.
+func (a *Handler) ExplainAcl(req *restful.Request, rsp *restful.Response) error {
+
+	ctx := req.Request.Context()
+	if claims, ok := claim.FromContext(ctx); !ok || claims.Profile != common.PydioProfileAdmin {
+		return errors.WithMessage(errors.StatusForbidden, "only admins can explain permissions")
+	}
+	var input rest.ExplainACLRequest
+	if err := req.ReadEntity(&input); err != nil {
+		return err
+	}
+	if input.Login == "" && input.UserUuid == "" {
+		return errors.WithMessage(errors.InvalidParameters, "please provide a user login or uuid")
+	}
+	if input.Path == "" && input.NodeUuid == "" {
+		return errors.WithMessage(errors.InvalidParameters, "please provide a node path or uuid")
+	}
+	log.Logger(ctx).Debug("Received ACL.Explain API request", zap.Any("request", &input))
+
+	node := &tree.Node{Uuid: input.NodeUuid, Path: input.Path}
+	ex, user, er := permissions.ExplainUserAccess(ctx, input.Login, input.UserUuid, node, input.Scopes...)
+	if er != nil {
+		return er
+	}
+	return rsp.WriteEntity(explanationToRest(ex, user))
+}
+
+func explanationToRest(ex *permissions.Explanation, user *idm.User) *rest.ExplainACLResponse {
+	resp := &rest.ExplainACLResponse{
+		User:        user,
+		Permissions: ex.Permissions(),
+		Roles:       ex.Roles,
+		ScopesDeny:  ex.ScopesDeny,
+		Trace:       ex.Trace,
+	}
+	if len(ex.Nodes) > 0 {
+		resp.Node = ex.Nodes[0].Node
+	}
+	if ex.DeniedBy != nil {
+		resp.DeniedBy = ex.DeniedBy.GetUuid()
+	}
+	for _, n := range ex.Nodes {
+		en := &rest.ExplainedNode{
+			Node:        n.Node,
+			Permissions: permissions.FlagNames(n.Mask.BitmaskFlag),
+			Deny:        n.Deny,
+			Applied:     n.Applied,
+		}
+		for id := range n.Mask.PolicyIds {
+			en.PolicyIds = append(en.PolicyIds, id)
+		}
+		sort.Strings(en.PolicyIds)
+		for _, acl := range n.ACLs {
+			en.ACLs = append(en.ACLs, &rest.ExplainedACL{
+				Action:      acl.Action,
+				Value:       acl.Value,
+				WorkspaceId: acl.WorkspaceId,
+				RoleId:      acl.RoleId,
+				RoleLabel:   acl.RoleLabel,
+				RoleType:    acl.RoleType,
+				Overridden:  acl.Overridden,
+			})
+		}
+		resp.Nodes = append(resp.Nodes, en)
+	}
+	for _, w := range ex.Workspaces {
+		resp.Workspaces = append(resp.Workspaces, &rest.ExplainedWorkspace{
+			Workspace: w.Workspace,
+			RootUuid:  w.RootUuid,
+			RootPath:  w.RootPath,
+		})
+	}
+	for _, p := range ex.Policies {
+		resp.Policies = append(resp.Policies, &rest.ExplainedPolicy{
+			PolicyId:     p.PolicyId,
+			Permission:   p.Permission,
+			Allowed:      p.Allowed,
+			ExplicitDeny: p.ExplicitDeny,
+		})
+	}
+	return resp
+}
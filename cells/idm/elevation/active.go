@@ -0,0 +1,104 @@
+/*
+ * Copyright (c) 2025 Abstrium SAS <team (at) pydio.com>
+ * This file is part of Pydio Cells.
+ *
+ * Pydio Cells is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * Pydio Cells is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with Pydio Cells.  If not, see <http://www.gnu.org/licenses/>.
+ *
+ * The latest code can be found at <https://pydio.com>.
+ */
+
+package elevation
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/pydio/cells/v5/common/proto/rest"
+	cw "github.com/pydio/cells/v5/common/telemetry/log/context-wrapper"
+)
+
+const (
+	// activeRefresh is the maximum age of the active elevations snapshot used to tag log entries
+	activeRefresh = 30 * time.Second
+)
+
+var active = &activeCache{}
+
+func init() {
+	cw.RegisterElevationsResolver(ActiveIds)
+}
+
+// activeCache keeps a snapshot of the active elevations indexed by user login.
+type activeCache struct {
+	sync.RWMutex
+	loading  sync.Mutex
+	byLogin  map[string][]*rest.Elevation
+	loadedAt time.Time
+}
+
+// ActiveIds returns the Uuids of the elevations currently active for a user, as known by the current snapshot.
+// Active elevations are reloaded in background at most every 30 seconds, or as soon as an elevation starts
+// or ends in this process.
+func ActiveIds(ctx context.Context, login string) (ids []string) {
+	if login == "" {
+		return
+	}
+	active.load(ctx)
+	active.RLock()
+	defer active.RUnlock()
+	now := time.Now()
+	for _, e := range active.byLogin[login] {
+		if !Expired(e, now) {
+			ids = append(ids, e.GetUuid())
+		}
+	}
+	return
+}
+
+// load triggers a background refresh of the snapshot if it is outdated. It never waits for the
+// docstore: callers, and logs emitted while loading, always use the current snapshot.
+func (a *activeCache) load(ctx context.Context) {
+	a.RLock()
+	fresh := time.Since(a.loadedAt) < activeRefresh
+	a.RUnlock()
+	if fresh || !a.loading.TryLock() {
+		return
+	}
+	go func() {
+		defer a.loading.Unlock()
+
+		ct, ca := context.WithTimeout(context.WithoutCancel(ctx), 5*time.Second)
+		defer ca()
+		ee, er := ListActive(ct)
+
+		a.Lock()
+		defer a.Unlock()
+		a.loadedAt = time.Now()
+		if er != nil {
+			return
+		}
+		a.byLogin = make(map[string][]*rest.Elevation, len(ee))
+		for _, e := range ee {
+			a.byLogin[e.GetUserLogin()] = append(a.byLogin[e.GetUserLogin()], e)
+		}
+	}()
+}
+
+// reset marks the snapshot as outdated, so that the next call triggers a reload.
+func (a *activeCache) reset() {
+	a.Lock()
+	a.loadedAt = time.Time{}
+	a.Unlock()
+}
@@ -0,0 +1,138 @@
+/*
+ * Copyright (c) 2025 Abstrium SAS <team (at) pydio.com>
+ * This file is part of Pydio Cells.
+ *
+ * Pydio Cells is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * Pydio Cells is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with Pydio Cells.  If not, see <http://www.gnu.org/licenses/>.
+ *
+ * The latest code can be found at <https://pydio.com>.
+ */
+
+// Package elevation implements time-boxed privilege elevations.
+//
+// A user requests a role for a limited duration with a justification. Once approved, the ACLs of the
+// requested role are copied to a temporary role attached to the user. These ACLs carry an expiration
+// date, so that rights vanish at the end of the elevation even before the temporary role is removed
+// by the actions.idm.elevations-expire action. Expired ACLs are finally purged by actions.idm.clean-expired-acl.
+package elevation
+
+import (
+	"context"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/pydio/cells/v5/common"
+	"github.com/pydio/cells/v5/common/config"
+	"github.com/pydio/cells/v5/common/errors"
+	"github.com/pydio/cells/v5/common/proto/idm"
+	"github.com/pydio/cells/v5/common/proto/rest"
+)
+
+const (
+	// DefaultMaxDuration is used when services/pydio.rest.elevation/maxDuration is not set
+	DefaultMaxDuration = 8 * time.Hour
+)
+
+// Config holds the elevation settings read from services/pydio.rest.elevation
+type Config struct {
+	// Approvers lists the logins allowed to approve requests. Administrators approve if empty.
+	Approvers []string
+	// Roles lists the roles that can be requested. Any role can be requested if empty.
+	Roles []string
+	// MaxDuration caps the requested duration
+	MaxDuration time.Duration
+}
+
+// LoadConfig reads elevation settings from the configuration.
+func LoadConfig(ctx context.Context) *Config {
+	c := &Config{MaxDuration: DefaultMaxDuration}
+	v := config.Get(ctx, "services", common.ServiceRestNamespace_+common.ServiceElevation)
+	c.Approvers = v.Val("approvers").StringArray()
+	c.Roles = v.Val("roles").StringArray()
+	if d, er := time.ParseDuration(v.Val("maxDuration").String()); er == nil && d > 0 {
+		c.MaxDuration = d
+	}
+	return c
+}
+
+// IsApprover checks if a user can decide on elevation requests.
+func (c *Config) IsApprover(login, profile string) bool {
+	if len(c.Approvers) > 0 {
+		return slices.Contains(c.Approvers, login)
+	}
+	return profile == common.PydioProfileAdmin
+}
+
+// Validate checks that the role can be requested and that the request is well-formed.
+func (c *Config) Validate(role *idm.Role, req *rest.CreateElevationRequest) error {
+	if role.GetUserRole() || role.GetGroupRole() || role.GetIsTeam() || strings.HasPrefix(role.GetUuid(), common.IdmElevationRolePrefix_) {
+		return errors.WithMessagef(errors.RoleNotAssignable, "role %s cannot be requested", role.GetLabel())
+	}
+	if len(c.Roles) > 0 && !slices.Contains(c.Roles, role.GetUuid()) {
+		return errors.WithMessagef(errors.RoleNotAssignable, "role %s is not in the list of roles that can be requested", role.GetLabel())
+	}
+	if strings.TrimSpace(req.GetJustification()) == "" {
+		return errors.WithMessage(errors.InvalidParameters, "please provide a justification")
+	}
+	if req.GetDuration() <= 0 {
+		return errors.WithMessage(errors.InvalidParameters, "please provide a duration")
+	}
+	if time.Duration(req.GetDuration())*time.Second > c.MaxDuration {
+		return errors.WithMessagef(errors.InvalidParameters, "duration cannot exceed %s", c.MaxDuration)
+	}
+	if p := req.GetProfile(); p != "" && p != common.PydioProfileAdmin {
+		return errors.WithMessagef(errors.InvalidParameters, "profile %s cannot be requested", p)
+	}
+	return nil
+}
+
+// RoleID returns the identifier of the temporary role attached to the user.
+func RoleID(e *rest.Elevation) string {
+	return common.IdmElevationRolePrefix_ + e.GetUuid()
+}
+
+// CopyACLs prepares the ACLs of the requested role to be inserted on the temporary role.
+func CopyACLs(acls []*idm.ACL, roleId string) (out []*idm.ACL) {
+	for _, a := range acls {
+		out = append(out, &idm.ACL{
+			Action:      a.GetAction(),
+			RoleID:      roleId,
+			WorkspaceID: a.GetWorkspaceID(),
+			NodeID:      a.GetNodeID(),
+		})
+	}
+	return
+}
+
+// Expired checks if an active elevation has reached its end date.
+func Expired(e *rest.Elevation, now time.Time) bool {
+	return e.GetStatus() == rest.ElevationStatus_ElevationActive && int64(e.GetExpiresAt()) <= now.Unix()
+}
+
+// Filter applies the status and requester filters of a ListElevationsRequest.
+func Filter(ee []*rest.Elevation, req *rest.ListElevationsRequest) (out []*rest.Elevation) {
+	for _, e := range ee {
+		if req.GetUserLogin() != "" && e.GetUserLogin() != req.GetUserLogin() {
+			continue
+		}
+		if len(req.GetStatus()) > 0 && !slices.Contains(req.GetStatus(), e.GetStatus()) {
+			continue
+		}
+		out = append(out, e)
+	}
+	slices.SortFunc(out, func(a, b *rest.Elevation) int {
+		return int(b.GetCreatedAt() - a.GetCreatedAt())
+	})
+	return
+}
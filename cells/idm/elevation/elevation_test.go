@@ -0,0 +1,135 @@
+/*
+ * Copyright (c) 2025 Abstrium SAS <team (at) pydio.com>
+ * This file is part of Pydio Cells.
+ *
+ * Pydio Cells is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * Pydio Cells is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with Pydio Cells.  If not, see <http://www.gnu.org/licenses/>.
+ *
+ * The latest code can be found at <https://pydio.com>.
+ */
+
+package elevation
+
+import (
+	"testing"
+	"time"
+
+	"github.com/pydio/cells/v5/common"
+	"github.com/pydio/cells/v5/common/errors"
+	"github.com/pydio/cells/v5/common/proto/idm"
+	"github.com/pydio/cells/v5/common/proto/rest"
+
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestConfig(t *testing.T) {
+
+	Convey("Test approvers", t, func() {
+		c := &Config{MaxDuration: DefaultMaxDuration}
+		So(c.IsApprover("admin", common.PydioProfileAdmin), ShouldBeTrue)
+		So(c.IsApprover("user", common.PydioProfileStandard), ShouldBeFalse)
+
+		c.Approvers = []string{"manager"}
+		So(c.IsApprover("admin", common.PydioProfileAdmin), ShouldBeFalse)
+		So(c.IsApprover("manager", common.PydioProfileStandard), ShouldBeTrue)
+	})
+
+	Convey("Test requests validation", t, func() {
+		c := &Config{MaxDuration: time.Hour}
+		role := &idm.Role{Uuid: "ops", Label: "Ops"}
+		req := &rest.CreateElevationRequest{RoleId: "ops", Justification: "incident", Duration: 1800}
+		So(c.Validate(role, req), ShouldBeNil)
+
+		So(errors.Is(c.Validate(&idm.Role{Uuid: "u1", UserRole: true}, req), errors.RoleNotAssignable), ShouldBeTrue)
+		So(errors.Is(c.Validate(&idm.Role{Uuid: common.IdmElevationRolePrefix_ + "x"}, req), errors.RoleNotAssignable), ShouldBeTrue)
+
+		c.Roles = []string{"other"}
+		So(errors.Is(c.Validate(role, req), errors.RoleNotAssignable), ShouldBeTrue)
+		c.Roles = nil
+
+		So(errors.Is(c.Validate(role, &rest.CreateElevationRequest{Duration: 1800}), errors.InvalidParameters), ShouldBeTrue)
+		So(errors.Is(c.Validate(role, &rest.CreateElevationRequest{Justification: "incident"}), errors.InvalidParameters), ShouldBeTrue)
+		So(errors.Is(c.Validate(role, &rest.CreateElevationRequest{Justification: "incident", Duration: 7200}), errors.InvalidParameters), ShouldBeTrue)
+		So(c.Validate(role, &rest.CreateElevationRequest{Justification: "incident", Duration: 1800, Profile: common.PydioProfileAdmin}), ShouldBeNil)
+		So(errors.Is(c.Validate(role, &rest.CreateElevationRequest{Justification: "incident", Duration: 1800, Profile: common.PydioProfileShared}), errors.InvalidParameters), ShouldBeTrue)
+	})
+
+}
+
+func TestElevations(t *testing.T) {
+
+	Convey("Test ACLs copy", t, func() {
+		e := &rest.Elevation{Uuid: "abc"}
+		acls := CopyACLs([]*idm.ACL{
+			{ID: "1", RoleID: "ops", NodeID: "n1", WorkspaceID: "ws", Action: &idm.ACLAction{Name: "read", Value: "1"}},
+			{ID: "2", RoleID: "ops", NodeID: "n1", WorkspaceID: "ws", Action: &idm.ACLAction{Name: "write", Value: "1"}},
+		}, RoleID(e))
+		So(acls, ShouldHaveLength, 2)
+		for _, a := range acls {
+			So(a.ID, ShouldBeEmpty)
+			So(a.RoleID, ShouldEqual, common.IdmElevationRolePrefix_+"abc")
+			So(a.NodeID, ShouldEqual, "n1")
+			So(a.WorkspaceID, ShouldEqual, "ws")
+		}
+	})
+
+	Convey("Test expiration", t, func() {
+		now := time.Now()
+		e := &rest.Elevation{Status: rest.ElevationStatus_ElevationActive, ExpiresAt: int32(now.Add(-time.Minute).Unix())}
+		So(Expired(e, now), ShouldBeTrue)
+		e.ExpiresAt = int32(now.Add(time.Minute).Unix())
+		So(Expired(e, now), ShouldBeFalse)
+		e.ExpiresAt = int32(now.Add(-time.Minute).Unix())
+		e.Status = rest.ElevationStatus_ElevationRevoked
+		So(Expired(e, now), ShouldBeFalse)
+	})
+
+	Convey("Test profile restoration", t, func() {
+		first := &rest.Elevation{Uuid: "1", UserLogin: "alice", Status: rest.ElevationStatus_ElevationActive, Profile: common.PydioProfileAdmin}
+		first.PreviousProfile = PreviousProfile(nil, first, "")
+		So(first.PreviousProfile, ShouldEqual, common.PydioProfileStandard)
+
+		// A second overlapping elevation must not record the elevated profile as the one to restore
+		second := &rest.Elevation{Uuid: "2", UserLogin: "alice", Status: rest.ElevationStatus_ElevationActive, Profile: common.PydioProfileAdmin}
+		second.PreviousProfile = PreviousProfile([]*rest.Elevation{first}, second, common.PydioProfileAdmin)
+		So(second.PreviousProfile, ShouldEqual, common.PydioProfileStandard)
+
+		_, ok := RestoredProfile([]*rest.Elevation{first, second}, first)
+		So(ok, ShouldBeFalse)
+		first.Status = rest.ElevationStatus_ElevationExpired
+		p, ok := RestoredProfile([]*rest.Elevation{first, second}, second)
+		So(ok, ShouldBeTrue)
+		So(p, ShouldEqual, common.PydioProfileStandard)
+
+		_, ok = RestoredProfile(nil, &rest.Elevation{Uuid: "3", UserLogin: "alice"})
+		So(ok, ShouldBeFalse)
+	})
+
+	Convey("Test filter", t, func() {
+		ee := []*rest.Elevation{
+			{Uuid: "1", UserLogin: "alice", Status: rest.ElevationStatus_ElevationPending, CreatedAt: 10},
+			{Uuid: "2", UserLogin: "bob", Status: rest.ElevationStatus_ElevationActive, CreatedAt: 30},
+			{Uuid: "3", UserLogin: "alice", Status: rest.ElevationStatus_ElevationActive, CreatedAt: 20},
+		}
+		all := Filter(ee, &rest.ListElevationsRequest{})
+		So(all, ShouldHaveLength, 3)
+		So(all[0].Uuid, ShouldEqual, "2")
+		So(all[2].Uuid, ShouldEqual, "1")
+
+		So(Filter(ee, &rest.ListElevationsRequest{UserLogin: "alice"}), ShouldHaveLength, 2)
+		active := Filter(ee, &rest.ListElevationsRequest{Status: []rest.ElevationStatus{rest.ElevationStatus_ElevationActive}})
+		So(active, ShouldHaveLength, 2)
+		So(Filter(ee, &rest.ListElevationsRequest{UserLogin: "alice", Status: []rest.ElevationStatus{rest.ElevationStatus_ElevationActive}}), ShouldHaveLength, 1)
+	})
+
+}
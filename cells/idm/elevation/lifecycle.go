@@ -0,0 +1,277 @@
+/*
+ * Copyright (c) 2025 Abstrium SAS <team (at) pydio.com>
+ * This file is part of Pydio Cells.
+ *
+ * Pydio Cells is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * Pydio Cells is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with Pydio Cells.  If not, see <http://www.gnu.org/licenses/>.
+ *
+ * The latest code can be found at <https://pydio.com>.
+ */
+
+package elevation
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"time"
+
+	"go.uber.org/zap"
+	"google.golang.org/protobuf/types/known/anypb"
+
+	"github.com/pydio/cells/v5/common"
+	"github.com/pydio/cells/v5/common/client/commons/idmc"
+	"github.com/pydio/cells/v5/common/errors"
+	"github.com/pydio/cells/v5/common/permissions"
+	"github.com/pydio/cells/v5/common/proto/idm"
+	"github.com/pydio/cells/v5/common/proto/rest"
+	"github.com/pydio/cells/v5/common/proto/service"
+	"github.com/pydio/cells/v5/common/telemetry/log"
+)
+
+// LoadRole finds a role by its Uuid.
+func LoadRole(ctx context.Context, roleId string) (*idm.Role, error) {
+	roles, er := permissions.GetRoles(ctx, []string{roleId})
+	if er != nil {
+		return nil, er
+	}
+	if len(roles) == 0 {
+		return nil, errors.WithMessagef(errors.RoleNotFound, "cannot find role %s", roleId)
+	}
+	return roles[0], nil
+}
+
+// Activate approves a pending elevation: the ACLs of the requested role are copied to a temporary role
+// that expires at the end of the elevation, and this role is appended to the user roles. If the elevation
+// requests a profile, it is set on the user and the previous profile is kept for End to restore it.
+func Activate(ctx context.Context, e *rest.Elevation, approver, comment string) error {
+	if e.GetStatus() != rest.ElevationStatus_ElevationPending {
+		return errors.WithMessagef(errors.StatusPreconditionFailed, "elevation is %s and cannot be approved", e.GetStatus().String())
+	}
+	now := time.Now()
+	expires := now.Add(time.Duration(e.GetDuration()) * time.Second)
+	roleId := RoleID(e)
+
+	aclClient := idmc.ACLServiceClient(ctx)
+	q, _ := anypb.New(&idm.ACLSingleQuery{RoleIDs: []string{e.GetRoleId()}})
+	st, er := aclClient.SearchACL(ctx, &idm.SearchACLRequest{Query: &service.Query{SubQueries: []*anypb.Any{q}}})
+	if er != nil {
+		return er
+	}
+	var acls []*idm.ACL
+	for {
+		resp, e := st.Recv()
+		if e == io.EOF {
+			break
+		} else if e != nil {
+			return e
+		}
+		acls = append(acls, resp.GetACL())
+	}
+
+	if _, er = idmc.RoleServiceClient(ctx).CreateRole(ctx, &idm.CreateRoleRequest{Role: &idm.Role{
+		Uuid:        roleId,
+		Label:       fmt.Sprintf("%s (elevation of %s)", e.GetRoleLabel(), e.GetUserLogin()),
+		LastUpdated: int32(now.Unix()),
+	}}); er != nil {
+		return er
+	}
+	if copies := CopyACLs(acls, roleId); len(copies) > 0 {
+		if _, er = aclClient.CreateACL(ctx, &idm.CreateACLRequest{Batch: copies}); er != nil {
+			return er
+		}
+		eq, _ := anypb.New(&idm.ACLSingleQuery{RoleIDs: []string{roleId}})
+		if _, er = aclClient.ExpireACL(ctx, &idm.ExpireACLRequest{
+			Query:     &service.Query{SubQueries: []*anypb.Any{eq}},
+			Timestamp: expires.Unix(),
+		}); er != nil {
+			return er
+		}
+	}
+	var others []*rest.Elevation
+	if e.GetProfile() != "" {
+		if others, er = ListActive(ctx); er != nil {
+			return er
+		}
+	}
+	if er = updateUser(ctx, e.GetUserLogin(), func(u *idm.User) {
+		setRole(u, roleId, true)
+		if e.GetProfile() != "" {
+			e.PreviousProfile = PreviousProfile(others, e, u.GetAttributes()[idm.UserAttrProfile])
+			u.Attributes[idm.UserAttrProfile] = e.GetProfile()
+		}
+	}); er != nil {
+		return er
+	}
+	active.reset()
+
+	e.Status = rest.ElevationStatus_ElevationActive
+	e.Approver = approver
+	e.Comment = comment
+	e.DecidedAt = int32(now.Unix())
+	e.ExpiresAt = int32(expires.Unix())
+	if er = Put(ctx, e); er != nil {
+		return er
+	}
+	DeleteTokens(ctx, e)
+	log.Auditer(ctx).Info(
+		fmt.Sprintf("Approved elevation of [%s] to role [%s] until %s", e.GetUserLogin(), e.GetRoleLabel(), expires.Format(time.RFC3339)),
+		log.GetAuditId(common.AuditElevationApprove),
+		zap.String(common.KeyElevationId, e.GetUuid()),
+		zap.String(common.KeyRoleUuid, e.GetRoleId()),
+		zap.String("Approver", approver),
+	)
+	return nil
+}
+
+// Reject closes a pending elevation without granting anything.
+func Reject(ctx context.Context, e *rest.Elevation, approver, comment string) error {
+	if e.GetStatus() != rest.ElevationStatus_ElevationPending {
+		return errors.WithMessagef(errors.StatusPreconditionFailed, "elevation is %s and cannot be rejected", e.GetStatus().String())
+	}
+	e.Status = rest.ElevationStatus_ElevationRejected
+	e.Approver = approver
+	e.Comment = comment
+	e.DecidedAt = int32(time.Now().Unix())
+	if er := Put(ctx, e); er != nil {
+		return er
+	}
+	DeleteTokens(ctx, e)
+	log.Auditer(ctx).Info(
+		fmt.Sprintf("Rejected elevation of [%s] to role [%s]", e.GetUserLogin(), e.GetRoleLabel()),
+		log.GetAuditId(common.AuditElevationReject),
+		zap.String(common.KeyElevationId, e.GetUuid()),
+		zap.String(common.KeyRoleUuid, e.GetRoleId()),
+		zap.String("Approver", approver),
+	)
+	return nil
+}
+
+// End terminates an active elevation, either because it expired or because it was revoked.
+// ACLs of the temporary role are expired immediately, then the role is detached and deleted. A granted
+// profile is restored, unless another active elevation of the user still grants one.
+func End(ctx context.Context, e *rest.Elevation, status rest.ElevationStatus, by string) error {
+	if e.GetStatus() != rest.ElevationStatus_ElevationActive {
+		return errors.WithMessagef(errors.StatusPreconditionFailed, "elevation is %s and cannot be ended", e.GetStatus().String())
+	}
+	now := time.Now()
+	roleId := RoleID(e)
+	if int64(e.GetExpiresAt()) > now.Unix() {
+		eq, _ := anypb.New(&idm.ACLSingleQuery{RoleIDs: []string{roleId}})
+		if _, er := idmc.ACLServiceClient(ctx).ExpireACL(ctx, &idm.ExpireACLRequest{
+			Query:     &service.Query{SubQueries: []*anypb.Any{eq}},
+			Timestamp: now.Unix(),
+		}); er != nil {
+			return er
+		}
+		e.ExpiresAt = int32(now.Unix())
+	}
+	var others []*rest.Elevation
+	if e.GetProfile() != "" {
+		var er error
+		if others, er = ListActive(ctx); er != nil {
+			return er
+		}
+	}
+	if er := updateUser(ctx, e.GetUserLogin(), func(u *idm.User) {
+		setRole(u, roleId, false)
+		if p, ok := RestoredProfile(others, e); ok {
+			u.Attributes[idm.UserAttrProfile] = p
+		}
+	}); er != nil && !errors.Is(er, errors.UserNotFound) {
+		return er
+	}
+	active.reset()
+	rq, _ := anypb.New(&idm.RoleSingleQuery{Uuid: []string{roleId}})
+	if _, er := idmc.RoleServiceClient(ctx).DeleteRole(ctx, &idm.DeleteRoleRequest{
+		Query: &service.Query{SubQueries: []*anypb.Any{rq}},
+	}); er != nil {
+		return er
+	}
+	e.Status = status
+	if er := Put(ctx, e); er != nil {
+		return er
+	}
+	msg := fmt.Sprintf("Elevation of [%s] to role [%s] has expired", e.GetUserLogin(), e.GetRoleLabel())
+	if status == rest.ElevationStatus_ElevationRevoked {
+		msg = fmt.Sprintf("Elevation of [%s] to role [%s] was revoked by [%s]", e.GetUserLogin(), e.GetRoleLabel(), by)
+	}
+	log.Auditer(ctx).Info(
+		msg,
+		log.GetAuditId(common.AuditElevationEnd),
+		zap.String(common.KeyElevationId, e.GetUuid()),
+		zap.String(common.KeyRoleUuid, e.GetRoleId()),
+	)
+	return nil
+}
+
+// PreviousProfile finds the profile to restore at the end of an elevation granting a profile. If another
+// active elevation of the user already granted one, the profile it will restore is kept.
+func PreviousProfile(actives []*rest.Elevation, e *rest.Elevation, current string) string {
+	for _, o := range actives {
+		if o.GetUuid() != e.GetUuid() && o.GetUserLogin() == e.GetUserLogin() && o.GetProfile() != "" && o.GetPreviousProfile() != "" {
+			return o.GetPreviousProfile()
+		}
+	}
+	if current == "" {
+		return common.PydioProfileStandard
+	}
+	return current
+}
+
+// RestoredProfile returns the profile to set on the user when an elevation ends, if any.
+func RestoredProfile(actives []*rest.Elevation, e *rest.Elevation) (string, bool) {
+	if e.GetProfile() == "" {
+		return "", false
+	}
+	for _, o := range actives {
+		if o.GetUuid() != e.GetUuid() && o.GetUserLogin() == e.GetUserLogin() && o.GetStatus() == rest.ElevationStatus_ElevationActive && o.GetProfile() != "" {
+			return "", false
+		}
+	}
+	if e.GetPreviousProfile() == "" {
+		return common.PydioProfileStandard, true
+	}
+	return e.GetPreviousProfile(), true
+}
+
+// updateUser loads a user, applies the modifications and stores it in a single update.
+func updateUser(ctx context.Context, login string, modify func(u *idm.User)) error {
+	u, er := permissions.SearchUniqueUser(ctx, login, "")
+	if er != nil {
+		return er
+	}
+	if u.Attributes == nil {
+		u.Attributes = make(map[string]string)
+	}
+	modify(u)
+	if _, er = idmc.UserServiceClient(ctx).CreateUser(ctx, &idm.CreateUserRequest{User: u}); er != nil {
+		return er
+	}
+	permissions.ForceClearUserCache(ctx, login)
+	return nil
+}
+
+// setRole attaches or detaches a role from a user.
+func setRole(u *idm.User, roleId string, attach bool) {
+	var roles []*idm.Role
+	for _, r := range u.GetRoles() {
+		if r.GetUuid() != roleId {
+			roles = append(roles, r)
+		}
+	}
+	if attach {
+		roles = append(roles, &idm.Role{Uuid: roleId})
+	}
+	u.Roles = roles
+}
@@ -0,0 +1,123 @@
+/*
+ * Copyright (c) 2025 Abstrium SAS <team (at) pydio.com>
+ * This file is part of Pydio Cells.
+ *
+ * Pydio Cells is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * Pydio Cells is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with Pydio Cells.  If not, see <http://www.gnu.org/licenses/>.
+ *
+ * The latest code can be found at <https://pydio.com>.
+ */
+
+package elevation
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"time"
+
+	"go.uber.org/zap"
+	"google.golang.org/protobuf/types/known/anypb"
+
+	"github.com/pydio/cells/v5/common"
+	"github.com/pydio/cells/v5/common/client/commons/idmc"
+	"github.com/pydio/cells/v5/common/client/grpc"
+	"github.com/pydio/cells/v5/common/permissions"
+	"github.com/pydio/cells/v5/common/proto/idm"
+	"github.com/pydio/cells/v5/common/proto/mailer"
+	"github.com/pydio/cells/v5/common/proto/rest"
+	"github.com/pydio/cells/v5/common/proto/service"
+	"github.com/pydio/cells/v5/common/telemetry/log"
+	"github.com/pydio/cells/v5/common/utils/i18n/languages"
+)
+
+// NotifyApprovers sends the request to each approver by email, with links to approve or reject it.
+func NotifyApprovers(ctx context.Context, e *rest.Elevation, cfg *Config) {
+	var approvers []*idm.User
+	if len(cfg.Approvers) > 0 {
+		for _, login := range cfg.Approvers {
+			if u, er := permissions.SearchUniqueUser(ctx, login, ""); er == nil {
+				approvers = append(approvers, u)
+			}
+		}
+	} else {
+		q, _ := anypb.New(&idm.UserSingleQuery{AttributeName: idm.UserAttrProfile, AttributeValue: common.PydioProfileAdmin})
+		st, er := idmc.UserServiceClient(ctx).SearchUser(ctx, &idm.SearchUserRequest{Query: &service.Query{SubQueries: []*anypb.Any{q}}})
+		if er != nil {
+			log.Logger(ctx).Error("Cannot list administrators to notify about elevation request", zap.Error(er))
+			return
+		}
+		for {
+			resp, e := st.Recv()
+			if e == io.EOF || e != nil {
+				break
+			}
+			approvers = append(approvers, resp.GetUser())
+		}
+	}
+	for _, a := range approvers {
+		if a.GetLogin() == e.GetUserLogin() || a.GetAttributes()["email"] == "" {
+			continue
+		}
+		token, er := CreateToken(ctx, e, a.GetLogin())
+		if er != nil {
+			log.Logger(ctx).Error("Cannot create elevation approval token", zap.Error(er))
+			continue
+		}
+		send(ctx, a, "ElevationRequest", map[string]string{
+			"Requester":     e.GetUserLogin(),
+			"Role":          e.GetRoleLabel(),
+			"Profile":       e.GetProfile(),
+			"Duration":      (time.Duration(e.GetDuration()) * time.Second).String(),
+			"Justification": e.GetJustification(),
+			"LinkPath":      fmt.Sprintf("%s/elevation/token/%s", common.DefaultRouteREST, token),
+			"RejectPath":    fmt.Sprintf("%s/elevation/token/%s?Reject=true", common.DefaultRouteREST, token),
+		})
+	}
+}
+
+// NotifyDecision informs the requester that the elevation was approved or rejected.
+func NotifyDecision(ctx context.Context, e *rest.Elevation) {
+	u, er := permissions.SearchUniqueUser(ctx, e.GetUserLogin(), "")
+	if er != nil || u.GetAttributes()["email"] == "" {
+		return
+	}
+	data := map[string]string{
+		"Role":     e.GetRoleLabel(),
+		"Approver": e.GetApprover(),
+		"Comment":  e.GetComment(),
+	}
+	if e.GetStatus() == rest.ElevationStatus_ElevationActive {
+		data["Expires"] = time.Unix(int64(e.GetExpiresAt()), 0).Format("2006-01-02 15:04")
+		send(ctx, u, "ElevationApproved", data)
+	} else {
+		send(ctx, u, "ElevationRejected", data)
+	}
+}
+
+func send(ctx context.Context, u *idm.User, templateId string, data map[string]string) {
+	if _, e := mailer.NewMailerServiceClient(grpc.ResolveConn(ctx, common.ServiceMailerGRPC)).SendMail(ctx, &mailer.SendMailRequest{
+		Mail: &mailer.Mail{
+			To: []*mailer.User{{
+				Uuid:     u.GetUuid(),
+				Name:     u.GetAttributes()["displayName"],
+				Address:  u.GetAttributes()["email"],
+				Language: languages.UserLanguage(ctx, u),
+			}},
+			TemplateId:   templateId,
+			TemplateData: data,
+		},
+	}); e != nil {
+		log.Logger(ctx).Error("Cannot send elevation email to "+u.GetLogin(), zap.Error(e))
+	}
+}
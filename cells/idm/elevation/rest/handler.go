@@ -0,0 +1,327 @@
+/*
+ * Copyright (c) 2025 Abstrium SAS <team (at) pydio.com>
+ * This file is part of Pydio Cells.
+ *
+ * Pydio Cells is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * Pydio Cells is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with Pydio Cells.  If not, see <http://www.gnu.org/licenses/>.
+ *
+ * The latest code can be found at <https://pydio.com>.
+ */
+
+// Package rest exposes privilege elevation requests and approvals.
+package rest
+
+import (
+	"context"
+	"fmt"
+	"html/template"
+	"net/http"
+	"strings"
+	"time"
+
+	restful "github.com/emicklei/go-restful/v3"
+	"go.uber.org/zap"
+
+	"github.com/pydio/cells/v5/common"
+	"github.com/pydio/cells/v5/common/auth/claim"
+	"github.com/pydio/cells/v5/common/errors"
+	"github.com/pydio/cells/v5/common/permissions"
+	"github.com/pydio/cells/v5/common/proto/idm"
+	"github.com/pydio/cells/v5/common/proto/rest"
+	"github.com/pydio/cells/v5/common/telemetry/log"
+	"github.com/pydio/cells/v5/idm/elevation"
+)
+
+// ElevationHandler responds to privilege elevations REST requests
+type ElevationHandler struct {
+	RuntimeCtx context.Context
+}
+
+func NewElevationHandler(ctx context.Context) *ElevationHandler {
+	return &ElevationHandler{RuntimeCtx: ctx}
+}
+
+// SwaggerTags list the names of the service tags declared in the swagger json implemented by this service
+func (h *ElevationHandler) SwaggerTags() []string {
+	return []string{"ElevationService"}
+}
+
+// Filter returns a function to filter the swagger path
+func (h *ElevationHandler) Filter() func(string) string {
+	return nil
+}
+
+// RequestElevation creates a pending elevation for the current user and notifies approvers
+func (h *ElevationHandler) RequestElevation(req *restful.Request, rsp *restful.Response) error {
+	ctx := req.Request.Context()
+	claims, er := userClaims(ctx)
+	if er != nil {
+		return er
+	}
+	var input rest.CreateElevationRequest
+	if e := req.ReadEntity(&input); e != nil {
+		return e
+	}
+	role, er := elevation.LoadRole(ctx, input.GetRoleId())
+	if er != nil {
+		return er
+	}
+	cfg := elevation.LoadConfig(ctx)
+	if er = cfg.Validate(role, &input); er != nil {
+		return er
+	}
+	existing, er := elevation.List(ctx, claims.Name)
+	if er != nil {
+		return er
+	}
+	for _, e := range existing {
+		if e.GetRoleId() == role.GetUuid() && (e.GetStatus() == rest.ElevationStatus_ElevationPending || e.GetStatus() == rest.ElevationStatus_ElevationActive) {
+			return errors.WithMessagef(errors.StatusConflict, "there is already a pending or active elevation for role %s", role.GetLabel())
+		}
+	}
+	el := &rest.Elevation{
+		UserLogin:     claims.Name,
+		UserUuid:      claims.Subject,
+		RoleId:        role.GetUuid(),
+		RoleLabel:     role.GetLabel(),
+		Justification: input.GetJustification(),
+		Duration:      input.GetDuration(),
+		Profile:       input.GetProfile(),
+		Status:        rest.ElevationStatus_ElevationPending,
+		CreatedAt:     int32(time.Now().Unix()),
+	}
+	if er = elevation.Put(ctx, el); er != nil {
+		return er
+	}
+	log.Auditer(ctx).Info(
+		fmt.Sprintf("Requested elevation to role [%s] for %s", role.GetLabel(), time.Duration(el.Duration)*time.Second),
+		log.GetAuditId(common.AuditElevationRequest),
+		zap.String(common.KeyElevationId, el.GetUuid()),
+		zap.String(common.KeyRoleUuid, el.GetRoleId()),
+	)
+	elevation.NotifyApprovers(ctx, el, cfg)
+	return rsp.WriteEntity(el)
+}
+
+// ListElevations lists the elevations of the current user, or all elevations for approvers
+func (h *ElevationHandler) ListElevations(req *restful.Request, rsp *restful.Response) error {
+	ctx := req.Request.Context()
+	claims, er := userClaims(ctx)
+	if er != nil {
+		return er
+	}
+	var input rest.ListElevationsRequest
+	if e := req.ReadEntity(&input); e != nil {
+		return e
+	}
+	var owner string
+	if !elevation.LoadConfig(ctx).IsApprover(claims.Name, claims.Profile) {
+		owner = claims.Name
+		input.UserLogin = claims.Name
+	}
+	all, er := elevation.List(ctx, owner)
+	if er != nil {
+		return er
+	}
+	filtered := elevation.Filter(all, &input)
+	coll := &rest.ElevationCollection{Total: int32(len(filtered))}
+	offset, limit := int(input.GetOffset()), int(input.GetLimit())
+	if offset > len(filtered) {
+		offset = len(filtered)
+	}
+	end := len(filtered)
+	if limit > 0 && offset+limit < end {
+		end = offset + limit
+	}
+	coll.Elevations = filtered[offset:end]
+	return rsp.WriteEntity(coll)
+}
+
+// DecideElevation approves or rejects a pending elevation
+func (h *ElevationHandler) DecideElevation(req *restful.Request, rsp *restful.Response) error {
+	ctx := req.Request.Context()
+	claims, er := userClaims(ctx)
+	if er != nil {
+		return er
+	}
+	var input rest.DecideElevationRequest
+	if e := req.ReadEntity(&input); e != nil {
+		return e
+	}
+	if input.Uuid == "" {
+		input.Uuid = req.PathParameter("Uuid")
+	}
+	if !elevation.LoadConfig(ctx).IsApprover(claims.Name, claims.Profile) {
+		return errors.WithMessage(errors.StatusForbidden, "you are not allowed to decide on elevation requests")
+	}
+	el, er := h.decide(ctx, input.GetUuid(), claims.Name, input.GetApprove(), input.GetComment())
+	if er != nil {
+		return er
+	}
+	return rsp.WriteEntity(el)
+}
+
+// RevokeElevation ends an active elevation, either by its requester or by an approver
+func (h *ElevationHandler) RevokeElevation(req *restful.Request, rsp *restful.Response) error {
+	ctx := req.Request.Context()
+	claims, er := userClaims(ctx)
+	if er != nil {
+		return er
+	}
+	el, er := elevation.Get(ctx, req.PathParameter("Uuid"))
+	if er != nil {
+		return er
+	}
+	if el.GetUserLogin() != claims.Name && !elevation.LoadConfig(ctx).IsApprover(claims.Name, claims.Profile) {
+		return errors.WithMessage(errors.StatusForbidden, "you are not allowed to revoke this elevation")
+	}
+	if er = elevation.End(ctx, el, rest.ElevationStatus_ElevationRevoked, claims.Name); er != nil {
+		return er
+	}
+	return rsp.WriteEntity(el)
+}
+
+// ElevationTokenForm displays the elevation of an email link with a form to approve or reject it. Opening the link does
+// not decide anything, so that mail scanners and link previews cannot approve requests on behalf of the approver.
+func (h *ElevationHandler) ElevationTokenForm(req *restful.Request, rsp *restful.Response) error {
+	ctx := req.Request.Context()
+	id, approver, er := elevation.PeekToken(ctx, req.PathParameter("Token"))
+	if er != nil {
+		return er
+	}
+	if er = checkTokenApprover(ctx, approver); er != nil {
+		return er
+	}
+	el, er := elevation.Get(ctx, id)
+	if er != nil {
+		return er
+	}
+	if strings.Contains(req.HeaderParameter("Accept"), restful.MIME_JSON) {
+		return rsp.WriteEntity(el)
+	}
+	return writeTokenPage(rsp, el, req.QueryParameter("Reject") == "true")
+}
+
+// DecideElevationToken approves or rejects a pending elevation using the token sent by email, posted by the
+// ElevationTokenForm page. The token is bound to the approver it was sent to: if the request is authenticated,
+// it must be by this approver.
+func (h *ElevationHandler) DecideElevationToken(req *restful.Request, rsp *restful.Response) error {
+	ctx := req.Request.Context()
+	var input rest.ElevationTokenRequest
+	isForm := strings.HasPrefix(req.HeaderParameter("Content-Type"), "application/x-www-form-urlencoded")
+	if isForm {
+		if e := req.Request.ParseForm(); e != nil {
+			return errors.Tag(e, errors.InvalidParameters)
+		}
+		input.Reject = req.Request.PostForm.Get("Reject") == "true"
+		input.Comment = req.Request.PostForm.Get("Comment")
+	} else if e := req.ReadEntity(&input); e != nil {
+		return e
+	}
+	token := req.PathParameter("Token")
+	_, approver, er := elevation.PeekToken(ctx, token)
+	if er != nil {
+		return er
+	}
+	if claims, ok := claim.FromContext(ctx); ok && claims.Name != "" && claims.Name != approver {
+		return errors.WithMessage(errors.StatusForbidden, "this link was sent to another approver")
+	}
+	if er = checkTokenApprover(ctx, approver); er != nil {
+		return er
+	}
+	id, approver, er := elevation.ConsumeToken(ctx, token)
+	if er != nil {
+		return er
+	}
+	el, er := h.decide(ctx, id, approver, !input.GetReject(), input.GetComment())
+	if er != nil {
+		return er
+	}
+	if isForm {
+		return writeTokenPage(rsp, el, false)
+	}
+	return rsp.WriteEntity(el)
+}
+
+// checkTokenApprover verifies that the recipient of an approval link is still allowed to decide.
+func checkTokenApprover(ctx context.Context, approver string) error {
+	u, er := permissions.SearchUniqueUser(ctx, approver, "")
+	if er != nil {
+		return er
+	}
+	if !elevation.LoadConfig(ctx).IsApprover(u.GetLogin(), u.GetAttributes()[idm.UserAttrProfile]) {
+		return errors.WithMessage(errors.StatusForbidden, "you are not allowed to decide on elevation requests")
+	}
+	return nil
+}
+
+var tokenPage = template.Must(template.New("elevation").Parse(`<!DOCTYPE html>
+<html><head><meta charset="utf-8"><meta name="viewport" content="width=device-width, initial-scale=1"><title>Privilege elevation</title>
+<style>body{font-family:sans-serif;max-width:600px;margin:40px auto;padding:0 16px;color:#424242}dt{font-weight:bold;margin-top:12px}textarea{width:100%;min-height:60px}button{margin:16px 8px 0 0;padding:8px 16px}</style>
+</head><body>
+<h2>Elevation of {{.E.UserLogin}} to role {{.E.RoleLabel}}</h2>
+<dl>
+<dt>Duration</dt><dd>{{.Duration}}</dd>
+<dt>Justification</dt><dd>{{.E.Justification}}</dd>
+<dt>Status</dt><dd>{{.Status}}</dd>
+{{if .E.Approver}}<dt>Decided by</dt><dd>{{.E.Approver}}</dd>{{end}}
+</dl>
+{{if .Pending}}<form method="POST">
+<label for="Comment">Comment (optional)</label><br><textarea id="Comment" name="Comment"></textarea><br>
+{{if .Reject}}<button type="submit" name="Reject" value="true">Reject</button><button type="submit" name="Reject" value="false">Approve</button>
+{{else}}<button type="submit" name="Reject" value="false">Approve</button><button type="submit" name="Reject" value="true">Reject</button>{{end}}
+</form>{{end}}
+</body></html>
+`))
+
+func writeTokenPage(rsp *restful.Response, el *rest.Elevation, reject bool) error {
+	rsp.Header().Set("Content-Type", "text/html; charset=utf-8")
+	rsp.Header().Set("Cache-Control", "no-store")
+	rsp.Header().Set("Referrer-Policy", "no-referrer")
+	rsp.WriteHeader(http.StatusOK)
+	return tokenPage.Execute(rsp, map[string]interface{}{
+		"E":        el,
+		"Duration": (time.Duration(el.GetDuration()) * time.Second).String(),
+		"Status":   strings.TrimPrefix(el.GetStatus().String(), "Elevation"),
+		"Pending":  el.GetStatus() == rest.ElevationStatus_ElevationPending,
+		"Reject":   reject,
+	})
+}
+
+func (h *ElevationHandler) decide(ctx context.Context, id, approver string, approve bool, comment string) (*rest.Elevation, error) {
+	el, er := elevation.Get(ctx, id)
+	if er != nil {
+		return nil, er
+	}
+	if el.GetUserLogin() == approver {
+		return nil, errors.WithMessage(errors.StatusForbidden, "you cannot approve your own request")
+	}
+	if approve {
+		er = elevation.Activate(ctx, el, approver, comment)
+	} else {
+		er = elevation.Reject(ctx, el, approver, comment)
+	}
+	if er != nil {
+		return nil, er
+	}
+	elevation.NotifyDecision(ctx, el)
+	return el, nil
+}
+
+func userClaims(ctx context.Context) (claim.Claims, error) {
+	claims, ok := claim.FromContext(ctx)
+	if !ok || claims.Name == "" {
+		return claims, errors.WithStack(errors.ContextUserNotFound)
+	}
+	return claims, nil
+}
@@ -0,0 +1,205 @@
+/*
+ * Copyright (c) 2025 Abstrium SAS <team (at) pydio.com>
+ * This file is part of Pydio Cells.
+ *
+ * Pydio Cells is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * Pydio Cells is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with Pydio Cells.  If not, see <http://www.gnu.org/licenses/>.
+ *
+ * The latest code can be found at <https://pydio.com>.
+ */
+
+package elevation
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"time"
+
+	"google.golang.org/protobuf/encoding/protojson"
+
+	"github.com/pydio/cells/v5/common"
+	"github.com/pydio/cells/v5/common/client/commons/docstorec"
+	"github.com/pydio/cells/v5/common/errors"
+	"github.com/pydio/cells/v5/common/proto/docstore"
+	"github.com/pydio/cells/v5/common/proto/rest"
+	json "github.com/pydio/cells/v5/common/utils/jsonx"
+	"github.com/pydio/cells/v5/common/utils/uuid"
+)
+
+const (
+	// tokenValidity is the lifetime of the approval links sent by email
+	tokenValidity = 72 * time.Hour
+)
+
+type approvalToken struct {
+	Elevation  string `json:"ELEVATION"`
+	Approver   string `json:"APPROVER"`
+	Expiration int64  `json:"expiration"`
+}
+
+// Get loads an elevation by its Uuid.
+func Get(ctx context.Context, id string) (*rest.Elevation, error) {
+	resp, er := docstorec.DocStoreClient(ctx).GetDocument(ctx, &docstore.GetDocumentRequest{
+		StoreID:    common.DocStoreIdElevations,
+		DocumentID: id,
+	})
+	if er != nil {
+		return nil, er
+	}
+	if resp.GetDocument() == nil || resp.GetDocument().GetData() == "" {
+		return nil, errors.WithMessagef(errors.StatusNotFound, "cannot find elevation %s", id)
+	}
+	e := &rest.Elevation{}
+	if er = protojson.Unmarshal([]byte(resp.GetDocument().GetData()), e); er != nil {
+		return nil, errors.Tag(er, errors.UnmarshalError)
+	}
+	return e, nil
+}
+
+// Put creates or updates an elevation.
+func Put(ctx context.Context, e *rest.Elevation) error {
+	if e.Uuid == "" {
+		e.Uuid = uuid.New()
+	}
+	data, er := protojson.Marshal(e)
+	if er != nil {
+		return er
+	}
+	meta, _ := json.Marshal(map[string]interface{}{
+		"USER":    e.GetUserLogin(),
+		"STATUS":  e.GetStatus().String(),
+		"expires": e.GetExpiresAt(),
+	})
+	_, er = docstorec.DocStoreClient(ctx).PutDocument(ctx, &docstore.PutDocumentRequest{
+		StoreID:    common.DocStoreIdElevations,
+		DocumentID: e.Uuid,
+		Document: &docstore.Document{
+			ID:            e.Uuid,
+			Owner:         e.GetUserLogin(),
+			Type:          docstore.DocumentType_JSON,
+			Data:          string(data),
+			IndexableMeta: string(meta),
+		},
+	})
+	return er
+}
+
+// List loads all elevations, or the elevations requested by a given user.
+func List(ctx context.Context, owner string) ([]*rest.Elevation, error) {
+	query := &docstore.DocumentQuery{Owner: owner}
+	return list(ctx, query)
+}
+
+// ListActive loads all active elevations.
+func ListActive(ctx context.Context) ([]*rest.Elevation, error) {
+	return list(ctx, &docstore.DocumentQuery{MetaQuery: "+STATUS:" + rest.ElevationStatus_ElevationActive.String()})
+}
+
+func list(ctx context.Context, query *docstore.DocumentQuery) (ee []*rest.Elevation, err error) {
+	ct, ca := context.WithCancel(ctx)
+	defer ca()
+	streamer, er := docstorec.DocStoreClient(ctx).ListDocuments(ct, &docstore.ListDocumentsRequest{
+		StoreID: common.DocStoreIdElevations,
+		Query:   query,
+	})
+	if er != nil {
+		return nil, er
+	}
+	for {
+		resp, e := streamer.Recv()
+		if e == io.EOF {
+			break
+		} else if e != nil {
+			return nil, e
+		}
+		el := &rest.Elevation{}
+		if protojson.Unmarshal([]byte(resp.GetDocument().GetData()), el) == nil {
+			ee = append(ee, el)
+		}
+	}
+	return
+}
+
+// CreateToken stores a single-use token allowing an approver to decide on an elevation from an email link.
+func CreateToken(ctx context.Context, e *rest.Elevation, approver string) (string, error) {
+	token := uuid.New()
+	data, _ := json.Marshal(&approvalToken{
+		Elevation:  e.GetUuid(),
+		Approver:   approver,
+		Expiration: time.Now().Add(tokenValidity).Unix(),
+	})
+	_, er := docstorec.DocStoreClient(ctx).PutDocument(ctx, &docstore.PutDocumentRequest{
+		StoreID:    common.DocStoreIdElevationTokens,
+		DocumentID: token,
+		Document: &docstore.Document{
+			ID:            token,
+			Owner:         approver,
+			Type:          docstore.DocumentType_JSON,
+			Data:          string(data),
+			IndexableMeta: string(data),
+		},
+	})
+	if er != nil {
+		return "", er
+	}
+	return token, nil
+}
+
+// PeekToken checks an approval token without using it, returning the elevation Uuid and the approver login.
+func PeekToken(ctx context.Context, token string) (string, string, error) {
+	t, er := loadToken(ctx, token)
+	if er != nil {
+		return "", "", er
+	}
+	if t.Expiration < time.Now().Unix() {
+		return "", "", errors.WithMessage(errors.StatusForbidden, "this link has expired")
+	}
+	return t.Elevation, t.Approver, nil
+}
+
+// ConsumeToken checks and deletes an approval token, returning the elevation Uuid and the approver login.
+func ConsumeToken(ctx context.Context, token string) (string, string, error) {
+	t, er := loadToken(ctx, token)
+	if er != nil {
+		return "", "", er
+	}
+	_, _ = docstorec.DocStoreClient(ctx).DeleteDocuments(ctx, &docstore.DeleteDocumentsRequest{StoreID: common.DocStoreIdElevationTokens, DocumentID: token})
+	if t.Expiration < time.Now().Unix() {
+		return "", "", errors.WithMessage(errors.StatusForbidden, "this link has expired")
+	}
+	return t.Elevation, t.Approver, nil
+}
+
+func loadToken(ctx context.Context, token string) (*approvalToken, error) {
+	resp, er := docstorec.DocStoreClient(ctx).GetDocument(ctx, &docstore.GetDocumentRequest{
+		StoreID:    common.DocStoreIdElevationTokens,
+		DocumentID: token,
+	})
+	if er != nil || resp.GetDocument() == nil || resp.GetDocument().GetData() == "" {
+		return nil, errors.WithMessage(errors.StatusNotFound, "invalid or already used token")
+	}
+	t := &approvalToken{}
+	if er = json.Unmarshal([]byte(resp.GetDocument().GetData()), t); er != nil {
+		return nil, errors.Tag(er, errors.UnmarshalError)
+	}
+	return t, nil
+}
+
+// DeleteTokens removes the remaining approval tokens of an elevation once it is decided.
+func DeleteTokens(ctx context.Context, e *rest.Elevation) {
+	_, _ = docstorec.DocStoreClient(ctx).DeleteDocuments(ctx, &docstore.DeleteDocumentsRequest{
+		StoreID: common.DocStoreIdElevationTokens,
+		Query:   &docstore.DocumentQuery{MetaQuery: fmt.Sprintf("+ELEVATION:\"%s\"", e.GetUuid())},
+	})
+}
@@ -0,0 +1,70 @@
+/*
+ * Copyright (c) 2025 Abstrium SAS <team (at) pydio.com>
+ * This file is part of Pydio Cells.
+ *
+ * Pydio Cells is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * Pydio Cells is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with Pydio Cells.  If not, see <http://www.gnu.org/licenses/>.
+ *
+ * The latest code can be found at <https://pydio.com>.
+ */
+
+package conditions
+
+import (
+	"context"
+	"strings"
+
+	"github.com/ory/ladon"
+	"go.uber.org/zap"
+
+	"github.com/pydio/cells/v5/common/auth/claim"
+	"github.com/pydio/cells/v5/common/telemetry/log"
+)
+
+// AuthMethodCondition is fulfilled if the passed authentication method (password, token, sso or mfa) is one of
+// Methods, and is at least as strong as Minimum. Strength goes from password and token, to sso, to mfa.
+type AuthMethodCondition struct {
+	Methods []string `json:"methods"`
+	Minimum string   `json:"minimum"`
+}
+
+// Fulfills returns true if the given value matches the condition methods and minimum strength.
+func (c *AuthMethodCondition) Fulfills(ctx context.Context, value interface{}, _ *ladon.Request) bool {
+
+	s, ok := value.(string)
+	if !ok {
+		log.Logger(ctx).Error("passed value must be a string", zap.Any("input param", value))
+		return false
+	}
+	if len(c.Methods) == 0 && c.Minimum == "" {
+		log.Logger(ctx).Error("AuthMethodCondition requires at least methods or minimum")
+		return false
+	}
+	if c.Minimum != "" && claim.AuthMethodStrength(s) < claim.AuthMethodStrength(strings.ToLower(c.Minimum)) {
+		return false
+	}
+	if len(c.Methods) == 0 {
+		return true
+	}
+	for _, m := range c.Methods {
+		if strings.EqualFold(strings.TrimSpace(m), s) {
+			return true
+		}
+	}
+	return false
+}
+
+// GetName returns the condition's name.
+func (c *AuthMethodCondition) GetName() string {
+	return "AuthMethodCondition"
+}
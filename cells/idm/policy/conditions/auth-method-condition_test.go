@@ -0,0 +1,63 @@
+/*
+ * Copyright (c) 2025 Abstrium SAS <team (at) pydio.com>
+ * This file is part of Pydio Cells.
+ *
+ * Pydio Cells is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * Pydio Cells is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with Pydio Cells.  If not, see <http://www.gnu.org/licenses/>.
+ *
+ * The latest code can be found at <https://pydio.com>.
+ */
+
+package conditions
+
+import (
+	"testing"
+
+	"github.com/ory/ladon"
+
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestAuthMethodCondition(t *testing.T) {
+
+	Convey("Match authentication methods and strength", t, func() {
+
+		for _, c := range []struct {
+			condition *AuthMethodCondition
+			value     interface{}
+			pass      bool
+		}{
+			{condition: &AuthMethodCondition{Methods: []string{"sso", "mfa"}}, value: "sso", pass: true},
+			{condition: &AuthMethodCondition{Methods: []string{"sso", "mfa"}}, value: "password", pass: false},
+			{condition: &AuthMethodCondition{Minimum: "sso"}, value: "mfa", pass: true},
+			{condition: &AuthMethodCondition{Minimum: "sso"}, value: "token", pass: false},
+			{condition: &AuthMethodCondition{Minimum: "MFA"}, value: "mfa", pass: true},
+			{condition: &AuthMethodCondition{Methods: []string{"password", "mfa"}, Minimum: "sso"}, value: "password", pass: false},
+			{condition: &AuthMethodCondition{}, value: "mfa", pass: false},
+			{condition: &AuthMethodCondition{Minimum: "password"}, value: "unknown", pass: false},
+		} {
+			So(c.condition.Fulfills(bg, c.value, new(ladon.Request)), ShouldEqual, c.pass)
+		}
+	})
+}
+
+func TestClientTypeCondition(t *testing.T) {
+
+	Convey("Match client types", t, func() {
+		condition := &ClientTypeCondition{Types: []string{"web", " Sync"}}
+		So(condition.Fulfills(bg, "web", new(ladon.Request)), ShouldBeTrue)
+		So(condition.Fulfills(bg, "sync", new(ladon.Request)), ShouldBeTrue)
+		So(condition.Fulfills(bg, "webdav", new(ladon.Request)), ShouldBeFalse)
+		So(condition.Fulfills(bg, nil, new(ladon.Request)), ShouldBeFalse)
+	})
+}
@@ -0,0 +1,57 @@
+/*
+ * Copyright (c) 2025 Abstrium SAS <team (at) pydio.com>
+ * This file is part of Pydio Cells.
+ *
+ * Pydio Cells is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * Pydio Cells is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with Pydio Cells.  If not, see <http://www.gnu.org/licenses/>.
+ *
+ * The latest code can be found at <https://pydio.com>.
+ */
+
+package conditions
+
+import (
+	"context"
+	"strings"
+
+	"github.com/ory/ladon"
+	"go.uber.org/zap"
+
+	"github.com/pydio/cells/v5/common/telemetry/log"
+)
+
+// ClientTypeCondition is fulfilled if the passed client type is one of Types: web, sync, cli, mobile, webdav, s3 or api.
+type ClientTypeCondition struct {
+	Types []string `json:"types"`
+}
+
+// Fulfills returns true if the given value is one of the condition types.
+func (c *ClientTypeCondition) Fulfills(ctx context.Context, value interface{}, _ *ladon.Request) bool {
+
+	s, ok := value.(string)
+	if !ok {
+		log.Logger(ctx).Error("passed value must be a string", zap.Any("input param", value))
+		return false
+	}
+	for _, t := range c.Types {
+		if strings.EqualFold(strings.TrimSpace(t), s) {
+			return true
+		}
+	}
+	return false
+}
+
+// GetName returns the condition's name.
+func (c *ClientTypeCondition) GetName() string {
+	return "ClientTypeCondition"
+}
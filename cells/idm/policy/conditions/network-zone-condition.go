@@ -0,0 +1,118 @@
+/*
+ * Copyright (c) 2025 Abstrium SAS <team (at) pydio.com>
+ * This file is part of Pydio Cells.
+ *
+ * Pydio Cells is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * Pydio Cells is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with Pydio Cells.  If not, see <http://www.gnu.org/licenses/>.
+ *
+ * The latest code can be found at <https://pydio.com>.
+ */
+
+package conditions
+
+import (
+	"context"
+	"net"
+	"strings"
+
+	"github.com/ory/ladon"
+	"go.uber.org/zap"
+
+	"github.com/pydio/cells/v5/common"
+	"github.com/pydio/cells/v5/common/config"
+	"github.com/pydio/cells/v5/common/middleware/keys"
+	"github.com/pydio/cells/v5/common/telemetry/log"
+	"github.com/pydio/cells/v5/common/utils/geoip"
+)
+
+// NetworkZoneCondition is fulfilled if the passed IP address belongs to one of the Zones or one of the CIDRs.
+// Zones are named lists of CIDRs (e.g. office, vpn) defined in the policy service configuration, under
+// services/pydio.grpc.policy/networkZones. When the request went through proxies, the client address is resolved
+// from the direct peer and the X-Forwarded-For chain, honouring only the proxies listed under
+// services/pydio.grpc.policy/trustedProxies.
+type NetworkZoneCondition struct {
+	Zones []string `json:"zones"`
+	CIDRs []string `json:"cidrs"`
+}
+
+// Fulfills returns true if the given value is an IP address (optionally with a port) contained in one of the networks.
+func (c *NetworkZoneCondition) Fulfills(ctx context.Context, value interface{}, r *ladon.Request) bool {
+
+	s, ok := value.(string)
+	if !ok {
+		log.Logger(ctx).Error("passed value must be a string", zap.Any("input param", value))
+		return false
+	}
+	if r != nil {
+		if peer, ok := r.Context[keys.HttpMetaPeerAddress].(string); ok && peer != "" {
+			ff, _ := r.Context[keys.HttpMetaForwardedFor].(string)
+			s = geoip.ClientAddress(peer, []string{ff}, TrustedProxies(ctx))
+		}
+	}
+	s = strings.TrimSpace(s)
+	if host, _, er := net.SplitHostPort(s); er == nil {
+		s = host
+	}
+	ip := net.ParseIP(s)
+	if ip == nil {
+		log.Logger(ctx).Debug("cannot parse passed value as an IP address", zap.String("input param", s))
+		return false
+	}
+
+	cidrs := append([]string{}, c.CIDRs...)
+	if len(c.Zones) > 0 {
+		zones := NetworkZones(ctx)
+		for _, z := range c.Zones {
+			if zc, ok := zones[z]; ok {
+				cidrs = append(cidrs, zc...)
+			} else {
+				log.Logger(ctx).Warn("NetworkZoneCondition: unknown network zone " + z)
+			}
+		}
+	}
+	for _, cidr := range cidrs {
+		_, network, er := net.ParseCIDR(strings.TrimSpace(cidr))
+		if er != nil {
+			log.Logger(ctx).Error("cannot parse CIDR", zap.String("cidr", cidr), zap.Error(er))
+			continue
+		}
+		if network.Contains(ip) {
+			return true
+		}
+	}
+	return false
+}
+
+// GetName returns the condition's name.
+func (c *NetworkZoneCondition) GetName() string {
+	return "NetworkZoneCondition"
+}
+
+// NetworkZones loads the named network zones from the configuration.
+func NetworkZones(ctx context.Context) map[string][]string {
+	zones := make(map[string][]string)
+	v := config.Get(ctx, "services", common.ServicePolicyGRPC, "networkZones")
+	if v == nil {
+		return zones
+	}
+	if er := v.Scan(&zones); er != nil {
+		log.Logger(ctx).Error("cannot read network zones from configuration", zap.Error(er))
+	}
+	return zones
+}
+
+// TrustedProxies lists the proxies (IPs or CIDRs) whose X-Forwarded-For entries are honoured when resolving
+// the client address.
+func TrustedProxies(ctx context.Context) []string {
+	return config.Get(ctx, "services", common.ServicePolicyGRPC, "trustedProxies").StringArray()
+}
@@ -0,0 +1,117 @@
+/*
+ * Copyright (c) 2025 Abstrium SAS <team (at) pydio.com>
+ * This file is part of Pydio Cells.
+ *
+ * Pydio Cells is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * Pydio Cells is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with Pydio Cells.  If not, see <http://www.gnu.org/licenses/>.
+ *
+ * The latest code can be found at <https://pydio.com>.
+ */
+
+package conditions
+
+import (
+	"testing"
+
+	"github.com/ory/ladon"
+	"github.com/ory/ladon/manager/memory"
+	"github.com/stretchr/testify/require"
+
+	"github.com/pydio/cells/v5/common"
+	"github.com/pydio/cells/v5/common/config"
+	"github.com/pydio/cells/v5/common/config/mock"
+	"github.com/pydio/cells/v5/common/middleware/keys"
+
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestNetworkZoneCondition(t *testing.T) {
+
+	ctx, er := mock.RegisterMockConfig(bg)
+	require.Nil(t, er)
+	require.Nil(t, config.Set(ctx, map[string][]string{
+		"office": {"192.168.1.0/24", "10.10.0.0/16"},
+		"vpn":    {"172.16.0.0/12", "fd00::/8"},
+	}, "services", common.ServicePolicyGRPC, "networkZones"))
+	require.Nil(t, config.Set(ctx, []string{"10.0.0.1"}, "services", common.ServicePolicyGRPC, "trustedProxies"))
+
+	Convey("Match IPs against zones and CIDRs", t, func() {
+
+		for _, c := range []struct {
+			condition *NetworkZoneCondition
+			value     interface{}
+			pass      bool
+		}{
+			{condition: &NetworkZoneCondition{Zones: []string{"office"}}, value: "192.168.1.12", pass: true},
+			{condition: &NetworkZoneCondition{Zones: []string{"office"}}, value: "192.168.1.12:54321", pass: true},
+			{condition: &NetworkZoneCondition{Zones: []string{"office"}}, value: "192.168.2.12", pass: false},
+			{condition: &NetworkZoneCondition{Zones: []string{"office", "vpn"}}, value: "172.20.1.1", pass: true},
+			{condition: &NetworkZoneCondition{Zones: []string{"vpn"}}, value: "[fd12::1]:8080", pass: true},
+			{condition: &NetworkZoneCondition{Zones: []string{"unknown"}}, value: "192.168.1.12", pass: false},
+			{condition: &NetworkZoneCondition{CIDRs: []string{"8.8.8.0/24"}}, value: " 8.8.8.8", pass: true},
+			{condition: &NetworkZoneCondition{CIDRs: []string{"not-a-cidr", "8.8.8.0/24"}}, value: "8.8.8.8", pass: true},
+			{condition: &NetworkZoneCondition{CIDRs: []string{"8.8.8.0/24"}}, value: "not-an-ip", pass: false},
+			{condition: &NetworkZoneCondition{CIDRs: []string{"8.8.8.0/24"}}, value: 12, pass: false},
+		} {
+			So(c.condition.Fulfills(ctx, c.value, new(ladon.Request)), ShouldEqual, c.pass)
+		}
+	})
+
+	Convey("Deny access from outside the office network", t, func() {
+
+		ladonPolicy := &ladon.DefaultPolicy{
+			ID:        "outside-office",
+			Subjects:  []string{"max"},
+			Resources: []string{"rest:/admin"},
+			Actions:   []string{"GET"},
+			Effect:    ladon.AllowAccess,
+			Conditions: ladon.Conditions{
+				keys.HttpMetaRemoteAddress: &NetworkZoneCondition{Zones: []string{"office", "vpn"}},
+			},
+		}
+		warden := &ladon.Ladon{Manager: memory.NewMemoryManager()}
+		require.Nil(t, warden.Manager.Create(ctx, ladonPolicy))
+
+		req := &ladon.Request{Subject: "max", Resource: "rest:/admin", Action: "GET", Context: ladon.Context{keys.HttpMetaRemoteAddress: "10.10.3.4"}}
+		So(warden.IsAllowed(ctx, req), ShouldBeNil)
+		req.Context[keys.HttpMetaRemoteAddress] = "81.2.3.4"
+		So(warden.IsAllowed(ctx, req), ShouldNotBeNil)
+	})
+	Convey("Ignore X-Forwarded-For set by untrusted peers", t, func() {
+
+		c := &NetworkZoneCondition{Zones: []string{"office"}}
+		// Forged header sent directly by an external client
+		forged := &ladon.Request{Context: ladon.Context{
+			keys.HttpMetaRemoteAddress: "192.168.1.12",
+			keys.HttpMetaPeerAddress:   "81.2.3.4:54321",
+			keys.HttpMetaForwardedFor:  "192.168.1.12",
+		}}
+		So(c.Fulfills(ctx, forged.Context[keys.HttpMetaRemoteAddress], forged), ShouldBeFalse)
+
+		// Same header appended by a trusted proxy
+		proxied := &ladon.Request{Context: ladon.Context{
+			keys.HttpMetaRemoteAddress: "192.168.1.12",
+			keys.HttpMetaPeerAddress:   "10.0.0.1:54321",
+			keys.HttpMetaForwardedFor:  "192.168.1.12",
+		}}
+		So(c.Fulfills(ctx, proxied.Context[keys.HttpMetaRemoteAddress], proxied), ShouldBeTrue)
+
+		// Forged leftmost entry behind a trusted proxy
+		chained := &ladon.Request{Context: ladon.Context{
+			keys.HttpMetaRemoteAddress: "192.168.1.12",
+			keys.HttpMetaPeerAddress:   "10.0.0.1:54321",
+			keys.HttpMetaForwardedFor:  "192.168.1.12, 81.2.3.4",
+		}}
+		So(c.Fulfills(ctx, chained.Context[keys.HttpMetaRemoteAddress], chained), ShouldBeFalse)
+	})
+}
@@ -22,6 +22,7 @@ package policy
 
 import (
 	"context"
+	"slices"
 	"strings"
 
 	"github.com/ory/ladon"
@@ -85,6 +86,22 @@ var (
 					Actions:     []string{"POST"},
 					Effect:      ladon.AllowAccess,
 				}),
+				converter.LadonToProtoPolicy(&ladon.DefaultPolicy{
+					ID:          "elevation-token-policy",
+					Description: "PolicyGroup.PublicAccess.Rule5",
+					Subjects:    []string{"profile:anon"},
+					Resources:   []string{"rest:/elevation/token<.+>"},
+					Actions:     []string{"GET", "POST"},
+					Effect:      ladon.AllowAccess,
+				}),
+				converter.LadonToProtoPolicy(&ladon.DefaultPolicy{
+					ID:          "data-export-download-policy",
+					Description: "PolicyGroup.PublicAccess.Rule6",
+					Subjects:    []string{"profile:anon"},
+					Resources:   []string{"rest:/user/export/<.+>"},
+					Actions:     []string{"GET"},
+					Effect:      ladon.AllowAccess,
+				}),
 			},
 		},
 
@@ -138,6 +155,9 @@ var (
 						"rest:/search/nodes",
 						"rest:/share<.+>",
 						"rest:/activity<.+>",
+						"rest:/chat<.+>",
+						"rest:/review/items",
+						"rest:/elevation<.+>",
 						"rest:/changes",
 						"rest:/changes<.+>",
 						"rest:/scheduler/hooks/<.+>",
@@ -741,6 +761,62 @@ func Upgrade4992(ctx context.Context) error {
 
 }
 
+// EnsureRestPolicies opens permissions for the chat, access reviews, privilege elevations and data exports
+// Rest APIs. It is run at every start and only stores the policy groups that miss one of these rules.
+func EnsureRestPolicies(ctx context.Context) error {
+	dao, er := manager.Resolve[DAO](ctx)
+	if er != nil {
+		return er
+	}
+	groups, e := dao.ListPolicyGroups(ctx, "")
+	if e != nil {
+		return e
+	}
+	for _, group := range groups {
+		var changed bool
+		if group.GetUuid() == "rest-apis-default-accesses" {
+			for _, p := range group.Policies {
+				if p.GetID() != "user-default-policy" {
+					continue
+				}
+				for _, res := range []string{"rest:/chat<.+>", "rest:/review/items", "rest:/elevation<.+>"} {
+					if !slices.Contains(p.Resources, res) {
+						p.Resources = append(p.Resources, res)
+						changed = true
+					}
+				}
+			}
+		} else if group.GetUuid() == "public-access" {
+			for id, rule := range map[string][]string{
+				"elevation-token-policy":      {"PolicyGroup.PublicAccess.Rule5", "rest:/elevation/token<.+>", "GET", "POST"},
+				"data-export-download-policy": {"PolicyGroup.PublicAccess.Rule6", "rest:/user/export/<.+>", "GET"},
+			} {
+				if slices.ContainsFunc(group.Policies, func(p *idm.Policy) bool { return p.GetID() == id }) {
+					continue
+				}
+				group.Policies = append(group.Policies, converter.LadonToProtoPolicy(&ladon.DefaultPolicy{
+					ID:          id,
+					Description: rule[0],
+					Subjects:    []string{"profile:anon"},
+					Resources:   []string{rule[1]},
+					Actions:     rule[2:],
+					Effect:      ladon.AllowAccess,
+				}))
+				changed = true
+			}
+		}
+		if !changed {
+			continue
+		}
+		if _, er := dao.StorePolicyGroup(ctx, group); er != nil {
+			log.Logger(ctx).Error("could not update policy group "+group.GetUuid(), zap.Error(er))
+		} else {
+			log.Logger(ctx).Info("Updated policy group " + group.GetUuid())
+		}
+	}
+	return nil
+}
+
 var GrpcServiceMigrations = []*service.Migration{
 	{
 		TargetVersion: service.FirstRun(),
@@ -794,4 +870,8 @@ var GrpcServiceMigrations = []*service.Migration{
 		TargetVersion: service.ValidVersion("4.3.99"),
 		Up:            Upgrade4399,
 	},
+	{
+		TargetVersion: service.RunAlways(),
+		Up:            EnsureRestPolicies,
+	},
 }
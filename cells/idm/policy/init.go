@@ -53,4 +53,16 @@ func init() {
 		return new(conditions.DateAfterCondition)
 	}
 
+	ladon.ConditionFactories[new(conditions.NetworkZoneCondition).GetName()] = func() ladon.Condition {
+		return new(conditions.NetworkZoneCondition)
+	}
+
+	ladon.ConditionFactories[new(conditions.ClientTypeCondition).GetName()] = func() ladon.Condition {
+		return new(conditions.ClientTypeCondition)
+	}
+
+	ladon.ConditionFactories[new(conditions.AuthMethodCondition).GetName()] = func() ladon.Condition {
+		return new(conditions.AuthMethodCondition)
+	}
+
 }
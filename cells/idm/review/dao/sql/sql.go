@@ -0,0 +1,257 @@
+/*
+ * Copyright (c) 2025 Abstrium SAS <team (at) pydio.com>
+ * This file is part of Pydio Cells.
+ *
+ * Pydio Cells is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * Pydio Cells is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with Pydio Cells.  If not, see <http://www.gnu.org/licenses/>.
+ *
+ * The latest code can be found at <https://pydio.com>.
+ */
+
+package sql
+
+import (
+	"context"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"github.com/pydio/cells/v5/common/errors"
+	"github.com/pydio/cells/v5/common/proto/review"
+	"github.com/pydio/cells/v5/common/storage/sql"
+	review2 "github.com/pydio/cells/v5/idm/review"
+)
+
+func init() {
+	review2.Drivers.Register(NewDAO)
+}
+
+type ReviewCampaign struct {
+	Uuid            string   `gorm:"primaryKey;column:uuid;type:varchar(128) not null;"`
+	Label           string   `gorm:"column:label;type:varchar(255);"`
+	Description     string   `gorm:"column:description;type:text;"`
+	WorkspaceIds    []string `gorm:"column:workspace_ids;type:text;serializer:json;"`
+	RoleIds         []string `gorm:"column:role_ids;type:text;serializer:json;"`
+	Creator         string   `gorm:"column:creator;type:varchar(255);"`
+	CreatedAt       int64    `gorm:"column:created_at;autoCreateTime:false;"`
+	Deadline        int64    `gorm:"column:deadline;"`
+	DefaultReviewer string   `gorm:"column:default_reviewer;type:varchar(255);"`
+	Status          int32    `gorm:"column:status;index;"`
+	ClosedAt        int64    `gorm:"column:closed_at;"`
+}
+
+func (c *ReviewCampaign) As(res *review.Campaign) *review.Campaign {
+	res.Uuid = c.Uuid
+	res.Label = c.Label
+	res.Description = c.Description
+	res.WorkspaceIds = c.WorkspaceIds
+	res.RoleIds = c.RoleIds
+	res.Creator = c.Creator
+	res.CreatedAt = c.CreatedAt
+	res.Deadline = c.Deadline
+	res.DefaultReviewer = c.DefaultReviewer
+	res.Status = review.CampaignStatus(c.Status)
+	res.ClosedAt = c.ClosedAt
+	return res
+}
+
+func (c *ReviewCampaign) From(res *review.Campaign) *ReviewCampaign {
+	c.Uuid = res.Uuid
+	c.Label = res.Label
+	c.Description = res.Description
+	c.WorkspaceIds = res.WorkspaceIds
+	c.RoleIds = res.RoleIds
+	c.Creator = res.Creator
+	c.CreatedAt = res.CreatedAt
+	c.Deadline = res.Deadline
+	c.DefaultReviewer = res.DefaultReviewer
+	c.Status = int32(res.Status)
+	c.ClosedAt = res.ClosedAt
+	return c
+}
+
+type ReviewItem struct {
+	Uuid           string   `gorm:"primaryKey;column:uuid;type:varchar(128) not null;"`
+	CampaignUuid   string   `gorm:"column:campaign_uuid;type:varchar(128) not null;index;"`
+	WorkspaceUuid  string   `gorm:"column:workspace_uuid;type:varchar(128);"`
+	WorkspaceLabel string   `gorm:"column:workspace_label;type:varchar(500);"`
+	UserUuid       string   `gorm:"column:user_uuid;type:varchar(128);"`
+	UserLogin      string   `gorm:"column:user_login;type:varchar(255);"`
+	Rights         string   `gorm:"column:rights;type:varchar(128);"`
+	RoleIds        []string `gorm:"column:role_ids;type:text;serializer:json;"`
+	Reviewer       string   `gorm:"column:reviewer;type:varchar(255);index;"`
+	Status         int32    `gorm:"column:status;"`
+	DecidedBy      string   `gorm:"column:decided_by;type:varchar(255);"`
+	DecidedAt      int64    `gorm:"column:decided_at;"`
+	Comment        string   `gorm:"column:comment;type:text;"`
+	Applied        string   `gorm:"column:applied;type:text;"`
+	EscalatedAt    int64    `gorm:"column:escalated_at;"`
+}
+
+func (i *ReviewItem) As(res *review.Item) *review.Item {
+	res.Uuid = i.Uuid
+	res.CampaignUuid = i.CampaignUuid
+	res.WorkspaceUuid = i.WorkspaceUuid
+	res.WorkspaceLabel = i.WorkspaceLabel
+	res.UserUuid = i.UserUuid
+	res.UserLogin = i.UserLogin
+	res.Rights = i.Rights
+	res.RoleIds = i.RoleIds
+	res.Reviewer = i.Reviewer
+	res.Status = review.ItemStatus(i.Status)
+	res.DecidedBy = i.DecidedBy
+	res.DecidedAt = i.DecidedAt
+	res.Comment = i.Comment
+	res.Applied = i.Applied
+	res.EscalatedAt = i.EscalatedAt
+	return res
+}
+
+func (i *ReviewItem) From(res *review.Item) *ReviewItem {
+	i.Uuid = res.Uuid
+	i.CampaignUuid = res.CampaignUuid
+	i.WorkspaceUuid = res.WorkspaceUuid
+	i.WorkspaceLabel = res.WorkspaceLabel
+	i.UserUuid = res.UserUuid
+	i.UserLogin = res.UserLogin
+	i.Rights = res.Rights
+	i.RoleIds = res.RoleIds
+	i.Reviewer = res.Reviewer
+	i.Status = int32(res.Status)
+	i.DecidedBy = res.DecidedBy
+	i.DecidedAt = res.DecidedAt
+	i.Comment = res.Comment
+	i.Applied = res.Applied
+	i.EscalatedAt = res.EscalatedAt
+	return i
+}
+
+func NewDAO(db *gorm.DB) review2.DAO {
+	return &sqlimpl{Abstract: sql.NewAbstract(db).WithModels(func() []any {
+		return []any{&ReviewCampaign{}, &ReviewItem{}}
+	})}
+}
+
+type sqlimpl struct {
+	*sql.Abstract
+}
+
+// PutCampaign creates or updates a campaign
+func (s *sqlimpl) PutCampaign(ctx context.Context, campaign *review.Campaign) error {
+	return s.Session(ctx).Clauses(clause.OnConflict{UpdateAll: true}).Create((&ReviewCampaign{}).From(campaign)).Error
+}
+
+// GetCampaign loads a campaign by its uuid
+func (s *sqlimpl) GetCampaign(ctx context.Context, uuid string) (*review.Campaign, error) {
+	var c *ReviewCampaign
+	if tx := s.Session(ctx).Where(&ReviewCampaign{Uuid: uuid}).First(&c); tx.Error != nil {
+		if errors.Is(tx.Error, gorm.ErrRecordNotFound) {
+			return nil, errors.WithMessage(review2.ErrCampaignNotFound, "cannot find campaign with id "+uuid)
+		}
+		return nil, tx.Error
+	}
+	return c.As(&review.Campaign{}), nil
+}
+
+// ListCampaigns lists campaigns, most recent first
+func (s *sqlimpl) ListCampaigns(ctx context.Context, runningOnly bool) (res []*review.Campaign, err error) {
+	var cc []*ReviewCampaign
+	db := s.Session(ctx)
+	if runningOnly {
+		db = db.Where("status = ?", int32(review.CampaignStatus_RUNNING))
+	}
+	if tx := db.Order("created_at desc").Find(&cc); tx.Error != nil {
+		return nil, tx.Error
+	}
+	for _, c := range cc {
+		res = append(res, c.As(&review.Campaign{}))
+	}
+	return
+}
+
+// PutItems creates or updates items
+func (s *sqlimpl) PutItems(ctx context.Context, items ...*review.Item) error {
+	if len(items) == 0 {
+		return nil
+	}
+	var rows []*ReviewItem
+	for _, i := range items {
+		rows = append(rows, (&ReviewItem{}).From(i))
+	}
+	return s.Session(ctx).Clauses(clause.OnConflict{UpdateAll: true}).CreateInBatches(rows, 100).Error
+}
+
+// GetItem loads an item by its uuid
+func (s *sqlimpl) GetItem(ctx context.Context, uuid string) (*review.Item, error) {
+	var i *ReviewItem
+	if tx := s.Session(ctx).Where(&ReviewItem{Uuid: uuid}).First(&i); tx.Error != nil {
+		if errors.Is(tx.Error, gorm.ErrRecordNotFound) {
+			return nil, errors.WithMessage(review2.ErrItemNotFound, "cannot find item with id "+uuid)
+		}
+		return nil, tx.Error
+	}
+	return i.As(&review.Item{}), nil
+}
+
+// ListItems lists items matching the filter
+func (s *sqlimpl) ListItems(ctx context.Context, filter *review.ListItemsRequest) (res []*review.Item, err error) {
+	var ii []*ReviewItem
+	db := s.Session(ctx)
+	if filter.GetCampaignUuid() != "" {
+		db = db.Where("campaign_uuid = ?", filter.GetCampaignUuid())
+	}
+	if filter.GetReviewer() != "" {
+		db = db.Where("reviewer = ?", filter.GetReviewer())
+	}
+	if filter.GetWorkspaceUuid() != "" {
+		db = db.Where("workspace_uuid = ?", filter.GetWorkspaceUuid())
+	}
+	if filter.GetUserUuid() != "" {
+		db = db.Where("user_uuid = ?", filter.GetUserUuid())
+	}
+	if len(filter.GetStatus()) > 0 {
+		var ss []int32
+		for _, st := range filter.GetStatus() {
+			ss = append(ss, int32(st))
+		}
+		db = db.Where("status IN ?", ss)
+	}
+	if tx := db.Order("workspace_label, user_login").Find(&ii); tx.Error != nil {
+		return nil, tx.Error
+	}
+	for _, i := range ii {
+		res = append(res, i.As(&review.Item{}))
+	}
+	return
+}
+
+// CountItems counts items of a campaign by status
+func (s *sqlimpl) CountItems(ctx context.Context, campaignUuid string) (map[review.ItemStatus]int32, error) {
+	var rows []struct {
+		Status int32
+		Count  int32
+	}
+	tx := s.Session(ctx).Model(&ReviewItem{}).
+		Select("status, count(*) as count").
+		Where("campaign_uuid = ?", campaignUuid).
+		Group("status").
+		Scan(&rows)
+	if tx.Error != nil {
+		return nil, tx.Error
+	}
+	counts := make(map[review.ItemStatus]int32, len(rows))
+	for _, r := range rows {
+		counts[review.ItemStatus(r.Status)] = r.Count
+	}
+	return counts, nil
+}
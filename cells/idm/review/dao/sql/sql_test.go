@@ -0,0 +1,115 @@
+//go:build storage || sql
+
+/*
+ * Copyright (c) 2025 Abstrium SAS <team (at) pydio.com>
+ * This file is part of Pydio Cells.
+ *
+ * Pydio Cells is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * Pydio Cells is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with Pydio Cells.  If not, see <http://www.gnu.org/licenses/>.
+ *
+ * The latest code can be found at <https://pydio.com>.
+ */
+
+package sql
+
+import (
+	"context"
+	"testing"
+
+	"github.com/pydio/cells/v5/common/proto/review"
+	"github.com/pydio/cells/v5/common/runtime/manager"
+	"github.com/pydio/cells/v5/common/storage/test"
+	review2 "github.com/pydio/cells/v5/idm/review"
+
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+var (
+	testcases = test.TemplateSQL(NewDAO)
+)
+
+func TestCampaigns(t *testing.T) {
+
+	test.RunStorageTests(testcases, t, func(ctx context.Context) {
+
+		dao, err := manager.Resolve[review2.DAO](ctx)
+		if err != nil {
+			panic(err)
+		}
+
+		Convey("Test campaigns CRUD", t, func() {
+			c := &review.Campaign{
+				Uuid:         "c1",
+				Label:        "Q1",
+				WorkspaceIds: []string{"ws1", "ws2"},
+				Creator:      "admin",
+				CreatedAt:    10,
+			}
+			So(dao.PutCampaign(ctx, c), ShouldBeNil)
+			So(dao.PutCampaign(ctx, &review.Campaign{Uuid: "c2", Label: "Q2", CreatedAt: 20}), ShouldBeNil)
+
+			loaded, er := dao.GetCampaign(ctx, "c1")
+			So(er, ShouldBeNil)
+			So(loaded.Label, ShouldEqual, "Q1")
+			So(loaded.WorkspaceIds, ShouldResemble, []string{"ws1", "ws2"})
+
+			_, er = dao.GetCampaign(ctx, "unknown")
+			So(er, ShouldNotBeNil)
+
+			loaded.Status = review.CampaignStatus_CLOSED
+			loaded.ClosedAt = 30
+			So(dao.PutCampaign(ctx, loaded), ShouldBeNil)
+
+			all, er := dao.ListCampaigns(ctx, false)
+			So(er, ShouldBeNil)
+			So(all, ShouldHaveLength, 2)
+			So(all[0].Uuid, ShouldEqual, "c2")
+
+			running, er := dao.ListCampaigns(ctx, true)
+			So(er, ShouldBeNil)
+			So(running, ShouldHaveLength, 1)
+			So(running[0].Uuid, ShouldEqual, "c2")
+		})
+
+		Convey("Test items", t, func() {
+			So(dao.PutItems(ctx,
+				&review.Item{Uuid: "i1", CampaignUuid: "c1", WorkspaceUuid: "ws1", UserLogin: "john", Reviewer: "alice", RoleIds: []string{"r1"}},
+				&review.Item{Uuid: "i2", CampaignUuid: "c1", WorkspaceUuid: "ws1", UserLogin: "jane", Reviewer: "alice"},
+				&review.Item{Uuid: "i3", CampaignUuid: "c1", WorkspaceUuid: "ws2", UserLogin: "john", Reviewer: "bob"},
+				&review.Item{Uuid: "i4", CampaignUuid: "c2", WorkspaceUuid: "ws1", UserLogin: "john", Reviewer: "alice"},
+			), ShouldBeNil)
+
+			i, er := dao.GetItem(ctx, "i1")
+			So(er, ShouldBeNil)
+			So(i.RoleIds, ShouldResemble, []string{"r1"})
+			i.Status = review.ItemStatus_REVOKED
+			i.DecidedBy = "alice"
+			So(dao.PutItems(ctx, i), ShouldBeNil)
+
+			ii, er := dao.ListItems(ctx, &review.ListItemsRequest{CampaignUuid: "c1"})
+			So(er, ShouldBeNil)
+			So(ii, ShouldHaveLength, 3)
+
+			ii, er = dao.ListItems(ctx, &review.ListItemsRequest{CampaignUuid: "c1", Reviewer: "alice", Status: []review.ItemStatus{review.ItemStatus_PENDING}})
+			So(er, ShouldBeNil)
+			So(ii, ShouldHaveLength, 1)
+			So(ii[0].Uuid, ShouldEqual, "i2")
+
+			counts, er := dao.CountItems(ctx, "c1")
+			So(er, ShouldBeNil)
+			So(counts[review.ItemStatus_PENDING], ShouldEqual, 2)
+			So(counts[review.ItemStatus_REVOKED], ShouldEqual, 1)
+			So(counts[review.ItemStatus_APPROVED], ShouldEqual, 0)
+		})
+	})
+}
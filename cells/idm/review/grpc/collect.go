@@ -0,0 +1,178 @@
+/*
+ * Copyright (c) 2025 Abstrium SAS <team (at) pydio.com>
+ * This file is part of Pydio Cells.
+ *
+ * Pydio Cells is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * Pydio Cells is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with Pydio Cells.  If not, see <http://www.gnu.org/licenses/>.
+ *
+ * The latest code can be found at <https://pydio.com>.
+ */
+
+package grpc
+
+import (
+	"context"
+	"path"
+	"sort"
+
+	"google.golang.org/protobuf/types/known/anypb"
+
+	"github.com/pydio/cells/v5/common/client/commons"
+	"github.com/pydio/cells/v5/common/client/commons/idmc"
+	"github.com/pydio/cells/v5/common/permissions"
+	"github.com/pydio/cells/v5/common/proto/idm"
+	"github.com/pydio/cells/v5/common/proto/review"
+	"github.com/pydio/cells/v5/common/proto/service"
+	"github.com/pydio/cells/v5/common/telemetry/log"
+	"github.com/pydio/cells/v5/common/utils/uuid"
+)
+
+// CollectItems lists all users reaching the campaign workspaces through the campaign roles (or any role if none
+// is set), along with their effective rights. Each workspace is reviewed by its owner, or by the campaign default reviewer.
+func CollectItems(ctx context.Context, campaign *review.Campaign) ([]*review.Item, error) {
+	filter := make(map[string]struct{}, len(campaign.GetRoleIds()))
+	for _, r := range campaign.GetRoleIds() {
+		filter[r] = struct{}{}
+	}
+
+	var items []*review.Item
+	for _, wsId := range campaign.GetWorkspaceIds() {
+		ws, er := permissions.SearchUniqueWorkspace(ctx, wsId, "")
+		if er != nil {
+			return nil, er
+		}
+		acls, er := permissions.GetACLsForWorkspace(ctx, []string{wsId}, permissions.AclRead, permissions.AclWrite)
+		if er != nil {
+			return nil, er
+		}
+		var roleIds []string
+		seen := make(map[string]struct{})
+		for _, acl := range acls {
+			if _, ok := seen[acl.RoleID]; ok {
+				continue
+			}
+			if _, ok := filter[acl.RoleID]; len(filter) > 0 && !ok {
+				continue
+			}
+			seen[acl.RoleID] = struct{}{}
+			roleIds = append(roleIds, acl.RoleID)
+		}
+		if len(roleIds) == 0 {
+			continue
+		}
+		roles, er := permissions.GetRoles(ctx, roleIds)
+		if er != nil {
+			return nil, er
+		}
+		users := make(map[string]*idm.User)
+		grantedBy := make(map[string][]string)
+		for _, role := range roles {
+			uu, er := usersForRole(ctx, role)
+			if er != nil {
+				return nil, er
+			}
+			for _, u := range uu {
+				users[u.Uuid] = u
+				grantedBy[u.Uuid] = append(grantedBy[u.Uuid], role.Uuid)
+			}
+		}
+
+		reviewer := workspaceReviewer(ctx, ws, campaign)
+		for _, u := range users {
+			accessList, er := permissions.AccessListFromRoles(ctx, u.Roles, true, false)
+			if er != nil {
+				return nil, er
+			}
+			right, ok := accessList.DetectedWsRights(ctx)[wsId]
+			if !ok {
+				// Access is granted, but overridden by another role (e.g. a deny)
+				continue
+			}
+			items = append(items, &review.Item{
+				Uuid:           uuid.New(),
+				CampaignUuid:   campaign.Uuid,
+				WorkspaceUuid:  wsId,
+				WorkspaceLabel: ws.Label,
+				UserUuid:       u.Uuid,
+				UserLogin:      u.Login,
+				Rights:         right.String(),
+				RoleIds:        grantedBy[u.Uuid],
+				Reviewer:       reviewer,
+				Status:         review.ItemStatus_PENDING,
+			})
+		}
+	}
+	sort.Slice(items, func(i, j int) bool {
+		if items[i].WorkspaceLabel == items[j].WorkspaceLabel {
+			return items[i].UserLogin < items[j].UserLogin
+		}
+		return items[i].WorkspaceLabel < items[j].WorkspaceLabel
+	})
+	return items, nil
+}
+
+// usersForRole finds the users on which a role applies.
+func usersForRole(ctx context.Context, role *idm.Role) ([]*idm.User, error) {
+	q := &idm.UserSingleQuery{NodeType: idm.NodeType_USER}
+	switch {
+	case role.UserRole:
+		q.Uuid = role.Uuid
+	case role.GroupRole:
+		if role.Uuid == "ROOT_GROUP" {
+			q.GroupPath = "/"
+		} else {
+			grp, er := permissions.SearchUniqueUser(ctx, "", "", &idm.UserSingleQuery{Uuid: role.Uuid, NodeType: idm.NodeType_GROUP})
+			if er != nil {
+				log.Logger(ctx).Warn("Cannot find group for role " + role.Label)
+				return nil, nil
+			}
+			q.GroupPath = path.Join(grp.GroupPath, grp.GroupLabel)
+		}
+		q.Recursive = true
+	case len(role.AutoApplies) > 0:
+		var qq []*anypb.Any
+		for _, p := range role.AutoApplies {
+			a, _ := anypb.New(&idm.UserSingleQuery{HasProfile: p, NodeType: idm.NodeType_USER})
+			qq = append(qq, a)
+		}
+		return searchUsers(ctx, &service.Query{SubQueries: qq, Operation: service.OperationType_OR})
+	default:
+		q.HasRole = role.Uuid
+	}
+	a, _ := anypb.New(q)
+	return searchUsers(ctx, &service.Query{SubQueries: []*anypb.Any{a}})
+}
+
+func searchUsers(ctx context.Context, query *service.Query) (uu []*idm.User, e error) {
+	st, er := idmc.UserServiceClient(ctx).SearchUser(ctx, &idm.SearchUserRequest{Query: query})
+	e = commons.ForEach(st, er, func(resp *idm.SearchUserResponse) error {
+		if u := resp.GetUser(); u != nil && !u.IsGroup {
+			uu = append(uu, u)
+		}
+		return nil
+	})
+	return
+}
+
+// workspaceReviewer finds the login of the workspace owner, defaulting to the campaign reviewer.
+func workspaceReviewer(ctx context.Context, ws *idm.Workspace, campaign *review.Campaign) string {
+	for _, p := range ws.GetPolicies() {
+		if p.Action != service.ResourcePolicyAction_OWNER || p.Effect != service.ResourcePolicy_allow {
+			continue
+		}
+		if owner, er := permissions.SearchUniqueUser(ctx, "", p.Subject); er == nil {
+			return owner.Login
+		}
+	}
+	return campaign.DefaultReviewer
+}
@@ -0,0 +1,304 @@
+/*
+ * Copyright (c) 2025 Abstrium SAS <team (at) pydio.com>
+ * This file is part of Pydio Cells.
+ *
+ * Pydio Cells is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * Pydio Cells is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with Pydio Cells.  If not, see <http://www.gnu.org/licenses/>.
+ *
+ * The latest code can be found at <https://pydio.com>.
+ */
+
+// Package grpc provides the gRPC access reviews service.
+package grpc
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/pydio/cells/v5/common"
+	"github.com/pydio/cells/v5/common/errors"
+	"github.com/pydio/cells/v5/common/proto/review"
+	"github.com/pydio/cells/v5/common/runtime/manager"
+	"github.com/pydio/cells/v5/common/telemetry/log"
+	"github.com/pydio/cells/v5/common/utils/uuid"
+	review2 "github.com/pydio/cells/v5/idm/review"
+)
+
+// Collector computes the items of a campaign: one item per user having access to one of the campaign workspaces.
+type Collector func(ctx context.Context, campaign *review.Campaign) ([]*review.Item, error)
+
+// Revoker applies the revocation of an item and returns a summary of the applied changes.
+type Revoker func(ctx context.Context, item *review.Item) (string, error)
+
+// Notifier informs reviewers and campaigns creators.
+type Notifier interface {
+	// ReviewRequested is called when a campaign starts, for each reviewer
+	ReviewRequested(ctx context.Context, campaign *review.Campaign, reviewer string, items []*review.Item)
+	// Escalated is called with the items still pending after the campaign deadline
+	Escalated(ctx context.Context, campaign *review.Campaign, items []*review.Item)
+}
+
+// Handler implements the AccessReviewService.
+type Handler struct {
+	review.UnimplementedAccessReviewServiceServer
+
+	Collector Collector
+	Revoker   Revoker
+	Notifier  Notifier
+}
+
+// NewHandler creates a Handler resolving accesses through the IDM services and notifying users by email.
+func NewHandler() *Handler {
+	return &Handler{
+		Collector: CollectItems,
+		Revoker:   RevokeAccess,
+		Notifier:  &MailNotifier{},
+	}
+}
+
+// StartCampaign stores a new campaign, computes its items and notifies the reviewers.
+func (h *Handler) StartCampaign(ctx context.Context, req *review.StartCampaignRequest) (*review.StartCampaignResponse, error) {
+	dao, er := manager.Resolve[review2.DAO](ctx)
+	if er != nil {
+		return nil, er
+	}
+	campaign := req.GetCampaign()
+	if campaign == nil || len(campaign.GetWorkspaceIds()) == 0 {
+		return nil, errors.WithMessage(errors.InvalidParameters, "please provide at least one workspace to review")
+	}
+	if campaign.Uuid == "" {
+		campaign.Uuid = uuid.New()
+	}
+	if campaign.DefaultReviewer == "" {
+		campaign.DefaultReviewer = campaign.Creator
+	}
+	campaign.CreatedAt = time.Now().Unix()
+	campaign.Status = review.CampaignStatus_RUNNING
+	campaign.ClosedAt = 0
+
+	items, er := h.Collector(ctx, campaign)
+	if er != nil {
+		return nil, er
+	}
+	if er := dao.PutCampaign(ctx, campaign); er != nil {
+		return nil, er
+	}
+	if er := dao.PutItems(ctx, items...); er != nil {
+		return nil, er
+	}
+	log.Auditer(ctx).Info(
+		fmt.Sprintf("Access review campaign %s started by %s with %d items to review", campaign.Label, campaign.Creator, len(items)),
+		log.GetAuditId(common.AuditReviewCampaignStart),
+	)
+
+	byReviewer := make(map[string][]*review.Item)
+	var reviewers []string
+	for _, i := range items {
+		if _, ok := byReviewer[i.Reviewer]; !ok {
+			reviewers = append(reviewers, i.Reviewer)
+		}
+		byReviewer[i.Reviewer] = append(byReviewer[i.Reviewer], i)
+	}
+	for _, r := range reviewers {
+		h.Notifier.ReviewRequested(ctx, campaign, r, byReviewer[r])
+	}
+
+	if er := h.withStats(ctx, dao, campaign); er != nil {
+		return nil, er
+	}
+	return &review.StartCampaignResponse{Campaign: campaign}, nil
+}
+
+// ListCampaigns lists campaigns with their items statistics.
+func (h *Handler) ListCampaigns(ctx context.Context, req *review.ListCampaignsRequest) (*review.ListCampaignsResponse, error) {
+	dao, er := manager.Resolve[review2.DAO](ctx)
+	if er != nil {
+		return nil, er
+	}
+	var campaigns []*review.Campaign
+	if req.GetUuid() != "" {
+		c, er := dao.GetCampaign(ctx, req.GetUuid())
+		if er != nil {
+			return nil, er
+		}
+		campaigns = append(campaigns, c)
+	} else if campaigns, er = dao.ListCampaigns(ctx, req.GetRunningOnly()); er != nil {
+		return nil, er
+	}
+	for _, c := range campaigns {
+		if er := h.withStats(ctx, dao, c); er != nil {
+			return nil, er
+		}
+	}
+	return &review.ListCampaignsResponse{Campaigns: campaigns}, nil
+}
+
+// CloseCampaign closes a running campaign.
+func (h *Handler) CloseCampaign(ctx context.Context, req *review.CloseCampaignRequest) (*review.CloseCampaignResponse, error) {
+	dao, er := manager.Resolve[review2.DAO](ctx)
+	if er != nil {
+		return nil, er
+	}
+	campaign, er := dao.GetCampaign(ctx, req.GetUuid())
+	if er != nil {
+		return nil, er
+	}
+	if campaign.Status != review.CampaignStatus_CLOSED {
+		campaign.Status = review.CampaignStatus_CLOSED
+		campaign.ClosedAt = time.Now().Unix()
+		if er := dao.PutCampaign(ctx, campaign); er != nil {
+			return nil, er
+		}
+	}
+	if er := h.withStats(ctx, dao, campaign); er != nil {
+		return nil, er
+	}
+	return &review.CloseCampaignResponse{Campaign: campaign}, nil
+}
+
+// ListItems lists items matching the request filters.
+func (h *Handler) ListItems(ctx context.Context, req *review.ListItemsRequest) (*review.ListItemsResponse, error) {
+	dao, er := manager.Resolve[review2.DAO](ctx)
+	if er != nil {
+		return nil, er
+	}
+	items, er := dao.ListItems(ctx, req)
+	if er != nil {
+		return nil, er
+	}
+	return &review.ListItemsResponse{Items: items}, nil
+}
+
+// DecideItems records decisions on pending items of running campaigns. Revocations are applied
+// before the decision is stored: if the revocation fails, the item stays pending.
+func (h *Handler) DecideItems(ctx context.Context, req *review.DecideItemsRequest) (*review.DecideItemsResponse, error) {
+	dao, er := manager.Resolve[review2.DAO](ctx)
+	if er != nil {
+		return nil, er
+	}
+	campaigns := make(map[string]*review.Campaign)
+	resp := &review.DecideItemsResponse{}
+	for _, d := range req.GetDecisions() {
+		if d.GetStatus() != review.ItemStatus_APPROVED && d.GetStatus() != review.ItemStatus_REVOKED {
+			return nil, errors.WithMessage(errors.InvalidParameters, "decision must be either APPROVED or REVOKED")
+		}
+		item, er := dao.GetItem(ctx, d.GetItemUuid())
+		if er != nil {
+			return nil, er
+		}
+		campaign, ok := campaigns[item.CampaignUuid]
+		if !ok {
+			if campaign, er = dao.GetCampaign(ctx, item.CampaignUuid); er != nil {
+				return nil, er
+			}
+			campaigns[item.CampaignUuid] = campaign
+		}
+		if campaign.Status != review.CampaignStatus_RUNNING {
+			return nil, errors.WithMessagef(errors.StatusPreconditionFailed, "campaign %s is closed", campaign.Label)
+		}
+		if item.Status != review.ItemStatus_PENDING {
+			return nil, errors.WithMessagef(errors.StatusConflict, "access of %s to %s was already reviewed", item.UserLogin, item.WorkspaceLabel)
+		}
+
+		auditId := common.AuditReviewItemApprove
+		if d.GetStatus() == review.ItemStatus_REVOKED {
+			applied, er := h.Revoker(ctx, item)
+			if er != nil {
+				return nil, er
+			}
+			item.Applied = applied
+			auditId = common.AuditReviewItemRevoke
+		}
+		item.Status = d.GetStatus()
+		item.Comment = d.GetComment()
+		item.DecidedBy = req.GetDecidedBy()
+		item.DecidedAt = time.Now().Unix()
+		if er := dao.PutItems(ctx, item); er != nil {
+			return nil, er
+		}
+		log.Auditer(ctx).Info(
+			fmt.Sprintf("Access of %s to workspace %s %s by %s (campaign %s)", item.UserLogin, item.WorkspaceLabel, itemStatusLabel(item.Status), item.DecidedBy, campaign.Label),
+			log.GetAuditId(auditId),
+		)
+		resp.Items = append(resp.Items, item)
+	}
+	return resp, nil
+}
+
+// EscalateOverdue notifies campaigns creators of the items still pending after the deadline. Each item is escalated once.
+func (h *Handler) EscalateOverdue(ctx context.Context, req *review.EscalateOverdueRequest) (*review.EscalateOverdueResponse, error) {
+	dao, er := manager.Resolve[review2.DAO](ctx)
+	if er != nil {
+		return nil, er
+	}
+	campaigns, er := dao.ListCampaigns(ctx, true)
+	if er != nil {
+		return nil, er
+	}
+	now := time.Now().Unix()
+	resp := &review.EscalateOverdueResponse{}
+	for _, c := range campaigns {
+		if c.Deadline == 0 || c.Deadline > now {
+			continue
+		}
+		pending, er := dao.ListItems(ctx, &review.ListItemsRequest{CampaignUuid: c.Uuid, Status: []review.ItemStatus{review.ItemStatus_PENDING}})
+		if er != nil {
+			return nil, er
+		}
+		var overdue []*review.Item
+		for _, i := range pending {
+			if i.EscalatedAt == 0 {
+				overdue = append(overdue, i)
+			}
+		}
+		if len(overdue) == 0 {
+			continue
+		}
+		resp.Items = append(resp.Items, overdue...)
+		if req.GetDryRun() {
+			continue
+		}
+		h.Notifier.Escalated(ctx, c, overdue)
+		for _, i := range overdue {
+			i.EscalatedAt = now
+		}
+		if er := dao.PutItems(ctx, overdue...); er != nil {
+			return nil, er
+		}
+	}
+	return resp, nil
+}
+
+func (h *Handler) withStats(ctx context.Context, dao review2.DAO, campaign *review.Campaign) error {
+	counts, er := dao.CountItems(ctx, campaign.Uuid)
+	if er != nil {
+		return er
+	}
+	campaign.Pending = counts[review.ItemStatus_PENDING]
+	campaign.Approved = counts[review.ItemStatus_APPROVED]
+	campaign.Revoked = counts[review.ItemStatus_REVOKED]
+	campaign.Total = campaign.Pending + campaign.Approved + campaign.Revoked
+	return nil
+}
+
+func itemStatusLabel(s review.ItemStatus) string {
+	switch s {
+	case review.ItemStatus_APPROVED:
+		return "approved"
+	case review.ItemStatus_REVOKED:
+		return "revoked"
+	default:
+		return "pending"
+	}
+}
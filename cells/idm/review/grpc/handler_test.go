@@ -0,0 +1,152 @@
+//go:build storage || sql
+
+/*
+ * Copyright (c) 2025 Abstrium SAS <team (at) pydio.com>
+ * This file is part of Pydio Cells.
+ *
+ * Pydio Cells is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * Pydio Cells is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with Pydio Cells.  If not, see <http://www.gnu.org/licenses/>.
+ *
+ * The latest code can be found at <https://pydio.com>.
+ */
+
+package grpc
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/pydio/cells/v5/common/errors"
+	"github.com/pydio/cells/v5/common/proto/review"
+	"github.com/pydio/cells/v5/common/storage/test"
+	"github.com/pydio/cells/v5/idm/review/dao/sql"
+
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+var (
+	testcases = test.TemplateSQL(sql.NewDAO)
+)
+
+type notifierMock struct {
+	requested map[string]int
+	escalated int
+}
+
+func (n *notifierMock) ReviewRequested(_ context.Context, _ *review.Campaign, reviewer string, items []*review.Item) {
+	n.requested[reviewer] += len(items)
+}
+
+func (n *notifierMock) Escalated(_ context.Context, _ *review.Campaign, items []*review.Item) {
+	n.escalated += len(items)
+}
+
+func TestCampaignLifecycle(t *testing.T) {
+
+	test.RunStorageTests(testcases, t, func(ctx context.Context) {
+
+		notifier := &notifierMock{requested: map[string]int{}}
+		var revoked []string
+		h := &Handler{
+			Collector: func(_ context.Context, c *review.Campaign) ([]*review.Item, error) {
+				return []*review.Item{
+					{Uuid: "i1", CampaignUuid: c.Uuid, WorkspaceUuid: "ws1", WorkspaceLabel: "Common", UserUuid: "u1", UserLogin: "alice", Rights: "read", Reviewer: "owner", Status: review.ItemStatus_PENDING},
+					{Uuid: "i2", CampaignUuid: c.Uuid, WorkspaceUuid: "ws1", WorkspaceLabel: "Common", UserUuid: "u2", UserLogin: "bob", Rights: "read,write", Reviewer: "owner", Status: review.ItemStatus_PENDING},
+					{Uuid: "i3", CampaignUuid: c.Uuid, WorkspaceUuid: "ws2", WorkspaceLabel: "Personal", UserUuid: "u2", UserLogin: "bob", Rights: "read", Reviewer: c.DefaultReviewer, Status: review.ItemStatus_PENDING},
+				}, nil
+			},
+			Revoker: func(_ context.Context, item *review.Item) (string, error) {
+				revoked = append(revoked, item.Uuid)
+				return "removed 1 user ACL(s)", nil
+			},
+			Notifier: notifier,
+		}
+
+		Convey("Start campaign", t, func() {
+			_, er := h.StartCampaign(ctx, &review.StartCampaignRequest{Campaign: &review.Campaign{Label: "Empty"}})
+			So(er, ShouldNotBeNil)
+			So(errors.Is(er, errors.InvalidParameters), ShouldBeTrue)
+
+			resp, er := h.StartCampaign(ctx, &review.StartCampaignRequest{Campaign: &review.Campaign{
+				Uuid:         "c1",
+				Label:        "Q1",
+				WorkspaceIds: []string{"ws1", "ws2"},
+				Creator:      "admin",
+				Deadline:     time.Now().Add(-time.Hour).Unix(),
+			}})
+			So(er, ShouldBeNil)
+			c := resp.GetCampaign()
+			So(c.Status, ShouldEqual, review.CampaignStatus_RUNNING)
+			So(c.DefaultReviewer, ShouldEqual, "admin")
+			So(c.Total, ShouldEqual, 3)
+			So(c.Pending, ShouldEqual, 3)
+			So(notifier.requested["owner"], ShouldEqual, 2)
+			So(notifier.requested["admin"], ShouldEqual, 1)
+		})
+
+		Convey("Decide items", t, func() {
+			resp, er := h.DecideItems(ctx, &review.DecideItemsRequest{DecidedBy: "owner", Decisions: []*review.ItemDecision{
+				{ItemUuid: "i1", Status: review.ItemStatus_APPROVED},
+				{ItemUuid: "i2", Status: review.ItemStatus_REVOKED, Comment: "left the team"},
+			}})
+			So(er, ShouldBeNil)
+			So(resp.GetItems(), ShouldHaveLength, 2)
+			So(revoked, ShouldResemble, []string{"i2"})
+			So(resp.GetItems()[1].Applied, ShouldEqual, "removed 1 user ACL(s)")
+			So(resp.GetItems()[1].DecidedBy, ShouldEqual, "owner")
+
+			_, er = h.DecideItems(ctx, &review.DecideItemsRequest{DecidedBy: "owner", Decisions: []*review.ItemDecision{{ItemUuid: "i1", Status: review.ItemStatus_REVOKED}}})
+			So(errors.Is(er, errors.StatusConflict), ShouldBeTrue)
+			_, er = h.DecideItems(ctx, &review.DecideItemsRequest{Decisions: []*review.ItemDecision{{ItemUuid: "i3", Status: review.ItemStatus_PENDING}}})
+			So(errors.Is(er, errors.InvalidParameters), ShouldBeTrue)
+
+			list, er := h.ListCampaigns(ctx, &review.ListCampaignsRequest{Uuid: "c1"})
+			So(er, ShouldBeNil)
+			So(list.GetCampaigns()[0].Approved, ShouldEqual, 1)
+			So(list.GetCampaigns()[0].Revoked, ShouldEqual, 1)
+			So(list.GetCampaigns()[0].Pending, ShouldEqual, 1)
+		})
+
+		Convey("Escalate overdue items once", t, func() {
+			dry, er := h.EscalateOverdue(ctx, &review.EscalateOverdueRequest{DryRun: true})
+			So(er, ShouldBeNil)
+			So(dry.GetItems(), ShouldHaveLength, 1)
+			So(notifier.escalated, ShouldEqual, 0)
+
+			resp, er := h.EscalateOverdue(ctx, &review.EscalateOverdueRequest{})
+			So(er, ShouldBeNil)
+			So(resp.GetItems(), ShouldHaveLength, 1)
+			So(resp.GetItems()[0].Uuid, ShouldEqual, "i3")
+			So(notifier.escalated, ShouldEqual, 1)
+
+			resp, er = h.EscalateOverdue(ctx, &review.EscalateOverdueRequest{})
+			So(er, ShouldBeNil)
+			So(resp.GetItems(), ShouldHaveLength, 0)
+		})
+
+		Convey("Close campaign", t, func() {
+			resp, er := h.CloseCampaign(ctx, &review.CloseCampaignRequest{Uuid: "c1"})
+			So(er, ShouldBeNil)
+			So(resp.GetCampaign().Status, ShouldEqual, review.CampaignStatus_CLOSED)
+
+			_, er = h.DecideItems(ctx, &review.DecideItemsRequest{Decisions: []*review.ItemDecision{{ItemUuid: "i3", Status: review.ItemStatus_APPROVED}}})
+			So(errors.Is(er, errors.StatusPreconditionFailed), ShouldBeTrue)
+
+			items, er := h.ListItems(ctx, &review.ListItemsRequest{CampaignUuid: "c1", Status: []review.ItemStatus{review.ItemStatus_PENDING}})
+			So(er, ShouldBeNil)
+			So(items.GetItems(), ShouldHaveLength, 1)
+			So(items.GetItems()[0].EscalatedAt, ShouldNotEqual, 0)
+		})
+	})
+}
@@ -0,0 +1,100 @@
+/*
+ * Copyright (c) 2025 Abstrium SAS <team (at) pydio.com>
+ * This file is part of Pydio Cells.
+ *
+ * Pydio Cells is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * Pydio Cells is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with Pydio Cells.  If not, see <http://www.gnu.org/licenses/>.
+ *
+ * The latest code can be found at <https://pydio.com>.
+ */
+
+package grpc
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/pydio/cells/v5/common"
+	"github.com/pydio/cells/v5/common/client/grpc"
+	"github.com/pydio/cells/v5/common/permissions"
+	"github.com/pydio/cells/v5/common/proto/mailer"
+	"github.com/pydio/cells/v5/common/proto/review"
+	"github.com/pydio/cells/v5/common/telemetry/log"
+	"github.com/pydio/cells/v5/common/utils/i18n/languages"
+)
+
+// MailNotifier sends review requests and escalations by email.
+type MailNotifier struct{}
+
+// ReviewRequested sends the list of accesses to review to the reviewer.
+func (m *MailNotifier) ReviewRequested(ctx context.Context, campaign *review.Campaign, reviewer string, items []*review.Item) {
+	data := map[string]string{
+		"Campaign": campaign.Label,
+		"Count":    fmt.Sprintf("%d", len(items)),
+	}
+	if campaign.Deadline > 0 {
+		data["Deadline"] = time.Unix(campaign.Deadline, 0).Format("2006-01-02")
+	}
+	m.send(ctx, reviewer, "AccessReviewRequest", data, items)
+}
+
+// Escalated sends the list of overdue accesses to the campaign creator.
+func (m *MailNotifier) Escalated(ctx context.Context, campaign *review.Campaign, items []*review.Item) {
+	m.send(ctx, campaign.Creator, "AccessReviewEscalation", map[string]string{
+		"Campaign": campaign.Label,
+		"Count":    fmt.Sprintf("%d", len(items)),
+	}, items)
+}
+
+func (m *MailNotifier) send(ctx context.Context, login, templateId string, data map[string]string, items []*review.Item) {
+	if login == "" {
+		return
+	}
+	u, er := permissions.SearchUniqueUser(ctx, login, "")
+	if er != nil || u.GetAttributes()["email"] == "" {
+		log.Logger(ctx).Warn("Cannot find an email address to notify " + login + " about access review")
+		return
+	}
+	var md []string
+	var current string
+	for _, i := range items {
+		if i.WorkspaceUuid != current {
+			current = i.WorkspaceUuid
+			md = append(md, "", "**"+i.WorkspaceLabel+"**", "")
+		}
+		line := fmt.Sprintf(" - %s (%s)", i.UserLogin, i.Rights)
+		if templateId == "AccessReviewEscalation" {
+			line += " - reviewer: " + i.Reviewer
+		}
+		md = append(md, line)
+	}
+	if _, e := mailer.NewMailerServiceClient(grpc.ResolveConn(ctx, common.ServiceMailerGRPC)).SendMail(ctx, &mailer.SendMailRequest{
+		Mail: &mailer.Mail{
+			To: []*mailer.User{{
+				Uuid:     u.GetUuid(),
+				Name:     u.GetAttributes()["displayName"],
+				Address:  u.GetAttributes()["email"],
+				Language: languages.UserLanguage(ctx, u),
+			}},
+			TemplateId:      templateId,
+			TemplateData:    data,
+			ContentMarkdown: strings.Join(md, "\n"),
+		},
+	}); e != nil {
+		log.Logger(ctx).Error("Cannot send access review email to "+login, zap.Error(e))
+	}
+}
@@ -0,0 +1,85 @@
+/*
+ * Copyright (c) 2025 Abstrium SAS <team (at) pydio.com>
+ * This file is part of Pydio Cells.
+ *
+ * Pydio Cells is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * Pydio Cells is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with Pydio Cells.  If not, see <http://www.gnu.org/licenses/>.
+ *
+ * The latest code can be found at <https://pydio.com>.
+ */
+
+package grpc
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"google.golang.org/protobuf/types/known/anypb"
+
+	"github.com/pydio/cells/v5/common/client/commons/idmc"
+	"github.com/pydio/cells/v5/common/permissions"
+	"github.com/pydio/cells/v5/common/proto/idm"
+	"github.com/pydio/cells/v5/common/proto/review"
+	"github.com/pydio/cells/v5/common/proto/service"
+)
+
+// RevokeAccess removes the read/write ACLs set on the workspace for the user role. If the user still
+// reaches the workspace through other roles (groups, profiles, ...), deny ACLs are added on the workspace roots
+// for the user role, as the last role applied wins.
+func RevokeAccess(ctx context.Context, item *review.Item) (string, error) {
+	aclClient := idmc.ACLServiceClient(ctx)
+	q, _ := anypb.New(&idm.ACLSingleQuery{
+		RoleIDs:      []string{item.UserUuid},
+		WorkspaceIDs: []string{item.WorkspaceUuid},
+		Actions:      []*idm.ACLAction{permissions.AclRead, permissions.AclWrite},
+	})
+	resp, er := aclClient.DeleteACL(ctx, &idm.DeleteACLRequest{Query: &service.Query{SubQueries: []*anypb.Any{q}}})
+	if er != nil {
+		return "", er
+	}
+	var applied []string
+	if resp.GetRowsDeleted() > 0 {
+		applied = append(applied, fmt.Sprintf("removed %d user ACL(s)", resp.GetRowsDeleted()))
+	}
+
+	u, er := permissions.SearchUniqueUser(ctx, "", item.UserUuid)
+	if er != nil {
+		return "", er
+	}
+	accessList, er := permissions.AccessListFromRoles(ctx, u.Roles, true, false)
+	if er != nil {
+		return "", er
+	}
+	if _, still := accessList.DetectedWsRights(ctx)[item.WorkspaceUuid]; still {
+		ws, er := permissions.SearchUniqueWorkspace(ctx, item.WorkspaceUuid, "")
+		if er != nil {
+			return "", er
+		}
+		for _, root := range ws.GetRootUUIDs() {
+			if _, er := aclClient.CreateACL(ctx, &idm.CreateACLRequest{ACL: &idm.ACL{
+				Action:      permissions.AclDeny,
+				RoleID:      item.UserUuid,
+				WorkspaceID: item.WorkspaceUuid,
+				NodeID:      root,
+			}}); er != nil {
+				return "", er
+			}
+		}
+		applied = append(applied, fmt.Sprintf("denied access on %d workspace root(s)", len(ws.GetRootUUIDs())))
+	}
+	if len(applied) == 0 {
+		return "no access left to revoke", nil
+	}
+	return strings.Join(applied, ", "), nil
+}
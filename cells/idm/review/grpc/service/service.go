@@ -0,0 +1,60 @@
+/*
+ * Copyright (c) 2025 Abstrium SAS <team (at) pydio.com>
+ * This file is part of Pydio Cells.
+ *
+ * Pydio Cells is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * Pydio Cells is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with Pydio Cells.  If not, see <http://www.gnu.org/licenses/>.
+ *
+ * The latest code can be found at <https://pydio.com>.
+ */
+
+// Package service exposes the access reviews persistence and logic as a GRPC service
+package service
+
+import (
+	"context"
+
+	"google.golang.org/grpc"
+
+	"github.com/pydio/cells/v5/common"
+	proto "github.com/pydio/cells/v5/common/proto/review"
+	"github.com/pydio/cells/v5/common/runtime"
+	"github.com/pydio/cells/v5/common/runtime/manager"
+	"github.com/pydio/cells/v5/common/service"
+	"github.com/pydio/cells/v5/idm/review"
+	grpc2 "github.com/pydio/cells/v5/idm/review/grpc"
+)
+
+const Name = common.ServiceReviewGRPC
+
+func init() {
+	runtime.Register("main", func(ctx context.Context) {
+		service.NewService(
+			service.Name(Name),
+			service.Context(ctx),
+			service.Tag(common.ServiceTagIdm),
+			service.Description("Access reviews campaigns"),
+			service.WithStorageDrivers(review.Drivers),
+			service.Migrations([]*service.Migration{
+				{
+					TargetVersion: service.FirstRunOrChange(),
+					Up:            manager.StorageMigration(),
+				},
+			}),
+			service.WithGRPC(func(ctx context.Context, server grpc.ServiceRegistrar) error {
+				proto.RegisterAccessReviewServiceServer(server, grpc2.NewHandler())
+				return nil
+			}),
+		)
+	})
+}
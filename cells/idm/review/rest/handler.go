@@ -0,0 +1,176 @@
+/*
+ * Copyright (c) 2025 Abstrium SAS <team (at) pydio.com>
+ * This file is part of Pydio Cells.
+ *
+ * Pydio Cells is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * Pydio Cells is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with Pydio Cells.  If not, see <http://www.gnu.org/licenses/>.
+ *
+ * The latest code can be found at <https://pydio.com>.
+ */
+
+// Package rest exposes access reviews campaigns to administrators and reviewers.
+package rest
+
+import (
+	"context"
+
+	restful "github.com/emicklei/go-restful/v3"
+
+	"github.com/pydio/cells/v5/common"
+	"github.com/pydio/cells/v5/common/auth/claim"
+	"github.com/pydio/cells/v5/common/client/grpc"
+	"github.com/pydio/cells/v5/common/errors"
+	"github.com/pydio/cells/v5/common/proto/review"
+)
+
+// ReviewHandler responds to access reviews REST requests
+type ReviewHandler struct {
+	RuntimeCtx context.Context
+}
+
+func NewReviewHandler(ctx context.Context) *ReviewHandler {
+	return &ReviewHandler{RuntimeCtx: ctx}
+}
+
+// SwaggerTags list the names of the service tags declared in the swagger json implemented by this service
+func (h *ReviewHandler) SwaggerTags() []string {
+	return []string{"AccessReviewService"}
+}
+
+// Filter returns a function to filter the swagger path
+func (h *ReviewHandler) Filter() func(string) string {
+	return nil
+}
+
+func (h *ReviewHandler) getClient(ctx context.Context) review.AccessReviewServiceClient {
+	return review.NewAccessReviewServiceClient(grpc.ResolveConn(ctx, common.ServiceReviewGRPC))
+}
+
+// StartReviewCampaign starts a new campaign, the current user being its creator
+func (h *ReviewHandler) StartReviewCampaign(req *restful.Request, rsp *restful.Response) error {
+	ctx := req.Request.Context()
+	claims, er := adminClaims(ctx)
+	if er != nil {
+		return er
+	}
+	var input review.Campaign
+	if e := req.ReadEntity(&input); e != nil {
+		return e
+	}
+	if input.GetLabel() == "" {
+		return errors.WithMessage(errors.InvalidParameters, "please provide a label for the campaign")
+	}
+	input.Creator = claims.Name
+	resp, er := h.getClient(ctx).StartCampaign(ctx, &review.StartCampaignRequest{Campaign: &input})
+	if er != nil {
+		return er
+	}
+	return rsp.WriteEntity(resp.GetCampaign())
+}
+
+// ListReviewCampaigns lists campaigns
+func (h *ReviewHandler) ListReviewCampaigns(req *restful.Request, rsp *restful.Response) error {
+	ctx := req.Request.Context()
+	if _, er := adminClaims(ctx); er != nil {
+		return er
+	}
+	var input review.ListCampaignsRequest
+	if e := req.ReadEntity(&input); e != nil {
+		return e
+	}
+	resp, er := h.getClient(ctx).ListCampaigns(ctx, &input)
+	if er != nil {
+		return er
+	}
+	return rsp.WriteEntity(resp)
+}
+
+// CloseReviewCampaign closes a running campaign
+func (h *ReviewHandler) CloseReviewCampaign(req *restful.Request, rsp *restful.Response) error {
+	ctx := req.Request.Context()
+	if _, er := adminClaims(ctx); er != nil {
+		return er
+	}
+	resp, er := h.getClient(ctx).CloseCampaign(ctx, &review.CloseCampaignRequest{Uuid: req.PathParameter("Uuid")})
+	if er != nil {
+		return er
+	}
+	return rsp.WriteEntity(resp.GetCampaign())
+}
+
+// ListReviewItems lists items. Users that are not admins are restricted to the items they must review.
+func (h *ReviewHandler) ListReviewItems(req *restful.Request, rsp *restful.Response) error {
+	ctx := req.Request.Context()
+	claims, ok := claim.FromContext(ctx)
+	if !ok {
+		return errors.WithMessage(errors.StatusUnauthorized, "cannot find claims in context")
+	}
+	var input review.ListItemsRequest
+	if e := req.ReadEntity(&input); e != nil {
+		return e
+	}
+	if claims.Profile != common.PydioProfileAdmin {
+		input.Reviewer = claims.Name
+	}
+	resp, er := h.getClient(ctx).ListItems(ctx, &input)
+	if er != nil {
+		return er
+	}
+	return rsp.WriteEntity(resp)
+}
+
+// DecideReviewItems approves or revokes items. Users that are not admins can only decide on the items they must review.
+func (h *ReviewHandler) DecideReviewItems(req *restful.Request, rsp *restful.Response) error {
+	ctx := req.Request.Context()
+	claims, ok := claim.FromContext(ctx)
+	if !ok {
+		return errors.WithMessage(errors.StatusUnauthorized, "cannot find claims in context")
+	}
+	var input review.DecideItemsRequest
+	if e := req.ReadEntity(&input); e != nil {
+		return e
+	}
+	if len(input.GetDecisions()) == 0 {
+		return errors.WithMessage(errors.InvalidParameters, "please provide at least one decision")
+	}
+	cli := h.getClient(ctx)
+	if claims.Profile != common.PydioProfileAdmin {
+		own, er := cli.ListItems(ctx, &review.ListItemsRequest{Reviewer: claims.Name, Status: []review.ItemStatus{review.ItemStatus_PENDING}})
+		if er != nil {
+			return er
+		}
+		allowed := make(map[string]struct{}, len(own.GetItems()))
+		for _, i := range own.GetItems() {
+			allowed[i.Uuid] = struct{}{}
+		}
+		for _, d := range input.GetDecisions() {
+			if _, ok := allowed[d.GetItemUuid()]; !ok {
+				return errors.WithMessagef(errors.StatusForbidden, "you are not the reviewer of item %s", d.GetItemUuid())
+			}
+		}
+	}
+	input.DecidedBy = claims.Name
+	resp, er := cli.DecideItems(ctx, &input)
+	if er != nil {
+		return er
+	}
+	return rsp.WriteEntity(resp)
+}
+
+func adminClaims(ctx context.Context) (claim.Claims, error) {
+	claims, ok := claim.FromContext(ctx)
+	if !ok || claims.Profile != common.PydioProfileAdmin {
+		return claims, errors.WithMessage(errors.StatusForbidden, "this action is restricted to administrators")
+	}
+	return claims, nil
+}
@@ -0,0 +1,51 @@
+/*
+ * Copyright (c) 2025 Abstrium SAS <team (at) pydio.com>
+ * This file is part of Pydio Cells.
+ *
+ * Pydio Cells is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * Pydio Cells is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with Pydio Cells.  If not, see <http://www.gnu.org/licenses/>.
+ *
+ * The latest code can be found at <https://pydio.com>.
+ */
+
+// Package review provides access reviews: certification campaigns where workspaces owners approve or revoke
+// the accesses of users to their workspaces.
+package review
+
+import (
+	"context"
+
+	"github.com/pydio/cells/v5/common/errors"
+	"github.com/pydio/cells/v5/common/proto/review"
+	"github.com/pydio/cells/v5/common/service"
+)
+
+var (
+	Drivers = service.StorageDrivers{}
+
+	ErrCampaignNotFound = errors.RegisterBaseSentinel(errors.StatusNotFound, "access review campaign not found")
+	ErrItemNotFound     = errors.RegisterBaseSentinel(errors.StatusNotFound, "access review item not found")
+)
+
+// DAO is the persistence layer for campaigns and their items.
+type DAO interface {
+	Migrate(ctx context.Context) error
+	PutCampaign(ctx context.Context, campaign *review.Campaign) error
+	GetCampaign(ctx context.Context, uuid string) (*review.Campaign, error)
+	ListCampaigns(ctx context.Context, runningOnly bool) ([]*review.Campaign, error)
+	PutItems(ctx context.Context, items ...*review.Item) error
+	GetItem(ctx context.Context, uuid string) (*review.Item, error)
+	ListItems(ctx context.Context, filter *review.ListItemsRequest) ([]*review.Item, error)
+	// CountItems counts items of a campaign by status
+	CountItems(ctx context.Context, campaignUuid string) (map[review.ItemStatus]int32, error)
+}
@@ -0,0 +1,159 @@
+/*
+ * Copyright (c) 2025 Abstrium SAS <team (at) pydio.com>
+ * This file is part of Pydio Cells.
+ *
+ * Pydio Cells is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * Pydio Cells is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with Pydio Cells.  If not, see <http://www.gnu.org/licenses/>.
+ *
+ * The latest code can be found at <https://pydio.com>.
+ */
+
+package rest
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	restful "github.com/emicklei/go-restful/v3"
+	"go.uber.org/zap"
+	"google.golang.org/protobuf/types/known/anypb"
+
+	"github.com/pydio/cells/v5/common"
+	"github.com/pydio/cells/v5/common/auth/claim"
+	"github.com/pydio/cells/v5/common/client/commons"
+	"github.com/pydio/cells/v5/common/client/commons/idmc"
+	"github.com/pydio/cells/v5/common/errors"
+	"github.com/pydio/cells/v5/common/permissions"
+	"github.com/pydio/cells/v5/common/proto/idm"
+	"github.com/pydio/cells/v5/common/proto/rest"
+	serviceproto "github.com/pydio/cells/v5/common/proto/service"
+	"github.com/pydio/cells/v5/common/telemetry/log"
+	"github.com/pydio/cells/v5/idm/user/delegation"
+)
+
+// ListDelegatedAdmins lists administration delegations. Admins see all delegations, other users only the ones
+// granted to them through their roles.
+func (s *RoleHandler) ListDelegatedAdmins(req *restful.Request, rsp *restful.Response) error {
+	ctx := req.Request.Context()
+	claims, ok := claim.FromContext(ctx)
+	if !ok {
+		return errors.WithStack(errors.MissingClaims)
+	}
+	all, er := delegation.List(ctx)
+	if er != nil {
+		return er
+	}
+	if claims.Profile != common.PydioProfileAdmin {
+		all = all.ForRoles(strings.Split(claims.Roles, ","))
+	}
+	out := &rest.DelegatedAdminCollection{}
+	filter := req.QueryParameter("RoleUuid")
+	for _, d := range all {
+		if filter == "" || d.GetRoleUuid() == filter {
+			out.Delegations = append(out.Delegations, d)
+		}
+	}
+	return rsp.WriteEntity(out)
+}
+
+// PutDelegatedAdmin grants the members of a role the administration of a group subtree, restricted to admins.
+func (s *RoleHandler) PutDelegatedAdmin(req *restful.Request, rsp *restful.Response) error {
+	ctx := req.Request.Context()
+	if er := s.restrictToAdmin(ctx); er != nil {
+		return er
+	}
+	var input rest.DelegatedAdmin
+	if er := req.ReadEntity(&input); er != nil {
+		return er
+	}
+	input.RoleUuid = req.PathParameter("RoleUuid")
+	role, er := s.findRole(ctx, input.RoleUuid)
+	if er != nil {
+		return er
+	}
+	if role.GetUserRole() || role.GetGroupRole() {
+		return errors.WithMessage(errors.InvalidParameters, "delegations cannot be granted to personal or group roles")
+	}
+	input.RoleLabel = role.GetLabel()
+	input.GroupPath = "/" + strings.Trim(input.GroupPath, "/")
+	if input.GroupPath != "/" {
+		if _, ok := permissions.GroupExists(ctx, input.GroupPath); !ok {
+			return errors.WithMessagef(errors.InvalidParameters, "cannot find group %s", input.GroupPath)
+		}
+	}
+	for _, r := range input.GetAssignableRoles() {
+		ar, e := s.findRole(ctx, r)
+		if e != nil {
+			return e
+		}
+		if ar.GetUserRole() || ar.GetGroupRole() {
+			return errors.WithMessagef(errors.InvalidParameters, "role %s cannot be assigned", r)
+		}
+	}
+	if er = delegation.Set(ctx, &input); er != nil {
+		return er
+	}
+	log.Auditer(ctx).Info(
+		fmt.Sprintf("Delegated administration of [%s] to role [%s]", input.GroupPath, input.RoleLabel),
+		log.GetAuditId(common.AuditRoleUpdate),
+		zap.String(common.KeyRoleUuid, input.RoleUuid),
+	)
+	return rsp.WriteEntity(&input)
+}
+
+// DeleteDelegatedAdmin revokes the delegation granted to a role, restricted to admins.
+func (s *RoleHandler) DeleteDelegatedAdmin(req *restful.Request, rsp *restful.Response) error {
+	ctx := req.Request.Context()
+	if er := s.restrictToAdmin(ctx); er != nil {
+		return er
+	}
+	roleUuid := req.PathParameter("RoleUuid")
+	d, er := delegation.Get(ctx, roleUuid)
+	if er != nil {
+		return er
+	}
+	if er = delegation.Revoke(ctx, roleUuid); er != nil {
+		return er
+	}
+	log.Auditer(ctx).Info(
+		fmt.Sprintf("Revoked administration of [%s] from role [%s]", d.GetGroupPath(), d.GetRoleLabel()),
+		log.GetAuditId(common.AuditRoleUpdate),
+		zap.String(common.KeyRoleUuid, roleUuid),
+	)
+	return rsp.WriteEntity(d)
+}
+
+func (s *RoleHandler) restrictToAdmin(ctx context.Context) error {
+	if claims, ok := claim.FromContext(ctx); !ok || claims.Profile != common.PydioProfileAdmin {
+		return errors.WithMessage(errors.StatusForbidden, "delegations are managed by administrators")
+	}
+	return nil
+}
+
+func (s *RoleHandler) findRole(ctx context.Context, uuid string) (role *idm.Role, er error) {
+	query, _ := anypb.New(&idm.RoleSingleQuery{Uuid: []string{uuid}})
+	streamer, err := idmc.RoleServiceClient(ctx).SearchRole(ctx, &idm.SearchRoleRequest{
+		Query: &serviceproto.Query{SubQueries: []*anypb.Any{query}},
+	})
+	if er = commons.ForEach(streamer, err, func(resp *idm.SearchRoleResponse) error {
+		role = resp.GetRole()
+		return nil
+	}); er != nil {
+		return
+	}
+	if role == nil {
+		er = errors.WithMessagef(errors.RoleNotFound, "cannot find role with uuid %s", uuid)
+	}
+	return
+}
@@ -29,6 +29,7 @@ import (
 	"google.golang.org/protobuf/types/known/anypb"
 
 	"github.com/pydio/cells/v5/common"
+	"github.com/pydio/cells/v5/common/auth/claim"
 	"github.com/pydio/cells/v5/common/client/commons"
 	"github.com/pydio/cells/v5/common/client/commons/idmc"
 	"github.com/pydio/cells/v5/common/errors"
@@ -37,6 +38,7 @@ import (
 	serviceproto "github.com/pydio/cells/v5/common/proto/service"
 	"github.com/pydio/cells/v5/common/service/resources"
 	"github.com/pydio/cells/v5/common/telemetry/log"
+	"github.com/pydio/cells/v5/idm/user/delegation"
 )
 
 // NewRoleHandler creates and configure a new RoleHandler
@@ -162,6 +164,12 @@ func (s *RoleHandler) DeleteRole(req *restful.Request, rsp *restful.Response) er
 	if e != nil {
 		return e
 	}
+	// Revoke any delegation granted to this role
+	if _, er := delegation.Get(ctx, uuid); er == nil {
+		if er = delegation.Revoke(ctx, uuid); er != nil {
+			log.Logger(ctx).Error("Cannot revoke delegation for deleted role", zap.String(common.KeyRoleUuid, uuid), zap.Error(er))
+		}
+	}
 
 	log.Auditer(ctx).Info(
 		fmt.Sprintf("Deleted role [%s]", uuid),
@@ -189,6 +197,12 @@ func (s *RoleHandler) SetRole(req *restful.Request, rsp *restful.Response) error
 	if checkError := s.IsAllowed(ctx, inputRole.Uuid, serviceproto.ResourcePolicyAction_WRITE, cl); checkError != nil && !errors.Is(checkError, errors.StatusNotFound) {
 		return checkError
 	}
+	// Policies of existing roles can only be changed by admins, as they carry delegations
+	if claims, ok := claim.FromContext(ctx); !ok || claims.Profile != common.PydioProfileAdmin {
+		if existing, er := s.PoliciesForRole(ctx, inputRole.Uuid, cl); er == nil {
+			inputRole.Policies = existing
+		}
+	}
 	// in fact create or update
 	resp, er := cl.CreateRole(ctx, &idm.CreateRoleRequest{
 		Role: &inputRole,
@@ -109,6 +109,14 @@ func (sc *Client) WorkspaceToShareLinkObject(ctx context.Context, workspace *idm
 	for _, rootId := range detectedRoots {
 		shareLink.RootNodes = append(shareLink.RootNodes, &tree.Node{Uuid: rootId})
 	}
+	if fr := workspace.LoadAttributes().FileRequest; fr != nil {
+		shareLink.FileRequest = &rest.ShareLinkFileRequest{
+			AllowedExtensions: fr.AllowedExtensions,
+			MaxFileSize:       fr.MaxFileSize,
+			MaxTotalSize:      fr.MaxTotalSize,
+			NotifyOwner:       fr.NotifyOwner,
+		}
+	}
 
 	if err := sc.LoadHashDocumentData(ctx, shareLink, acls); err != nil {
 		return nil, err
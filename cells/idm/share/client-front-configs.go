@@ -74,6 +74,15 @@ func (sc *Client) CheckLinkOptionsAgainstConfigs(ctx context.Context, link *rest
 	if options.ShareForcePassword && !link.PasswordRequired {
 		return options, errors.WithStack(errors.ShareLinkPasswordRequired)
 	}
+	if link.FileRequest != nil && (files || len(link.RootNodes) != 1) {
+		return options, errors.WithMessage(errors.InvalidParameters, "file requests can only be created on a single folder")
+	}
+	if fr := link.FileRequest; fr != nil && (fr.MaxFileSize < 0 || fr.MaxTotalSize < 0) {
+		return options, errors.WithMessage(errors.InvalidParameters, "file request size limits cannot be negative")
+	}
+	if link.AccessStart > 0 && link.AccessEnd > 0 && link.AccessStart >= link.AccessEnd {
+		return options, errors.WithMessage(errors.InvalidParameters, "link start date must be before its expiration date")
+	}
 
 	return options, nil
 }
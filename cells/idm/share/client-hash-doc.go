@@ -22,6 +22,7 @@ package share
 
 import (
 	"context"
+	"time"
 
 	"github.com/pydio/cells/v5/common"
 	"github.com/pydio/cells/v5/common/client/commons/docstorec"
@@ -45,9 +46,15 @@ func (sc *Client) StoreHashDocument(ctx context.Context, ownerUser *idm.User, li
 		TemplateName:  link.ViewTemplateName,
 		RepositoryId:  link.Uuid,
 		ExpireTime:    link.AccessEnd,
+		StartTime:     link.AccessStart,
 		DownloadLimit: link.MaxDownloads,
+		Watermark:     link.Watermark,
 		ShareType:     "minisite",
 	}
+	// Owner is only notified for state changes that happen after the link is stored
+	now := time.Now()
+	hashDoc.ActivationNotified = !hashDoc.IsPending(now)
+	hashDoc.ExpirationNotified = hashDoc.IsExpired(now)
 
 	if link.PasswordRequired {
 		hashDoc.PresetLogin = link.UserLogin
@@ -132,9 +139,12 @@ func (sc *Client) LoadHashDocumentData(ctx context.Context, shareLink *rest.Shar
 	var linkData *docstore.ShareDocument
 	if err := json.Unmarshal([]byte(linkDoc.Data), &linkData); err == nil {
 		shareLink.ViewTemplateName = linkData.TemplateName
+		shareLink.AccessStart = linkData.StartTime
 		shareLink.AccessEnd = linkData.ExpireTime
 		shareLink.MaxDownloads = linkData.DownloadLimit
 		shareLink.CurrentDownloads = linkData.DownloadCount
+		shareLink.State = LinkState(linkData, time.Now())
+		shareLink.Watermark = linkData.Watermark
 		if linkData.PresetLogin != "" {
 			shareLink.PasswordRequired = true
 			shareLink.UserLogin = linkData.PresetLogin
@@ -185,6 +195,20 @@ func (sc *Client) LoadHashDocumentData(ctx context.Context, shareLink *rest.Shar
 
 }
 
+// LinkState computes the current state of a link from its dates and downloads counter.
+func LinkState(linkData *docstore.ShareDocument, now time.Time) rest.ShareLinkState {
+	switch {
+	case linkData.IsExpired(now):
+		return rest.ShareLinkState_LinkExpired
+	case linkData.IsExhausted():
+		return rest.ShareLinkState_LinkExhausted
+	case linkData.IsPending(now):
+		return rest.ShareLinkState_LinkPending
+	default:
+		return rest.ShareLinkState_LinkActive
+	}
+}
+
 // DeleteHashDocument removes link data from the storage.
 func (sc *Client) DeleteHashDocument(ctx context.Context, shareId string) error {
 
@@ -0,0 +1,49 @@
+/*
+ * Copyright (c) 2025 Abstrium SAS <team (at) pydio.com>
+ * This file is part of Pydio Cells.
+ *
+ * Pydio Cells is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * Pydio Cells is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with Pydio Cells.  If not, see <http://www.gnu.org/licenses/>.
+ *
+ * The latest code can be found at <https://pydio.com>.
+ */
+
+package share
+
+import (
+	"testing"
+	"time"
+
+	"github.com/pydio/cells/v5/common/proto/docstore"
+	"github.com/pydio/cells/v5/common/proto/rest"
+
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestLinkState(t *testing.T) {
+
+	Convey("Test link state computation", t, func() {
+
+		now := time.Now()
+		hour := int64(3600)
+
+		So(LinkState(&docstore.ShareDocument{}, now), ShouldEqual, rest.ShareLinkState_LinkActive)
+		So(LinkState(&docstore.ShareDocument{StartTime: now.Unix() - hour, ExpireTime: now.Unix() + hour}, now), ShouldEqual, rest.ShareLinkState_LinkActive)
+		So(LinkState(&docstore.ShareDocument{StartTime: now.Unix() + hour}, now), ShouldEqual, rest.ShareLinkState_LinkPending)
+		So(LinkState(&docstore.ShareDocument{ExpireTime: now.Unix() - hour}, now), ShouldEqual, rest.ShareLinkState_LinkExpired)
+		So(LinkState(&docstore.ShareDocument{DownloadLimit: 2, DownloadCount: 2}, now), ShouldEqual, rest.ShareLinkState_LinkExhausted)
+		So(LinkState(&docstore.ShareDocument{DownloadLimit: 2, DownloadCount: 1}, now), ShouldEqual, rest.ShareLinkState_LinkActive)
+
+	})
+
+}
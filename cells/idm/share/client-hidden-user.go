@@ -23,6 +23,7 @@ package share
 import (
 	"context"
 	"strings"
+	"time"
 
 	"go.uber.org/zap"
 	"google.golang.org/protobuf/types/known/anypb"
@@ -36,6 +37,7 @@ import (
 	"github.com/pydio/cells/v5/common/proto/tree"
 	"github.com/pydio/cells/v5/common/telemetry/log"
 	"github.com/pydio/cells/v5/common/utils/uuid"
+	"github.com/pydio/cells/v5/idm/user/guest"
 )
 
 // GetOrCreateHiddenUser will load or create a user to create a ShareLink with.
@@ -72,6 +74,7 @@ func (sc *Client) GetOrCreateHiddenUser(ctx context.Context, ownerUser *idm.User
 		if passwordHashed {
 			hiddenUser.Attributes[idm.UserAttrPassHashed] = "true"
 		}
+		guest.LoadPolicy(ctx).Stamp(hiddenUser, ownerUser.GetLogin(), time.Now())
 		resp, e := uClient.CreateUser(ctx, &idm.CreateUserRequest{User: hiddenUser})
 		if e != nil {
 			return nil, e
@@ -0,0 +1,527 @@
+/*
+ * Copyright (c) 2025 Abstrium SAS <team (at) pydio.com>
+ * This file is part of Pydio Cells.
+ *
+ * Pydio Cells is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * Pydio Cells is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with Pydio Cells.  If not, see <http://www.gnu.org/licenses/>.
+ *
+ * The latest code can be found at <https://pydio.com>.
+ */
+
+package share
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"go.uber.org/zap"
+	"google.golang.org/protobuf/types/known/anypb"
+
+	"github.com/pydio/cells/v5/common"
+	"github.com/pydio/cells/v5/common/client/commons/docstorec"
+	"github.com/pydio/cells/v5/common/client/commons/idmc"
+	"github.com/pydio/cells/v5/common/errors"
+	"github.com/pydio/cells/v5/common/permissions"
+	"github.com/pydio/cells/v5/common/proto/docstore"
+	"github.com/pydio/cells/v5/common/proto/idm"
+	"github.com/pydio/cells/v5/common/proto/rest"
+	service2 "github.com/pydio/cells/v5/common/proto/service"
+	"github.com/pydio/cells/v5/common/proto/tree"
+	"github.com/pydio/cells/v5/common/telemetry/log"
+	json "github.com/pydio/cells/v5/common/utils/jsonx"
+)
+
+// inventoryFilter holds the resolved criteria of a ShareInventoryRequest.
+type inventoryFilter struct {
+	shareType     rest.ListSharedResourcesRequest_ListShareType
+	ownerUuid     string
+	wsPrefixes    []string
+	pathPrefix    string
+	expiresBefore int64
+	noExpiration  bool
+	targets       []string
+}
+
+func hasPathPrefix(p, prefix string) bool {
+	p = strings.Trim(p, "/")
+	prefix = strings.Trim(prefix, "/")
+	return prefix == "" || p == prefix || strings.HasPrefix(p, prefix+"/")
+}
+
+func (f *inventoryFilter) match(e *rest.ShareInventoryEntry) bool {
+	if f.shareType != rest.ListSharedResourcesRequest_ANY && e.Type != f.shareType {
+		return false
+	}
+	if f.ownerUuid != "" && e.OwnerUuid != f.ownerUuid {
+		return false
+	}
+	if f.noExpiration && e.AccessEnd > 0 {
+		return false
+	}
+	if f.expiresBefore > 0 && (e.AccessEnd <= 0 || e.AccessEnd >= f.expiresBefore) {
+		return false
+	}
+	if f.pathPrefix != "" || len(f.wsPrefixes) > 0 {
+		var found bool
+		for _, r := range e.RootPaths {
+			if !hasPathPrefix(r, f.pathPrefix) {
+				continue
+			}
+			if len(f.wsPrefixes) == 0 {
+				found = true
+				break
+			}
+			for _, wp := range f.wsPrefixes {
+				if hasPathPrefix(r, wp) {
+					found = true
+					break
+				}
+			}
+			if found {
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+	if len(f.targets) > 0 {
+		var found bool
+		for _, t := range e.TargetUsers {
+			for _, ft := range f.targets {
+				if strings.EqualFold(t, ft) {
+					found = true
+				}
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+	return true
+}
+
+// resolveUser finds a user by its login, or by its uuid.
+func (sc *Client) resolveUser(ctx context.Context, loginOrUuid string) (*idm.User, error) {
+	if u, er := permissions.SearchUniqueUser(ctx, loginOrUuid, ""); er == nil {
+		return u, nil
+	}
+	return permissions.SearchUniqueUser(ctx, "", loginOrUuid)
+}
+
+// Inventory lists all Cells and Links of the instance matching the request criteria, whoever owns them.
+// It must only be called in an admin context.
+func (sc *Client) Inventory(ctx context.Context, req *rest.ShareInventoryRequest) ([]*rest.ShareInventoryEntry, error) {
+
+	f := &inventoryFilter{
+		shareType:     req.GetShareType(),
+		pathPrefix:    req.GetPathPrefix(),
+		expiresBefore: req.GetExpiresBefore(),
+		noExpiration:  req.GetNoExpiration(),
+	}
+	if req.GetOwner() != "" {
+		u, er := sc.resolveUser(ctx, req.GetOwner())
+		if er != nil {
+			return nil, errors.Tag(er, errors.UserNotFound)
+		}
+		f.ownerUuid = u.GetUuid()
+	}
+	if t := req.GetTargetUser(); t != "" {
+		f.targets = append(f.targets, t)
+		if u, er := sc.resolveUser(ctx, t); er == nil {
+			f.targets = append(f.targets, u.GetLogin(), u.GetUuid())
+		}
+	}
+	if w := req.GetWorkspace(); w != "" {
+		ws, er := permissions.SearchUniqueWorkspace(ctx, w, "")
+		if er != nil {
+			if ws, er = permissions.SearchUniqueWorkspace(ctx, "", w); er != nil {
+				return nil, errors.Tag(er, errors.WorkspaceNotFound)
+			}
+		}
+		wss := map[string]*idm.Workspace{ws.GetUUID(): ws}
+		// Virtual roots are resolved per user and cannot be matched against admin paths
+		if er := permissions.LoadRootNodesForWorkspaces(ctx, []string{ws.GetUUID()}, wss, func(ctx context.Context, node *tree.Node) (*tree.Node, bool) {
+			return nil, false
+		}); er != nil {
+			return nil, er
+		}
+		for _, r := range ws.GetRootNodes() {
+			f.wsPrefixes = append(f.wsPrefixes, r.GetPath())
+		}
+		if len(f.wsPrefixes) == 0 {
+			return nil, errors.WithMessage(errors.InvalidParameters, "workspace has no static root, please filter by path prefix instead")
+		}
+	}
+
+	var qs []*anypb.Any
+	if f.shareType != rest.ListSharedResourcesRequest_LINKS {
+		q, _ := anypb.New(&idm.WorkspaceSingleQuery{Scope: idm.WorkspaceScope_ROOM})
+		qs = append(qs, q)
+	}
+	if f.shareType != rest.ListSharedResourcesRequest_CELLS {
+		q, _ := anypb.New(&idm.WorkspaceSingleQuery{Scope: idm.WorkspaceScope_LINK})
+		qs = append(qs, q)
+	}
+	streamer, er := idmc.WorkspaceServiceClient(ctx).SearchWorkspace(ctx, &idm.SearchWorkspaceRequest{
+		Query: &service2.Query{SubQueries: qs, Operation: service2.OperationType_OR},
+	})
+	if er != nil {
+		return nil, er
+	}
+	var entries []*rest.ShareInventoryEntry
+	byWs := make(map[string]*rest.ShareInventoryEntry)
+	var workspaceIds []string
+	for {
+		resp, e := streamer.Recv()
+		if e != nil {
+			break
+		}
+		ws := resp.GetWorkspace()
+		entry := &rest.ShareInventoryEntry{
+			Uuid:  ws.GetUUID(),
+			Label: ws.GetLabel(),
+			Type:  rest.ListSharedResourcesRequest_CELLS,
+		}
+		for _, p := range ws.GetPolicies() {
+			if p.Action == service2.ResourcePolicyAction_OWNER {
+				entry.OwnerUuid = p.Subject
+				break
+			}
+		}
+		if f.ownerUuid != "" && entry.OwnerUuid != f.ownerUuid {
+			continue
+		}
+		if ws.GetScope() == idm.WorkspaceScope_LINK {
+			entry.Type = rest.ListSharedResourcesRequest_LINKS
+		} else {
+			entry.AccessEnd = ws.LoadAttributes().ShareExpiration
+		}
+		entries = append(entries, entry)
+		byWs[entry.Uuid] = entry
+		workspaceIds = append(workspaceIds, entry.Uuid)
+	}
+	if len(entries) == 0 {
+		return nil, nil
+	}
+
+	acls, er := permissions.GetACLsForWorkspace(ctx, workspaceIds, permissions.AclRead, permissions.AclWrite, permissions.AclPolicy)
+	if er != nil {
+		return nil, er
+	}
+	wsRoots := make(map[string][]string)
+	wsRoles := make(map[string][]string)
+	seen := make(map[string]bool)
+	var detectedRoots []string
+	for _, acl := range acls {
+		if acl.NodeID == "" || byWs[acl.WorkspaceID] == nil {
+			continue
+		}
+		if k := acl.WorkspaceID + acl.NodeID; !seen[k] {
+			seen[k] = true
+			wsRoots[acl.WorkspaceID] = append(wsRoots[acl.WorkspaceID], acl.NodeID)
+		}
+		if k := acl.WorkspaceID + "/" + acl.RoleID; !seen[k] {
+			seen[k] = true
+			wsRoles[acl.WorkspaceID] = append(wsRoles[acl.WorkspaceID], acl.RoleID)
+		}
+		if !seen[acl.NodeID] {
+			seen[acl.NodeID] = true
+			detectedRoots = append(detectedRoots, acl.NodeID)
+		}
+	}
+	rootNodes := sc.LoadAdminRootNodes(ctx, detectedRoots)
+
+	logins := make(map[string]string)
+	loginFor := func(uuid string) string {
+		if l, ok := logins[uuid]; ok {
+			return l
+		}
+		l := uuid
+		if u, e := permissions.SearchUniqueUser(ctx, "", uuid); e == nil {
+			l = u.GetLogin()
+		}
+		logins[uuid] = l
+		return l
+	}
+
+	var out []*rest.ShareInventoryEntry
+	for _, entry := range entries {
+		entry.OwnerLogin = loginFor(entry.OwnerUuid)
+		for _, nodeId := range wsRoots[entry.Uuid] {
+			if n, ok := rootNodes[nodeId]; ok {
+				entry.RootPaths = append(entry.RootPaths, n.GetPath())
+			}
+		}
+		if entry.Type == rest.ListSharedResourcesRequest_LINKS {
+			link := &rest.ShareLink{Uuid: entry.Uuid}
+			if e := sc.LoadHashDocumentData(ctx, link, nil); e != nil {
+				log.Logger(ctx).Warn("Share inventory - cannot load link data", zap.String(common.KeyLinkUuid, entry.Uuid), zap.Error(e))
+			} else {
+				entry.AccessEnd = link.AccessEnd
+				for id, t := range link.TargetUsers {
+					entry.TargetUsers = append(entry.TargetUsers, id)
+					if t.Display != "" && t.Display != id {
+						entry.TargetUsers = append(entry.TargetUsers, t.Display)
+					}
+				}
+				sort.Strings(entry.TargetUsers)
+			}
+		} else {
+			for _, roleId := range wsRoles[entry.Uuid] {
+				if roleId != entry.OwnerUuid {
+					entry.TargetUsers = append(entry.TargetUsers, loginFor(roleId))
+				}
+			}
+		}
+		if f.match(entry) {
+			out = append(out, entry)
+		}
+	}
+	return out, nil
+}
+
+// BulkAction applies an expire, transfer or delete action to a set of Cells and Links. A result is
+// returned for each uuid, and each successful action is recorded in the audit log.
+func (sc *Client) BulkAction(ctx context.Context, req *rest.ShareBulkActionRequest) (*rest.ShareBulkActionResponse, error) {
+
+	var newOwner *idm.User
+	switch req.GetAction() {
+	case rest.ShareBulkActionRequest_EXPIRE, rest.ShareBulkActionRequest_DELETE:
+	case rest.ShareBulkActionRequest_TRANSFER:
+		if req.GetTargetOwner() == "" {
+			return nil, errors.WithMessage(errors.InvalidParameters, "please provide a target owner")
+		}
+		u, er := sc.resolveUser(ctx, req.GetTargetOwner())
+		if er != nil {
+			return nil, errors.Tag(er, errors.UserNotFound)
+		}
+		newOwner = u
+	default:
+		return nil, errors.WithMessage(errors.InvalidParameters, "unsupported bulk action")
+	}
+
+	resp := &rest.ShareBulkActionResponse{}
+	for _, id := range req.GetUuids() {
+		res := &rest.ShareBulkActionResponse_Result{Uuid: id}
+		if er := sc.applyBulkAction(ctx, req.GetAction(), id, newOwner); er != nil {
+			res.Error = er.Error()
+		} else {
+			res.Success = true
+		}
+		resp.Results = append(resp.Results, res)
+	}
+	return resp, nil
+}
+
+func (sc *Client) applyBulkAction(ctx context.Context, action rest.ShareBulkActionRequest_ShareBulkAction, id string, newOwner *idm.User) error {
+	ws, er := permissions.SearchUniqueWorkspace(ctx, id, "")
+	if er != nil {
+		return errors.Tag(er, errors.ShareNotFound)
+	}
+	isLink := ws.GetScope() == idm.WorkspaceScope_LINK
+	if !isLink && ws.GetScope() != idm.WorkspaceScope_ROOM {
+		return errors.WithMessage(errors.ShareNotFound, "workspace is not a cell or a link")
+	}
+	auditId, uuidKey, kind := common.AuditCellUpdate, common.KeyCellUuid, "cell"
+	if isLink {
+		auditId, uuidKey, kind = common.AuditLinkUpdate, common.KeyLinkUuid, "share link"
+	}
+
+	var msg string
+	switch action {
+	case rest.ShareBulkActionRequest_EXPIRE:
+		if isLink {
+			er = sc.expireLink(ctx, id)
+		} else {
+			er = sc.expireCell(ctx, ws)
+		}
+		msg = fmt.Sprintf("Expired %s [%s]", kind, ws.GetLabel())
+	case rest.ShareBulkActionRequest_TRANSFER:
+		var oldOwner string
+		oldOwner, er = sc.transferShare(ctx, ws, newOwner)
+		msg = fmt.Sprintf("Transferred %s [%s] from [%s] to [%s]", kind, ws.GetLabel(), oldOwner, newOwner.GetLogin())
+	case rest.ShareBulkActionRequest_DELETE:
+		if isLink {
+			auditId = common.AuditLinkDelete
+			er = sc.DeleteLink(ctx, id)
+		} else {
+			auditId = common.AuditCellDelete
+			er = sc.DeleteCell(ctx, id, "")
+		}
+		msg = fmt.Sprintf("Removed %s [%s]", kind, ws.GetLabel())
+	}
+	if er != nil {
+		return er
+	}
+	if action == rest.ShareBulkActionRequest_DELETE && !isLink {
+		// DeleteCell already audits the deletion
+		return nil
+	}
+	log.Auditer(ctx).Info(
+		msg,
+		log.GetAuditId(auditId),
+		zap.String(uuidKey, id),
+		zap.String(common.KeyWorkspaceUuid, id),
+	)
+	return nil
+}
+
+// updateHashDocument loads the link hash document, applies a modifier and stores it back.
+func (sc *Client) updateHashDocument(ctx context.Context, linkUuid string, modifier func(doc *docstore.ShareDocument)) error {
+	link := &rest.ShareLink{Uuid: linkUuid}
+	if er := sc.LoadHashDocumentData(ctx, link, nil); er != nil {
+		return er
+	}
+	store := docstorec.DocStoreClient(ctx)
+	resp, er := store.GetDocument(ctx, &docstore.GetDocumentRequest{StoreID: common.DocStoreIdShares, DocumentID: link.LinkHash})
+	if er != nil {
+		return er
+	}
+	var doc *docstore.ShareDocument
+	if er := json.Unmarshal([]byte(resp.GetDocument().GetData()), &doc); er != nil {
+		return er
+	}
+	modifier(doc)
+	data, _ := json.Marshal(doc)
+	_, er = store.PutDocument(ctx, &docstore.PutDocumentRequest{
+		StoreID:    common.DocStoreIdShares,
+		DocumentID: link.LinkHash,
+		Document:   &docstore.Document{ID: link.LinkHash, Data: string(data), IndexableMeta: string(data)},
+	})
+	return er
+}
+
+// expireLink sets the link expiration date to now. Owner is not notified as the link was revoked by an admin.
+func (sc *Client) expireLink(ctx context.Context, linkUuid string) error {
+	now := time.Now().Unix()
+	return sc.updateHashDocument(ctx, linkUuid, func(doc *docstore.ShareDocument) {
+		if doc.ExpireTime <= 0 || doc.ExpireTime > now {
+			doc.ExpireTime = now
+		}
+		doc.ExpirationNotified = true
+	})
+}
+
+// expireCell sets the cell expiration date to now and revokes access to all its members but the owner.
+func (sc *Client) expireCell(ctx context.Context, ws *idm.Workspace) error {
+	var ownerUuid string
+	for _, p := range ws.GetPolicies() {
+		if p.Action == service2.ResourcePolicyAction_OWNER {
+			ownerUuid = p.Subject
+		}
+	}
+	if ownerUuid == "" {
+		return errors.New("cannot find original owner UUID")
+	}
+	acls, er := permissions.GetACLsForWorkspace(ctx, []string{ws.GetUUID()}, permissions.AclRead, permissions.AclWrite, permissions.AclPolicy)
+	if er != nil {
+		return er
+	}
+	var roles []string
+	revoked := make(map[string]bool)
+	for _, acl := range acls {
+		if acl.RoleID != ownerUuid && !revoked[acl.RoleID] {
+			revoked[acl.RoleID] = true
+			roles = append(roles, acl.RoleID)
+		}
+	}
+	if len(roles) > 0 {
+		q, _ := anypb.New(&idm.ACLSingleQuery{WorkspaceIDs: []string{ws.GetUUID()}, RoleIDs: roles})
+		if _, er = idmc.ACLServiceClient(ctx).DeleteACL(ctx, &idm.DeleteACLRequest{Query: &service2.Query{SubQueries: []*anypb.Any{q}}}); er != nil {
+			return er
+		}
+	}
+	var policies []*service2.ResourcePolicy
+	for _, p := range ws.GetPolicies() {
+		if p.Action == service2.ResourcePolicyAction_READ && revoked[strings.TrimPrefix(p.Subject, permissions.PolicySubjectRolePrefix)] {
+			continue
+		}
+		policies = append(policies, p)
+	}
+	ws.Policies = policies
+	att := ws.LoadAttributes()
+	att.ShareExpiration = time.Now().Unix()
+	ws.SetAttributes(att)
+	_, er = idmc.WorkspaceServiceClient(ctx).CreateWorkspace(ctx, &idm.CreateWorkspaceRequest{Workspace: ws})
+	return er
+}
+
+// transferShare gives ownership of a Cell or a Link to another user, and returns the previous owner login.
+func (sc *Client) transferShare(ctx context.Context, ws *idm.Workspace, newOwner *idm.User) (string, error) {
+	var oldUuid string
+	for _, p := range ws.GetPolicies() {
+		if p.Action == service2.ResourcePolicyAction_OWNER {
+			oldUuid = p.Subject
+		}
+	}
+	if oldUuid == "" {
+		return "", errors.New("cannot find original owner UUID")
+	}
+	if oldUuid == newOwner.GetUuid() {
+		return newOwner.GetLogin(), nil
+	}
+	oldLogin := oldUuid
+	if u, er := permissions.SearchUniqueUser(ctx, "", oldUuid); er == nil {
+		oldLogin = u.GetLogin()
+	}
+
+	replace := map[string]string{
+		oldUuid: newOwner.GetUuid(),
+		permissions.PolicySubjectUuidPrefix + oldUuid:   permissions.PolicySubjectUuidPrefix + newOwner.GetUuid(),
+		permissions.PolicySubjectRolePrefix + oldUuid:   permissions.PolicySubjectRolePrefix + newOwner.GetUuid(),
+		permissions.PolicySubjectLoginPrefix + oldLogin: permissions.PolicySubjectLoginPrefix + newOwner.GetLogin(),
+	}
+	for _, p := range ws.GetPolicies() {
+		if r, ok := replace[p.Subject]; ok && (p.Action != service2.ResourcePolicyAction_OWNER || p.Subject == oldUuid) {
+			p.Subject = r
+		}
+	}
+
+	if ws.GetScope() == idm.WorkspaceScope_LINK {
+		if er := sc.updateHashDocument(ctx, ws.GetUUID(), func(doc *docstore.ShareDocument) {
+			doc.OwnerId = newOwner.GetLogin()
+		}); er != nil {
+			return oldLogin, er
+		}
+	} else {
+		// Move the owner ACLs on the cell roots to the new owner
+		acls, er := permissions.GetACLsForWorkspace(ctx, []string{ws.GetUUID()}, permissions.AclRead, permissions.AclWrite, permissions.AclPolicy)
+		if er != nil {
+			return oldLogin, er
+		}
+		aclClient := idmc.ACLServiceClient(ctx)
+		for _, acl := range acls {
+			if acl.RoleID != oldUuid {
+				continue
+			}
+			if _, er := aclClient.CreateACL(ctx, &idm.CreateACLRequest{ACL: &idm.ACL{
+				NodeID:      acl.NodeID,
+				WorkspaceID: acl.WorkspaceID,
+				RoleID:      newOwner.GetUuid(),
+				Action:      acl.Action,
+			}}); er != nil {
+				return oldLogin, er
+			}
+		}
+		q, _ := anypb.New(&idm.ACLSingleQuery{WorkspaceIDs: []string{ws.GetUUID()}, RoleIDs: []string{oldUuid}})
+		if _, er = aclClient.DeleteACL(ctx, &idm.DeleteACLRequest{Query: &service2.Query{SubQueries: []*anypb.Any{q}}}); er != nil {
+			return oldLogin, er
+		}
+	}
+	_, er := idmc.WorkspaceServiceClient(ctx).CreateWorkspace(ctx, &idm.CreateWorkspaceRequest{Workspace: ws})
+	return oldLogin, er
+}
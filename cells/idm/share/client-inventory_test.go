@@ -0,0 +1,73 @@
+/*
+ * Copyright (c) 2025 Abstrium SAS <team (at) pydio.com>
+ * This file is part of Pydio Cells.
+ *
+ * Pydio Cells is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * Pydio Cells is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with Pydio Cells.  If not, see <http://www.gnu.org/licenses/>.
+ *
+ * The latest code can be found at <https://pydio.com>.
+ */
+
+package share
+
+import (
+	"testing"
+
+	"github.com/pydio/cells/v5/common/proto/rest"
+
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestInventoryFilter(t *testing.T) {
+
+	Convey("Test share inventory filters", t, func() {
+
+		link := &rest.ShareInventoryEntry{
+			Uuid:        "link-1",
+			Type:        rest.ListSharedResourcesRequest_LINKS,
+			OwnerUuid:   "owner-1",
+			RootPaths:   []string{"pydiods1/projects/alpha/report.pdf"},
+			AccessEnd:   1000,
+			TargetUsers: []string{"jdoe@example.com"},
+		}
+		cell := &rest.ShareInventoryEntry{
+			Uuid:        "cell-1",
+			Type:        rest.ListSharedResourcesRequest_CELLS,
+			OwnerUuid:   "owner-2",
+			RootPaths:   []string{"personal/admin/docs", "pydiods1/projects-old"},
+			TargetUsers: []string{"alice", "bob"},
+		}
+
+		So((&inventoryFilter{}).match(link), ShouldBeTrue)
+		So((&inventoryFilter{shareType: rest.ListSharedResourcesRequest_CELLS}).match(link), ShouldBeFalse)
+		So((&inventoryFilter{ownerUuid: "owner-2"}).match(cell), ShouldBeTrue)
+		So((&inventoryFilter{ownerUuid: "owner-2"}).match(link), ShouldBeFalse)
+
+		So((&inventoryFilter{expiresBefore: 2000}).match(link), ShouldBeTrue)
+		So((&inventoryFilter{expiresBefore: 500}).match(link), ShouldBeFalse)
+		So((&inventoryFilter{expiresBefore: 2000}).match(cell), ShouldBeFalse)
+		So((&inventoryFilter{noExpiration: true}).match(cell), ShouldBeTrue)
+		So((&inventoryFilter{noExpiration: true}).match(link), ShouldBeFalse)
+
+		So((&inventoryFilter{pathPrefix: "/pydiods1/projects/"}).match(link), ShouldBeTrue)
+		So((&inventoryFilter{pathPrefix: "pydiods1/projects"}).match(cell), ShouldBeFalse)
+		So((&inventoryFilter{wsPrefixes: []string{"personal/admin", "other"}}).match(cell), ShouldBeTrue)
+		So((&inventoryFilter{wsPrefixes: []string{"personal/admin"}, pathPrefix: "pydiods1"}).match(cell), ShouldBeFalse)
+
+		So((&inventoryFilter{targets: []string{"JDoe@example.com"}}).match(link), ShouldBeTrue)
+		So((&inventoryFilter{targets: []string{"carol", "bob"}}).match(cell), ShouldBeTrue)
+		So((&inventoryFilter{targets: []string{"carol"}}).match(cell), ShouldBeFalse)
+
+	})
+
+}
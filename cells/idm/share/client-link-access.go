@@ -0,0 +1,158 @@
+/*
+ * Copyright (c) 2025 Abstrium SAS <team (at) pydio.com>
+ * This file is part of Pydio Cells.
+ *
+ * Pydio Cells is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * Pydio Cells is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with Pydio Cells.  If not, see <http://www.gnu.org/licenses/>.
+ *
+ * The latest code can be found at <https://pydio.com>.
+ */
+
+package share
+
+import (
+	"context"
+	"encoding/csv"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/pydio/cells/v5/common"
+	"github.com/pydio/cells/v5/common/client/commons/docstorec"
+	"github.com/pydio/cells/v5/common/errors"
+	"github.com/pydio/cells/v5/common/proto/docstore"
+	"github.com/pydio/cells/v5/common/proto/rest"
+	json "github.com/pydio/cells/v5/common/utils/jsonx"
+)
+
+// LinkAnalytics counts recorded accesses to a public link and lists them, most recent first. If limit is
+// greater than 0, only one page of accesses is returned. Only users allowed to edit the link can read them.
+func (sc *Client) LinkAnalytics(ctx context.Context, linkUuid string, offset, limit int64) (*rest.PublicLinkAnalytics, error) {
+	ws, er := sc.GetLinkWorkspace(ctx, linkUuid)
+	if er != nil {
+		return nil, er
+	}
+	if !sc.checker.IsContextEditable(ctx, linkUuid, ws.Policies) {
+		return nil, errors.WithMessage(errors.StatusForbidden, "you are not allowed to read this link analytics")
+	}
+
+	ct, ca := context.WithCancel(ctx)
+	defer ca()
+	stream, er := docstorec.DocStoreClient(ctx).ListDocuments(ct, &docstore.ListDocumentsRequest{
+		StoreID: common.DocStoreIdLinkAccesses,
+		Query:   &docstore.DocumentQuery{Owner: linkUuid},
+	})
+	if er != nil {
+		return nil, er
+	}
+	an := &rest.PublicLinkAnalytics{LinkUuid: linkUuid}
+	for {
+		resp, e := stream.Recv()
+		if e != nil {
+			break
+		}
+		var la *docstore.LinkAccess
+		if resp.GetDocument() == nil || json.Unmarshal([]byte(resp.GetDocument().GetData()), &la) != nil {
+			continue
+		}
+		switch la.Type {
+		case docstore.LinkAccessView:
+			an.Views++
+		case docstore.LinkAccessPreview:
+			an.Previews++
+		case docstore.LinkAccessDownload:
+			an.Downloads++
+		}
+		an.Accesses = append(an.Accesses, &rest.PublicLinkAccess{
+			Type:      la.Type,
+			Time:      la.Time,
+			IP:        la.IP,
+			Country:   la.Country,
+			UserAgent: la.UserAgent,
+			NodePath:  la.NodePath,
+		})
+	}
+	sort.Slice(an.Accesses, func(i, j int) bool {
+		return an.Accesses[i].Time > an.Accesses[j].Time
+	})
+	if limit > 0 {
+		total := int64(len(an.Accesses))
+		offset = min(max(offset, 0), total)
+		an.Accesses = an.Accesses[offset:min(offset+limit, total)]
+		an.Pagination = &rest.Pagination{
+			Limit:         int32(limit),
+			CurrentOffset: int32(offset),
+			Total:         int32(total),
+		}
+		if offset+limit < total {
+			an.Pagination.NextOffset = int32(offset + limit)
+		}
+		if offset > 0 {
+			an.Pagination.PrevOffset = int32(max(offset-limit, 0))
+		}
+	}
+	return an, nil
+}
+
+// DeleteLinkAccesses removes all recorded accesses to a public link.
+func (sc *Client) DeleteLinkAccesses(ctx context.Context, linkUuid string) error {
+	_, er := docstorec.DocStoreClient(ctx).DeleteDocuments(ctx, &docstore.DeleteDocumentsRequest{
+		StoreID: common.DocStoreIdLinkAccesses,
+		Query:   &docstore.DocumentQuery{Owner: linkUuid},
+	})
+	return er
+}
+
+// PruneLinkAccesses removes all recorded accesses older than the given time, whatever the link.
+func PruneLinkAccesses(ctx context.Context, before time.Time) (int32, error) {
+	resp, er := docstorec.DocStoreClient(ctx).DeleteDocuments(ctx, &docstore.DeleteDocumentsRequest{
+		StoreID: common.DocStoreIdLinkAccesses,
+		Query:   &docstore.DocumentQuery{MetaQuery: fmt.Sprintf("TIME<%d", before.Unix())},
+	})
+	if er != nil {
+		return 0, er
+	}
+	return resp.GetDeletionCount(), nil
+}
+
+// csvCell neutralizes values that spreadsheets would interpret as formulas.
+func csvCell(s string) string {
+	if s != "" && strings.ContainsRune("=+-@\t\r", rune(s[0])) {
+		return "'" + s
+	}
+	return s
+}
+
+// WriteLinkAnalyticsCSV exports link accesses in CSV format, one line per access.
+func WriteLinkAnalyticsCSV(w io.Writer, an *rest.PublicLinkAnalytics) error {
+	cw := csv.NewWriter(w)
+	if er := cw.Write([]string{"Time", "Type", "File", "IP", "Country", "User Agent"}); er != nil {
+		return er
+	}
+	for _, a := range an.GetAccesses() {
+		if er := cw.Write([]string{
+			time.Unix(a.GetTime(), 0).UTC().Format(time.RFC3339),
+			csvCell(a.GetType()),
+			csvCell(a.GetNodePath()),
+			csvCell(a.GetIP()),
+			csvCell(a.GetCountry()),
+			csvCell(a.GetUserAgent()),
+		}); er != nil {
+			return er
+		}
+	}
+	cw.Flush()
+	return cw.Error()
+}
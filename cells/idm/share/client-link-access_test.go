@@ -0,0 +1,76 @@
+/*
+ * Copyright (c) 2025 Abstrium SAS <team (at) pydio.com>
+ * This file is part of Pydio Cells.
+ *
+ * Pydio Cells is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * Pydio Cells is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with Pydio Cells.  If not, see <http://www.gnu.org/licenses/>.
+ *
+ * The latest code can be found at <https://pydio.com>.
+ */
+
+package share
+
+import (
+	"bytes"
+	"encoding/csv"
+	"testing"
+
+	"github.com/pydio/cells/v5/common/proto/rest"
+
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestWriteLinkAnalyticsCSV(t *testing.T) {
+
+	Convey("Test link analytics CSV export", t, func() {
+
+		an := &rest.PublicLinkAnalytics{
+			LinkUuid: "link",
+			Accesses: []*rest.PublicLinkAccess{
+				{Type: "download", Time: 1700000000, IP: "10.0.0.0", Country: "FR", UserAgent: "Mozilla/5.0 (X11, Linux)", NodePath: "folder/file, with comma.txt"},
+				{Type: "view", Time: 1690000000},
+			},
+		}
+		buf := &bytes.Buffer{}
+		So(WriteLinkAnalyticsCSV(buf, an), ShouldBeNil)
+
+		records, er := csv.NewReader(buf).ReadAll()
+		So(er, ShouldBeNil)
+		So(records, ShouldHaveLength, 3)
+		So(records[0][0], ShouldEqual, "Time")
+		So(records[1], ShouldResemble, []string{"2023-11-14T22:13:20Z", "download", "folder/file, with comma.txt", "10.0.0.0", "FR", "Mozilla/5.0 (X11, Linux)"})
+		So(records[2][1], ShouldEqual, "view")
+
+	})
+
+	Convey("Test CSV export neutralizes formulas", t, func() {
+
+		an := &rest.PublicLinkAnalytics{
+			Accesses: []*rest.PublicLinkAccess{
+				{Type: "view", Time: 1700000000, UserAgent: "=HYPERLINK(\"http://evil\")", NodePath: "+cmd.txt"},
+				{Type: "view", Time: 1700000000, UserAgent: "@SUM(1)", NodePath: "-1.txt"},
+			},
+		}
+		buf := &bytes.Buffer{}
+		So(WriteLinkAnalyticsCSV(buf, an), ShouldBeNil)
+
+		records, er := csv.NewReader(buf).ReadAll()
+		So(er, ShouldBeNil)
+		So(records[1][5], ShouldEqual, "'=HYPERLINK(\"http://evil\")")
+		So(records[1][2], ShouldEqual, "'+cmd.txt")
+		So(records[2][5], ShouldEqual, "'@SUM(1)")
+		So(records[2][2], ShouldEqual, "'-1.txt")
+
+	})
+
+}
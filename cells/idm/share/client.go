@@ -222,6 +222,14 @@ func (sc *Client) UpsertLink(ctx context.Context, link *rest.ShareLink, linkOpti
 		}
 	}
 
+	// File requests only let visitors upload, and preview their own uploads
+	if link.FileRequest != nil {
+		link.Permissions = []rest.ShareLinkAccessType{rest.ShareLinkAccessType_Preview, rest.ShareLinkAccessType_Upload}
+	}
+	if er := sc.updateFileRequestAttributes(ctx, workspace, link.FileRequest); er != nil {
+		return nil, er
+	}
+
 	err = sc.UpdateACLsForHiddenUser(ctx, user.Uuid, workspace.UUID, link.RootNodes, link.Permissions, parentPolicy, !create)
 	track("UpdateACLsForHiddenUser")
 	if err != nil {
@@ -255,6 +263,28 @@ func (sc *Client) UpsertLink(ctx context.Context, link *rest.ShareLink, linkOpti
 
 }
 
+// updateFileRequestAttributes stores the file request options in the link workspace attributes, where they are
+// read by the file request nodes handler.
+func (sc *Client) updateFileRequestAttributes(ctx context.Context, workspace *idm.Workspace, fr *rest.ShareLinkFileRequest) error {
+	att := workspace.LoadAttributes()
+	if fr == nil && att.FileRequest == nil {
+		return nil
+	}
+	if fr == nil {
+		att.FileRequest = nil
+	} else {
+		att.FileRequest = &idm.FileRequestOptions{
+			AllowedExtensions: fr.GetAllowedExtensions(),
+			MaxFileSize:       fr.GetMaxFileSize(),
+			MaxTotalSize:      fr.GetMaxTotalSize(),
+			NotifyOwner:       fr.GetNotifyOwner(),
+		}
+	}
+	workspace.SetAttributes(att)
+	_, er := idmc.WorkspaceServiceClient(ctx).CreateWorkspace(ctx, &idm.CreateWorkspaceRequest{Workspace: workspace})
+	return er
+}
+
 // DeleteLink disable an existing ShareLink and remove associated resources
 func (sc *Client) DeleteLink(ctx context.Context, id string) error {
 	if ws, e := sc.GetLinkWorkspace(ctx, id); e != nil || ws == nil {
@@ -278,6 +308,11 @@ func (sc *Client) DeleteLink(ctx context.Context, id string) error {
 		return err
 	}
 
+	// Drop access analytics
+	if err := sc.DeleteLinkAccesses(ctx, id); err != nil {
+		log.Logger(ctx).Warn("Cannot remove link accesses", zap.Error(err))
+	}
+
 	// Finally remove workspace
 	return sc.DeleteLinkWorkspace(ctx, id)
 
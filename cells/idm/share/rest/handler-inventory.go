@@ -0,0 +1,74 @@
+/*
+ * Copyright (c) 2025 Abstrium SAS <team (at) pydio.com>
+ * This file is part of Pydio Cells.
+ *
+ * Pydio Cells is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * Pydio Cells is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with Pydio Cells.  If not, see <http://www.gnu.org/licenses/>.
+ *
+ * The latest code can be found at <https://pydio.com>.
+ */
+
+package rest
+
+import (
+	restful "github.com/emicklei/go-restful/v3"
+
+	"github.com/pydio/cells/v5/common"
+	"github.com/pydio/cells/v5/common/auth/claim"
+	"github.com/pydio/cells/v5/common/errors"
+	"github.com/pydio/cells/v5/common/proto/rest"
+)
+
+// ListShareInventory implements the corresponding Rest API operation. It is restricted to administrators.
+func (h *SharesHandler) ListShareInventory(req *restful.Request, rsp *restful.Response) error {
+
+	var request rest.ShareInventoryRequest
+	if e := req.ReadEntity(&request); e != nil {
+		return e
+	}
+	ctx := req.Request.Context()
+	if claims, ok := claim.FromContext(ctx); !ok || claims.Profile != common.PydioProfileAdmin {
+		return errors.WithMessage(errors.StatusForbidden, "share inventory is restricted to administrators")
+	}
+	if err := h.docStoreStatus(ctx); err != nil {
+		return err
+	}
+	shares, e := h.sc.Inventory(ctx, &request)
+	if e != nil {
+		return e
+	}
+	return rsp.WriteEntity(&rest.ShareInventoryResponse{Shares: shares})
+
+}
+
+// ShareBulkAction implements the corresponding Rest API operation. It is restricted to administrators.
+func (h *SharesHandler) ShareBulkAction(req *restful.Request, rsp *restful.Response) error {
+
+	var request rest.ShareBulkActionRequest
+	if e := req.ReadEntity(&request); e != nil {
+		return e
+	}
+	ctx := req.Request.Context()
+	if claims, ok := claim.FromContext(ctx); !ok || claims.Profile != common.PydioProfileAdmin {
+		return errors.WithMessage(errors.StatusForbidden, "share bulk actions are restricted to administrators")
+	}
+	if err := h.docStoreStatus(ctx); err != nil {
+		return err
+	}
+	response, e := h.sc.BulkAction(ctx, &request)
+	if e != nil {
+		return e
+	}
+	return rsp.WriteEntity(response)
+
+}
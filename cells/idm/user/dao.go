@@ -25,6 +25,7 @@ package user
 
 import (
 	"context"
+	"time"
 
 	"github.com/pydio/cells/v5/common/proto/idm"
 	service2 "github.com/pydio/cells/v5/common/proto/service"
@@ -54,4 +55,10 @@ type DAO interface {
 	CleanRole(ctx context.Context, roleId string) error
 	TouchUser(ctx context.Context, userUuid string) error
 	LoginModifiedAttr(ctx context.Context, oldName, newName string) (int64, error)
+
+	// MatchPasswordHistory checks if a clear password matches the current or one of the
+	// last depth passwords of a user.
+	MatchPasswordHistory(ctx context.Context, userUuid string, password string, depth int) (bool, error)
+	// PasswordChangedAt returns the last time the password of a user was set, or a zero time if unknown.
+	PasswordChangedAt(ctx context.Context, userUuid string) (time.Time, error)
 }
@@ -1,6 +1,8 @@
 package user_model
 
 import (
+	"time"
+
 	"gorm.io/gorm/schema"
 
 	"github.com/pydio/cells/v5/common/proto/tree"
@@ -53,6 +55,15 @@ type UserRole struct {
 	User   *User  `gorm:"foreignKey:UUID;constraint:OnDelete:CASCADE;"`
 }
 
+// UserPasswordHistory keeps track of the successive password hashes of a user.
+type UserPasswordHistory struct {
+	ID        uint      `gorm:"column:id; primaryKey; autoIncrement;"`
+	UUID      string    `gorm:"column:uuid; type: varchar(128) not null; index;"`
+	Hash      string    `gorm:"column:hash; type: varchar(255) not null;"`
+	CreatedAt time.Time `gorm:"column:created_at;"`
+	User      *User     `gorm:"foreignKey:UUID;constraint:OnDelete:CASCADE;"`
+}
+
 type Querier interface {
 	// FilterWithLowerVal is a code snippet to filter a column using its lower value
 	//
@@ -83,7 +83,7 @@ func init() {
 func NewDAO(ctx context.Context, db *gorm.DB) user.DAO {
 	return &sqlimpl{
 		AbstractResources: sql.NewAbstractResources(db).WithModels(func() []any {
-			return []any{&user_model.User{}, &user_model.UserRole{}, &user_model.UserAttribute{}}
+			return []any{&user_model.User{}, &user_model.UserRole{}, &user_model.UserAttribute{}, &user_model.UserPasswordHistory{}}
 		}),
 		indexDAO: index.NewDAO[*user_model.User](db),
 	}
@@ -321,6 +321,10 @@ func (s *sqlimpl) Add(ctx context.Context, in interface{}) (interface{}, []*idm.
 			}
 		}
 
+		if !user.IsGroup && node.GetNode().GetEtag() != "" {
+			return s.appendPasswordHistory(tx, user.Uuid, node.GetNode().GetEtag())
+		}
+
 		return nil
 	}); err != nil {
 		return nil, nil, wrap(err)
@@ -379,9 +383,11 @@ func (s *sqlimpl) Bind(ctx context.Context, userName string, password string) (u
 
 	// Check password
 	if valid, _ := hasher.CheckDBKDF2PydioPwd(password, hashedPass); valid {
+		s.initPasswordHistory(ctx, user.Uuid, hashedPass)
 		return user, nil
 	} else if valid, _ = hasher.CheckDBKDF2PydioPwd(password, hashedPass, true); valid {
 		// Recheck with legacy format (users coming from PHP, salt []byte is built differently)
+		s.initPasswordHistory(ctx, user.Uuid, hashedPass)
 		return user, nil
 	}
 
@@ -615,6 +621,68 @@ func (s *sqlimpl) LoginModifiedAttr(ctx context.Context, oldName, newName string
 	return tx.RowsAffected, nil
 }
 
+// MatchPasswordHistory checks the clear password against the last depth hashes stored for this user.
+func (s *sqlimpl) MatchPasswordHistory(ctx context.Context, userUuid string, password string, depth int) (bool, error) {
+	if depth <= 0 {
+		return false, nil
+	}
+	var rows []*user_model.UserPasswordHistory
+	tx := s.Session(ctx).Where(&user_model.UserPasswordHistory{UUID: userUuid}).Order("id desc").Limit(depth).Find(&rows)
+	if tx.Error != nil {
+		return false, wrap(tx.Error)
+	}
+	for _, row := range rows {
+		if valid, _ := hasher.CheckDBKDF2PydioPwd(password, row.Hash); valid {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// PasswordChangedAt finds the date of the most recent password history entry.
+func (s *sqlimpl) PasswordChangedAt(ctx context.Context, userUuid string) (time.Time, error) {
+	var rows []*user_model.UserPasswordHistory
+	tx := s.Session(ctx).Where(&user_model.UserPasswordHistory{UUID: userUuid}).Order("id desc").Limit(1).Find(&rows)
+	if tx.Error != nil {
+		return time.Time{}, wrap(tx.Error)
+	}
+	if len(rows) == 0 {
+		return time.Time{}, nil
+	}
+	return rows[0].CreatedAt, nil
+}
+
+// appendPasswordHistory records a new password hash and drops entries above MaxPasswordHistory.
+func (s *sqlimpl) appendPasswordHistory(tx *gorm.DB, userUuid, hash string) error {
+	var last []*user_model.UserPasswordHistory
+	if er := tx.Where(&user_model.UserPasswordHistory{UUID: userUuid}).Order("id desc").Limit(auth.MaxPasswordHistory).Find(&last).Error; er != nil {
+		return er
+	}
+	if len(last) > 0 && last[0].Hash == hash {
+		return nil
+	}
+	if er := tx.Create(&user_model.UserPasswordHistory{UUID: userUuid, Hash: hash, CreatedAt: time.Now()}).Error; er != nil {
+		return er
+	}
+	if len(last) == auth.MaxPasswordHistory {
+		return tx.Where(&user_model.UserPasswordHistory{UUID: userUuid}).Where("id <= ?", last[len(last)-1].ID).Delete(&user_model.UserPasswordHistory{}).Error
+	}
+	return nil
+}
+
+// initPasswordHistory stores the current hash of users that have no history yet (created before
+// history was introduced), so that password age is computed from their first login.
+func (s *sqlimpl) initPasswordHistory(ctx context.Context, userUuid, hash string) {
+	var count int64
+	db := s.Session(ctx)
+	if er := db.Model(&user_model.UserPasswordHistory{}).Where(&user_model.UserPasswordHistory{UUID: userUuid}).Count(&count).Error; er != nil || count > 0 {
+		return
+	}
+	if er := db.Create(&user_model.UserPasswordHistory{UUID: userUuid, Hash: hash, CreatedAt: time.Now()}).Error; er != nil {
+		log.Logger(ctx).Warn("cannot initialize password history", zap.Error(er))
+	}
+}
+
 // skipRoleAsAutoApplies Check if role is here because of autoApply - if so, do not save
 func (s *sqlimpl) skipRoleAsAutoApplies(profile string, role *idm.Role) bool {
 	if profile == "" || len(role.AutoApplies) == 0 {
@@ -642,6 +710,10 @@ func (s *sqlimpl) deleteNodeData(ctx context.Context, node tree.ITreeNode) error
 		return tx.Error
 	}
 
+	if tx := db.Where("uuid IN (?)", subQ).Delete(&user_model.UserPasswordHistory{}); tx.Error != nil {
+		return tx.Error
+	}
+
 	return nil
 }
 
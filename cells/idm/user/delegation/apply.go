@@ -0,0 +1,147 @@
+/*
+ * Copyright (c) 2025 Abstrium SAS <team (at) pydio.com>
+ * This file is part of Pydio Cells.
+ *
+ * Pydio Cells is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * Pydio Cells is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with Pydio Cells.  If not, see <http://www.gnu.org/licenses/>.
+ *
+ * The latest code can be found at <https://pydio.com>.
+ */
+
+package delegation
+
+import (
+	"context"
+	"slices"
+
+	"go.uber.org/zap"
+	"google.golang.org/protobuf/types/known/anypb"
+
+	"github.com/pydio/cells/v5/common/client/commons"
+	"github.com/pydio/cells/v5/common/client/commons/idmc"
+	"github.com/pydio/cells/v5/common/permissions"
+	"github.com/pydio/cells/v5/common/proto/idm"
+	"github.com/pydio/cells/v5/common/proto/rest"
+	"github.com/pydio/cells/v5/common/proto/service"
+	"github.com/pydio/cells/v5/common/telemetry/log"
+)
+
+// Set stores a delegation and propagates its policies to the users and groups of its subtree. If the delegation
+// previously targeted another subtree, policies are removed from there.
+func Set(ctx context.Context, d *rest.DelegatedAdmin) error {
+	previous, _ := Get(ctx, d.GetRoleUuid())
+	if er := put(ctx, d); er != nil {
+		return er
+	}
+	all, er := List(ctx)
+	if er != nil {
+		return er
+	}
+	if previous != nil && clean(previous.GetGroupPath()) != clean(d.GetGroupPath()) {
+		if er = Apply(ctx, append(all, previous), previous.GetGroupPath()); er != nil {
+			return er
+		}
+	}
+	return Apply(ctx, all, d.GetGroupPath())
+}
+
+// Revoke deletes a delegation and removes its policies from the users and groups of its subtree.
+func Revoke(ctx context.Context, roleUuid string) error {
+	previous, er := Get(ctx, roleUuid)
+	if er != nil {
+		return er
+	}
+	if er = del(ctx, roleUuid); er != nil {
+		return er
+	}
+	all, er := List(ctx)
+	if er != nil {
+		return er
+	}
+	// Keep revoked delegation in the managed set so that its policies are removed
+	return Apply(ctx, append(all, previous), previous.GetGroupPath(), roleUuid)
+}
+
+// Apply recomputes delegation policies on all users and groups below root, as well as on their personal or group
+// roles. Delegations whose role is listed in revoked are removed without being reapplied. Admin users are skipped.
+func Apply(ctx context.Context, all Delegations, root string, revoked ...string) error {
+	var active Delegations
+	for _, d := range all {
+		if !slices.Contains(revoked, d.GetRoleUuid()) {
+			active = append(active, d)
+		}
+	}
+	uq, _ := anypb.New(&idm.UserSingleQuery{GroupPath: clean(root), Recursive: true})
+	cli := idmc.UserServiceClient(ctx)
+	st, e := cli.SearchUser(ctx, &idm.SearchUserRequest{Query: &service.Query{SubQueries: []*anypb.Any{uq}}})
+	var uu []*idm.User
+	if er := commons.ForEach(st, e, func(resp *idm.SearchUserResponse) error {
+		uu = append(uu, resp.GetUser())
+		return nil
+	}); er != nil {
+		return er
+	}
+	covering := map[string]Delegations{}
+	for _, u := range uu {
+		full := FullPath(u)
+		if !Protected(u) {
+			covering[u.GetUuid()] = active.ForPath(full, u.GetIsGroup())
+		} else {
+			// Admins never receive delegated policies, previous ones are removed
+			covering[u.GetUuid()] = nil
+		}
+		u.Policies = all.Rewrite(u.GetPolicies(), covering[u.GetUuid()])
+		if u.GetIsGroup() {
+			u.GroupPath = full
+		}
+		if _, er := cli.CreateUser(ctx, &idm.CreateUserRequest{User: u}); er != nil {
+			return er
+		}
+		if !u.GetIsGroup() {
+			permissions.ForceClearUserCache(ctx, u.GetLogin())
+		}
+	}
+	if len(covering) == 0 {
+		return nil
+	}
+	return ApplyRoles(ctx, all, covering)
+}
+
+// ApplyRoles updates the personal roles of users and the roles of groups, that share their owner uuid, with the
+// delegations covering each owner.
+func ApplyRoles(ctx context.Context, all Delegations, covering map[string]Delegations) error {
+	var uuids []string
+	for id := range covering {
+		uuids = append(uuids, id)
+	}
+	rq, _ := anypb.New(&idm.RoleSingleQuery{Uuid: uuids})
+	roleCli := idmc.RoleServiceClient(ctx)
+	st, e := roleCli.SearchRole(ctx, &idm.SearchRoleRequest{Query: &service.Query{SubQueries: []*anypb.Any{rq}}})
+	var rr []*idm.Role
+	if er := commons.ForEach(st, e, func(resp *idm.SearchRoleResponse) error {
+		rr = append(rr, resp.GetRole())
+		return nil
+	}); er != nil {
+		return er
+	}
+	for _, r := range rr {
+		if !r.GetUserRole() && !r.GetGroupRole() {
+			continue
+		}
+		r.Policies = all.Rewrite(r.GetPolicies(), covering[r.GetUuid()])
+		if _, er := roleCli.CreateRole(ctx, &idm.CreateRoleRequest{Role: r}); er != nil {
+			log.Logger(ctx).Error("Cannot update role policies for delegation", zap.String("role", r.GetUuid()), zap.Error(er))
+		}
+	}
+	return nil
+}
@@ -0,0 +1,150 @@
+/*
+ * Copyright (c) 2025 Abstrium SAS <team (at) pydio.com>
+ * This file is part of Pydio Cells.
+ *
+ * Pydio Cells is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * Pydio Cells is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with Pydio Cells.  If not, see <http://www.gnu.org/licenses/>.
+ *
+ * The latest code can be found at <https://pydio.com>.
+ */
+
+// Package delegation restricts administration rights granted to the members of a role to a groups subtree.
+//
+// A delegation is materialized by WRITE resource policies bound to the delegated role subject, set on all users
+// and groups of the subtree as well as on their personal roles. Standard policy checks of the user and role REST
+// handlers then apply naturally, delegations being additionally used to validate creations and role assignments.
+package delegation
+
+import (
+	"context"
+	"path"
+	"slices"
+	"strings"
+
+	"github.com/pydio/cells/v5/common"
+	"github.com/pydio/cells/v5/common/auth/claim"
+	"github.com/pydio/cells/v5/common/permissions"
+	"github.com/pydio/cells/v5/common/proto/idm"
+	"github.com/pydio/cells/v5/common/proto/rest"
+	"github.com/pydio/cells/v5/common/proto/service"
+)
+
+// Delegations is a set of administration delegations.
+type Delegations []*rest.DelegatedAdmin
+
+// ForContext loads the delegations granted to the current user through its roles. It is always empty for admins,
+// who do not need any delegation.
+func ForContext(ctx context.Context) (Delegations, error) {
+	claims, ok := claim.FromContext(ctx)
+	if !ok || claims.Profile == common.PydioProfileAdmin || claims.Profile == common.PydioProfileShared {
+		return nil, nil
+	}
+	all, er := List(ctx)
+	if er != nil {
+		return nil, er
+	}
+	return all.ForRoles(strings.Split(claims.Roles, ",")), nil
+}
+
+// ForRoles filters the delegations granted to one of the passed roles.
+func (dd Delegations) ForRoles(roles []string) (out Delegations) {
+	for _, d := range dd {
+		if slices.Contains(roles, d.GetRoleUuid()) {
+			out = append(out, d)
+		}
+	}
+	return
+}
+
+// ForPath filters the delegations whose subtree contains a user group path or a group full path. The root group of
+// a subtree itself remains out of reach of its delegated administrators.
+func (dd Delegations) ForPath(groupPath string, isGroup bool) (out Delegations) {
+	for _, d := range dd {
+		if Covers(d.GetGroupPath(), groupPath, isGroup) {
+			out = append(out, d)
+		}
+	}
+	return
+}
+
+// CanAssign checks if one of the delegations allows assigning a role.
+func (dd Delegations) CanAssign(roleUuid string) bool {
+	for _, d := range dd {
+		if slices.Contains(d.GetAssignableRoles(), roleUuid) {
+			return true
+		}
+	}
+	return false
+}
+
+// Covers checks if a user group path or a group full path is located below a subtree root.
+func Covers(root, groupPath string, isGroup bool) bool {
+	root = clean(root)
+	gp := clean(groupPath)
+	if isGroup && gp == root {
+		return false
+	}
+	return root == "/" || gp == root || strings.HasPrefix(gp, root+"/")
+}
+
+// Protected checks if a user is out of reach of delegated administrators: admins are only managed by admins.
+func Protected(u *idm.User) bool {
+	return !u.GetIsGroup() && u.GetAttributes()[idm.UserAttrProfile] == common.PydioProfileAdmin
+}
+
+// FullPath returns the full path of a group as returned by a user search, or the group path of a user.
+func FullPath(u *idm.User) string {
+	if u.GetIsGroup() {
+		return path.Join(clean(u.GetGroupPath()), u.GetGroupLabel())
+	}
+	return clean(u.GetGroupPath())
+}
+
+// Rewrite replaces the delegation policies in a list of policies by the ones of the covering delegations.
+func (dd Delegations) Rewrite(pp []*service.ResourcePolicy, covering Delegations) (out []*service.ResourcePolicy) {
+	managed := map[string]bool{}
+	for _, d := range dd {
+		managed[subject(d)] = true
+	}
+	for _, p := range pp {
+		if p.GetAction() == service.ResourcePolicyAction_WRITE && managed[p.GetSubject()] {
+			continue
+		}
+		out = append(out, p)
+	}
+	for _, d := range covering {
+		out = append(out, &service.ResourcePolicy{
+			Subject: subject(d),
+			Action:  service.ResourcePolicyAction_WRITE,
+			Effect:  service.ResourcePolicy_allow,
+		})
+	}
+	return
+}
+
+// DefaultPolicies returns the policies applied by default to a new user or group.
+func DefaultPolicies(isGroup bool) []*service.ResourcePolicy {
+	b := permissions.NewResourcePoliciesBuilder()
+	if isGroup {
+		return b.WithProfileRead(common.PydioProfileStandard).WithProfileWrite(common.PydioProfileAdmin).Policies()
+	}
+	return b.WithStandardUserPolicies().Policies()
+}
+
+func subject(d *rest.DelegatedAdmin) string {
+	return permissions.PolicySubjectRolePrefix + d.GetRoleUuid()
+}
+
+func clean(groupPath string) string {
+	return "/" + strings.Trim(groupPath, "/")
+}
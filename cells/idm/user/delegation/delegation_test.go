@@ -0,0 +1,88 @@
+/*
+ * Copyright (c) 2025 Abstrium SAS <team (at) pydio.com>
+ * This file is part of Pydio Cells.
+ *
+ * Pydio Cells is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * Pydio Cells is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with Pydio Cells.  If not, see <http://www.gnu.org/licenses/>.
+ *
+ * The latest code can be found at <https://pydio.com>.
+ */
+
+package delegation
+
+import (
+	"testing"
+
+	"github.com/pydio/cells/v5/common"
+	"github.com/pydio/cells/v5/common/proto/idm"
+	"github.com/pydio/cells/v5/common/proto/service"
+
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestCovers(t *testing.T) {
+	Convey("Test subtree coverage", t, func() {
+		So(Covers("/sales", "/sales", false), ShouldBeTrue)
+		So(Covers("/sales", "/sales/emea", false), ShouldBeTrue)
+		So(Covers("/sales/", "sales/emea", true), ShouldBeTrue)
+		So(Covers("/sales", "/sales", true), ShouldBeFalse)
+		So(Covers("/sales", "/salesforce", false), ShouldBeFalse)
+		So(Covers("/sales", "/", false), ShouldBeFalse)
+		So(Covers("/", "/", false), ShouldBeTrue)
+		So(Covers("/", "/", true), ShouldBeFalse)
+	})
+	Convey("Test full path", t, func() {
+		So(FullPath(&idm.User{GroupPath: "/sales/", Login: "john"}), ShouldEqual, "/sales")
+		So(FullPath(&idm.User{GroupPath: "/sales", GroupLabel: "emea", IsGroup: true}), ShouldEqual, "/sales/emea")
+		So(FullPath(&idm.User{GroupPath: "/", GroupLabel: "sales", IsGroup: true}), ShouldEqual, "/sales")
+	})
+	Convey("Test protected users", t, func() {
+		So(Protected(&idm.User{Login: "root", Attributes: map[string]string{idm.UserAttrProfile: common.PydioProfileAdmin}}), ShouldBeTrue)
+		So(Protected(&idm.User{Login: "john", Attributes: map[string]string{idm.UserAttrProfile: common.PydioProfileStandard}}), ShouldBeFalse)
+		So(Protected(&idm.User{GroupLabel: "sales", IsGroup: true}), ShouldBeFalse)
+	})
+}
+
+func TestDelegations(t *testing.T) {
+	dd := Delegations{
+		{RoleUuid: "sales-admins", GroupPath: "/sales", AssignableRoles: []string{"sales-reader"}},
+		{RoleUuid: "emea-admins", GroupPath: "/sales/emea", AssignableRoles: []string{"emea-reader"}},
+		{RoleUuid: "rd-admins", GroupPath: "/rd"},
+	}
+	Convey("Test filtering delegations", t, func() {
+		So(dd.ForRoles([]string{"emea-admins", "other"}), ShouldHaveLength, 1)
+		So(dd.ForPath("/sales/emea", false), ShouldHaveLength, 2)
+		So(dd.ForPath("/sales/emea", true), ShouldHaveLength, 1)
+		So(dd.ForPath("/marketing", false), ShouldBeEmpty)
+		So(dd.ForPath("/sales/emea", false).CanAssign("sales-reader"), ShouldBeTrue)
+		So(dd.ForPath("/sales", false).CanAssign("emea-reader"), ShouldBeFalse)
+	})
+	Convey("Test rewriting policies", t, func() {
+		pp := []*service.ResourcePolicy{
+			{Subject: "profile:admin", Action: service.ResourcePolicyAction_WRITE, Effect: service.ResourcePolicy_allow},
+			{Subject: "role:rd-admins", Action: service.ResourcePolicyAction_WRITE, Effect: service.ResourcePolicy_allow},
+			{Subject: "role:custom", Action: service.ResourcePolicyAction_WRITE, Effect: service.ResourcePolicy_allow},
+		}
+		out := dd.Rewrite(pp, dd.ForPath("/sales/emea", false))
+		var subjects []string
+		for _, p := range out {
+			subjects = append(subjects, p.GetSubject())
+		}
+		So(subjects, ShouldResemble, []string{"profile:admin", "role:custom", "role:sales-admins", "role:emea-admins"})
+		So(dd.Rewrite(out, nil), ShouldHaveLength, 2)
+	})
+	Convey("Test default policies", t, func() {
+		So(DefaultPolicies(true), ShouldHaveLength, 2)
+		So(DefaultPolicies(false), ShouldNotBeEmpty)
+	})
+}
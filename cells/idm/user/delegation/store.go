@@ -0,0 +1,101 @@
+/*
+ * Copyright (c) 2025 Abstrium SAS <team (at) pydio.com>
+ * This file is part of Pydio Cells.
+ *
+ * Pydio Cells is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * Pydio Cells is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with Pydio Cells.  If not, see <http://www.gnu.org/licenses/>.
+ *
+ * The latest code can be found at <https://pydio.com>.
+ */
+
+package delegation
+
+import (
+	"context"
+	"io"
+
+	"google.golang.org/protobuf/encoding/protojson"
+
+	"github.com/pydio/cells/v5/common"
+	"github.com/pydio/cells/v5/common/client/commons/docstorec"
+	"github.com/pydio/cells/v5/common/errors"
+	"github.com/pydio/cells/v5/common/proto/docstore"
+	"github.com/pydio/cells/v5/common/proto/rest"
+)
+
+// Get loads the delegation attached to a role.
+func Get(ctx context.Context, roleUuid string) (*rest.DelegatedAdmin, error) {
+	resp, er := docstorec.DocStoreClient(ctx).GetDocument(ctx, &docstore.GetDocumentRequest{
+		StoreID:    common.DocStoreIdDelegatedAdmins,
+		DocumentID: roleUuid,
+	})
+	if er != nil || resp.GetDocument() == nil || resp.GetDocument().GetData() == "" {
+		return nil, errors.WithMessagef(errors.StatusNotFound, "cannot find delegation for role %s", roleUuid)
+	}
+	d := &rest.DelegatedAdmin{}
+	if er = protojson.Unmarshal([]byte(resp.GetDocument().GetData()), d); er != nil {
+		return nil, errors.Tag(er, errors.UnmarshalError)
+	}
+	return d, nil
+}
+
+// List loads all delegations.
+func List(ctx context.Context) (dd Delegations, err error) {
+	ct, ca := context.WithCancel(ctx)
+	defer ca()
+	streamer, er := docstorec.DocStoreClient(ctx).ListDocuments(ct, &docstore.ListDocumentsRequest{
+		StoreID: common.DocStoreIdDelegatedAdmins,
+		Query:   &docstore.DocumentQuery{},
+	})
+	if er != nil {
+		return nil, er
+	}
+	for {
+		resp, e := streamer.Recv()
+		if e == io.EOF {
+			break
+		} else if e != nil {
+			return nil, e
+		}
+		d := &rest.DelegatedAdmin{}
+		if protojson.Unmarshal([]byte(resp.GetDocument().GetData()), d) == nil {
+			dd = append(dd, d)
+		}
+	}
+	return
+}
+
+func put(ctx context.Context, d *rest.DelegatedAdmin) error {
+	data, er := protojson.Marshal(d)
+	if er != nil {
+		return er
+	}
+	_, er = docstorec.DocStoreClient(ctx).PutDocument(ctx, &docstore.PutDocumentRequest{
+		StoreID:    common.DocStoreIdDelegatedAdmins,
+		DocumentID: d.GetRoleUuid(),
+		Document: &docstore.Document{
+			ID:   d.GetRoleUuid(),
+			Type: docstore.DocumentType_JSON,
+			Data: string(data),
+		},
+	})
+	return er
+}
+
+func del(ctx context.Context, roleUuid string) error {
+	_, er := docstorec.DocStoreClient(ctx).DeleteDocuments(ctx, &docstore.DeleteDocumentsRequest{
+		StoreID:    common.DocStoreIdDelegatedAdmins,
+		DocumentID: roleUuid,
+	})
+	return er
+}
@@ -0,0 +1,181 @@
+/*
+ * Copyright (c) 2025 Abstrium SAS <team (at) pydio.com>
+ * This file is part of Pydio Cells.
+ *
+ * Pydio Cells is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * Pydio Cells is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with Pydio Cells.  If not, see <http://www.gnu.org/licenses/>.
+ *
+ * The latest code can be found at <https://pydio.com>.
+ */
+
+package export
+
+import (
+	"context"
+	"time"
+
+	"github.com/pydio/cells/v5/common"
+	"github.com/pydio/cells/v5/common/client/commons"
+	"github.com/pydio/cells/v5/common/client/commons/idmc"
+	"github.com/pydio/cells/v5/common/client/grpc"
+	"github.com/pydio/cells/v5/common/proto/activity"
+	"github.com/pydio/cells/v5/common/proto/chat"
+	"github.com/pydio/cells/v5/common/proto/idm"
+	"github.com/pydio/cells/v5/common/proto/rest"
+	"github.com/pydio/cells/v5/idm/meta"
+	"github.com/pydio/cells/v5/idm/share"
+)
+
+type profile struct {
+	Login      string            `json:"login"`
+	Uuid       string            `json:"uuid"`
+	GroupPath  string            `json:"groupPath"`
+	Attributes map[string]string `json:"attributes"`
+	Roles      []string          `json:"roles"`
+}
+
+type userMeta struct {
+	NodeUuid  string `json:"nodeUuid"`
+	Namespace string `json:"namespace"`
+	Value     string `json:"value"`
+}
+
+type chatMessage struct {
+	Room      string    `json:"room"`
+	RoomType  string    `json:"roomType"`
+	Object    string    `json:"object,omitempty"`
+	Message   string    `json:"message"`
+	Posted    time.Time `json:"posted"`
+	ParentId  string    `json:"parentId,omitempty"`
+	MessageId string    `json:"messageId"`
+}
+
+// DefaultSources lists the data collected from the various services.
+func DefaultSources() []*Source {
+	return []*Source{
+		{Name: "profile", File: "profile.json", Collect: collectProfile},
+		{Name: "user-meta", File: "user-meta.json", Collect: collectUserMeta(false)},
+		{Name: "bookmarks", File: "bookmarks.json", Collect: collectUserMeta(true)},
+		{Name: "activities", File: "activities.json", Collect: collectActivities},
+		{Name: "chats", File: "chats.json", Collect: collectChats},
+		{Name: "shares", File: "shares.json", Collect: collectShares},
+	}
+}
+
+func collectProfile(ctx context.Context, u *idm.User) (interface{}, int, error) {
+	pub := u.WithPublicData(ctx, true)
+	p := &profile{
+		Login:      pub.GetLogin(),
+		Uuid:       pub.GetUuid(),
+		GroupPath:  pub.GetGroupPath(),
+		Attributes: pub.GetAttributes(),
+	}
+	for _, r := range pub.GetRoles() {
+		if r.GetUserRole() || r.GetGroupRole() {
+			continue
+		}
+		p.Roles = append(p.Roles, r.GetLabel())
+	}
+	return p, 1, nil
+}
+
+func collectUserMeta(bookmarks bool) func(ctx context.Context, u *idm.User) (interface{}, int, error) {
+	return func(ctx context.Context, u *idm.User) (interface{}, int, error) {
+		req := &idm.SearchUserMetaRequest{ResourceSubjectOwner: u.GetUuid()}
+		if bookmarks {
+			req.Namespace = meta.ReservedNamespaceBookmark
+		}
+		out := []*userMeta{}
+		st, er := idmc.UserMetaServiceClient(ctx).SearchUserMeta(ctx, req)
+		er = commons.ForEach(st, er, func(resp *idm.SearchUserMetaResponse) error {
+			m := resp.GetUserMeta()
+			if !bookmarks && m.GetNamespace() == meta.ReservedNamespaceBookmark {
+				return nil
+			}
+			out = append(out, &userMeta{NodeUuid: m.GetNodeUuid(), Namespace: m.GetNamespace(), Value: m.GetJsonValue()})
+			return nil
+		})
+		return out, len(out), er
+	}
+}
+
+func collectActivities(ctx context.Context, u *idm.User) (interface{}, int, error) {
+	cli := activity.NewActivityServiceClient(grpc.ResolveConn(ctx, common.ServiceActivityGRPC))
+	out := map[string][]*activity.Object{}
+	var count int
+	for _, box := range []string{"outbox", "inbox"} {
+		out[box] = []*activity.Object{}
+		st, er := cli.StreamActivities(ctx, &activity.StreamActivitiesRequest{
+			Context:     activity.StreamContext_USER_ID,
+			ContextData: u.GetLogin(),
+			BoxName:     box,
+		})
+		if er = commons.ForEach(st, er, func(resp *activity.StreamActivitiesResponse) error {
+			out[box] = append(out[box], resp.GetActivity())
+			count++
+			return nil
+		}); er != nil {
+			return nil, 0, er
+		}
+	}
+	return out, count, nil
+}
+
+func collectChats(ctx context.Context, u *idm.User) (interface{}, int, error) {
+	cli := chat.NewChatServiceClient(grpc.ResolveConn(ctx, common.ServiceChatGRPC))
+	var rooms []*chat.ChatRoom
+	// Rooms can only be listed by type
+	for _, t := range []chat.RoomType{chat.RoomType_WORKSPACE, chat.RoomType_NODE, chat.RoomType_USER, chat.RoomType_GLOBAL} {
+		st, er := cli.ListRooms(ctx, &chat.ListRoomsRequest{ByType: t})
+		if er = commons.ForEach(st, er, func(resp *chat.ListRoomsResponse) error {
+			rooms = append(rooms, resp.GetRoom())
+			return nil
+		}); er != nil {
+			return nil, 0, er
+		}
+	}
+	out := []*chatMessage{}
+	for _, room := range rooms {
+		ms, e := cli.ListMessages(ctx, &chat.ListMessagesRequest{RoomUuid: room.GetUuid()})
+		if e = commons.ForEach(ms, e, func(resp *chat.ListMessagesResponse) error {
+			msg := resp.GetMessage()
+			if msg.GetAuthor() != u.GetLogin() {
+				return nil
+			}
+			out = append(out, &chatMessage{
+				Room:      room.GetRoomLabel(),
+				RoomType:  room.GetType().String(),
+				Object:    room.GetRoomTypeObject(),
+				Message:   msg.GetMessage(),
+				Posted:    time.Unix(msg.GetTimestamp(), 0),
+				ParentId:  msg.GetParentUuid(),
+				MessageId: msg.GetUuid(),
+			})
+			return nil
+		}); e != nil {
+			return nil, 0, e
+		}
+	}
+	return out, len(out), nil
+}
+
+func collectShares(ctx context.Context, u *idm.User) (interface{}, int, error) {
+	entries, er := share.NewClient(nil).Inventory(ctx, &rest.ShareInventoryRequest{Owner: u.GetLogin()})
+	if er != nil {
+		return nil, 0, er
+	}
+	if entries == nil {
+		entries = []*rest.ShareInventoryEntry{}
+	}
+	return entries, len(entries), nil
+}
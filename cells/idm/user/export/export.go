@@ -0,0 +1,194 @@
+/*
+ * Copyright (c) 2025 Abstrium SAS <team (at) pydio.com>
+ * This file is part of Pydio Cells.
+ *
+ * Pydio Cells is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * Pydio Cells is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with Pydio Cells.  If not, see <http://www.gnu.org/licenses/>.
+ *
+ * The latest code can be found at <https://pydio.com>.
+ */
+
+// Package export builds self-service archives of the personal data attached to a user account.
+package export
+
+import (
+	"archive/zip"
+	"context"
+	"io"
+	"path"
+	"strings"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/pydio/cells/v5/common/nodes"
+	"github.com/pydio/cells/v5/common/nodes/models"
+	"github.com/pydio/cells/v5/common/proto/idm"
+	"github.com/pydio/cells/v5/common/proto/tree"
+	"github.com/pydio/cells/v5/common/telemetry/log"
+	json "github.com/pydio/cells/v5/common/utils/jsonx"
+)
+
+const (
+	// ManifestVersion is increased each time the archive layout changes
+	ManifestVersion = 1
+	// ManifestFile is the name of the JSON manifest at the root of the archive
+	ManifestFile = "manifest.json"
+	// ArchivePrefix is used to name the archives, which are skipped when exporting personal files
+	ArchivePrefix = "data-export-"
+	// PersonalFiles is the slug of the workspace copied under files/
+	PersonalFiles = "personal-files"
+	// DefaultValidity is the lifetime of the download link
+	DefaultValidity = 7 * 24 * time.Hour
+
+	filesFolder = "files"
+)
+
+// Section describes one part of the archive.
+type Section struct {
+	Name  string `json:"name"`
+	File  string `json:"file"`
+	Count int    `json:"count"`
+	Error string `json:"error,omitempty"`
+}
+
+// Manifest lists the content of the archive.
+type Manifest struct {
+	Version   int        `json:"version"`
+	User      string     `json:"user"`
+	UserUuid  string     `json:"userUuid"`
+	Generated time.Time  `json:"generated"`
+	Expires   time.Time  `json:"expires"`
+	Sections  []*Section `json:"sections"`
+}
+
+// Source collects one category of data. Collect returns a JSON-serializable value and the number of records.
+type Source struct {
+	Name    string
+	File    string
+	Collect func(ctx context.Context, u *idm.User) (interface{}, int, error)
+}
+
+// Exporter writes the archive of a user.
+type Exporter struct {
+	Sources []*Source
+	// Router is used to copy the personal files, they are skipped if nil.
+	Router    nodes.Handler
+	FilesRoot string
+	Validity  time.Duration
+}
+
+// ArchiveName computes the name of the archive generated at a given time.
+func ArchiveName(now time.Time) string {
+	return ArchivePrefix + now.Format("20060102-150405") + ".zip"
+}
+
+// Write builds a zip archive of all sources and personal files. A failing source is recorded in the
+// manifest instead of aborting the whole export.
+func (e *Exporter) Write(ctx context.Context, w io.Writer, u *idm.User, now time.Time) (*Manifest, error) {
+	validity := e.Validity
+	if validity == 0 {
+		validity = DefaultValidity
+	}
+	m := &Manifest{
+		Version:   ManifestVersion,
+		User:      u.GetLogin(),
+		UserUuid:  u.GetUuid(),
+		Generated: now,
+		Expires:   now.Add(validity),
+	}
+	z := zip.NewWriter(w)
+	for _, s := range e.Sources {
+		section := &Section{Name: s.Name, File: s.File}
+		m.Sections = append(m.Sections, section)
+		data, count, er := s.Collect(ctx, u)
+		if er != nil {
+			log.Logger(ctx).Warn("Cannot collect "+s.Name+" for data export", zap.Error(er))
+			section.Error = er.Error()
+			continue
+		}
+		section.Count = count
+		if er = writeJSON(z, s.File, now, data); er != nil {
+			return nil, er
+		}
+	}
+	if e.Router != nil {
+		section := &Section{Name: "files", File: filesFolder + "/"}
+		m.Sections = append(m.Sections, section)
+		count, er := e.copyFiles(ctx, z)
+		section.Count = count
+		if er != nil {
+			section.Error = er.Error()
+		}
+	}
+	if er := writeJSON(z, ManifestFile, now, m); er != nil {
+		return nil, er
+	}
+	return m, z.Close()
+}
+
+func (e *Exporter) copyFiles(ctx context.Context, z *zip.Writer) (count int, err error) {
+	root := e.FilesRoot
+	if root == "" {
+		root = PersonalFiles
+	}
+	err = e.Router.ListNodesWithCallback(ctx, &tree.ListNodesRequest{
+		Node:       &tree.Node{Path: root},
+		Recursive:  true,
+		FilterType: tree.NodeType_LEAF,
+	}, func(ctx context.Context, n *tree.Node, err error) error {
+		if err != nil {
+			return nil
+		}
+		internalPath := strings.TrimLeft(strings.TrimPrefix(n.GetPath(), root), "/")
+		if internalPath == "" || strings.HasPrefix(path.Base(internalPath), ArchivePrefix) {
+			return nil
+		}
+		r, er := e.Router.GetObject(ctx, n, &models.GetRequestData{StartOffset: 0, Length: -1})
+		if nodes.Is403(er) {
+			return nil
+		} else if er != nil {
+			return er
+		}
+		defer r.Close()
+		header := &zip.FileHeader{
+			Name:               filesFolder + "/" + internalPath,
+			Method:             zip.Deflate,
+			UncompressedSize64: uint64(n.GetSize()),
+			Modified:           n.GetModTime(),
+		}
+		fw, er := z.CreateHeader(header)
+		if er != nil {
+			return er
+		}
+		if _, er = io.Copy(fw, r); er != nil {
+			return er
+		}
+		count++
+		return nil
+	}, false, nodes.WalkFilterSkipPydioHiddenFile)
+	return
+}
+
+func writeJSON(z *zip.Writer, name string, now time.Time, data interface{}) error {
+	content, er := json.MarshalIndent(data, "", "  ")
+	if er != nil {
+		return er
+	}
+	fw, er := z.CreateHeader(&zip.FileHeader{Name: name, Method: zip.Deflate, Modified: now})
+	if er != nil {
+		return er
+	}
+	_, er = fw.Write(content)
+	return er
+}
@@ -0,0 +1,113 @@
+/*
+ * Copyright (c) 2025 Abstrium SAS <team (at) pydio.com>
+ * This file is part of Pydio Cells.
+ *
+ * Pydio Cells is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * Pydio Cells is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with Pydio Cells.  If not, see <http://www.gnu.org/licenses/>.
+ *
+ * The latest code can be found at <https://pydio.com>.
+ */
+
+package export
+
+import (
+	"archive/zip"
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/pydio/cells/v5/common/nodes"
+	"github.com/pydio/cells/v5/common/proto/idm"
+	"github.com/pydio/cells/v5/common/proto/tree"
+	json "github.com/pydio/cells/v5/common/utils/jsonx"
+
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func readZip(data []byte) map[string][]byte {
+	r, _ := zip.NewReader(bytes.NewReader(data), int64(len(data)))
+	out := map[string][]byte{}
+	for _, f := range r.File {
+		rc, _ := f.Open()
+		out[f.Name], _ = io.ReadAll(rc)
+		_ = rc.Close()
+	}
+	return out
+}
+
+func TestExporterWrite(t *testing.T) {
+
+	Convey("Test writing an export archive", t, func() {
+		ctx := context.Background()
+		now := time.Date(2025, 3, 1, 10, 0, 0, 0, time.UTC)
+		u := &idm.User{Login: "alice", Uuid: "alice-uuid"}
+
+		e := &Exporter{Sources: []*Source{
+			{Name: "profile", File: "profile.json", Collect: collectProfile},
+			{Name: "bookmarks", File: "bookmarks.json", Collect: func(ctx context.Context, u *idm.User) (interface{}, int, error) {
+				return []*userMeta{{NodeUuid: "n1", Namespace: "bookmark", Value: "true"}}, 1, nil
+			}},
+			{Name: "chats", File: "chats.json", Collect: func(ctx context.Context, u *idm.User) (interface{}, int, error) {
+				return nil, 0, fmt.Errorf("service unavailable")
+			}},
+		}}
+		buf := &bytes.Buffer{}
+		m, er := e.Write(ctx, buf, u, now)
+		So(er, ShouldBeNil)
+		So(m.Expires, ShouldEqual, now.Add(DefaultValidity))
+		So(m.Sections, ShouldHaveLength, 3)
+		So(m.Sections[1].Count, ShouldEqual, 1)
+		So(m.Sections[2].Error, ShouldEqual, "service unavailable")
+
+		files := readZip(buf.Bytes())
+		So(files, ShouldContainKey, ManifestFile)
+		So(files, ShouldContainKey, "profile.json")
+		So(files, ShouldContainKey, "bookmarks.json")
+		So(files, ShouldNotContainKey, "chats.json")
+
+		var read Manifest
+		So(json.Unmarshal(files[ManifestFile], &read), ShouldBeNil)
+		So(read.User, ShouldEqual, "alice")
+		So(read.Version, ShouldEqual, ManifestVersion)
+		So(read.Sections, ShouldHaveLength, 3)
+	})
+
+	Convey("Test personal files are copied, previous exports skipped", t, func() {
+		ctx := context.Background()
+		now := time.Now()
+		mock := nodes.NewHandlerMock()
+		mock.RootDir = t.TempDir()
+		_ = os.MkdirAll(filepath.Join(mock.RootDir, PersonalFiles), 0755)
+		_ = os.WriteFile(filepath.Join(mock.RootDir, PersonalFiles, "doc.txt"), []byte("my content"), 0644)
+		mock.Nodes[PersonalFiles] = &tree.Node{Path: PersonalFiles, Type: tree.NodeType_COLLECTION}
+		mock.Nodes[PersonalFiles+"/doc.txt"] = &tree.Node{Path: PersonalFiles + "/doc.txt", Type: tree.NodeType_LEAF, Size: 10}
+		mock.Nodes[PersonalFiles+"/"+ArchiveName(now)] = &tree.Node{Path: PersonalFiles + "/" + ArchiveName(now), Type: tree.NodeType_LEAF, Size: 10}
+
+		e := &Exporter{Router: mock}
+		buf := &bytes.Buffer{}
+		m, er := e.Write(ctx, buf, &idm.User{Login: "alice"}, now)
+		So(er, ShouldBeNil)
+		So(m.Sections, ShouldHaveLength, 1)
+		So(m.Sections[0].Count, ShouldEqual, 1)
+
+		files := readZip(buf.Bytes())
+		So(string(files["files/doc.txt"]), ShouldEqual, "my content")
+		So(files, ShouldHaveLength, 2)
+	})
+
+}
@@ -0,0 +1,159 @@
+/*
+ * Copyright (c) 2025 Abstrium SAS <team (at) pydio.com>
+ * This file is part of Pydio Cells.
+ *
+ * Pydio Cells is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * Pydio Cells is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with Pydio Cells.  If not, see <http://www.gnu.org/licenses/>.
+ *
+ * The latest code can be found at <https://pydio.com>.
+ */
+
+package export
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/pydio/cells/v5/common"
+	"github.com/pydio/cells/v5/common/client/commons/docstorec"
+	"github.com/pydio/cells/v5/common/client/grpc"
+	"github.com/pydio/cells/v5/common/errors"
+	"github.com/pydio/cells/v5/common/proto/docstore"
+	"github.com/pydio/cells/v5/common/proto/idm"
+	"github.com/pydio/cells/v5/common/proto/mailer"
+	"github.com/pydio/cells/v5/common/telemetry/log"
+	"github.com/pydio/cells/v5/common/utils/i18n/languages"
+	json "github.com/pydio/cells/v5/common/utils/jsonx"
+	"github.com/pydio/cells/v5/common/utils/uuid"
+)
+
+// Link is a download token pointing to a generated archive.
+type Link struct {
+	Token      string `json:"-"`
+	Owner      string `json:"OWNER"`
+	NodeUuid   string `json:"NODE"`
+	Name       string `json:"name"`
+	Expiration int64  `json:"expiration"`
+}
+
+// Expired checks the link validity.
+func (l *Link) Expired(now time.Time) bool {
+	return l.Expiration < now.Unix()
+}
+
+// CreateLink stores a new download token for an archive.
+func CreateLink(ctx context.Context, owner, nodeUuid, name string, expires time.Time) (*Link, error) {
+	l := &Link{
+		Token:      uuid.New(),
+		Owner:      owner,
+		NodeUuid:   nodeUuid,
+		Name:       name,
+		Expiration: expires.Unix(),
+	}
+	data, _ := json.Marshal(l)
+	_, er := docstorec.DocStoreClient(ctx).PutDocument(ctx, &docstore.PutDocumentRequest{
+		StoreID:    common.DocStoreIdDataExports,
+		DocumentID: l.Token,
+		Document: &docstore.Document{
+			ID:            l.Token,
+			Owner:         owner,
+			Type:          docstore.DocumentType_JSON,
+			Data:          string(data),
+			IndexableMeta: string(data),
+		},
+	})
+	if er != nil {
+		return nil, er
+	}
+	return l, nil
+}
+
+// GetLink loads a download token, whether it is expired or not.
+func GetLink(ctx context.Context, token string) (*Link, error) {
+	resp, er := docstorec.DocStoreClient(ctx).GetDocument(ctx, &docstore.GetDocumentRequest{
+		StoreID:    common.DocStoreIdDataExports,
+		DocumentID: token,
+	})
+	if er != nil || resp.GetDocument() == nil || resp.GetDocument().GetData() == "" {
+		return nil, errors.WithMessage(errors.StatusNotFound, "invalid or expired link")
+	}
+	l := &Link{Token: token}
+	if er = json.Unmarshal([]byte(resp.GetDocument().GetData()), l); er != nil {
+		return nil, errors.Tag(er, errors.UnmarshalError)
+	}
+	return l, nil
+}
+
+// ListLinks loads all download tokens.
+func ListLinks(ctx context.Context) (ll []*Link, err error) {
+	ct, ca := context.WithCancel(ctx)
+	defer ca()
+	streamer, er := docstorec.DocStoreClient(ctx).ListDocuments(ct, &docstore.ListDocumentsRequest{
+		StoreID: common.DocStoreIdDataExports,
+		Query:   &docstore.DocumentQuery{},
+	})
+	if er != nil {
+		return nil, er
+	}
+	for {
+		resp, e := streamer.Recv()
+		if e == io.EOF {
+			break
+		} else if e != nil {
+			return nil, e
+		}
+		l := &Link{Token: resp.GetDocument().GetID()}
+		if json.Unmarshal([]byte(resp.GetDocument().GetData()), l) == nil {
+			ll = append(ll, l)
+		}
+	}
+	return
+}
+
+// DeleteLink removes a download token.
+func DeleteLink(ctx context.Context, token string) error {
+	_, er := docstorec.DocStoreClient(ctx).DeleteDocuments(ctx, &docstore.DeleteDocumentsRequest{
+		StoreID:    common.DocStoreIdDataExports,
+		DocumentID: token,
+	})
+	return er
+}
+
+// Notify sends the download link to the user.
+func Notify(ctx context.Context, u *idm.User, l *Link) {
+	if u.GetAttributes()[idm.UserAttrEmail] == "" {
+		return
+	}
+	if _, e := mailer.NewMailerServiceClient(grpc.ResolveConn(ctx, common.ServiceMailerGRPC)).SendMail(ctx, &mailer.SendMailRequest{
+		Mail: &mailer.Mail{
+			To: []*mailer.User{{
+				Uuid:     u.GetUuid(),
+				Name:     u.GetAttributes()[idm.UserAttrDisplayName],
+				Address:  u.GetAttributes()[idm.UserAttrEmail],
+				Language: languages.UserLanguage(ctx, u),
+			}},
+			TemplateId: "DataExport",
+			TemplateData: map[string]string{
+				"Archive":  l.Name,
+				"Expires":  time.Unix(l.Expiration, 0).Format("2006-01-02"),
+				"LinkPath": fmt.Sprintf("%s/user/export/%s", common.DefaultRouteREST, l.Token),
+			},
+		},
+	}); e != nil {
+		log.Logger(ctx).Error("Cannot send data export email to "+u.GetLogin(), zap.Error(e))
+	}
+}
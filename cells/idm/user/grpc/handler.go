@@ -130,6 +130,7 @@ func (h *Handler) BindUser(ctx context.Context, req *idm.BindUserRequest) (*idm.
 		return nil, err
 	}
 	u.Password = ""
+	h.checkPasswordAge(ctx, dao, u)
 	resp := &idm.BindUserResponse{
 		User: u,
 	}
@@ -145,6 +146,11 @@ func (h *Handler) CreateUser(ctx context.Context, req *idm.CreateUserRequest) (*
 	}
 
 	passChange := req.User.Password
+	if passChange != "" && !req.User.IsGroup {
+		if er := h.checkPasswordPolicy(ctx, dao, req.User); er != nil {
+			return nil, er
+		}
+	}
 	// Create or update user
 	newUser, createdNodes, err := dao.Add(ctx, req.User)
 	if err != nil {
@@ -271,6 +277,65 @@ func (h *Handler) CreateUser(ctx context.Context, req *idm.CreateUserRequest) (*
 	return resp, nil
 }
 
+// checkPasswordPolicy validates a new password against the configured password policy and the user history.
+// Hidden users (shared links) and already hashed passwords (imports) are not checked.
+func (h *Handler) checkPasswordPolicy(ctx context.Context, dao user.DAO, u *idm.User) error {
+	if u.IsHidden() || u.GetAttributes()[idm.UserAttrPassHashed] == "true" {
+		return nil
+	}
+	policy := auth.LoadPasswordPolicy(ctx)
+	if er := policy.Validate(u.Login, u.Password); er != nil {
+		return er
+	}
+	if policy.History > 0 && u.Uuid != "" {
+		if reused, er := dao.MatchPasswordHistory(ctx, u.Uuid, u.Password, policy.History); er != nil {
+			return er
+		} else if reused {
+			return errors.WithMessagef(errors.PasswordPolicyViolation, "password must be different from the last %d passwords", policy.History)
+		}
+	}
+	return nil
+}
+
+// checkPasswordAge sets a pass_change lock on users whose password is older than the configured maximum age.
+func (h *Handler) checkPasswordAge(ctx context.Context, dao user.DAO, u *idm.User) {
+	if u.IsHidden() {
+		return
+	}
+	policy := auth.LoadPasswordPolicy(ctx)
+	if policy.MaxAge == 0 {
+		return
+	}
+	changedAt, er := dao.PasswordChangedAt(ctx, u.Uuid)
+	if er != nil || !policy.Expired(changedAt) {
+		return
+	}
+	var locks []string
+	if l, ok := u.Attributes["locks"]; ok {
+		_ = json.Unmarshal([]byte(l), &locks)
+	}
+	for _, lock := range locks {
+		if lock == "pass_change" {
+			return
+		}
+	}
+	locks = append(locks, "pass_change")
+	marsh, _ := json.Marshal(locks)
+	if u.Attributes == nil {
+		u.Attributes = make(map[string]string)
+	}
+	u.Attributes["locks"] = string(marsh)
+	if _, _, e := dao.Add(ctx, u); e != nil {
+		log.Logger(ctx).Error("cannot set pass_change lock on user "+u.Login, u.ZapUuid(), zap.Error(e))
+		return
+	}
+	log.Auditer(ctx).Info(
+		fmt.Sprintf("Password of user [%s] has expired, a change is required", u.Login),
+		log.GetAuditId(common.AuditLockUser),
+		u.ZapUuid(),
+	)
+}
+
 // DeleteUser from database
 func (h *Handler) DeleteUser(ctx context.Context, req *idm.DeleteUserRequest) (*idm.DeleteUserResponse, error) {
 	usersChan := make(chan *idm.User)
@@ -27,21 +27,27 @@ import (
 	"fmt"
 	"io"
 	"testing"
+	"time"
 
 	"go.uber.org/zap"
 	"google.golang.org/grpc/metadata"
 	"google.golang.org/protobuf/types/known/anypb"
+	"gorm.io/gorm"
 
 	"github.com/pydio/cells/v5/common"
 	"github.com/pydio/cells/v5/common/config"
+	"github.com/pydio/cells/v5/common/errors"
 	"github.com/pydio/cells/v5/common/proto/idm"
 	"github.com/pydio/cells/v5/common/proto/service"
+	"github.com/pydio/cells/v5/common/runtime/manager"
 	"github.com/pydio/cells/v5/common/storage/test"
 	"github.com/pydio/cells/v5/common/telemetry/log"
 	"github.com/pydio/cells/v5/common/utils/cache"
 	cache_helper "github.com/pydio/cells/v5/common/utils/cache/helper"
 	"github.com/pydio/cells/v5/common/utils/propagator"
+	"github.com/pydio/cells/v5/idm/user"
 	"github.com/pydio/cells/v5/idm/user/dao/sql"
+	user_model "github.com/pydio/cells/v5/idm/user/dao/sql/model"
 
 	. "github.com/smartystreets/goconvey/convey"
 )
@@ -296,6 +302,71 @@ func TestUser(t *testing.T) {
 	})
 }
 
+func TestPasswordPolicy(t *testing.T) {
+
+	test.RunStorageTests(testcases, t, func(ctx context.Context) {
+		var cfg config.Store
+		propagator.Get(ctx, config.ContextKey, &cfg)
+		Convey("Set password policy in config", t, func() {
+			So(cfg.Val(config.FrontendPluginPath("core.auth", "PASSWORD_MINLENGTH")...).Set(8), ShouldBeNil)
+			So(cfg.Val(config.FrontendPluginPath("core.auth", "PASSWORD_MIN_CLASSES")...).Set(3), ShouldBeNil)
+			So(cfg.Val(config.FrontendPluginPath("core.auth", "PASSWORD_HISTORY")...).Set(2), ShouldBeNil)
+			So(cfg.Val(config.FrontendPluginPath("core.auth", "PASSWORD_MAX_AGE")...).Set(30), ShouldBeNil)
+		})
+		defer func() {
+			_ = cfg.Val(config.FrontendPluginPath("core.auth")...).Del()
+		}()
+
+		h := NewHandler()
+
+		Convey("Test password rules", t, func() {
+			_, err := h.CreateUser(ctx, &idm.CreateUserRequest{User: &idm.User{Login: "weak", Password: "short"}})
+			So(errors.Is(err, errors.PasswordPolicyViolation), ShouldBeTrue)
+			_, err = h.CreateUser(ctx, &idm.CreateUserRequest{User: &idm.User{Login: "weak", Password: "onlylowercase"}})
+			So(errors.Is(err, errors.PasswordPolicyViolation), ShouldBeTrue)
+			resp, err := h.CreateUser(ctx, &idm.CreateUserRequest{User: &idm.User{Login: "hidden", Password: "short", Attributes: map[string]string{idm.UserAttrHidden: "true"}}})
+			So(err, ShouldBeNil)
+			So(resp.User, ShouldNotBeNil)
+		})
+
+		Convey("Test password history", t, func() {
+			resp, err := h.CreateUser(ctx, &idm.CreateUserRequest{User: &idm.User{Login: "paula", Password: "First-Pass1"}})
+			So(err, ShouldBeNil)
+			u := resp.GetUser()
+			u.Password = "Second-Pass2"
+			_, err = h.CreateUser(ctx, &idm.CreateUserRequest{User: u})
+			So(err, ShouldBeNil)
+			u.Password = "First-Pass1"
+			_, err = h.CreateUser(ctx, &idm.CreateUserRequest{User: u})
+			So(errors.Is(err, errors.PasswordPolicyViolation), ShouldBeTrue)
+			u.Password = "Third-Pass3"
+			_, err = h.CreateUser(ctx, &idm.CreateUserRequest{User: u})
+			So(err, ShouldBeNil)
+			// First password is now out of the last 2
+			u.Password = "First-Pass1"
+			_, err = h.CreateUser(ctx, &idm.CreateUserRequest{User: u})
+			So(err, ShouldBeNil)
+		})
+
+		Convey("Test password max age", t, func() {
+			bindResp, err := h.BindUser(ctx, &idm.BindUserRequest{UserName: "paula", Password: "First-Pass1"})
+			So(err, ShouldBeNil)
+			So(bindResp.GetUser().GetAttributes()["locks"], ShouldBeEmpty)
+
+			dao, er := manager.Resolve[user.DAO](ctx)
+			So(er, ShouldBeNil)
+			db := dao.(interface {
+				Session(context.Context) *gorm.DB
+			}).Session(ctx)
+			So(db.Model(&user_model.UserPasswordHistory{}).Where("uuid = ?", bindResp.GetUser().GetUuid()).Update("created_at", time.Now().Add(-31*24*time.Hour)).Error, ShouldBeNil)
+
+			bindResp, err = h.BindUser(ctx, &idm.BindUserRequest{UserName: "paula", Password: "First-Pass1"})
+			So(err, ShouldBeNil)
+			So(bindResp.GetUser().GetAttributes()["locks"], ShouldEqual, `["pass_change"]`)
+		})
+	})
+}
+
 // =================================================
 // * Mock *
 // =================================================
@@ -0,0 +1,192 @@
+/*
+ * Copyright (c) 2025 Abstrium SAS <team (at) pydio.com>
+ * This file is part of Pydio Cells.
+ *
+ * Pydio Cells is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * Pydio Cells is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with Pydio Cells.  If not, see <http://www.gnu.org/licenses/>.
+ *
+ * The latest code can be found at <https://pydio.com>.
+ */
+
+// Package guest manages the lifecycle of external users.
+//
+// External users (profile "shared") are stamped at creation with a sponsor, the internal user who invited them,
+// and an expiration date. The actions.idm.guests-lifecycle action reminds sponsors before expiration, disables
+// expired guests and finally deletes them, while actions.idm.guests-transfer hands sponsored guests over to a
+// fallback sponsor when their sponsor is deleted. Hidden users created for public links are stamped with a sponsor,
+// but follow the lifecycle of their link.
+package guest
+
+import (
+	"context"
+	"encoding/json"
+	"slices"
+	"strconv"
+	"time"
+
+	"github.com/pydio/cells/v5/common"
+	"github.com/pydio/cells/v5/common/config"
+	"github.com/pydio/cells/v5/common/proto/idm"
+)
+
+const (
+	// DefaultReminder is the delay before expiration at which sponsors are reminded, if not configured
+	DefaultReminder = 7 * 24 * time.Hour
+	// DefaultDeleteAfter is the delay after expiration at which disabled guests are deleted, if not configured
+	DefaultDeleteAfter = 30 * 24 * time.Hour
+
+	lockLogout = "logout"
+)
+
+// Stage is the next lifecycle step to apply to a guest.
+type Stage int
+
+const (
+	StageNone Stage = iota
+	StageInit
+	StageRemind
+	StageDisable
+	StageDelete
+)
+
+// Policy holds the guests lifecycle settings read from the Delegation parameters of the core.auth frontend plugin.
+type Policy struct {
+	// Lifetime is the validity of new guests accounts. Lifecycle is disabled if zero.
+	Lifetime time.Duration
+	// Reminder is the delay before expiration at which sponsors receive a reminder
+	Reminder time.Duration
+	// DeleteAfter is the delay after expiration at which disabled guests are deleted
+	DeleteAfter time.Duration
+	// FallbackSponsor receives the guests of deleted sponsors. Guests are left without sponsor if empty.
+	FallbackSponsor string
+}
+
+// LoadPolicy reads the guests lifecycle policy from the configuration.
+func LoadPolicy(ctx context.Context) *Policy {
+	days := func(name string, def time.Duration) time.Duration {
+		v := config.Get(ctx, config.FrontendPluginPath("core.auth", name)...).Default(-1).Int()
+		if v < 0 {
+			return def
+		}
+		return time.Duration(v) * 24 * time.Hour
+	}
+	return &Policy{
+		Lifetime:        days("GUEST_LIFETIME", 0),
+		Reminder:        days("GUEST_REMINDER_DAYS", DefaultReminder),
+		DeleteAfter:     days("GUEST_DELETE_DAYS", DefaultDeleteAfter),
+		FallbackSponsor: config.Get(ctx, config.FrontendPluginPath("core.auth", "GUEST_FALLBACK_SPONSOR")...).String(),
+	}
+}
+
+// Enabled tells if guests accounts expire.
+func (p *Policy) Enabled() bool {
+	return p.Lifetime > 0
+}
+
+// IsGuest checks if a user is an external user subject to the lifecycle.
+func IsGuest(u *idm.User) bool {
+	return !u.GetIsGroup() && !u.IsHidden() && u.GetAttributes()[idm.UserAttrProfile] == common.PydioProfileShared
+}
+
+// Stamp sets the sponsor and, for guests, the expiration date on an external user being created.
+// Lifecycle attributes passed by the client are always discarded.
+func (p *Policy) Stamp(u *idm.User, sponsor string, now time.Time) {
+	if u.Attributes == nil {
+		u.Attributes = map[string]string{}
+	}
+	for _, a := range []string{idm.UserAttrSponsor, idm.UserAttrGuestExpiration, idm.UserAttrGuestReminded, idm.UserAttrGuestDisabled} {
+		delete(u.Attributes, a)
+	}
+	if u.GetIsGroup() || u.Attributes[idm.UserAttrProfile] != common.PydioProfileShared {
+		return
+	}
+	if sponsor != "" && sponsor != u.GetLogin() {
+		u.Attributes[idm.UserAttrSponsor] = sponsor
+	}
+	if IsGuest(u) && p.Enabled() {
+		u.Attributes[idm.UserAttrGuestExpiration] = strconv.FormatInt(now.Add(p.Lifetime).Unix(), 10)
+	}
+}
+
+// Sponsor returns the login of the guest sponsor.
+func Sponsor(u *idm.User) string {
+	return u.GetAttributes()[idm.UserAttrSponsor]
+}
+
+// Expiration returns the guest expiration date, or a zero time if it has none.
+func Expiration(u *idm.User) time.Time {
+	return unixAttribute(u, idm.UserAttrGuestExpiration)
+}
+
+// Next computes the lifecycle step to apply to a guest.
+func (p *Policy) Next(u *idm.User, now time.Time) Stage {
+	if !IsGuest(u) || !p.Enabled() {
+		return StageNone
+	}
+	exp := Expiration(u)
+	if exp.IsZero() {
+		return StageInit
+	}
+	if !now.Before(exp.Add(p.DeleteAfter)) {
+		return StageDelete
+	}
+	if !now.Before(exp) {
+		if u.GetAttributes()[idm.UserAttrGuestDisabled] == "" {
+			return StageDisable
+		}
+		return StageNone
+	}
+	if Sponsor(u) != "" && !now.Before(exp.Add(-p.Reminder)) && u.GetAttributes()[idm.UserAttrGuestReminded] != u.GetAttributes()[idm.UserAttrGuestExpiration] {
+		return StageRemind
+	}
+	return StageNone
+}
+
+// Extend sets a new expiration date and re-enables the guest if it was disabled on expiration.
+func Extend(u *idm.User, until time.Time) {
+	if u.Attributes == nil {
+		u.Attributes = map[string]string{}
+	}
+	u.Attributes[idm.UserAttrGuestExpiration] = strconv.FormatInt(until.Unix(), 10)
+	delete(u.Attributes, idm.UserAttrGuestReminded)
+	if u.Attributes[idm.UserAttrGuestDisabled] != "" {
+		delete(u.Attributes, idm.UserAttrGuestDisabled)
+		setLogoutLock(u, false)
+	}
+}
+
+func unixAttribute(u *idm.User, name string) time.Time {
+	if v, ok := u.GetAttributes()[name]; ok {
+		if ts, er := strconv.ParseInt(v, 10, 64); er == nil && ts > 0 {
+			return time.Unix(ts, 0)
+		}
+	}
+	return time.Time{}
+}
+
+func setLogoutLock(u *idm.User, lock bool) {
+	var locks []string
+	if l, ok := u.Attributes["locks"]; ok {
+		_ = json.Unmarshal([]byte(l), &locks)
+	}
+	locks = slices.DeleteFunc(locks, func(s string) bool { return s == lockLogout })
+	if lock {
+		locks = append(locks, lockLogout)
+	}
+	if len(locks) == 0 {
+		delete(u.Attributes, "locks")
+		return
+	}
+	data, _ := json.Marshal(locks)
+	u.Attributes["locks"] = string(data)
+}
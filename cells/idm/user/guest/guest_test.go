@@ -0,0 +1,125 @@
+/*
+ * Copyright (c) 2025 Abstrium SAS <team (at) pydio.com>
+ * This file is part of Pydio Cells.
+ *
+ * Pydio Cells is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * Pydio Cells is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with Pydio Cells.  If not, see <http://www.gnu.org/licenses/>.
+ *
+ * The latest code can be found at <https://pydio.com>.
+ */
+
+package guest
+
+import (
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/pydio/cells/v5/common"
+	"github.com/pydio/cells/v5/common/proto/idm"
+
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func newGuest(login string) *idm.User {
+	return &idm.User{Login: login, Attributes: map[string]string{idm.UserAttrProfile: common.PydioProfileShared}}
+}
+
+func TestStamp(t *testing.T) {
+
+	Convey("Test stamping new users", t, func() {
+		now := time.Now()
+		p := &Policy{Lifetime: 90 * 24 * time.Hour, Reminder: DefaultReminder, DeleteAfter: DefaultDeleteAfter}
+
+		u := newGuest("guest")
+		u.Attributes[idm.UserAttrGuestExpiration] = "1"
+		u.Attributes[idm.UserAttrGuestDisabled] = "1"
+		p.Stamp(u, "alice", now)
+		So(Sponsor(u), ShouldEqual, "alice")
+		So(Expiration(u).Unix(), ShouldEqual, now.Add(p.Lifetime).Unix())
+		So(u.Attributes, ShouldNotContainKey, idm.UserAttrGuestDisabled)
+
+		hidden := newGuest("link")
+		hidden.Attributes[idm.UserAttrHidden] = "true"
+		p.Stamp(hidden, "alice", now)
+		So(Sponsor(hidden), ShouldEqual, "alice")
+		So(Expiration(hidden).IsZero(), ShouldBeTrue)
+
+		std := &idm.User{Login: "bob", Attributes: map[string]string{idm.UserAttrProfile: common.PydioProfileStandard, idm.UserAttrSponsor: "eve"}}
+		p.Stamp(std, "alice", now)
+		So(std.Attributes, ShouldNotContainKey, idm.UserAttrSponsor)
+		So(std.Attributes, ShouldNotContainKey, idm.UserAttrGuestExpiration)
+
+		disabled := &Policy{}
+		u = newGuest("guest")
+		disabled.Stamp(u, "alice", now)
+		So(Sponsor(u), ShouldEqual, "alice")
+		So(Expiration(u).IsZero(), ShouldBeTrue)
+	})
+
+}
+
+func TestLifecycle(t *testing.T) {
+
+	Convey("Test lifecycle stages", t, func() {
+		now := time.Now()
+		day := 24 * time.Hour
+		p := &Policy{Lifetime: 90 * day, Reminder: 7 * day, DeleteAfter: 30 * day}
+		at := func(u *idm.User, exp time.Time) *idm.User {
+			u.Attributes[idm.UserAttrGuestExpiration] = strconv.FormatInt(exp.Unix(), 10)
+			return u
+		}
+
+		u := newGuest("guest")
+		u.Attributes[idm.UserAttrSponsor] = "alice"
+		So(p.Next(u, now), ShouldEqual, StageInit)
+		So((&Policy{}).Next(u, now), ShouldEqual, StageNone)
+
+		at(u, now.Add(30*day))
+		So(p.Next(u, now), ShouldEqual, StageNone)
+
+		at(u, now.Add(3*day))
+		So(p.Next(u, now), ShouldEqual, StageRemind)
+		u.Attributes[idm.UserAttrGuestReminded] = u.Attributes[idm.UserAttrGuestExpiration]
+		So(p.Next(u, now), ShouldEqual, StageNone)
+
+		at(u, now.Add(-day))
+		So(p.Next(u, now), ShouldEqual, StageDisable)
+		u.Attributes[idm.UserAttrGuestDisabled] = strconv.FormatInt(now.Unix(), 10)
+		setLogoutLock(u, true)
+		So(p.Next(u, now), ShouldEqual, StageNone)
+
+		at(u, now.Add(-31*day))
+		So(p.Next(u, now), ShouldEqual, StageDelete)
+
+		orphan := at(newGuest("orphan"), now.Add(3*day))
+		So(p.Next(orphan, now), ShouldEqual, StageNone)
+	})
+
+	Convey("Test extending a disabled guest", t, func() {
+		now := time.Now()
+		u := newGuest("guest")
+		u.Attributes["locks"] = `["pass_change"]`
+		setLogoutLock(u, true)
+		u.Attributes[idm.UserAttrGuestDisabled] = strconv.FormatInt(now.Unix(), 10)
+		u.Attributes[idm.UserAttrGuestReminded] = "1"
+		So(u.Attributes["locks"], ShouldEqual, `["pass_change","logout"]`)
+
+		Extend(u, now.Add(24*time.Hour))
+		So(Expiration(u).Unix(), ShouldEqual, now.Add(24*time.Hour).Unix())
+		So(u.Attributes["locks"], ShouldEqual, `["pass_change"]`)
+		So(u.Attributes, ShouldNotContainKey, idm.UserAttrGuestDisabled)
+		So(u.Attributes, ShouldNotContainKey, idm.UserAttrGuestReminded)
+	})
+
+}
@@ -0,0 +1,243 @@
+/*
+ * Copyright (c) 2025 Abstrium SAS <team (at) pydio.com>
+ * This file is part of Pydio Cells.
+ *
+ * Pydio Cells is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * Pydio Cells is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with Pydio Cells.  If not, see <http://www.gnu.org/licenses/>.
+ *
+ * The latest code can be found at <https://pydio.com>.
+ */
+
+package guest
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	"go.uber.org/zap"
+	"google.golang.org/protobuf/types/known/anypb"
+
+	"github.com/pydio/cells/v5/common"
+	"github.com/pydio/cells/v5/common/client/commons"
+	"github.com/pydio/cells/v5/common/client/commons/idmc"
+	"github.com/pydio/cells/v5/common/client/commons/jobsc"
+	"github.com/pydio/cells/v5/common/client/grpc"
+	"github.com/pydio/cells/v5/common/errors"
+	"github.com/pydio/cells/v5/common/permissions"
+	"github.com/pydio/cells/v5/common/proto/idm"
+	"github.com/pydio/cells/v5/common/proto/jobs"
+	"github.com/pydio/cells/v5/common/proto/mailer"
+	"github.com/pydio/cells/v5/common/proto/service"
+	"github.com/pydio/cells/v5/common/telemetry/log"
+	"github.com/pydio/cells/v5/common/utils/i18n/languages"
+)
+
+// Report counts the operations applied by a lifecycle run.
+type Report struct {
+	Initialized int
+	Reminded    int
+	Disabled    int
+	Deleted     int
+	Transferred int
+}
+
+// Process applies the lifecycle policy to all guests.
+func Process(ctx context.Context, p *Policy, now time.Time) (*Report, error) {
+	r := &Report{}
+	if !p.Enabled() {
+		return r, nil
+	}
+	guests, er := search(ctx, &idm.UserSingleQuery{AttributeName: idm.UserAttrProfile, AttributeValue: common.PydioProfileShared})
+	if er != nil {
+		return nil, er
+	}
+	sponsors := map[string]*idm.User{}
+	left := map[string]bool{}
+	loadSponsor := func(login string) *idm.User {
+		if _, known := sponsors[login]; !known {
+			u, er := permissions.SearchUniqueUser(ctx, login, "")
+			sponsors[login] = u
+			left[login] = er != nil && errors.Is(er, errors.UserNotFound)
+		}
+		return sponsors[login]
+	}
+	for _, u := range guests {
+		if !IsGuest(u) {
+			continue
+		}
+		if s := Sponsor(u); s != "" && loadSponsor(s) == nil && left[s] {
+			// Sponsor has left, hand the guest over
+			if transferOne(ctx, u, s, p.FallbackSponsor) == nil {
+				r.Transferred++
+			}
+		}
+		var err error
+		switch p.Next(u, now) {
+		case StageInit:
+			Extend(u, now.Add(p.Lifetime))
+			if err = save(ctx, u); err == nil {
+				r.Initialized++
+			}
+		case StageRemind:
+			if sp := loadSponsor(Sponsor(u)); sp != nil {
+				remind(ctx, sp, u)
+			}
+			u.Attributes[idm.UserAttrGuestReminded] = u.Attributes[idm.UserAttrGuestExpiration]
+			if err = save(ctx, u); err == nil {
+				r.Reminded++
+			}
+		case StageDisable:
+			setLogoutLock(u, true)
+			u.Attributes[idm.UserAttrGuestDisabled] = strconv.FormatInt(now.Unix(), 10)
+			if err = save(ctx, u); err == nil {
+				log.Auditer(ctx).Info(
+					fmt.Sprintf("Disabled guest [%s] after expiration", u.GetLogin()),
+					log.GetAuditId(common.AuditGuestDisable),
+					u.ZapLogin(),
+					zap.String(common.KeyUserUuid, u.GetUuid()),
+				)
+				r.Disabled++
+			}
+		case StageDelete:
+			if err = scheduleDeletion(ctx, u); err == nil {
+				r.Deleted++
+			}
+		}
+		if err != nil {
+			log.Logger(ctx).Error("Cannot apply lifecycle to guest "+u.GetLogin(), zap.Error(err))
+		}
+	}
+	return r, nil
+}
+
+// Transfer hands over all the users sponsored by from to another sponsor. If to is empty, users are left without sponsor.
+func Transfer(ctx context.Context, from, to string) (int, error) {
+	if from == "" {
+		return 0, errors.WithMessage(errors.InvalidParameters, "please provide the current sponsor")
+	}
+	if to != "" {
+		if _, er := permissions.SearchUniqueUser(ctx, to, ""); er != nil {
+			return 0, er
+		}
+	}
+	sponsored, er := search(ctx, &idm.UserSingleQuery{AttributeName: idm.UserAttrSponsor, AttributeValue: from})
+	if er != nil {
+		return 0, er
+	}
+	var count int
+	for _, u := range sponsored {
+		if transferOne(ctx, u, from, to) == nil {
+			count++
+		}
+	}
+	return count, nil
+}
+
+func transferOne(ctx context.Context, u *idm.User, from, to string) error {
+	if to == "" || to == u.GetLogin() {
+		delete(u.Attributes, idm.UserAttrSponsor)
+	} else {
+		u.Attributes[idm.UserAttrSponsor] = to
+	}
+	// New sponsor must be reminded as well
+	delete(u.Attributes, idm.UserAttrGuestReminded)
+	if er := save(ctx, u); er != nil {
+		log.Logger(ctx).Error("Cannot transfer sponsorship of "+u.GetLogin(), zap.Error(er))
+		return er
+	}
+	log.Auditer(ctx).Info(
+		fmt.Sprintf("Transferred sponsorship of [%s] from [%s] to [%s]", u.GetLogin(), from, to),
+		log.GetAuditId(common.AuditGuestTransfer),
+		u.ZapLogin(),
+		zap.String(common.KeyUserUuid, u.GetUuid()),
+	)
+	return nil
+}
+
+// scheduleDeletion runs the users deletion action in background, which in turn triggers the user data clean up.
+func scheduleDeletion(ctx context.Context, u *idm.User) error {
+	job := &jobs.Job{
+		ID:             "delete-guest-" + u.GetUuid(),
+		Owner:          common.PydioSystemUsername,
+		Label:          "Delete expired guest " + u.GetLogin(),
+		MaxConcurrency: 1,
+		AutoStart:      true,
+		AutoClean:      true,
+		Actions: []*jobs.Action{
+			{
+				ID: "actions.idm.users.delete",
+				Parameters: map[string]string{
+					"login": u.GetLogin(),
+				},
+			},
+		},
+	}
+	if _, er := jobsc.JobServiceClient(ctx).PutJob(ctx, &jobs.PutJobRequest{Job: job}); er != nil {
+		return er
+	}
+	log.Auditer(ctx).Info(
+		fmt.Sprintf("Deleting guest [%s] expired since %s", u.GetLogin(), Expiration(u).Format("2006-01-02")),
+		log.GetAuditId(common.AuditUserDelete),
+		u.ZapLogin(),
+		zap.String(common.KeyUserUuid, u.GetUuid()),
+	)
+	return nil
+}
+
+func remind(ctx context.Context, sponsor, u *idm.User) {
+	if sponsor.GetAttributes()[idm.UserAttrEmail] == "" {
+		return
+	}
+	name := u.GetAttributes()[idm.UserAttrDisplayName]
+	if name == "" {
+		name = u.GetLogin()
+	}
+	if _, e := mailer.NewMailerServiceClient(grpc.ResolveConn(ctx, common.ServiceMailerGRPC)).SendMail(ctx, &mailer.SendMailRequest{
+		Mail: &mailer.Mail{
+			To: []*mailer.User{{
+				Uuid:     sponsor.GetUuid(),
+				Name:     sponsor.GetAttributes()[idm.UserAttrDisplayName],
+				Address:  sponsor.GetAttributes()[idm.UserAttrEmail],
+				Language: languages.UserLanguage(ctx, sponsor),
+			}},
+			TemplateId: "GuestExpiration",
+			TemplateData: map[string]string{
+				"Guest":   name,
+				"Login":   u.GetLogin(),
+				"Expires": Expiration(u).Format("2006-01-02"),
+			},
+		},
+	}); e != nil {
+		log.Logger(ctx).Error("Cannot send guest expiration reminder to "+sponsor.GetLogin(), zap.Error(e))
+	}
+}
+
+func search(ctx context.Context, q *idm.UserSingleQuery) (uu []*idm.User, er error) {
+	sq, _ := anypb.New(q)
+	st, e := idmc.UserServiceClient(ctx).SearchUser(ctx, &idm.SearchUserRequest{Query: &service.Query{SubQueries: []*anypb.Any{sq}}})
+	er = commons.ForEach(st, e, func(resp *idm.SearchUserResponse) error {
+		uu = append(uu, resp.GetUser())
+		return nil
+	})
+	return
+}
+
+func save(ctx context.Context, u *idm.User) error {
+	if _, er := idmc.UserServiceClient(ctx).CreateUser(ctx, &idm.CreateUserRequest{User: u}); er != nil {
+		return er
+	}
+	permissions.ForceClearUserCache(ctx, u.GetLogin())
+	return nil
+}
@@ -0,0 +1,196 @@
+/*
+ * Copyright (c) 2025 Abstrium SAS <team (at) pydio.com>
+ * This file is part of Pydio Cells.
+ *
+ * Pydio Cells is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * Pydio Cells is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with Pydio Cells.  If not, see <http://www.gnu.org/licenses/>.
+ *
+ * The latest code can be found at <https://pydio.com>.
+ */
+
+// Package quota warns users and reports groups whose storage usage reaches soft limits, expressed as
+// percentages of the quotas applied to them.
+package quota
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+
+	humanize "github.com/dustin/go-humanize"
+	"go.uber.org/zap"
+	"google.golang.org/protobuf/types/known/anypb"
+
+	"github.com/pydio/cells/v5/common"
+	"github.com/pydio/cells/v5/common/client/commons"
+	"github.com/pydio/cells/v5/common/client/commons/idmc"
+	"github.com/pydio/cells/v5/common/client/grpc"
+	"github.com/pydio/cells/v5/common/config"
+	"github.com/pydio/cells/v5/common/nodes/acl"
+	"github.com/pydio/cells/v5/common/permissions"
+	"github.com/pydio/cells/v5/common/proto/idm"
+	"github.com/pydio/cells/v5/common/proto/mailer"
+	"github.com/pydio/cells/v5/common/proto/service"
+	"github.com/pydio/cells/v5/common/telemetry/log"
+	"github.com/pydio/cells/v5/common/utils/i18n/languages"
+)
+
+// DefaultThresholds are used when no thresholds are configured.
+const DefaultThresholds = "80,95"
+
+// Report counts the warnings sent by a run.
+type Report struct {
+	Checked int
+	Warned  int
+	Groups  int
+}
+
+// LoadThresholds reads the warning thresholds from the uploaders configuration.
+func LoadThresholds(ctx context.Context) []int64 {
+	return ParseThresholds(config.Get(ctx, config.FrontendPluginPath("core.uploader", "QUOTA_WARNING_THRESHOLDS")...).Default(DefaultThresholds).String())
+}
+
+// ParseThresholds parses a comma-separated list of percentages, ignoring invalid values.
+func ParseThresholds(s string) (tt []int64) {
+	for _, p := range strings.Split(s, ",") {
+		v, er := strconv.ParseInt(strings.TrimSpace(strings.TrimSuffix(strings.TrimSpace(p), "%")), 10, 64)
+		if er != nil || v <= 0 || slices.Contains(tt, v) {
+			continue
+		}
+		tt = append(tt, v)
+	}
+	slices.Sort(tt)
+	return
+}
+
+// Level returns the highest threshold reached by a usage percentage, or 0.
+func Level(ratio int64, thresholds []int64) (level int64) {
+	for _, t := range thresholds {
+		if ratio >= t {
+			level = t
+		}
+	}
+	return
+}
+
+// Process computes the usage of all users and groups carrying quotas, and sends a warning each time a new
+// threshold is reached. The level reached is stored on the user or group, so that it is warned only once.
+func Process(ctx context.Context, thresholds []int64) (*Report, error) {
+	r := &Report{}
+	if len(thresholds) == 0 {
+		return r, nil
+	}
+	q, _ := anypb.New(&idm.UserSingleQuery{GroupPath: "/", Recursive: true})
+	st, e := idmc.UserServiceClient(ctx).SearchUser(ctx, &idm.SearchUserRequest{Query: &service.Query{SubQueries: []*anypb.Any{q}}})
+	var uu []*idm.User
+	if er := commons.ForEach(st, e, func(resp *idm.SearchUserResponse) error {
+		uu = append(uu, resp.GetUser())
+		return nil
+	}); er != nil {
+		return nil, er
+	}
+	usage := acl.NewQuotaUsage(nil)
+	for _, u := range uu {
+		var level int64
+		if u.GetIsGroup() {
+			g, er := usage.ForGroup(ctx, u.GetUuid())
+			if er != nil {
+				log.Logger(ctx).Error("Cannot compute usage of group "+u.GetGroupLabel(), zap.Error(er))
+				continue
+			}
+			if level = Level(g.Ratio(), thresholds); level > warned(u) {
+				log.Auditer(ctx).Warn(
+					fmt.Sprintf("Group [%s] reached %d%% of its quota (%s of %s)", g.Path, level, humanize.Bytes(uint64(g.Usage)), humanize.Bytes(uint64(g.Quota))),
+					log.GetAuditId(common.AuditQuotaWarning),
+					zap.String(common.KeyGroupPath, g.Path),
+				)
+				log.TasksLogger(ctx).Warn(fmt.Sprintf("Group %s reached %d%% of its quota", g.Path, level))
+				r.Groups++
+			}
+			// Groups are saved with their full path
+			u.GroupPath = g.Path
+		} else {
+			uq, er := usage.ForUser(ctx, u)
+			if er != nil {
+				log.Logger(ctx).Error("Cannot compute usage of user "+u.GetLogin(), zap.Error(er))
+				continue
+			}
+			r.Checked++
+			if level = Level(uq.Ratio(), thresholds); level > warned(u) {
+				notify(ctx, uq, level)
+				r.Warned++
+			}
+		}
+		if level != warned(u) {
+			if er := mark(ctx, u, level); er != nil {
+				log.Logger(ctx).Error("Cannot store quota warning level", zap.String(common.KeyUserUuid, u.GetUuid()), zap.Error(er))
+			}
+		}
+	}
+	return r, nil
+}
+
+func warned(u *idm.User) int64 {
+	v, _ := strconv.ParseInt(u.GetAttributes()[idm.UserAttrQuotaWarned], 10, 64)
+	return v
+}
+
+func mark(ctx context.Context, u *idm.User, level int64) error {
+	if u.Attributes == nil {
+		u.Attributes = map[string]string{}
+	}
+	if level > 0 {
+		u.Attributes[idm.UserAttrQuotaWarned] = strconv.FormatInt(level, 10)
+	} else {
+		delete(u.Attributes, idm.UserAttrQuotaWarned)
+	}
+	if _, er := idmc.UserServiceClient(ctx).CreateUser(ctx, &idm.CreateUserRequest{User: u}); er != nil {
+		return er
+	}
+	if !u.GetIsGroup() {
+		permissions.ForceClearUserCache(ctx, u.GetLogin())
+	}
+	return nil
+}
+
+func notify(ctx context.Context, uq *acl.UserQuota, level int64) {
+	u := uq.User
+	if u.GetAttributes()[idm.UserAttrEmail] == "" {
+		return
+	}
+	quota, used, scope := uq.Total, uq.TotalUsage, "files"
+	if uq.Personal > 0 && (uq.Total == 0 || uq.PersonalUsage*uq.Total > uq.TotalUsage*uq.Personal) {
+		quota, used, scope = uq.Personal, uq.PersonalUsage, "personal files"
+	}
+	if _, e := mailer.NewMailerServiceClient(grpc.ResolveConn(ctx, common.ServiceMailerGRPC)).SendMail(ctx, &mailer.SendMailRequest{
+		Mail: &mailer.Mail{
+			To: []*mailer.User{{
+				Uuid:     u.GetUuid(),
+				Name:     u.GetAttributes()[idm.UserAttrDisplayName],
+				Address:  u.GetAttributes()[idm.UserAttrEmail],
+				Language: languages.UserLanguage(ctx, u),
+			}},
+			TemplateId: "QuotaWarning",
+			TemplateData: map[string]string{
+				"Percent": strconv.FormatInt(level, 10),
+				"Scope":   scope,
+				"Usage":   humanize.Bytes(uint64(used)),
+				"Quota":   humanize.Bytes(uint64(quota)),
+			},
+		},
+	}); e != nil {
+		log.Logger(ctx).Error("Cannot send quota warning email to "+u.GetLogin(), zap.Error(e))
+	}
+}
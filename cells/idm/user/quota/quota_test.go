@@ -0,0 +1,46 @@
+/*
+ * Copyright (c) 2025 Abstrium SAS <team (at) pydio.com>
+ * This file is part of Pydio Cells.
+ *
+ * Pydio Cells is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * Pydio Cells is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with Pydio Cells.  If not, see <http://www.gnu.org/licenses/>.
+ *
+ * The latest code can be found at <https://pydio.com>.
+ */
+
+package quota
+
+import (
+	"testing"
+
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestThresholds(t *testing.T) {
+
+	Convey("Test parsing thresholds", t, func() {
+		So(ParseThresholds(DefaultThresholds), ShouldResemble, []int64{80, 95})
+		So(ParseThresholds(" 95%, 50 ,80,foo,-1,80"), ShouldResemble, []int64{50, 80, 95})
+		So(ParseThresholds(""), ShouldBeEmpty)
+	})
+
+	Convey("Test reached level", t, func() {
+		tt := []int64{80, 95}
+		So(Level(0, tt), ShouldEqual, 0)
+		So(Level(79, tt), ShouldEqual, 0)
+		So(Level(80, tt), ShouldEqual, 80)
+		So(Level(96, tt), ShouldEqual, 95)
+		So(Level(150, tt), ShouldEqual, 95)
+		So(Level(150, nil), ShouldEqual, 0)
+	})
+}
@@ -24,7 +24,9 @@ import (
 	"context"
 	"fmt"
 	"io"
+	"net/http"
 	"strings"
+	"time"
 
 	restful "github.com/emicklei/go-restful/v3"
 	"go.uber.org/zap"
@@ -39,20 +41,29 @@ import (
 	grpc2 "github.com/pydio/cells/v5/common/client/grpc"
 	"github.com/pydio/cells/v5/common/config"
 	"github.com/pydio/cells/v5/common/errors"
+	"github.com/pydio/cells/v5/common/nodes"
+	"github.com/pydio/cells/v5/common/nodes/acl"
+	"github.com/pydio/cells/v5/common/nodes/compose"
+	"github.com/pydio/cells/v5/common/nodes/models"
 	"github.com/pydio/cells/v5/common/permissions"
+	"github.com/pydio/cells/v5/common/proto/activity"
 	"github.com/pydio/cells/v5/common/proto/front"
 	"github.com/pydio/cells/v5/common/proto/idm"
 	"github.com/pydio/cells/v5/common/proto/jobs"
 	"github.com/pydio/cells/v5/common/proto/mailer"
 	"github.com/pydio/cells/v5/common/proto/rest"
 	service2 "github.com/pydio/cells/v5/common/proto/service"
+	"github.com/pydio/cells/v5/common/proto/tree"
 	"github.com/pydio/cells/v5/common/service/resources"
 	"github.com/pydio/cells/v5/common/telemetry/log"
 	"github.com/pydio/cells/v5/common/utils/cache"
 	cache_helper "github.com/pydio/cells/v5/common/utils/cache/helper"
 	json "github.com/pydio/cells/v5/common/utils/jsonx"
 	"github.com/pydio/cells/v5/common/utils/uuid"
+	"github.com/pydio/cells/v5/idm/user/delegation"
+	"github.com/pydio/cells/v5/idm/user/export"
 	"github.com/pydio/cells/v5/idm/user/grpc"
+	"github.com/pydio/cells/v5/idm/user/guest"
 )
 
 var profilesLevel = map[string]int{
@@ -356,6 +367,13 @@ func (s *UserHandler) PutUser(req *restful.Request, rsp *restful.Response) error
 	if !ok {
 		return errors.WithStack(errors.MissingClaims)
 	}
+	// Delegations granted to current user on the target subtree
+	granted, er := delegation.ForContext(ctx)
+	if er != nil {
+		return er
+	}
+	target := delegation.FullPath(&inputUser)
+	delegated := granted.ForPath(target, inputUser.IsGroup)
 	if update != nil {
 		// Check User Policies
 		if !s.MatchPolicies(ctx, update.Uuid, update.Policies, service2.ResourcePolicyAction_WRITE) {
@@ -372,7 +390,7 @@ func (s *UserHandler) PutUser(req *restful.Request, rsp *restful.Response) error
 		removes := s.diffRoles(update.Roles, inputUser.Roles)
 		log.Logger(ctx).Debug("ADD/REMOVE ROLES", log.DangerouslyZapSmallSlice("add", rolesToCheck), log.DangerouslyZapSmallSlice("remove", removes), log.DangerouslyZapSmallSlice("new", inputUser.Roles), log.DangerouslyZapSmallSlice("existings", update.Roles))
 		rolesToCheck = append(rolesToCheck, removes...)
-		if err := s.checkCanAssignRoles(ctx, rolesToCheck, roleCli); err != nil {
+		if err := s.checkCanAssignRoles(ctx, rolesToCheck, roleCli, granted.ForPath(delegation.FullPath(update), update.IsGroup)); err != nil {
 			log.Auditer(ctx).Error(
 				fmt.Sprintf("Forbidden action: could not assign roles on [%s]", update.GetLogin()),
 				log.GetAuditId(common.AuditUserUpdate),
@@ -380,6 +398,21 @@ func (s *UserHandler) PutUser(req *restful.Request, rsp *restful.Response) error
 			)
 			return errors.Tag(err, errors.StatusForbidden)
 		}
+		if len(granted) > 0 {
+			// Delegated admins cannot alter policies, nor move users outside of their subtrees
+			inputUser.Policies = update.Policies
+			if target != delegation.FullPath(update) && len(delegated) == 0 {
+				return errors.WithAPICode(errors.StatusForbidden, errors.ApiUserNotEditable)
+			}
+			// They never manage admins, nor raise the profile of the users they manage
+			if delegation.Protected(update) {
+				return errors.WithAPICode(errors.StatusForbidden, errors.ApiUserNotEditable)
+			}
+			current := update.GetAttributes()[idm.UserAttrProfile]
+			if p := inputUser.GetAttributes()[idm.UserAttrProfile]; !inputUser.IsGroup && p != "" && profilesLevel[p] > profilesLevel[current] {
+				return errors.WithAPICode(errors.StatusForbidden, errors.ApiUserCannotIncreaseProfile, "profile", p, "own", current)
+			}
+		}
 		// Check user own password change
 		if inputUser.Password != "" && ctxClaims.Name == inputUser.Login {
 			if _, err := cli.BindUser(ctx, &idm.BindUserRequest{UserName: inputUser.Login, Password: inputUser.OldPassword}); err != nil {
@@ -410,7 +443,25 @@ func (s *UserHandler) PutUser(req *restful.Request, rsp *restful.Response) error
 		}
 	}
 
-	if update == nil && ctxClaims.Profile != common.PydioProfileAdmin {
+	if update != nil && inputUser.Attributes != nil && inputUser.Attributes[idm.UserAttrEmail] != update.GetAttributes()[idm.UserAttrEmail] {
+		// Email address was changed, clear any previous bounce flag
+		delete(inputUser.Attributes, idm.UserAttrEmailBounce)
+	}
+
+	if update == nil && ctxClaims.Profile != common.PydioProfileAdmin && len(delegated) > 0 {
+		// Delegated admins may only assign the roles allowed by their delegations
+		for _, r := range inputUser.Roles {
+			if !r.UserRole && !r.GroupRole && !delegated.CanAssign(r.Uuid) {
+				return errors.WithStack(errors.RoleNotAssignable)
+			}
+		}
+		if strings.Trim(inputUser.GroupPath, "/") != "" {
+			if _, ok := permissions.GroupExists(ctx, inputUser.GroupPath); !ok {
+				return errors.WithAPICode(errors.StatusForbidden, errors.ApiGroupCannotCreate)
+			}
+		}
+		inputUser.Policies = delegation.DefaultPolicies(inputUser.IsGroup)
+	} else if update == nil && ctxClaims.Profile != common.PydioProfileAdmin {
 		// Clear roles
 		inputUser.Roles = nil
 		// Check that parent group exists, or it will be created automatically (ok for admin, nok for others)
@@ -446,7 +497,7 @@ func (s *UserHandler) PutUser(req *restful.Request, rsp *restful.Response) error
 	}
 
 	if inputUser.IsGroup {
-		if ctxClaims.Profile != common.PydioProfileAdmin {
+		if ctxClaims.Profile != common.PydioProfileAdmin && len(delegated) == 0 {
 			return errors.WithAPICode(errors.StatusForbidden, errors.ApiGroupCannotCreate)
 		}
 		inputUser.GroupPath = strings.TrimSuffix(inputUser.GroupPath, "/") + "/" + inputUser.GroupLabel
@@ -458,7 +509,7 @@ func (s *UserHandler) PutUser(req *restful.Request, rsp *restful.Response) error
 		// Std users can only create shared ones, except for delegated admins
 		// Double-check authorized access to /acl endpoint - if allowed, it's a delegated admin.
 		if ctxClaims.Profile == common.PydioProfileStandard && inputUser.Attributes[idm.UserAttrProfile] != common.PydioProfileShared &&
-			ctxClaims.Name != inputUser.Login && !allowedUserSpecialPermissions(ctx, ctxClaims) {
+			ctxClaims.Name != inputUser.Login && len(delegated) == 0 && !allowedUserSpecialPermissions(ctx, ctxClaims) {
 			return errors.WithAPICode(errors.StatusForbidden, errors.ApiUserCannotCreateProfile)
 		}
 		// Generic check - profile is never higher than current user profile
@@ -468,6 +519,15 @@ func (s *UserHandler) PutUser(req *restful.Request, rsp *restful.Response) error
 		if _, ok := inputUser.Attributes[idm.UserAttrPassHashed]; ok {
 			return errors.WithMessage(errors.StatusForbidden, "You are not allowed to use this attribute")
 		}
+		if value, ok := inputUser.Attributes[idm.UserAttrNotifications]; ok {
+			if _, er := activity.ParseNotificationPreferences(value); er != nil {
+				return errors.Tag(er, errors.InvalidParameters)
+			}
+		}
+		// External users are sponsored by their creator and expire
+		if update == nil {
+			guest.LoadPolicy(ctx).Stamp(&inputUser, ctxClaims.Name, time.Now())
+		}
 	}
 
 	var acls []*idm.ACL
@@ -506,12 +566,38 @@ func (s *UserHandler) PutUser(req *restful.Request, rsp *restful.Response) error
 	}
 	inputUser.Attributes = cleanAttributes
 
+	// Maintain delegation policies on created or moved users and groups
+	var all, covering delegation.Delegations
+	moved := update != nil && target != delegation.FullPath(update)
+	if update == nil || moved {
+		if all, er = delegation.List(ctx); er != nil {
+			return er
+		}
+	}
+	if len(all) > 0 {
+		covering = all.ForPath(target, inputUser.IsGroup)
+		if len(inputUser.Policies) == 0 {
+			inputUser.Policies = delegation.DefaultPolicies(inputUser.IsGroup)
+		}
+		inputUser.Policies = all.Rewrite(inputUser.Policies, covering)
+	}
+
 	response, er := cli.CreateUser(ctx, &idm.CreateUserRequest{
 		User: &inputUser,
 	})
 	if er != nil {
 		return er
 	}
+	if moved && len(all) > 0 {
+		if er := delegation.ApplyRoles(ctx, all, map[string]delegation.Delegations{response.User.Uuid: covering}); er != nil {
+			return er
+		}
+		if inputUser.IsGroup {
+			if er := delegation.Apply(ctx, all, response.User.GroupPath); er != nil {
+				return er
+			}
+		}
+	}
 
 	if update == nil {
 		var newRole *idm.Role
@@ -521,6 +607,9 @@ func (s *UserHandler) PutUser(req *restful.Request, rsp *restful.Response) error
 				GroupRole: true,
 				Label:     "Group " + response.User.GroupLabel,
 			}
+			if len(covering) > 0 {
+				newRole.Policies = all.Rewrite(delegation.DefaultPolicies(true), covering)
+			}
 		} else {
 			newRole = &idm.Role{
 				Uuid:     response.User.Uuid,
@@ -532,6 +621,7 @@ func (s *UserHandler) PutUser(req *restful.Request, rsp *restful.Response) error
 					{Subject: permissions.PolicySubjectProfilePrefix + common.PydioProfileAdmin, Action: service2.ResourcePolicyAction_WRITE, Effect: service2.ResourcePolicy_allow},
 				},
 			}
+			newRole.Policies = all.Rewrite(newRole.Policies, covering)
 		}
 		roleCli := idmc.RoleServiceClient(ctx)
 		if _, er := roleCli.CreateRole(ctx, &idm.CreateRoleRequest{Role: newRole}); er != nil {
@@ -697,7 +787,14 @@ func (s *UserHandler) PutRoles(req *restful.Request, rsp *restful.Response) erro
 	removes := s.diffRoles(update.Roles, inputUser.Roles)
 	log.Logger(ctx).Debug("ADD/REMOVE ROLES", log.DangerouslyZapSmallSlice("add", rolesToCheck), log.DangerouslyZapSmallSlice("remove", removes), log.DangerouslyZapSmallSlice("new", inputUser.Roles), log.DangerouslyZapSmallSlice("existings", update.Roles))
 	rolesToCheck = append(rolesToCheck, removes...)
-	if err := s.checkCanAssignRoles(ctx, rolesToCheck, roleCli); err != nil {
+	granted, er := delegation.ForContext(ctx)
+	if er != nil {
+		return er
+	}
+	if len(granted) > 0 && delegation.Protected(update) {
+		return errors.WithAPICode(errors.StatusForbidden, errors.ApiUserNotEditable)
+	}
+	if err := s.checkCanAssignRoles(ctx, rolesToCheck, roleCli, granted.ForPath(delegation.FullPath(update), update.IsGroup)); err != nil {
 		log.Auditer(ctx).Error(
 			fmt.Sprintf("Forbidden action: could not assign roles to user [%s]", update.GetLogin()),
 			log.GetAuditId(common.AuditUserUpdate),
@@ -729,6 +826,119 @@ func (s *UserHandler) PutRoles(req *restful.Request, rsp *restful.Response) erro
 	return we
 }
 
+// DownloadDataExport streams a personal data archive, answering to rest endpoint GET:/a/user/export/{Token}.
+// It does not require authentication, the token being sent to the archive owner only.
+func (s *UserHandler) DownloadDataExport(req *restful.Request, rsp *restful.Response) error {
+
+	ctx := req.Request.Context()
+	link, er := export.GetLink(ctx, req.PathParameter("Token"))
+	if er != nil {
+		return er
+	}
+	if link.Expired(time.Now()) {
+		return errors.WithMessage(errors.StatusNotFound, "invalid or expired link")
+	}
+	router := compose.PathClient(nodes.AsAdmin())
+	read, er := router.GetClientsPool(ctx).GetTreeClient().ReadNode(ctx, &tree.ReadNodeRequest{Node: &tree.Node{Uuid: link.NodeUuid}})
+	if er != nil {
+		return errors.WithMessage(errors.StatusNotFound, "archive is not available anymore")
+	}
+	node := read.GetNode()
+	reader, er := router.GetObject(ctx, node, &models.GetRequestData{StartOffset: 0, Length: -1})
+	if er != nil {
+		return er
+	}
+	defer reader.Close()
+
+	log.Auditer(ctx).Info(
+		fmt.Sprintf("Personal data archive [%s] of user [%s] downloaded", link.Name, link.Owner),
+		log.GetAuditId(common.AuditDataDownload),
+		zap.String(common.KeyUsername, link.Owner),
+		node.ZapUuid(),
+	)
+	rsp.Header().Set("Content-Type", "application/zip")
+	rsp.Header().Set("Content-Disposition", "attachment; filename=\""+link.Name+"\"")
+	if node.GetSize() > 0 {
+		rsp.Header().Set("Content-Length", fmt.Sprintf("%d", node.GetSize()))
+	}
+	rsp.WriteHeader(http.StatusOK)
+	_, er = io.Copy(rsp, reader)
+	return er
+}
+
+// QuotaUsageReport computes storage usage and quotas of users and groups below a group, from the folder sizes
+// maintained by the index. It is restricted to admins and to the delegated administrators of the group.
+func (s *UserHandler) QuotaUsageReport(req *restful.Request, rsp *restful.Response) error {
+
+	ctx := req.Request.Context()
+	var input rest.QuotaUsageRequest
+	if err := req.ReadEntity(&input); err != nil {
+		return err
+	}
+	groupPath := "/" + strings.Trim(input.GroupPath, "/")
+	claims, ok := claim.FromContext(ctx)
+	if !ok {
+		return errors.WithStack(errors.MissingClaims)
+	}
+	if claims.Profile != common.PydioProfileAdmin {
+		granted, er := delegation.ForContext(ctx)
+		if er != nil {
+			return er
+		}
+		if len(granted.ForPath(groupPath, false)) == 0 {
+			return errors.WithMessage(errors.StatusForbidden, "quota usage report is restricted to administrators")
+		}
+	}
+
+	q, _ := anypb.New(&idm.UserSingleQuery{GroupPath: groupPath, Recursive: input.Recursive})
+	streamer, err := idmc.UserServiceClient(ctx).SearchUser(ctx, &idm.SearchUserRequest{
+		Query: &service2.Query{SubQueries: []*anypb.Any{q}},
+	})
+	var uu []*idm.User
+	if er := commons.ForEach(streamer, err, func(resp *idm.SearchUserResponse) error {
+		uu = append(uu, resp.GetUser())
+		return nil
+	}); er != nil {
+		return er
+	}
+
+	usage := acl.NewQuotaUsage(nil)
+	out := &rest.QuotaUsageCollection{}
+	for _, u := range uu {
+		if u.IsGroup {
+			g, er := usage.ForGroup(ctx, u.Uuid)
+			if er != nil {
+				return er
+			}
+			out.Usages = append(out.Usages, &rest.QuotaUsage{
+				Uuid:      u.Uuid,
+				Label:     g.Path,
+				GroupPath: u.GroupPath,
+				IsGroup:   true,
+				Usage:     g.Usage,
+				Quota:     g.Quota,
+				Members:   int32(g.Members),
+			})
+			continue
+		}
+		uq, er := usage.ForUser(ctx, u)
+		if er != nil {
+			return er
+		}
+		out.Usages = append(out.Usages, &rest.QuotaUsage{
+			Uuid:          u.Uuid,
+			Label:         u.Login,
+			GroupPath:     u.GroupPath,
+			PersonalUsage: uq.PersonalUsage,
+			PersonalQuota: uq.Personal,
+			Usage:         uq.TotalUsage,
+			Quota:         uq.Total,
+		})
+		out.TotalUsage += uq.TotalUsage
+	}
+	return rsp.WriteEntity(out)
+}
+
 // PoliciesForUserId retrieves policies for a given UserId.
 func (s *UserHandler) PoliciesForUserId(ctx context.Context, resourceId string, resourceClient interface{}) (policies []*service2.ResourcePolicy, e error) {
 
@@ -742,8 +952,8 @@ func (s *UserHandler) PoliciesForUserId(ctx context.Context, resourceId string,
 }
 
 // Load all roles that will be changed and use their Policies to check if they can be
-// assigned in the current context.
-func (s *UserHandler) checkCanAssignRoles(ctx context.Context, roles []*idm.Role, cli idm.RoleServiceClient) error {
+// assigned in the current context, or if one of the delegations covering the target user allows it.
+func (s *UserHandler) checkCanAssignRoles(ctx context.Context, roles []*idm.Role, cli idm.RoleServiceClient, delegated delegation.Delegations) error {
 	if len(roles) == 0 {
 		// Ignore
 		return nil
@@ -766,7 +976,7 @@ func (s *UserHandler) checkCanAssignRoles(ctx context.Context, roles []*idm.Role
 		if rsp == nil {
 			continue
 		}
-		if !s.MatchPolicies(ctx, rsp.Role.Uuid, rsp.Role.Policies, service2.ResourcePolicyAction_WRITE) {
+		if !delegated.CanAssign(rsp.Role.Uuid) && !s.MatchPolicies(ctx, rsp.Role.Uuid, rsp.Role.Policies, service2.ResourcePolicyAction_WRITE) {
 			return errors.WithStack(errors.RoleNotAssignable)
 		}
 	}
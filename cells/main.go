@@ -87,6 +87,8 @@ import (
 	// Broker DAOs
 	_ "github.com/pydio/cells/v5/broker/activity/dao/bolt"
 	_ "github.com/pydio/cells/v5/broker/activity/dao/mongo"
+	_ "github.com/pydio/cells/v5/broker/audit/dao/bolt"
+	_ "github.com/pydio/cells/v5/broker/audit/dao/mongo"
 	_ "github.com/pydio/cells/v5/broker/chat/dao/bolt"
 	_ "github.com/pydio/cells/v5/broker/chat/dao/mongo"
 	_ "github.com/pydio/cells/v5/broker/log/dao/bleve"
@@ -97,7 +99,9 @@ import (
 	// Broker
 	_ "github.com/pydio/cells/v5/broker/activity/grpc/service"
 	_ "github.com/pydio/cells/v5/broker/activity/rest/service"
+	_ "github.com/pydio/cells/v5/broker/audit/grpc/service"
 	_ "github.com/pydio/cells/v5/broker/chat/grpc/service"
+	_ "github.com/pydio/cells/v5/broker/chat/rest/service"
 	_ "github.com/pydio/cells/v5/broker/log/grpc/service"
 	_ "github.com/pydio/cells/v5/broker/log/rest/service"
 	_ "github.com/pydio/cells/v5/broker/mailer/grpc/service"
@@ -126,6 +130,7 @@ import (
 	_ "github.com/pydio/cells/v5/idm/meta/dao/sql"
 	_ "github.com/pydio/cells/v5/idm/oauth/dao/sql"
 	_ "github.com/pydio/cells/v5/idm/policy/dao/sql"
+	_ "github.com/pydio/cells/v5/idm/review/dao/sql"
 	_ "github.com/pydio/cells/v5/idm/role/dao/sql"
 	_ "github.com/pydio/cells/v5/idm/user/dao/sql"
 	_ "github.com/pydio/cells/v5/idm/workspace/dao/sql"
@@ -133,6 +138,7 @@ import (
 	// IDM
 	_ "github.com/pydio/cells/v5/idm/acl/grpc/service"
 	_ "github.com/pydio/cells/v5/idm/acl/rest/service"
+	_ "github.com/pydio/cells/v5/idm/elevation/rest/service"
 	_ "github.com/pydio/cells/v5/idm/graph/rest/service"
 	_ "github.com/pydio/cells/v5/idm/key/grpc/service"
 	_ "github.com/pydio/cells/v5/idm/meta/grpc/service"
@@ -142,6 +148,8 @@ import (
 	_ "github.com/pydio/cells/v5/idm/oauth/web/service"
 	_ "github.com/pydio/cells/v5/idm/policy/grpc/service"
 	_ "github.com/pydio/cells/v5/idm/policy/rest/service"
+	_ "github.com/pydio/cells/v5/idm/review/grpc/service"
+	_ "github.com/pydio/cells/v5/idm/review/rest/service"
 	_ "github.com/pydio/cells/v5/idm/role/grpc/service"
 	_ "github.com/pydio/cells/v5/idm/role/rest/service"
 	_ "github.com/pydio/cells/v5/idm/share/grpc/service"
@@ -0,0 +1,83 @@
+/*
+ * Copyright (c) 2025 Abstrium SAS <team (at) pydio.com>
+ * This file is part of Pydio Cells.
+ *
+ * Pydio Cells is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * Pydio Cells is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with Pydio Cells.  If not, see <http://www.gnu.org/licenses/>.
+ *
+ * The latest code can be found at <https://pydio.com>.
+ */
+
+package idm
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/pydio/cells/v5/common"
+	"github.com/pydio/cells/v5/common/client/grpc"
+	"github.com/pydio/cells/v5/common/forms"
+	"github.com/pydio/cells/v5/common/proto/jobs"
+	"github.com/pydio/cells/v5/common/proto/review"
+	"github.com/pydio/cells/v5/common/telemetry/log"
+	"github.com/pydio/cells/v5/scheduler/actions"
+)
+
+var (
+	accessReviewEscalateName = "actions.idm.access-review-escalate"
+)
+
+// AccessReviewEscalateAction notifies campaigns creators of the access reviews still pending after the deadline.
+type AccessReviewEscalateAction struct{}
+
+// GetDescription returns action description
+func (c *AccessReviewEscalateAction) GetDescription(lang ...string) actions.ActionDescription {
+	return actions.ActionDescription{
+		ID:              accessReviewEscalateName,
+		IsInternal:      true,
+		Label:           "Escalate access reviews",
+		Icon:            "account-check",
+		Category:        actions.ActionCategoryIDM,
+		Description:     "Send an email to access review campaigns creators listing accesses not reviewed before the deadline",
+		SummaryTemplate: "",
+		HasForm:         false,
+	}
+}
+
+// GetParametersForm returns a UX form
+func (c *AccessReviewEscalateAction) GetParametersForm(context.Context) *forms.Form {
+	return nil
+}
+
+// GetName provides unique identifier
+func (c *AccessReviewEscalateAction) GetName() string {
+	return accessReviewEscalateName
+}
+
+// Init passes parameters
+func (c *AccessReviewEscalateAction) Init(ctx context.Context, job *jobs.Job, action *jobs.Action) error {
+	return nil
+}
+
+// Run perform actual action code
+func (c *AccessReviewEscalateAction) Run(ctx context.Context, channels *actions.RunnableChannels, input *jobs.ActionMessage) (*jobs.ActionMessage, error) {
+	cli := review.NewAccessReviewServiceClient(grpc.ResolveConn(ctx, common.ServiceReviewGRPC))
+	resp, er := cli.EscalateOverdue(ctx, &review.EscalateOverdueRequest{})
+	if er != nil {
+		return input.WithError(er), er
+	}
+	if l := len(resp.GetItems()); l > 0 {
+		log.TasksLogger(ctx).Info(fmt.Sprintf("Escalated %d overdue access review(s)", l))
+	}
+	return input, nil
+}
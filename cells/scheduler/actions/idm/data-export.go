@@ -0,0 +1,217 @@
+/*
+ * Copyright (c) 2025 Abstrium SAS <team (at) pydio.com>
+ * This file is part of Pydio Cells.
+ *
+ * Pydio Cells is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * Pydio Cells is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with Pydio Cells.  If not, see <http://www.gnu.org/licenses/>.
+ *
+ * The latest code can be found at <https://pydio.com>.
+ */
+
+package idm
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"path"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/pydio/cells/v5/common"
+	"github.com/pydio/cells/v5/common/forms"
+	"github.com/pydio/cells/v5/common/nodes"
+	"github.com/pydio/cells/v5/common/nodes/compose"
+	"github.com/pydio/cells/v5/common/nodes/models"
+	"github.com/pydio/cells/v5/common/permissions"
+	"github.com/pydio/cells/v5/common/proto/jobs"
+	"github.com/pydio/cells/v5/common/proto/tree"
+	"github.com/pydio/cells/v5/common/telemetry/log"
+	"github.com/pydio/cells/v5/idm/user/export"
+	"github.com/pydio/cells/v5/scheduler/actions"
+	"github.com/pydio/cells/v5/scheduler/actions/tools"
+)
+
+var (
+	dataExportName      = "actions.idm.data-export"
+	dataExportCleanName = "actions.idm.data-exports-clean"
+)
+
+// DataExportAction builds an archive of all personal data of the job owner and sends a download link.
+type DataExportAction struct {
+	tools.ScopedRouterConsumer
+	owner string
+}
+
+// GetDescription returns action description
+func (c *DataExportAction) GetDescription(lang ...string) actions.ActionDescription {
+	return actions.ActionDescription{
+		ID:                dataExportName,
+		IsInternal:        true,
+		Label:             "Personal data export",
+		Icon:              "database-export",
+		Category:          actions.ActionCategoryIDM,
+		Description:       "Build a zip archive of the profile, metadata, activities, comments, shares and personal files of the job owner",
+		OutputDescription: "One single node pointing to the created archive file.",
+		SummaryTemplate:   "",
+		HasForm:           false,
+	}
+}
+
+// GetParametersForm returns a UX form
+func (c *DataExportAction) GetParametersForm(context.Context) *forms.Form {
+	return nil
+}
+
+// GetName provides unique identifier
+func (c *DataExportAction) GetName() string {
+	return dataExportName
+}
+
+// Init passes parameters
+func (c *DataExportAction) Init(ctx context.Context, job *jobs.Job, action *jobs.Action) error {
+	c.owner = job.Owner
+	c.ParseScope(job.Owner, action.Parameters)
+	return nil
+}
+
+// Run perform actual action code
+func (c *DataExportAction) Run(ctx context.Context, channels *actions.RunnableChannels, input *jobs.ActionMessage) (*jobs.ActionMessage, error) {
+	if c.owner == common.PydioSystemUsername {
+		er := fmt.Errorf("data export must be run by a user")
+		return input.WithError(er), er
+	}
+	u, er := permissions.SearchUniqueUser(ctx, c.owner, "")
+	if er != nil {
+		return input.WithError(er), er
+	}
+	ctx, handler, er := c.GetHandler(ctx)
+	if er != nil {
+		return input.WithError(er), er
+	}
+	now := time.Now()
+	exporter := &export.Exporter{
+		Sources:   export.DefaultSources(),
+		Router:    handler,
+		FilesRoot: export.PersonalFiles,
+	}
+	targetFile := path.Join(export.PersonalFiles, export.ArchiveName(now))
+
+	reader, writer := io.Pipe()
+	var manifest *export.Manifest
+	var writeErr error
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		manifest, writeErr = exporter.Write(ctx, writer, u, now)
+		_ = writer.CloseWithError(writeErr)
+	}()
+	_, err := handler.PutObject(ctx, &tree.Node{Path: targetFile}, reader, &models.PutRequestData{Size: -1})
+	// Unblock the writer if the upload stopped before reading the whole archive, then wait for it
+	_ = reader.CloseWithError(err)
+	<-done
+	if writeErr != nil {
+		err = writeErr
+	}
+	if err != nil {
+		log.TasksLogger(ctx).Error("Cannot write data export archive", zap.Error(err))
+		return input.WithError(err), err
+	}
+	for _, s := range manifest.Sections {
+		if s.Error != "" {
+			log.TasksLogger(ctx).Warn(fmt.Sprintf("Section %s is incomplete: %s", s.Name, s.Error))
+		}
+	}
+
+	resp, er := handler.ReadNode(ctx, &tree.ReadNodeRequest{Node: &tree.Node{Path: targetFile}})
+	if er != nil {
+		return input.WithError(er), er
+	}
+	link, er := export.CreateLink(ctx, u.GetLogin(), resp.GetNode().GetUuid(), path.Base(targetFile), manifest.Expires)
+	if er != nil {
+		return input.WithError(er), er
+	}
+	export.Notify(ctx, u, link)
+	log.TasksLogger(ctx).Info(fmt.Sprintf("Personal data archive %s was created in %s", path.Base(targetFile), export.PersonalFiles))
+	log.Auditer(ctx).Info(
+		fmt.Sprintf("User [%s] exported personal data to [%s]", u.GetLogin(), targetFile),
+		log.GetAuditId(common.AuditDataExport),
+		u.ZapLogin(),
+		resp.GetNode().ZapUuid(),
+	)
+	return input.WithNode(resp.GetNode()), nil
+}
+
+// DataExportsCleanAction removes expired data export links and their archives.
+type DataExportsCleanAction struct{}
+
+// GetDescription returns action description
+func (c *DataExportsCleanAction) GetDescription(lang ...string) actions.ActionDescription {
+	return actions.ActionDescription{
+		ID:              dataExportCleanName,
+		IsInternal:      true,
+		Label:           "Clean data exports",
+		Icon:            "database-remove",
+		Category:        actions.ActionCategoryIDM,
+		Description:     "Delete expired personal data export archives and their download links",
+		SummaryTemplate: "",
+		HasForm:         false,
+	}
+}
+
+// GetParametersForm returns a UX form
+func (c *DataExportsCleanAction) GetParametersForm(context.Context) *forms.Form {
+	return nil
+}
+
+// GetName provides unique identifier
+func (c *DataExportsCleanAction) GetName() string {
+	return dataExportCleanName
+}
+
+// Init passes parameters
+func (c *DataExportsCleanAction) Init(ctx context.Context, job *jobs.Job, action *jobs.Action) error {
+	return nil
+}
+
+// Run perform actual action code
+func (c *DataExportsCleanAction) Run(ctx context.Context, channels *actions.RunnableChannels, input *jobs.ActionMessage) (*jobs.ActionMessage, error) {
+	links, er := export.ListLinks(ctx)
+	if er != nil {
+		return input.WithError(er), er
+	}
+	router := compose.PathClient(nodes.AsAdmin())
+	now := time.Now()
+	var count int
+	for _, l := range links {
+		if !l.Expired(now) {
+			continue
+		}
+		if resp, e := router.GetClientsPool(ctx).GetTreeClient().ReadNode(ctx, &tree.ReadNodeRequest{Node: &tree.Node{Uuid: l.NodeUuid}}); e == nil {
+			if _, e = router.DeleteNode(ctx, &tree.DeleteNodeRequest{Node: resp.GetNode()}); e != nil {
+				log.TasksLogger(ctx).Error("Cannot delete data export archive "+l.Name, zap.Error(e))
+				continue
+			}
+		}
+		if e := export.DeleteLink(ctx, l.Token); e != nil {
+			log.TasksLogger(ctx).Error("Cannot delete data export link", zap.Error(e))
+			continue
+		}
+		count++
+	}
+	if count > 0 {
+		log.TasksLogger(ctx).Info(fmt.Sprintf("Removed %d expired data export(s)", count))
+	}
+	return input, nil
+}
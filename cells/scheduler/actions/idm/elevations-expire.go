@@ -0,0 +1,96 @@
+/*
+ * Copyright (c) 2025 Abstrium SAS <team (at) pydio.com>
+ * This file is part of Pydio Cells.
+ *
+ * Pydio Cells is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * Pydio Cells is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with Pydio Cells.  If not, see <http://www.gnu.org/licenses/>.
+ *
+ * The latest code can be found at <https://pydio.com>.
+ */
+
+package idm
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/pydio/cells/v5/common/forms"
+	"github.com/pydio/cells/v5/common/proto/jobs"
+	"github.com/pydio/cells/v5/common/proto/rest"
+	"github.com/pydio/cells/v5/common/telemetry/log"
+	"github.com/pydio/cells/v5/idm/elevation"
+	"github.com/pydio/cells/v5/scheduler/actions"
+)
+
+var (
+	elevationsExpireName = "actions.idm.elevations-expire"
+)
+
+// ElevationsExpireAction ends privilege elevations that have reached their expiration date.
+type ElevationsExpireAction struct{}
+
+// GetDescription returns action description
+func (c *ElevationsExpireAction) GetDescription(lang ...string) actions.ActionDescription {
+	return actions.ActionDescription{
+		ID:              elevationsExpireName,
+		IsInternal:      true,
+		Label:           "Expire privilege elevations",
+		Icon:            "account-arrow-up",
+		Category:        actions.ActionCategoryIDM,
+		Description:     "Detach and delete the temporary roles of privilege elevations that have expired",
+		SummaryTemplate: "",
+		HasForm:         false,
+	}
+}
+
+// GetParametersForm returns a UX form
+func (c *ElevationsExpireAction) GetParametersForm(context.Context) *forms.Form {
+	return nil
+}
+
+// GetName provides unique identifier
+func (c *ElevationsExpireAction) GetName() string {
+	return elevationsExpireName
+}
+
+// Init passes parameters
+func (c *ElevationsExpireAction) Init(ctx context.Context, job *jobs.Job, action *jobs.Action) error {
+	return nil
+}
+
+// Run perform actual action code
+func (c *ElevationsExpireAction) Run(ctx context.Context, channels *actions.RunnableChannels, input *jobs.ActionMessage) (*jobs.ActionMessage, error) {
+	active, er := elevation.ListActive(ctx)
+	if er != nil {
+		return input.WithError(er), er
+	}
+	now := time.Now()
+	var count int
+	for _, e := range active {
+		if !elevation.Expired(e, now) {
+			continue
+		}
+		if er := elevation.End(ctx, e, rest.ElevationStatus_ElevationExpired, ""); er != nil {
+			log.TasksLogger(ctx).Error("Cannot end elevation "+e.GetUuid(), zap.Error(er))
+			continue
+		}
+		count++
+	}
+	if count > 0 {
+		log.TasksLogger(ctx).Info(fmt.Sprintf("Ended %d expired privilege elevation(s)", count))
+	}
+	return input, nil
+}
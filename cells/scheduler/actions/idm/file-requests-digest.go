@@ -0,0 +1,194 @@
+/*
+ * Copyright (c) 2025 Abstrium SAS <team (at) pydio.com>
+ * This file is part of Pydio Cells.
+ *
+ * Pydio Cells is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * Pydio Cells is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with Pydio Cells.  If not, see <http://www.gnu.org/licenses/>.
+ *
+ * The latest code can be found at <https://pydio.com>.
+ */
+
+package idm
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	humanize "github.com/dustin/go-humanize"
+	"go.uber.org/zap"
+
+	"github.com/pydio/cells/v5/common"
+	"github.com/pydio/cells/v5/common/client/commons/docstorec"
+	"github.com/pydio/cells/v5/common/client/grpc"
+	"github.com/pydio/cells/v5/common/errors"
+	"github.com/pydio/cells/v5/common/forms"
+	"github.com/pydio/cells/v5/common/nodes/filerequest"
+	"github.com/pydio/cells/v5/common/permissions"
+	"github.com/pydio/cells/v5/common/proto/docstore"
+	"github.com/pydio/cells/v5/common/proto/jobs"
+	"github.com/pydio/cells/v5/common/proto/mailer"
+	"github.com/pydio/cells/v5/common/telemetry/log"
+	"github.com/pydio/cells/v5/common/utils/i18n/languages"
+	json "github.com/pydio/cells/v5/common/utils/jsonx"
+	"github.com/pydio/cells/v5/scheduler/actions"
+)
+
+var (
+	fileRequestsDigestName = "actions.idm.file-requests-digest"
+	// markdownReplacer neutralizes markdown, raw HTML and autolinks in uploader-provided values
+	markdownReplacer = strings.NewReplacer(markdownEscapes()...)
+)
+
+func markdownEscapes() (pairs []string) {
+	pairs = append(pairs, "\r", " ", "\n", " ")
+	for _, c := range "\\`*_{}[]()#+-.!:|&<>~" {
+		pairs = append(pairs, string(c), "\\"+string(c))
+	}
+	return
+}
+
+// escapeMarkdown renders s as literal text on a single line once converted by the mailer.
+func escapeMarkdown(s string) string {
+	return markdownReplacer.Replace(s)
+}
+
+// FileRequestsDigestAction sends to file request owners a summary of the files uploaded since last run.
+type FileRequestsDigestAction struct{}
+
+// GetDescription returns action description
+func (c *FileRequestsDigestAction) GetDescription(lang ...string) actions.ActionDescription {
+	return actions.ActionDescription{
+		ID:              fileRequestsDigestName,
+		IsInternal:      true,
+		Label:           "File requests digest",
+		Icon:            "inbox-arrow-down",
+		Category:        actions.ActionCategoryIDM,
+		Description:     "Send an email to file requests owners listing newly uploaded files",
+		SummaryTemplate: "",
+		HasForm:         false,
+	}
+}
+
+// GetParametersForm returns a UX form
+func (c *FileRequestsDigestAction) GetParametersForm(context.Context) *forms.Form {
+	return nil
+}
+
+// GetName provides unique identifier
+func (c *FileRequestsDigestAction) GetName() string {
+	return fileRequestsDigestName
+}
+
+// Init passes parameters
+func (c *FileRequestsDigestAction) Init(ctx context.Context, job *jobs.Job, action *jobs.Action) error {
+	return nil
+}
+
+// Run perform actual action code
+func (c *FileRequestsDigestAction) Run(ctx context.Context, channels *actions.RunnableChannels, input *jobs.ActionMessage) (*jobs.ActionMessage, error) {
+
+	store := docstorec.DocStoreClient(ctx)
+	ct, ca := context.WithCancel(ctx)
+	defer ca()
+	streamer, er := store.ListDocuments(ct, &docstore.ListDocumentsRequest{StoreID: common.DocStoreIdFileRequestUploads})
+	if er != nil {
+		return input.WithError(er), er
+	}
+	byOwner := make(map[string][]*filerequest.Upload)
+	for {
+		resp, e := streamer.Recv()
+		if e != nil {
+			break
+		}
+		var up *filerequest.Upload
+		if resp.GetDocument() == nil || json.Unmarshal([]byte(resp.GetDocument().GetData()), &up) != nil {
+			continue
+		}
+		byOwner[up.OwnerUuid] = append(byOwner[up.OwnerUuid], up)
+	}
+	ca()
+
+	var sent int
+	for ownerUuid, uploads := range byOwner {
+		if er := c.sendDigest(ctx, ownerUuid, uploads); er == nil {
+			sent++
+		} else if !errors.Is(er, errors.UserNotFound) {
+			// Keep uploads queued, they will be part of next digest
+			log.TasksLogger(ctx).Error("Cannot send file requests digest", zap.String("owner", ownerUuid), zap.Error(er))
+			continue
+		}
+		// Owner was notified, or cannot be notified anymore
+		for _, up := range uploads {
+			if _, e := store.DeleteDocuments(ctx, &docstore.DeleteDocumentsRequest{StoreID: common.DocStoreIdFileRequestUploads, DocumentID: up.NodeUuid}); e != nil {
+				log.TasksLogger(ctx).Warn("Cannot remove upload from digest queue", zap.Error(e))
+			}
+		}
+	}
+	if sent > 0 {
+		log.TasksLogger(ctx).Info(fmt.Sprintf("Sent %d file requests digest(s)", sent))
+	}
+
+	return input, nil
+}
+
+// sendDigest groups uploads by file request and sends them to the owner as a markdown summary. It returns
+// a UserNotFound error if the owner does not exist anymore or has no email.
+func (c *FileRequestsDigestAction) sendDigest(ctx context.Context, ownerUuid string, uploads []*filerequest.Upload) error {
+	owner, er := permissions.SearchUniqueUser(ctx, "", ownerUuid)
+	if er != nil {
+		return er
+	} else if owner.GetAttributes()["email"] == "" {
+		return errors.WithMessagef(errors.UserNotFound, "owner %s has no email", owner.GetLogin())
+	}
+	sort.Slice(uploads, func(i, j int) bool {
+		if uploads[i].WorkspaceLabel == uploads[j].WorkspaceLabel {
+			return uploads[i].Time < uploads[j].Time
+		}
+		return uploads[i].WorkspaceLabel < uploads[j].WorkspaceLabel
+	})
+	var md []string
+	var current string
+	for _, up := range uploads {
+		if up.WorkspaceUuid != current {
+			current = up.WorkspaceUuid
+			md = append(md, "", "**"+escapeMarkdown(up.WorkspaceLabel)+"**", "")
+		}
+		md = append(md, fmt.Sprintf(" - %s (%s) by %s \\<%s\\> - %s",
+			escapeMarkdown(up.Name),
+			humanize.Bytes(uint64(up.Size)),
+			escapeMarkdown(up.UploaderName),
+			escapeMarkdown(up.UploaderEmail),
+			time.Unix(up.Time, 0).Format("2006-01-02 15:04")))
+	}
+
+	lang := languages.UserLanguage(ctx, owner)
+	if _, e := mailer.NewMailerServiceClient(grpc.ResolveConn(ctx, common.ServiceMailerGRPC)).SendMail(ctx, &mailer.SendMailRequest{
+		Mail: &mailer.Mail{
+			To: []*mailer.User{{
+				Uuid:     owner.GetUuid(),
+				Name:     owner.GetAttributes()["displayName"],
+				Address:  owner.GetAttributes()["email"],
+				Language: lang,
+			}},
+			TemplateId:      "FileRequestDigest",
+			TemplateData:    map[string]string{"Count": fmt.Sprintf("%d", len(uploads))},
+			ContentMarkdown: strings.Join(md, "\n"),
+		},
+	}); e != nil {
+		return e
+	}
+	return nil
+}
@@ -0,0 +1,136 @@
+/*
+ * Copyright (c) 2025 Abstrium SAS <team (at) pydio.com>
+ * This file is part of Pydio Cells.
+ *
+ * Pydio Cells is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * Pydio Cells is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with Pydio Cells.  If not, see <http://www.gnu.org/licenses/>.
+ *
+ * The latest code can be found at <https://pydio.com>.
+ */
+
+package idm
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/pydio/cells/v5/common/forms"
+	"github.com/pydio/cells/v5/common/proto/jobs"
+	"github.com/pydio/cells/v5/common/telemetry/log"
+	"github.com/pydio/cells/v5/idm/user/guest"
+	"github.com/pydio/cells/v5/scheduler/actions"
+)
+
+var (
+	guestsLifecycleName = "actions.idm.guests-lifecycle"
+	guestsTransferName  = "actions.idm.guests-transfer"
+)
+
+// GuestsLifecycleAction reminds sponsors of expiring guests, then disables and deletes expired guests.
+type GuestsLifecycleAction struct{}
+
+// GetDescription returns action description
+func (c *GuestsLifecycleAction) GetDescription(lang ...string) actions.ActionDescription {
+	return actions.ActionDescription{
+		ID:              guestsLifecycleName,
+		IsInternal:      true,
+		Label:           "Guests lifecycle",
+		Icon:            "account-clock",
+		Category:        actions.ActionCategoryIDM,
+		Description:     "Remind sponsors before their guests expire, disable expired guests and delete them after a grace period",
+		SummaryTemplate: "",
+		HasForm:         false,
+	}
+}
+
+// GetParametersForm returns a UX form
+func (c *GuestsLifecycleAction) GetParametersForm(context.Context) *forms.Form {
+	return nil
+}
+
+// GetName provides unique identifier
+func (c *GuestsLifecycleAction) GetName() string {
+	return guestsLifecycleName
+}
+
+// Init passes parameters
+func (c *GuestsLifecycleAction) Init(ctx context.Context, job *jobs.Job, action *jobs.Action) error {
+	return nil
+}
+
+// Run perform actual action code
+func (c *GuestsLifecycleAction) Run(ctx context.Context, channels *actions.RunnableChannels, input *jobs.ActionMessage) (*jobs.ActionMessage, error) {
+	p := guest.LoadPolicy(ctx)
+	if !p.Enabled() {
+		log.TasksLogger(ctx).Info("Guests lifetime is not configured, skipping")
+		return input, nil
+	}
+	r, er := guest.Process(ctx, p, time.Now())
+	if er != nil {
+		return input.WithError(er), er
+	}
+	log.TasksLogger(ctx).Info(fmt.Sprintf("Guests lifecycle: %d initialized, %d reminded, %d disabled, %d deleted, %d transferred", r.Initialized, r.Reminded, r.Disabled, r.Deleted, r.Transferred))
+	return input, nil
+}
+
+// GuestsTransferAction hands the guests of deleted users over to the fallback sponsor.
+type GuestsTransferAction struct{}
+
+// GetDescription returns action description
+func (c *GuestsTransferAction) GetDescription(lang ...string) actions.ActionDescription {
+	return actions.ActionDescription{
+		ID:               guestsTransferName,
+		IsInternal:       true,
+		Label:            "Transfer guests",
+		Icon:             "account-switch",
+		Category:         actions.ActionCategoryIDM,
+		Description:      "Transfer the sponsorship of the guests invited by a deleted user to the fallback sponsor",
+		InputDescription: "Single-selection of one user, provided by the delete user event.",
+		SummaryTemplate:  "",
+		HasForm:          false,
+	}
+}
+
+// GetParametersForm returns a UX form
+func (c *GuestsTransferAction) GetParametersForm(context.Context) *forms.Form {
+	return nil
+}
+
+// GetName provides unique identifier
+func (c *GuestsTransferAction) GetName() string {
+	return guestsTransferName
+}
+
+// Init passes parameters
+func (c *GuestsTransferAction) Init(ctx context.Context, job *jobs.Job, action *jobs.Action) error {
+	return nil
+}
+
+// Run perform actual action code
+func (c *GuestsTransferAction) Run(ctx context.Context, channels *actions.RunnableChannels, input *jobs.ActionMessage) (*jobs.ActionMessage, error) {
+	to := guest.LoadPolicy(ctx).FallbackSponsor
+	for _, u := range input.GetUsers() {
+		if u.GetIsGroup() || u.GetLogin() == "" {
+			continue
+		}
+		n, er := guest.Transfer(ctx, u.GetLogin(), to)
+		if er != nil {
+			return input.WithError(er), er
+		}
+		if n > 0 {
+			log.TasksLogger(ctx).Info(fmt.Sprintf("Transferred %d guest(s) of %s to '%s'", n, u.GetLogin(), to))
+		}
+	}
+	return input, nil
+}
@@ -31,5 +31,35 @@ func init() {
 	manager.Register(cleanACLName, func() actions.ConcreteAction {
 		return &CleanExpiredACLAction{}
 	})
+	manager.Register(linksActivationName, func() actions.ConcreteAction {
+		return &LinksActivationAction{}
+	})
+	manager.Register(fileRequestsDigestName, func() actions.ConcreteAction {
+		return &FileRequestsDigestAction{}
+	})
+	manager.Register(accessReviewEscalateName, func() actions.ConcreteAction {
+		return &AccessReviewEscalateAction{}
+	})
+	manager.Register(elevationsExpireName, func() actions.ConcreteAction {
+		return &ElevationsExpireAction{}
+	})
+	manager.Register(guestsLifecycleName, func() actions.ConcreteAction {
+		return &GuestsLifecycleAction{}
+	})
+	manager.Register(guestsTransferName, func() actions.ConcreteAction {
+		return &GuestsTransferAction{}
+	})
+	manager.Register(dataExportName, func() actions.ConcreteAction {
+		return &DataExportAction{}
+	})
+	manager.Register(dataExportCleanName, func() actions.ConcreteAction {
+		return &DataExportsCleanAction{}
+	})
+	manager.Register(quotaWarningsName, func() actions.ConcreteAction {
+		return &QuotaWarningsAction{}
+	})
+	manager.Register(linkAccessesPruneName, func() actions.ConcreteAction {
+		return &LinkAccessesPruneAction{}
+	})
 
 }
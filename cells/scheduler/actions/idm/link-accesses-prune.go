@@ -0,0 +1,107 @@
+/*
+ * Copyright (c) 2025 Abstrium SAS <team (at) pydio.com>
+ * This file is part of Pydio Cells.
+ *
+ * Pydio Cells is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * Pydio Cells is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with Pydio Cells.  If not, see <http://www.gnu.org/licenses/>.
+ *
+ * The latest code can be found at <https://pydio.com>.
+ */
+
+package idm
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/pydio/cells/v5/common/errors"
+	"github.com/pydio/cells/v5/common/forms"
+	"github.com/pydio/cells/v5/common/proto/jobs"
+	"github.com/pydio/cells/v5/common/telemetry/log"
+	"github.com/pydio/cells/v5/idm/share"
+	"github.com/pydio/cells/v5/scheduler/actions"
+)
+
+var (
+	linkAccessesPruneName = "actions.idm.link-accesses-prune"
+)
+
+// LinkAccessesPruneAction removes public links accesses older than the retention period.
+type LinkAccessesPruneAction struct {
+	retention string
+}
+
+// GetDescription returns action description
+func (c *LinkAccessesPruneAction) GetDescription(lang ...string) actions.ActionDescription {
+	return actions.ActionDescription{
+		ID:              linkAccessesPruneName,
+		IsInternal:      true,
+		Label:           "Prune links analytics",
+		Icon:            "chart-line",
+		Category:        actions.ActionCategoryIDM,
+		Description:     "Delete public links accesses older than the retention period",
+		SummaryTemplate: "",
+		HasForm:         true,
+	}
+}
+
+// GetParametersForm returns a UX form
+func (c *LinkAccessesPruneAction) GetParametersForm(context.Context) *forms.Form {
+	return &forms.Form{Groups: []*forms.Group{
+		{
+			Fields: []forms.Field{
+				&forms.FormField{
+					Name:        "retention",
+					Type:        forms.ParamString,
+					Label:       "Retention",
+					Description: "Duration after which accesses are deleted (default 2160h)",
+					Default:     "2160h",
+					Mandatory:   false,
+					Editable:    true,
+				},
+			},
+		},
+	}}
+}
+
+// GetName provides unique identifier
+func (c *LinkAccessesPruneAction) GetName() string {
+	return linkAccessesPruneName
+}
+
+// Init passes parameters
+func (c *LinkAccessesPruneAction) Init(ctx context.Context, job *jobs.Job, action *jobs.Action) error {
+	c.retention = "2160h"
+	if r, o := action.Parameters["retention"]; o && r != "" {
+		c.retention = r
+	}
+	return nil
+}
+
+// Run perform actual action code
+func (c *LinkAccessesPruneAction) Run(ctx context.Context, channels *actions.RunnableChannels, input *jobs.ActionMessage) (*jobs.ActionMessage, error) {
+	d, er := time.ParseDuration(jobs.EvaluateFieldStr(ctx, input, c.retention))
+	if er != nil || d <= 0 {
+		e := errors.WithMessagef(errors.InvalidParameters, "invalid retention duration %s", c.retention)
+		return input.WithError(e), e
+	}
+	count, er := share.PruneLinkAccesses(ctx, time.Now().Add(-d))
+	if er != nil {
+		return input.WithError(er), er
+	}
+	if count > 0 {
+		log.TasksLogger(ctx).Info(fmt.Sprintf("Removed %d link access(es) older than %s", count, d))
+	}
+	return input, nil
+}
@@ -0,0 +1,200 @@
+/*
+ * Copyright (c) 2025 Abstrium SAS <team (at) pydio.com>
+ * This file is part of Pydio Cells.
+ *
+ * Pydio Cells is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * Pydio Cells is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with Pydio Cells.  If not, see <http://www.gnu.org/licenses/>.
+ *
+ * The latest code can be found at <https://pydio.com>.
+ */
+
+package idm
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/pydio/cells/v5/common"
+	"github.com/pydio/cells/v5/common/client/commons/docstorec"
+	"github.com/pydio/cells/v5/common/client/grpc"
+	"github.com/pydio/cells/v5/common/forms"
+	"github.com/pydio/cells/v5/common/permissions"
+	"github.com/pydio/cells/v5/common/proto/docstore"
+	"github.com/pydio/cells/v5/common/proto/jobs"
+	"github.com/pydio/cells/v5/common/proto/mailer"
+	"github.com/pydio/cells/v5/common/proto/rest"
+	"github.com/pydio/cells/v5/common/telemetry/log"
+	"github.com/pydio/cells/v5/common/utils/i18n/languages"
+	json "github.com/pydio/cells/v5/common/utils/jsonx"
+	"github.com/pydio/cells/v5/idm/share"
+	"github.com/pydio/cells/v5/scheduler/actions"
+)
+
+var (
+	linksActivationName = "actions.idm.links-activation"
+	notifyExpiredWithin = 24 * time.Hour
+)
+
+// LinksActivationAction looks for public links that reached their start or expiration date since last run,
+// and notifies their owner by email.
+type LinksActivationAction struct{}
+
+// GetDescription returns action description
+func (c *LinksActivationAction) GetDescription(lang ...string) actions.ActionDescription {
+	return actions.ActionDescription{
+		ID:              linksActivationName,
+		IsInternal:      true,
+		Label:           "Public links activation",
+		Icon:            "link-variant",
+		Category:        actions.ActionCategoryIDM,
+		Description:     "Notify links owners when their scheduled public links are activated or deactivated",
+		SummaryTemplate: "",
+		HasForm:         false,
+	}
+}
+
+// GetParametersForm returns a UX form
+func (c *LinksActivationAction) GetParametersForm(context.Context) *forms.Form {
+	return nil
+}
+
+// GetName provides unique identifier
+func (c *LinksActivationAction) GetName() string {
+	return linksActivationName
+}
+
+// Init passes parameters
+func (c *LinksActivationAction) Init(ctx context.Context, job *jobs.Job, action *jobs.Action) error {
+	return nil
+}
+
+// Run perform actual action code
+func (c *LinksActivationAction) Run(ctx context.Context, channels *actions.RunnableChannels, input *jobs.ActionMessage) (*jobs.ActionMessage, error) {
+
+	store := docstorec.DocStoreClient(ctx)
+	ct, ca := context.WithCancel(ctx)
+	defer ca()
+	streamer, er := store.ListDocuments(ct, &docstore.ListDocumentsRequest{StoreID: common.DocStoreIdShares, Query: &docstore.DocumentQuery{
+		MetaQuery: "+SHARE_TYPE:minisite",
+	}})
+	if er != nil {
+		return input.WithError(er), er
+	}
+	now := time.Now()
+	var activated, deactivated []*docstore.Document
+	for {
+		resp, e := streamer.Recv()
+		if e != nil {
+			break
+		}
+		var linkData *docstore.ShareDocument
+		if resp.GetDocument() == nil || json.Unmarshal([]byte(resp.GetDocument().GetData()), &linkData) != nil {
+			continue
+		}
+		// Links stored before start dates were supported carry no flags: only scheduled links are considered for
+		// activation, and only recently expired links are considered for deactivation.
+		if linkData.StartTime > 0 && !linkData.ActivationNotified && !linkData.IsPending(now) && !linkData.IsExpired(now) {
+			activated = append(activated, resp.GetDocument())
+		} else if !linkData.ExpirationNotified && linkData.IsExpired(now) && now.Sub(time.Unix(linkData.ExpireTime, 0)) < notifyExpiredWithin {
+			deactivated = append(deactivated, resp.GetDocument())
+		}
+	}
+
+	var count int
+	for _, doc := range activated {
+		if c.notify(ctx, doc, "LinkActivated", func(d *docstore.ShareDocument) { d.ActivationNotified = true }) {
+			count++
+		}
+	}
+	for _, doc := range deactivated {
+		if c.notify(ctx, doc, "LinkDeactivated", func(d *docstore.ShareDocument) { d.ActivationNotified = true; d.ExpirationNotified = true }) {
+			count++
+		}
+	}
+	if count > 0 {
+		log.TasksLogger(ctx).Info(fmt.Sprintf("Sent %d link activation notification(s)", count))
+	}
+
+	return input, nil
+}
+
+// notify sends an email to the link owner and flags the document as notified. The flag is stored even if the
+// owner has no email address, to avoid retrying on every run.
+func (c *LinksActivationAction) notify(ctx context.Context, doc *docstore.Document, templateId string, flag func(d *docstore.ShareDocument)) bool {
+	var linkData *docstore.ShareDocument
+	if er := json.Unmarshal([]byte(doc.GetData()), &linkData); er != nil {
+		return false
+	}
+	sent := false
+	if owner, er := permissions.SearchUniqueUser(ctx, linkData.OwnerId, ""); er == nil && owner.GetAttributes()["email"] != "" {
+		label := doc.GetID()
+		if ws, e := permissions.SearchUniqueWorkspace(ctx, linkData.RepositoryId, ""); e == nil && ws != nil && ws.GetLabel() != "" {
+			label = ws.GetLabel()
+		}
+		link := &rest.ShareLink{LinkHash: doc.GetID()}
+		_ = share.PublicLinkUrlBuilder.BuildLinkURL(ctx, link)
+		data := map[string]string{"Label": label}
+		if strings.HasPrefix(link.LinkUrl, "http") {
+			data["LinkUrl"] = link.LinkUrl
+		} else {
+			data["LinkPath"] = link.LinkUrl
+		}
+		lang := languages.UserLanguage(ctx, owner)
+		if _, e := mailer.NewMailerServiceClient(grpc.ResolveConn(ctx, common.ServiceMailerGRPC)).SendMail(ctx, &mailer.SendMailRequest{
+			Mail: &mailer.Mail{
+				To: []*mailer.User{{
+					Uuid:     owner.GetUuid(),
+					Name:     owner.GetAttributes()["displayName"],
+					Address:  owner.GetAttributes()["email"],
+					Language: lang,
+				}},
+				TemplateId:   templateId,
+				TemplateData: data,
+			},
+		}); e != nil {
+			log.TasksLogger(ctx).Error("Cannot send link notification to "+owner.GetLogin(), zap.Error(e))
+			return false
+		}
+		sent = true
+	}
+
+	if er := c.flagDocument(ctx, doc.GetID(), flag); er != nil {
+		log.TasksLogger(ctx).Error("Cannot update link document "+doc.GetID(), zap.Error(er))
+	}
+	return sent
+}
+
+// flagDocument re-reads the link document right before updating it, so that changes written since it was listed
+// (e.g. download counts) are not overwritten, and only applies the notification flags.
+func (c *LinksActivationAction) flagDocument(ctx context.Context, docID string, flag func(d *docstore.ShareDocument)) error {
+	store := docstorec.DocStoreClient(ctx)
+	resp, er := store.GetDocument(ctx, &docstore.GetDocumentRequest{StoreID: common.DocStoreIdShares, DocumentID: docID})
+	if er != nil {
+		return er
+	}
+	doc := resp.GetDocument()
+	var linkData *docstore.ShareDocument
+	if er = json.Unmarshal([]byte(doc.GetData()), &linkData); er != nil {
+		return er
+	}
+	flag(linkData)
+	marshaled, _ := json.Marshal(linkData)
+	doc.Data = string(marshaled)
+	doc.IndexableMeta = string(marshaled)
+	_, er = store.PutDocument(ctx, &docstore.PutDocumentRequest{StoreID: common.DocStoreIdShares, DocumentID: docID, Document: doc})
+	return er
+}
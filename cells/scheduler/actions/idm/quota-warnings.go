@@ -0,0 +1,83 @@
+/*
+ * Copyright (c) 2025 Abstrium SAS <team (at) pydio.com>
+ * This file is part of Pydio Cells.
+ *
+ * Pydio Cells is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * Pydio Cells is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with Pydio Cells.  If not, see <http://www.gnu.org/licenses/>.
+ *
+ * The latest code can be found at <https://pydio.com>.
+ */
+
+package idm
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/pydio/cells/v5/common/forms"
+	"github.com/pydio/cells/v5/common/proto/jobs"
+	"github.com/pydio/cells/v5/common/telemetry/log"
+	"github.com/pydio/cells/v5/idm/user/quota"
+	"github.com/pydio/cells/v5/scheduler/actions"
+)
+
+var (
+	quotaWarningsName = "actions.idm.quota-warnings"
+)
+
+// QuotaWarningsAction warns users and reports groups reaching the quota warning thresholds.
+type QuotaWarningsAction struct{}
+
+// GetDescription returns action description
+func (c *QuotaWarningsAction) GetDescription(lang ...string) actions.ActionDescription {
+	return actions.ActionDescription{
+		ID:              quotaWarningsName,
+		IsInternal:      true,
+		Label:           "Quota warnings",
+		Icon:            "harddisk",
+		Category:        actions.ActionCategoryIDM,
+		Description:     "Compute users and groups storage usage and send warnings when they reach a quota threshold",
+		SummaryTemplate: "",
+		HasForm:         false,
+	}
+}
+
+// GetParametersForm returns a UX form
+func (c *QuotaWarningsAction) GetParametersForm(context.Context) *forms.Form {
+	return nil
+}
+
+// GetName provides unique identifier
+func (c *QuotaWarningsAction) GetName() string {
+	return quotaWarningsName
+}
+
+// Init passes parameters
+func (c *QuotaWarningsAction) Init(ctx context.Context, job *jobs.Job, action *jobs.Action) error {
+	return nil
+}
+
+// Run perform actual action code
+func (c *QuotaWarningsAction) Run(ctx context.Context, channels *actions.RunnableChannels, input *jobs.ActionMessage) (*jobs.ActionMessage, error) {
+	thresholds := quota.LoadThresholds(ctx)
+	if len(thresholds) == 0 {
+		log.TasksLogger(ctx).Info("Quota warning thresholds are not configured, skipping")
+		return input, nil
+	}
+	r, er := quota.Process(ctx, thresholds)
+	if er != nil {
+		return input.WithError(er), er
+	}
+	log.TasksLogger(ctx).Info(fmt.Sprintf("Quota warnings: %d users checked, %d users warned, %d groups reported", r.Checked, r.Warned, r.Groups))
+	return input, nil
+}
@@ -0,0 +1,251 @@
+/*
+ * Copyright (c) 2025 Abstrium SAS <team (at) pydio.com>
+ * This file is part of Pydio Cells.
+ *
+ * Pydio Cells is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * Pydio Cells is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with Pydio Cells.  If not, see <http://www.gnu.org/licenses/>.
+ *
+ * The latest code can be found at <https://pydio.com>.
+ */
+
+package tree
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/pydio/cells/v5/common"
+	"github.com/pydio/cells/v5/common/client/commons"
+	"github.com/pydio/cells/v5/common/client/commons/treec"
+	"github.com/pydio/cells/v5/common/client/grpc"
+	"github.com/pydio/cells/v5/common/config"
+	"github.com/pydio/cells/v5/common/errors"
+	"github.com/pydio/cells/v5/common/forms"
+	"github.com/pydio/cells/v5/common/nodes/encryption"
+	encryption2 "github.com/pydio/cells/v5/common/proto/encryption"
+	"github.com/pydio/cells/v5/common/proto/jobs"
+	"github.com/pydio/cells/v5/common/proto/object"
+	"github.com/pydio/cells/v5/common/proto/tree"
+	"github.com/pydio/cells/v5/common/telemetry/log"
+	"github.com/pydio/cells/v5/scheduler/actions"
+)
+
+var (
+	datasourceRotateKeyActionName = "actions.tree.ds-rotate-key"
+)
+
+// datasourceRotateKeyAction switches an encrypted datasource to a new master key and re-wraps all node keys with it.
+// Previous keys are kept in the datasource configuration until all nodes are processed, so that the action can be
+// safely interrupted and started again: nodes already sealed with the new key are skipped.
+type datasourceRotateKeyAction struct {
+	keyID    string
+	keyLabel string
+}
+
+func (d *datasourceRotateKeyAction) GetName() string {
+	return datasourceRotateKeyActionName
+}
+
+func (d *datasourceRotateKeyAction) GetDescription(lang ...string) actions.ActionDescription {
+	return actions.ActionDescription{
+		ID:          datasourceRotateKeyActionName,
+		Label:       "Rotate Datasource Key",
+		Icon:        "mdi mdi-key-change",
+		IsInternal:  true,
+		Description: "Create a new master key for an encrypted datasource and re-encrypt all files keys with it",
+		Category:    actions.ActionCategoryTree,
+		HasForm:     true,
+	}
+}
+
+func (d *datasourceRotateKeyAction) GetParametersForm(context.Context) *forms.Form {
+	return &forms.Form{
+		Groups: []*forms.Group{
+			{
+				Fields: []forms.Field{
+					&forms.FormField{
+						Name:        "keyId",
+						Type:        forms.ParamString,
+						Label:       "New Key ID",
+						Description: "Identifier of the new master key, created if it does not exist. Leave empty to generate one.",
+					},
+					&forms.FormField{
+						Name:        "keyLabel",
+						Type:        forms.ParamString,
+						Label:       "New Key Label",
+						Description: "Label used when creating the new key",
+					},
+				},
+			},
+		},
+	}
+}
+
+// ProvidesProgress implements interface
+func (d *datasourceRotateKeyAction) ProvidesProgress() bool {
+	return true
+}
+
+func (d *datasourceRotateKeyAction) Init(ctx context.Context, job *jobs.Job, action *jobs.Action) error {
+	d.keyID = action.Parameters["keyId"]
+	d.keyLabel = action.Parameters["keyLabel"]
+	return nil
+}
+
+func (d *datasourceRotateKeyAction) Run(ctx context.Context, channels *actions.RunnableChannels, input *jobs.ActionMessage) (*jobs.ActionMessage, error) {
+	dss := input.GetDataSources()
+	if len(dss) == 0 {
+		er := errors.New("cannot find datasource")
+		return input.WithError(er), er
+	}
+	ds := dss[0]
+	if ds.EncryptionKMS() != "" {
+		er := errors.New("datasource " + ds.Name + " keys are wrapped by an external KMS, rotate the key on the KMS side instead")
+		return input.WithError(er), er
+	}
+	if ds.EncryptionMode != object.EncryptionMode_MASTER {
+		er := errors.New("datasource " + ds.Name + " is not encrypted with a master key")
+		return input.WithError(er), er
+	}
+
+	newKey := jobs.EvaluateFieldStr(ctx, input, d.keyID)
+	if newKey == "" {
+		if len(ds.PreviousEncryptionKeys()) > 0 {
+			// A rotation was interrupted, resume it instead of creating yet another key
+			newKey = ds.EncryptionKey
+		} else {
+			newKey = ds.Name + "-" + time.Now().Format("20060102150405")
+		}
+	}
+
+	if ds.EncryptionKey != newKey {
+		if er := d.switchKey(ctx, ds, newKey, jobs.EvaluateFieldStr(ctx, input, d.keyLabel)); er != nil {
+			return input.WithError(er), er
+		}
+	} else {
+		log.TasksLogger(ctx).Info("Datasource " + ds.Name + " already uses key " + newKey + ", resuming re-encryption of files keys")
+	}
+
+	previous := ds.PreviousEncryptionKeys()
+	if len(previous) == 0 {
+		log.TasksLogger(ctx).Info("No previous key registered for datasource " + ds.Name + ", nothing to re-encrypt")
+		return input.WithDataSource(ds), nil
+	}
+
+	total, er := d.walkLeaves(ctx, ds, nil)
+	if er != nil {
+		return input.WithError(er), er
+	}
+
+	tool, er := encryption.MasterKeyTool(ctx)
+	if er != nil {
+		return input.WithError(er), er
+	}
+	var processed, rewrapped, failed int
+	if _, er = d.walkLeaves(ctx, ds, func(uuid string) {
+		if done, e := encryption.RewrapNodeKey(ctx, tool, ds.Name, uuid, newKey, previous); e != nil {
+			failed++
+			log.TasksLogger(ctx).Error("Cannot re-encrypt key for node "+uuid, zap.Error(e))
+		} else if done {
+			rewrapped++
+		}
+		processed++
+		if total > 0 {
+			channels.Progress <- float32(min(processed, total)) / float32(total)
+		}
+		if processed%100 == 0 {
+			channels.StatusMsg <- fmt.Sprintf("Processed %d/%d files", processed, total)
+		}
+	}); er != nil {
+		return input.WithError(er), er
+	}
+
+	if failed > 0 {
+		er = errors.New(fmt.Sprintf("failed re-encrypting %d file(s) keys, previous keys are kept: run this action again to resume", failed))
+		return input.WithError(er), er
+	}
+
+	// All nodes are now sealed with the new key, previous keys are not required anymore
+	delete(ds.StorageConfiguration, object.StorageKeyEncryptionPreviousKeys)
+	if er = d.saveDataSource(ctx, ds, "Finished key rotation for datasource "+ds.Name); er != nil {
+		return input.WithError(er), er
+	}
+	msg := fmt.Sprintf("Finished rotating datasource [%s] key to [%s], re-encrypted %d files keys", ds.Name, newKey, rewrapped)
+	log.TasksLogger(ctx).Info(msg)
+	log.Auditer(ctx).Info(msg, log.GetAuditId(common.AuditEncryptionKeyRotate), zap.String("datasource", ds.Name), zap.String("keyId", newKey))
+
+	return input.WithDataSource(ds), nil
+}
+
+// walkLeaves streams all files of the datasource, calling cb (if not nil) for each of them, and returns their count.
+// Nodes are not kept in memory so that large datasources can be processed.
+func (d *datasourceRotateKeyAction) walkLeaves(ctx context.Context, ds *object.DataSource, cb func(uuid string)) (int, error) {
+	var count int
+	st, er := treec.NodeProviderClient(ctx).ListNodes(ctx, &tree.ListNodesRequest{
+		Node:       &tree.Node{Path: ds.Name},
+		Recursive:  true,
+		FilterType: tree.NodeType_LEAF,
+	})
+	er = commons.ForEach(st, er, func(resp *tree.ListNodesResponse) error {
+		count++
+		if cb != nil {
+			cb(resp.GetNode().GetUuid())
+		}
+		return nil
+	})
+	return count, er
+}
+
+// switchKey creates the new key if required, then sets it as the datasource current key and records the
+// current key in the list of previous keys.
+func (d *datasourceRotateKeyAction) switchKey(ctx context.Context, ds *object.DataSource, newKey, label string) error {
+	cli := encryption2.NewUserKeyStoreClient(grpc.ResolveConn(ctx, common.ServiceUserKeyGRPC))
+	if label == "" {
+		label = "Key for datasource " + ds.Name
+	}
+	if _, er := cli.AdminCreateKey(ctx, &encryption2.AdminCreateKeyRequest{KeyID: newKey, Label: label}); er != nil {
+		if !errors.Is(er, errors.StatusConflict) {
+			return er
+		}
+		log.TasksLogger(ctx).Info("Key " + newKey + " already exists, using it for datasource " + ds.Name)
+	} else {
+		log.TasksLogger(ctx).Info("Created new key " + newKey)
+	}
+
+	previous := append([]string{ds.EncryptionKey}, ds.PreviousEncryptionKeys()...)
+	if ds.StorageConfiguration == nil {
+		ds.StorageConfiguration = map[string]string{}
+	}
+	ds.StorageConfiguration[object.StorageKeyEncryptionPreviousKeys] = strings.Join(previous, ",")
+	oldKey := ds.EncryptionKey
+	ds.EncryptionKey = newKey
+	if er := d.saveDataSource(ctx, ds, "Rotating datasource "+ds.Name+" key to "+newKey); er != nil {
+		return er
+	}
+
+	msg := fmt.Sprintf("Started rotating datasource [%s] key from [%s] to [%s]", ds.Name, oldKey, newKey)
+	log.TasksLogger(ctx).Info(msg)
+	log.Auditer(ctx).Info(msg, log.GetAuditId(common.AuditEncryptionKeyRotate), zap.String("datasource", ds.Name), zap.String("keyId", newKey))
+	return nil
+}
+
+func (d *datasourceRotateKeyAction) saveDataSource(ctx context.Context, ds *object.DataSource, msg string) error {
+	if er := config.Set(ctx, ds, "services", "pydio.grpc.data.sync."+ds.Name); er != nil {
+		return er
+	}
+	return config.Save(ctx, common.PydioSystemUsername, msg)
+}
@@ -0,0 +1,396 @@
+/*
+ * Copyright (c) 2025 Abstrium SAS <team (at) pydio.com>
+ * This file is part of Pydio Cells.
+ *
+ * Pydio Cells is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * Pydio Cells is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with Pydio Cells.  If not, see <http://www.gnu.org/licenses/>.
+ *
+ * The latest code can be found at <https://pydio.com>.
+ */
+
+package tree
+
+import (
+	"context"
+	"crypto/md5"
+	"encoding/hex"
+	"fmt"
+	"hash"
+	"io"
+	"path"
+	"strconv"
+	"strings"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/pydio/cells/v5/common"
+	"github.com/pydio/cells/v5/common/client/commons"
+	"github.com/pydio/cells/v5/common/client/commons/treec"
+	"github.com/pydio/cells/v5/common/client/grpc"
+	"github.com/pydio/cells/v5/common/errors"
+	"github.com/pydio/cells/v5/common/forms"
+	"github.com/pydio/cells/v5/common/nodes"
+	"github.com/pydio/cells/v5/common/nodes/compose"
+	"github.com/pydio/cells/v5/common/nodes/models"
+	"github.com/pydio/cells/v5/common/nodes/put"
+	"github.com/pydio/cells/v5/common/nodes/tiering"
+	"github.com/pydio/cells/v5/common/proto/jobs"
+	"github.com/pydio/cells/v5/common/proto/object"
+	"github.com/pydio/cells/v5/common/proto/tree"
+	"github.com/pydio/cells/v5/common/sync/endpoints/s3"
+	"github.com/pydio/cells/v5/common/telemetry/log"
+	json "github.com/pydio/cells/v5/common/utils/jsonx"
+	"github.com/pydio/cells/v5/common/utils/propagator"
+	"github.com/pydio/cells/v5/scheduler/actions"
+)
+
+var (
+	datasourceScrubActionName = "actions.tree.ds-scrub"
+)
+
+const (
+	scrubOK         = "ok"
+	scrubCorrupted  = "corrupted"
+	scrubMissing    = "missing"
+	scrubRestored   = "restored"
+	scrubUnverified = "unverified"
+)
+
+// scrubStatus is stored in the node metadata after each check. ETag and Checksum keep the content MD5 that was
+// computed for a given ETag, so that objects whose ETag is not a plain MD5 (multipart uploads) are verified
+// on next runs.
+type scrubStatus struct {
+	Checked  int64  `json:"checked"`
+	Status   string `json:"status"`
+	ETag     string `json:"etag,omitempty"`
+	Checksum string `json:"checksum,omitempty"`
+}
+
+// ScrubReport sums up the result of a scrub for one datasource.
+type ScrubReport struct {
+	DataSource string   `json:"datasource"`
+	Checked    int      `json:"checked"`
+	Skipped    int      `json:"skipped"`
+	Unverified int      `json:"unverified"`
+	Corrupted  []string `json:"corrupted,omitempty"`
+	Missing    []string `json:"missing,omitempty"`
+	Restored   []string `json:"restored,omitempty"`
+}
+
+// datasourceScrubAction re-reads the objects of a datasource and compares their contents with the hash or the ETag
+// stored in the index. ETags that are not the MD5 of the contents are resolved through a checksum mapper, as the
+// sync does. Each verified node keeps the result of the check in its metadata, so that an interrupted scrub
+// skips nodes that were recently verified when it is started again.
+type datasourceScrubAction struct {
+	recheckAfter string
+	restore      string
+}
+
+func (d *datasourceScrubAction) GetName() string {
+	return datasourceScrubActionName
+}
+
+func (d *datasourceScrubAction) GetDescription(lang ...string) actions.ActionDescription {
+	return actions.ActionDescription{
+		ID:                datasourceScrubActionName,
+		Label:             "Scrub Datasource",
+		Icon:              "mdi mdi-shield-check",
+		IsInternal:        true,
+		Description:       "Verify stored contents against the index hashes, report corrupted or missing files and optionally restore them from their versions",
+		Category:          actions.ActionCategoryTree,
+		OutputDescription: "Scrub report for each datasource",
+		HasForm:           true,
+	}
+}
+
+func (d *datasourceScrubAction) GetParametersForm(context.Context) *forms.Form {
+	return &forms.Form{
+		Groups: []*forms.Group{
+			{
+				Fields: []forms.Field{
+					&forms.FormField{
+						Name:        "recheckAfter",
+						Type:        forms.ParamInteger,
+						Label:       "Recheck after (days)",
+						Description: "Files verified more recently than this number of days are skipped. Set to 0 to verify all files.",
+						Default:     30,
+					},
+					&forms.FormField{
+						Name:        "restore",
+						Type:        forms.ParamBool,
+						Label:       "Restore from versions",
+						Description: "Restore corrupted or missing files from their last version matching the index hash",
+						Default:     false,
+					},
+				},
+			},
+		},
+	}
+}
+
+// ProvidesProgress implements interface
+func (d *datasourceScrubAction) ProvidesProgress() bool {
+	return true
+}
+
+func (d *datasourceScrubAction) Init(ctx context.Context, job *jobs.Job, action *jobs.Action) error {
+	d.recheckAfter = "30"
+	if r, o := action.Parameters["recheckAfter"]; o {
+		d.recheckAfter = r
+	}
+	d.restore = action.Parameters["restore"]
+	return nil
+}
+
+func (d *datasourceScrubAction) Run(ctx context.Context, channels *actions.RunnableChannels, input *jobs.ActionMessage) (*jobs.ActionMessage, error) {
+	dss := input.GetDataSources()
+	if len(dss) == 0 {
+		er := errors.New("cannot find datasource")
+		return input.WithError(er), er
+	}
+	days, er := strconv.Atoi(jobs.EvaluateFieldStr(ctx, input, d.recheckAfter))
+	if er != nil || days < 0 {
+		er = errors.New("invalid number of days: " + d.recheckAfter)
+		return input.WithError(er), er
+	}
+	restore, _ := jobs.EvaluateFieldBool(ctx, input, d.restore)
+
+	ctx = propagator.WithUserNameMetadata(ctx, common.PydioContextUserKey, common.PydioSystemUsername)
+	router := compose.PathClient(nodes.AsAdmin())
+	output := input
+	var failed bool
+	for _, ds := range dss {
+		report, e := d.scrubDataSource(ctx, channels, router, ds, time.Now().Add(-time.Duration(days)*24*time.Hour), restore)
+		if e != nil {
+			return input.WithError(e), e
+		}
+		msg := fmt.Sprintf("Scrubbed datasource %s: %d file(s) checked, %d skipped, %d unverified, %d corrupted, %d missing, %d restored",
+			ds.Name, report.Checked, report.Skipped, report.Unverified, len(report.Corrupted), len(report.Missing), len(report.Restored))
+		bb, _ := json.Marshal(report)
+		if len(report.Corrupted)+len(report.Missing) > len(report.Restored) {
+			failed = true
+			log.TasksLogger(ctx).Error(msg)
+		} else {
+			log.TasksLogger(ctx).Info(msg)
+		}
+		output.AppendOutput(&jobs.ActionOutput{Success: true, JsonBody: bb, StringBody: msg})
+	}
+	if failed {
+		er = errors.New("integrity errors were found, see scrub report for details")
+		return output.WithError(er), er
+	}
+	return output, nil
+}
+
+// scrubDataSource verifies all files of a datasource that were not checked since a given time.
+func (d *datasourceScrubAction) scrubDataSource(ctx context.Context, channels *actions.RunnableChannels, router nodes.Client, ds *object.DataSource, since time.Time, restore bool) (*ScrubReport, error) {
+	report := &ScrubReport{DataSource: ds.Name}
+	var candidates []*tree.Node
+	var knownETags []string
+	mapper := s3.NewMemChecksumMapper()
+	st, er := treec.NodeProviderClient(ctx).ListNodes(ctx, &tree.ListNodesRequest{
+		Node:       &tree.Node{Path: ds.Name},
+		Recursive:  true,
+		FilterType: tree.NodeType_LEAF,
+	})
+	if er = commons.ForEach(st, er, func(resp *tree.ListNodesResponse) error {
+		n := resp.GetNode()
+		if path.Base(n.GetPath()) == common.PydioSyncHiddenFile {
+			return nil
+		}
+		// Failed nodes are always checked again
+		var status scrubStatus
+		e := n.GetMeta(common.MetaNamespaceScrub, &status)
+		if e == nil && status.ETag != "" && status.Checksum != "" {
+			mapper.Set(ctx, status.ETag, status.Checksum)
+			knownETags = append(knownETags, status.ETag)
+		}
+		if e == nil && status.Status != "" && status.Status != scrubCorrupted &&
+			status.Status != scrubMissing && time.Unix(status.Checked, 0).After(since) {
+			report.Skipped++
+			return nil
+		}
+		if n.GetStringMeta(common.MetaNamespaceTier) == tiering.Cold {
+			// Do not recall cold files for verification
+			report.Skipped++
+			return nil
+		}
+		candidates = append(candidates, n)
+		return nil
+	}); er != nil {
+		return nil, er
+	}
+
+	mc := tree.NewNodeReceiverClient(grpc.ResolveConn(ctx, common.ServiceMetaGRPC))
+	for i, n := range candidates {
+		status, checksum := d.verify(ctx, router, mapper, ds, n)
+		switch status {
+		case scrubCorrupted, scrubMissing:
+			log.TasksLogger(ctx).Error("Integrity check failed for "+n.GetPath(), zap.String("status", status))
+			if status == scrubCorrupted {
+				report.Corrupted = append(report.Corrupted, n.GetPath())
+			} else {
+				report.Missing = append(report.Missing, n.GetPath())
+			}
+			if restore {
+				if e := d.restoreFromVersion(ctx, router, mapper, ds, n); e != nil {
+					log.TasksLogger(ctx).Error("Cannot restore "+n.GetPath()+" from versions", zap.Error(e))
+				} else {
+					log.TasksLogger(ctx).Info("Restored " + n.GetPath() + " from its last good version")
+					report.Restored = append(report.Restored, n.GetPath())
+					status = scrubRestored
+				}
+			}
+		case scrubUnverified:
+			report.Unverified++
+		}
+		report.Checked++
+
+		mn := &tree.Node{Uuid: n.GetUuid(), Path: n.GetPath(), Type: tree.NodeType_LEAF}
+		ss := &scrubStatus{Checked: time.Now().Unix(), Status: status}
+		if checksum != "" {
+			ss.ETag, ss.Checksum = d.etag(n), checksum
+		}
+		mn.MustSetMeta(common.MetaNamespaceScrub, ss)
+		if _, e := mc.UpdateNode(ctx, &tree.UpdateNodeRequest{From: mn, To: mn, Silent: true}); e != nil {
+			log.TasksLogger(ctx).Warn("Cannot store scrub status for "+n.GetPath(), zap.Error(e))
+		}
+
+		channels.Progress <- float32(i+1) / float32(len(candidates))
+		if (i+1)%100 == 0 {
+			channels.StatusMsg <- fmt.Sprintf("Verified %d/%d files of %s", i+1, len(candidates), ds.Name)
+		}
+	}
+	mapper.Purge(ctx, knownETags)
+	return report, nil
+}
+
+// etag returns the index ETag of a node, without quotes.
+func (d *datasourceScrubAction) etag(n *tree.Node) string {
+	return strings.Trim(n.GetEtag(), "\"")
+}
+
+// plainContents checks that stored data are the plain contents. Otherwise, storage ETags are computed on
+// transformed data and cannot be compared with the contents.
+func (d *datasourceScrubAction) plainContents(ds *object.DataSource) bool {
+	return ds.EncryptionMode == object.EncryptionMode_CLEAR && !ds.CompressionEnabled()
+}
+
+// expectedDigest returns a hash factory and the value expected for a node, or nil if the node contents cannot be verified.
+// Without a Cells hash, the ETag is used if it is a plain MD5, or resolved to the contents MD5 through the checksum mapper.
+func (d *datasourceScrubAction) expectedDigest(ctx context.Context, mapper s3.ChecksumMapper, ds *object.DataSource, n *tree.Node) (func() hash.Hash, string) {
+	if h := n.GetStringMeta(common.MetaNamespaceHash); h != "" {
+		return put.HashFunc, h
+	}
+	etag := d.etag(n)
+	if etag == "" || !d.plainContents(ds) {
+		return nil, ""
+	}
+	if _, e := hex.DecodeString(etag); e == nil && len(etag) == 32 {
+		return md5.New, etag
+	}
+	if mapper != nil {
+		if cs, ok := mapper.Get(ctx, etag); ok {
+			return md5.New, cs
+		}
+	}
+	return nil, ""
+}
+
+// digest reads a node content, possibly at a given version, and computes its hash.
+func (d *datasourceScrubAction) digest(ctx context.Context, router nodes.Handler, n *tree.Node, versionId string, factory func() hash.Hash) (string, error) {
+	rc, er := router.GetObject(ctx, n, &models.GetRequestData{Length: -1, VersionId: versionId})
+	if er != nil {
+		return "", er
+	}
+	defer rc.Close()
+	h := factory()
+	if _, er = io.Copy(h, rc); er != nil {
+		return "", er
+	}
+	return hex.EncodeToString(h.Sum(nil)), nil
+}
+
+// verify checks that the object exists and that its contents match the index. It also returns the contents MD5
+// when it must be recorded for the node ETag: the first time an ETag that is not an MD5 is read, its contents
+// are taken as reference for the next checks and the node is reported as unverified.
+func (d *datasourceScrubAction) verify(ctx context.Context, router nodes.Handler, mapper s3.ChecksumMapper, ds *object.DataSource, n *tree.Node) (string, string) {
+	if _, er := router.ReadNode(ctx, &tree.ReadNodeRequest{Node: n, ObjectStats: true}); er != nil {
+		if errors.Is(er, errors.ObjectNotFound) || errors.Is(er, errors.NodeNotFound) {
+			return scrubMissing, ""
+		}
+		log.TasksLogger(ctx).Warn("Cannot stat object for "+n.GetPath(), zap.Error(er))
+		return scrubUnverified, ""
+	}
+	factory, expected := d.expectedDigest(ctx, mapper, ds, n)
+	// Contents MD5 is kept for ETags that are not an MD5 themselves
+	etag := d.etag(n)
+	mapped := n.GetStringMeta(common.MetaNamespaceHash) == "" && etag != "" && etag != expected && d.plainContents(ds)
+	if factory == nil {
+		factory = md5.New
+	}
+	actual, er := d.digest(ctx, router, n, "", factory)
+	if er != nil {
+		// Object exists but cannot be fully read
+		log.TasksLogger(ctx).Warn("Cannot read contents of "+n.GetPath(), zap.Error(er))
+		return scrubCorrupted, ""
+	}
+	if expected == "" {
+		if mapped {
+			mapper.Set(ctx, etag, actual)
+			return scrubUnverified, actual
+		}
+		return scrubUnverified, ""
+	}
+	if actual != expected {
+		return scrubCorrupted, ""
+	}
+	if mapped {
+		return scrubOK, actual
+	}
+	return scrubOK, ""
+}
+
+// restoreFromVersion finds the most recent published version matching the index hash, verifies its contents
+// and copies it back as the current contents.
+func (d *datasourceScrubAction) restoreFromVersion(ctx context.Context, router nodes.Handler, mapper s3.ChecksumMapper, ds *object.DataSource, n *tree.Node) error {
+	factory, expected := d.expectedDigest(ctx, mapper, ds, n)
+	if factory == nil {
+		return errors.New("no hash known for this file, cannot find a good version")
+	}
+	var revisions []*tree.ContentRevision
+	vc := tree.NewNodeVersionerClient(grpc.ResolveConn(ctx, common.ServiceVersionsGRPC))
+	st, er := vc.ListVersions(ctx, &tree.ListVersionsRequest{Node: n})
+	if er = commons.ForEach(st, er, func(resp *tree.ListVersionsResponse) error {
+		v := resp.GetVersion()
+		if v.GetDraft() {
+			return nil
+		}
+		if v.GetContentHash() == expected || strings.Trim(v.GetETag(), "\"") == expected || strings.Trim(v.GetETag(), "\"") == d.etag(n) {
+			revisions = append(revisions, v)
+		}
+		return nil
+	}); er != nil {
+		return er
+	}
+	for _, v := range revisions {
+		if h, e := d.digest(ctx, router, n, v.GetVersionId(), factory); e != nil || h != expected {
+			log.TasksLogger(ctx).Warn("Version " + v.GetVersionId() + " of " + n.GetPath() + " does not match index hash, skipping")
+			continue
+		}
+		_, er = router.CopyObject(ctx, n, n, &models.CopyRequestData{SrcVersionId: v.GetVersionId()})
+		return er
+	}
+	return errors.WithMessage(errors.StatusNotFound, "no version matching the index hash")
+}
@@ -0,0 +1,141 @@
+/*
+ * Copyright (c) 2025 Abstrium SAS <team (at) pydio.com>
+ * This file is part of Pydio Cells.
+ *
+ * Pydio Cells is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * Pydio Cells is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with Pydio Cells.  If not, see <http://www.gnu.org/licenses/>.
+ *
+ * The latest code can be found at <https://pydio.com>.
+ */
+
+package tree
+
+import (
+	"context"
+	"crypto/md5"
+	"encoding/hex"
+	"testing"
+
+	"github.com/pydio/cells/v5/common"
+	"github.com/pydio/cells/v5/common/nodes"
+	"github.com/pydio/cells/v5/common/proto/jobs"
+	"github.com/pydio/cells/v5/common/proto/object"
+	"github.com/pydio/cells/v5/common/proto/tree"
+	"github.com/pydio/cells/v5/common/sync/endpoints/s3"
+
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestDatasourceScrubAction_Init(t *testing.T) {
+	Convey("Test default parameters", t, func() {
+		a := &datasourceScrubAction{}
+		So(a.Init(nil, &jobs.Job{}, &jobs.Action{}), ShouldBeNil)
+		So(a.GetName(), ShouldEqual, datasourceScrubActionName)
+		So(a.recheckAfter, ShouldEqual, "30")
+		So(a.restore, ShouldBeEmpty)
+	})
+}
+
+func TestDatasourceScrubAction_ExpectedDigest(t *testing.T) {
+	a := &datasourceScrubAction{}
+	ctx := context.Background()
+	ds := &object.DataSource{Name: "pydiods1", EncryptionMode: object.EncryptionMode_CLEAR}
+
+	Convey("Cells hash is preferred", t, func() {
+		n := &tree.Node{Etag: "d41d8cd98f00b204e9800998ecf8427e"}
+		n.MustSetMeta(common.MetaNamespaceHash, "abcd")
+		f, h := a.expectedDigest(ctx, nil, ds, n)
+		So(f, ShouldNotBeNil)
+		So(h, ShouldEqual, "abcd")
+	})
+
+	Convey("Plain MD5 ETags are used as fallback", t, func() {
+		n := &tree.Node{Etag: "\"d41d8cd98f00b204e9800998ecf8427e\""}
+		f, h := a.expectedDigest(ctx, nil, ds, n)
+		So(f, ShouldNotBeNil)
+		So(h, ShouldEqual, "d41d8cd98f00b204e9800998ecf8427e")
+	})
+
+	Convey("Multipart ETags are resolved through the checksum mapper", t, func() {
+		mapper := s3.NewMemChecksumMapper()
+		n := &tree.Node{Etag: "d41d8cd98f00b204e9800998ecf8427e-2"}
+		f, _ := a.expectedDigest(ctx, mapper, ds, n)
+		So(f, ShouldBeNil)
+		mapper.Set(ctx, "d41d8cd98f00b204e9800998ecf8427e-2", "0123456789abcdef0123456789abcdef")
+		f, h := a.expectedDigest(ctx, mapper, ds, n)
+		So(f, ShouldNotBeNil)
+		So(h, ShouldEqual, "0123456789abcdef0123456789abcdef")
+	})
+
+	Convey("Transformed contents cannot be verified", t, func() {
+		enc := &object.DataSource{Name: "enc", EncryptionMode: object.EncryptionMode_MASTER}
+		f, _ := a.expectedDigest(ctx, nil, enc, &tree.Node{Etag: "d41d8cd98f00b204e9800998ecf8427e"})
+		So(f, ShouldBeNil)
+	})
+}
+
+func TestDatasourceScrubAction_Verify(t *testing.T) {
+	a := &datasourceScrubAction{}
+	ctx := context.Background()
+	ds := &object.DataSource{Name: "pydiods1", EncryptionMode: object.EncryptionMode_CLEAR}
+	// Mock router serves node path + "hello world" as contents
+	md5Of := func(s string) string {
+		h := md5.Sum([]byte(s))
+		return hex.EncodeToString(h[:])
+	}
+	router := nodes.NewHandlerMock()
+	mapper := s3.NewMemChecksumMapper()
+
+	Convey("Intact objects are verified", t, func() {
+		n := &tree.Node{Path: "pydiods1/file", Etag: md5Of("pydiods1/filehello world")}
+		router.Nodes[n.Path] = n
+		status, _ := a.verify(ctx, router, mapper, ds, n)
+		So(status, ShouldEqual, scrubOK)
+	})
+
+	Convey("Corrupted objects are detected", t, func() {
+		n := &tree.Node{Path: "pydiods1/corrupted", Etag: md5Of("original contents")}
+		router.Nodes[n.Path] = n
+		status, _ := a.verify(ctx, router, mapper, ds, n)
+		So(status, ShouldEqual, scrubCorrupted)
+
+		h := &tree.Node{Path: "pydiods1/hashed", Etag: md5Of("pydiods1/hashedhello world")}
+		h.MustSetMeta(common.MetaNamespaceHash, "not-the-hash")
+		router.Nodes[h.Path] = h
+		status, _ = a.verify(ctx, router, mapper, ds, h)
+		So(status, ShouldEqual, scrubCorrupted)
+	})
+
+	Convey("Missing objects are detected", t, func() {
+		status, _ := a.verify(ctx, router, mapper, ds, &tree.Node{Path: "pydiods1/missing", Etag: md5Of("")})
+		So(status, ShouldEqual, scrubMissing)
+	})
+
+	Convey("Multipart objects are verified against the recorded checksum", t, func() {
+		n := &tree.Node{Path: "pydiods1/multipart", Etag: "0123456789abcdef0123456789abcdef-3"}
+		router.Nodes[n.Path] = n
+		status, checksum := a.verify(ctx, router, mapper, ds, n)
+		So(status, ShouldEqual, scrubUnverified)
+		So(checksum, ShouldEqual, md5Of("pydiods1/multiparthello world"))
+
+		status, _ = a.verify(ctx, router, mapper, ds, n)
+		So(status, ShouldEqual, scrubOK)
+
+		// Same ETag, different contents
+		mapper.Set(ctx, "fedcba9876543210fedcba9876543210-2", md5Of("original contents"))
+		c := &tree.Node{Path: "pydiods1/multipart-corrupted", Etag: "fedcba9876543210fedcba9876543210-2"}
+		router.Nodes[c.Path] = c
+		status, _ = a.verify(ctx, router, mapper, ds, c)
+		So(status, ShouldEqual, scrubCorrupted)
+	})
+}
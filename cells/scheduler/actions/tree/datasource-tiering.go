@@ -0,0 +1,186 @@
+/*
+ * Copyright (c) 2025 Abstrium SAS <team (at) pydio.com>
+ * This file is part of Pydio Cells.
+ *
+ * Pydio Cells is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Affero General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * Pydio Cells is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Affero General Public License for more details.
+ *
+ * You should have received a copy of the GNU Affero General Public License
+ * along with Pydio Cells.  If not, see <http://www.gnu.org/licenses/>.
+ *
+ * The latest code can be found at <https://pydio.com>.
+ */
+
+package tree
+
+import (
+	"context"
+	"fmt"
+	"path"
+	"strconv"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/pydio/cells/v5/common"
+	"github.com/pydio/cells/v5/common/client/commons"
+	"github.com/pydio/cells/v5/common/client/commons/treec"
+	"github.com/pydio/cells/v5/common/errors"
+	"github.com/pydio/cells/v5/common/forms"
+	"github.com/pydio/cells/v5/common/nodes"
+	"github.com/pydio/cells/v5/common/nodes/compose"
+	"github.com/pydio/cells/v5/common/nodes/tiering"
+	"github.com/pydio/cells/v5/common/proto/jobs"
+	"github.com/pydio/cells/v5/common/proto/tree"
+	"github.com/pydio/cells/v5/common/telemetry/log"
+	"github.com/pydio/cells/v5/scheduler/actions"
+)
+
+var (
+	datasourceTieringActionName = "actions.tree.ds-tiering"
+)
+
+// datasourceTieringAction moves files of a flat datasource that were not accessed for a given period to the storage
+// of its cold datasource. Files are transparently recalled to the hot storage when they are read again.
+type datasourceTieringAction struct {
+	coldAfter string
+}
+
+func (d *datasourceTieringAction) GetName() string {
+	return datasourceTieringActionName
+}
+
+func (d *datasourceTieringAction) GetDescription(lang ...string) actions.ActionDescription {
+	return actions.ActionDescription{
+		ID:          datasourceTieringActionName,
+		Label:       "Datasource Tiering",
+		Icon:        "mdi mdi-snowflake",
+		IsInternal:  true,
+		Description: "Move files that were not accessed recently to the datasource cold storage",
+		Category:    actions.ActionCategoryTree,
+		HasForm:     true,
+	}
+}
+
+func (d *datasourceTieringAction) GetParametersForm(context.Context) *forms.Form {
+	return &forms.Form{
+		Groups: []*forms.Group{
+			{
+				Fields: []forms.Field{
+					&forms.FormField{
+						Name:        "coldAfter",
+						Type:        forms.ParamString,
+						Label:       "Days without access",
+						Description: "Number of days without access after which a file is moved to cold storage. Leave empty to use the datasource configuration.",
+					},
+				},
+			},
+		},
+	}
+}
+
+// ProvidesProgress implements interface
+func (d *datasourceTieringAction) ProvidesProgress() bool {
+	return true
+}
+
+func (d *datasourceTieringAction) Init(ctx context.Context, job *jobs.Job, action *jobs.Action) error {
+	d.coldAfter = action.Parameters["coldAfter"]
+	return nil
+}
+
+func (d *datasourceTieringAction) Run(ctx context.Context, channels *actions.RunnableChannels, input *jobs.ActionMessage) (*jobs.ActionMessage, error) {
+	dss := input.GetDataSources()
+	if len(dss) == 0 {
+		er := errors.New("cannot find datasource")
+		return input.WithError(er), er
+	}
+	ds := dss[0]
+	if ds.TieringColdDatasource() == "" {
+		er := errors.New("datasource " + ds.Name + " has no cold datasource configured, or is not using a flat storage")
+		return input.WithError(er), er
+	}
+	after := ds.TieringColdAfter()
+	if p := jobs.EvaluateFieldStr(ctx, input, d.coldAfter); p != "" {
+		days, er := strconv.Atoi(p)
+		if er != nil || days <= 0 {
+			er = errors.New("invalid number of days: " + p)
+			return input.WithError(er), er
+		}
+		after = time.Duration(days) * 24 * time.Hour
+	}
+	if after == 0 {
+		log.TasksLogger(ctx).Info("No tiering delay configured for datasource " + ds.Name + ", nothing to do")
+		return input.WithDataSource(ds), nil
+	}
+
+	pool := compose.PathClient(nodes.AsAdmin()).GetClientsPool(ctx)
+	source, er := pool.GetDataSourceInfo(ds.Name)
+	if er != nil {
+		return input.WithError(er), er
+	}
+	cold, er := tiering.ColdSource(pool, source)
+	if er != nil {
+		return input.WithError(er), er
+	}
+
+	limit := time.Now().Add(-after)
+	var candidates []*tree.Node
+	var tagged int
+	st, er := treec.NodeProviderClient(ctx).ListNodes(ctx, &tree.ListNodesRequest{
+		Node:       &tree.Node{Path: ds.Name},
+		Recursive:  true,
+		FilterType: tree.NodeType_LEAF,
+	})
+	if er = commons.ForEach(st, er, func(resp *tree.ListNodesResponse) error {
+		n := resp.GetNode()
+		tier := n.GetStringMeta(common.MetaNamespaceTier)
+		if tier == tiering.Cold || path.Base(n.GetPath()) == common.PydioSyncHiddenFile {
+			return nil
+		}
+		if tiering.LastAccess(n).Before(limit) {
+			candidates = append(candidates, n)
+		} else if tier != tiering.Hot {
+			// Files written before tiering was enabled carry no tier yet
+			if e := tiering.SetTier(ctx, n, tiering.Hot); e != nil {
+				log.TasksLogger(ctx).Warn("Cannot update tier metadata for "+n.GetPath(), zap.Error(e))
+			} else {
+				tagged++
+			}
+		}
+		return nil
+	}); er != nil {
+		return input.WithError(er), er
+	}
+
+	var moved, failed int
+	for i, n := range candidates {
+		if e := tiering.MoveObject(ctx, source, cold, source.FlatShardedPath(n.GetUuid())); e != nil {
+			failed++
+			log.TasksLogger(ctx).Error("Cannot move file "+n.GetPath()+" to cold storage", zap.Error(e))
+		} else {
+			moved++
+			if e = tiering.SetTier(ctx, n, tiering.Cold); e != nil {
+				log.TasksLogger(ctx).Warn("Cannot update tier metadata for "+n.GetPath(), zap.Error(e))
+			}
+		}
+		channels.Progress <- float32(i+1) / float32(len(candidates))
+		if (i+1)%100 == 0 {
+			channels.StatusMsg <- fmt.Sprintf("Processed %d/%d files", i+1, len(candidates))
+		}
+	}
+
+	log.TasksLogger(ctx).Info(fmt.Sprintf("Moved %d file(s) of datasource %s to cold storage, tagged %d file(s) as hot", moved, ds.Name, tagged))
+	if failed > 0 {
+		er = errors.Errorf("failed moving %d file(s) to cold storage", failed)
+		return input.WithError(er), er
+	}
+	return input.WithDataSource(ds), nil
+}
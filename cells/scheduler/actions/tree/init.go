@@ -47,6 +47,18 @@ func init() {
 		return &datasourceAttributeAction{}
 	})
 
+	manager.Register(datasourceRotateKeyActionName, func() actions.ConcreteAction {
+		return &datasourceRotateKeyAction{}
+	})
+
+	manager.Register(datasourceTieringActionName, func() actions.ConcreteAction {
+		return &datasourceTieringAction{}
+	})
+
+	manager.Register(datasourceScrubActionName, func() actions.ConcreteAction {
+		return &datasourceScrubAction{}
+	})
+
 	manager.Register(middlewareMetaActionName, func() actions.ConcreteAction {
 		return &middlewareMetaAction{}
 	})
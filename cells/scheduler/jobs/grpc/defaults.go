@@ -195,12 +195,160 @@ func GetDefaultJobs() []*jobs.Job {
 		},
 	}
 
+	linksActivation := &jobs.Job{
+		ID:    "links-activation",
+		Label: "Jobs.Default.LinksActivation",
+		Owner: common.PydioSystemUsername,
+		Schedule: &jobs.Schedule{
+			Iso8601Schedule: "R/2012-01-01T00:05:00.828Z/PT15M",
+		},
+		Actions: []*jobs.Action{
+			{
+				ID: "actions.idm.links-activation",
+			},
+		},
+	}
+
+	fileRequestsDigest := &jobs.Job{
+		ID:    "file-requests-digest",
+		Label: "Jobs.Default.FileRequestsDigest",
+		Owner: common.PydioSystemUsername,
+		Schedule: &jobs.Schedule{
+			Iso8601Schedule: "R/2012-01-01T00:10:00.828Z/PT1H",
+		},
+		Actions: []*jobs.Action{
+			{
+				ID: "actions.idm.file-requests-digest",
+			},
+		},
+	}
+
+	accessReviewEscalate := &jobs.Job{
+		ID:    "access-review-escalate",
+		Label: "Jobs.Default.AccessReviewEscalate",
+		Owner: common.PydioSystemUsername,
+		Schedule: &jobs.Schedule{
+			Iso8601Schedule: "R/2012-01-01T07:00:00.828Z/PT24H",
+		},
+		Actions: []*jobs.Action{
+			{
+				ID: "actions.idm.access-review-escalate",
+			},
+		},
+	}
+
+	elevationsExpire := &jobs.Job{
+		ID:    "elevations-expire",
+		Label: "Jobs.Default.ElevationsExpire",
+		Owner: common.PydioSystemUsername,
+		Schedule: &jobs.Schedule{
+			Iso8601Schedule: "R/2012-01-01T00:02:00.828Z/PT5M",
+		},
+		Actions: []*jobs.Action{
+			{
+				ID: "actions.idm.elevations-expire",
+			},
+		},
+	}
+
+	guestsLifecycle := &jobs.Job{
+		ID:    "guests-lifecycle",
+		Label: "Jobs.Default.GuestsLifecycle",
+		Owner: common.PydioSystemUsername,
+		Schedule: &jobs.Schedule{
+			Iso8601Schedule: "R/2012-01-01T06:00:00.828Z/PT24H",
+		},
+		Actions: []*jobs.Action{
+			{
+				ID: "actions.idm.guests-lifecycle",
+			},
+		},
+	}
+
+	guestsTransfer := &jobs.Job{
+		ID:                "guests-transfer",
+		Owner:             common.PydioSystemUsername,
+		Label:             "Jobs.Default.GuestsTransfer",
+		MaxConcurrency:    5,
+		TasksSilentUpdate: true,
+		EventNames: []string{
+			jobs.IdmChangeEventName(jobs.IdmSelectorType_User, idm.ChangeEventType_DELETE),
+		},
+		IdmFilter: &jobs.IdmSelector{
+			Type: jobs.IdmSelectorType_User,
+			Query: &service.Query{
+				SubQueries: []*anypb.Any{jobs.MustMarshalAny(&idm.UserSingleQuery{
+					NodeType: idm.NodeType_USER,
+				})},
+			},
+		},
+		Actions: []*jobs.Action{
+			{
+				ID: "actions.idm.guests-transfer",
+			},
+		},
+	}
+
+	dataExportsClean := &jobs.Job{
+		ID:    "data-exports-clean",
+		Label: "Jobs.Default.DataExportsClean",
+		Owner: common.PydioSystemUsername,
+		Schedule: &jobs.Schedule{
+			Iso8601Schedule: "R/2012-01-01T04:00:00.828Z/PT24H",
+		},
+		Actions: []*jobs.Action{
+			{
+				ID: "actions.idm.data-exports-clean",
+			},
+		},
+	}
+
+	quotaWarnings := &jobs.Job{
+		ID:    "quota-warnings",
+		Label: "Jobs.Default.QuotaWarnings",
+		Owner: common.PydioSystemUsername,
+		Schedule: &jobs.Schedule{
+			Iso8601Schedule: "R/2012-01-01T05:00:00.828Z/PT24H",
+		},
+		Actions: []*jobs.Action{
+			{
+				ID: "actions.idm.quota-warnings",
+			},
+		},
+	}
+
+	linkAccessesPrune := &jobs.Job{
+		ID:    "link-accesses-prune",
+		Label: "Jobs.Default.LinkAccessesPrune",
+		Owner: common.PydioSystemUsername,
+		Schedule: &jobs.Schedule{
+			Iso8601Schedule: "R/2012-01-01T03:30:00.828Z/PT24H",
+		},
+		Actions: []*jobs.Action{
+			{
+				ID: "actions.idm.link-accesses-prune",
+				Parameters: map[string]string{
+					"retention": "2160h",
+				},
+			},
+		},
+	}
+
 	defJobs := []*jobs.Job{
 		thumbnailsJob,
 		stuckTasksJob,
 		cleanUserDataJob,
 		cleanTemporaryOrphans,
 		cleanExpiredACLs,
+		linksActivation,
+		fileRequestsDigest,
+		accessReviewEscalate,
+		elevationsExpire,
+		guestsLifecycle,
+		guestsTransfer,
+		dataExportsClean,
+		quotaWarnings,
+		linkAccessesPrune,
 	}
 
 	return defJobs
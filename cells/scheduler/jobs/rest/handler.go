@@ -304,6 +304,8 @@ func (s *JobsHandler) UserCreateJob(req *restful.Request, rsp *restful.Response)
 		job, err = userspace.SyncDatasourceTask(ctx, dsName, ll...)
 	case "import-p8":
 		job, err = userspace.P8migrationTask(ctx, request.JsonParameters)
+	case "data-export":
+		job, err = userspace.DataExportTask(ctx, ll...)
 	default:
 		err = errors.WithMessagef(errors.InvalidParameters, "unknown job name %s", request.JobName)
 	}
@@ -755,6 +755,38 @@ func WgetTask(ctx context.Context, router nodes.Client, parentPath string, urls
 
 }
 
+// DataExportTask launches a background task building an archive of all personal data of the current user.
+func DataExportTask(ctx context.Context, languages ...string) (*jobs.Job, error) {
+
+	claims, ok := claim.FromContext(ctx)
+	if !ok {
+		return nil, errors.WithStack(errors.MissingClaims)
+	}
+	T := lang.Bundle().T(languages...)
+	job := &jobs.Job{
+		ID:             "data-export-" + uuid.New(),
+		Owner:          claims.Name,
+		Label:          T("Jobs.User.DataExport"),
+		Inactive:       false,
+		Languages:      languages,
+		MaxConcurrency: 1,
+		AutoStart:      true,
+		AutoClean:      true,
+		Actions: []*jobs.Action{
+			{
+				ID:         "actions.idm.data-export",
+				Parameters: map[string]string{"scope": "owner"},
+			},
+		},
+	}
+	resp, er := jobsc.JobServiceClient(ctx).PutJob(ctx, &jobs.PutJobRequest{Job: job})
+	if er != nil {
+		return nil, er
+	}
+	return resp.GetJob(), nil
+
+}
+
 func P8migrationTask(ctx context.Context, jsonParams string) (*jobs.Job, error) {
 
 	claims, ok := claim.FromContext(ctx)